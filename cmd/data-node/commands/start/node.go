@@ -244,6 +244,9 @@ func (l *NodeCommand) createGRPCServer(config api.Config) *api.GRPCServer {
 		l.ammPoolsService,
 		l.volumeRebateStatsService,
 		l.volumeRebateProgramService,
+		l.requestsForQuoteService,
+		l.autoDeleveragingRankingsService,
+		l.governanceDelegationsService,
 	)
 	return grpcServer
 }
@@ -86,6 +86,9 @@ type SQLSubscribers struct {
 	ammPoolsStore                     *sqlstore.AMMPools
 	volumeRebateStatsStore            *sqlstore.VolumeRebateStats
 	volumeRebateProgramsStore         *sqlstore.VolumeRebatePrograms
+	requestsForQuoteStore             *sqlstore.RequestsForQuote
+	autoDeleveragingRankingsStore     *sqlstore.AutoDeleveragingRankings
+	governanceDelegationsStore        *sqlstore.GovernanceDelegations
 
 	// Services
 	candleService                       *candlesv2.Svc
@@ -146,6 +149,9 @@ type SQLSubscribers struct {
 	ammPoolsService                     *service.AMMPools
 	volumeRebateStatsService            *service.VolumeRebateStats
 	volumeRebateProgramService          *service.VolumeRebatePrograms
+	requestsForQuoteService             *service.RequestsForQuote
+	autoDeleveragingRankingsService     *service.AutoDeleveragingRankings
+	governanceDelegationsService        *service.GovernanceDelegations
 
 	// Subscribers
 	accountSub                      *sqlsubscribers.Account
@@ -202,6 +208,9 @@ type SQLSubscribers struct {
 	ammPoolsSub                     *sqlsubscribers.AMMPools
 	volumeRebateStatsSub            *sqlsubscribers.VolumeRebateStatsUpdated
 	volumeRebateProgramSub          *sqlsubscribers.VolumeRebateProgram
+	requestsForQuoteSub             *sqlsubscribers.RequestsForQuote
+	autoDeleveragingRankingsSub     *sqlsubscribers.AutoDeleveragingRankings
+	governanceDelegationsSub        *sqlsubscribers.GovernanceDelegations
 }
 
 func (s *SQLSubscribers) GetSQLSubscribers() []broker.SQLBrokerSubscriber {
@@ -262,6 +271,9 @@ func (s *SQLSubscribers) GetSQLSubscribers() []broker.SQLBrokerSubscriber {
 		s.ammPoolsSub,
 		s.volumeRebateProgramSub,
 		s.volumeRebateStatsSub,
+		s.requestsForQuoteSub,
+		s.autoDeleveragingRankingsSub,
+		s.governanceDelegationsSub,
 	}
 }
 
@@ -327,6 +339,9 @@ func (s *SQLSubscribers) CreateAllStores(ctx context.Context, Log *logging.Logge
 	s.ammPoolsStore = sqlstore.NewAMMPools(transactionalConnectionSource)
 	s.volumeRebateStatsStore = sqlstore.NewVolumeRebateStats(transactionalConnectionSource)
 	s.volumeRebateProgramsStore = sqlstore.NewVolumeRebatePrograms(transactionalConnectionSource)
+	s.requestsForQuoteStore = sqlstore.NewRequestsForQuote(transactionalConnectionSource)
+	s.autoDeleveragingRankingsStore = sqlstore.NewAutoDeleveragingRankings(transactionalConnectionSource)
+	s.governanceDelegationsStore = sqlstore.NewGovernanceDelegations(transactionalConnectionSource)
 }
 
 func (s *SQLSubscribers) SetupServices(ctx context.Context, log *logging.Logger, cfg service.Config, candlesConfig candlesv2.Config) error {
@@ -386,6 +401,9 @@ func (s *SQLSubscribers) SetupServices(ctx context.Context, log *logging.Logger,
 	s.ammPoolsService = service.NewAMMPools(s.ammPoolsStore)
 	s.volumeRebateStatsService = service.NewVolumeRebateStats(s.volumeRebateStatsStore)
 	s.volumeRebateProgramService = service.NewVolumeRebatePrograms(s.volumeRebateProgramsStore)
+	s.requestsForQuoteService = service.NewRequestsForQuote(s.requestsForQuoteStore)
+	s.autoDeleveragingRankingsService = service.NewAutoDeleveragingRankings(s.autoDeleveragingRankingsStore)
+	s.governanceDelegationsService = service.NewGovernanceDelegations(s.governanceDelegationsStore)
 
 	s.marketDepthService = service.NewMarketDepth(
 		cfg.MarketDepth,
@@ -470,4 +488,7 @@ func (s *SQLSubscribers) SetupSQLSubscribers() {
 	s.volumeRebateStatsSub = sqlsubscribers.NewVolumeRebateStatsUpdated(s.volumeRebateStatsService)
 	s.volumeRebateProgramSub = sqlsubscribers.NewVolumeRebateProgram(s.volumeRebateProgramService)
 	s.ammPoolsSub = sqlsubscribers.NewAMMPools(s.ammPoolsService, s.marketDepthService)
+	s.requestsForQuoteSub = sqlsubscribers.NewRequestsForQuote(s.requestsForQuoteService)
+	s.autoDeleveragingRankingsSub = sqlsubscribers.NewAutoDeleveragingRankings(s.autoDeleveragingRankingsService)
+	s.governanceDelegationsSub = sqlsubscribers.NewGovernanceDelegations(s.governanceDelegationsService)
 }
@@ -59,6 +59,15 @@ func checkAmendAMM(cmd *commandspb.AmendAMM) Errors {
 		}
 	}
 
+	if cmd.BaseCommitmentAmount != nil {
+		hasUpdate = true
+		if amount, _ := big.NewInt(0).SetString(*cmd.BaseCommitmentAmount, 10); amount == nil {
+			errs.FinalAddForProperty("amend_amm.base_commitment_amount", ErrIsNotValidNumber)
+		} else if amount.Cmp(big.NewInt(0)) < 0 {
+			errs.AddForProperty("amend_amm.base_commitment_amount", ErrMustBePositiveOrZero)
+		}
+	}
+
 	if cmd.ProposedFee != nil {
 		hasUpdate = true
 		if proposedFee, err := num.DecimalFromString(*cmd.ProposedFee); err != nil {
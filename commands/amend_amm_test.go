@@ -95,6 +95,18 @@ func TestCheckAmendAMM(t *testing.T) {
 			},
 			errStr: "amend_amm.commitment_amount (must be positive)",
 		},
+		{
+			submission: commandspb.AmendAMM{
+				BaseCommitmentAmount: ptr.From("abc"),
+			},
+			errStr: "amend_amm.base_commitment_amount (is not a valid number)",
+		},
+		{
+			submission: commandspb.AmendAMM{
+				BaseCommitmentAmount: ptr.From("-10"),
+			},
+			errStr: "amend_amm.base_commitment_amount (must be positive or zero)",
+		},
 		{
 			submission: commandspb.AmendAMM{
 				ProposedFee: ptr.From(""),
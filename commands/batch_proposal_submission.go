@@ -226,6 +226,12 @@ func checkNewFreeformBatchChanges(change *protoTypes.BatchProposalTermsChange_Ne
 	if change.NewFreeform == nil {
 		return errs.FinalAddForProperty("batch_proposal_submission.terms.changes.new_freeform", ErrIsRequired)
 	}
+
+	// the votes of a batch proposal are shared by all its proposals, so a
+	// poll cannot be part of it.
+	if len(change.NewFreeform.Options) > 0 || change.NewFreeform.TallyMethod != protoTypes.PollTallyMethod_POLL_TALLY_METHOD_UNSPECIFIED {
+		errs.AddForProperty("batch_proposal_submission.terms.changes.new_freeform.options", ErrIsNotSupported)
+	}
 	return errs
 }
 
@@ -44,6 +44,7 @@ func TestCheckBatchProposalSubmission(t *testing.T) {
 	t.Run("Submitting a proposal with closing timestamp after enactment timestamp fails", testBatchProposalSubmissionWithClosingTimestampAfterEnactmentTimestampFails)
 	t.Run("Submitting a proposal with closing timestamp before enactment timestamp succeeds", testBatchProposalSubmissionWithClosingTimestampBeforeEnactmentTimestampSucceeds)
 	t.Run("Submitting a proposal with closing timestamp at enactment timestamp succeeds", testProposalSubmissionWithClosingTimestampAtEnactmentTimestampSucceeds)
+	t.Run("Submitting a proposal with a poll fails", testBatchProposalSubmissionWithPollFails)
 }
 
 func testNilBatchProposalSubmissionFails(t *testing.T) {
@@ -66,6 +67,25 @@ func testBatchProposalSubmissionWithoutChangesFails(t *testing.T) {
 	assert.Contains(t, err.Get("batch_proposal_submission.terms.changes"), commands.ErrIsRequired)
 }
 
+func testBatchProposalSubmissionWithPollFails(t *testing.T) {
+	err := checkBatchProposalSubmission(&commandspb.BatchProposalSubmission{
+		Terms: &commandspb.BatchProposalSubmissionTerms{
+			Changes: []*vegapb.BatchProposalTermsChange{
+				{
+					Change: &vegapb.BatchProposalTermsChange_NewFreeform{
+						NewFreeform: &vegapb.NewFreeform{
+							Options:     []string{"a", "b"},
+							TallyMethod: vegapb.PollTallyMethod_POLL_TALLY_METHOD_PLURALITY,
+						},
+					},
+				},
+			},
+		},
+	})
+
+	assert.Contains(t, err.Get("batch_proposal_submission.terms.changes.new_freeform.options"), commands.ErrIsNotSupported)
+}
+
 func testBatchProposalSubmissionWithoutRationalFails(t *testing.T) {
 	err := checkBatchProposalSubmission(&commandspb.BatchProposalSubmission{})
 
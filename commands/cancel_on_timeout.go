@@ -0,0 +1,53 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package commands
+
+import (
+	"fmt"
+
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+)
+
+func CheckCancelOnTimeout(cmd *commandspb.CancelOnTimeout) error {
+	return checkCancelOnTimeout(cmd).ErrorOrNil()
+}
+
+func checkCancelOnTimeout(cmd *commandspb.CancelOnTimeout) Errors {
+	errs := NewErrors()
+
+	if cmd == nil {
+		return errs.FinalAddForProperty("cancel_on_timeout", ErrIsRequired)
+	}
+
+	if cmd.Timeout < 0 {
+		errs.AddForProperty("cancel_on_timeout.timeout", ErrMustBePositiveOrZero)
+	}
+
+	seen := make(map[string]struct{}, len(cmd.MarketIds))
+	for i, id := range cmd.MarketIds {
+		property := fmt.Sprintf("cancel_on_timeout.market_ids.%d", i)
+		if !IsVegaID(id) {
+			errs.AddForProperty(property, ErrShouldBeAValidVegaID)
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			errs.AddForProperty(property, ErrIsDuplicated)
+		}
+		seen[id] = struct{}{}
+	}
+
+	return errs
+}
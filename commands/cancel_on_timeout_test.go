@@ -0,0 +1,86 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package commands_test
+
+import (
+	"errors"
+	"testing"
+
+	"code.vegaprotocol.io/vega/commands"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckCancelOnTimeout(t *testing.T) {
+	marketID := "e9982447fb4128f9968f9981612c5ea85d19b62058ec2636efc812dcbbc745ca"
+	cases := []struct {
+		submission *commandspb.CancelOnTimeout
+		errStr     string
+	}{
+		{
+			// a zero timeout removes the cancel-on-timeout
+			submission: &commandspb.CancelOnTimeout{},
+		},
+		{
+			submission: &commandspb.CancelOnTimeout{
+				Timeout:    10,
+				MarketIds:  []string{marketID},
+				CancelAmms: true,
+			},
+		},
+		{
+			submission: &commandspb.CancelOnTimeout{
+				Timeout: -1,
+			},
+			errStr: "cancel_on_timeout.timeout (must be positive or zero)",
+		},
+		{
+			submission: &commandspb.CancelOnTimeout{
+				Timeout:   10,
+				MarketIds: []string{marketID, "notavalidmarketid"},
+			},
+			errStr: "cancel_on_timeout.market_ids.1 (should be a valid Vega ID)",
+		},
+		{
+			submission: &commandspb.CancelOnTimeout{
+				Timeout:   10,
+				MarketIds: []string{marketID, marketID},
+			},
+			errStr: "cancel_on_timeout.market_ids.1 (is duplicated)",
+		},
+	}
+
+	for n, c := range cases {
+		if len(c.errStr) <= 0 {
+			assert.NoError(t, commands.CheckCancelOnTimeout(c.submission), n)
+			continue
+		}
+
+		assert.Contains(t, checkCancelOnTimeout(c.submission).Error(), c.errStr, n)
+	}
+}
+
+func checkCancelOnTimeout(cmd *commandspb.CancelOnTimeout) commands.Errors {
+	err := commands.CheckCancelOnTimeout(cmd)
+
+	var e commands.Errors
+	if ok := errors.As(err, &e); !ok {
+		return commands.NewErrors()
+	}
+
+	return e
+}
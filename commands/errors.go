@@ -96,6 +96,11 @@ var (
 	ErrMustBeAtMost250                                 = errors.New("must be at most 250")
 	ErrNoUpdatesProvided                               = errors.New("no updates provided")
 	ErrMaxPriceMustRespectTickSize                     = errors.New("must respect tick size")
+	ErrMustHaveAtLeastTwoOutcomes                      = errors.New("must have at least 2 outcomes")
+	ErrMustBeSetForPredictionMarkets                   = errors.New("must be set for prediction markets")
+	ErrMustHaveAtLeastTwoOptions                       = errors.New("must have at least 2 options")
+	ErrIsLimitedTo32Entries                            = errors.New("is limited to 32 entries")
+	ErrCanOnlyBeSetOnYesVote                           = errors.New("can only be set on a yes vote")
 )
 
 type Errors map[string][]error
@@ -69,6 +69,10 @@ func checkOrderSubmission(cmd *commandspb.OrderSubmission) Errors {
 		errs.AddForProperty("order_submission.time_in_force", ErrIsNotValid)
 	}
 
+	if _, ok := types.Order_SelfTradePrevention_name[int32(cmd.SelfTradePrevention)]; !ok {
+		errs.AddForProperty("order_submission.self_trade_prevention", ErrIsNotValid)
+	}
+
 	if cmd.Size <= 0 {
 		errs.AddForProperty("order_submission.size", ErrMustBePositive)
 	}
@@ -117,6 +121,11 @@ func checkOrderSubmission(cmd *commandspb.OrderSubmission) Errors {
 		}
 	}
 
+	if cmd.MaxSlippage != nil && cmd.Type != types.Order_TYPE_MARKET {
+		errs.AddForProperty("order_submission.max_slippage",
+			errors.New("only valid for market orders"))
+	}
+
 	// iceberg checks
 	if cmd.IcebergOpts != nil {
 		iceberg := cmd.IcebergOpts
@@ -174,7 +183,9 @@ func checkOrderSubmission(cmd *commandspb.OrderSubmission) Errors {
 				errs.AddForProperty("order_submission.pegged_order.reference",
 					errors.New("cannot have a reference of type BEST_ASK when on BUY side"),
 				)
-			case types.PeggedReference_PEGGED_REFERENCE_BEST_BID:
+			case types.PeggedReference_PEGGED_REFERENCE_BEST_BID,
+				types.PeggedReference_PEGGED_REFERENCE_MARK_PRICE,
+				types.PeggedReference_PEGGED_REFERENCE_INDEX_PRICE:
 				if offset, ok := big.NewInt(0).SetString(cmd.PeggedOrder.Offset, 10); !ok {
 					errs.AddForProperty(
 						"order_submission.pegged_order.offset",
@@ -201,7 +212,9 @@ func checkOrderSubmission(cmd *commandspb.OrderSubmission) Errors {
 			errs.AddForProperty("order_submission.pegged_order.reference",
 				errors.New("cannot have a reference of type BEST_BID when on SELL side"),
 			)
-		case types.PeggedReference_PEGGED_REFERENCE_BEST_ASK:
+		case types.PeggedReference_PEGGED_REFERENCE_BEST_ASK,
+			types.PeggedReference_PEGGED_REFERENCE_MARK_PRICE,
+			types.PeggedReference_PEGGED_REFERENCE_INDEX_PRICE:
 			if offset, ok := big.NewInt(0).SetString(cmd.PeggedOrder.Offset, 10); !ok {
 				errs.AddForProperty(
 					"order_submission.pegged_order.offset",
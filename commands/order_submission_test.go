@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"code.vegaprotocol.io/vega/commands"
+	"code.vegaprotocol.io/vega/libs/ptr"
 	"code.vegaprotocol.io/vega/libs/test"
 	types "code.vegaprotocol.io/vega/protos/vega"
 	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
@@ -39,6 +40,7 @@ func TestCheckOrderSubmission(t *testing.T) {
 	t.Run("Submitting an order with NETWORK type fails", testOrderSubmissionWithNetworkTypeFails)
 	t.Run("Submitting an order with undefined time in force fails", testOrderSubmissionWithUndefinedTimeInForceFails)
 	t.Run("Submitting an order with unspecified time in force fails", testOrderSubmissionWithUnspecifiedTimeInForceFails)
+	t.Run("Submitting an order with undefined self-trade prevention fails", testOrderSubmissionWithUndefinedSelfTradePreventionFails)
 	t.Run("Submitting an order with non-positive size fails", testOrderSubmissionWithInvalidSizeFails)
 	t.Run("Submitting an order with GTT and non-positive expiration date fails", testOrderSubmissionWithGTTAndNonPositiveExpirationDateFails)
 	t.Run("Submitting an order without GTT and expiration date fails", testOrderSubmissionWithoutGTTAndExpirationDateFails)
@@ -65,8 +67,51 @@ func TestCheckOrderSubmission(t *testing.T) {
 	t.Run("Submitting a pegged order with side sell and best ask reference and non negative offset succeeds", testPeggedOrderSubmissionWithSideSellAndBestAskReferenceAndNonNegativeOffsetSucceeds)
 	t.Run("Submitting a pegged order with side sell and mid reference and non-positive offset fails", testPeggedOrderSubmissionWithSideSellAndMidReferenceAndNonPositiveOffsetFails)
 	t.Run("Submitting a pegged order with side sell and mid reference and positive offset succeeds", testPeggedOrderSubmissionWithSideSellAndMidReferenceAndPositiveOffsetSucceeds)
+	t.Run("Submitting a pegged order with mark or index reference and negative offset fails", testPeggedOrderSubmissionWithMarkOrIndexReferenceAndNegativeOffsetFails)
+	t.Run("Submitting a pegged order with mark or index reference on either side succeeds", testPeggedOrderSubmissionWithMarkOrIndexReferenceSucceeds)
 	t.Run("Submitting Post or Reduce only orders", testSubmittingPostOrReduceOnlyOrders)
 	t.Run("Submitting iceberg orders", testSubmittingIcebergOrders)
+	t.Run("Submitting orders with a maximum slippage", testSubmittingOrdersWithMaxSlippage)
+}
+
+func testSubmittingOrdersWithMaxSlippage(t *testing.T) {
+	testCases := []struct {
+		submission commandspb.OrderSubmission
+		errString  string
+	}{
+		{
+			submission: commandspb.OrderSubmission{
+				Type:        types.Order_TYPE_LIMIT,
+				TimeInForce: types.Order_TIME_IN_FORCE_IOC,
+				MaxSlippage: ptr.From(uint64(100)),
+			},
+			errString: "only valid for market orders",
+		},
+		// valid cases
+		{
+			submission: commandspb.OrderSubmission{
+				Type:        types.Order_TYPE_MARKET,
+				TimeInForce: types.Order_TIME_IN_FORCE_IOC,
+				MaxSlippage: ptr.From(uint64(100)),
+			},
+		},
+		{
+			submission: commandspb.OrderSubmission{
+				Type:        types.Order_TYPE_MARKET,
+				TimeInForce: types.Order_TIME_IN_FORCE_FOK,
+				MaxSlippage: ptr.From(uint64(0)),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		errs := checkOrderSubmission(&tc.submission).Get("order_submission.max_slippage")
+		if len(tc.errString) == 0 {
+			assert.Len(t, errs, 0)
+			continue
+		}
+		assert.Contains(t, errs, errors.New(tc.errString))
+	}
 }
 
 func testSubmittingIcebergOrders(t *testing.T) {
@@ -321,6 +366,14 @@ func testOrderSubmissionWithUndefinedTimeInForceFails(t *testing.T) {
 	assert.Contains(t, err.Get("order_submission.time_in_force"), commands.ErrIsNotValid)
 }
 
+func testOrderSubmissionWithUndefinedSelfTradePreventionFails(t *testing.T) {
+	err := checkOrderSubmission(&commandspb.OrderSubmission{
+		SelfTradePrevention: types.Order_SelfTradePrevention(-42),
+	})
+
+	assert.Contains(t, err.Get("order_submission.self_trade_prevention"), commands.ErrIsNotValid)
+}
+
 func testOrderSubmissionWithInvalidSizeFails(t *testing.T) {
 	// FIXME(big int) doesn't test negative numbers since it's an unsigned int
 	// 	but that will definitely be needed when moving to big int.
@@ -735,6 +788,43 @@ func testPeggedOrderSubmissionWithSideSellAndBestAskReferenceAndNonNegativeOffse
 	}
 }
 
+func testPeggedOrderSubmissionWithMarkOrIndexReferenceAndNegativeOffsetFails(t *testing.T) {
+	for _, side := range []types.Side{types.Side_SIDE_BUY, types.Side_SIDE_SELL} {
+		for _, reference := range []types.PeggedReference{types.PeggedReference_PEGGED_REFERENCE_MARK_PRICE, types.PeggedReference_PEGGED_REFERENCE_INDEX_PRICE} {
+			t.Run(side.String()+" "+reference.String(), func(t *testing.T) {
+				err := checkOrderSubmission(&commandspb.OrderSubmission{
+					Side: side,
+					PeggedOrder: &types.PeggedOrder{
+						Reference: reference,
+						Offset:    "-1",
+					},
+				})
+
+				assert.Contains(t, err.Get("order_submission.pegged_order.offset"), errors.New("must be positive or zero"))
+			})
+		}
+	}
+}
+
+func testPeggedOrderSubmissionWithMarkOrIndexReferenceSucceeds(t *testing.T) {
+	for _, side := range []types.Side{types.Side_SIDE_BUY, types.Side_SIDE_SELL} {
+		for _, reference := range []types.PeggedReference{types.PeggedReference_PEGGED_REFERENCE_MARK_PRICE, types.PeggedReference_PEGGED_REFERENCE_INDEX_PRICE} {
+			t.Run(side.String()+" "+reference.String(), func(t *testing.T) {
+				err := checkOrderSubmission(&commandspb.OrderSubmission{
+					Side: side,
+					PeggedOrder: &types.PeggedOrder{
+						Reference: reference,
+						Offset:    "0",
+					},
+				})
+
+				assert.Empty(t, err.Get("order_submission.pegged_order.reference"))
+				assert.Empty(t, err.Get("order_submission.pegged_order.offset"))
+			})
+		}
+	}
+}
+
 func testPeggedOrderSubmissionWithSideSellAndMidReferenceAndNonPositiveOffsetFails(t *testing.T) {
 	testCases := []struct {
 		msg   string
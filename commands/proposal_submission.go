@@ -340,6 +340,51 @@ func CheckNewFreeformChanges(change *protoTypes.ProposalTerms_NewFreeform) Error
 	if change.NewFreeform == nil {
 		return errs.FinalAddForProperty("proposal_submission.terms.change.new_freeform", ErrIsRequired)
 	}
+
+	return checkPollOptions(change.NewFreeform).AddPrefix("proposal_submission.terms.change.")
+}
+
+// MaxPollOptions is the maximum number of options of a poll.
+const MaxPollOptions = 32
+
+func checkPollOptions(freeform *protoTypes.NewFreeform) Errors {
+	errs := NewErrors()
+
+	if len(freeform.Options) == 0 {
+		if freeform.TallyMethod != protoTypes.PollTallyMethod_POLL_TALLY_METHOD_UNSPECIFIED {
+			errs.AddForProperty("new_freeform.tally_method", ErrMustBeEmpty)
+		}
+		return errs
+	}
+
+	if len(freeform.Options) < 2 {
+		errs.AddForProperty("new_freeform.options", ErrMustHaveAtLeastTwoOptions)
+	} else if len(freeform.Options) > MaxPollOptions {
+		errs.AddForProperty("new_freeform.options", ErrIsLimitedTo32Entries)
+	}
+
+	options := make(map[string]struct{}, len(freeform.Options))
+	for i, option := range freeform.Options {
+		property := fmt.Sprintf("new_freeform.options.%d", i)
+		if len(strings.TrimSpace(option)) == 0 {
+			errs.AddForProperty(property, ErrCannotBeBlank)
+			continue
+		}
+		if len(option) > 255 {
+			errs.AddForProperty(property, ErrIsLimitedTo255Characters)
+		}
+		if _, ok := options[option]; ok {
+			errs.AddForProperty(property, ErrIsDuplicated)
+		}
+		options[option] = struct{}{}
+	}
+
+	if freeform.TallyMethod == protoTypes.PollTallyMethod_POLL_TALLY_METHOD_UNSPECIFIED {
+		errs.AddForProperty("new_freeform.tally_method", ErrIsRequired)
+	} else if _, ok := protoTypes.PollTallyMethod_name[int32(freeform.TallyMethod)]; !ok {
+		errs.AddForProperty("new_freeform.tally_method", ErrIsNotValid)
+	}
+
 	return errs
 }
 
@@ -1041,12 +1086,18 @@ func checkNewSpotMarketConfiguration(changes *vegapb.NewSpotMarketConfiguration)
 		errs.AddForProperty("new_spot_market.changes.position_decimal_places", ErrMustBeWithinRange7)
 	}
 	errs.Merge(checkPriceMonitoring(changes.PriceMonitoringParameters, "new_spot_market.changes"))
+	if changes.PriceMonitoringParameters != nil && changes.PriceMonitoringParameters.IndexDivergence != nil {
+		// spot markets have no index price to diverge from
+		errs.AddForProperty("new_spot_market.changes.price_monitoring_parameters.index_divergence", ErrIsNotSupported)
+	}
 	errs.Merge(checkTargetStakeParams(changes.TargetStakeParameters, "new_spot_market.changes"))
 	errs.Merge(checkNewInstrument(changes.Instrument, "new_spot_market.changes.instrument", changes.TickSize))
 	errs.Merge(checkNewSpotRiskParameters(changes))
 	errs.Merge(checkSLAParams(changes.SlaParams, "new_spot_market.changes.sla_params"))
 	errs.Merge(checkTickSize(changes.TickSize, "new_spot_market.changes"))
 	errs.Merge(checkLiquidityFeeSettings(changes.LiquidityFeeSettings, "new_spot_market.changes.liquidity_fee_settings"))
+	errs.Merge(checkFrequentBatchAuction(changes.FrequentBatchAuction, "new_spot_market.changes"))
+	errs.Merge(checkMatchingAlgorithm(changes.MatchingAlgorithm, "new_spot_market.changes"))
 
 	return errs
 }
@@ -1131,6 +1182,9 @@ func checkNewMarketChangesConfiguration(changes *vegapb.NewMarketConfiguration)
 	errs.Merge(checkLiquidityFeeSettings(changes.LiquidityFeeSettings, "new_market.changes.liquidity_fee_settings"))
 	errs.Merge(checkCompositePriceConfiguration(changes.MarkPriceConfiguration, "new_market.changes.mark_price_configuration"))
 	errs.Merge(checkTickSize(changes.TickSize, "new_market.changes"))
+	errs.Merge(checkFrequentBatchAuction(changes.FrequentBatchAuction, "new_market.changes"))
+	errs.Merge(checkMatchingAlgorithm(changes.MatchingAlgorithm, "new_market.changes"))
+	errs.Merge(checkPositionLimits(changes.PositionLimits, "new_market.changes"))
 
 	return errs
 }
@@ -1198,6 +1252,9 @@ func checkUpdateMarket(updateMarket *vegapb.UpdateMarket) Errors {
 	errs.Merge(checkLiquidityFeeSettings(changes.LiquidityFeeSettings, "update_market.changes.liquidity_fee_settings"))
 	errs.Merge(checkCompositePriceConfiguration(changes.MarkPriceConfiguration, "update_market.changes.mark_price_configuration"))
 	errs.Merge(checkTickSize(changes.TickSize, "update_market.changes"))
+	errs.Merge(checkFrequentBatchAuction(changes.FrequentBatchAuction, "update_market.changes"))
+	errs.Merge(checkMatchingAlgorithm(changes.MatchingAlgorithm, "update_market.changes"))
+	errs.Merge(checkPositionLimits(changes.PositionLimits, "update_market.changes"))
 	return errs
 }
 
@@ -1226,18 +1283,38 @@ func checkUpdateSpotMarket(updateSpotMarket *vegapb.UpdateSpotMarket) Errors {
 	changes := updateSpotMarket.Changes
 	errs.Merge(checkUpdateSpotInstrument(changes.Instrument).AddPrefix("proposal_submission.terms.change."))
 	errs.Merge(checkPriceMonitoring(changes.PriceMonitoringParameters, "update_spot_market.changes"))
+	if changes.PriceMonitoringParameters != nil && changes.PriceMonitoringParameters.IndexDivergence != nil {
+		// spot markets have no index price to diverge from
+		errs.AddForProperty("update_spot_market.changes.price_monitoring_parameters.index_divergence", ErrIsNotSupported)
+	}
 	errs.Merge(checkTargetStakeParams(changes.TargetStakeParameters, "update_spot_market.changes"))
 	errs.Merge(checkUpdateSpotRiskParameters(changes))
 	errs.Merge(checkSLAParams(changes.SlaParams, "update_spot_market.changes.sla_params"))
 	errs.Merge(checkTickSize(changes.TickSize, "update_spot_market.changes"))
 	errs.Merge(checkLiquidityFeeSettings(changes.LiquidityFeeSettings, "update_spot_market.changes.liquidity_fee_settings"))
+	errs.Merge(checkFrequentBatchAuction(changes.FrequentBatchAuction, "update_spot_market.changes"))
+	errs.Merge(checkMatchingAlgorithm(changes.MatchingAlgorithm, "update_spot_market.changes"))
 	return errs
 }
 
 func checkPriceMonitoring(parameters *protoTypes.PriceMonitoringParameters, parentProperty string) Errors {
 	errs := NewErrors()
 
-	if parameters == nil || len(parameters.Triggers) == 0 {
+	if parameters == nil {
+		return errs
+	}
+
+	if parameters.IndexDivergence != nil {
+		maxDivergence, err := num.DecimalFromString(parameters.IndexDivergence.MaxDivergence)
+		if err != nil || !maxDivergence.IsPositive() {
+			errs.AddForProperty(fmt.Sprintf("%s.price_monitoring_parameters.index_divergence.max_divergence", parentProperty), ErrMustBePositive)
+		}
+		if parameters.IndexDivergence.AuctionExtension <= 0 {
+			errs.AddForProperty(fmt.Sprintf("%s.price_monitoring_parameters.index_divergence.auction_extension", parentProperty), ErrMustBePositive)
+		}
+	}
+
+	if len(parameters.Triggers) == 0 {
 		return errs
 	}
 
@@ -1270,6 +1347,38 @@ func checkPriceMonitoring(parameters *protoTypes.PriceMonitoringParameters, pare
 	return errs
 }
 
+func checkFrequentBatchAuction(params *protoTypes.FrequentBatchAuctionParameters, parent string) Errors {
+	errs := NewErrors()
+	if params == nil {
+		return errs
+	}
+	if params.BatchDuration <= 0 {
+		errs.AddForProperty(fmt.Sprintf("%s.frequent_batch_auction.batch_duration", parent), ErrMustBePositive)
+	}
+	return errs
+}
+
+func checkPositionLimits(limits *protoTypes.PositionLimits, parent string) Errors {
+	errs := NewErrors()
+	if limits == nil || len(limits.MaxPositionNotional) == 0 {
+		return errs
+	}
+	if notional, _ := big.NewInt(0).SetString(limits.MaxPositionNotional, 10); notional == nil {
+		errs.AddForProperty(fmt.Sprintf("%s.position_limits.max_position_notional", parent), ErrIsNotValidNumber)
+	} else if notional.Cmp(big.NewInt(0)) < 0 {
+		errs.AddForProperty(fmt.Sprintf("%s.position_limits.max_position_notional", parent), ErrMustBePositiveOrZero)
+	}
+	return errs
+}
+
+func checkMatchingAlgorithm(algorithm protoTypes.MatchingAlgorithm, parent string) Errors {
+	errs := NewErrors()
+	if _, ok := protoTypes.MatchingAlgorithm_name[int32(algorithm)]; !ok {
+		errs.AddForProperty(fmt.Sprintf("%s.matching_algorithm", parent), ErrIsNotValid)
+	}
+	return errs
+}
+
 func checkLiquidationStrategy(params *protoTypes.LiquidationStrategy, parent string) Errors {
 	errs := NewErrors()
 	if params == nil {
@@ -1294,6 +1403,18 @@ func checkLiquidationStrategy(params *protoTypes.LiquidationStrategy, parent str
 	if err != nil || slippage.IsNegative() || slippage.IsZero() {
 		errs.AddForProperty(fmt.Sprintf("%s.liquidation_strategy.disposal_slippage_range", parent), ErrMustBePositive)
 	}
+	if len(params.PartialCloseOutStep) > 0 {
+		step, err := num.DecimalFromString(params.PartialCloseOutStep)
+		if err != nil || step.IsNegative() || step.IsZero() || step.GreaterThan(num.DecimalOne()) {
+			errs.AddForProperty(fmt.Sprintf("%s.liquidation_strategy.partial_close_out_step", parent), ErrMustBeBetween01)
+		}
+	}
+	if len(params.PartialCloseOutBuffer) > 0 {
+		buffer, err := num.DecimalFromString(params.PartialCloseOutBuffer)
+		if err != nil || buffer.IsNegative() {
+			errs.AddForProperty(fmt.Sprintf("%s.liquidation_strategy.partial_close_out_buffer", parent), ErrMustBePositiveOrZero)
+		}
+	}
 	return errs
 }
 
@@ -1355,6 +1476,8 @@ func checkNewInstrument(instrument *protoTypes.InstrumentConfiguration, parent,
 		errs.Merge(checkNewFuture(product.Future, tickSize))
 	case *protoTypes.InstrumentConfiguration_Perpetual:
 		errs.Merge(checkNewPerps(product.Perpetual, fmt.Sprintf("%s.product", parent)))
+	case *protoTypes.InstrumentConfiguration_Option:
+		errs.Merge(checkNewOption(product.Option))
 	case *protoTypes.InstrumentConfiguration_Spot:
 		errs.Merge(checkNewSpot(product.Spot))
 	default:
@@ -1443,6 +1566,10 @@ func checkNewFuture(future *protoTypes.FutureProduct, tickSize string) Errors {
 		}
 	}
 
+	if len(future.PredictionOutcomes) > 0 {
+		errs.Merge(checkPredictionOutcomes(future))
+	}
+
 	errs.Merge(checkDataSourceSpec(future.DataSourceSpecForSettlementData, "data_source_spec_for_settlement_data", "new_market.changes.instrument.product.future", true))
 	errs.Merge(checkDataSourceSpec(future.DataSourceSpecForTradingTermination, "data_source_spec_for_trading_termination", "new_market.changes.instrument.product.future", false))
 	errs.Merge(checkNewOracleBinding(future))
@@ -1450,6 +1577,75 @@ func checkNewFuture(future *protoTypes.FutureProduct, tickSize string) Errors {
 	return errs
 }
 
+func checkPredictionOutcomes(future *protoTypes.FutureProduct) Errors {
+	errs := NewErrors()
+
+	if len(future.PredictionOutcomes) < 2 {
+		errs.AddForProperty("new_market.changes.instrument.product.future.prediction_outcomes", ErrMustHaveAtLeastTwoOutcomes)
+	}
+	outcomes := make(map[string]struct{}, len(future.PredictionOutcomes))
+	for i, outcome := range future.PredictionOutcomes {
+		if len(outcome) == 0 {
+			errs.AddForProperty(fmt.Sprintf("new_market.changes.instrument.product.future.prediction_outcomes.%d", i), ErrIsRequired)
+		} else if _, ok := outcomes[outcome]; ok {
+			errs.AddForProperty(fmt.Sprintf("new_market.changes.instrument.product.future.prediction_outcomes.%d", i), ErrIsDuplicated)
+		}
+		outcomes[outcome] = struct{}{}
+	}
+
+	if future.Cap == nil {
+		errs.AddForProperty("new_market.changes.instrument.product.future.cap", ErrMustBeSetForPredictionMarkets)
+		return errs
+	}
+	if !future.Cap.GetBinarySettlement() {
+		errs.AddForProperty("new_market.changes.instrument.product.future.cap.binary_settlement", ErrMustBeSetForPredictionMarkets)
+	}
+	if !future.Cap.GetFullyCollateralised() {
+		errs.AddForProperty("new_market.changes.instrument.product.future.cap.fully_collateralised", ErrMustBeSetForPredictionMarkets)
+	}
+
+	return errs
+}
+
+func checkNewOption(option *protoTypes.OptionProduct) Errors {
+	errs := NewErrors()
+
+	if option == nil {
+		return errs.FinalAddForProperty("new_market.changes.instrument.product.option", ErrIsRequired)
+	}
+
+	if len(option.SettlementAsset) == 0 {
+		errs.AddForProperty("new_market.changes.instrument.product.option.settlement_asset", ErrIsRequired)
+	}
+	if len(option.QuoteName) == 0 {
+		errs.AddForProperty("new_market.changes.instrument.product.option.quote_name", ErrIsRequired)
+	}
+
+	if option.OptionType == protoTypes.OptionType_OPTION_TYPE_UNSPECIFIED {
+		errs.AddForProperty("new_market.changes.instrument.product.option.option_type", ErrIsRequired)
+	} else if _, ok := protoTypes.OptionType_name[int32(option.OptionType)]; !ok {
+		errs.AddForProperty("new_market.changes.instrument.product.option.option_type", ErrIsNotValid)
+	}
+
+	if len(option.StrikePrice) == 0 {
+		errs.AddForProperty("new_market.changes.instrument.product.option.strike_price", ErrIsRequired)
+	} else if strike, err := num.DecimalFromString(option.StrikePrice); err != nil {
+		errs.AddForProperty("new_market.changes.instrument.product.option.strike_price", ErrIsNotValidNumber)
+	} else if !strike.IsPositive() {
+		errs.AddForProperty("new_market.changes.instrument.product.option.strike_price", ErrMustBePositive)
+	}
+
+	if option.ExpiryTimestamp <= 0 {
+		errs.AddForProperty("new_market.changes.instrument.product.option.expiry_timestamp", ErrMustBePositive)
+	}
+
+	errs.Merge(checkDataSourceSpec(option.DataSourceSpecForSettlementData, "data_source_spec_for_settlement_data", "new_market.changes.instrument.product.option", true))
+	errs.Merge(checkDataSourceSpec(option.DataSourceSpecForTradingTermination, "data_source_spec_for_trading_termination", "new_market.changes.instrument.product.option", false))
+	errs.Merge(checkNewOptionOracleBinding(option))
+
+	return errs
+}
+
 func checkNewPerps(perps *protoTypes.PerpetualProduct, parentProperty string) Errors {
 	errs := NewErrors()
 
@@ -1559,6 +1755,10 @@ func checkNewPerps(perps *protoTypes.PerpetualProduct, parentProperty string) Er
 		errs.Merge(checkCompositePriceConfiguration(perps.InternalCompositePriceConfiguration, fmt.Sprintf("%s.perps.internal_composite_price_configuration", parentProperty)))
 	}
 
+	if perps.IndexBasketConfiguration != nil {
+		errs.Merge(checkIndexBasketConfiguration(perps.IndexBasketConfiguration, fmt.Sprintf("%s.perps.index_basket_configuration", parentProperty)))
+	}
+
 	return errs
 }
 
@@ -1706,6 +1906,10 @@ func checkUpdatePerps(perps *protoTypes.UpdatePerpetualProduct, parentProperty s
 		errs.Merge(checkCompositePriceConfiguration(perps.InternalCompositePriceConfiguration, fmt.Sprintf("%s.perps.internal_composite_price_configuration", parentProperty)))
 	}
 
+	if perps.IndexBasketConfiguration != nil {
+		errs.Merge(checkIndexBasketConfiguration(perps.IndexBasketConfiguration, fmt.Sprintf("%s.perps.index_basket_configuration", parentProperty)))
+	}
+
 	return errs
 }
 
@@ -1996,6 +2200,31 @@ func checkNewOracleBinding(future *protoTypes.FutureProduct) Errors {
 	return errs
 }
 
+func checkNewOptionOracleBinding(option *protoTypes.OptionProduct) Errors {
+	errs := NewErrors()
+	if option.DataSourceSpecBinding != nil {
+		if len(option.DataSourceSpecBinding.SettlementDataProperty) == 0 {
+			errs.AddForProperty("new_market.changes.instrument.product.option.data_source_spec_binding.settlement_data_property", ErrIsRequired)
+		} else {
+			if !isBindingMatchingSpec(option.DataSourceSpecForSettlementData, option.DataSourceSpecBinding.SettlementDataProperty) {
+				errs.AddForProperty("new_market.changes.instrument.product.option.data_source_spec_binding.settlement_data_property", ErrIsMismatching)
+			}
+		}
+
+		if len(option.DataSourceSpecBinding.TradingTerminationProperty) == 0 {
+			errs.AddForProperty("new_market.changes.instrument.product.option.data_source_spec_binding.trading_termination_property", ErrIsRequired)
+		} else {
+			if option.DataSourceSpecForTradingTermination == nil || option.DataSourceSpecForTradingTermination.GetExternal() != nil && !isBindingMatchingSpec(option.DataSourceSpecForTradingTermination, option.DataSourceSpecBinding.TradingTerminationProperty) {
+				errs.AddForProperty("new_market.changes.instrument.product.option.data_source_spec_binding.trading_termination_property", ErrIsMismatching)
+			}
+		}
+	} else {
+		errs.AddForProperty("new_market.changes.instrument.product.option.data_source_spec_binding", ErrIsRequired)
+	}
+
+	return errs
+}
+
 func checkNewPerpsOracleBinding(perps *protoTypes.PerpetualProduct) Errors {
 	errs := NewErrors()
 
@@ -2068,6 +2297,8 @@ func checkNewRiskParameters(config *protoTypes.NewMarketConfiguration) Errors {
 		errs.Merge(checkNewSimpleParameters(parameters))
 	case *protoTypes.NewMarketConfiguration_LogNormal:
 		errs.Merge(checkNewLogNormalRiskParameters(parameters))
+	case *protoTypes.NewMarketConfiguration_HistoricalSimulation:
+		errs.Merge(checkHistoricalSimulationRiskParameters(parameters.HistoricalSimulation, "new_market.changes.risk_parameters.historical_simulation"))
 	default:
 		errs.AddForProperty("new_market.changes.risk_parameters", ErrIsNotValid)
 	}
@@ -2259,6 +2490,41 @@ func checkCompositePriceConfiguration(config *protoTypes.CompositePriceConfigura
 	return errs
 }
 
+func checkIndexBasketConfiguration(config *protoTypes.IndexBasketConfiguration, parent string) Errors {
+	errs := NewErrors()
+	if len(config.Constituents) == 0 {
+		errs.AddForProperty(fmt.Sprintf("%s.constituents", parent), ErrIsRequired)
+	}
+	if len(config.Constituents) > 10 {
+		errs.AddForProperty(fmt.Sprintf("%s.constituents", parent), fmt.Errorf("too many constituents - must be less than or equal to 10"))
+	}
+	if config.RebalanceInterval < 0 {
+		errs.AddForProperty(fmt.Sprintf("%s.rebalance_interval", parent), ErrMustBePositiveOrZero)
+	}
+
+	for i, c := range config.Constituents {
+		property := fmt.Sprintf("%s.constituents.%d", parent, i)
+		if len(c.Weight) == 0 {
+			errs.AddForProperty(fmt.Sprintf("%s.weight", property), ErrIsRequired)
+		} else if d, err := num.DecimalFromString(c.Weight); err != nil {
+			errs.AddForProperty(fmt.Sprintf("%s.weight", property), ErrIsNotValidNumber)
+		} else if !d.IsPositive() {
+			errs.AddForProperty(fmt.Sprintf("%s.weight", property), ErrMustBePositive)
+		}
+		if len(c.StalenessTolerance) > 0 {
+			if d, err := time.ParseDuration(c.StalenessTolerance); err != nil || d < 0 {
+				errs.AddForProperty(fmt.Sprintf("%s.staleness_tolerance", property), fmt.Errorf("must be a valid duration"))
+			}
+		}
+		errs.Merge(checkDataSourceSpec(c.DataSourceSpec, "data_source_spec", property, true))
+		if c.DataSourceSpec != nil {
+			errs.Merge(checkCompositePriceBinding(c.DataSourceSpecBinding, c.DataSourceSpec, fmt.Sprintf("%s.data_source_spec_binding", property)))
+		}
+	}
+
+	return errs
+}
+
 func checkNewSpotRiskParameters(config *protoTypes.NewSpotMarketConfiguration) Errors {
 	errs := NewErrors()
 
@@ -2290,6 +2556,8 @@ func checkUpdateRiskParameters(config *protoTypes.UpdateMarketConfiguration) Err
 		errs.Merge(checkUpdateSimpleParameters(parameters))
 	case *protoTypes.UpdateMarketConfiguration_LogNormal:
 		errs.Merge(checkUpdateLogNormalRiskParameters(parameters))
+	case *protoTypes.UpdateMarketConfiguration_HistoricalSimulation:
+		errs.Merge(checkHistoricalSimulationRiskParameters(parameters.HistoricalSimulation, "update_market.changes.risk_parameters.historical_simulation"))
 	default:
 		errs.AddForProperty("update_market.changes.risk_parameters", ErrIsNotValid)
 	}
@@ -2550,6 +2818,42 @@ func checkUpdateLogNormalRiskParameters(params *protoTypes.UpdateMarketConfigura
 	return errs
 }
 
+func checkHistoricalSimulationRiskParameters(params *protoTypes.HistoricalSimulationRiskModel, property string) Errors {
+	errs := NewErrors()
+
+	if params == nil {
+		return errs.FinalAddForProperty(property, ErrIsRequired)
+	}
+
+	if math.IsNaN(params.RiskAversionParameter) || params.RiskAversionParameter < 1e-8 || params.RiskAversionParameter > 0.1 {
+		errs.AddForProperty(property+".risk_aversion_parameter", errors.New("must be between [1e-8, 0.1]"))
+	}
+
+	if math.IsNaN(params.Tau) || params.Tau < 1e-8 || params.Tau > 1 {
+		errs.AddForProperty(property+".tau", errors.New("must be between [1e-8, 1]"))
+	}
+
+	if params.WindowSize < 2 || params.WindowSize > 100000 {
+		errs.AddForProperty(property+".window_size", errors.New("must be between [2, 100000]"))
+	}
+
+	if params.MinObservations < 2 {
+		errs.AddForProperty(property+".min_observations", errors.New("must be at least 2"))
+	} else if params.MinObservations > params.WindowSize {
+		errs.AddForProperty(property+".min_observations", errors.New("must not be greater than the window size"))
+	}
+
+	if math.IsNaN(params.DecayFactor) || params.DecayFactor < 0 || params.DecayFactor >= 1 {
+		errs.AddForProperty(property+".decay_factor", errors.New("must be between [0, 1)"))
+	}
+
+	if math.IsNaN(params.FallbackSigma) || params.FallbackSigma < 1e-3 || params.FallbackSigma > 50 {
+		errs.AddForProperty(property+".fallback_sigma", errors.New("must be between [1e-3, 50]"))
+	}
+
+	return errs
+}
+
 func checkNewSpotLogNormalRiskParameters(params *protoTypes.NewSpotMarketConfiguration_LogNormal) Errors {
 	errs := NewErrors()
 
@@ -16,6 +16,7 @@
 package commands_test
 
 import (
+	"fmt"
 	"testing"
 
 	"code.vegaprotocol.io/vega/commands"
@@ -28,6 +29,12 @@ import (
 func TestCheckProposalSubmissionForNewFreeform(t *testing.T) {
 	t.Run("Submitting a new freeform change without new freeform fails", testNewFreeformChangeSubmissionWithoutNewFreeformFails)
 	t.Run("Submitting a new freeform proposal without rational URL and hash fails", testNewFreeformProposalSubmissionWithoutRationalURLandHashFails)
+	t.Run("Submitting a poll succeeds", testNewFreeformPollSubmissionSucceeds)
+	t.Run("Submitting a poll with a single option fails", testNewFreeformPollWithSingleOptionFails)
+	t.Run("Submitting a poll with too many options fails", testNewFreeformPollWithTooManyOptionsFails)
+	t.Run("Submitting a poll with blank or duplicated options fails", testNewFreeformPollWithInvalidOptionsFails)
+	t.Run("Submitting a poll without tally method fails", testNewFreeformPollWithoutTallyMethodFails)
+	t.Run("Submitting a freeform proposal with tally method but no options fails", testNewFreeformWithTallyMethodWithoutOptionsFails)
 }
 
 func testNewFreeformChangeSubmissionWithoutNewFreeformFails(t *testing.T) {
@@ -51,3 +58,98 @@ func testNewFreeformProposalSubmissionWithoutRationalURLandHashFails(t *testing.
 	assert.Contains(t, err.Get("proposal_submission.rationale.description"), commands.ErrIsRequired)
 	assert.Contains(t, err.Get("proposal_submission.rationale.title"), commands.ErrIsRequired)
 }
+
+func testNewFreeformPollSubmissionSucceeds(t *testing.T) {
+	err := checkProposalSubmission(&commandspb.ProposalSubmission{
+		Terms: &types.ProposalTerms{
+			Change: &types.ProposalTerms_NewFreeform{
+				NewFreeform: &types.NewFreeform{
+					Options:     []string{"a", "b", "c"},
+					TallyMethod: types.PollTallyMethod_POLL_TALLY_METHOD_RANKED_CHOICE,
+				},
+			},
+		},
+	})
+
+	assert.Empty(t, err.Get("proposal_submission.terms.change.new_freeform.options"))
+	assert.Empty(t, err.Get("proposal_submission.terms.change.new_freeform.tally_method"))
+}
+
+func testNewFreeformPollWithSingleOptionFails(t *testing.T) {
+	err := checkProposalSubmission(&commandspb.ProposalSubmission{
+		Terms: &types.ProposalTerms{
+			Change: &types.ProposalTerms_NewFreeform{
+				NewFreeform: &types.NewFreeform{
+					Options:     []string{"a"},
+					TallyMethod: types.PollTallyMethod_POLL_TALLY_METHOD_PLURALITY,
+				},
+			},
+		},
+	})
+
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_freeform.options"), commands.ErrMustHaveAtLeastTwoOptions)
+}
+
+func testNewFreeformPollWithTooManyOptionsFails(t *testing.T) {
+	options := make([]string, 0, commands.MaxPollOptions+1)
+	for i := 0; i <= commands.MaxPollOptions; i++ {
+		options = append(options, fmt.Sprintf("option %d", i))
+	}
+
+	err := checkProposalSubmission(&commandspb.ProposalSubmission{
+		Terms: &types.ProposalTerms{
+			Change: &types.ProposalTerms_NewFreeform{
+				NewFreeform: &types.NewFreeform{
+					Options:     options,
+					TallyMethod: types.PollTallyMethod_POLL_TALLY_METHOD_APPROVAL,
+				},
+			},
+		},
+	})
+
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_freeform.options"), commands.ErrIsLimitedTo32Entries)
+}
+
+func testNewFreeformPollWithInvalidOptionsFails(t *testing.T) {
+	err := checkProposalSubmission(&commandspb.ProposalSubmission{
+		Terms: &types.ProposalTerms{
+			Change: &types.ProposalTerms_NewFreeform{
+				NewFreeform: &types.NewFreeform{
+					Options:     []string{"a", " ", "a"},
+					TallyMethod: types.PollTallyMethod_POLL_TALLY_METHOD_PLURALITY,
+				},
+			},
+		},
+	})
+
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_freeform.options.1"), commands.ErrCannotBeBlank)
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_freeform.options.2"), commands.ErrIsDuplicated)
+}
+
+func testNewFreeformPollWithoutTallyMethodFails(t *testing.T) {
+	err := checkProposalSubmission(&commandspb.ProposalSubmission{
+		Terms: &types.ProposalTerms{
+			Change: &types.ProposalTerms_NewFreeform{
+				NewFreeform: &types.NewFreeform{
+					Options: []string{"a", "b"},
+				},
+			},
+		},
+	})
+
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_freeform.tally_method"), commands.ErrIsRequired)
+}
+
+func testNewFreeformWithTallyMethodWithoutOptionsFails(t *testing.T) {
+	err := checkProposalSubmission(&commandspb.ProposalSubmission{
+		Terms: &types.ProposalTerms{
+			Change: &types.ProposalTerms_NewFreeform{
+				NewFreeform: &types.NewFreeform{
+					TallyMethod: types.PollTallyMethod_POLL_TALLY_METHOD_PLURALITY,
+				},
+			},
+		},
+	})
+
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_freeform.tally_method"), commands.ErrMustBeEmpty)
+}
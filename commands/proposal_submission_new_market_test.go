@@ -53,6 +53,8 @@ func TestCheckProposalSubmissionForNewMarket(t *testing.T) {
 	t.Run("Submitting a market change with too large slippage factor fails", testNewMarketChangeSubmissionWithSlippageFactorTooLargeFails)
 	t.Run("Submitting a new capped market with max price succeeds", testNewCappedMarketWithMaxPriceSucceeds)
 	t.Run("Submitting a new capped market without max price fails", testNewCappedMarketWithoutMaxPriceFails)
+	t.Run("Submitting a new prediction market with valid outcomes succeeds", testNewPredictionMarketWithValidOutcomesSucceeds)
+	t.Run("Submitting a new prediction market with invalid outcomes fails", testNewPredictionMarketWithInvalidOutcomesFails)
 
 	t.Run("Submitting a new market without price monitoring succeeds", testNewMarketChangeSubmissionWithoutPriceMonitoringSucceeds)
 	t.Run("Submitting a new market with price monitoring succeeds", testNewMarketChangeSubmissionWithPriceMonitoringSucceeds)
@@ -64,6 +66,14 @@ func TestCheckProposalSubmissionForNewMarket(t *testing.T) {
 	t.Run("Submitting a price monitoring change with right trigger probability succeeds", testPriceMonitoringChangeSubmissionWithRightTriggerProbabilitySucceeds)
 	t.Run("Submitting a price monitoring change without trigger auction extension fails", testPriceMonitoringChangeSubmissionWithoutTriggerAuctionExtensionFails)
 	t.Run("Submitting a price monitoring change with trigger auction extension succeeds", testPriceMonitoringChangeSubmissionWithTriggerAuctionExtensionSucceeds)
+	t.Run("Submitting a price monitoring change with invalid index divergence fails", testPriceMonitoringChangeSubmissionWithInvalidIndexDivergenceFails)
+	t.Run("Submitting a price monitoring change with valid index divergence succeeds", testPriceMonitoringChangeSubmissionWithValidIndexDivergenceSucceeds)
+	t.Run("Submitting a new market with a non-positive batch duration fails", testNewMarketChangeSubmissionWithNonPositiveBatchDurationFails)
+	t.Run("Submitting a new market with a positive batch duration succeeds", testNewMarketChangeSubmissionWithPositiveBatchDurationSucceeds)
+	t.Run("Submitting a new market with an invalid max position notional fails", testNewMarketChangeSubmissionWithInvalidMaxPositionNotionalFails)
+	t.Run("Submitting a new market with valid position limits succeeds", testNewMarketChangeSubmissionWithValidPositionLimitsSucceeds)
+	t.Run("Submitting a new market with an undefined matching algorithm fails", testNewMarketChangeSubmissionWithUndefinedMatchingAlgorithmFails)
+	t.Run("Submitting a new market with a pro-rata matching algorithm succeeds", testNewMarketChangeSubmissionWithProRataMatchingAlgorithmSucceeds)
 	t.Run("Submitting a new market without liquidity monitoring fails", testNewMarketChangeSubmissionWithoutLiquidityMonitoringFails)
 	t.Run("Submitting a new market with liquidity monitoring succeeds", testNewMarketChangeSubmissionWithLiquidityMonitoringSucceeds)
 	t.Run("Submitting a liquidity monitoring change without target stake parameters fails", testLiquidityMonitoringChangeSubmissionWithoutTargetStakeParametersFails)
@@ -179,6 +189,7 @@ func TestCheckProposalSubmissionForNewMarket(t *testing.T) {
 	t.Run("Submitting a perps market change with match between binding property name and filter succeeds", testNewPerpsMarketChangeSubmissionWithNoMismatchBetweenFilterAndBindingSucceeds)
 	t.Run("Submitting a perps market change with settlement data and trading termination properties succeeds", testNewPerpsMarketChangeSubmissionWithSettlementDataPropertySucceeds)
 	t.Run("Submitting a perps market change with intenal composite price config", testNewPerpsMarketChangeSubmissionWithInternalCompositePriceConfig)
+	t.Run("Submitting a perps market change with index basket config", testNewPerpsMarketChangeSubmissionWithIndexBasketConfig)
 	t.Run("Submitting a new market with invalid SLA price range fails", testNewMarketChangeSubmissionWithInvalidLpRangeFails)
 	t.Run("Submitting a new market with valid SLA price range succeeds", testNewMarketChangeSubmissionWithValidLpRangeSucceeds)
 	t.Run("Submitting a new market with invalid min time fraction fails", testNewMarketChangeSubmissionWithInvalidMinTimeFractionFails)
@@ -878,6 +889,192 @@ func testPriceMonitoringChangeSubmissionWithTriggerAuctionExtensionSucceeds(t *t
 	assert.NotContains(t, err.Get("proposal_submission.terms.change.new_market.changes.price_monitoring_parameters.triggers.1.auction_extension"), commands.ErrMustBePositive)
 }
 
+func testPriceMonitoringChangeSubmissionWithInvalidIndexDivergenceFails(t *testing.T) {
+	testCases := []struct {
+		msg              string
+		maxDivergence    string
+		auctionExtension int64
+	}{
+		{
+			msg:              "with empty max divergence",
+			maxDivergence:    "",
+			auctionExtension: 0,
+		}, {
+			msg:              "with zero max divergence",
+			maxDivergence:    "0",
+			auctionExtension: -1,
+		}, {
+			msg:              "with negative max divergence",
+			maxDivergence:    "-0.1",
+			auctionExtension: 0,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			err := checkProposalSubmission(&commandspb.ProposalSubmission{
+				Terms: &vegapb.ProposalTerms{
+					Change: &vegapb.ProposalTerms_NewMarket{
+						NewMarket: &vegapb.NewMarket{
+							Changes: &vegapb.NewMarketConfiguration{
+								PriceMonitoringParameters: &vegapb.PriceMonitoringParameters{
+									IndexDivergence: &vegapb.PriceMonitoringIndexDivergence{
+										MaxDivergence:    tc.maxDivergence,
+										AuctionExtension: tc.auctionExtension,
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+
+			assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.price_monitoring_parameters.index_divergence.max_divergence"), commands.ErrMustBePositive)
+			assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.price_monitoring_parameters.index_divergence.auction_extension"), commands.ErrMustBePositive)
+		})
+	}
+}
+
+func testPriceMonitoringChangeSubmissionWithValidIndexDivergenceSucceeds(t *testing.T) {
+	err := checkProposalSubmission(&commandspb.ProposalSubmission{
+		Terms: &vegapb.ProposalTerms{
+			Change: &vegapb.ProposalTerms_NewMarket{
+				NewMarket: &vegapb.NewMarket{
+					Changes: &vegapb.NewMarketConfiguration{
+						PriceMonitoringParameters: &vegapb.PriceMonitoringParameters{
+							IndexDivergence: &vegapb.PriceMonitoringIndexDivergence{
+								MaxDivergence:    "0.05",
+								AuctionExtension: test.RandomPositiveI64(),
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	assert.Empty(t, err.Get("proposal_submission.terms.change.new_market.changes.price_monitoring_parameters.index_divergence.max_divergence"))
+	assert.Empty(t, err.Get("proposal_submission.terms.change.new_market.changes.price_monitoring_parameters.index_divergence.auction_extension"))
+}
+
+func testNewMarketChangeSubmissionWithNonPositiveBatchDurationFails(t *testing.T) {
+	for _, duration := range []int64{0, -1} {
+		err := checkProposalSubmission(&commandspb.ProposalSubmission{
+			Terms: &vegapb.ProposalTerms{
+				Change: &vegapb.ProposalTerms_NewMarket{
+					NewMarket: &vegapb.NewMarket{
+						Changes: &vegapb.NewMarketConfiguration{
+							FrequentBatchAuction: &vegapb.FrequentBatchAuctionParameters{
+								BatchDuration: duration,
+							},
+						},
+					},
+				},
+			},
+		})
+
+		assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.frequent_batch_auction.batch_duration"), commands.ErrMustBePositive)
+	}
+}
+
+func testNewMarketChangeSubmissionWithPositiveBatchDurationSucceeds(t *testing.T) {
+	err := checkProposalSubmission(&commandspb.ProposalSubmission{
+		Terms: &vegapb.ProposalTerms{
+			Change: &vegapb.ProposalTerms_NewMarket{
+				NewMarket: &vegapb.NewMarket{
+					Changes: &vegapb.NewMarketConfiguration{
+						FrequentBatchAuction: &vegapb.FrequentBatchAuctionParameters{
+							BatchDuration: test.RandomPositiveI64(),
+						},
+					},
+				},
+			},
+		},
+	})
+
+	assert.Empty(t, err.Get("proposal_submission.terms.change.new_market.changes.frequent_batch_auction.batch_duration"))
+}
+
+func testNewMarketChangeSubmissionWithInvalidMaxPositionNotionalFails(t *testing.T) {
+	for notional, expected := range map[string]error{
+		"-1":           commands.ErrMustBePositiveOrZero,
+		"1.5":          commands.ErrIsNotValidNumber,
+		"not a number": commands.ErrIsNotValidNumber,
+	} {
+		err := checkProposalSubmission(&commandspb.ProposalSubmission{
+			Terms: &vegapb.ProposalTerms{
+				Change: &vegapb.ProposalTerms_NewMarket{
+					NewMarket: &vegapb.NewMarket{
+						Changes: &vegapb.NewMarketConfiguration{
+							PositionLimits: &vegapb.PositionLimits{
+								MaxPositionNotional: notional,
+							},
+						},
+					},
+				},
+			},
+		})
+
+		assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.position_limits.max_position_notional"), expected)
+	}
+}
+
+func testNewMarketChangeSubmissionWithValidPositionLimitsSucceeds(t *testing.T) {
+	err := checkProposalSubmission(&commandspb.ProposalSubmission{
+		Terms: &vegapb.ProposalTerms{
+			Change: &vegapb.ProposalTerms_NewMarket{
+				NewMarket: &vegapb.NewMarket{
+					Changes: &vegapb.NewMarketConfiguration{
+						PositionLimits: &vegapb.PositionLimits{
+							MaxPositionSize:     100,
+							MaxPositionNotional: "1000000",
+							MaxOpenInterest:     1000,
+						},
+					},
+				},
+			},
+		},
+	})
+
+	assert.Empty(t, err.Get("proposal_submission.terms.change.new_market.changes.position_limits.max_position_notional"))
+}
+
+func testNewMarketChangeSubmissionWithUndefinedMatchingAlgorithmFails(t *testing.T) {
+	err := checkProposalSubmission(&commandspb.ProposalSubmission{
+		Terms: &vegapb.ProposalTerms{
+			Change: &vegapb.ProposalTerms_NewMarket{
+				NewMarket: &vegapb.NewMarket{
+					Changes: &vegapb.NewMarketConfiguration{
+						MatchingAlgorithm: vegapb.MatchingAlgorithm(-42),
+					},
+				},
+			},
+		},
+	})
+
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.matching_algorithm"), commands.ErrIsNotValid)
+}
+
+func testNewMarketChangeSubmissionWithProRataMatchingAlgorithmSucceeds(t *testing.T) {
+	for _, algorithm := range []vegapb.MatchingAlgorithm{
+		vegapb.MatchingAlgorithm_MATCHING_ALGORITHM_PRO_RATA,
+		vegapb.MatchingAlgorithm_MATCHING_ALGORITHM_PRO_RATA_TOP_OF_BOOK,
+	} {
+		err := checkProposalSubmission(&commandspb.ProposalSubmission{
+			Terms: &vegapb.ProposalTerms{
+				Change: &vegapb.ProposalTerms_NewMarket{
+					NewMarket: &vegapb.NewMarket{
+						Changes: &vegapb.NewMarketConfiguration{
+							MatchingAlgorithm: algorithm,
+						},
+					},
+				},
+			},
+		})
+
+		assert.Empty(t, err.Get("proposal_submission.terms.change.new_market.changes.matching_algorithm"))
+	}
+}
+
 func testNewCappedMarketWithMaxPriceSucceeds(t *testing.T) {
 	err := checkProposalSubmission(&commandspb.ProposalSubmission{
 		Terms: &vegapb.ProposalTerms{
@@ -5157,6 +5354,85 @@ func testNewPerpsMarketChangeSubmissionWithInternalCompositePriceConfig(t *testi
 	}
 }
 
+func testNewPerpsMarketChangeSubmissionWithIndexBasketConfig(t *testing.T) {
+	cases := []struct {
+		config *vegapb.IndexBasketConfiguration
+		field  string
+		err    error
+	}{
+		{
+			config: &vegapb.IndexBasketConfiguration{},
+			field:  "constituents",
+			err:    commands.ErrIsRequired,
+		},
+		{
+			config: &vegapb.IndexBasketConfiguration{RebalanceInterval: -1},
+			field:  "rebalance_interval",
+			err:    commands.ErrMustBePositiveOrZero,
+		},
+		{
+			config: &vegapb.IndexBasketConfiguration{Constituents: []*vegapb.IndexBasketConstituent{{}}},
+			field:  "constituents.0.weight",
+			err:    commands.ErrIsRequired,
+		},
+		{
+			config: &vegapb.IndexBasketConfiguration{Constituents: []*vegapb.IndexBasketConstituent{{Weight: "abc"}}},
+			field:  "constituents.0.weight",
+			err:    commands.ErrIsNotValidNumber,
+		},
+		{
+			config: &vegapb.IndexBasketConfiguration{Constituents: []*vegapb.IndexBasketConstituent{{Weight: "0"}}},
+			field:  "constituents.0.weight",
+			err:    commands.ErrMustBePositive,
+		},
+		{
+			config: &vegapb.IndexBasketConfiguration{Constituents: []*vegapb.IndexBasketConstituent{{Weight: "1", StalenessTolerance: "abc"}}},
+			field:  "constituents.0.staleness_tolerance",
+			err:    fmt.Errorf("must be a valid duration"),
+		},
+		{
+			config: &vegapb.IndexBasketConfiguration{Constituents: []*vegapb.IndexBasketConstituent{{Weight: "1", StalenessTolerance: "10m"}}},
+			field:  "constituents.0.data_source_spec",
+			err:    commands.ErrIsRequired,
+		},
+		{
+			config: &vegapb.IndexBasketConfiguration{Constituents: []*vegapb.IndexBasketConstituent{{Weight: "1", StalenessTolerance: "10m"}}},
+			field:  "constituents.0.staleness_tolerance",
+		},
+		{
+			config: &vegapb.IndexBasketConfiguration{Constituents: []*vegapb.IndexBasketConstituent{{Weight: "0.5"}}},
+			field:  "constituents.0.weight",
+		},
+	}
+	for _, c := range cases {
+		err := checkProposalSubmission(&commandspb.ProposalSubmission{
+			Terms: &vegapb.ProposalTerms{
+				Change: &vegapb.ProposalTerms_NewMarket{
+					NewMarket: &vegapb.NewMarket{
+						Changes: &vegapb.NewMarketConfiguration{
+							Instrument: &vegapb.InstrumentConfiguration{
+								Product: &vegapb.InstrumentConfiguration_Perpetual{
+									Perpetual: &vegapb.PerpetualProduct{
+										DataSourceSpecBinding: &vegapb.DataSourceSpecToPerpetualBinding{
+											SettlementDataProperty: "My property",
+										},
+										IndexBasketConfiguration: c.config,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+		if c.err != nil {
+			assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.perps.index_basket_configuration."+c.field), c.err)
+		} else {
+			assert.Empty(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.perps.index_basket_configuration."+c.field))
+		}
+	}
+}
+
 func TestNewPerpsMarketChangeSubmissionProductParameters(t *testing.T) {
 	cases := []struct {
 		product vegapb.PerpetualProduct
@@ -6855,12 +7131,37 @@ func testFutureMarketSubmissionWithInvalidLiquidationStrategyFails(t *testing.T)
 			},
 			err: commands.ErrMustBePositive,
 		},
+		"proposal_submission.terms.change.new_market.changes.liquidation_strategy.partial_close_out_step": {
+			ls: &vegapb.LiquidationStrategy{
+				DisposalTimeStep:      5,
+				DisposalFraction:      "0.1",
+				FullDisposalSize:      20,
+				MaxFractionConsumed:   "0.1",
+				DisposalSlippageRange: "0.5",
+				PartialCloseOutStep:   "1.5",
+			},
+			err: commands.ErrMustBeBetween01,
+		},
+		"proposal_submission.terms.change.new_market.changes.liquidation_strategy.partial_close_out_buffer": {
+			ls: &vegapb.LiquidationStrategy{
+				DisposalTimeStep:      5,
+				DisposalFraction:      "0.1",
+				FullDisposalSize:      20,
+				MaxFractionConsumed:   "0.1",
+				DisposalSlippageRange: "0.5",
+				PartialCloseOutStep:   "0.1",
+				PartialCloseOutBuffer: "-0.1",
+			},
+			err: commands.ErrMustBePositiveOrZero,
+		},
 	}
 	checks := []string{
 		"proposal_submission.terms.change.new_market.changes.liquidation_strategy.disposal_fraction",
 		"proposal_submission.terms.change.new_market.changes.liquidation_strategy.max_fraction_consumed",
 		"proposal_submission.terms.change.new_market.changes.liquidation_strategy.disposal_time_step",
 		"proposal_submission.terms.change.new_market.changes.liquidation_strategy.disposal_slippage_range",
+		"proposal_submission.terms.change.new_market.changes.liquidation_strategy.partial_close_out_step",
+		"proposal_submission.terms.change.new_market.changes.liquidation_strategy.partial_close_out_buffer",
 	}
 	for ec, exp := range data {
 		nm := submission.Terms.GetNewMarket()
@@ -6941,3 +7242,240 @@ func testFutureMarketSubmissionWithInvalidLiquidationStrategyFails(t *testing.T)
 		}
 	}
 }
+
+func TestCheckProposalSubmissionForNewOptionMarket(t *testing.T) {
+	t.Run("Submitting an option market without required fields fails", testNewOptionMarketChangeSubmissionWithoutRequiredFieldsFails)
+	t.Run("Submitting an option market with an invalid strike price fails", testNewOptionMarketChangeSubmissionWithInvalidStrikeFails)
+	t.Run("Submitting an option market with valid fields succeeds", testNewOptionMarketChangeSubmissionWithValidFieldsSucceeds)
+}
+
+func newOptionMarketSubmission(option *vegapb.OptionProduct) *commandspb.ProposalSubmission {
+	return &commandspb.ProposalSubmission{
+		Terms: &vegapb.ProposalTerms{
+			Change: &vegapb.ProposalTerms_NewMarket{
+				NewMarket: &vegapb.NewMarket{
+					Changes: &vegapb.NewMarketConfiguration{
+						Instrument: &vegapb.InstrumentConfiguration{
+							Product: &vegapb.InstrumentConfiguration_Option{
+								Option: option,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func testNewOptionMarketChangeSubmissionWithoutRequiredFieldsFails(t *testing.T) {
+	err := checkProposalSubmission(newOptionMarketSubmission(&vegapb.OptionProduct{}))
+
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.option.settlement_asset"), commands.ErrIsRequired)
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.option.quote_name"), commands.ErrIsRequired)
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.option.option_type"), commands.ErrIsRequired)
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.option.strike_price"), commands.ErrIsRequired)
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.option.expiry_timestamp"), commands.ErrMustBePositive)
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.option.data_source_spec_for_settlement_data"), commands.ErrIsRequired)
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.option.data_source_spec_for_trading_termination"), commands.ErrIsRequired)
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.option.data_source_spec_binding"), commands.ErrIsRequired)
+}
+
+func testNewOptionMarketChangeSubmissionWithInvalidStrikeFails(t *testing.T) {
+	cases := map[string]error{
+		"banana": commands.ErrIsNotValidNumber,
+		"0":      commands.ErrMustBePositive,
+		"-10":    commands.ErrMustBePositive,
+	}
+	for strike, expected := range cases {
+		err := checkProposalSubmission(newOptionMarketSubmission(&vegapb.OptionProduct{
+			StrikePrice: strike,
+		}))
+		assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.option.strike_price"), expected, strike)
+	}
+}
+
+func testNewOptionMarketChangeSubmissionWithValidFieldsSucceeds(t *testing.T) {
+	pubKey := []*dstypes.Signer{
+		dstypes.CreateSignerFromString("0xDEADBEEF", dstypes.SignerTypePubKey),
+	}
+	err := checkProposalSubmission(newOptionMarketSubmission(&vegapb.OptionProduct{
+		SettlementAsset: "BTC",
+		QuoteName:       "USD",
+		OptionType:      vegapb.OptionType_OPTION_TYPE_PUT,
+		StrikePrice:     "25000.5",
+		ExpiryTimestamp: time.Now().Add(24 * time.Hour).Unix(),
+		DataSourceSpecForSettlementData: vegapb.NewDataSourceDefinition(
+			vegapb.DataSourceContentTypeOracle,
+		).SetOracleConfig(
+			&vegapb.DataSourceDefinitionExternal_Oracle{
+				Oracle: &vegapb.DataSourceSpecConfiguration{
+					Signers: dstypes.SignersIntoProto(pubKey),
+					Filters: []*datapb.Filter{
+						{
+							Key: &datapb.PropertyKey{
+								Name: "prices.BTC.value",
+								Type: datapb.PropertyKey_TYPE_DECIMAL,
+							},
+						},
+					},
+				},
+			},
+		),
+		DataSourceSpecForTradingTermination: vegapb.NewDataSourceDefinition(
+			vegapb.DataSourceContentTypeOracle,
+		).SetOracleConfig(
+			&vegapb.DataSourceDefinitionExternal_Oracle{
+				Oracle: &vegapb.DataSourceSpecConfiguration{
+					Signers: dstypes.SignersIntoProto(pubKey),
+					Filters: []*datapb.Filter{
+						{
+							Key: &datapb.PropertyKey{
+								Name: "trading.terminated",
+								Type: datapb.PropertyKey_TYPE_BOOLEAN,
+							},
+						},
+					},
+				},
+			},
+		),
+		DataSourceSpecBinding: &vegapb.DataSourceSpecToFutureBinding{
+			SettlementDataProperty:     "prices.BTC.value",
+			TradingTerminationProperty: "trading.terminated",
+		},
+	}))
+
+	for _, prop := range []string{
+		"settlement_asset", "quote_name", "option_type", "strike_price", "expiry_timestamp",
+		"data_source_spec_for_settlement_data", "data_source_spec_for_trading_termination",
+		"data_source_spec_binding.settlement_data_property", "data_source_spec_binding.trading_termination_property",
+	} {
+		assert.Empty(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.option."+prop), prop)
+	}
+}
+
+func TestCheckProposalSubmissionForNewMarketHistoricalSimulation(t *testing.T) {
+	t.Run("Submitting historical simulation risk parameters without parameters fails", testNewHistoricalSimulationRiskParametersWithoutParamsFails)
+	t.Run("Submitting historical simulation risk parameters with invalid values fails", testNewHistoricalSimulationRiskParametersInvalidFails)
+	t.Run("Submitting historical simulation risk parameters with valid values succeeds", testNewHistoricalSimulationRiskParametersSucceeds)
+}
+
+func newHistoricalSimulationProposal(params *vegapb.HistoricalSimulationRiskModel) *commandspb.ProposalSubmission {
+	return &commandspb.ProposalSubmission{
+		Terms: &vegapb.ProposalTerms{
+			Change: &vegapb.ProposalTerms_NewMarket{
+				NewMarket: &vegapb.NewMarket{
+					Changes: &vegapb.NewMarketConfiguration{
+						RiskParameters: &vegapb.NewMarketConfiguration_HistoricalSimulation{
+							HistoricalSimulation: params,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func testNewHistoricalSimulationRiskParametersWithoutParamsFails(t *testing.T) {
+	err := checkProposalSubmission(newHistoricalSimulationProposal(nil))
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.risk_parameters.historical_simulation"), commands.ErrIsRequired)
+}
+
+func testNewHistoricalSimulationRiskParametersInvalidFails(t *testing.T) {
+	err := checkProposalSubmission(newHistoricalSimulationProposal(&vegapb.HistoricalSimulationRiskModel{
+		RiskAversionParameter: 0.5,
+		Tau:                   0,
+		WindowSize:            1,
+		MinObservations:       10,
+		DecayFactor:           1,
+		FallbackSigma:         0,
+	}))
+	prefix := "proposal_submission.terms.change.new_market.changes.risk_parameters.historical_simulation"
+	assert.Contains(t, err.Get(prefix+".risk_aversion_parameter"), errors.New("must be between [1e-8, 0.1]"))
+	assert.Contains(t, err.Get(prefix+".tau"), errors.New("must be between [1e-8, 1]"))
+	assert.Contains(t, err.Get(prefix+".window_size"), errors.New("must be between [2, 100000]"))
+	assert.Contains(t, err.Get(prefix+".min_observations"), errors.New("must not be greater than the window size"))
+	assert.Contains(t, err.Get(prefix+".decay_factor"), errors.New("must be between [0, 1)"))
+	assert.Contains(t, err.Get(prefix+".fallback_sigma"), errors.New("must be between [1e-3, 50]"))
+}
+
+func testNewHistoricalSimulationRiskParametersSucceeds(t *testing.T) {
+	err := checkProposalSubmission(newHistoricalSimulationProposal(&vegapb.HistoricalSimulationRiskModel{
+		RiskAversionParameter: 0.01,
+		Tau:                   1.0 / 365.25 / 24,
+		WindowSize:            500,
+		MinObservations:       50,
+		DecayFactor:           0.94,
+		FallbackSigma:         1.5,
+	}))
+	prefix := "proposal_submission.terms.change.new_market.changes.risk_parameters.historical_simulation"
+	for _, field := range []string{"", ".risk_aversion_parameter", ".tau", ".window_size", ".min_observations", ".decay_factor", ".fallback_sigma"} {
+		assert.Empty(t, err.Get(prefix+field))
+	}
+}
+
+func newPredictionMarketSubmission(future *vegapb.FutureProduct) *commandspb.ProposalSubmission {
+	return &commandspb.ProposalSubmission{
+		Terms: &vegapb.ProposalTerms{
+			Change: &vegapb.ProposalTerms_NewMarket{
+				NewMarket: &vegapb.NewMarket{
+					Changes: &vegapb.NewMarketConfiguration{
+						Instrument: &vegapb.InstrumentConfiguration{
+							Product: &vegapb.InstrumentConfiguration_Future{
+								Future: future,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func testNewPredictionMarketWithValidOutcomesSucceeds(t *testing.T) {
+	err := checkProposalSubmission(newPredictionMarketSubmission(&vegapb.FutureProduct{
+		PredictionOutcomes: []string{"yes", "no"},
+		Cap: &vegapb.FutureCap{
+			MaxPrice:            "100",
+			BinarySettlement:    ptr.From(true),
+			FullyCollateralised: ptr.From(true),
+		},
+	}))
+
+	assert.Empty(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.future.prediction_outcomes"))
+	assert.Empty(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.future.cap"))
+	assert.Empty(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.future.cap.binary_settlement"))
+	assert.Empty(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.future.cap.fully_collateralised"))
+}
+
+func testNewPredictionMarketWithInvalidOutcomesFails(t *testing.T) {
+	fCap := &vegapb.FutureCap{
+		MaxPrice:            "100",
+		BinarySettlement:    ptr.From(true),
+		FullyCollateralised: ptr.From(true),
+	}
+	future := &vegapb.FutureProduct{
+		PredictionOutcomes: []string{"yes"},
+		Cap:                fCap,
+	}
+	cmd := newPredictionMarketSubmission(future)
+
+	err := checkProposalSubmission(cmd)
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.future.prediction_outcomes"), commands.ErrMustHaveAtLeastTwoOutcomes)
+
+	future.PredictionOutcomes = []string{"yes", "", "yes"}
+	err = checkProposalSubmission(cmd)
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.future.prediction_outcomes.1"), commands.ErrIsRequired)
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.future.prediction_outcomes.2"), commands.ErrIsDuplicated)
+
+	// the outcome contracts must be binary and fully collateralised
+	future.PredictionOutcomes = []string{"yes", "no"}
+	fCap.BinarySettlement = nil
+	fCap.FullyCollateralised = ptr.From(false)
+	err = checkProposalSubmission(cmd)
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.future.cap.binary_settlement"), commands.ErrMustBeSetForPredictionMarkets)
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.future.cap.fully_collateralised"), commands.ErrMustBeSetForPredictionMarkets)
+
+	future.Cap = nil
+	err = checkProposalSubmission(cmd)
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.instrument.product.future.cap"), commands.ErrMustBeSetForPredictionMarkets)
+}
@@ -101,6 +101,7 @@ func TestCheckProposalSubmissionForNewSpotMarket(t *testing.T) {
 	t.Run("Submitting a new spot market with valid hysteresis epochs succeeds", testNewSpotMarketChangeSubmissionWithValidPerformanceHysteresisEpochsSucceeds)
 
 	t.Run("Submitting a new spot market with invalid tick size fails and valid tick size succeeds", testNewSpotMarketTickSize)
+	t.Run("Submitting a new spot market with a non-positive batch duration fails", testNewSpotMarketChangeSubmissionWithNonPositiveBatchDurationFails)
 }
 
 func testNewSpotMarketTickSize(t *testing.T) {
@@ -2258,3 +2259,22 @@ func testNewSpotMarketChangeSubmissionWithValidPerformanceHysteresisEpochsSuccee
 
 	assert.NotContains(t, err.Get("proposal_submission.terms.change.new_spot_market.changes.sla_params.performance_hysteresis_epochs"), commands.ErrMustBePositive)
 }
+
+func testNewSpotMarketChangeSubmissionWithNonPositiveBatchDurationFails(t *testing.T) {
+	err := checkProposalSubmission(&commandspb.ProposalSubmission{
+		Terms: &protoTypes.ProposalTerms{
+			Change: &protoTypes.ProposalTerms_NewSpotMarket{
+				NewSpotMarket: &protoTypes.NewSpotMarket{
+					Changes: &protoTypes.NewSpotMarketConfiguration{
+						Instrument: &protoTypes.InstrumentConfiguration{
+							Product: &protoTypes.InstrumentConfiguration_Spot{},
+						},
+						FrequentBatchAuction: &protoTypes.FrequentBatchAuctionParameters{},
+					},
+				},
+			},
+		},
+	})
+
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_spot_market.changes.frequent_batch_auction.batch_duration"), commands.ErrMustBePositive)
+}
@@ -179,6 +179,28 @@ func TestCheckStopOrdersStubmission(t *testing.T) {
 			},
 			errStr: "order_submission (is required), stop_orders_submission.rises_below.expiry_strategy (is not a valid value)",
 		},
+		{
+			submission: commandspb.StopOrdersSubmission{
+				RisesAbove: &commandspb.StopOrderSetup{
+					TriggerReference: ptr.From(vega.StopOrder_TriggerReference(-1)),
+					Trigger: &commandspb.StopOrderSetup_TrailingPercentOffset{
+						TrailingPercentOffset: "0.1",
+					},
+				},
+			},
+			errStr: "order_submission (is required), stop_orders_submission.rises_below.trigger_reference (is not a valid value)",
+		},
+		{
+			submission: commandspb.StopOrdersSubmission{
+				RisesAbove: &commandspb.StopOrderSetup{
+					TriggerReference: ptr.From(vega.StopOrder_TRIGGER_REFERENCE_MARK_PRICE),
+					Trigger: &commandspb.StopOrderSetup_TrailingPercentOffset{
+						TrailingPercentOffset: "0.1",
+					},
+				},
+			},
+			errStr: "order_submission (is required)",
+		},
 		{
 			submission: commandspb.StopOrdersSubmission{
 				RisesAbove: &commandspb.StopOrderSetup{
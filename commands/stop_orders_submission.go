@@ -130,6 +130,12 @@ func checkStopOrderSetup(
 		}
 	}
 
+	if setup.TriggerReference != nil {
+		if _, ok := types.StopOrder_TriggerReference_name[int32(*setup.TriggerReference)]; !ok {
+			errs.AddForProperty(fmt.Sprintf("%s.trigger_reference", fieldName), ErrIsNotValid)
+		}
+	}
+
 	if setup.Trigger != nil {
 		switch t := setup.Trigger.(type) {
 		case *commandspb.StopOrderSetup_Price:
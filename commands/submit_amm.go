@@ -56,6 +56,14 @@ func checkSubmitAMM(cmd *commandspb.SubmitAMM) Errors {
 		errs.AddForProperty("submit_amm.commitment_amount", ErrMustBePositive)
 	}
 
+	if cmd.BaseCommitmentAmount != nil {
+		if amount, _ := big.NewInt(0).SetString(*cmd.BaseCommitmentAmount, 10); amount == nil {
+			errs.FinalAddForProperty("submit_amm.base_commitment_amount", ErrIsNotValidNumber)
+		} else if amount.Cmp(big.NewInt(0)) < 0 {
+			errs.AddForProperty("submit_amm.base_commitment_amount", ErrMustBePositiveOrZero)
+		}
+	}
+
 	if len(cmd.ProposedFee) <= 0 {
 		errs.AddForProperty("submit_amm.proposed_fee", ErrIsRequired)
 	} else if proposedFee, err := num.DecimalFromString(cmd.ProposedFee); err != nil {
@@ -95,6 +95,18 @@ func TestCheckSubmitAMM(t *testing.T) {
 			},
 			errStr: "submit_amm.commitment_amount (must be positive)",
 		},
+		{
+			submission: commandspb.SubmitAMM{
+				BaseCommitmentAmount: ptr.From("abc"),
+			},
+			errStr: "submit_amm.base_commitment_amount (is not a valid number)",
+		},
+		{
+			submission: commandspb.SubmitAMM{
+				BaseCommitmentAmount: ptr.From("-10"),
+			},
+			errStr: "submit_amm.base_commitment_amount (must be positive or zero)",
+		},
 		{
 			submission: commandspb.SubmitAMM{
 				ProposedFee: "",
@@ -0,0 +1,55 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package commands
+
+import (
+	"math"
+
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+)
+
+func CheckSubmitCompleteSet(cmd *commandspb.SubmitCompleteSet) error {
+	return checkSubmitCompleteSet(cmd).ErrorOrNil()
+}
+
+func checkSubmitCompleteSet(cmd *commandspb.SubmitCompleteSet) Errors {
+	errs := NewErrors()
+
+	if cmd == nil {
+		return errs.FinalAddForProperty("submit_complete_set", ErrIsRequired)
+	}
+
+	if len(cmd.PredictionId) == 0 {
+		errs.AddForProperty("submit_complete_set.prediction_id", ErrIsRequired)
+	} else if !IsVegaID(cmd.PredictionId) {
+		errs.AddForProperty("submit_complete_set.prediction_id", ErrShouldBeAValidVegaID)
+	}
+
+	if cmd.Size == 0 {
+		errs.AddForProperty("submit_complete_set.size", ErrMustBePositive)
+	} else if cmd.Size > math.MaxInt64/2 {
+		// the size of the positions opened in each outcome market is cast to int64
+		errs.AddForProperty("submit_complete_set.size", ErrSizeIsTooLarge)
+	}
+
+	if cmd.Action == commandspb.SubmitCompleteSet_ACTION_UNSPECIFIED {
+		errs.AddForProperty("submit_complete_set.action", ErrIsRequired)
+	} else if _, ok := commandspb.SubmitCompleteSet_Action_name[int32(cmd.Action)]; !ok {
+		errs.AddForProperty("submit_complete_set.action", ErrIsNotValid)
+	}
+
+	return errs
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package commands_test
+
+import (
+	"testing"
+
+	"code.vegaprotocol.io/vega/commands"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckSubmitCompleteSet(t *testing.T) {
+	predictionID := "e9982447fb4128f9968f9981612c5ea85d19b62058ec2636efc812dcbbc745ca"
+
+	cases := []struct {
+		submission *commandspb.SubmitCompleteSet
+		errStr     string
+	}{
+		{
+			submission: &commandspb.SubmitCompleteSet{
+				PredictionId: predictionID,
+				Size:         10,
+				Action:       commandspb.SubmitCompleteSet_ACTION_MINT,
+			},
+		},
+		{
+			submission: &commandspb.SubmitCompleteSet{
+				PredictionId: predictionID,
+				Size:         10,
+				Action:       commandspb.SubmitCompleteSet_ACTION_REDEEM,
+			},
+		},
+		{
+			submission: &commandspb.SubmitCompleteSet{
+				Size:   10,
+				Action: commandspb.SubmitCompleteSet_ACTION_MINT,
+			},
+			errStr: "submit_complete_set.prediction_id (is required)",
+		},
+		{
+			submission: &commandspb.SubmitCompleteSet{
+				PredictionId: "not-an-id",
+				Size:         10,
+				Action:       commandspb.SubmitCompleteSet_ACTION_MINT,
+			},
+			errStr: "submit_complete_set.prediction_id (should be a valid Vega ID)",
+		},
+		{
+			submission: &commandspb.SubmitCompleteSet{
+				PredictionId: predictionID,
+				Action:       commandspb.SubmitCompleteSet_ACTION_MINT,
+			},
+			errStr: "submit_complete_set.size (must be positive)",
+		},
+		{
+			submission: &commandspb.SubmitCompleteSet{
+				PredictionId: predictionID,
+				Size:         10,
+			},
+			errStr: "submit_complete_set.action (is required)",
+		},
+		{
+			submission: &commandspb.SubmitCompleteSet{
+				PredictionId: predictionID,
+				Size:         10,
+				Action:       commandspb.SubmitCompleteSet_Action(42),
+			},
+			errStr: "submit_complete_set.action (is not a valid value)",
+		},
+	}
+
+	for n, c := range cases {
+		err := commands.CheckSubmitCompleteSet(c.submission)
+		if len(c.errStr) == 0 {
+			assert.NoError(t, err, n)
+			continue
+		}
+		assert.Contains(t, err.Error(), c.errStr, n)
+	}
+	assert.Contains(t, commands.CheckSubmitCompleteSet(nil).Error(), "submit_complete_set (is required)")
+}
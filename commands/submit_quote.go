@@ -0,0 +1,56 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package commands
+
+import (
+	"math/big"
+
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+)
+
+func CheckSubmitQuote(cmd *commandspb.SubmitQuote) error {
+	return checkSubmitQuote(cmd).ErrorOrNil()
+}
+
+func checkSubmitQuote(cmd *commandspb.SubmitQuote) Errors {
+	errs := NewErrors()
+
+	if cmd == nil {
+		return errs.FinalAddForProperty("submit_quote", ErrIsRequired)
+	}
+
+	if len(cmd.QuoteRequestId) <= 0 {
+		errs.AddForProperty("submit_quote.quote_request_id", ErrIsRequired)
+	} else if !IsVegaID(cmd.QuoteRequestId) {
+		errs.AddForProperty("submit_quote.quote_request_id", ErrShouldBeAValidVegaID)
+	}
+
+	if len(cmd.Price) <= 0 {
+		errs.AddForProperty("submit_quote.price", ErrIsRequired)
+	} else if price, ok := big.NewInt(0).SetString(cmd.Price, 10); !ok {
+		errs.AddForProperty("submit_quote.price", ErrNotAValidInteger)
+	} else if price.Sign() <= 0 {
+		errs.AddForProperty("submit_quote.price", ErrMustBePositive)
+	}
+
+	if cmd.ExpiresIn <= 0 {
+		errs.AddForProperty("submit_quote.expires_in", ErrMustBePositive)
+	} else if cmd.ExpiresIn > maxQuoteExpiry {
+		errs.AddForProperty("submit_quote.expires_in", ErrMustBeAtMost3600)
+	}
+
+	return errs
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package commands
+
+import (
+	types "code.vegaprotocol.io/vega/protos/vega"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+)
+
+// maxQuoteExpiry is the longest a request for quote, or a quote, can stay open for, in seconds.
+const maxQuoteExpiry = 3600
+
+func CheckSubmitQuoteRequest(cmd *commandspb.SubmitQuoteRequest) error {
+	return checkSubmitQuoteRequest(cmd).ErrorOrNil()
+}
+
+func checkSubmitQuoteRequest(cmd *commandspb.SubmitQuoteRequest) Errors {
+	errs := NewErrors()
+
+	if cmd == nil {
+		return errs.FinalAddForProperty("submit_quote_request", ErrIsRequired)
+	}
+
+	if len(cmd.MarketId) <= 0 {
+		errs.AddForProperty("submit_quote_request.market_id", ErrIsRequired)
+	} else if !IsVegaID(cmd.MarketId) {
+		errs.AddForProperty("submit_quote_request.market_id", ErrShouldBeAValidVegaID)
+	}
+
+	if cmd.Side == types.Side_SIDE_UNSPECIFIED {
+		errs.AddForProperty("submit_quote_request.side", ErrIsRequired)
+	}
+	if _, ok := types.Side_name[int32(cmd.Side)]; !ok {
+		errs.AddForProperty("submit_quote_request.side", ErrIsNotValid)
+	}
+
+	if cmd.Size == 0 {
+		errs.AddForProperty("submit_quote_request.size", ErrMustBePositive)
+	}
+
+	if cmd.ExpiresIn <= 0 {
+		errs.AddForProperty("submit_quote_request.expires_in", ErrMustBePositive)
+	} else if cmd.ExpiresIn > maxQuoteExpiry {
+		errs.AddForProperty("submit_quote_request.expires_in", ErrMustBeAtMost3600)
+	}
+
+	if len(cmd.Reference) > ReferenceMaxLen {
+		errs.AddForProperty("submit_quote_request.reference", ErrReferenceTooLong)
+	}
+
+	return errs
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package commands_test
+
+import (
+	"errors"
+	"testing"
+
+	"code.vegaprotocol.io/vega/commands"
+	types "code.vegaprotocol.io/vega/protos/vega"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckSubmitQuoteRequest(t *testing.T) {
+	marketID := "e9982447fb4128f9968f9981612c5ea85d19b62058ec2636efc812dcbbc745ca"
+	cases := []struct {
+		submission *commandspb.SubmitQuoteRequest
+		errStr     string
+	}{
+		{
+			submission: &commandspb.SubmitQuoteRequest{
+				MarketId:  marketID,
+				Side:      types.Side_SIDE_BUY,
+				Size:      1000,
+				ExpiresIn: 60,
+				Reference: "block",
+			},
+		},
+		{
+			submission: &commandspb.SubmitQuoteRequest{
+				Side:      types.Side_SIDE_BUY,
+				Size:      1000,
+				ExpiresIn: 60,
+			},
+			errStr: "submit_quote_request.market_id (is required)",
+		},
+		{
+			submission: &commandspb.SubmitQuoteRequest{
+				MarketId:  "notavalidmarketid",
+				Side:      types.Side_SIDE_BUY,
+				Size:      1000,
+				ExpiresIn: 60,
+			},
+			errStr: "submit_quote_request.market_id (should be a valid Vega ID)",
+		},
+		{
+			submission: &commandspb.SubmitQuoteRequest{
+				MarketId:  marketID,
+				Size:      1000,
+				ExpiresIn: 60,
+			},
+			errStr: "submit_quote_request.side (is required)",
+		},
+		{
+			submission: &commandspb.SubmitQuoteRequest{
+				MarketId:  marketID,
+				Side:      types.Side(42),
+				Size:      1000,
+				ExpiresIn: 60,
+			},
+			errStr: "submit_quote_request.side (is not a valid value)",
+		},
+		{
+			submission: &commandspb.SubmitQuoteRequest{
+				MarketId:  marketID,
+				Side:      types.Side_SIDE_SELL,
+				ExpiresIn: 60,
+			},
+			errStr: "submit_quote_request.size (must be positive)",
+		},
+		{
+			submission: &commandspb.SubmitQuoteRequest{
+				MarketId: marketID,
+				Side:     types.Side_SIDE_SELL,
+				Size:     1000,
+			},
+			errStr: "submit_quote_request.expires_in (must be positive)",
+		},
+		{
+			submission: &commandspb.SubmitQuoteRequest{
+				MarketId:  marketID,
+				Side:      types.Side_SIDE_SELL,
+				Size:      1000,
+				ExpiresIn: 3601,
+			},
+			errStr: "submit_quote_request.expires_in (must be at most 3600)",
+		},
+	}
+
+	for n, c := range cases {
+		if len(c.errStr) <= 0 {
+			assert.NoError(t, commands.CheckSubmitQuoteRequest(c.submission), n)
+			continue
+		}
+
+		assert.Contains(t, checkSubmitQuoteRequest(c.submission).Error(), c.errStr, n)
+	}
+}
+
+func checkSubmitQuoteRequest(cmd *commandspb.SubmitQuoteRequest) commands.Errors {
+	err := commands.CheckSubmitQuoteRequest(cmd)
+
+	var e commands.Errors
+	if ok := errors.As(err, &e); !ok {
+		return commands.NewErrors()
+	}
+
+	return e
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package commands_test
+
+import (
+	"errors"
+	"testing"
+
+	"code.vegaprotocol.io/vega/commands"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckSubmitQuote(t *testing.T) {
+	requestID := "e9982447fb4128f9968f9981612c5ea85d19b62058ec2636efc812dcbbc745ca"
+	cases := []struct {
+		submission *commandspb.SubmitQuote
+		errStr     string
+	}{
+		{
+			submission: &commandspb.SubmitQuote{
+				QuoteRequestId: requestID,
+				Price:          "10000",
+				ExpiresIn:      30,
+			},
+		},
+		{
+			submission: &commandspb.SubmitQuote{
+				Price:     "10000",
+				ExpiresIn: 30,
+			},
+			errStr: "submit_quote.quote_request_id (is required)",
+		},
+		{
+			submission: &commandspb.SubmitQuote{
+				QuoteRequestId: requestID,
+				ExpiresIn:      30,
+			},
+			errStr: "submit_quote.price (is required)",
+		},
+		{
+			submission: &commandspb.SubmitQuote{
+				QuoteRequestId: requestID,
+				Price:          "100.5",
+				ExpiresIn:      30,
+			},
+			errStr: "submit_quote.price (not a valid integer)",
+		},
+		{
+			submission: &commandspb.SubmitQuote{
+				QuoteRequestId: requestID,
+				Price:          "0",
+				ExpiresIn:      30,
+			},
+			errStr: "submit_quote.price (must be positive)",
+		},
+		{
+			submission: &commandspb.SubmitQuote{
+				QuoteRequestId: requestID,
+				Price:          "10000",
+			},
+			errStr: "submit_quote.expires_in (must be positive)",
+		},
+		{
+			submission: &commandspb.SubmitQuote{
+				QuoteRequestId: requestID,
+				Price:          "10000",
+				ExpiresIn:      7200,
+			},
+			errStr: "submit_quote.expires_in (must be at most 3600)",
+		},
+	}
+
+	for n, c := range cases {
+		if len(c.errStr) <= 0 {
+			assert.NoError(t, commands.CheckSubmitQuote(c.submission), n)
+			continue
+		}
+
+		assert.Contains(t, checkSubmitQuote(c.submission).Error(), c.errStr, n)
+	}
+}
+
+func checkSubmitQuote(cmd *commandspb.SubmitQuote) commands.Errors {
+	err := commands.CheckSubmitQuote(cmd)
+
+	var e commands.Errors
+	if ok := errors.As(err, &e); !ok {
+		return commands.NewErrors()
+	}
+
+	return e
+}
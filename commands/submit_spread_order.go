@@ -0,0 +1,93 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	types "code.vegaprotocol.io/vega/protos/vega"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+)
+
+const maxSpreadOrderLegs = 10
+
+func CheckSubmitSpreadOrder(cmd *commandspb.SubmitSpreadOrder) error {
+	return checkSubmitSpreadOrder(cmd).ErrorOrNil()
+}
+
+func checkSubmitSpreadOrder(cmd *commandspb.SubmitSpreadOrder) Errors {
+	errs := NewErrors()
+
+	if cmd == nil {
+		return errs.FinalAddForProperty("submit_spread_order", ErrIsRequired)
+	}
+
+	if len(cmd.Reference) > ReferenceMaxLen {
+		errs.AddForProperty("submit_spread_order.reference", ErrReferenceTooLong)
+	}
+
+	if cmd.Size == 0 {
+		errs.AddForProperty("submit_spread_order.size", ErrMustBePositive)
+	}
+
+	if len(cmd.MaxNetPrice) == 0 {
+		errs.AddForProperty("submit_spread_order.max_net_price", ErrIsRequired)
+	} else if _, ok := big.NewInt(0).SetString(cmd.MaxNetPrice, 10); !ok {
+		errs.AddForProperty("submit_spread_order.max_net_price", ErrNotAValidInteger)
+	}
+
+	if len(cmd.Legs) < 2 {
+		errs.AddForProperty("submit_spread_order.legs", errors.New("must have at least 2 legs"))
+	} else if len(cmd.Legs) > maxSpreadOrderLegs {
+		errs.AddForProperty("submit_spread_order.legs", ErrIsLimitedTo10Entries)
+	}
+
+	seen := make(map[string]struct{}, len(cmd.Legs))
+	for i, leg := range cmd.Legs {
+		property := fmt.Sprintf("submit_spread_order.legs.%d", i)
+		if leg == nil {
+			errs.AddForProperty(property, ErrIsRequired)
+			continue
+		}
+
+		if len(leg.MarketId) == 0 {
+			errs.AddForProperty(property+".market_id", ErrIsRequired)
+		} else if !IsVegaID(leg.MarketId) {
+			errs.AddForProperty(property+".market_id", ErrShouldBeAValidVegaID)
+		} else if _, ok := seen[leg.MarketId]; ok {
+			errs.AddForProperty(property+".market_id", ErrIsDuplicated)
+		}
+		seen[leg.MarketId] = struct{}{}
+
+		if leg.Side == types.Side_SIDE_UNSPECIFIED {
+			errs.AddForProperty(property+".side", ErrIsRequired)
+		} else if _, ok := types.Side_name[int32(leg.Side)]; !ok {
+			errs.AddForProperty(property+".side", ErrIsNotValid)
+		}
+
+		if leg.Ratio == 0 {
+			errs.AddForProperty(property+".ratio", ErrMustBePositive)
+		} else if cmd.Size > 0 && leg.Ratio > math.MaxInt64/2/cmd.Size {
+			// just make sure the leg size is not some silly big number because we do sometimes cast to int64s
+			errs.AddForProperty(property+".ratio", ErrSizeIsTooLarge)
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,165 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package commands_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"code.vegaprotocol.io/vega/commands"
+	types "code.vegaprotocol.io/vega/protos/vega"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckSubmitSpreadOrder(t *testing.T) {
+	quarterly := "e9982447fb4128f9968f9981612c5ea85d19b62058ec2636efc812dcbbc745ca"
+	perp := "7ee53ab2eb7d3d2f9a3b46c2e4bb1c0e6e9a3d1b2c0f8a6d4e2c1b0a9f8e7d6c"
+	legs := func() []*commandspb.SpreadOrderLeg {
+		return []*commandspb.SpreadOrderLeg{
+			{MarketId: quarterly, Side: types.Side_SIDE_BUY, Ratio: 1},
+			{MarketId: perp, Side: types.Side_SIDE_SELL, Ratio: 1},
+		}
+	}
+
+	cases := []struct {
+		submission *commandspb.SubmitSpreadOrder
+		errStr     string
+	}{
+		{
+			submission: &commandspb.SubmitSpreadOrder{
+				Legs:        legs(),
+				Size:        10,
+				MaxNetPrice: "150",
+			},
+		},
+		{
+			// a negative net price is a minimum credit
+			submission: &commandspb.SubmitSpreadOrder{
+				Legs:        legs(),
+				Size:        10,
+				MaxNetPrice: "-150",
+			},
+		},
+		{
+			submission: &commandspb.SubmitSpreadOrder{
+				Legs:        legs(),
+				MaxNetPrice: "150",
+			},
+			errStr: "submit_spread_order.size (must be positive)",
+		},
+		{
+			submission: &commandspb.SubmitSpreadOrder{
+				Legs: legs(),
+				Size: 10,
+			},
+			errStr: "submit_spread_order.max_net_price (is required)",
+		},
+		{
+			submission: &commandspb.SubmitSpreadOrder{
+				Legs:        legs(),
+				Size:        10,
+				MaxNetPrice: "1.5",
+			},
+			errStr: "submit_spread_order.max_net_price (not a valid integer)",
+		},
+		{
+			submission: &commandspb.SubmitSpreadOrder{
+				Legs:        legs()[:1],
+				Size:        10,
+				MaxNetPrice: "150",
+			},
+			errStr: "submit_spread_order.legs (must have at least 2 legs)",
+		},
+		{
+			submission: &commandspb.SubmitSpreadOrder{
+				Legs: []*commandspb.SpreadOrderLeg{
+					{MarketId: quarterly, Side: types.Side_SIDE_BUY, Ratio: 1},
+					{MarketId: quarterly, Side: types.Side_SIDE_SELL, Ratio: 1},
+				},
+				Size:        10,
+				MaxNetPrice: "150",
+			},
+			errStr: "submit_spread_order.legs.1.market_id (is duplicated)",
+		},
+		{
+			submission: &commandspb.SubmitSpreadOrder{
+				Legs: []*commandspb.SpreadOrderLeg{
+					{MarketId: quarterly, Side: types.Side_SIDE_BUY, Ratio: 1},
+					{MarketId: "notavalidmarketid", Side: types.Side_SIDE_SELL, Ratio: 1},
+				},
+				Size:        10,
+				MaxNetPrice: "150",
+			},
+			errStr: "submit_spread_order.legs.1.market_id (should be a valid Vega ID)",
+		},
+		{
+			submission: &commandspb.SubmitSpreadOrder{
+				Legs: []*commandspb.SpreadOrderLeg{
+					{MarketId: quarterly, Ratio: 1},
+					{MarketId: perp, Side: types.Side_SIDE_SELL, Ratio: 1},
+				},
+				Size:        10,
+				MaxNetPrice: "150",
+			},
+			errStr: "submit_spread_order.legs.0.side (is required)",
+		},
+		{
+			submission: &commandspb.SubmitSpreadOrder{
+				Legs: []*commandspb.SpreadOrderLeg{
+					{MarketId: quarterly, Side: types.Side_SIDE_BUY},
+					{MarketId: perp, Side: types.Side_SIDE_SELL, Ratio: 1},
+				},
+				Size:        10,
+				MaxNetPrice: "150",
+			},
+			errStr: "submit_spread_order.legs.0.ratio (must be positive)",
+		},
+		{
+			submission: &commandspb.SubmitSpreadOrder{
+				Legs: []*commandspb.SpreadOrderLeg{
+					{MarketId: quarterly, Side: types.Side_SIDE_BUY, Ratio: math.MaxInt64},
+					{MarketId: perp, Side: types.Side_SIDE_SELL, Ratio: 1},
+				},
+				Size:        10,
+				MaxNetPrice: "150",
+			},
+			errStr: "submit_spread_order.legs.0.ratio (size is too large)",
+		},
+	}
+
+	for n, c := range cases {
+		if len(c.errStr) <= 0 {
+			assert.NoError(t, commands.CheckSubmitSpreadOrder(c.submission), n)
+			continue
+		}
+
+		assert.Contains(t, checkSubmitSpreadOrder(c.submission).Error(), c.errStr, n)
+	}
+}
+
+func checkSubmitSpreadOrder(cmd *commandspb.SubmitSpreadOrder) commands.Errors {
+	err := commands.CheckSubmitSpreadOrder(cmd)
+
+	var e commands.Errors
+	if ok := errors.As(err, &e); !ok {
+		return commands.NewErrors()
+	}
+
+	return e
+}
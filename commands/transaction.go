@@ -264,6 +264,24 @@ func CheckInputData(rawInputData []byte) (*commandspb.InputData, Errors) {
 			errs.Merge(checkAmendAMM(cmd.AmendAmm))
 		case *commandspb.InputData_CancelAmm:
 			errs.Merge(checkCancelAMM(cmd.CancelAmm))
+		case *commandspb.InputData_UpdateMarketMakerProtection:
+			errs.Merge(checkUpdateMarketMakerProtection(cmd.UpdateMarketMakerProtection))
+		case *commandspb.InputData_CancelOnTimeout:
+			errs.Merge(checkCancelOnTimeout(cmd.CancelOnTimeout))
+		case *commandspb.InputData_SubmitSpreadOrder:
+			errs.Merge(checkSubmitSpreadOrder(cmd.SubmitSpreadOrder))
+		case *commandspb.InputData_SubmitQuoteRequest:
+			errs.Merge(checkSubmitQuoteRequest(cmd.SubmitQuoteRequest))
+		case *commandspb.InputData_SubmitQuote:
+			errs.Merge(checkSubmitQuote(cmd.SubmitQuote))
+		case *commandspb.InputData_AcceptQuote:
+			errs.Merge(checkAcceptQuote(cmd.AcceptQuote))
+		case *commandspb.InputData_CancelQuoteRequest:
+			errs.Merge(checkCancelQuoteRequest(cmd.CancelQuoteRequest))
+		case *commandspb.InputData_SubmitCompleteSet:
+			errs.Merge(checkSubmitCompleteSet(cmd.SubmitCompleteSet))
+		case *commandspb.InputData_UpdateGovernanceDelegation:
+			errs.Merge(checkUpdateGovernanceDelegation(cmd.UpdateGovernanceDelegation))
 		case *commandspb.InputData_DelayedTransactionsWrapper:
 			break
 		default:
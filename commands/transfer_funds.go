@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	"code.vegaprotocol.io/vega/libs/num"
 	"code.vegaprotocol.io/vega/protos/vega"
@@ -27,6 +28,7 @@ import (
 
 var (
 	ErrMustBeAfterStartEpoch = errors.New("must be after start_epoch")
+	ErrMustBeAfterStartTime  = errors.New("must be after start_time")
 	ErrUnknownAsset          = errors.New("unknown asset")
 )
 
@@ -166,6 +168,49 @@ func checkTransfer(cmd *commandspb.Transfer) (e Errors) {
 			if k.Recurring.DispatchStrategy != nil {
 				validateDispatchStrategy(cmd.ToAccountType, k.Recurring.DispatchStrategy, errs, "transfer.kind.dispatch_strategy", "transfer.account.to")
 			}
+		case *commandspb.Transfer_Streaming:
+			if cmd.FromAccountType != vega.AccountType_ACCOUNT_TYPE_GENERAL {
+				errs.AddForProperty("transfer.from_account_type", errors.New("account type is not valid for streaming transfer"))
+			}
+			if cmd.ToAccountType != vega.AccountType_ACCOUNT_TYPE_GLOBAL_REWARD && cmd.ToAccountType != vega.AccountType_ACCOUNT_TYPE_GENERAL && cmd.ToAccountType != vega.AccountType_ACCOUNT_TYPE_NETWORK_TREASURY {
+				errs.AddForProperty("transfer.to_account_type", errors.New("account type is not valid for streaming transfer"))
+			}
+			if k.Streaming == nil {
+				errs.AddForProperty("transfer.kind.streaming", ErrIsRequired)
+				break
+			}
+			if k.Streaming.StartTime <= 0 {
+				errs.AddForProperty("transfer.kind.start_time", ErrMustBePositive)
+			}
+			if k.Streaming.EndTime <= k.Streaming.StartTime {
+				errs.AddForProperty("transfer.kind.end_time", ErrMustBeAfterStartTime)
+			}
+			if k.Streaming.ReleaseInterval != nil {
+				if *k.Streaming.ReleaseInterval <= 0 {
+					errs.AddForProperty("transfer.kind.release_interval", ErrMustBePositive)
+				} else if k.Streaming.EndTime > k.Streaming.StartTime && time.Duration(*k.Streaming.ReleaseInterval)*time.Second > time.Duration(k.Streaming.EndTime-k.Streaming.StartTime) {
+					errs.AddForProperty("transfer.kind.release_interval", errors.New("must not be greater than the streaming duration"))
+				}
+			}
+			if _, ok := vega.StreamingReleaseCurve_name[int32(k.Streaming.ReleaseCurve)]; !ok {
+				errs.AddForProperty("transfer.kind.release_curve", ErrIsNotValid)
+			}
+
+		case *commandspb.Transfer_Conditional:
+			if cmd.FromAccountType != vega.AccountType_ACCOUNT_TYPE_GENERAL {
+				errs.AddForProperty("transfer.from_account_type", errors.New("account type is not valid for conditional transfer"))
+			}
+			if cmd.ToAccountType != vega.AccountType_ACCOUNT_TYPE_GLOBAL_REWARD && cmd.ToAccountType != vega.AccountType_ACCOUNT_TYPE_GENERAL && cmd.ToAccountType != vega.AccountType_ACCOUNT_TYPE_NETWORK_TREASURY {
+				errs.AddForProperty("transfer.to_account_type", errors.New("account type is not valid for conditional transfer"))
+			}
+			if k.Conditional == nil {
+				errs.AddForProperty("transfer.kind.conditional", ErrIsRequired)
+				break
+			}
+			if k.Conditional.Timeout <= 0 {
+				errs.AddForProperty("transfer.kind.timeout", ErrMustBePositive)
+			}
+			errs.Merge(checkDataSourceSpec(k.Conditional.ReleaseCondition, "release_condition", "transfer.kind", false))
 
 		default:
 			errs.AddForProperty("transfer.kind", ErrIsNotSupported)
@@ -23,6 +23,7 @@ import (
 	"code.vegaprotocol.io/vega/libs/ptr"
 	"code.vegaprotocol.io/vega/protos/vega"
 	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+	datapb "code.vegaprotocol.io/vega/protos/vega/data/v1"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -1441,6 +1442,250 @@ func TestTransferFunds(t *testing.T) {
 				Reference: "testing",
 			},
 		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				Kind: &commandspb.Transfer_Streaming{
+					Streaming: &commandspb.StreamingTransfer{
+						StartTime:       1_000_000_000,
+						EndTime:         61_000_000_000,
+						ReleaseInterval: ptr.From(int64(10)),
+						ReleaseCurve:    vega.StreamingReleaseCurve_STREAMING_RELEASE_CURVE_EASE_IN,
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_VESTED_REWARDS,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				Kind: &commandspb.Transfer_Streaming{
+					Streaming: &commandspb.StreamingTransfer{
+						StartTime: 1_000_000_000,
+						EndTime:   61_000_000_000,
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+			errString: "transfer.from_account_type (account type is not valid for streaming transfer)",
+		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_REWARD_MAKER_PAID_FEES,
+				Kind: &commandspb.Transfer_Streaming{
+					Streaming: &commandspb.StreamingTransfer{
+						StartTime: 1_000_000_000,
+						EndTime:   61_000_000_000,
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+			errString: "transfer.to_account_type (account type is not valid for streaming transfer)",
+		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				Kind: &commandspb.Transfer_Streaming{
+					Streaming: &commandspb.StreamingTransfer{
+						EndTime: 61_000_000_000,
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+			errString: "transfer.kind.start_time (must be positive)",
+		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				Kind: &commandspb.Transfer_Streaming{
+					Streaming: &commandspb.StreamingTransfer{
+						StartTime: 61_000_000_000,
+						EndTime:   1_000_000_000,
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+			errString: "transfer.kind.end_time (must be after start_time)",
+		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				Kind: &commandspb.Transfer_Streaming{
+					Streaming: &commandspb.StreamingTransfer{
+						StartTime:       1_000_000_000,
+						EndTime:         61_000_000_000,
+						ReleaseInterval: ptr.From(int64(0)),
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+			errString: "transfer.kind.release_interval (must be positive)",
+		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				Kind: &commandspb.Transfer_Streaming{
+					Streaming: &commandspb.StreamingTransfer{
+						StartTime:       1_000_000_000,
+						EndTime:         61_000_000_000,
+						ReleaseInterval: ptr.From(int64(61)),
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+			errString: "transfer.kind.release_interval (must not be greater than the streaming duration)",
+		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				Kind: &commandspb.Transfer_Streaming{
+					Streaming: &commandspb.StreamingTransfer{
+						StartTime:    1_000_000_000,
+						EndTime:      61_000_000_000,
+						ReleaseCurve: vega.StreamingReleaseCurve(42),
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+			errString: "transfer.kind.release_curve (is not a valid value)",
+		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				Kind: &commandspb.Transfer_Conditional{
+					Conditional: &commandspb.ConditionalTransfer{
+						ReleaseCondition: conditionalTransferReleaseCondition(),
+						Timeout:          61_000_000_000,
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_VESTED_REWARDS,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				Kind: &commandspb.Transfer_Conditional{
+					Conditional: &commandspb.ConditionalTransfer{
+						ReleaseCondition: conditionalTransferReleaseCondition(),
+						Timeout:          61_000_000_000,
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+			errString: "transfer.from_account_type (account type is not valid for conditional transfer)",
+		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_REWARD_MAKER_PAID_FEES,
+				Kind: &commandspb.Transfer_Conditional{
+					Conditional: &commandspb.ConditionalTransfer{
+						ReleaseCondition: conditionalTransferReleaseCondition(),
+						Timeout:          61_000_000_000,
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+			errString: "transfer.to_account_type (account type is not valid for conditional transfer)",
+		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				Kind: &commandspb.Transfer_Conditional{
+					Conditional: &commandspb.ConditionalTransfer{
+						ReleaseCondition: conditionalTransferReleaseCondition(),
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+			errString: "transfer.kind.timeout (must be positive)",
+		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				Kind: &commandspb.Transfer_Conditional{
+					Conditional: &commandspb.ConditionalTransfer{
+						Timeout: 61_000_000_000,
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+			errString: "transfer.kind.release_condition (is required)",
+		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				Kind: &commandspb.Transfer_Conditional{
+					Conditional: &commandspb.ConditionalTransfer{
+						ReleaseCondition: vega.NewDataSourceDefinition(
+							vega.DataSourceContentTypeOracle,
+						).SetOracleConfig(
+							&vega.DataSourceDefinitionExternal_Oracle{
+								Oracle: &vega.DataSourceSpecConfiguration{},
+							},
+						),
+						Timeout: 61_000_000_000,
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+			errString: "transfer.kind.release_condition.external.oracle.signers (is required)",
+		},
 	}
 
 	invalidAccountTypesForOneOff := []vega.AccountType{
@@ -1495,6 +1740,40 @@ func TestTransferFunds(t *testing.T) {
 	}
 }
 
+func conditionalTransferReleaseCondition() *vega.DataSourceDefinition {
+	return vega.NewDataSourceDefinition(
+		vega.DataSourceContentTypeOracle,
+	).SetOracleConfig(
+		&vega.DataSourceDefinitionExternal_Oracle{
+			Oracle: &vega.DataSourceSpecConfiguration{
+				Signers: []*datapb.Signer{
+					{
+						Signer: &datapb.Signer_PubKey{
+							PubKey: &datapb.PubKey{
+								Key: "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+							},
+						},
+					},
+				},
+				Filters: []*datapb.Filter{
+					{
+						Key: &datapb.PropertyKey{
+							Name: "audit.report.published",
+							Type: datapb.PropertyKey_TYPE_BOOLEAN,
+						},
+						Conditions: []*datapb.Condition{
+							{
+								Operator: datapb.Condition_OPERATOR_EQUALS,
+								Value:    "true",
+							},
+						},
+					},
+				},
+			},
+		},
+	)
+}
+
 func checkTransfer(cmd *commandspb.Transfer) commands.Errors {
 	err := commands.CheckTransfer(cmd)
 
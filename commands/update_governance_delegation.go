@@ -0,0 +1,48 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package commands
+
+import (
+	"code.vegaprotocol.io/vega/protos/vega"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+)
+
+func CheckUpdateGovernanceDelegation(cmd *commandspb.UpdateGovernanceDelegation) error {
+	return checkUpdateGovernanceDelegation(cmd).ErrorOrNil()
+}
+
+func checkUpdateGovernanceDelegation(cmd *commandspb.UpdateGovernanceDelegation) Errors {
+	errs := NewErrors()
+
+	if cmd == nil {
+		return errs.FinalAddForProperty("update_governance_delegation", ErrIsRequired)
+	}
+
+	// an empty delegate revokes the delegation
+	if len(cmd.Delegate) > 0 && !IsVegaPublicKey(cmd.Delegate) {
+		errs.AddForProperty("update_governance_delegation.delegate", ErrShouldBeAValidVegaPublicKey)
+	}
+
+	if cmd.ProposalType != nil {
+		if *cmd.ProposalType == vega.ProposalType_PROPOSAL_TYPE_UNSPECIFIED {
+			errs.AddForProperty("update_governance_delegation.proposal_type", ErrIsRequired)
+		} else if _, ok := vega.ProposalType_name[int32(*cmd.ProposalType)]; !ok {
+			errs.AddForProperty("update_governance_delegation.proposal_type", ErrIsNotValid)
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package commands_test
+
+import (
+	"testing"
+
+	"code.vegaprotocol.io/vega/commands"
+	"code.vegaprotocol.io/vega/libs/ptr"
+	"code.vegaprotocol.io/vega/protos/vega"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckUpdateGovernanceDelegation(t *testing.T) {
+	delegate := "e9982447fb4128f9968f9981612c5ea85d19b62058ec2636efc812dcbbc745ca"
+
+	cases := []struct {
+		submission *commandspb.UpdateGovernanceDelegation
+		errStr     string
+	}{
+		{
+			submission: &commandspb.UpdateGovernanceDelegation{
+				Delegate: delegate,
+			},
+		},
+		{
+			submission: &commandspb.UpdateGovernanceDelegation{
+				Delegate:     delegate,
+				ProposalType: ptr.From(vega.ProposalType_PROPOSAL_TYPE_UPDATE_MARKET),
+			},
+		},
+		{
+			// revoking a delegation
+			submission: &commandspb.UpdateGovernanceDelegation{
+				ProposalType: ptr.From(vega.ProposalType_PROPOSAL_TYPE_NEW_ASSET),
+			},
+		},
+		{
+			submission: &commandspb.UpdateGovernanceDelegation{
+				Delegate: "not-a-key",
+			},
+			errStr: "update_governance_delegation.delegate (should be a valid vega public key)",
+		},
+		{
+			submission: &commandspb.UpdateGovernanceDelegation{
+				Delegate:     delegate,
+				ProposalType: ptr.From(vega.ProposalType_PROPOSAL_TYPE_UNSPECIFIED),
+			},
+			errStr: "update_governance_delegation.proposal_type (is required)",
+		},
+		{
+			submission: &commandspb.UpdateGovernanceDelegation{
+				Delegate:     delegate,
+				ProposalType: ptr.From(vega.ProposalType(42)),
+			},
+			errStr: "update_governance_delegation.proposal_type (is not a valid value)",
+		},
+	}
+
+	for n, c := range cases {
+		err := commands.CheckUpdateGovernanceDelegation(c.submission)
+		if len(c.errStr) == 0 {
+			assert.NoError(t, err, n)
+			continue
+		}
+		assert.Contains(t, err.Error(), c.errStr, n)
+	}
+	assert.Contains(t, commands.CheckUpdateGovernanceDelegation(nil).Error(), "update_governance_delegation (is required)")
+}
@@ -45,6 +45,10 @@ func checkUpdateMarginMode(cmd *commandspb.UpdateMarginMode) Errors {
 		errs.AddForProperty("update_margin_mode.margin_factor", fmt.Errorf("margin factor must not be defined when margin mode is cross margin"))
 	}
 
+	if cmd.Mode == commandspb.UpdateMarginMode_MODE_ISOLATED_MARGIN && cmd.PortfolioMargin != nil && *cmd.PortfolioMargin {
+		errs.AddForProperty("update_margin_mode.portfolio_margin", fmt.Errorf("portfolio margin can only be enabled when margin mode is cross margin"))
+	}
+
 	if cmd.Mode == commandspb.UpdateMarginMode_MODE_ISOLATED_MARGIN && (cmd.MarginFactor == nil || len(*cmd.MarginFactor) == 0) {
 		errs.AddForProperty("update_margin_mode.margin_factor", fmt.Errorf("margin factor must be defined when margin mode is isolated margin"))
 	}
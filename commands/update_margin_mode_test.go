@@ -35,6 +35,7 @@ func TestUpdateMarginMode(t *testing.T) {
 	largeMarginFactor101 := "1.01"
 	validMarginFactor1 := "1"
 	validMarginFactorHalf := "0.5"
+	portfolioMargin := true
 
 	txs := []struct {
 		name    string
@@ -137,6 +138,27 @@ func TestUpdateMarginMode(t *testing.T) {
 			"",
 			nil,
 		},
+		{
+			"isolated margin mode with portfolio margin",
+			&commandspb.UpdateMarginMode{
+				Mode:            commandspb.UpdateMarginMode_MODE_ISOLATED_MARGIN,
+				MarketId:        "123",
+				MarginFactor:    &validMarginFactorHalf,
+				PortfolioMargin: &portfolioMargin,
+			},
+			"update_margin_mode.portfolio_margin",
+			fmt.Errorf("portfolio margin can only be enabled when margin mode is cross margin"),
+		},
+		{
+			"valid cross margin update with portfolio margin",
+			&commandspb.UpdateMarginMode{
+				Mode:            commandspb.UpdateMarginMode_MODE_CROSS_MARGIN,
+				MarketId:        "123",
+				PortfolioMargin: &portfolioMargin,
+			},
+			"",
+			nil,
+		},
 		{
 			"valid isolated margin update",
 			&commandspb.UpdateMarginMode{
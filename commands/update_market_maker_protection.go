@@ -0,0 +1,70 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package commands
+
+import (
+	"code.vegaprotocol.io/vega/libs/num"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+)
+
+func CheckUpdateMarketMakerProtection(cmd *commandspb.UpdateMarketMakerProtection) error {
+	return checkUpdateMarketMakerProtection(cmd).ErrorOrNil()
+}
+
+func checkUpdateMarketMakerProtection(cmd *commandspb.UpdateMarketMakerProtection) Errors {
+	errs := NewErrors()
+
+	if cmd == nil {
+		return errs.FinalAddForProperty("update_market_maker_protection", ErrIsRequired)
+	}
+
+	if len(cmd.MarketId) <= 0 {
+		errs.AddForProperty("update_market_maker_protection.market_id", ErrIsRequired)
+	} else if !IsVegaID(cmd.MarketId) {
+		errs.AddForProperty("update_market_maker_protection.market_id", ErrShouldBeAValidVegaID)
+	}
+
+	// no limits means the protection is being removed, so there is nothing else to check
+	if cmd.MaxFilledVolume == nil && cmd.MaxFilledDelta == nil && cmd.MaxFilledNotional == nil {
+		return errs
+	}
+
+	if cmd.WindowDuration <= 0 {
+		errs.AddForProperty("update_market_maker_protection.window_duration", ErrMustBePositive)
+	}
+
+	if cmd.FreezeDuration < 0 {
+		errs.AddForProperty("update_market_maker_protection.freeze_duration", ErrMustBePositiveOrZero)
+	}
+
+	if cmd.MaxFilledVolume != nil && *cmd.MaxFilledVolume == 0 {
+		errs.AddForProperty("update_market_maker_protection.max_filled_volume", ErrMustBePositive)
+	}
+
+	if cmd.MaxFilledDelta != nil && *cmd.MaxFilledDelta == 0 {
+		errs.AddForProperty("update_market_maker_protection.max_filled_delta", ErrMustBePositive)
+	}
+
+	if cmd.MaxFilledNotional != nil {
+		if notional, overflow := num.UintFromString(*cmd.MaxFilledNotional, 10); overflow {
+			errs.AddForProperty("update_market_maker_protection.max_filled_notional", ErrIsNotValidNumber)
+		} else if notional.IsZero() {
+			errs.AddForProperty("update_market_maker_protection.max_filled_notional", ErrMustBePositive)
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package commands_test
+
+import (
+	"errors"
+	"testing"
+
+	"code.vegaprotocol.io/vega/commands"
+	"code.vegaprotocol.io/vega/libs/ptr"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckUpdateMarketMakerProtection(t *testing.T) {
+	marketID := "e9982447fb4128f9968f9981612c5ea85d19b62058ec2636efc812dcbbc745ca"
+	cases := []struct {
+		submission *commandspb.UpdateMarketMakerProtection
+		errStr     string
+	}{
+		{
+			submission: &commandspb.UpdateMarketMakerProtection{},
+			errStr:     "update_market_maker_protection.market_id (is required)",
+		},
+		{
+			submission: &commandspb.UpdateMarketMakerProtection{
+				MarketId: "notavalidmarketid",
+			},
+			errStr: "update_market_maker_protection.market_id (should be a valid Vega ID)",
+		},
+		{
+			// no limits removes the protection
+			submission: &commandspb.UpdateMarketMakerProtection{
+				MarketId: marketID,
+			},
+		},
+		{
+			submission: &commandspb.UpdateMarketMakerProtection{
+				MarketId:        marketID,
+				WindowDuration:  10,
+				FreezeDuration:  30,
+				MaxFilledVolume: ptr.From(uint64(100)),
+				MaxFilledDelta:  ptr.From(uint64(50)),
+			},
+		},
+		{
+			submission: &commandspb.UpdateMarketMakerProtection{
+				MarketId:        marketID,
+				MaxFilledVolume: ptr.From(uint64(100)),
+			},
+			errStr: "update_market_maker_protection.window_duration (must be positive)",
+		},
+		{
+			submission: &commandspb.UpdateMarketMakerProtection{
+				MarketId:        marketID,
+				WindowDuration:  10,
+				FreezeDuration:  -1,
+				MaxFilledVolume: ptr.From(uint64(100)),
+			},
+			errStr: "update_market_maker_protection.freeze_duration (must be positive or zero)",
+		},
+		{
+			submission: &commandspb.UpdateMarketMakerProtection{
+				MarketId:        marketID,
+				WindowDuration:  10,
+				MaxFilledVolume: ptr.From(uint64(0)),
+			},
+			errStr: "update_market_maker_protection.max_filled_volume (must be positive)",
+		},
+		{
+			submission: &commandspb.UpdateMarketMakerProtection{
+				MarketId:       marketID,
+				WindowDuration: 10,
+				MaxFilledDelta: ptr.From(uint64(0)),
+			},
+			errStr: "update_market_maker_protection.max_filled_delta (must be positive)",
+		},
+		{
+			submission: &commandspb.UpdateMarketMakerProtection{
+				MarketId:          marketID,
+				WindowDuration:    10,
+				MaxFilledNotional: ptr.From("abc"),
+			},
+			errStr: "update_market_maker_protection.max_filled_notional (is not a valid number)",
+		},
+		{
+			submission: &commandspb.UpdateMarketMakerProtection{
+				MarketId:          marketID,
+				WindowDuration:    10,
+				MaxFilledNotional: ptr.From("0"),
+			},
+			errStr: "update_market_maker_protection.max_filled_notional (must be positive)",
+		},
+	}
+
+	for n, c := range cases {
+		if len(c.errStr) <= 0 {
+			assert.NoError(t, commands.CheckUpdateMarketMakerProtection(c.submission), n)
+			continue
+		}
+
+		assert.Contains(t, checkUpdateMarketMakerProtection(c.submission).Error(), c.errStr, n)
+	}
+}
+
+func checkUpdateMarketMakerProtection(cmd *commandspb.UpdateMarketMakerProtection) commands.Errors {
+	err := commands.CheckUpdateMarketMakerProtection(cmd)
+
+	var e commands.Errors
+	if ok := errors.As(err, &e); !ok {
+		return commands.NewErrors()
+	}
+
+	return e
+}
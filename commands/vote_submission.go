@@ -16,6 +16,8 @@
 package commands
 
 import (
+	"fmt"
+
 	types "code.vegaprotocol.io/vega/protos/vega"
 	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
 )
@@ -45,5 +47,24 @@ func checkVoteSubmission(cmd *commandspb.VoteSubmission) Errors {
 		errs.AddForProperty("vote_submission.value", ErrIsNotValid)
 	}
 
+	if len(cmd.Options) > 0 {
+		if cmd.Value != types.Vote_VALUE_YES {
+			errs.AddForProperty("vote_submission.options", ErrCanOnlyBeSetOnYesVote)
+		}
+		if len(cmd.Options) > MaxPollOptions {
+			errs.AddForProperty("vote_submission.options", ErrIsLimitedTo32Entries)
+		}
+		options := make(map[uint32]struct{}, len(cmd.Options))
+		for i, option := range cmd.Options {
+			if option >= MaxPollOptions {
+				errs.AddForProperty(fmt.Sprintf("vote_submission.options.%d", i), ErrIsNotValid)
+			}
+			if _, ok := options[option]; ok {
+				errs.AddForProperty(fmt.Sprintf("vote_submission.options.%d", i), ErrIsDuplicated)
+			}
+			options[option] = struct{}{}
+		}
+	}
+
 	return errs
 }
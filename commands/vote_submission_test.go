@@ -66,6 +66,37 @@ func TestVoteSubmission(t *testing.T) {
 			vote:      commandspb.VoteSubmission{},
 			errString: "vote_submission.proposal_id (is required), vote_submission.value (is required)",
 		},
+		{
+			vote: commandspb.VoteSubmission{
+				Value:      types.Vote_VALUE_YES,
+				ProposalId: "08dce6ebf50e34fedee32860b6f459824e4b834762ea66a96504fdc57a9c4741",
+				Options:    []uint32{2, 0, 1},
+			},
+		},
+		{
+			vote: commandspb.VoteSubmission{
+				Value:      types.Vote_VALUE_NO,
+				ProposalId: "08dce6ebf50e34fedee32860b6f459824e4b834762ea66a96504fdc57a9c4741",
+				Options:    []uint32{0},
+			},
+			errString: "vote_submission.options (can only be set on a yes vote)",
+		},
+		{
+			vote: commandspb.VoteSubmission{
+				Value:      types.Vote_VALUE_YES,
+				ProposalId: "08dce6ebf50e34fedee32860b6f459824e4b834762ea66a96504fdc57a9c4741",
+				Options:    []uint32{1, 1},
+			},
+			errString: "vote_submission.options.1 (is duplicated)",
+		},
+		{
+			vote: commandspb.VoteSubmission{
+				Value:      types.Vote_VALUE_YES,
+				ProposalId: "08dce6ebf50e34fedee32860b6f459824e4b834762ea66a96504fdc57a9c4741",
+				Options:    []uint32{commands.MaxPollOptions},
+			},
+			errString: "vote_submission.options.0 (is not a valid value)",
+		},
 	}
 
 	for _, c := range cases {
@@ -33,6 +33,15 @@ func (e *Engine) CancelTransferFunds(
 	ctx context.Context,
 	cancel *types.CancelTransferFunds,
 ) error {
+	if streaming, ok := e.streamingTransfersMap[cancel.TransferID]; ok {
+		return e.cancelStreamingTransfer(ctx, streaming, cancel.Party)
+	}
+
+	// escrowed funds are only released by the condition or the timeout
+	if _, ok := e.conditionalTransfersMap[cancel.TransferID]; ok {
+		return ErrCannotCancelConditionalTransfers
+	}
+
 	// validation is simple, does the transfer
 	// exists
 	transfer, ok := e.recurringTransfersMap[cancel.TransferID]
@@ -287,6 +287,38 @@ func (e *Engine) loadRecurringTransfers(
 		}
 		evts = append(evts, events.NewRecurringTransferFundsEvent(ctx, transfer, e.getGameID(transfer)))
 	}
+	evts = append(evts, e.loadStreamingTransfers(ctx, r.StreamingTransfers)...)
+	evts = append(evts, e.loadConditionalTransfers(ctx, r.ConditionalTransfers)...)
+	return evts
+}
+
+func (e *Engine) loadStreamingTransfers(ctx context.Context, transfers []*eventspb.Transfer) []events.Event {
+	evts := make([]events.Event, 0, len(transfers))
+	for _, v := range transfers {
+		transfer := types.StreamingTransferFromEvent(v)
+		e.streamingTransfers = append(e.streamingTransfers, transfer)
+		e.streamingTransfersMap[transfer.ID] = transfer
+		evts = append(evts, events.NewStreamingTransferFundsEvent(ctx, transfer))
+	}
+	return evts
+}
+
+func (e *Engine) loadConditionalTransfers(ctx context.Context, transfers []*eventspb.Transfer) []events.Event {
+	evts := make([]events.Event, 0, len(transfers))
+	for _, v := range transfers {
+		transfer := types.ConditionalTransferFromEvent(v)
+		// subscriptions are not part of the state, start listening to the
+		// release condition again, it was valid when the transfer was accepted.
+		if err := e.subscribeConditionalTransfer(ctx, transfer); err != nil {
+			e.log.Panic("could not subscribe to conditional transfer release condition",
+				logging.String("transfer-id", transfer.ID),
+				logging.Error(err),
+			)
+		}
+		e.conditionalTransfers = append(e.conditionalTransfers, transfer)
+		e.conditionalTransfersMap[transfer.ID] = transfer
+		evts = append(evts, events.NewConditionalTransferFundsEvent(ctx, transfer))
+	}
 	return evts
 }
 
@@ -329,7 +361,9 @@ func (e *Engine) getSecondaryBridgeState() *checkpoint.BridgeState {
 
 func (e *Engine) getRecurringTransfers() *checkpoint.RecurringTransfers {
 	out := &checkpoint.RecurringTransfers{
-		RecurringTransfers: make([]*eventspb.Transfer, 0, len(e.recurringTransfers)),
+		RecurringTransfers:   make([]*eventspb.Transfer, 0, len(e.recurringTransfers)),
+		StreamingTransfers:   e.getStreamingTransfers(),
+		ConditionalTransfers: e.getConditionalTransfers(),
 	}
 
 	for _, v := range e.recurringTransfers {
@@ -339,6 +373,22 @@ func (e *Engine) getRecurringTransfers() *checkpoint.RecurringTransfers {
 	return out
 }
 
+func (e *Engine) getStreamingTransfers() []*eventspb.Transfer {
+	out := make([]*eventspb.Transfer, 0, len(e.streamingTransfers))
+	for _, v := range e.streamingTransfers {
+		out = append(out, v.IntoEvent(nil))
+	}
+	return out
+}
+
+func (e *Engine) getConditionalTransfers() []*eventspb.Transfer {
+	out := make([]*eventspb.Transfer, 0, len(e.conditionalTransfers))
+	for _, v := range e.conditionalTransfers {
+		out = append(out, v.IntoEvent(nil))
+	}
+	return out
+}
+
 func (e *Engine) getRecurringGovernanceTransfers() []*checkpoint.GovernanceTransfer {
 	out := make([]*checkpoint.GovernanceTransfer, 0, len(e.recurringGovernanceTransfers))
 	for _, v := range e.recurringGovernanceTransfers {
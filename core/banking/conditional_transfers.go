@@ -0,0 +1,217 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package banking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	dscommon "code.vegaprotocol.io/vega/core/datasource/common"
+	"code.vegaprotocol.io/vega/core/datasource/spec"
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/logging"
+)
+
+var (
+	ErrTimeoutInThePast                 = errors.New("timeout in the past")
+	ErrCannotCancelConditionalTransfers = errors.New("cannot cancel conditional transfers")
+)
+
+func (e *Engine) conditionalTransfer(
+	ctx context.Context,
+	transfer *types.ConditionalTransfer,
+) (err error) {
+	defer func() {
+		if err != nil {
+			e.broker.Send(events.NewConditionalTransferFundsEventWithReason(ctx, transfer, err.Error()))
+		} else {
+			e.broker.Send(events.NewConditionalTransferFundsEvent(ctx, transfer))
+		}
+	}()
+
+	// ensure asset exists
+	a, err := e.assets.Get(transfer.Asset)
+	if err != nil {
+		transfer.Status = types.TransferStatusRejected
+		e.log.Debug("cannot transfer funds, invalid asset", logging.Error(err))
+		return fmt.Errorf("could not transfer funds: %w", err)
+	}
+
+	if err := transfer.IsValid(); err != nil {
+		transfer.Status = types.TransferStatusRejected
+		return err
+	}
+
+	if err := e.ensureMinimalTransferAmount(a, transfer.Amount, transfer.FromAccountType, transfer.From, transfer.FromDerivedKey); err != nil {
+		transfer.Status = types.TransferStatusRejected
+		return err
+	}
+
+	if !transfer.Timeout.After(transfer.Timestamp) {
+		transfer.Status = types.TransferStatusRejected
+		return ErrTimeoutInThePast
+	}
+
+	if err := e.subscribeConditionalTransfer(ctx, transfer); err != nil {
+		transfer.Status = types.TransferStatusRejected
+		return err
+	}
+
+	tresps, err := e.escrowTransfer(ctx, a, transfer.TransferBase)
+	if err != nil {
+		e.unsubscribeConditionalTransfer(ctx, transfer.ID)
+		transfer.Status = types.TransferStatusRejected
+		return err
+	}
+
+	// the full amount is now held in the pending transfers
+	// account until the condition is met or the transfer times out
+	e.conditionalTransfers = append(e.conditionalTransfers, transfer)
+	e.conditionalTransfersMap[transfer.ID] = transfer
+	e.broker.Send(events.NewLedgerMovements(ctx, tresps))
+
+	return nil
+}
+
+// subscribeConditionalTransfer starts listening to the release
+// condition of the transfer.
+func (e *Engine) subscribeConditionalTransfer(
+	ctx context.Context,
+	transfer *types.ConditionalTransfer,
+) error {
+	releaseCondition, err := spec.New(*transfer.ReleaseCondition)
+	if err != nil {
+		return fmt.Errorf("invalid release condition: %w", err)
+	}
+
+	transferID := transfer.ID
+	subscriptionID, _, err := e.oracle.Subscribe(ctx, *releaseCondition, func(ctx context.Context, _ dscommon.Data) error {
+		return e.releaseConditionalTransfer(ctx, transferID)
+	})
+	if err != nil {
+		return fmt.Errorf("could not subscribe to release condition: %w", err)
+	}
+
+	e.conditionalSubscriptions[transferID] = subscriptionID
+	return nil
+}
+
+func (e *Engine) unsubscribeConditionalTransfer(ctx context.Context, transferID string) {
+	if subscriptionID, ok := e.conditionalSubscriptions[transferID]; ok {
+		e.oracle.Unsubscribe(ctx, subscriptionID)
+		delete(e.conditionalSubscriptions, transferID)
+	}
+}
+
+// releaseConditionalTransfer is called when the release condition
+// of the transfer is met, the escrowed funds are paid to the receiver.
+func (e *Engine) releaseConditionalTransfer(ctx context.Context, transferID string) error {
+	transfer, ok := e.conditionalTransfersMap[transferID]
+	if !ok {
+		// already released or refunded
+		return nil
+	}
+
+	_, toTransfer := e.makeTransfers(transfer.From, transfer.To, transfer.Asset, "", "", transfer.Amount, &transfer.ID)
+	// no fees required there, they've been paid already
+	tresps, err := e.col.TransferFunds(
+		ctx,
+		[]*types.Transfer{toTransfer},
+		[]types.AccountType{transfer.ToAccountType},
+		[]string{transfer.Reference},
+		nil,
+		nil,
+	)
+	if err != nil {
+		e.log.Error("could not release conditional transfer",
+			logging.String("transfer-id", transfer.ID),
+			logging.Error(err),
+		)
+		return err
+	}
+
+	e.unsubscribeConditionalTransfer(ctx, transfer.ID)
+	e.deleteConditionalTransfer(transfer.ID)
+
+	transfer.Status = types.TransferStatusDone
+	e.broker.Send(events.NewLedgerMovements(ctx, tresps))
+	e.broker.Send(events.NewConditionalTransferFundsEvent(ctx, transfer))
+
+	return nil
+}
+
+// refundTimedOutConditionalTransfers returns the escrowed funds to the
+// party for all the transfers whose release condition hasn't been met in time.
+func (e *Engine) refundTimedOutConditionalTransfers(ctx context.Context, now time.Time) error {
+	var (
+		transfers    = []*types.Transfer{}
+		accountTypes = []types.AccountType{}
+		references   = []string{}
+		evts         = []events.Event{}
+		timedOut     = []*types.ConditionalTransfer{}
+	)
+
+	for _, v := range e.conditionalTransfers {
+		if now.Before(v.Timeout) {
+			continue
+		}
+
+		_, refundTransfer := e.makeTransfers(v.From, v.From, v.Asset, "", "", v.Amount, &v.ID)
+		transfers = append(transfers, refundTransfer)
+		accountTypes = append(accountTypes, v.FromAccountType)
+		references = append(references, v.Reference)
+		timedOut = append(timedOut, v)
+	}
+
+	if len(timedOut) <= 0 {
+		return nil
+	}
+
+	// no fees required there, they've been paid already
+	tresps, err := e.col.TransferFunds(ctx, transfers, accountTypes, references, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range timedOut {
+		e.unsubscribeConditionalTransfer(ctx, v.ID)
+		e.deleteConditionalTransfer(v.ID)
+		v.Status = types.TransferStatusStopped
+		evts = append(evts, events.NewConditionalTransferFundsEventWithReason(ctx, v, "release condition not met before timeout"))
+	}
+
+	e.broker.Send(events.NewLedgerMovements(ctx, tresps))
+	e.broker.SendBatch(evts)
+
+	return nil
+}
+
+func (e *Engine) deleteConditionalTransfer(ID string) {
+	index := -1
+	for i, ct := range e.conditionalTransfers {
+		if ct.ID == ID {
+			index = i
+			break
+		}
+	}
+	if index >= 0 {
+		e.conditionalTransfers = append(e.conditionalTransfers[:index], e.conditionalTransfers[index+1:]...)
+		delete(e.conditionalTransfersMap, ID)
+	}
+}
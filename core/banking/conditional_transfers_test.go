@@ -0,0 +1,287 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package banking_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/assets"
+	"code.vegaprotocol.io/vega/core/banking"
+	dscommon "code.vegaprotocol.io/vega/core/datasource/common"
+	"code.vegaprotocol.io/vega/core/datasource/spec"
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/proto"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+	datapb "code.vegaprotocol.io/vega/protos/vega/data/v1"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionalTransfers(t *testing.T) {
+	t.Run("conditional transfer released when the condition is met", testConditionalTransferReleased)
+	t.Run("conditional transfer refunded on timeout", testConditionalTransferTimeout)
+	t.Run("invalid conditional transfer, timeout in the past", testInvalidConditionalTransferTimeoutInThePast)
+	t.Run("conditional transfer not escrowed is unsubscribed", testConditionalTransferEscrowFailure)
+	t.Run("conditional transfer cannot be cancelled", testConditionalTransferCannotBeCancelled)
+	t.Run("conditional transfer survives checkpoint", testConditionalTransferCheckpoint)
+	t.Run("conditional transfer survives snapshot", testConditionalTransferSnapshot)
+}
+
+func newConditionalTransfer(t *testing.T, id string, timeout time.Time) *types.TransferFunds {
+	t.Helper()
+	tf, err := types.NewTransferFromProto(id, streamFrom, &commandspb.Transfer{
+		FromAccountType: vegapb.AccountType_ACCOUNT_TYPE_GENERAL,
+		To:              streamTo,
+		ToAccountType:   vegapb.AccountType_ACCOUNT_TYPE_GENERAL,
+		Asset:           assetNameETH,
+		Amount:          "1000",
+		Reference:       "bounty",
+		Kind: &commandspb.Transfer_Conditional{
+			Conditional: &commandspb.ConditionalTransfer{
+				ReleaseCondition: vegapb.NewDataSourceDefinition(
+					vegapb.DataSourceContentTypeOracle,
+				).SetOracleConfig(
+					&vegapb.DataSourceDefinitionExternal_Oracle{
+						Oracle: &vegapb.DataSourceSpecConfiguration{
+							Signers: []*datapb.Signer{
+								{
+									Signer: &datapb.Signer_PubKey{
+										PubKey: &datapb.PubKey{Key: "0xCAFEDOOD"},
+									},
+								},
+							},
+							Filters: []*datapb.Filter{
+								{
+									Key: &datapb.PropertyKey{
+										Name: "audit.report.published",
+										Type: datapb.PropertyKey_TYPE_BOOLEAN,
+									},
+									Conditions: []*datapb.Condition{
+										{Operator: datapb.Condition_OPERATOR_EQUALS, Value: "true"},
+									},
+								},
+							},
+						},
+					},
+				),
+				Timeout: timeout.UnixNano(),
+			},
+		},
+	})
+	require.NoError(t, err)
+	return tf
+}
+
+// setupConditionalEngine returns an engine and the transfer events it sends.
+func setupConditionalEngine(t *testing.T) (*testEngine, *[]*eventspb.Transfer) {
+	t.Helper()
+	e := getTestEngine(t)
+	e.assets.EXPECT().Get(gomock.Any()).AnyTimes().Return(assets.NewAsset(&mockAsset{name: assetNameETH, quantum: num.DecimalFromFloat(10)}), nil)
+	e.col.EXPECT().GetPartyGeneralAccount(gomock.Any(), gomock.Any()).AnyTimes().Return(&types.Account{Balance: num.NewUint(100000)}, nil)
+
+	seen := []*eventspb.Transfer{}
+	record := func(evt events.Event) {
+		if evt.Type() == events.TransferEvent {
+			seen = append(seen, evt.StreamMessage().GetTransfer())
+		}
+	}
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes().Do(record)
+	e.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes().Do(func(evts []events.Event) {
+		for _, evt := range evts {
+			record(evt)
+		}
+	})
+	return e, &seen
+}
+
+// expectConditionalSubscription expects a subscription to the release
+// condition and returns the callback registered with the oracle engine.
+func expectConditionalSubscription(e *testEngine, id spec.SubscriptionID) *spec.OnMatchedData {
+	cb := new(spec.OnMatchedData)
+	e.oracle.EXPECT().Subscribe(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, _ spec.Spec, f spec.OnMatchedData) (spec.SubscriptionID, spec.Unsubscriber, error) {
+			*cb = f
+			return id, func(context.Context, spec.SubscriptionID) {}, nil
+		})
+	return cb
+}
+
+func expectConditionalTransfer(t *testing.T, e *testEngine, party string, tpe types.TransferType) {
+	t.Helper()
+	e.col.EXPECT().TransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, transfers []*types.Transfer, accountTypes []types.AccountType, _ []string, feeTransfers []*types.Transfer, _ []types.AccountType) ([]*types.LedgerMovement, error) {
+			require.Len(t, transfers, 1)
+			assert.Equal(t, tpe, transfers[0].Type)
+			assert.Equal(t, party, transfers[0].Owner)
+			assert.Equal(t, "1000", transfers[0].Amount.Amount.String())
+			assert.Equal(t, []types.AccountType{types.AccountTypeGeneral}, accountTypes)
+			if tpe == types.TransferTypeTransferFundsSend {
+				assert.Len(t, feeTransfers, 1)
+			} else {
+				assert.Len(t, feeTransfers, 0)
+			}
+			return nil, nil
+		})
+}
+
+func testConditionalTransferReleased(t *testing.T) {
+	e, seen := setupConditionalEngine(t)
+	ctx := context.Background()
+
+	cb := expectConditionalSubscription(e, 1)
+	expectConditionalTransfer(t, e, streamFrom, types.TransferTypeTransferFundsSend)
+	require.NoError(t, e.TransferFunds(ctx, newConditionalTransfer(t, "bounty", time.Unix(100, 0))))
+	require.Len(t, *seen, 1)
+	assert.Equal(t, types.TransferStatusPending, (*seen)[0].Status)
+	assert.NotNil(t, (*seen)[0].GetConditional().GetReleaseCondition())
+
+	// nothing happens until the condition is met
+	e.OnTick(ctx, time.Unix(50, 0))
+	require.Len(t, *seen, 1)
+
+	expectConditionalTransfer(t, e, streamTo, types.TransferTypeTransferFundsDistribute)
+	e.oracle.EXPECT().Unsubscribe(gomock.Any(), spec.SubscriptionID(1)).Times(1)
+	require.NoError(t, (*cb)(ctx, dscommon.Data{}))
+	require.Len(t, *seen, 2)
+	assert.Equal(t, types.TransferStatusDone, (*seen)[1].Status)
+
+	// the condition firing again, or the timeout, has no effect
+	require.NoError(t, (*cb)(ctx, dscommon.Data{}))
+	e.OnTick(ctx, time.Unix(200, 0))
+}
+
+func testConditionalTransferTimeout(t *testing.T) {
+	e, seen := setupConditionalEngine(t)
+	ctx := context.Background()
+
+	cb := expectConditionalSubscription(e, 1)
+	expectConditionalTransfer(t, e, streamFrom, types.TransferTypeTransferFundsSend)
+	require.NoError(t, e.TransferFunds(ctx, newConditionalTransfer(t, "bounty", time.Unix(100, 0))))
+
+	e.OnTick(ctx, time.Unix(99, 0))
+	require.Len(t, *seen, 1)
+
+	// the funds go back to the sender
+	expectConditionalTransfer(t, e, streamFrom, types.TransferTypeTransferFundsDistribute)
+	e.oracle.EXPECT().Unsubscribe(gomock.Any(), spec.SubscriptionID(1)).Times(1)
+	e.OnTick(ctx, time.Unix(100, 0))
+	require.Len(t, *seen, 2)
+	assert.Equal(t, types.TransferStatusStopped, (*seen)[1].Status)
+	assert.Equal(t, "release condition not met before timeout", (*seen)[1].GetReason())
+
+	// too late
+	require.NoError(t, (*cb)(ctx, dscommon.Data{}))
+}
+
+func testInvalidConditionalTransferTimeoutInThePast(t *testing.T) {
+	e := setupStreamingEngine(t)
+
+	err := e.TransferFunds(context.Background(), newConditionalTransfer(t, "bounty", time.Unix(10, 0)))
+	assert.ErrorIs(t, err, banking.ErrTimeoutInThePast)
+}
+
+func testConditionalTransferEscrowFailure(t *testing.T) {
+	e, seen := setupConditionalEngine(t)
+	ctx := context.Background()
+
+	expectConditionalSubscription(e, 1)
+	e.col.EXPECT().TransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, errors.New("not enough funds"))
+	e.oracle.EXPECT().Unsubscribe(gomock.Any(), spec.SubscriptionID(1)).Times(1)
+	require.Error(t, e.TransferFunds(ctx, newConditionalTransfer(t, "bounty", time.Unix(100, 0))))
+	require.Len(t, *seen, 1)
+	assert.Equal(t, types.TransferStatusRejected, (*seen)[0].Status)
+
+	// nothing to refund
+	e.OnTick(ctx, time.Unix(200, 0))
+}
+
+func testConditionalTransferCannotBeCancelled(t *testing.T) {
+	e := setupStreamingEngine(t)
+	ctx := context.Background()
+
+	expectConditionalSubscription(e, 1)
+	expectConditionalTransfer(t, e, streamFrom, types.TransferTypeTransferFundsSend)
+	require.NoError(t, e.TransferFunds(ctx, newConditionalTransfer(t, "bounty", time.Unix(100, 0))))
+
+	err := e.CancelTransferFunds(ctx, &types.CancelTransferFunds{Party: streamFrom, TransferID: "bounty"})
+	assert.ErrorIs(t, err, banking.ErrCannotCancelConditionalTransfers)
+}
+
+func testConditionalTransferCheckpoint(t *testing.T) {
+	e := setupStreamingEngine(t)
+	ctx := context.Background()
+
+	expectConditionalSubscription(e, 1)
+	expectConditionalTransfer(t, e, streamFrom, types.TransferTypeTransferFundsSend)
+	require.NoError(t, e.TransferFunds(ctx, newConditionalTransfer(t, "bounty", time.Unix(100, 0))))
+
+	cp, err := e.Checkpoint()
+	require.NoError(t, err)
+
+	// the release condition is subscribed to again
+	e2, _ := setupConditionalEngine(t)
+	cb := expectConditionalSubscription(e2, 2)
+	require.NoError(t, e2.Load(ctx, cp))
+
+	expectConditionalTransfer(t, e2, streamTo, types.TransferTypeTransferFundsDistribute)
+	e2.oracle.EXPECT().Unsubscribe(gomock.Any(), spec.SubscriptionID(2)).Times(1)
+	require.NoError(t, (*cb)(ctx, dscommon.Data{}))
+}
+
+func testConditionalTransferSnapshot(t *testing.T) {
+	e := setupStreamingEngine(t)
+	ctx := context.Background()
+	key := (&types.PayloadBankingConditionalTransfers{}).Key()
+
+	state, _, err := e.GetState(key)
+	require.NoError(t, err)
+
+	expectConditionalSubscription(e, 1)
+	expectConditionalTransfer(t, e, streamFrom, types.TransferTypeTransferFundsSend)
+	require.NoError(t, e.TransferFunds(ctx, newConditionalTransfer(t, "bounty", time.Unix(100, 0))))
+
+	// the new transfer prompts a change
+	state2, _, err := e.GetState(key)
+	require.NoError(t, err)
+	require.False(t, bytes.Equal(state, state2))
+
+	// reload the state, the release condition is subscribed to again
+	var pl snapshotpb.Payload
+	require.NoError(t, proto.Unmarshal(state2, &pl))
+	e2, _ := setupConditionalEngine(t)
+	cb := expectConditionalSubscription(e2, 2)
+	_, err = e2.LoadState(ctx, types.PayloadFromProto(&pl))
+	require.NoError(t, err)
+
+	statePostReload, _, err := e2.GetState(key)
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(state2, statePostReload))
+
+	expectConditionalTransfer(t, e2, streamTo, types.TransferTypeTransferFundsDistribute)
+	e2.oracle.EXPECT().Unsubscribe(gomock.Any(), spec.SubscriptionID(2)).Times(1)
+	require.NoError(t, (*cb)(ctx, dscommon.Data{}))
+}
@@ -28,6 +28,7 @@ import (
 
 	"code.vegaprotocol.io/vega/core/assets"
 	"code.vegaprotocol.io/vega/core/broker"
+	"code.vegaprotocol.io/vega/core/datasource/spec"
 	"code.vegaprotocol.io/vega/core/events"
 	"code.vegaprotocol.io/vega/core/types"
 	"code.vegaprotocol.io/vega/core/validators"
@@ -40,7 +41,7 @@ import (
 	"golang.org/x/exp/maps"
 )
 
-//go:generate go run github.com/golang/mock/mockgen -destination mocks/mocks.go -package mocks code.vegaprotocol.io/vega/core/banking Assets,Notary,Collateral,Witness,TimeService,EpochService,Topology,MarketActivityTracker,ERC20BridgeView,EthereumEventSource,Parties
+//go:generate go run github.com/golang/mock/mockgen -destination mocks/mocks.go -package mocks code.vegaprotocol.io/vega/core/banking Assets,Notary,Collateral,Witness,TimeService,EpochService,Topology,MarketActivityTracker,ERC20BridgeView,EthereumEventSource,Parties,OracleEngine
 
 var (
 	ErrWrongAssetTypeUsedInBuiltinAssetChainEvent = errors.New("non builtin asset used for builtin asset chain event")
@@ -125,6 +126,12 @@ type Parties interface {
 	CheckDerivedKeyOwnership(party types.PartyID, derivedKey string) bool
 }
 
+// OracleEngine is used to listen to the release conditions of the conditional transfers.
+type OracleEngine interface {
+	Subscribe(context.Context, spec.Spec, spec.OnMatchedData) (spec.SubscriptionID, spec.Unsubscriber, error)
+	Unsubscribe(context.Context, spec.SubscriptionID)
+}
+
 const (
 	pendingState uint32 = iota
 	okState
@@ -149,6 +156,7 @@ type Engine struct {
 	assets      Assets
 	top         Topology
 	parties     Parties
+	oracle      OracleEngine
 
 	// assetActions tracks all the asset actions the engine must process on network
 	// tick.
@@ -212,6 +220,18 @@ type Engine struct {
 	// transfer id to recurringTransfers
 	recurringTransfersMap map[string]*types.RecurringTransfer
 
+	// streaming transfers in the order they were created
+	streamingTransfers []*types.StreamingTransfer
+	// transfer id to streamingTransfers
+	streamingTransfersMap map[string]*types.StreamingTransfer
+
+	// conditional transfers in the order they were created
+	conditionalTransfers []*types.ConditionalTransfer
+	// transfer id to conditionalTransfers
+	conditionalTransfersMap map[string]*types.ConditionalTransfer
+	// transfer id to the subscription to its release condition
+	conditionalSubscriptions map[string]spec.SubscriptionID
+
 	minWithdrawQuantumMultiple num.Decimal
 
 	maxGovTransferQunatumMultiplier num.Decimal
@@ -243,6 +263,7 @@ func New(log *logging.Logger,
 	secondaryBridgeView ERC20BridgeView,
 	ethEventSource EthereumEventSource,
 	parties Parties,
+	oracle OracleEngine,
 ) (e *Engine) {
 	log = log.Named(namedLogger)
 	log.SetLevel(cfg.Level.Get())
@@ -259,6 +280,7 @@ func New(log *logging.Logger,
 		top:                             top,
 		ethEventSource:                  ethEventSource,
 		parties:                         parties,
+		oracle:                          oracle,
 		assetActions:                    map[string]*assetAction{},
 		seenAssetActions:                treeset.NewWithStringComparator(),
 		withdrawals:                     map[string]withdrawalRef{},
@@ -268,6 +290,11 @@ func New(log *logging.Logger,
 		scheduledTransfers:              map[int64][]scheduledTransfer{},
 		recurringTransfers:              []*types.RecurringTransfer{},
 		recurringTransfersMap:           map[string]*types.RecurringTransfer{},
+		streamingTransfers:              []*types.StreamingTransfer{},
+		streamingTransfersMap:           map[string]*types.StreamingTransfer{},
+		conditionalTransfers:            []*types.ConditionalTransfer{},
+		conditionalTransfersMap:         map[string]*types.ConditionalTransfer{},
+		conditionalSubscriptions:        map[string]spec.SubscriptionID{},
 		scheduledGovernanceTransfers:    map[int64][]*types.GovernanceTransfer{},
 		recurringGovernanceTransfers:    []*types.GovernanceTransfer{},
 		recurringGovernanceTransfersMap: map[string]*types.GovernanceTransfer{},
@@ -385,6 +412,20 @@ func (e *Engine) OnTick(ctx context.Context, now time.Time) {
 
 	// process governance transfers
 	e.distributeScheduledGovernanceTransfers(ctx, now)
+
+	// release the vested part of the streaming transfers
+	if err := e.distributeStreamingTransfers(ctx, now); err != nil {
+		e.log.Error("could not process streaming transfers",
+			logging.Error(err),
+		)
+	}
+
+	// refund the conditional transfers which timed out
+	if err := e.refundTimedOutConditionalTransfers(ctx, now); err != nil {
+		e.log.Error("could not process conditional transfers",
+			logging.Error(err),
+		)
+	}
 }
 
 func (e *Engine) processAssetActions(ctx context.Context, now time.Time) {
@@ -56,6 +56,7 @@ type testEngine struct {
 	ethSource             *mocks.MockEthereumEventSource
 	secondaryBridgeView   *mocks.MockERC20BridgeView
 	parties               *mocks.MockParties
+	oracle                *mocks.MockOracleEngine
 }
 
 func getTestEngine(t *testing.T) *testEngine {
@@ -82,7 +83,8 @@ func getTestEngine(t *testing.T) *testEngine {
 	notary.EXPECT().OfferSignatures(gomock.Any(), gomock.Any()).AnyTimes()
 	epoch.EXPECT().NotifyOnEpoch(gomock.Any(), gomock.Any()).AnyTimes()
 	parties := mocks.NewMockParties(ctrl)
-	eng := banking.New(logging.NewTestLogger(), banking.NewDefaultConfig(), col, witness, tsvc, assets, notary, broker, top, marketActivityTracker, primaryBridgeView, secondaryBridgeView, ethSource, parties)
+	oracle := mocks.NewMockOracleEngine(ctrl)
+	eng := banking.New(logging.NewTestLogger(), banking.NewDefaultConfig(), col, witness, tsvc, assets, notary, broker, top, marketActivityTracker, primaryBridgeView, secondaryBridgeView, ethSource, parties, oracle)
 
 	require.NoError(t, eng.OnMaxQuantumAmountUpdate(context.Background(), num.DecimalOne()))
 	eng.OnPrimaryEthChainIDUpdated("1", "hello")
@@ -103,6 +105,7 @@ func getTestEngine(t *testing.T) *testEngine {
 		marketActivityTracker: marketActivityTracker,
 		ethSource:             ethSource,
 		parties:               parties,
+		oracle:                oracle,
 	}
 }
 
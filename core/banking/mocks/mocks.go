@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: code.vegaprotocol.io/vega/core/banking (interfaces: Assets,Notary,Collateral,Witness,TimeService,EpochService,Topology,MarketActivityTracker,ERC20BridgeView,EthereumEventSource,Parties)
+// Source: code.vegaprotocol.io/vega/core/banking (interfaces: Assets,Notary,Collateral,Witness,TimeService,EpochService,Topology,MarketActivityTracker,ERC20BridgeView,EthereumEventSource,Parties,OracleEngine)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -10,6 +10,7 @@ import (
 	time "time"
 
 	assets "code.vegaprotocol.io/vega/core/assets"
+	spec "code.vegaprotocol.io/vega/core/datasource/spec"
 	types "code.vegaprotocol.io/vega/core/types"
 	validators "code.vegaprotocol.io/vega/core/validators"
 	num "code.vegaprotocol.io/vega/libs/num"
@@ -770,3 +771,54 @@ func (mr *MockPartiesMockRecorder) CheckDerivedKeyOwnership(arg0, arg1 interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDerivedKeyOwnership", reflect.TypeOf((*MockParties)(nil).CheckDerivedKeyOwnership), arg0, arg1)
 }
+
+// MockOracleEngine is a mock of OracleEngine interface.
+type MockOracleEngine struct {
+	ctrl     *gomock.Controller
+	recorder *MockOracleEngineMockRecorder
+}
+
+// MockOracleEngineMockRecorder is the mock recorder for MockOracleEngine.
+type MockOracleEngineMockRecorder struct {
+	mock *MockOracleEngine
+}
+
+// NewMockOracleEngine creates a new mock instance.
+func NewMockOracleEngine(ctrl *gomock.Controller) *MockOracleEngine {
+	mock := &MockOracleEngine{ctrl: ctrl}
+	mock.recorder = &MockOracleEngineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOracleEngine) EXPECT() *MockOracleEngineMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method.
+func (m *MockOracleEngine) Subscribe(arg0 context.Context, arg1 spec.Spec, arg2 spec.OnMatchedData) (spec.SubscriptionID, spec.Unsubscriber, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0, arg1, arg2)
+	ret0, _ := ret[0].(spec.SubscriptionID)
+	ret1, _ := ret[1].(spec.Unsubscriber)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockOracleEngineMockRecorder) Subscribe(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockOracleEngine)(nil).Subscribe), arg0, arg1, arg2)
+}
+
+// Unsubscribe mocks base method.
+func (m *MockOracleEngine) Unsubscribe(arg0 context.Context, arg1 spec.SubscriptionID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Unsubscribe", arg0, arg1)
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockOracleEngineMockRecorder) Unsubscribe(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockOracleEngine)(nil).Unsubscribe), arg0, arg1)
+}
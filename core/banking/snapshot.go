@@ -28,6 +28,7 @@ import (
 	"code.vegaprotocol.io/vega/libs/proto"
 	"code.vegaprotocol.io/vega/logging"
 	checkpoint "code.vegaprotocol.io/vega/protos/vega/checkpoint/v1"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
 	snapshot "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
 
 	"github.com/emirpasic/gods/sets/treeset"
@@ -39,6 +40,8 @@ var (
 	seenKey                  = (&types.PayloadBankingSeen{}).Key()
 	assetActionsKey          = (&types.PayloadBankingAssetActions{}).Key()
 	recurringTransfersKey    = (&types.PayloadBankingRecurringTransfers{}).Key()
+	streamingTransfersKey    = (&types.PayloadBankingStreamingTransfers{}).Key()
+	conditionalTransfersKey  = (&types.PayloadBankingConditionalTransfers{}).Key()
 	scheduledTransfersKey    = (&types.PayloadBankingScheduledTransfers{}).Key()
 	primaryBridgeStateKey    = (&types.PayloadBankingPrimaryBridgeState{}).Key()
 	secondaryBridgeStateKey  = (&types.PayloadBankingEVMBridgeStates{}).Key()
@@ -52,6 +55,8 @@ var (
 		seenKey,
 		assetActionsKey,
 		recurringTransfersKey,
+		streamingTransfersKey,
+		conditionalTransfersKey,
 		scheduledTransfersKey,
 		primaryBridgeStateKey,
 		secondaryBridgeStateKey,
@@ -67,6 +72,8 @@ type bankingSnapshotState struct {
 	serialisedSeen                  []byte
 	serialisedAssetActions          []byte
 	serialisedRecurringTransfers    []byte
+	serialisedStreamingTransfers    []byte
+	serialisedConditionalTransfers  []byte
 	serialisedScheduledTransfers    []byte
 	serialisedPrimaryBridgeState    []byte
 	serialisedSecondaryBridgeState  []byte
@@ -119,9 +126,13 @@ func (e *Engine) serialiseSecondaryBridgeState() ([]byte, error) {
 }
 
 func (e *Engine) serialiseRecurringTransfers() ([]byte, error) {
+	transfers := e.getRecurringTransfers()
+	// streaming and conditional transfers have their own snapshot keys
+	transfers.StreamingTransfers = nil
+	transfers.ConditionalTransfers = nil
 	payload := types.Payload{
 		Data: &types.PayloadBankingRecurringTransfers{
-			BankingRecurringTransfers: e.getRecurringTransfers(),
+			BankingRecurringTransfers: transfers,
 			NextMetricUpdate:          e.nextMetricUpdate,
 		},
 	}
@@ -129,6 +140,26 @@ func (e *Engine) serialiseRecurringTransfers() ([]byte, error) {
 	return proto.Marshal(payload.IntoProto())
 }
 
+func (e *Engine) serialiseStreamingTransfers() ([]byte, error) {
+	payload := types.Payload{
+		Data: &types.PayloadBankingStreamingTransfers{
+			BankingStreamingTransfers: e.getStreamingTransfers(),
+		},
+	}
+
+	return proto.Marshal(payload.IntoProto())
+}
+
+func (e *Engine) serialiseConditionalTransfers() ([]byte, error) {
+	payload := types.Payload{
+		Data: &types.PayloadBankingConditionalTransfers{
+			BankingConditionalTransfers: e.getConditionalTransfers(),
+		},
+	}
+
+	return proto.Marshal(payload.IntoProto())
+}
+
 func (e *Engine) serialiseScheduledTransfers() ([]byte, error) {
 	payload := types.Payload{
 		Data: &types.PayloadBankingScheduledTransfers{
@@ -280,6 +311,10 @@ func (e *Engine) serialise(k string) ([]byte, error) {
 		return e.serialiseK(e.serialiseAssetActions, &e.bss.serialisedAssetActions)
 	case recurringTransfersKey:
 		return e.serialiseK(e.serialiseRecurringTransfers, &e.bss.serialisedRecurringTransfers)
+	case streamingTransfersKey:
+		return e.serialiseK(e.serialiseStreamingTransfers, &e.bss.serialisedStreamingTransfers)
+	case conditionalTransfersKey:
+		return e.serialiseK(e.serialiseConditionalTransfers, &e.bss.serialisedConditionalTransfers)
 	case scheduledTransfersKey:
 		return e.serialiseK(e.serialiseScheduledTransfers, &e.bss.serialisedScheduledTransfers)
 	case recurringGovTransfersKey:
@@ -318,6 +353,10 @@ func (e *Engine) LoadState(ctx context.Context, p *types.Payload) ([]types.State
 		return nil, e.restoreAssetActions(pl.BankingAssetActions, p)
 	case *types.PayloadBankingRecurringTransfers:
 		return nil, e.restoreRecurringTransfers(ctx, pl.BankingRecurringTransfers, pl.NextMetricUpdate, p)
+	case *types.PayloadBankingStreamingTransfers:
+		return nil, e.restoreStreamingTransfers(ctx, pl.BankingStreamingTransfers, p)
+	case *types.PayloadBankingConditionalTransfers:
+		return nil, e.restoreConditionalTransfers(ctx, pl.BankingConditionalTransfers, p)
 	case *types.PayloadBankingScheduledTransfers:
 		return nil, e.restoreScheduledTransfers(ctx, pl.BankingScheduledTransfers, p)
 	case *types.PayloadBankingRecurringGovernanceTransfers:
@@ -344,6 +383,22 @@ func (e *Engine) restoreRecurringTransfers(ctx context.Context, transfers *check
 	return err
 }
 
+func (e *Engine) restoreStreamingTransfers(ctx context.Context, transfers []*eventspb.Transfer, p *types.Payload) error {
+	var err error
+	// ignore events here as we don't need to send them
+	_ = e.loadStreamingTransfers(ctx, transfers)
+	e.bss.serialisedStreamingTransfers, err = proto.Marshal(p.IntoProto())
+	return err
+}
+
+func (e *Engine) restoreConditionalTransfers(ctx context.Context, transfers []*eventspb.Transfer, p *types.Payload) error {
+	var err error
+	// ignore events, the release conditions are subscribed to again
+	_ = e.loadConditionalTransfers(ctx, transfers)
+	e.bss.serialisedConditionalTransfers, err = proto.Marshal(p.IntoProto())
+	return err
+}
+
 func (e *Engine) restoreRecurringGovernanceTransfers(ctx context.Context, transfers []*checkpoint.GovernanceTransfer, p *types.Payload) error {
 	var err error
 	_ = e.loadRecurringGovernanceTransfers(ctx, transfers)
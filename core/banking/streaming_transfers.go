@@ -0,0 +1,251 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package banking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/assets"
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+)
+
+var (
+	ErrStartTimeInThePast                         = errors.New("start time in the past")
+	ErrCannotCancelOtherPartiesStreamingTransfers = errors.New("cannot cancel other parties streaming transfers")
+)
+
+func (e *Engine) streamingTransfer(
+	ctx context.Context,
+	transfer *types.StreamingTransfer,
+) (err error) {
+	defer func() {
+		if err != nil {
+			e.broker.Send(events.NewStreamingTransferFundsEventWithReason(ctx, transfer, err.Error()))
+		} else {
+			e.broker.Send(events.NewStreamingTransferFundsEvent(ctx, transfer))
+		}
+	}()
+
+	// ensure asset exists
+	a, err := e.assets.Get(transfer.Asset)
+	if err != nil {
+		transfer.Status = types.TransferStatusRejected
+		e.log.Debug("cannot transfer funds, invalid asset", logging.Error(err))
+		return fmt.Errorf("could not transfer funds: %w", err)
+	}
+
+	if err := transfer.IsValid(); err != nil {
+		transfer.Status = types.TransferStatusRejected
+		return err
+	}
+
+	if err := e.ensureMinimalTransferAmount(a, transfer.Amount, transfer.FromAccountType, transfer.From, transfer.FromDerivedKey); err != nil {
+		transfer.Status = types.TransferStatusRejected
+		return err
+	}
+
+	// can't create a stream which should have started already
+	if transfer.StartTime.Before(transfer.Timestamp) {
+		transfer.Status = types.TransferStatusRejected
+		return ErrStartTimeInThePast
+	}
+
+	tresps, err := e.escrowTransfer(ctx, a, transfer.TransferBase)
+	if err != nil {
+		transfer.Status = types.TransferStatusRejected
+		return err
+	}
+
+	// the full amount is now held in the pending transfers
+	// account and released along the way
+	e.streamingTransfers = append(e.streamingTransfers, transfer)
+	e.streamingTransfersMap[transfer.ID] = transfer
+	e.broker.Send(events.NewLedgerMovements(ctx, tresps))
+
+	return nil
+}
+
+// escrowTransfer takes the full amount of the transfer and
+// its fee from the party, the amount is moved to the pending transfers
+// account until it's released to the receiver.
+func (e *Engine) escrowTransfer(
+	ctx context.Context,
+	asset *assets.Asset,
+	transfer *types.TransferBase,
+) ([]*types.LedgerMovement, error) {
+	assetType := asset.ToAssetType()
+
+	feeTransfer, discount, err := e.makeFeeTransferForFundsTransfer(
+		ctx, assetType, transfer.Amount, transfer.From, transfer.FromAccountType, nil, transfer.To,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not pay the fee for transfer: %w", err)
+	}
+
+	fromTransfer, _ := e.makeTransfers(transfer.From, transfer.To, assetType.ID, "", "", transfer.Amount, &transfer.ID)
+	tresps, err := e.col.TransferFunds(
+		ctx,
+		[]*types.Transfer{fromTransfer},
+		[]types.AccountType{transfer.FromAccountType},
+		[]string{transfer.Reference},
+		[]*types.Transfer{feeTransfer},
+		[]types.AccountType{transfer.FromAccountType},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	e.broker.Send(events.NewTransferFeesEvent(ctx, transfer.ID, feeTransfer.Amount.Amount, discount, e.currentEpoch))
+
+	return tresps, nil
+}
+
+// distributeStreamingTransfers releases the vested amount of
+// all the streaming transfers which are due a release.
+func (e *Engine) distributeStreamingTransfers(ctx context.Context, now time.Time) error {
+	type release struct {
+		transfer *types.StreamingTransfer
+		amount   *num.Uint
+		done     bool
+	}
+
+	var (
+		releases     = []release{}
+		transfers    = []*types.Transfer{}
+		accountTypes = []types.AccountType{}
+		references   = []string{}
+	)
+
+	for _, v := range e.streamingTransfers {
+		if !v.ReleaseDue(now) {
+			continue
+		}
+
+		amount := v.ClaimableAt(now)
+		done := !now.Before(v.EndTime)
+		if amount.IsZero() && !done {
+			continue
+		}
+
+		if !amount.IsZero() {
+			_, toTransfer := e.makeTransfers(v.From, v.To, v.Asset, "", "", amount, &v.ID)
+			transfers = append(transfers, toTransfer)
+			accountTypes = append(accountTypes, v.ToAccountType)
+			references = append(references, v.Reference)
+		}
+		releases = append(releases, release{transfer: v, amount: amount, done: done})
+	}
+
+	if len(transfers) > 0 {
+		// no fees required there, they've been paid already
+		tresps, err := e.col.TransferFunds(ctx, transfers, accountTypes, references, nil, nil)
+		if err != nil {
+			// nothing was released, the streams are left as is
+			// so the release is attempted again on the next tick.
+			return err
+		}
+		e.broker.Send(events.NewLedgerMovements(ctx, tresps))
+	}
+
+	evts := make([]events.Event, 0, len(releases))
+	for _, r := range releases {
+		r.transfer.LastRelease = now
+		r.transfer.Released.AddSum(r.amount)
+		if r.done {
+			r.transfer.Status = types.TransferStatusDone
+			e.deleteStreamingTransfer(r.transfer.ID)
+		}
+		evts = append(evts, events.NewStreamingTransferFundsEvent(ctx, r.transfer))
+	}
+
+	if len(evts) > 0 {
+		e.broker.SendBatch(evts)
+	}
+
+	return nil
+}
+
+// cancelStreamingTransfer stops the stream, the amount vested so far is
+// released to the receiver and the remainder is refunded to the party.
+func (e *Engine) cancelStreamingTransfer(
+	ctx context.Context,
+	transfer *types.StreamingTransfer,
+	party string,
+) error {
+	if transfer.From != party {
+		return ErrCannotCancelOtherPartiesStreamingTransfers
+	}
+
+	var (
+		now          = e.timeService.GetTimeNow()
+		vested       = transfer.ClaimableAt(now)
+		refund       = num.UintZero().Sub(transfer.Remaining(), vested)
+		transfers    = []*types.Transfer{}
+		accountTypes = []types.AccountType{}
+		references   = []string{}
+	)
+
+	if !vested.IsZero() {
+		_, toTransfer := e.makeTransfers(transfer.From, transfer.To, transfer.Asset, "", "", vested, &transfer.ID)
+		transfers = append(transfers, toTransfer)
+		accountTypes = append(accountTypes, transfer.ToAccountType)
+		references = append(references, transfer.Reference)
+	}
+
+	if !refund.IsZero() {
+		_, refundTransfer := e.makeTransfers(transfer.From, transfer.From, transfer.Asset, "", "", refund, &transfer.ID)
+		transfers = append(transfers, refundTransfer)
+		accountTypes = append(accountTypes, transfer.FromAccountType)
+		references = append(references, transfer.Reference)
+	}
+
+	if len(transfers) > 0 {
+		tresps, err := e.col.TransferFunds(ctx, transfers, accountTypes, references, nil, nil)
+		if err != nil {
+			return err
+		}
+		e.broker.Send(events.NewLedgerMovements(ctx, tresps))
+	}
+
+	transfer.Released.AddSum(vested)
+	transfer.LastRelease = now
+	e.deleteStreamingTransfer(transfer.ID)
+
+	transfer.Status = types.TransferStatusCancelled
+	e.broker.Send(events.NewStreamingTransferFundsEventWithReason(ctx, transfer, "transfer cancelled"))
+
+	return nil
+}
+
+func (e *Engine) deleteStreamingTransfer(ID string) {
+	index := -1
+	for i, st := range e.streamingTransfers {
+		if st.ID == ID {
+			index = i
+			break
+		}
+	}
+	if index >= 0 {
+		e.streamingTransfers = append(e.streamingTransfers[:index], e.streamingTransfers[index+1:]...)
+		delete(e.streamingTransfersMap, ID)
+	}
+}
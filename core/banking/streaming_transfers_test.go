@@ -0,0 +1,302 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package banking_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/assets"
+	"code.vegaprotocol.io/vega/core/banking"
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/proto"
+	checkpoint "code.vegaprotocol.io/vega/protos/vega/checkpoint/v1"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	streamFrom = "03ae90688632c649c4beab6040ff5bd04dbde8efbf737d8673bbda792a110301"
+	streamTo   = "2e05fd230f3c9f4eaf0bdc5bfb7ca0c9d00278afc44637aab60da76653d7ccf0"
+)
+
+func TestStreamingTransfers(t *testing.T) {
+	t.Run("valid streaming transfer", testValidStreamingTransfer)
+	t.Run("streaming transfer with release interval", testStreamingTransferReleaseInterval)
+	t.Run("invalid streaming transfer, in the past", testInvalidStreamingTransferInThePast)
+	t.Run("cancel streaming transfer, pro-rata refund", testCancelStreamingTransferProRata)
+	t.Run("streaming transfer survives checkpoint", testStreamingTransferCheckpoint)
+	t.Run("streaming transfer survives snapshot", testStreamingTransferSnapshot)
+	t.Run("failed release leaves the stream untouched", testStreamingTransferFailedRelease)
+}
+
+func newStreamingTransfer(id string, start, end time.Time, interval time.Duration) *types.TransferFunds {
+	return &types.TransferFunds{
+		Kind: types.TransferCommandKindStreaming,
+		Streaming: &types.StreamingTransfer{
+			TransferBase: &types.TransferBase{
+				ID:              id,
+				From:            streamFrom,
+				FromAccountType: types.AccountTypeGeneral,
+				To:              streamTo,
+				ToAccountType:   types.AccountTypeGeneral,
+				Asset:           assetNameETH,
+				Amount:          num.NewUint(1000),
+				Reference:       "payroll",
+				Status:          types.TransferStatusPending,
+			},
+			StartTime:       start,
+			EndTime:         end,
+			ReleaseInterval: interval,
+			ReleaseCurve:    types.StreamingReleaseCurveLinear,
+			Released:        num.UintZero(),
+			LastRelease:     start,
+		},
+	}
+}
+
+func setupStreamingEngine(t *testing.T) *testEngine {
+	t.Helper()
+	e := getTestEngine(t)
+	e.assets.EXPECT().Get(gomock.Any()).AnyTimes().Return(assets.NewAsset(&mockAsset{name: assetNameETH, quantum: num.DecimalFromFloat(10)}), nil)
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	e.col.EXPECT().GetPartyGeneralAccount(gomock.Any(), gomock.Any()).AnyTimes().Return(&types.Account{Balance: num.NewUint(100000)}, nil)
+	return e
+}
+
+// expectStreamRelease expects a single release of the given amount to the receiver.
+func expectStreamRelease(t *testing.T, e *testEngine, amount uint64) {
+	t.Helper()
+	e.col.EXPECT().TransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, transfers []*types.Transfer, accountTypes []types.AccountType, _ []string, feeTransfers []*types.Transfer, _ []types.AccountType) ([]*types.LedgerMovement, error) {
+			require.Len(t, transfers, 1)
+			assert.Equal(t, types.TransferTypeTransferFundsDistribute, transfers[0].Type)
+			assert.Equal(t, streamTo, transfers[0].Owner)
+			assert.Equal(t, num.NewUint(amount), transfers[0].Amount.Amount)
+			assert.Equal(t, []types.AccountType{types.AccountTypeGeneral}, accountTypes)
+			assert.Len(t, feeTransfers, 0)
+			return nil, nil
+		})
+}
+
+func testValidStreamingTransfer(t *testing.T) {
+	e := setupStreamingEngine(t)
+	ctx := context.Background()
+
+	// the full amount is escrowed straight away
+	e.col.EXPECT().TransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, transfers []*types.Transfer, accountTypes []types.AccountType, _ []string, feeTransfers []*types.Transfer, _ []types.AccountType) ([]*types.LedgerMovement, error) {
+			require.Len(t, transfers, 1)
+			assert.Equal(t, types.TransferTypeTransferFundsSend, transfers[0].Type)
+			assert.Equal(t, streamFrom, transfers[0].Owner)
+			assert.Equal(t, num.NewUint(1000), transfers[0].Amount.Amount)
+			assert.Equal(t, []types.AccountType{types.AccountTypeGeneral}, accountTypes)
+			assert.Len(t, feeTransfers, 1)
+			return nil, nil
+		})
+	require.NoError(t, e.TransferFunds(ctx, newStreamingTransfer("stream", time.Unix(10, 0), time.Unix(110, 0), 0)))
+
+	seenEvts := []events.Event{}
+	e.broker.EXPECT().SendBatch(gomock.Any()).DoAndReturn(func(evts []events.Event) {
+		seenEvts = append(seenEvts, evts...)
+	}).AnyTimes()
+
+	// nothing vested yet
+	e.OnTick(ctx, time.Unix(10, 0))
+	assert.Len(t, seenEvts, 0)
+
+	expectStreamRelease(t, e, 250)
+	e.OnTick(ctx, time.Unix(35, 0))
+	require.Len(t, seenEvts, 1)
+	assert.Equal(t, "250", seenEvts[0].StreamMessage().GetTransfer().GetStreaming().GetReleased())
+	assert.Equal(t, types.TransferStatusPending, seenEvts[0].StreamMessage().GetTransfer().Status)
+
+	// same block time, nothing more to release
+	e.OnTick(ctx, time.Unix(35, 0))
+	assert.Len(t, seenEvts, 1)
+
+	// past the end, the remainder is released
+	expectStreamRelease(t, e, 750)
+	e.OnTick(ctx, time.Unix(200, 0))
+	require.Len(t, seenEvts, 2)
+	assert.Equal(t, "1000", seenEvts[1].StreamMessage().GetTransfer().GetStreaming().GetReleased())
+	assert.Equal(t, types.TransferStatusDone, seenEvts[1].StreamMessage().GetTransfer().Status)
+
+	// the transfer is done and can't be cancelled anymore
+	err := e.CancelTransferFunds(ctx, &types.CancelTransferFunds{Party: streamFrom, TransferID: "stream"})
+	assert.ErrorIs(t, err, banking.ErrRecurringTransferDoesNotExists)
+}
+
+func testStreamingTransferReleaseInterval(t *testing.T) {
+	e := setupStreamingEngine(t)
+	ctx := context.Background()
+
+	e.col.EXPECT().TransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+	require.NoError(t, e.TransferFunds(ctx, newStreamingTransfer("stream", time.Unix(10, 0), time.Unix(110, 0), 30*time.Second)))
+	e.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+
+	// less than the interval since the start
+	e.OnTick(ctx, time.Unix(30, 0))
+
+	expectStreamRelease(t, e, 300)
+	e.OnTick(ctx, time.Unix(40, 0))
+
+	// less than the interval since the last release
+	e.OnTick(ctx, time.Unix(69, 0))
+
+	expectStreamRelease(t, e, 300)
+	e.OnTick(ctx, time.Unix(70, 0))
+}
+
+func testInvalidStreamingTransferInThePast(t *testing.T) {
+	e := setupStreamingEngine(t)
+
+	err := e.TransferFunds(context.Background(), newStreamingTransfer("stream", time.Unix(5, 0), time.Unix(110, 0), 0))
+	assert.ErrorIs(t, err, banking.ErrStartTimeInThePast)
+}
+
+func testCancelStreamingTransferProRata(t *testing.T) {
+	e := setupStreamingEngine(t)
+	ctx := context.Background()
+
+	// the stream started before now, load it from a checkpoint,
+	// half of it vested at the time of the cancellation.
+	st := newStreamingTransfer("stream", time.Unix(0, 0), time.Unix(20, 0), 0).Streaming
+	st.Released = num.NewUint(200)
+	cp, err := proto.Marshal(&checkpoint.Banking{
+		RecurringTransfers: &checkpoint.RecurringTransfers{
+			StreamingTransfers: []*eventspb.Transfer{st.IntoEvent(nil)},
+		},
+	})
+	require.NoError(t, err)
+	e.broker.EXPECT().SendBatch(gomock.Any()).Times(1)
+	require.NoError(t, e.Load(ctx, cp))
+
+	err = e.CancelTransferFunds(ctx, &types.CancelTransferFunds{Party: streamTo, TransferID: "stream"})
+	assert.ErrorIs(t, err, banking.ErrCannotCancelOtherPartiesStreamingTransfers)
+
+	e.col.EXPECT().TransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, transfers []*types.Transfer, accountTypes []types.AccountType, _ []string, feeTransfers []*types.Transfer, _ []types.AccountType) ([]*types.LedgerMovement, error) {
+			require.Len(t, transfers, 2)
+			// the vested part not released yet goes to the receiver
+			assert.Equal(t, streamTo, transfers[0].Owner)
+			assert.Equal(t, num.NewUint(300), transfers[0].Amount.Amount)
+			// the rest is refunded
+			assert.Equal(t, streamFrom, transfers[1].Owner)
+			assert.Equal(t, num.NewUint(500), transfers[1].Amount.Amount)
+			assert.Equal(t, []types.AccountType{types.AccountTypeGeneral, types.AccountTypeGeneral}, accountTypes)
+			assert.Len(t, feeTransfers, 0)
+			return nil, nil
+		})
+	require.NoError(t, e.CancelTransferFunds(ctx, &types.CancelTransferFunds{Party: streamFrom, TransferID: "stream"}))
+
+	// nothing left to stream
+	e.OnTick(ctx, time.Unix(30, 0))
+}
+
+func testStreamingTransferCheckpoint(t *testing.T) {
+	e := setupStreamingEngine(t)
+	ctx := context.Background()
+
+	e.col.EXPECT().TransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+	require.NoError(t, e.TransferFunds(ctx, newStreamingTransfer("stream", time.Unix(10, 0), time.Unix(110, 0), 0)))
+	e.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+
+	expectStreamRelease(t, e, 250)
+	e.OnTick(ctx, time.Unix(35, 0))
+
+	cp, err := e.Checkpoint()
+	require.NoError(t, err)
+
+	e2 := setupStreamingEngine(t)
+	e2.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+	require.NoError(t, e2.Load(ctx, cp))
+
+	// only what vested since the last release is paid out
+	expectStreamRelease(t, e2, 250)
+	e2.OnTick(ctx, time.Unix(60, 0))
+}
+
+func testStreamingTransferSnapshot(t *testing.T) {
+	e := setupStreamingEngine(t)
+	ctx := context.Background()
+	key := (&types.PayloadBankingStreamingTransfers{}).Key()
+
+	state, _, err := e.GetState(key)
+	require.NoError(t, err)
+
+	e.col.EXPECT().TransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+	require.NoError(t, e.TransferFunds(ctx, newStreamingTransfer("stream", time.Unix(10, 0), time.Unix(110, 0), 0)))
+	e.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+
+	expectStreamRelease(t, e, 250)
+	e.OnTick(ctx, time.Unix(35, 0))
+
+	// the new transfer prompts a change
+	state2, _, err := e.GetState(key)
+	require.NoError(t, err)
+	require.False(t, bytes.Equal(state, state2))
+
+	// reload the state
+	var pl snapshotpb.Payload
+	require.NoError(t, proto.Unmarshal(state2, &pl))
+	e2 := setupStreamingEngine(t)
+	e2.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+	_, err = e2.LoadState(ctx, types.PayloadFromProto(&pl))
+	require.NoError(t, err)
+
+	statePostReload, _, err := e2.GetState(key)
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(state2, statePostReload))
+
+	// only what vested since the last release is paid out
+	expectStreamRelease(t, e2, 250)
+	e2.OnTick(ctx, time.Unix(60, 0))
+}
+
+func testStreamingTransferFailedRelease(t *testing.T) {
+	e := setupStreamingEngine(t)
+	ctx := context.Background()
+
+	e.col.EXPECT().TransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, nil)
+	require.NoError(t, e.TransferFunds(ctx, newStreamingTransfer("stream", time.Unix(10, 0), time.Unix(110, 0), 0)))
+
+	seenEvts := []events.Event{}
+	e.broker.EXPECT().SendBatch(gomock.Any()).DoAndReturn(func(evts []events.Event) {
+		seenEvts = append(seenEvts, evts...)
+	}).AnyTimes()
+
+	// the release fails after the end time, the stream is kept
+	e.col.EXPECT().TransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, errors.New("boom"))
+	e.OnTick(ctx, time.Unix(200, 0))
+	assert.Len(t, seenEvts, 0)
+
+	// and the full amount is released on the next attempt
+	expectStreamRelease(t, e, 1000)
+	e.OnTick(ctx, time.Unix(201, 0))
+	require.Len(t, seenEvts, 1)
+	assert.Equal(t, "1000", seenEvts[0].StreamMessage().GetTransfer().GetStreaming().GetReleased())
+	assert.Equal(t, types.TransferStatusDone, seenEvts[0].StreamMessage().GetTransfer().Status)
+}
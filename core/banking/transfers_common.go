@@ -74,6 +74,12 @@ func (e *Engine) TransferFunds(
 	case types.TransferCommandKindRecurring:
 		transfer.Recurring.Timestamp = now
 		return e.recurringTransfer(ctx, transfer.Recurring)
+	case types.TransferCommandKindStreaming:
+		transfer.Streaming.Timestamp = now
+		return e.streamingTransfer(ctx, transfer.Streaming)
+	case types.TransferCommandKindConditional:
+		transfer.Conditional.Timestamp = now
+		return e.conditionalTransfer(ctx, transfer.Conditional)
 	default:
 		return ErrUnsupportedTransferKind
 	}
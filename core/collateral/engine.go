@@ -111,6 +111,10 @@ type Engine struct {
 
 	idbuf []byte
 
+	// market ID -> ID of the market owning the insurance pool the market shares,
+	// the outcome markets of a prediction share the insurance pool of the prediction
+	sharedInsurancePools map[string]string
+
 	// asset ID to asset
 	enabledAssets map[string]types.Asset
 	// snapshot stuff
@@ -145,6 +149,7 @@ func New(log *logging.Logger, conf Config, ts TimeService, broker Broker) *Engin
 		broker:                  broker,
 		idbuf:                   make([]byte, 256),
 		enabledAssets:           map[string]types.Asset{},
+		sharedInsurancePools:    map[string]string{},
 		state:                   newAccState(),
 		vesting:                 map[string]map[string]*num.Uint{},
 		partiesAccsBalanceCache: map[string]*num.Uint{},
@@ -3529,7 +3534,12 @@ func (e *Engine) ClearInsurancepool(ctx context.Context, mktID, asset string, cl
 		Asset:       asset,
 		Type:        types.TransferTypeClearAccount,
 	}
-	marketInsuranceAcc := e.GetOrCreateMarketInsurancePoolAccount(ctx, mktID, asset)
+	// a shared insurance pool is only cleared along with the last market using it
+	pool, shared := e.unshareInsurancePool(mktID)
+	if shared {
+		return resp, nil
+	}
+	marketInsuranceAcc := e.GetOrCreateMarketInsurancePoolAccount(ctx, pool, asset)
 	marketInsuranceID := marketInsuranceAcc.ID
 	// redistribute the remaining funds in the market insurance account between other markets insurance accounts and global insurance account
 	if marketInsuranceAcc.Balance.IsZero() {
@@ -4334,7 +4344,7 @@ func (e *Engine) CreateMarketAccounts(ctx context.Context, marketID, asset strin
 			Asset:    asset,
 			Owner:    systemOwner,
 			Balance:  num.UintZero(),
-			MarketID: marketID,
+			MarketID: e.insurancePoolMarket(marketID),
 			Type:     types.AccountTypeInsurance,
 		}
 		e.accs[insuranceID] = insAcc
@@ -4626,6 +4636,9 @@ func (e *Engine) accountID(marketID, partyID, asset string, ty types.AccountType
 	if len(marketID) <= 0 {
 		marketID = noMarket
 	}
+	if ty == types.AccountTypeInsurance {
+		marketID = e.insurancePoolMarket(marketID)
+	}
 
 	// market account
 	if len(partyID) <= 0 {
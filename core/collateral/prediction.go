@@ -0,0 +1,186 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package collateral
+
+import (
+	"context"
+	"errors"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+)
+
+// ErrNotEnoughFundsForCompleteSet is returned when a party does not have enough collateral in its general account
+// to mint complete sets.
+var ErrNotEnoughFundsForCompleteSet = errors.New("not enough collateral in general account to mint complete sets")
+
+// ShareInsurancePool makes the market use the insurance pool of the pool market instead of its own.
+// The outcome markets of a prediction share the insurance pool of the prediction, which backs the complete sets.
+func (e *Engine) ShareInsurancePool(marketID, poolMarketID string) {
+	e.sharedInsurancePools[marketID] = poolMarketID
+}
+
+// insurancePoolMarket returns the market owning the insurance pool the market uses.
+func (e *Engine) insurancePoolMarket(marketID string) string {
+	if pool, ok := e.sharedInsurancePools[marketID]; ok {
+		return pool
+	}
+	return marketID
+}
+
+// unshareInsurancePool stops the market sharing an insurance pool, and returns the market owning the insurance pool
+// the market was using along with whether the pool is still used by other markets.
+func (e *Engine) unshareInsurancePool(marketID string) (string, bool) {
+	pool, ok := e.sharedInsurancePools[marketID]
+	if !ok {
+		return marketID, false
+	}
+	delete(e.sharedInsurancePools, marketID)
+	for _, p := range e.sharedInsurancePools {
+		if p == pool {
+			return pool, true
+		}
+	}
+	return pool, false
+}
+
+// CompleteSetUpdate moves collateral between the general account of a party and the insurance pool of the market when the
+// party mints or redeems complete sets of the outcome contracts of a prediction.
+func (e *Engine) CompleteSetUpdate(ctx context.Context, market string, transfer *types.Transfer) (*types.LedgerMovement, error) {
+	general, err := e.GetAccountByID(e.accountID(noMarket, transfer.Owner, transfer.Amount.Asset, types.AccountTypeGeneral))
+	if err != nil {
+		e.log.Error(
+			"Failed to get the general party account",
+			logging.String("owner-id", transfer.Owner),
+			logging.String("market-id", market),
+			logging.Error(err),
+		)
+		return nil, err
+	}
+	insurancePool, err := e.GetAccountByID(e.accountID(market, systemOwner, transfer.Amount.Asset, types.AccountTypeInsurance))
+	if err != nil {
+		e.log.Error(
+			"Failed to get the insurance pool account",
+			logging.String("market-id", market),
+			logging.Error(err),
+		)
+		return nil, err
+	}
+
+	req := &types.TransferRequest{
+		Amount:    transfer.Amount.Amount.Clone(),
+		MinAmount: transfer.Amount.Amount.Clone(),
+		Asset:     transfer.Amount.Asset,
+		Type:      transfer.Type,
+	}
+	switch transfer.Type {
+	case types.TransferTypeCompleteSetMint:
+		if general.Balance.LT(transfer.Amount.Amount) {
+			return nil, ErrNotEnoughFundsForCompleteSet
+		}
+		req.FromAccount = []*types.Account{general}
+		req.ToAccount = []*types.Account{insurancePool}
+	case types.TransferTypeCompleteSetRedeem:
+		// the insurance pool pays out as much as it can
+		req.MinAmount = num.UintZero()
+		req.FromAccount = []*types.Account{insurancePool}
+		req.ToAccount = []*types.Account{general}
+	default:
+		return nil, errors.New("unsupported transfer type for complete sets")
+	}
+
+	return e.completeSetTransfer(ctx, req)
+}
+
+// CompleteSetMarginUpdate moves collateral between the margin account of a party in an outcome market and the insurance pool
+// of the market when the party mints or redeems complete sets. On mint the insurance pool funds the margin of the long position
+// the party gets, as much as it can, while on redeem the party pays back the value of the position it gives up, using its general
+// account if the margin account is not enough.
+func (e *Engine) CompleteSetMarginUpdate(ctx context.Context, market string, transfer *types.Transfer) (*types.LedgerMovement, error) {
+	margin, err := e.GetAccountByID(e.accountID(market, transfer.Owner, transfer.Amount.Asset, types.AccountTypeMargin))
+	if err != nil {
+		e.log.Error(
+			"Failed to get the margin party account",
+			logging.String("owner-id", transfer.Owner),
+			logging.String("market-id", market),
+			logging.Error(err),
+		)
+		return nil, err
+	}
+	general, err := e.GetAccountByID(e.accountID(noMarket, transfer.Owner, transfer.Amount.Asset, types.AccountTypeGeneral))
+	if err != nil {
+		e.log.Error(
+			"Failed to get the general party account",
+			logging.String("owner-id", transfer.Owner),
+			logging.String("market-id", market),
+			logging.Error(err),
+		)
+		return nil, err
+	}
+	insurancePool, err := e.GetAccountByID(e.accountID(market, systemOwner, transfer.Amount.Asset, types.AccountTypeInsurance))
+	if err != nil {
+		e.log.Error(
+			"Failed to get the insurance pool account",
+			logging.String("market-id", market),
+			logging.Error(err),
+		)
+		return nil, err
+	}
+
+	req := &types.TransferRequest{
+		Amount: transfer.Amount.Amount.Clone(),
+		Asset:  transfer.Amount.Asset,
+		Type:   transfer.Type,
+	}
+	switch transfer.Type {
+	case types.TransferTypeCompleteSetMint:
+		req.MinAmount = num.UintZero()
+		req.FromAccount = []*types.Account{insurancePool}
+		req.ToAccount = []*types.Account{margin}
+	case types.TransferTypeCompleteSetRedeem:
+		if num.Sum(margin.Balance, general.Balance).LT(transfer.Amount.Amount) {
+			return nil, ErrNotEnoughFundsForCompleteSet
+		}
+		req.MinAmount = transfer.Amount.Amount.Clone()
+		req.FromAccount = []*types.Account{margin, general}
+		req.ToAccount = []*types.Account{insurancePool}
+	default:
+		return nil, errors.New("unsupported transfer type for complete sets")
+	}
+	return e.completeSetTransfer(ctx, req)
+}
+
+func (e *Engine) completeSetTransfer(ctx context.Context, req *types.TransferRequest) (*types.LedgerMovement, error) {
+	res, err := e.getLedgerEntries(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range res.Entries {
+		if err := e.IncrementBalance(ctx, e.ADtoID(v.ToAccount), v.Amount); err != nil {
+			e.log.Error(
+				"Failed to increment balance for account",
+				logging.String("asset", v.ToAccount.AssetID),
+				logging.String("market", v.ToAccount.MarketID),
+				logging.String("owner", v.ToAccount.Owner),
+				logging.String("type", v.ToAccount.Type.String()),
+				logging.BigUint("amount", v.Amount),
+				logging.Error(err),
+			)
+		}
+	}
+	return res, nil
+}
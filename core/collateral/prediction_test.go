@@ -0,0 +1,175 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package collateral_test
+
+import (
+	"context"
+	"testing"
+
+	"code.vegaprotocol.io/vega/core/collateral"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompleteSets(t *testing.T) {
+	t.Run("outcome markets share the insurance pool of the prediction", testOutcomeMarketsShareInsurancePool)
+	t.Run("minting and redeeming complete sets moves collateral to and from the shared insurance pool", testCompleteSetTransfers)
+	t.Run("minting complete sets fails without enough collateral", testCompleteSetMintNotEnoughFunds)
+	t.Run("the margin of the outcome positions is funded by and paid back to the shared insurance pool", testCompleteSetMarginTransfers)
+	t.Run("the shared insurance pool is cleared with the last outcome market", testSharedInsurancePoolClearedWithLastMarket)
+}
+
+const outcomeMarketID = "outcome-market"
+
+func getTestPredictionEngine(t *testing.T) *testEngine {
+	t.Helper()
+	eng := getTestEngine(t)
+	eng.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	eng.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+
+	// the test market is the first outcome market, its ID is the ID of the prediction
+	eng.ShareInsurancePool(testMarketID, testMarketID)
+	eng.ShareInsurancePool(outcomeMarketID, testMarketID)
+	_, _, err := eng.CreateMarketAccounts(context.Background(), outcomeMarketID, testMarketAsset)
+	assert.NoError(t, err)
+	return eng
+}
+
+func testOutcomeMarketsShareInsurancePool(t *testing.T) {
+	eng := getTestPredictionEngine(t)
+	defer eng.Finish()
+
+	pool, err := eng.GetMarketInsurancePoolAccount(testMarketID, testMarketAsset)
+	assert.NoError(t, err)
+	outcomePool, err := eng.GetMarketInsurancePoolAccount(outcomeMarketID, testMarketAsset)
+	assert.NoError(t, err)
+	assert.Equal(t, eng.marketInsuranceID, outcomePool.ID)
+	assert.Equal(t, pool.ID, outcomePool.ID)
+}
+
+func newCompleteSetTransfer(party string, amount uint64, tt types.TransferType) *types.Transfer {
+	return &types.Transfer{
+		Owner: party,
+		Amount: &types.FinancialAmount{
+			Amount: num.NewUint(amount),
+			Asset:  testMarketAsset,
+		},
+		Type: tt,
+	}
+}
+
+func testCompleteSetTransfers(t *testing.T) {
+	ctx := context.Background()
+	party := "test-party"
+	eng := getTestPredictionEngine(t)
+	defer eng.Finish()
+
+	gID, err := eng.CreatePartyGeneralAccount(ctx, party, testMarketAsset)
+	assert.NoError(t, err)
+	assert.NoError(t, eng.UpdateBalance(ctx, gID, num.NewUint(1000)))
+
+	_, err = eng.CompleteSetUpdate(ctx, outcomeMarketID, newCompleteSetTransfer(party, 600, types.TransferTypeCompleteSetMint))
+	assert.NoError(t, err)
+
+	general, _ := eng.GetPartyGeneralAccount(party, testMarketAsset)
+	pool, _ := eng.GetMarketInsurancePoolAccount(testMarketID, testMarketAsset)
+	assert.Equal(t, num.NewUint(400), general.Balance)
+	assert.Equal(t, num.NewUint(600), pool.Balance)
+
+	_, err = eng.CompleteSetUpdate(ctx, testMarketID, newCompleteSetTransfer(party, 200, types.TransferTypeCompleteSetRedeem))
+	assert.NoError(t, err)
+
+	general, _ = eng.GetPartyGeneralAccount(party, testMarketAsset)
+	pool, _ = eng.GetMarketInsurancePoolAccount(outcomeMarketID, testMarketAsset)
+	assert.Equal(t, num.NewUint(600), general.Balance)
+	assert.Equal(t, num.NewUint(400), pool.Balance)
+}
+
+func testCompleteSetMintNotEnoughFunds(t *testing.T) {
+	ctx := context.Background()
+	party := "test-party"
+	eng := getTestPredictionEngine(t)
+	defer eng.Finish()
+
+	gID, err := eng.CreatePartyGeneralAccount(ctx, party, testMarketAsset)
+	assert.NoError(t, err)
+	assert.NoError(t, eng.UpdateBalance(ctx, gID, num.NewUint(100)))
+
+	_, err = eng.CompleteSetUpdate(ctx, testMarketID, newCompleteSetTransfer(party, 101, types.TransferTypeCompleteSetMint))
+	assert.ErrorIs(t, err, collateral.ErrNotEnoughFundsForCompleteSet)
+}
+
+func testCompleteSetMarginTransfers(t *testing.T) {
+	ctx := context.Background()
+	party := "test-party"
+	eng := getTestPredictionEngine(t)
+	defer eng.Finish()
+
+	gID, err := eng.CreatePartyGeneralAccount(ctx, party, testMarketAsset)
+	assert.NoError(t, err)
+	assert.NoError(t, eng.UpdateBalance(ctx, gID, num.NewUint(100)))
+	mID, err := eng.CreatePartyMarginAccount(ctx, party, outcomeMarketID, testMarketAsset)
+	assert.NoError(t, err)
+	assert.NoError(t, eng.UpdateBalance(ctx, eng.marketInsuranceID, num.NewUint(500)))
+
+	// the insurance pool funds as much of the margin as it can
+	_, err = eng.CompleteSetMarginUpdate(ctx, outcomeMarketID, newCompleteSetTransfer(party, 800, types.TransferTypeCompleteSetMint))
+	assert.NoError(t, err)
+	margin, _ := eng.GetAccountByID(mID)
+	pool, _ := eng.GetMarketInsurancePoolAccount(outcomeMarketID, testMarketAsset)
+	assert.Equal(t, num.NewUint(500), margin.Balance)
+	assert.True(t, pool.Balance.IsZero())
+
+	// the general account covers what the margin account can't
+	_, err = eng.CompleteSetMarginUpdate(ctx, outcomeMarketID, newCompleteSetTransfer(party, 550, types.TransferTypeCompleteSetRedeem))
+	assert.NoError(t, err)
+	margin, _ = eng.GetAccountByID(mID)
+	general, _ := eng.GetPartyGeneralAccount(party, testMarketAsset)
+	pool, _ = eng.GetMarketInsurancePoolAccount(testMarketID, testMarketAsset)
+	assert.True(t, margin.Balance.IsZero())
+	assert.Equal(t, num.NewUint(50), general.Balance)
+	assert.Equal(t, num.NewUint(550), pool.Balance)
+
+	_, err = eng.CompleteSetMarginUpdate(ctx, outcomeMarketID, newCompleteSetTransfer(party, 51, types.TransferTypeCompleteSetRedeem))
+	assert.ErrorIs(t, err, collateral.ErrNotEnoughFundsForCompleteSet)
+}
+
+func testSharedInsurancePoolClearedWithLastMarket(t *testing.T) {
+	ctx := context.Background()
+	eng := getTestPredictionEngine(t)
+	defer eng.Finish()
+
+	assert.NoError(t, eng.UpdateBalance(ctx, eng.marketInsuranceID, num.NewUint(1000)))
+
+	// the first outcome market to go leaves the pool to the other one
+	_, err := eng.ClearInsurancepool(ctx, testMarketID, testMarketAsset, true)
+	assert.NoError(t, err)
+	pool, err := eng.GetMarketInsurancePoolAccount(outcomeMarketID, testMarketAsset)
+	assert.NoError(t, err)
+	assert.Equal(t, num.NewUint(1000), pool.Balance)
+
+	// the last one moves it to the global insurance pool
+	_, err = eng.ClearInsurancepool(ctx, outcomeMarketID, testMarketAsset, true)
+	assert.NoError(t, err)
+	_, err = eng.GetMarketInsurancePoolAccount(testMarketID, testMarketAsset)
+	assert.Error(t, err)
+	global, err := eng.GetGlobalInsuranceAccount(testMarketAsset)
+	assert.NoError(t, err)
+	assert.Equal(t, num.NewUint(1000), global.Balance)
+}
@@ -20,6 +20,7 @@ import (
 
 	"code.vegaprotocol.io/vega/core/types"
 	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/ptr"
 	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
 )
 
@@ -73,6 +74,14 @@ func NewAMMPoolEvent(
 	}
 }
 
+// WithBaseCommitment sets the amount of base asset committed to a pool on a spot market.
+func (p *AMMPool) WithBaseCommitment(baseCommitment *num.Uint) *AMMPool {
+	if baseCommitment != nil {
+		p.pool.BaseCommitment = ptr.From(baseCommitment.String())
+	}
+	return p
+}
+
 func (p AMMPool) IsParty(id string) bool {
 	return p.pool.PartyId == id
 }
@@ -0,0 +1,148 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package events
+
+import (
+	"context"
+
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+)
+
+// AutoDeleveraging contains the positions of a market reduced to absorb the position of the network
+// once the insurance pool is depleted.
+type AutoDeleveraging struct {
+	*Base
+	pb eventspb.AutoDeleveraging
+}
+
+func NewAutoDeleveragingEvent(ctx context.Context, marketID string, positions []*eventspb.AutoDeleveragedPosition) *AutoDeleveraging {
+	return &AutoDeleveraging{
+		Base: newBase(ctx, AutoDeleveragingEvent),
+		pb: eventspb.AutoDeleveraging{
+			MarketId:  marketID,
+			Positions: positions,
+		},
+	}
+}
+
+func (a AutoDeleveraging) MarketID() string {
+	return a.pb.MarketId
+}
+
+func (a AutoDeleveraging) IsMarket(marketID string) bool {
+	return a.pb.MarketId == marketID
+}
+
+func (a AutoDeleveraging) IsParty(partyID string) bool {
+	for _, p := range a.pb.Positions {
+		if p.PartyId == partyID {
+			return true
+		}
+	}
+	return false
+}
+
+func (a AutoDeleveraging) Positions() []*eventspb.AutoDeleveragedPosition {
+	return a.pb.Positions
+}
+
+func (a AutoDeleveraging) Proto() eventspb.AutoDeleveraging {
+	return a.pb
+}
+
+func (a AutoDeleveraging) StreamMessage() *eventspb.BusEvent {
+	busEvent := newBusEventFromBase(a.Base)
+	cpy := a.pb
+	busEvent.Event = &eventspb.BusEvent_AutoDeleveraging{
+		AutoDeleveraging: &cpy,
+	}
+
+	return busEvent
+}
+
+func (a AutoDeleveraging) StreamMarketMessage() *eventspb.BusEvent {
+	return a.StreamMessage()
+}
+
+func AutoDeleveragingEventFromStream(ctx context.Context, be *eventspb.BusEvent) *AutoDeleveraging {
+	return &AutoDeleveraging{
+		Base: newBaseFromBusEvent(ctx, AutoDeleveragingEvent, be),
+		pb:   *be.GetAutoDeleveraging(),
+	}
+}
+
+// AutoDeleveragingRankings contains the ranking of the profitable positions of a market, in the order
+// in which they would be reduced by auto-deleveraging.
+type AutoDeleveragingRankings struct {
+	*Base
+	pb eventspb.AutoDeleveragingRankings
+}
+
+func NewAutoDeleveragingRankingsEvent(ctx context.Context, marketID string, rankings []*eventspb.AutoDeleveragingRank) *AutoDeleveragingRankings {
+	return &AutoDeleveragingRankings{
+		Base: newBase(ctx, AutoDeleveragingRankingsEvent),
+		pb: eventspb.AutoDeleveragingRankings{
+			MarketId: marketID,
+			Rankings: rankings,
+		},
+	}
+}
+
+func (a AutoDeleveragingRankings) MarketID() string {
+	return a.pb.MarketId
+}
+
+func (a AutoDeleveragingRankings) IsMarket(marketID string) bool {
+	return a.pb.MarketId == marketID
+}
+
+func (a AutoDeleveragingRankings) IsParty(partyID string) bool {
+	for _, r := range a.pb.Rankings {
+		if r.PartyId == partyID {
+			return true
+		}
+	}
+	return false
+}
+
+func (a AutoDeleveragingRankings) Rankings() []*eventspb.AutoDeleveragingRank {
+	return a.pb.Rankings
+}
+
+func (a AutoDeleveragingRankings) Proto() eventspb.AutoDeleveragingRankings {
+	return a.pb
+}
+
+func (a AutoDeleveragingRankings) StreamMessage() *eventspb.BusEvent {
+	busEvent := newBusEventFromBase(a.Base)
+	cpy := a.pb
+	busEvent.Event = &eventspb.BusEvent_AutoDeleveragingRankings{
+		AutoDeleveragingRankings: &cpy,
+	}
+
+	return busEvent
+}
+
+func (a AutoDeleveragingRankings) StreamMarketMessage() *eventspb.BusEvent {
+	return a.StreamMessage()
+}
+
+func AutoDeleveragingRankingsEventFromStream(ctx context.Context, be *eventspb.BusEvent) *AutoDeleveragingRankings {
+	return &AutoDeleveragingRankings{
+		Base: newBaseFromBusEvent(ctx, AutoDeleveragingRankingsEvent, be),
+		pb:   *be.GetAutoDeleveragingRankings(),
+	}
+}
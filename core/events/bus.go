@@ -178,6 +178,14 @@ const (
 	VolumeRebateProgramEndedEvent
 	VolumeRebateProgramUpdatedEvent
 	VolumeRebateStatsUpdatedEvent
+	MarketMakerProtectionTriggeredEvent
+	CancelOnTimeoutTriggeredEvent
+	QuoteRequestEvent
+	QuoteEvent
+	PartialCloseOutsEvent
+	AutoDeleveragingEvent
+	AutoDeleveragingRankingsEvent
+	GovernanceDelegationEvent
 )
 
 var (
@@ -285,6 +293,14 @@ var (
 		eventspb.BusEventType_BUS_EVENT_TYPE_VOLUME_REBATE_PROGRAM_ENDED:             VolumeRebateProgramEndedEvent,
 		eventspb.BusEventType_BUS_EVENT_TYPE_VOLUME_REBATE_PROGRAM_UPDATED:           VolumeRebateProgramUpdatedEvent,
 		eventspb.BusEventType_BUS_EVENT_TYPE_VOLUME_REBATE_STATS_UPDATED:             VolumeRebateStatsUpdatedEvent,
+		eventspb.BusEventType_BUS_EVENT_TYPE_MARKET_MAKER_PROTECTION_TRIGGERED:       MarketMakerProtectionTriggeredEvent,
+		eventspb.BusEventType_BUS_EVENT_TYPE_CANCEL_ON_TIMEOUT_TRIGGERED:             CancelOnTimeoutTriggeredEvent,
+		eventspb.BusEventType_BUS_EVENT_TYPE_QUOTE_REQUEST:                           QuoteRequestEvent,
+		eventspb.BusEventType_BUS_EVENT_TYPE_QUOTE:                                   QuoteEvent,
+		eventspb.BusEventType_BUS_EVENT_TYPE_PARTIAL_CLOSE_OUTS:                      PartialCloseOutsEvent,
+		eventspb.BusEventType_BUS_EVENT_TYPE_AUTO_DELEVERAGING:                       AutoDeleveragingEvent,
+		eventspb.BusEventType_BUS_EVENT_TYPE_AUTO_DELEVERAGING_RANKINGS:              AutoDeleveragingRankingsEvent,
+		eventspb.BusEventType_BUS_EVENT_TYPE_GOVERNANCE_DELEGATION:                   GovernanceDelegationEvent,
 		// If adding a type here, please also add it to datanode/broker/convert.go
 	}
 
@@ -383,6 +399,14 @@ var (
 		VolumeRebateProgramEndedEvent:            eventspb.BusEventType_BUS_EVENT_TYPE_VOLUME_REBATE_PROGRAM_ENDED,
 		VolumeRebateProgramUpdatedEvent:          eventspb.BusEventType_BUS_EVENT_TYPE_VOLUME_REBATE_PROGRAM_UPDATED,
 		VolumeRebateStatsUpdatedEvent:            eventspb.BusEventType_BUS_EVENT_TYPE_VOLUME_REBATE_STATS_UPDATED,
+		MarketMakerProtectionTriggeredEvent:      eventspb.BusEventType_BUS_EVENT_TYPE_MARKET_MAKER_PROTECTION_TRIGGERED,
+		CancelOnTimeoutTriggeredEvent:            eventspb.BusEventType_BUS_EVENT_TYPE_CANCEL_ON_TIMEOUT_TRIGGERED,
+		QuoteRequestEvent:                        eventspb.BusEventType_BUS_EVENT_TYPE_QUOTE_REQUEST,
+		QuoteEvent:                               eventspb.BusEventType_BUS_EVENT_TYPE_QUOTE,
+		PartialCloseOutsEvent:                    eventspb.BusEventType_BUS_EVENT_TYPE_PARTIAL_CLOSE_OUTS,
+		AutoDeleveragingEvent:                    eventspb.BusEventType_BUS_EVENT_TYPE_AUTO_DELEVERAGING,
+		AutoDeleveragingRankingsEvent:            eventspb.BusEventType_BUS_EVENT_TYPE_AUTO_DELEVERAGING_RANKINGS,
+		GovernanceDelegationEvent:                eventspb.BusEventType_BUS_EVENT_TYPE_GOVERNANCE_DELEGATION,
 
 		// If adding a type here, please also add it to datanode/broker/convert.go
 	}
@@ -481,6 +505,14 @@ var (
 		VolumeRebateProgramEndedEvent:            "VolumeRebateProgramEndedEvent",
 		VolumeRebateProgramUpdatedEvent:          "VolumeRebateProgramUpdatedEvent",
 		VolumeRebateStatsUpdatedEvent:            "VolumeRebateStatsUpdatedEvent",
+		MarketMakerProtectionTriggeredEvent:      "MarketMakerProtectionTriggeredEvent",
+		CancelOnTimeoutTriggeredEvent:            "CancelOnTimeoutTriggeredEvent",
+		QuoteRequestEvent:                        "QuoteRequestEvent",
+		QuoteEvent:                               "QuoteEvent",
+		PartialCloseOutsEvent:                    "PartialCloseOutsEvent",
+		AutoDeleveragingEvent:                    "AutoDeleveragingEvent",
+		AutoDeleveragingRankingsEvent:            "AutoDeleveragingRankingsEvent",
+		GovernanceDelegationEvent:                "GovernanceDelegationEvent",
 	}
 )
 
@@ -0,0 +1,72 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package events
+
+import (
+	"context"
+	"time"
+
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+)
+
+type CancelOnTimeoutTriggered struct {
+	*Base
+	pb *eventspb.CancelOnTimeoutTriggered
+}
+
+func NewCancelOnTimeoutTriggeredEvent(ctx context.Context, partyID string, marketIDs []string, ammsCancelled bool, deadline time.Time) *CancelOnTimeoutTriggered {
+	return &CancelOnTimeoutTriggered{
+		Base: newBase(ctx, CancelOnTimeoutTriggeredEvent),
+		pb: &eventspb.CancelOnTimeoutTriggered{
+			PartyId:       partyID,
+			MarketIds:     marketIDs,
+			AmmsCancelled: ammsCancelled,
+			Deadline:      deadline.UnixNano(),
+		},
+	}
+}
+
+func (c CancelOnTimeoutTriggered) PartyID() string {
+	return c.pb.PartyId
+}
+
+func (c CancelOnTimeoutTriggered) IsParty(pID string) bool {
+	return c.pb.PartyId == pID
+}
+
+func (c CancelOnTimeoutTriggered) MarketIDs() []string {
+	return c.pb.MarketIds
+}
+
+func (c CancelOnTimeoutTriggered) Proto() *eventspb.CancelOnTimeoutTriggered {
+	return c.pb
+}
+
+func (c CancelOnTimeoutTriggered) StreamMessage() *eventspb.BusEvent {
+	busEvent := newBusEventFromBase(c.Base)
+	busEvent.Event = &eventspb.BusEvent_CancelOnTimeoutTriggered{
+		CancelOnTimeoutTriggered: c.pb,
+	}
+
+	return busEvent
+}
+
+func CancelOnTimeoutTriggeredEventFromStream(ctx context.Context, be *eventspb.BusEvent) *CancelOnTimeoutTriggered {
+	return &CancelOnTimeoutTriggered{
+		Base: newBaseFromBusEvent(ctx, CancelOnTimeoutTriggeredEvent, be),
+		pb:   be.GetCancelOnTimeoutTriggered(),
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package events
+
+import (
+	"context"
+
+	"code.vegaprotocol.io/vega/core/types"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+)
+
+// GovernanceDelegation is emitted when a party registers, updates or revokes
+// its governance delegate.
+type GovernanceDelegation struct {
+	*Base
+	d vegapb.GovernanceDelegation
+}
+
+func NewGovernanceDelegationEvent(ctx context.Context, d types.GovernanceDelegation) *GovernanceDelegation {
+	return &GovernanceDelegation{
+		Base: newBase(ctx, GovernanceDelegationEvent),
+		d:    *d.IntoProto(),
+	}
+}
+
+// GovernanceDelegation returns the delegation object.
+func (g GovernanceDelegation) GovernanceDelegation() vegapb.GovernanceDelegation {
+	return g.d
+}
+
+// IsParty - used in event stream API filter.
+func (g GovernanceDelegation) IsParty(id string) bool {
+	return g.d.Delegator == id || g.d.Delegate == id
+}
+
+func (g GovernanceDelegation) Proto() vegapb.GovernanceDelegation {
+	return g.d
+}
+
+func (g GovernanceDelegation) StreamMessage() *eventspb.BusEvent {
+	busEvent := newBusEventFromBase(g.Base)
+	busEvent.Event = &eventspb.BusEvent_GovernanceDelegation{
+		GovernanceDelegation: &g.d,
+	}
+
+	return busEvent
+}
+
+func GovernanceDelegationEventFromStream(ctx context.Context, be *eventspb.BusEvent) *GovernanceDelegation {
+	return &GovernanceDelegation{
+		Base: newBaseFromBusEvent(ctx, GovernanceDelegationEvent, be),
+		d:    *be.GetGovernanceDelegation(),
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package events
+
+import (
+	"context"
+	"time"
+
+	"code.vegaprotocol.io/vega/libs/num"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+)
+
+type MarketMakerProtectionTriggered struct {
+	*Base
+	pb *eventspb.MarketMakerProtectionTriggered
+}
+
+func NewMarketMakerProtectionTriggeredEvent(
+	ctx context.Context,
+	marketID, partyID string,
+	limit eventspb.MarketMakerProtectionTriggered_Limit,
+	filled *num.Uint,
+	frozenUntil time.Time,
+) *MarketMakerProtectionTriggered {
+	return &MarketMakerProtectionTriggered{
+		Base: newBase(ctx, MarketMakerProtectionTriggeredEvent),
+		pb: &eventspb.MarketMakerProtectionTriggered{
+			MarketId:    marketID,
+			PartyId:     partyID,
+			Limit:       limit,
+			Filled:      filled.String(),
+			FrozenUntil: frozenUntil.UnixNano(),
+		},
+	}
+}
+
+func (m MarketMakerProtectionTriggered) MarketID() string {
+	return m.pb.MarketId
+}
+
+func (m MarketMakerProtectionTriggered) IsMarket(mID string) bool {
+	return m.pb.MarketId == mID
+}
+
+func (m MarketMakerProtectionTriggered) PartyID() string {
+	return m.pb.PartyId
+}
+
+func (m MarketMakerProtectionTriggered) IsParty(pID string) bool {
+	return m.pb.PartyId == pID
+}
+
+func (m MarketMakerProtectionTriggered) Proto() *eventspb.MarketMakerProtectionTriggered {
+	return m.pb
+}
+
+func (m MarketMakerProtectionTriggered) StreamMessage() *eventspb.BusEvent {
+	busEvent := newBusEventFromBase(m.Base)
+	busEvent.Event = &eventspb.BusEvent_MarketMakerProtectionTriggered{
+		MarketMakerProtectionTriggered: m.pb,
+	}
+
+	return busEvent
+}
+
+func (m MarketMakerProtectionTriggered) StreamMarketMessage() *eventspb.BusEvent {
+	return m.StreamMessage()
+}
+
+func MarketMakerProtectionTriggeredEventFromStream(ctx context.Context, be *eventspb.BusEvent) *MarketMakerProtectionTriggered {
+	return &MarketMakerProtectionTriggered{
+		Base: newBaseFromBusEvent(ctx, MarketMakerProtectionTriggeredEvent, be),
+		pb:   be.GetMarketMakerProtectionTriggered(),
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package events
+
+import (
+	"context"
+
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+)
+
+// PartialCloseOuts contains the distressed parties of a market which had only part of their position
+// transferred to the network, and kept the rest of it.
+type PartialCloseOuts struct {
+	*Base
+	pb eventspb.PartialCloseOuts
+}
+
+func NewPartialCloseOutsEvent(ctx context.Context, marketID string, closeOuts []*eventspb.PartialCloseOut) *PartialCloseOuts {
+	return &PartialCloseOuts{
+		Base: newBase(ctx, PartialCloseOutsEvent),
+		pb: eventspb.PartialCloseOuts{
+			MarketId:  marketID,
+			CloseOuts: closeOuts,
+		},
+	}
+}
+
+func (p PartialCloseOuts) MarketID() string {
+	return p.pb.MarketId
+}
+
+func (p PartialCloseOuts) IsMarket(marketID string) bool {
+	return p.pb.MarketId == marketID
+}
+
+func (p PartialCloseOuts) IsParty(partyID string) bool {
+	for _, co := range p.pb.CloseOuts {
+		if co.PartyId == partyID {
+			return true
+		}
+	}
+	return false
+}
+
+func (p PartialCloseOuts) CloseOuts() []*eventspb.PartialCloseOut {
+	return p.pb.CloseOuts
+}
+
+func (p PartialCloseOuts) Proto() eventspb.PartialCloseOuts {
+	return p.pb
+}
+
+func (p PartialCloseOuts) StreamMessage() *eventspb.BusEvent {
+	busEvent := newBusEventFromBase(p.Base)
+	cpy := p.pb
+	busEvent.Event = &eventspb.BusEvent_PartialCloseOuts{
+		PartialCloseOuts: &cpy,
+	}
+
+	return busEvent
+}
+
+func (p PartialCloseOuts) StreamMarketMessage() *eventspb.BusEvent {
+	return p.StreamMessage()
+}
+
+func PartialCloseOutsEventFromStream(ctx context.Context, be *eventspb.BusEvent) *PartialCloseOuts {
+	return &PartialCloseOuts{
+		Base: newBaseFromBusEvent(ctx, PartialCloseOutsEvent, be),
+		pb:   *be.GetPartialCloseOuts(),
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package events
+
+import (
+	"context"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/protos/vega"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+)
+
+type QuoteRequest struct {
+	*Base
+	qr *vega.QuoteRequest
+}
+
+func NewQuoteRequestEvent(ctx context.Context, qr *types.QuoteRequest) *QuoteRequest {
+	return &QuoteRequest{
+		Base: newBase(ctx, QuoteRequestEvent),
+		qr:   qr.IntoProto(),
+	}
+}
+
+func (q QuoteRequest) QuoteRequest() *vega.QuoteRequest {
+	return q.qr
+}
+
+func (q QuoteRequest) PartyID() string {
+	return q.qr.PartyId
+}
+
+func (q QuoteRequest) IsParty(id string) bool {
+	return q.qr.PartyId == id
+}
+
+func (q QuoteRequest) MarketID() string {
+	return q.qr.MarketId
+}
+
+func (q QuoteRequest) Proto() *vega.QuoteRequest {
+	return q.qr
+}
+
+func (q QuoteRequest) StreamMessage() *eventspb.BusEvent {
+	busEvent := newBusEventFromBase(q.Base)
+	busEvent.Event = &eventspb.BusEvent_QuoteRequest{
+		QuoteRequest: q.qr,
+	}
+	return busEvent
+}
+
+func QuoteRequestEventFromStream(ctx context.Context, be *eventspb.BusEvent) *QuoteRequest {
+	return &QuoteRequest{
+		Base: newBaseFromBusEvent(ctx, QuoteRequestEvent, be),
+		qr:   be.GetQuoteRequest(),
+	}
+}
+
+type Quote struct {
+	*Base
+	q *vega.Quote
+}
+
+func NewQuoteEvent(ctx context.Context, q *types.Quote) *Quote {
+	return &Quote{
+		Base: newBase(ctx, QuoteEvent),
+		q:    q.IntoProto(),
+	}
+}
+
+func (q Quote) Quote() *vega.Quote {
+	return q.q
+}
+
+func (q Quote) PartyID() string {
+	return q.q.PartyId
+}
+
+func (q Quote) IsParty(id string) bool {
+	return q.q.PartyId == id
+}
+
+func (q Quote) MarketID() string {
+	return q.q.MarketId
+}
+
+func (q Quote) Proto() *vega.Quote {
+	return q.q
+}
+
+func (q Quote) StreamMessage() *eventspb.BusEvent {
+	busEvent := newBusEventFromBase(q.Base)
+	busEvent.Event = &eventspb.BusEvent_Quote{
+		Quote: q.q,
+	}
+	return busEvent
+}
+
+func QuoteEventFromStream(ctx context.Context, be *eventspb.BusEvent) *Quote {
+	return &Quote{
+		Base: newBaseFromBusEvent(ctx, QuoteEvent, be),
+		q:    be.GetQuote(),
+	}
+}
@@ -260,6 +260,42 @@ func (t *TransactionResult) setTx(tx interface{}) *TransactionResult {
 		t.evt.Transaction = &eventspb.TransactionResult_CancelAmm{
 			CancelAmm: tv,
 		}
+	case *commandspb.UpdateMarketMakerProtection:
+		t.evt.Transaction = &eventspb.TransactionResult_UpdateMarketMakerProtection{
+			UpdateMarketMakerProtection: tv,
+		}
+	case *commandspb.CancelOnTimeout:
+		t.evt.Transaction = &eventspb.TransactionResult_CancelOnTimeout{
+			CancelOnTimeout: tv,
+		}
+	case *commandspb.SubmitSpreadOrder:
+		t.evt.Transaction = &eventspb.TransactionResult_SubmitSpreadOrder{
+			SubmitSpreadOrder: tv,
+		}
+	case *commandspb.SubmitQuoteRequest:
+		t.evt.Transaction = &eventspb.TransactionResult_SubmitQuoteRequest{
+			SubmitQuoteRequest: tv,
+		}
+	case *commandspb.SubmitQuote:
+		t.evt.Transaction = &eventspb.TransactionResult_SubmitQuote{
+			SubmitQuote: tv,
+		}
+	case *commandspb.AcceptQuote:
+		t.evt.Transaction = &eventspb.TransactionResult_AcceptQuote{
+			AcceptQuote: tv,
+		}
+	case *commandspb.CancelQuoteRequest:
+		t.evt.Transaction = &eventspb.TransactionResult_CancelQuoteRequest{
+			CancelQuoteRequest: tv,
+		}
+	case *commandspb.SubmitCompleteSet:
+		t.evt.Transaction = &eventspb.TransactionResult_SubmitCompleteSet{
+			SubmitCompleteSet: tv,
+		}
+	case *commandspb.UpdateGovernanceDelegation:
+		t.evt.Transaction = &eventspb.TransactionResult_UpdateGovernanceDelegation{
+			UpdateGovernanceDelegation: tv,
+		}
 	default:
 		panic(fmt.Sprintf("unsupported command %T", tv))
 	}
@@ -97,6 +97,48 @@ func NewRecurringTransferFundsEventWithReason(
 	}
 }
 
+func NewStreamingTransferFundsEvent(
+	ctx context.Context,
+	t *types.StreamingTransfer,
+) *TransferFunds {
+	return &TransferFunds{
+		Base:     newBase(ctx, TransferEvent),
+		transfer: t.IntoEvent(nil),
+	}
+}
+
+func NewStreamingTransferFundsEventWithReason(
+	ctx context.Context,
+	t *types.StreamingTransfer,
+	reason string,
+) *TransferFunds {
+	return &TransferFunds{
+		Base:     newBase(ctx, TransferEvent),
+		transfer: t.IntoEvent(&reason),
+	}
+}
+
+func NewConditionalTransferFundsEvent(
+	ctx context.Context,
+	t *types.ConditionalTransfer,
+) *TransferFunds {
+	return &TransferFunds{
+		Base:     newBase(ctx, TransferEvent),
+		transfer: t.IntoEvent(nil),
+	}
+}
+
+func NewConditionalTransferFundsEventWithReason(
+	ctx context.Context,
+	t *types.ConditionalTransfer,
+	reason string,
+) *TransferFunds {
+	return &TransferFunds{
+		Base:     newBase(ctx, TransferEvent),
+		transfer: t.IntoEvent(&reason),
+	}
+}
+
 func (t TransferFunds) PartyID() string {
 	return t.transfer.From
 }
@@ -144,6 +144,10 @@ type Engine struct {
 
 	minCommitmentQuantum *num.Uint
 	maxCalculationLevels *num.Uint
+
+	// only set on spot markets, where the pools hold the base asset alongside the quote asset
+	baseAsset  string
+	baseFactor num.Decimal
 }
 
 func New(
@@ -179,6 +183,27 @@ func New(
 	}
 }
 
+// NewSpot returns an engine for a spot market. The pools are fully funded, they hold the quote asset to buy
+// and the base asset to sell, and their position is the base they hold above or below their base commitment.
+func NewSpot(
+	log *logging.Logger,
+	broker Broker,
+	collateral Collateral,
+	marketID string,
+	quoteAsset string,
+	baseAsset string,
+	priceFactor num.Decimal,
+	positionFactor num.Decimal,
+	baseFactor num.Decimal,
+	marketActivityTracker *common.MarketActivityTracker,
+	parties common.Parties,
+) *Engine {
+	e := New(log, broker, collateral, marketID, quoteAsset, nil, priceFactor, positionFactor, marketActivityTracker, parties)
+	e.baseAsset = baseAsset
+	e.baseFactor = baseFactor
+	return e
+}
+
 func NewFromProto(
 	log *logging.Logger,
 	broker Broker,
@@ -193,20 +218,49 @@ func NewFromProto(
 	parties common.Parties,
 ) (*Engine, error) {
 	e := New(log, broker, collateral, marketID, assetID, position, priceFactor, positionFactor, marketActivityTracker, parties)
+	return e, e.restore(state)
+}
+
+func NewSpotFromProto(
+	log *logging.Logger,
+	broker Broker,
+	collateral Collateral,
+	marketID string,
+	quoteAsset string,
+	baseAsset string,
+	state *v1.AmmState,
+	priceFactor num.Decimal,
+	positionFactor num.Decimal,
+	baseFactor num.Decimal,
+	marketActivityTracker *common.MarketActivityTracker,
+	parties common.Parties,
+) (*Engine, error) {
+	e := NewSpot(log, broker, collateral, marketID, quoteAsset, baseAsset, priceFactor, positionFactor, baseFactor, marketActivityTracker, parties)
+	return e, e.restore(state)
+}
 
+func (e *Engine) restore(state *v1.AmmState) error {
 	for _, v := range state.AmmPartyIds {
 		e.ammParties[v.Key] = v.Value
 	}
 
 	for _, v := range state.Pools {
-		p, err := NewPoolFromProto(log, e.rooter.sqrt, e.collateral, e.position, v.Pool, v.Party, priceFactor, positionFactor)
+		p, err := NewPoolFromProto(e.log, e.rooter.sqrt, e.collateral, e.position, v.Pool, v.Party, e.priceFactor, e.positionFactor)
 		if err != nil {
-			return e, err
+			return err
+		}
+		if p.isSpot() {
+			p.baseAsset = e.baseAsset
+			p.baseFactor = e.baseFactor
 		}
 		e.add(p)
 	}
+	return nil
+}
 
-	return e, nil
+// isSpot returns whether the engine runs the pools of a spot market.
+func (e *Engine) isSpot() bool {
+	return e.baseAsset != ""
 }
 
 func (e *Engine) IntoProto() *v1.AmmState {
@@ -365,6 +419,12 @@ func (e *Engine) submit(active []*Pool, agg *types.Order, inner, outer *num.Uint
 	orders := []*types.Order{}
 	useActive := make([]*Pool, 0, len(active))
 	for _, p := range active {
+		// self-trade prevention against the party's own pool is applied by the order book
+		// through SelfTradeOrder, whatever the outcome the pool is left out of the match
+		if p.owner == agg.Party && agg.SelfTradePrevention != types.OrderSelfTradePreventionUnspecified {
+			continue
+		}
+
 		p.setEphemeralPosition()
 
 		price := p.BestPrice(agg)
@@ -599,6 +659,83 @@ func (e *Engine) SubmitOrder(agg *types.Order, inner, outer *num.Uint) []*types.
 	return orders
 }
 
+// SelfTradeOrder returns the order the pool owned by the aggressive order's party would generate to trade with it
+// between the price levels inner and outer, or nil if the party has no pool able to trade there. The pool's ephemeral
+// position is initialised, as it is for any matching, but it is not updated with the order so that the order book can
+// apply the aggressive order's self-trade prevention to the pool's volume.
+func (e *Engine) SelfTradeOrder(agg *types.Order, inner, outer *num.Uint) *types.Order {
+	p, ok := e.pools[agg.Party]
+	if !ok || !p.canTrade(agg.Side) {
+		return nil
+	}
+
+	// only the outer bounds of the partitioned range matter here, they are ordered in the direction of the order
+	_, levels := e.partition(agg, inner, outer)
+	if len(levels) < 2 {
+		return nil
+	}
+	inner, outer = levels[0], levels[len(levels)-1]
+
+	p.setEphemeralPosition()
+	price := p.BestPrice(agg)
+	if agg.Side == types.SideBuy && (price.GT(outer) || (agg.Type != types.OrderTypeMarket && price.GT(agg.Price))) {
+		return nil
+	}
+	if agg.Side == types.SideSell && (price.LT(outer) || (agg.Type != types.OrderTypeMarket && price.LT(agg.Price))) {
+		return nil
+	}
+
+	volume := p.TradableVolumeInRange(agg.Side, inner, outer)
+	if volume == 0 {
+		return nil
+	}
+
+	o := p.makeOrder(volume, p.PriceForVolume(volume, agg.Side), types.OtherSide(agg.Side), nil)
+	o.CreatedAt = agg.CreatedAt
+	return o
+}
+
+// VolumeAtPrice returns the volume the pools would trade with the aggressive order at the given price, along with
+// the price one tick before it in the direction of the order. It lets the order book share the volume at one of its
+// price levels between the pools and the orders resting there. No order is generated so the pools do not trade.
+func (e *Engine) VolumeAtPrice(agg *types.Order, price *num.Uint) (uint64, *num.Uint) {
+	// as when partitioning a single price, the volume at a price is the volume of the tick leading up to it
+	var low, high, before *num.Uint
+	if agg.Side == types.SideBuy {
+		before = num.UintZero()
+		if price.GT(e.oneTick) {
+			before.Sub(price, e.oneTick)
+		}
+		low, high = before, price
+	} else {
+		before = num.UintZero().Add(price, e.oneTick)
+		low, high = price, before
+	}
+
+	var volume uint64
+	for _, p := range e.poolsCpy {
+		if !p.canTrade(agg.Side) || agg.Party == p.AMMParty {
+			continue
+		}
+
+		// the party's own pool is left out of the match when the order has self-trade prevention set
+		if p.owner == agg.Party && agg.SelfTradePrevention != types.OrderSelfTradePreventionUnspecified {
+			continue
+		}
+
+		p.setEphemeralPosition()
+		best := p.BestPrice(agg)
+		if agg.Side == types.SideBuy && (best.GT(price) || (agg.Type != types.OrderTypeMarket && best.GT(agg.Price))) {
+			continue
+		}
+		if agg.Side == types.SideSell && (best.LT(price) || (agg.Type != types.OrderTypeMarket && best.LT(agg.Price))) {
+			continue
+		}
+		volume += p.TradableVolumeInRange(agg.Side, low, high)
+	}
+	return volume, before
+}
+
 // NotifyFinished is called when the matching engine has finished matching an order and is returning it to
 // the market for processing.
 func (e *Engine) NotifyFinished() {
@@ -634,7 +771,11 @@ func (e *Engine) Create(
 		return nil, ErrPartyAlreadyOwnAPool(e.marketID)
 	}
 
-	if err := e.ensureCommitmentAmount(ctx, submit.Party, subAccount, submit.CommitmentAmount); err != nil {
+	err := e.ensureCommitmentAmount(ctx, submit.Party, subAccount, submit.CommitmentAmount)
+	if err == nil && e.isSpot() && submit.BaseCommitmentAmount != nil {
+		err = e.ensureBaseCommitmentAmount(submit.Party, subAccount, submit.BaseCommitmentAmount)
+	}
+	if err != nil {
 		reason := types.AMMStatusReasonCannotFillCommitment
 		if err == ErrCommitmentTooLow {
 			reason = types.AMMStatusReasonCommitmentTooLow
@@ -650,7 +791,10 @@ func (e *Engine) Create(
 		return nil, err
 	}
 
-	_, _, err := e.collateral.CreatePartyAMMsSubAccounts(ctx, submit.Party, subAccount, e.assetID, submit.MarketID)
+	_, _, err = e.collateral.CreatePartyAMMsSubAccounts(ctx, submit.Party, subAccount, e.assetID, submit.MarketID)
+	if err == nil && e.isSpot() {
+		_, _, err = e.collateral.CreatePartyAMMsSubAccounts(ctx, submit.Party, subAccount, e.baseAsset, submit.MarketID)
+	}
 	if err != nil {
 		e.broker.Send(
 			events.NewAMMPoolEvent(
@@ -664,22 +808,40 @@ func (e *Engine) Create(
 		return nil, err
 	}
 
-	pool, err := NewPool(
-		e.log,
-		poolID,
-		subAccount,
-		e.assetID,
-		submit,
-		e.rooter.sqrt,
-		e.collateral,
-		e.position,
-		riskFactors,
-		scalingFactors,
-		slippage,
-		e.priceFactor,
-		e.positionFactor,
-		e.maxCalculationLevels,
-	)
+	var pool *Pool
+	if e.isSpot() {
+		pool, err = NewSpotPool(
+			e.log,
+			poolID,
+			subAccount,
+			e.assetID,
+			e.baseAsset,
+			submit,
+			e.rooter.sqrt,
+			e.collateral,
+			e.priceFactor,
+			e.positionFactor,
+			e.baseFactor,
+			e.maxCalculationLevels,
+		)
+	} else {
+		pool, err = NewPool(
+			e.log,
+			poolID,
+			subAccount,
+			e.assetID,
+			submit,
+			e.rooter.sqrt,
+			e.collateral,
+			e.position,
+			riskFactors,
+			scalingFactors,
+			slippage,
+			e.priceFactor,
+			e.positionFactor,
+			e.maxCalculationLevels,
+		)
+	}
 	if err != nil {
 		e.broker.Send(
 			events.NewAMMPoolEvent(
@@ -696,8 +858,8 @@ func (e *Engine) Create(
 	// sanity check, a *new* AMM should not already have a position. If it does it means that the party
 	// previously had an AMM but it was stopped/cancelled while still holding a position which should not happen.
 	// It should have either handed its position over to the liquidation engine, or be in reduce-only mode
-	// and only be removed when its position is 0.
-	if pool.getPosition() != 0 {
+	// and only be removed when its position is 0. A spot pool's position only makes sense once it is funded.
+	if !pool.isSpot() && pool.getPosition() != 0 {
 		e.log.Panic("AMM has position before existing")
 	}
 
@@ -748,6 +910,12 @@ func (e *Engine) Amend(
 		}
 	}
 
+	if pool.isSpot() && amend.BaseCommitmentAmount != nil {
+		if err := e.ensureBaseCommitmentAmount(amend.Party, pool.AMMParty, amend.BaseCommitmentAmount); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	updated, err := pool.Update(amend, riskFactors, scalingFactors, slippage)
 	if err != nil {
 		return nil, nil, err
@@ -842,7 +1010,7 @@ func (e *Engine) sendUpdate(ctx context.Context, pool *Pool) {
 				VirtualLiquidity:    pool.upper.l,
 				TheoreticalPosition: pool.upper.pv,
 			},
-		),
+		).WithBaseCommitment(pool.BaseCommitment),
 	)
 }
 
@@ -881,9 +1049,30 @@ func (e *Engine) ensureCommitmentAmount(
 	return nil
 }
 
+// ensureBaseCommitmentAmount checks that the owner of a spot pool can fund its base commitment.
+func (e *Engine) ensureBaseCommitmentAmount(party, subAccount string, baseCommitment *num.Uint) error {
+	total := num.UintZero()
+	if a, err := e.collateral.GetPartyGeneralAccount(subAccount, e.baseAsset); err == nil {
+		total.Add(total, a.Balance)
+	}
+
+	if a, err := e.collateral.GetPartyGeneralAccount(party, e.baseAsset); err == nil {
+		total.Add(total, a.Balance)
+	}
+
+	if total.LT(baseCommitment) {
+		return fmt.Errorf("not enough base asset in general account")
+	}
+	return nil
+}
+
 // releaseSubAccountGeneralBalance returns the full balance of the sub-accounts general account back to the
 // owner of the pool.
 func (e *Engine) releaseSubAccounts(ctx context.Context, pool *Pool, mktClose bool) (events.Margin, error) {
+	if pool.isSpot() {
+		return nil, e.releaseSpotSubAccounts(ctx, pool, mktClose)
+	}
+
 	if mktClose {
 		ledgerMovements, err := e.collateral.SubAccountClosed(ctx, pool.owner, pool.AMMParty, pool.asset, pool.market)
 		if err != nil {
@@ -911,6 +1100,85 @@ func (e *Engine) releaseSubAccounts(ctx context.Context, pool *Pool, mktClose bo
 	return closeout, nil
 }
 
+// releaseSpotSubAccounts returns both the quote and the base held by a spot pool to its owner. A spot pool owns
+// what it holds so there is never a position to close out.
+func (e *Engine) releaseSpotSubAccounts(ctx context.Context, pool *Pool, mktClose bool) error {
+	ledgerMovements := []*types.LedgerMovement{}
+	for _, asset := range []string{pool.asset, pool.baseAsset} {
+		if mktClose {
+			lm, err := e.collateral.SubAccountClosed(ctx, pool.owner, pool.AMMParty, asset, pool.market)
+			if err != nil {
+				return err
+			}
+			ledgerMovements = append(ledgerMovements, lm...)
+			continue
+		}
+
+		lm, _, err := e.collateral.SubAccountRelease(ctx, pool.owner, pool.AMMParty, asset, pool.market, nil)
+		if err != nil {
+			return err
+		}
+		ledgerMovements = append(ledgerMovements, lm...)
+	}
+
+	e.broker.Send(events.NewLedgerMovements(ctx, ledgerMovements))
+	return nil
+}
+
+// UpdateSpotSubAccountBalances moves the change in the quote and base commitments of a spot pool between its
+// owner and its sub-account. Only the difference is moved so that what the pool has gained or lost from
+// trading stays in the pool. The previous pool is nil when the pool is being created.
+func (e *Engine) UpdateSpotSubAccountBalances(ctx context.Context, pool, previous *Pool) error {
+	prevQuote, prevBase := num.UintZero(), num.UintZero()
+	if previous != nil {
+		prevQuote, prevBase = previous.Commitment, previous.BaseCommitment
+	}
+
+	type update struct {
+		asset        string
+		transferType types.TransferType
+		amount       *num.Uint
+	}
+	updates := make([]update, 0, 2)
+	for _, c := range []struct {
+		asset           string
+		current, target *num.Uint
+	}{
+		{asset: pool.asset, current: prevQuote, target: pool.Commitment},
+		{asset: pool.baseAsset, current: prevBase, target: pool.BaseCommitment},
+	} {
+		switch {
+		case c.current.LT(c.target):
+			amount := num.UintZero().Sub(c.target, c.current)
+			if owner, err := e.collateral.GetPartyGeneralAccount(pool.owner, c.asset); err != nil || owner.Balance.LT(amount) {
+				return fmt.Errorf("not enough collateral in general account")
+			}
+			updates = append(updates, update{asset: c.asset, transferType: types.TransferTypeAMMLow, amount: amount})
+		case c.current.GT(c.target):
+			amount := num.UintZero().Sub(c.current, c.target)
+			if pool.generalBalance(c.asset).LT(amount) {
+				return fmt.Errorf("not enough left in the pool to reduce its commitment")
+			}
+			updates = append(updates, update{asset: c.asset, transferType: types.TransferTypeAMMHigh, amount: amount})
+		}
+	}
+
+	ledgerMovements := make([]*types.LedgerMovement, 0, len(updates))
+	for _, u := range updates {
+		lm, err := e.collateral.SubAccountUpdate(ctx, pool.owner, pool.AMMParty, u.asset, e.marketID, u.transferType, u.amount)
+		if err != nil {
+			// both balances were checked above
+			e.log.Panic("unable to update spot AMM sub-account balance", logging.Error(err))
+		}
+		ledgerMovements = append(ledgerMovements, lm)
+	}
+
+	if len(ledgerMovements) > 0 {
+		e.broker.Send(events.NewLedgerMovements(ctx, ledgerMovements))
+	}
+	return nil
+}
+
 func (e *Engine) UpdateSubAccountBalance(
 	ctx context.Context,
 	party, subAccount string,
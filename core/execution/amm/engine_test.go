@@ -57,6 +57,8 @@ func TestAMMTrading(t *testing.T) {
 
 	t.Run("test submit buy order across AMM boundary", testSubmitOrderAcrossAMMBoundary)
 	t.Run("test submit sell order across AMM boundary", testSubmitOrderAcrossAMMBoundarySell)
+	t.Run("test self-trade order with own pool", testSelfTradeOrder)
+	t.Run("test volume at price", testVolumeAtPrice)
 }
 
 func TestAmendAMM(t *testing.T) {
@@ -203,6 +205,102 @@ func testBasicSubmitOrder(t *testing.T) {
 	assert.Equal(t, 362325, int(orders[0].Size))
 }
 
+func testSelfTradeOrder(t *testing.T) {
+	tst := getTestEngine(t)
+
+	party, subAccount := getParty(t, tst)
+	submit := getPoolSubmission(t, party, tst.marketID)
+
+	expectSubaccountCreation(t, tst, party, subAccount)
+	whenAMMIsSubmitted(t, tst, submit)
+
+	agg := &types.Order{
+		Party:               party,
+		Size:                1000000,
+		Remaining:           1000000,
+		Side:                types.SideBuy,
+		Price:               num.NewUint(2100),
+		Type:                types.OrderTypeLimit,
+		SelfTradePrevention: types.OrderSelfTradePreventionDecrementAndCancel,
+	}
+
+	// the pool's quote is the same as the order it would generate for anyone else, but it's not traded
+	ensurePositionN(t, tst.pos, 0, num.NewUint(0), -1)
+	own := tst.engine.SelfTradeOrder(agg, num.NewUint(2000), num.NewUint(2020))
+	require.NotNil(t, own)
+	assert.Equal(t, subAccount, own.Party)
+	assert.Equal(t, types.SideSell, own.Side)
+	assert.Equal(t, "2009", own.Price.String())
+	assert.Equal(t, 236855, int(own.Size))
+	assert.Empty(t, own.ID)
+
+	// with self-trade prevention set the pool is left out of the match
+	orders := tst.engine.SubmitOrder(agg, num.NewUint(2000), num.NewUint(2020))
+	assert.Len(t, orders, 0)
+	assert.Equal(t, 1000000, int(agg.Remaining))
+	tst.engine.NotifyFinished()
+
+	// a party without a pool has no quote
+	agg.Party = vgcrypto.RandomHash()
+	assert.Nil(t, tst.engine.SelfTradeOrder(agg, num.NewUint(2000), num.NewUint(2020)))
+
+	// and the pool trades as usual with it
+	orders = tst.engine.SubmitOrder(agg, num.NewUint(2000), num.NewUint(2020))
+	require.Len(t, orders, 1)
+	assert.Equal(t, "2009", orders[0].Price.String())
+	assert.Equal(t, 236855, int(orders[0].Size))
+}
+
+func testVolumeAtPrice(t *testing.T) {
+	tst := getTestEngine(t)
+
+	party, subAccount := getParty(t, tst)
+	submit := getPoolSubmission(t, party, tst.marketID)
+
+	expectSubaccountCreation(t, tst, party, subAccount)
+	whenAMMIsSubmitted(t, tst, submit)
+
+	agg := &types.Order{
+		Party:     vgcrypto.RandomHash(),
+		Size:      1000000,
+		Remaining: 1000000,
+		Side:      types.SideBuy,
+		Price:     num.NewUint(2100),
+		Type:      types.OrderTypeLimit,
+	}
+
+	// the volume at a price is the volume of the tick leading up to it
+	ensurePositionN(t, tst.pos, 0, num.NewUint(0), -1)
+	volume, before := tst.engine.VolumeAtPrice(agg, num.NewUint(2010))
+	assert.Equal(t, "2009", before.String())
+	assert.Equal(t, 11847, int(volume))
+
+	// and it's what the pool trades at that price
+	orders := tst.engine.SubmitOrder(agg, num.NewUint(2010), num.NewUint(2010))
+	require.Len(t, orders, 1)
+	assert.Equal(t, int(volume), int(orders[0].Size))
+	tst.engine.NotifyFinished()
+
+	// for a sell the tick leading up to the price is above it
+	agg.Side = types.SideSell
+	agg.Price = num.NewUint(1900)
+	volume, before = tst.engine.VolumeAtPrice(agg, num.NewUint(1990))
+	assert.Equal(t, "1991", before.String())
+	assert.NotZero(t, volume)
+
+	// there's nothing for the pool to sell below its fair price
+	agg.Side = types.SideBuy
+	agg.Price = num.NewUint(2100)
+	volume, _ = tst.engine.VolumeAtPrice(agg, num.NewUint(1990))
+	assert.Zero(t, volume)
+
+	// and the party's own pool is left out when the order has self-trade prevention set
+	agg.Party = party
+	agg.SelfTradePrevention = types.OrderSelfTradePreventionDecrementAndCancel
+	volume, _ = tst.engine.VolumeAtPrice(agg, num.NewUint(2010))
+	assert.Zero(t, volume)
+}
+
 func testSubmitOrderAtBestPrice(t *testing.T) {
 	tst := getTestEngine(t)
 
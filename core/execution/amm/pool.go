@@ -22,6 +22,7 @@ import (
 	"code.vegaprotocol.io/vega/core/idgeneration"
 	"code.vegaprotocol.io/vega/core/types"
 	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/ptr"
 	"code.vegaprotocol.io/vega/logging"
 	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
 )
@@ -116,6 +117,12 @@ type Pool struct {
 	oneTick              *num.Uint // one price tick
 
 	fpCache map[int64]*num.Uint
+
+	// on a spot market the pool holds the base asset instead of a position, its lower curve is funded
+	// by the commitment in the quote asset and its upper curve by the base commitment. Nil for other markets.
+	BaseCommitment *num.Uint
+	baseAsset      string
+	baseFactor     num.Decimal // gets us from a volume in the market's position decimals to an amount of base asset
 }
 
 func NewPool(
@@ -162,6 +169,55 @@ func NewPool(
 	return pool, nil
 }
 
+// NewSpotPool creates a fully funded pool for a spot market, the pool's asset is the quote asset.
+func NewSpotPool(
+	log *logging.Logger,
+	id,
+	ammParty,
+	quoteAsset,
+	baseAsset string,
+	submit *types.SubmitAMM,
+	sqrt sqrtFn,
+	collateral Collateral,
+	priceFactor num.Decimal,
+	positionFactor num.Decimal,
+	baseFactor num.Decimal,
+	maxCalculationLevels *num.Uint,
+) (*Pool, error) {
+	baseCommitment := num.UintZero()
+	if submit.BaseCommitmentAmount != nil {
+		baseCommitment = submit.BaseCommitmentAmount.Clone()
+	}
+
+	oneTick, _ := num.UintFromDecimal(priceFactor)
+	pool := &Pool{
+		log:                  log,
+		ID:                   id,
+		AMMParty:             ammParty,
+		Commitment:           submit.CommitmentAmount,
+		BaseCommitment:       baseCommitment,
+		ProposedFee:          submit.ProposedFee,
+		Parameters:           submit.Parameters,
+		market:               submit.MarketID,
+		owner:                submit.Party,
+		asset:                quoteAsset,
+		baseAsset:            baseAsset,
+		sqrt:                 sqrt,
+		collateral:           collateral,
+		priceFactor:          priceFactor,
+		positionFactor:       positionFactor,
+		baseFactor:           baseFactor,
+		oneTick:              num.Max(num.UintOne(), oneTick),
+		status:               types.AMMPoolStatusActive,
+		maxCalculationLevels: maxCalculationLevels,
+		fpCache:              map[int64]*num.Uint{},
+	}
+	if err := pool.setCurves(nil, nil, num.DecimalZero()); err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
 func NewPoolFromProto(
 	log *logging.Logger,
 	sqrt sqrtFn,
@@ -226,6 +282,14 @@ func NewPoolFromProto(
 		return nil, err
 	}
 
+	var baseCommitment *num.Uint
+	if state.BaseCommitment != nil {
+		baseCommitment, overflow = num.UintFromString(*state.BaseCommitment, 10)
+		if overflow {
+			return nil, fmt.Errorf("failed to convert string to Uint: %s", *state.BaseCommitment)
+		}
+	}
+
 	return &Pool{
 		log:         log,
 		ID:          state.Id,
@@ -251,6 +315,7 @@ func NewPoolFromProto(
 		positionFactor: positionFactor,
 		oneTick:        num.Max(num.UintOne(), oneTick),
 		status:         state.Status,
+		BaseCommitment: baseCommitment,
 	}, nil
 }
 
@@ -290,6 +355,10 @@ func NewCurveFromProto(c *snapshotpb.PoolMapEntry_Curve) (*curve, error) {
 }
 
 func (p *Pool) IntoProto() *snapshotpb.PoolMapEntry_Pool {
+	var baseCommitment *string
+	if p.isSpot() {
+		baseCommitment = ptr.From(p.BaseCommitment.String())
+	}
 	return &snapshotpb.PoolMapEntry_Pool{
 		Id:          p.ID,
 		AmmPartyId:  p.AMMParty,
@@ -312,7 +381,8 @@ func (p *Pool) IntoProto() *snapshotpb.PoolMapEntry_Pool {
 			Empty: p.upper.empty,
 			Pv:    p.upper.pv.String(),
 		},
-		Status: p.status,
+		Status:         p.status,
+		BaseCommitment: baseCommitment,
 	}
 }
 
@@ -328,6 +398,14 @@ func (p *Pool) Update(
 		commitment = amend.CommitmentAmount
 	}
 
+	var baseCommitment *num.Uint
+	if p.isSpot() {
+		baseCommitment = p.BaseCommitment.Clone()
+		if amend.BaseCommitmentAmount != nil {
+			baseCommitment = amend.BaseCommitmentAmount.Clone()
+		}
+	}
+
 	proposedFee := p.ProposedFee
 	if amend.ProposedFee.IsPositive() {
 		proposedFee = amend.ProposedFee
@@ -368,6 +446,9 @@ func (p *Pool) Update(
 		oneTick:              p.oneTick,
 		maxCalculationLevels: p.maxCalculationLevels,
 		fpCache:              map[int64]*num.Uint{},
+		BaseCommitment:       baseCommitment,
+		baseAsset:            p.baseAsset,
+		baseFactor:           p.baseFactor,
 	}
 	if err := updated.setCurves(rf, sf, linearSlippage); err != nil {
 		return nil, err
@@ -406,7 +487,18 @@ func generateCurve(
 		// rf = min(rf, leverage)
 		rf = num.MinD(rf, *leverageAtBound)
 	}
+	return generateCurveAtLeverage(sqrt, commitment, low, high, rf, positionFactor, isLower)
+}
 
+// generateCurveAtLeverage creates the curve details for a commitment used at leverage rf.
+func generateCurveAtLeverage(
+	sqrt sqrtFn,
+	commitment,
+	low, high *num.Uint,
+	rf num.Decimal,
+	positionFactor num.Decimal,
+	isLower bool,
+) *curve {
 	// we now need to calculate the virtual-liquidity L of the curve from the
 	// input parameters: leverage (rf), lower bound price (pl), upper bound price (pu)
 	// we first calculate the unit-virtual-liquidity:
@@ -456,12 +548,35 @@ func generateCurve(
 
 	// now we scale theoretical position by position factor so that is it feeds through into all subsequent equations
 	pv = pv.Mul(positionFactor)
+	return newCurve(sqrt, low, high, pv, lu, isLower)
+}
+
+// generateCurveForVolume creates the curve details for a curve whose total tradeable volume is pv, which must
+// already be expressed in the market's position decimals.
+func generateCurveForVolume(
+	sqrt sqrtFn,
+	low, high *num.Uint,
+	pv num.Decimal,
+	isLower bool,
+) *curve {
+	// Lu = sqrt(pu) * sqrt(pl) / sqrt(pu) - sqrt(pl)
+	lu := sqrt(high).Mul(sqrt(low)).Div(sqrt(high).Sub(sqrt(low)))
+	return newCurve(sqrt, low, high, pv, lu, isLower)
+}
+
+// newCurve returns the curve with total tradeable volume pv and unit-virtual-liquidity lu.
+func newCurve(
+	sqrt sqrtFn,
+	low, high *num.Uint,
+	pv, lu num.Decimal,
+	isLower bool,
+) *curve {
+	// and finally calculate L = pv * Lu
 	l := pv.Mul(lu)
 
 	sqrtHigh := sqrt(high)
 	lDivSqrtPu := l.Div(sqrtHigh)
 
-	// and finally calculate L = pv * Lu
 	return &curve{
 		l:          l,
 		low:        low,
@@ -485,18 +600,23 @@ func (p *Pool) setCurves(
 
 	if p.Parameters.LowerBound != nil {
 		lowerBound, _ := num.UintFromDecimal(p.Parameters.LowerBound.ToDecimal().Mul(p.priceFactor))
-		p.lower = generateCurve(
-			p.sqrt,
-			p.Commitment.Clone(),
-			lowerBound,
-			base,
-			rfs.Long,
-			sfs.InitialMargin,
-			linearSlippage,
-			p.Parameters.LeverageAtLowerBound,
-			p.positionFactor,
-			true,
-		)
+		if p.isSpot() {
+			// the pool can only buy as much base as its quote commitment can pay for
+			p.lower = generateCurveAtLeverage(p.sqrt, p.Commitment.Clone(), lowerBound, base, num.DecimalOne(), p.positionFactor, true)
+		} else {
+			p.lower = generateCurve(
+				p.sqrt,
+				p.Commitment.Clone(),
+				lowerBound,
+				base,
+				rfs.Long,
+				sfs.InitialMargin,
+				linearSlippage,
+				p.Parameters.LeverageAtLowerBound,
+				p.positionFactor,
+				true,
+			)
+		}
 
 		highPriceMinusOne := num.UintZero().Sub(p.lower.high, p.oneTick)
 		// verify that the lower curve maintains sufficient volume from highPrice - 1 to the end of the curve.
@@ -507,18 +627,23 @@ func (p *Pool) setCurves(
 
 	if p.Parameters.UpperBound != nil {
 		upperBound, _ := num.UintFromDecimal(p.Parameters.UpperBound.ToDecimal().Mul(p.priceFactor))
-		p.upper = generateCurve(
-			p.sqrt,
-			p.Commitment.Clone(),
-			base.Clone(),
-			upperBound,
-			rfs.Short,
-			sfs.InitialMargin,
-			linearSlippage,
-			p.Parameters.LeverageAtUpperBound,
-			p.positionFactor,
-			false,
-		)
+		if p.isSpot() {
+			// the pool can only sell the base it holds
+			p.upper = generateCurveForVolume(p.sqrt, base.Clone(), upperBound, p.BaseCommitment.ToDecimal().Div(p.baseFactor), false)
+		} else {
+			p.upper = generateCurve(
+				p.sqrt,
+				p.Commitment.Clone(),
+				base.Clone(),
+				upperBound,
+				rfs.Short,
+				sfs.InitialMargin,
+				linearSlippage,
+				p.Parameters.LeverageAtUpperBound,
+				p.positionFactor,
+				false,
+			)
+		}
 
 		highPriceMinusOne := num.UintZero().Sub(p.upper.high, p.oneTick)
 		// verify that the upper curve maintains sufficient volume from highPrice - 1 to the end of the curve.
@@ -642,8 +767,13 @@ func (p *Pool) TradableVolumeInRange(side types.Side, price1 *num.Uint, price2 *
 	return volume
 }
 
-// getBalance returns the total balance of the pool i.e it's general account + it's margin account.
+// getBalance returns the total balance of the pool i.e it's general account + it's margin account. On a spot
+// market it is the balance of its general accounts in the quote and base asset.
 func (p *Pool) getBalance() *num.Uint {
+	if p.isSpot() {
+		return num.Sum(p.generalBalance(p.asset), p.generalBalance(p.baseAsset))
+	}
+
 	general, err := p.collateral.GetPartyGeneralAccount(p.AMMParty, p.asset)
 	if err != nil {
 		panic("general account not created")
@@ -664,11 +794,7 @@ func (p *Pool) setEphemeralPosition() {
 		return
 	}
 	p.eph = &ephemeralPosition{
-		size: 0,
-	}
-
-	if pos := p.position.GetPositionsByParty(p.AMMParty); len(pos) != 0 {
-		p.eph.size = pos[0].Size()
+		size: p.settledPosition(),
 	}
 }
 
@@ -692,6 +818,16 @@ func (p *Pool) getPosition() int64 {
 	if p.eph != nil {
 		return p.eph.size
 	}
+	return p.settledPosition()
+}
+
+// settledPosition returns the position of the pool outside of the matching process. On a spot market the position
+// is the amount of base the pool holds above or below its base commitment.
+func (p *Pool) settledPosition() int64 {
+	if p.isSpot() {
+		held := p.generalBalance(p.baseAsset).ToDecimal().Sub(p.BaseCommitment.ToDecimal())
+		return held.Div(p.baseFactor).IntPart()
+	}
 
 	if pos := p.position.GetPositionsByParty(p.AMMParty); len(pos) != 0 {
 		return pos[0].Size()
@@ -699,6 +835,20 @@ func (p *Pool) getPosition() int64 {
 	return 0
 }
 
+// generalBalance returns the balance of the pool's general account for the given asset.
+func (p *Pool) generalBalance(asset string) *num.Uint {
+	general, err := p.collateral.GetPartyGeneralAccount(p.AMMParty, asset)
+	if err != nil {
+		return num.UintZero()
+	}
+	return general.Balance.Clone()
+}
+
+// isSpot returns whether the pool is on a spot market.
+func (p *Pool) isSpot() bool {
+	return p.BaseCommitment != nil
+}
+
 // fairPrice returns the fair price of the pool given its current position.
 
 // sqrt(pf) = sqrt(pu) / (1 + pv * sqrt(pu) * 1/L )
@@ -848,6 +998,18 @@ func (p *Pool) BestPrice(order *types.Order) *num.Uint {
 	}
 }
 
+// PositionsAtBounds returns the long position the pool holds at its lower bound and the short position
+// it holds at its upper bound, zero for a side the pool does not trade on.
+func (p *Pool) PositionsAtBounds() (long, short uint64) {
+	if !p.lower.empty {
+		long = uint64(p.lower.pv.IntPart())
+	}
+	if !p.upper.empty {
+		short = uint64(p.upper.pv.IntPart())
+	}
+	return long, short
+}
+
 func (p *Pool) LiquidityFee() num.Decimal {
 	return p.ProposedFee
 }
@@ -40,6 +40,63 @@ func TestAMMPool(t *testing.T) {
 	t.Run("test near zero volume curve triggers and error", testNearZeroCurveErrors)
 }
 
+func TestSpotAMMPool(t *testing.T) {
+	t.Run("test upper curve is funded by base commitment", testSpotUpperCurveVolume)
+	t.Run("test position is read from base balance", testSpotPositionFromBalance)
+	t.Run("test pool without base commitment can only buy", testSpotNoBaseCommitment)
+}
+
+func testSpotUpperCurveVolume(t *testing.T) {
+	p := newTestSpotPool(t, num.NewUint(100000))
+	defer p.ctrl.Finish()
+
+	// base commitment of 100000 in asset decimals with a base factor of 10 is 10000 in position decimals
+	assert.Equal(t, "10000", p.pool.upper.pv.String())
+	assert.False(t, p.pool.upper.empty)
+	assert.False(t, p.pool.lower.empty)
+}
+
+func testSpotPositionFromBalance(t *testing.T) {
+	p := newTestSpotPool(t, num.NewUint(100000))
+	defer p.ctrl.Finish()
+
+	// holding exactly its base commitment the pool has no position and quotes at its base price
+	ensureSpotBaseBalanceN(t, p, 100000, 2)
+	assert.Equal(t, int64(0), p.pool.getPosition())
+	assert.Equal(t, "2000", p.pool.BestPrice(nil).String())
+
+	// bought 5 units of base so it is long and its fair price drops
+	ensureSpotBaseBalanceN(t, p, 100050, 2)
+	assert.Equal(t, int64(5), p.pool.getPosition())
+	assert.True(t, p.pool.BestPrice(nil).LT(num.NewUint(2000)))
+
+	// sold 5 units of base so it is short and its fair price rises
+	ensureSpotBaseBalanceN(t, p, 99950, 2)
+	assert.Equal(t, int64(-5), p.pool.getPosition())
+	assert.True(t, p.pool.BestPrice(nil).GT(num.NewUint(2000)))
+}
+
+func testSpotNoBaseCommitment(t *testing.T) {
+	submit := newSpotSubmission(nil)
+
+	// without any base the pool has nothing to sell on its upper curve
+	_, err := newSpotPoolWithSubmit(t, submit)
+	assert.ErrorContains(t, err, "insufficient commitment - less than one volume at price levels on upper curve")
+
+	// but it can still only buy
+	submit.Parameters.UpperBound = nil
+	p, err := newSpotPoolWithSubmit(t, submit)
+	require.NoError(t, err)
+	defer p.ctrl.Finish()
+
+	assert.True(t, p.pool.BaseCommitment.IsZero())
+	assert.True(t, p.pool.upper.empty)
+
+	ensureSpotBaseBalanceN(t, p, 0, 2)
+	volume := p.pool.TradableVolumeInRange(types.SideBuy, num.NewUint(2000), num.NewUint(2200))
+	assert.Equal(t, int(0), int(volume))
+}
+
 func testTradeableVolumeInRange(t *testing.T) {
 	p := newTestPool(t)
 	defer p.ctrl.Finish()
@@ -486,6 +543,69 @@ type tstPool struct {
 	submission *types.SubmitAMM
 }
 
+func newSpotSubmission(baseCommitment *num.Uint) *types.SubmitAMM {
+	return &types.SubmitAMM{
+		AMMBaseCommand: types.AMMBaseCommand{
+			Party:             vgcrypto.RandomHash(),
+			MarketID:          vgcrypto.RandomHash(),
+			SlippageTolerance: num.DecimalFromFloat(0.1),
+		},
+		CommitmentAmount:     num.NewUint(10000000),
+		BaseCommitmentAmount: baseCommitment,
+		Parameters: &types.ConcentratedLiquidityParameters{
+			Base:       num.NewUint(2000),
+			LowerBound: num.NewUint(1800),
+			UpperBound: num.NewUint(2200),
+		},
+	}
+}
+
+func newTestSpotPool(t *testing.T, baseCommitment *num.Uint) *tstPool {
+	t.Helper()
+	p, err := newSpotPoolWithSubmit(t, newSpotSubmission(baseCommitment))
+	require.NoError(t, err)
+	return p
+}
+
+func newSpotPoolWithSubmit(t *testing.T, submit *types.SubmitAMM) (*tstPool, error) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	col := mocks.NewMockCollateral(ctrl)
+
+	sqrter := &Sqrter{cache: map[string]num.Decimal{}}
+
+	// quote and base assets both have 1 more decimal place than the market
+	pool, err := NewSpotPool(
+		logging.NewTestLogger(),
+		vgcrypto.RandomHash(),
+		vgcrypto.RandomHash(),
+		"quote",
+		"base",
+		submit,
+		sqrter.sqrt,
+		col,
+		num.DecimalOne(),
+		num.DecimalOne(),
+		num.DecimalFromInt64(10),
+		num.NewUint(100000),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tstPool{
+		submission: submit,
+		pool:       pool,
+		col:        col,
+		ctrl:       ctrl,
+	}, nil
+}
+
+func ensureSpotBaseBalanceN(t *testing.T, p *tstPool, balance uint64, times int) {
+	t.Helper()
+	p.col.EXPECT().GetPartyGeneralAccount(p.pool.AMMParty, "base").Times(times).Return(&types.Account{Balance: num.NewUint(balance)}, nil)
+}
+
 func newTestPool(t *testing.T) *tstPool {
 	t.Helper()
 	return newTestPoolWithPositionFactor(t, num.DecimalOne())
@@ -0,0 +1,145 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/types"
+	vegacontext "code.vegaprotocol.io/vega/libs/context"
+	"code.vegaprotocol.io/vega/libs/crypto"
+	"code.vegaprotocol.io/vega/logging"
+	snapshot "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+)
+
+type cancelOnTimeout struct {
+	deadline time.Time
+	// if empty, all markets
+	marketIDs  []string
+	cancelAMMs bool
+}
+
+// CancelOnTimeout sets, refreshes or removes the deadline after which all of a party's
+// orders are cancelled.
+func (e *Engine) CancelOnTimeout(ctx context.Context, cot *types.CancelOnTimeout) error {
+	for _, id := range cot.MarketIDs {
+		if _, ok := e.allMarkets[id]; !ok {
+			return ErrMarketDoesNotExist
+		}
+	}
+
+	if cot.Timeout == 0 {
+		delete(e.cancelOnTimeouts, cot.Party)
+		return nil
+	}
+
+	e.cancelOnTimeouts[cot.Party] = &cancelOnTimeout{
+		deadline:   e.timeService.GetTimeNow().Add(cot.Timeout),
+		marketIDs:  cot.MarketIDs,
+		cancelAMMs: cot.CancelAMMs,
+	}
+	return nil
+}
+
+// checkCancelOnTimeouts cancels the orders of all the parties whose deadline has passed.
+func (e *Engine) checkCancelOnTimeouts(ctx context.Context, now time.Time) {
+	if len(e.cancelOnTimeouts) == 0 {
+		return
+	}
+
+	expired := []string{}
+	for party, cot := range e.cancelOnTimeouts {
+		if !cot.deadline.After(now) {
+			expired = append(expired, party)
+		}
+	}
+	sort.Strings(expired)
+
+	_, blockHash := vegacontext.TraceIDFromContext(ctx)
+	for _, party := range expired {
+		cot := e.cancelOnTimeouts[party]
+		delete(e.cancelOnTimeouts, party)
+
+		marketIDs := cot.marketIDs
+		if len(marketIDs) == 0 {
+			marketIDs = make([]string, 0, len(e.allMarketsCpy))
+			for _, mkt := range e.allMarketsCpy {
+				marketIDs = append(marketIDs, mkt.GetID())
+			}
+		}
+
+		cancelled := make([]string, 0, len(marketIDs))
+		for _, id := range marketIDs {
+			// the market may have been closed since the command was submitted
+			mkt, ok := e.allMarkets[id]
+			if !ok {
+				continue
+			}
+			if _, err := mkt.CancelAllOrders(ctx, party); err != nil && e.log.IsDebug() {
+				e.log.Debug("could not cancel orders on timeout", logging.MarketID(id), logging.PartyID(party), logging.Error(err))
+			}
+			if err := mkt.CancelAllStopOrders(ctx, party); err != nil && e.log.IsDebug() {
+				e.log.Debug("could not cancel stop orders on timeout", logging.MarketID(id), logging.PartyID(party), logging.Error(err))
+			}
+			if cot.cancelAMMs {
+				cancel := &types.CancelAMM{
+					MarketID: id,
+					Party:    party,
+					Method:   types.AMMCancellationMethodImmediate,
+				}
+				// most parties won't have an AMM on the market, nothing to cancel then
+				if err := mkt.CancelAMM(ctx, cancel, crypto.HashStrToHex(blockHash+party+id)); err != nil && e.log.IsDebug() {
+					e.log.Debug("could not cancel AMM on timeout", logging.MarketID(id), logging.PartyID(party), logging.Error(err))
+				}
+			}
+			cancelled = append(cancelled, id)
+		}
+		e.broker.Send(events.NewCancelOnTimeoutTriggeredEvent(ctx, party, cancelled, cot.cancelAMMs, cot.deadline))
+	}
+}
+
+func (e *Engine) serialiseCancelOnTimeouts() []*snapshot.CancelOnTimeout {
+	parties := make([]string, 0, len(e.cancelOnTimeouts))
+	for party := range e.cancelOnTimeouts {
+		parties = append(parties, party)
+	}
+	sort.Strings(parties)
+
+	state := make([]*snapshot.CancelOnTimeout, 0, len(parties))
+	for _, party := range parties {
+		cot := e.cancelOnTimeouts[party]
+		state = append(state, &snapshot.CancelOnTimeout{
+			Party:      party,
+			Deadline:   cot.deadline.UnixNano(),
+			MarketIds:  cot.marketIDs,
+			CancelAmms: cot.cancelAMMs,
+		})
+	}
+	return state
+}
+
+func (e *Engine) restoreCancelOnTimeouts(state []*snapshot.CancelOnTimeout) {
+	for _, s := range state {
+		e.cancelOnTimeouts[s.Party] = &cancelOnTimeout{
+			deadline:   time.Unix(0, s.Deadline),
+			marketIDs:  s.MarketIds,
+			cancelAMMs: s.CancelAmms,
+		}
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/execution"
+	"code.vegaprotocol.io/vega/core/types"
+	vgcontext "code.vegaprotocol.io/vega/libs/context"
+	"code.vegaprotocol.io/vega/libs/crypto"
+	"code.vegaprotocol.io/vega/libs/proto"
+	snapshot "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type cancelOnTimeoutEngine struct {
+	*engineFake
+	now       time.Time
+	triggered []*events.CancelOnTimeoutTriggered
+}
+
+func getCancelOnTimeoutEngine(t *testing.T) *cancelOnTimeoutEngine {
+	t.Helper()
+	e := &cancelOnTimeoutEngine{
+		engineFake: getMockedEngine(t),
+		now:        time.Unix(1000, 0),
+	}
+	e.timeSvc.EXPECT().GetTimeNow().DoAndReturn(func() time.Time { return e.now }).AnyTimes()
+	e.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes().Do(func(evt events.Event) {
+		if cot, ok := evt.(*events.CancelOnTimeoutTriggered); ok {
+			e.triggered = append(e.triggered, cot)
+		}
+	})
+	return e
+}
+
+func (e *cancelOnTimeoutEngine) tick(ctx context.Context, d time.Duration) {
+	e.now = e.now.Add(d)
+	e.OnTick(ctx, e.now)
+}
+
+func TestCancelOnTimeout(t *testing.T) {
+	t.Run("cancel on timeout triggers once the deadline passes", testCancelOnTimeoutTriggers)
+	t.Run("submitting the command again pushes the deadline", testCancelOnTimeoutRefresh)
+	t.Run("a zero timeout removes the cancel on timeout", testCancelOnTimeoutRemove)
+	t.Run("unknown markets are rejected", testCancelOnTimeoutUnknownMarket)
+	t.Run("snapshot", testCancelOnTimeoutSnapshot)
+}
+
+func testCancelOnTimeoutTriggers(t *testing.T) {
+	ctx := vgcontext.WithTraceID(context.Background(), crypto.RandomHash())
+	e := getCancelOnTimeoutEngine(t)
+
+	require.NoError(t, e.CancelOnTimeout(ctx, &types.CancelOnTimeout{
+		Party:      "party1",
+		Timeout:    10 * time.Second,
+		CancelAMMs: true,
+	}))
+	deadline := e.now.Add(10 * time.Second)
+
+	e.tick(ctx, 9*time.Second)
+	assert.Empty(t, e.triggered)
+
+	e.tick(ctx, time.Second)
+	require.Len(t, e.triggered, 1)
+	assert.Equal(t, "party1", e.triggered[0].PartyID())
+	assert.True(t, e.triggered[0].Proto().AmmsCancelled)
+	assert.Equal(t, deadline.UnixNano(), e.triggered[0].Proto().Deadline)
+
+	// it only triggers once.
+	e.tick(ctx, time.Second)
+	assert.Len(t, e.triggered, 1)
+}
+
+func testCancelOnTimeoutRefresh(t *testing.T) {
+	ctx := vgcontext.WithTraceID(context.Background(), crypto.RandomHash())
+	e := getCancelOnTimeoutEngine(t)
+
+	cot := &types.CancelOnTimeout{
+		Party:   "party1",
+		Timeout: 10 * time.Second,
+	}
+	require.NoError(t, e.CancelOnTimeout(ctx, cot))
+	e.tick(ctx, 8*time.Second)
+	require.NoError(t, e.CancelOnTimeout(ctx, cot))
+
+	e.tick(ctx, 8*time.Second)
+	assert.Empty(t, e.triggered)
+
+	e.tick(ctx, 2*time.Second)
+	require.Len(t, e.triggered, 1)
+}
+
+func testCancelOnTimeoutRemove(t *testing.T) {
+	ctx := vgcontext.WithTraceID(context.Background(), crypto.RandomHash())
+	e := getCancelOnTimeoutEngine(t)
+
+	require.NoError(t, e.CancelOnTimeout(ctx, &types.CancelOnTimeout{
+		Party:   "party1",
+		Timeout: 10 * time.Second,
+	}))
+	require.NoError(t, e.CancelOnTimeout(ctx, &types.CancelOnTimeout{
+		Party: "party1",
+	}))
+
+	e.tick(ctx, 20*time.Second)
+	assert.Empty(t, e.triggered)
+}
+
+func testCancelOnTimeoutUnknownMarket(t *testing.T) {
+	ctx := vgcontext.WithTraceID(context.Background(), crypto.RandomHash())
+	e := getCancelOnTimeoutEngine(t)
+
+	err := e.CancelOnTimeout(ctx, &types.CancelOnTimeout{
+		Party:     "party1",
+		Timeout:   10 * time.Second,
+		MarketIDs: []string{crypto.RandomHash()},
+	})
+	require.ErrorIs(t, err, execution.ErrMarketDoesNotExist)
+}
+
+func testCancelOnTimeoutSnapshot(t *testing.T) {
+	ctx := vgcontext.WithTraceID(context.Background(), crypto.RandomHash())
+	e := getCancelOnTimeoutEngine(t)
+
+	require.NoError(t, e.CancelOnTimeout(ctx, &types.CancelOnTimeout{
+		Party:   "party2",
+		Timeout: 20 * time.Second,
+	}))
+	require.NoError(t, e.CancelOnTimeout(ctx, &types.CancelOnTimeout{
+		Party:      "party1",
+		Timeout:    10 * time.Second,
+		CancelAMMs: true,
+	}))
+
+	key := e.Keys()[0]
+	b, _, err := e.GetState(key)
+	require.NoError(t, err)
+
+	snap := &snapshot.Payload{}
+	require.NoError(t, proto.Unmarshal(b, snap))
+	cots := snap.GetExecutionMarkets().CancelOnTimeouts
+	require.Len(t, cots, 2)
+	assert.Equal(t, "party1", cots[0].Party)
+	assert.Equal(t, "party2", cots[1].Party)
+
+	e2 := getCancelOnTimeoutEngine(t)
+	e2.now = e.now
+	_, err = e2.LoadState(ctx, types.PayloadFromProto(snap))
+	require.NoError(t, err)
+
+	b2, _, err := e2.GetState(key)
+	require.NoError(t, err)
+	assert.Equal(t, b, b2)
+
+	e2.tick(ctx, 10*time.Second)
+	require.Len(t, e2.triggered, 1)
+	assert.Equal(t, "party1", e2.triggered[0].PartyID())
+	assert.True(t, e2.triggered[0].Proto().AmmsCancelled)
+}
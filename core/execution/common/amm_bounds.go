@@ -0,0 +1,41 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"fmt"
+
+	"code.vegaprotocol.io/vega/libs/num"
+)
+
+// VerifyAMMBounds checks that the AMM's base price lies strictly between its lower and upper bounds once converted
+// into asset decimals with the market's price factor.
+func VerifyAMMBounds(baseParam *num.Uint, lowerParam *num.Uint, upperParam *num.Uint, priceFactor num.Decimal) error {
+	base, _ := num.UintFromDecimal(baseParam.ToDecimal().Mul(priceFactor))
+	if lowerParam != nil {
+		lower, _ := num.UintFromDecimal(lowerParam.ToDecimal().Mul(priceFactor))
+		if lower.GTE(base) {
+			return fmt.Errorf(fmt.Sprintf("base (%s) as factored by market and asset decimals must be greater than lower bound (%s)", base.String(), lower.String()))
+		}
+	}
+	if upperParam != nil {
+		upper, _ := num.UintFromDecimal(upperParam.ToDecimal().Mul(priceFactor))
+		if base.GTE(upper) {
+			return fmt.Errorf(fmt.Sprintf("upper bound (%s) as factored by market and asset decimals must be greater than base (%s)", upper.String(), base.String()))
+		}
+	}
+	return nil
+}
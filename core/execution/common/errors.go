@@ -84,10 +84,48 @@ var (
 	// ErrStopOrderSizeOverrideNotSupportedForSpots is returned when a stop order is received with size override for a spot product.
 	ErrStopOrderSizeOverrideNotSupportedForSpots = errors.New("stop order size override is not supported for spot product")
 	ErrAMMCannotRebase                           = errors.New("not enough liquidity for AMM to rebase")
+	// ErrStopOrderTriggerReferenceNotSupported is returned when a stop order uses a trigger reference price the market does not provide.
+	ErrStopOrderTriggerReferenceNotSupported = errors.New("stop order trigger reference is not supported by the market")
 	// ErrInvalidOrderPrice is returned when an order is submitted to a capped future with a price > max price.
 	ErrInvalidOrderPrice = errors.New("invalid order price")
 	// ErrIsolatedMarginFullyCollateralised is returned when a party tries to switch margin modes on a fully collateralised market.
 	ErrIsolatedMarginFullyCollateralised = errors.New("isolated margin not permitted on fully collateralised markets")
 	// ErrSettlementDataOutOfRange is returned when a capped future receives settlement data that is outside of the acceptable range (either > max price, or neither 0 nor max for binary settlements).
 	ErrSettlementDataOutOfRange = errors.New("settlement data is outside of the price cap")
+	// ErrMarketMakerProtectionFrozen is returned when a party frozen by its market maker protection submits an order which could rest on the book.
+	ErrMarketMakerProtectionFrozen = errors.New("party is frozen by its market maker protection")
+	// ErrSpreadLegCannotFill is returned when the leg of a spread order would not fill in full against the book.
+	ErrSpreadLegCannotFill = errors.New("spread order leg cannot be filled in full")
+	// ErrSpreadOrderNetPriceTooHigh is returned when the net price of a spread order is above its limit.
+	ErrSpreadOrderNetPriceTooHigh = errors.New("spread order net price is above the limit")
+	// ErrSpreadOrderMarketsMismatch is returned when the legs of a spread order are on markets with different settlement assets or decimal places.
+	ErrSpreadOrderMarketsMismatch = errors.New("spread order legs must share the same settlement asset and decimal places")
+	// ErrSpreadOrderMarginCheckFailed is returned when the general account of the party cannot cover the margin and fees of all the legs of a spread order.
+	ErrSpreadOrderMarginCheckFailed = errors.New("spread order legs margin check failed")
+	// ErrSpreadOrderMarketNotSupported is returned when the leg of a spread order is on a market which is neither a future nor a perpetual.
+	ErrSpreadOrderMarketNotSupported = errors.New("spread orders are only supported on futures and perpetual markets")
+	// ErrBlockTradeMarketNotSupported is returned when a request for quote is for a market which is neither a future nor a perpetual.
+	ErrBlockTradeMarketNotSupported = errors.New("block trades are only supported on futures and perpetual markets")
+	// ErrBlockTradeMakerMarginCheckFailed is returned when the quoting party of a block trade cannot cover the margin for it.
+	ErrBlockTradeMakerMarginCheckFailed = errors.New("quoting party cannot cover the margin for the block trade")
+	// ErrBlockTradeMakerPositionLimitExceeded is returned when the block trade would take the position of the quoting party above the position limits.
+	ErrBlockTradeMakerPositionLimitExceeded = errors.New("block trade would take the position of the quoting party above the position limits")
+	// ErrBlockTradePriceOutOfBounds is returned when the price of a block trade is outside of the price monitoring bounds.
+	ErrBlockTradePriceOutOfBounds = errors.New("block trade price is outside of the price monitoring bounds")
+	// ErrQuoteRequestNotFound is returned when a quote, an acceptance or a cancellation refers to an unknown or closed request for quote.
+	ErrQuoteRequestNotFound = errors.New("request for quote not found")
+	// ErrQuoteRequestNotOwned is returned when a party tries to accept a quote on, or cancel, a request for quote made by another party.
+	ErrQuoteRequestNotOwned = errors.New("request for quote belongs to another party")
+	// ErrQuoteNotFound is returned when accepting an unknown quote, or one which is no longer active.
+	ErrQuoteNotFound = errors.New("quote not found")
+	// ErrCannotQuoteOwnRequest is returned when a party quotes on its own request for quote.
+	ErrCannotQuoteOwnRequest = errors.New("cannot quote on own request for quote")
+	// ErrPredictionDoesNotExist is returned when complete sets are minted or redeemed for an unknown prediction market.
+	ErrPredictionDoesNotExist = errors.New("prediction market does not exist")
+	// ErrCompleteSetNotAllowed is returned when complete sets are minted or redeemed while one of the outcome markets is not trading continuously.
+	ErrCompleteSetNotAllowed = errors.New("complete sets can only be minted or redeemed while all outcome markets trade continuously")
+	// ErrCompleteSetIsolatedMargin is returned when a party in isolated margin mode on an outcome market mints or redeems complete sets.
+	ErrCompleteSetIsolatedMargin = errors.New("complete sets cannot be minted or redeemed in isolated margin mode")
+	// ErrCompleteSetPositionTooSmall is returned when a party redeems more complete sets than the long positions it holds.
+	ErrCompleteSetPositionTooSmall = errors.New("party does not hold enough of every outcome to redeem the complete sets")
 )
@@ -57,6 +57,7 @@ type PriceMonitor interface {
 	GetCurrentBounds() []*types.PriceMonitoringBounds
 	GetBounds() []*types.PriceMonitoringBounds
 	SetMinDuration(d time.Duration)
+	SetIndexPriceProvider(f func() *num.Uint)
 	GetValidPriceRange() (num.WrappedDecimal, num.WrappedDecimal)
 	// Snapshot
 	GetState() *types.PriceMonitor
@@ -131,6 +132,9 @@ type AuctionState interface {
 	StartGovernanceSuspensionAuction(t time.Time)
 	StartLongBlockAuction(t time.Time, d int64)
 	EndGovernanceSuspensionAuction()
+	// frequent batch auctions
+	StartBatchAuction(t time.Time, d int64)
+	UpdateDefaultMode(mode types.MarketTradingMode)
 }
 
 type EpochEngine interface {
@@ -182,6 +186,9 @@ type Collateral interface {
 	CreateSpotMarketAccounts(ctx context.Context, marketID, quoteAsset string) error
 	SuccessorInsuranceFraction(ctx context.Context, successor, parent, asset string, fraction num.Decimal) *types.LedgerMovement
 	ClearInsurancepool(ctx context.Context, marketID string, asset string, clearFees bool) ([]*types.LedgerMovement, error)
+	ShareInsurancePool(marketID, poolMarketID string)
+	CompleteSetUpdate(ctx context.Context, market string, transfer *types.Transfer) (*types.LedgerMovement, error)
+	CompleteSetMarginUpdate(ctx context.Context, market string, transfer *types.Transfer) (*types.LedgerMovement, error)
 	TransferToHoldingAccount(ctx context.Context, transfer *types.Transfer) (*types.LedgerMovement, error)
 	ReleaseFromHoldingAccount(ctx context.Context, transfer *types.Transfer) (*types.LedgerMovement, error)
 	ClearSpotMarket(ctx context.Context, mktID, quoteAsset string, parties []string) ([]*types.LedgerMovement, error)
@@ -227,17 +234,37 @@ const (
 	// PriceMoveBestAsk used to indicate that the best ask price has moved.
 	PriceMoveBestAsk = 4
 
+	// PriceMoveMarkPrice used to indicate that the mark price has moved.
+	PriceMoveMarkPrice = 8
+
+	// PriceMoveIndexPrice used to indicate that the index price has moved.
+	PriceMoveIndexPrice = 16
+
 	// PriceMoveAll used to indicate everything has moved.
-	PriceMoveAll = PriceMoveMid + PriceMoveBestBid + PriceMoveBestAsk
+	PriceMoveAll = PriceMoveMid + PriceMoveBestBid + PriceMoveBestAsk + PriceMoveMarkPrice + PriceMoveIndexPrice
 )
 
 func (o OrderReferenceCheck) HasMoved(changes uint8) bool {
-	return (o.PeggedOrder.Reference == types.PeggedReferenceMid &&
-		changes&PriceMoveMid > 0) ||
-		(o.PeggedOrder.Reference == types.PeggedReferenceBestBid &&
-			changes&PriceMoveBestBid > 0) ||
-		(o.PeggedOrder.Reference == types.PeggedReferenceBestAsk &&
-			changes&PriceMoveBestAsk > 0)
+	switch o.PeggedOrder.Reference {
+	case types.PeggedReferenceMid:
+		return changes&PriceMoveMid > 0
+	case types.PeggedReferenceBestBid:
+		return changes&PriceMoveBestBid > 0
+	case types.PeggedReferenceBestAsk:
+		return changes&PriceMoveBestAsk > 0
+	case types.PeggedReferenceMarkPrice, types.PeggedReferenceIndexPrice:
+		// the reference price is not derived from the book, so the order may have been parked
+		// because it would have crossed it, in which case it needs another go once the other side moves.
+		crossing := uint8(PriceMoveBestAsk)
+		if o.Side == types.SideSell {
+			crossing = PriceMoveBestBid
+		}
+		if o.PeggedOrder.Reference == types.PeggedReferenceMarkPrice {
+			return changes&(PriceMoveMarkPrice|crossing) > 0
+		}
+		return changes&(PriceMoveIndexPrice|crossing) > 0
+	}
+	return false
 }
 
 type Banking interface {
@@ -411,6 +438,8 @@ type CommonMarket interface {
 	AmendAMM(context.Context, *types.AmendAMM, string) error
 	CancelAMM(context.Context, *types.CancelAMM, string) error
 
+	UpdateMarketMakerProtection(context.Context, *types.MarketMakerProtection) error
+
 	PostRestore(context.Context) error
 	ValidateSettlementData(*num.Uint) bool
 }
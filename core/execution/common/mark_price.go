@@ -282,6 +282,21 @@ func (mpc *CompositePriceCalculator) GetPrice() *num.Uint {
 	return mpc.price
 }
 
+// GetOraclePrice returns the median of the non-stale prices received from the oracle price sources,
+// or nil if there are no oracle sources or none of them has a current price.
+func (mpc *CompositePriceCalculator) GetOraclePrice() *num.Uint {
+	last := len(mpc.priceSources) - 1
+	if last <= FirstOraclePriceIndex || mpc.config == nil || len(mpc.config.SourceStalenessTolerance) < last {
+		return nil
+	}
+	return CompositePriceByMedian(
+		mpc.priceSources[FirstOraclePriceIndex:last],
+		mpc.sourceLastUpdate[FirstOraclePriceIndex:last],
+		mpc.config.SourceStalenessTolerance[FirstOraclePriceIndex:last],
+		mpc.timeService.GetTimeNow().UnixNano(),
+	)
+}
+
 func (mpc *CompositePriceCalculator) GetConfig() *types.CompositePriceConfiguration {
 	return mpc.config
 }
@@ -296,7 +311,8 @@ func (mpc *CompositePriceCalculator) updateMarkPriceIfNotInAuction(ctx context.C
 	} else {
 		priceMonitor.CheckPrice(ctx, as, mpcCandidate, true, true)
 	}
-	if as.InAuction() || as.AuctionStart() {
+	// a batch of a market trading in frequent batch auctions is normal trading, unless price monitoring extended it
+	if as.AuctionStart() || (as.InAuction() && (!as.IsFBA() || as.IsPriceExtension())) {
 		return fmt.Errorf("price monitoring failed for the new mark price")
 	}
 	mpc.price = mpcCandidate
@@ -17,9 +17,11 @@ package common
 
 import (
 	"testing"
+	"time"
 
 	dstypes "code.vegaprotocol.io/vega/core/datasource/common"
 	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
 	vega "code.vegaprotocol.io/vega/protos/vega"
 	datav1 "code.vegaprotocol.io/vega/protos/vega/data/v1"
 
@@ -65,3 +67,53 @@ func TestSerialisation(t *testing.T) {
 	mpcProto2 := mpc.IntoProto()
 	require.Equal(t, mpcProto, mpcProto2)
 }
+
+type fixedTime time.Time
+
+func (f fixedTime) GetTimeNow() time.Time { return time.Time(f) }
+
+func TestGetOraclePrice(t *testing.T) {
+	now := time.Unix(1000, 0)
+	mpc := &CompositePriceCalculator{
+		config: &types.CompositePriceConfiguration{
+			SourceStalenessTolerance: []time.Duration{time.Minute, time.Minute, time.Minute, 10 * time.Second, time.Minute, time.Minute},
+		},
+		timeService:      fixedTime(now),
+		priceSources:     make([]*num.Uint, 6),
+		sourceLastUpdate: make([]int64, 6),
+	}
+	// no oracle has reported yet
+	require.Nil(t, mpc.GetOraclePrice())
+
+	// trade and book prices are not oracle prices
+	mpc.priceSources[TradePriceIndex] = num.NewUint(90)
+	mpc.sourceLastUpdate[TradePriceIndex] = now.UnixNano()
+	mpc.priceSources[BookPriceIndex] = num.NewUint(95)
+	mpc.sourceLastUpdate[BookPriceIndex] = now.UnixNano()
+	require.Nil(t, mpc.GetOraclePrice())
+
+	mpc.priceSources[FirstOraclePriceIndex] = num.NewUint(100)
+	mpc.sourceLastUpdate[FirstOraclePriceIndex] = now.UnixNano()
+	mpc.priceSources[FirstOraclePriceIndex+1] = num.NewUint(104)
+	mpc.sourceLastUpdate[FirstOraclePriceIndex+1] = now.Add(-30 * time.Second).UnixNano()
+	mpc.priceSources[FirstOraclePriceIndex+2] = num.NewUint(110)
+	mpc.sourceLastUpdate[FirstOraclePriceIndex+2] = now.UnixNano()
+	// the median price is not an oracle price either
+	mpc.priceSources[5] = num.NewUint(1)
+	mpc.sourceLastUpdate[5] = now.UnixNano()
+
+	// the second oracle is stale
+	require.Equal(t, "105", mpc.GetOraclePrice().String())
+
+	mpc.sourceLastUpdate[FirstOraclePriceIndex+1] = now.UnixNano()
+	require.Equal(t, "104", mpc.GetOraclePrice().String())
+
+	// no oracles configured
+	mpc = &CompositePriceCalculator{
+		config:           &types.CompositePriceConfiguration{},
+		timeService:      fixedTime(now),
+		priceSources:     make([]*num.Uint, 1),
+		sourceLastUpdate: make([]int64, 1),
+	}
+	require.Nil(t, mpc.GetOraclePrice())
+}
@@ -0,0 +1,282 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"sort"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	snapshot "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+)
+
+// ProtectionTrigger is a breach of a party's market maker protection limit
+// which is waiting to be acted upon by the market.
+type ProtectionTrigger struct {
+	Party  string
+	Limit  types.MarketMakerProtectionLimit
+	Filled *num.Uint
+}
+
+type protectionFill struct {
+	time     time.Time
+	volume   uint64
+	delta    int64
+	notional *num.Uint
+}
+
+type partyProtection struct {
+	cfg         *types.MarketMakerProtection
+	fills       []*protectionFill
+	frozenUntil time.Time
+}
+
+// MarketMakerProtections keeps track of the fills of the parties which have set
+// a market maker protection in a market, over their rolling window, and reports
+// the parties which have breached one of their limits.
+type MarketMakerProtections struct {
+	parties map[string]*partyProtection
+	// parties which have breached a limit since the last call to Triggered,
+	// in the order the breaches happened.
+	triggered []*ProtectionTrigger
+}
+
+func NewMarketMakerProtections() *MarketMakerProtections {
+	return &MarketMakerProtections{
+		parties: map[string]*partyProtection{},
+	}
+}
+
+func NewMarketMakerProtectionsFromSnapshot(marketID string, state []*snapshot.MarketMakerProtection) *MarketMakerProtections {
+	m := NewMarketMakerProtections()
+	for _, s := range state {
+		pp := &partyProtection{
+			fills: make([]*protectionFill, 0, len(s.Fills)),
+		}
+		if s.FrozenUntil > 0 {
+			pp.frozenUntil = time.Unix(0, s.FrozenUntil)
+		}
+		if s.MaxFilledVolume != nil || s.MaxFilledDelta != nil || s.MaxFilledNotional != nil {
+			pp.cfg = &types.MarketMakerProtection{
+				MarketID:        marketID,
+				Party:           s.Party,
+				WindowDuration:  time.Duration(s.WindowDuration),
+				FreezeDuration:  time.Duration(s.FreezeDuration),
+				MaxFilledVolume: s.MaxFilledVolume,
+				MaxFilledDelta:  s.MaxFilledDelta,
+			}
+			if s.MaxFilledNotional != nil {
+				pp.cfg.MaxFilledNotional, _ = num.UintFromString(*s.MaxFilledNotional, 10)
+			}
+		}
+		for _, f := range s.Fills {
+			notional, _ := num.UintFromString(f.Notional, 10)
+			pp.fills = append(pp.fills, &protectionFill{
+				time:     time.Unix(0, f.Time),
+				volume:   f.Volume,
+				delta:    f.Delta,
+				notional: notional,
+			})
+		}
+		m.parties[s.Party] = pp
+	}
+	return m
+}
+
+// Update sets the protection of a party, or removes it if no limit is set.
+// An ongoing freeze is not lifted by updating or removing the protection.
+func (m *MarketMakerProtections) Update(now time.Time, p *types.MarketMakerProtection) {
+	pp, ok := m.parties[p.Party]
+	if p.IsRemoval() {
+		if !ok {
+			return
+		}
+		if !pp.frozenUntil.After(now) {
+			delete(m.parties, p.Party)
+			return
+		}
+		pp.cfg, pp.fills = nil, nil
+		return
+	}
+
+	if !ok {
+		pp = &partyProtection{}
+		m.parties[p.Party] = pp
+	}
+	// fills recorded under the previous limits are discarded so the new
+	// window starts from a clean slate.
+	pp.cfg, pp.fills = p, nil
+}
+
+// RecordFill adds a fill for the party, and queues a trigger if any of its
+// limits is breached over the rolling window.
+func (m *MarketMakerProtections) RecordFill(now time.Time, party string, side types.Side, size uint64, notional *num.Uint) {
+	pp, ok := m.parties[party]
+	if !ok || pp.cfg == nil {
+		return
+	}
+
+	delta := int64(size)
+	if side == types.SideSell {
+		delta = -delta
+	}
+	pp.fills = append(pp.fills, &protectionFill{
+		time:     now,
+		volume:   size,
+		delta:    delta,
+		notional: notional.Clone(),
+	})
+	pp.prune(now)
+
+	if t := pp.breach(); t != nil {
+		t.Party = party
+		m.triggered = append(m.triggered, t)
+		// start counting afresh, the party's orders are about to be cancelled.
+		pp.fills = nil
+	}
+}
+
+// Triggered returns the pending triggers and clears them.
+func (m *MarketMakerProtections) Triggered() []*ProtectionTrigger {
+	t := m.triggered
+	m.triggered = nil
+	return t
+}
+
+// HasTriggered returns true if some triggers are waiting to be acted upon.
+func (m *MarketMakerProtections) HasTriggered() bool {
+	return len(m.triggered) > 0
+}
+
+// Freeze stops the party from placing resting orders until the end of its
+// freeze duration, which is returned.
+func (m *MarketMakerProtections) Freeze(now time.Time, party string) time.Time {
+	pp, ok := m.parties[party]
+	if !ok || pp.cfg == nil {
+		return now
+	}
+	pp.frozenUntil = now.Add(pp.cfg.FreezeDuration)
+	return pp.frozenUntil
+}
+
+// IsFrozen returns true if the party cannot place resting orders.
+func (m *MarketMakerProtections) IsFrozen(now time.Time, party string) bool {
+	pp, ok := m.parties[party]
+	if !ok {
+		return false
+	}
+	return pp.frozenUntil.After(now)
+}
+
+// OnTick drops the fills which fell out of their window and the parties
+// which removed their protection once their freeze is over.
+func (m *MarketMakerProtections) OnTick(now time.Time) {
+	for party, pp := range m.parties {
+		if pp.cfg == nil {
+			if !pp.frozenUntil.After(now) {
+				delete(m.parties, party)
+			}
+			continue
+		}
+		pp.prune(now)
+	}
+}
+
+func (m *MarketMakerProtections) GetState() []*snapshot.MarketMakerProtection {
+	parties := make([]string, 0, len(m.parties))
+	for party := range m.parties {
+		parties = append(parties, party)
+	}
+	sort.Strings(parties)
+
+	state := make([]*snapshot.MarketMakerProtection, 0, len(parties))
+	for _, party := range parties {
+		pp := m.parties[party]
+		s := &snapshot.MarketMakerProtection{
+			Party: party,
+			Fills: make([]*snapshot.MarketMakerProtectionFill, 0, len(pp.fills)),
+		}
+		if !pp.frozenUntil.IsZero() {
+			s.FrozenUntil = pp.frozenUntil.UnixNano()
+		}
+		if pp.cfg != nil {
+			s.WindowDuration = int64(pp.cfg.WindowDuration)
+			s.FreezeDuration = int64(pp.cfg.FreezeDuration)
+			s.MaxFilledVolume = pp.cfg.MaxFilledVolume
+			s.MaxFilledDelta = pp.cfg.MaxFilledDelta
+			if pp.cfg.MaxFilledNotional != nil {
+				notional := pp.cfg.MaxFilledNotional.String()
+				s.MaxFilledNotional = &notional
+			}
+		}
+		for _, f := range pp.fills {
+			s.Fills = append(s.Fills, &snapshot.MarketMakerProtectionFill{
+				Time:     f.time.UnixNano(),
+				Volume:   f.volume,
+				Delta:    f.delta,
+				Notional: f.notional.String(),
+			})
+		}
+		state = append(state, s)
+	}
+	return state
+}
+
+func (pp *partyProtection) prune(now time.Time) {
+	start := now.Add(-pp.cfg.WindowDuration)
+	i := 0
+	for i < len(pp.fills) && !pp.fills[i].time.After(start) {
+		i++
+	}
+	pp.fills = pp.fills[i:]
+}
+
+func (pp *partyProtection) breach() *ProtectionTrigger {
+	var (
+		volume   uint64
+		delta    int64
+		notional = num.UintZero()
+	)
+	for _, f := range pp.fills {
+		volume += f.volume
+		delta += f.delta
+		notional.AddSum(f.notional)
+	}
+
+	if pp.cfg.MaxFilledVolume != nil && volume > *pp.cfg.MaxFilledVolume {
+		return &ProtectionTrigger{
+			Limit:  types.MarketMakerProtectionLimitVolume,
+			Filled: num.NewUint(volume),
+		}
+	}
+	if delta < 0 {
+		delta = -delta
+	}
+	if pp.cfg.MaxFilledDelta != nil && uint64(delta) > *pp.cfg.MaxFilledDelta {
+		return &ProtectionTrigger{
+			Limit:  types.MarketMakerProtectionLimitDelta,
+			Filled: num.NewUint(uint64(delta)),
+		}
+	}
+	if pp.cfg.MaxFilledNotional != nil && notional.GT(pp.cfg.MaxFilledNotional) {
+		return &ProtectionTrigger{
+			Limit:  types.MarketMakerProtectionLimitNotional,
+			Filled: notional,
+		}
+	}
+	return nil
+}
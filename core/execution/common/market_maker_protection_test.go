@@ -0,0 +1,215 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package common_test
+
+import (
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/execution/common"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/ptr"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarketMakerProtections(t *testing.T) {
+	t.Run("breaching the volume limit triggers the protection", testMarketMakerProtectionVolumeLimit)
+	t.Run("offsetting fills do not breach the delta limit", testMarketMakerProtectionDeltaLimit)
+	t.Run("breaching the notional limit triggers the protection", testMarketMakerProtectionNotionalLimit)
+	t.Run("fills outside of the window are ignored", testMarketMakerProtectionWindow)
+	t.Run("a triggered party is frozen until the end of its freeze", testMarketMakerProtectionFreeze)
+	t.Run("removing the protection does not lift the freeze", testMarketMakerProtectionRemovalWhileFrozen)
+	t.Run("parties without protection are not tracked", testMarketMakerProtectionNotConfigured)
+	t.Run("snapshot", testMarketMakerProtectionSnapshot)
+}
+
+func newProtection(party string) *types.MarketMakerProtection {
+	return &types.MarketMakerProtection{
+		MarketID:       "market",
+		Party:          party,
+		WindowDuration: 10 * time.Second,
+		FreezeDuration: 30 * time.Second,
+	}
+}
+
+func testMarketMakerProtectionVolumeLimit(t *testing.T) {
+	now := time.Unix(1000, 0)
+	mmp := common.NewMarketMakerProtections()
+	p := newProtection("party1")
+	p.MaxFilledVolume = ptr.From(uint64(10))
+	mmp.Update(now, p)
+
+	mmp.RecordFill(now, "party1", types.SideBuy, 6, num.NewUint(60))
+	mmp.RecordFill(now, "party1", types.SideSell, 4, num.NewUint(40))
+	assert.False(t, mmp.HasTriggered())
+
+	mmp.RecordFill(now, "party1", types.SideSell, 1, num.NewUint(10))
+	triggered := mmp.Triggered()
+	require.Len(t, triggered, 1)
+	assert.Equal(t, "party1", triggered[0].Party)
+	assert.Equal(t, types.MarketMakerProtectionLimitVolume, triggered[0].Limit)
+	assert.Equal(t, "11", triggered[0].Filled.String())
+
+	// triggers are only reported once.
+	assert.False(t, mmp.HasTriggered())
+	assert.Empty(t, mmp.Triggered())
+}
+
+func testMarketMakerProtectionDeltaLimit(t *testing.T) {
+	now := time.Unix(1000, 0)
+	mmp := common.NewMarketMakerProtections()
+	p := newProtection("party1")
+	p.MaxFilledDelta = ptr.From(uint64(5))
+	mmp.Update(now, p)
+
+	mmp.RecordFill(now, "party1", types.SideBuy, 5, num.NewUint(50))
+	mmp.RecordFill(now, "party1", types.SideSell, 5, num.NewUint(50))
+	mmp.RecordFill(now, "party1", types.SideSell, 5, num.NewUint(50))
+	assert.False(t, mmp.HasTriggered())
+
+	mmp.RecordFill(now, "party1", types.SideSell, 1, num.NewUint(10))
+	triggered := mmp.Triggered()
+	require.Len(t, triggered, 1)
+	assert.Equal(t, types.MarketMakerProtectionLimitDelta, triggered[0].Limit)
+	assert.Equal(t, "6", triggered[0].Filled.String())
+}
+
+func testMarketMakerProtectionNotionalLimit(t *testing.T) {
+	now := time.Unix(1000, 0)
+	mmp := common.NewMarketMakerProtections()
+	p := newProtection("party1")
+	p.MaxFilledNotional = num.NewUint(1000)
+	mmp.Update(now, p)
+
+	mmp.RecordFill(now, "party1", types.SideBuy, 1, num.NewUint(600))
+	assert.False(t, mmp.HasTriggered())
+	mmp.RecordFill(now, "party1", types.SideSell, 1, num.NewUint(600))
+
+	triggered := mmp.Triggered()
+	require.Len(t, triggered, 1)
+	assert.Equal(t, types.MarketMakerProtectionLimitNotional, triggered[0].Limit)
+	assert.Equal(t, "1200", triggered[0].Filled.String())
+}
+
+func testMarketMakerProtectionWindow(t *testing.T) {
+	now := time.Unix(1000, 0)
+	mmp := common.NewMarketMakerProtections()
+	p := newProtection("party1")
+	p.MaxFilledVolume = ptr.From(uint64(10))
+	mmp.Update(now, p)
+
+	mmp.RecordFill(now, "party1", types.SideBuy, 8, num.NewUint(80))
+
+	// the first fill is now out of the window.
+	now = now.Add(10 * time.Second)
+	mmp.OnTick(now)
+	mmp.RecordFill(now, "party1", types.SideBuy, 8, num.NewUint(80))
+	assert.False(t, mmp.HasTriggered())
+
+	now = now.Add(time.Second)
+	mmp.RecordFill(now, "party1", types.SideBuy, 3, num.NewUint(30))
+	assert.True(t, mmp.HasTriggered())
+}
+
+func testMarketMakerProtectionFreeze(t *testing.T) {
+	now := time.Unix(1000, 0)
+	mmp := common.NewMarketMakerProtections()
+	p := newProtection("party1")
+	p.MaxFilledVolume = ptr.From(uint64(1))
+	mmp.Update(now, p)
+
+	mmp.RecordFill(now, "party1", types.SideBuy, 2, num.NewUint(20))
+	require.Len(t, mmp.Triggered(), 1)
+	assert.False(t, mmp.IsFrozen(now, "party1"))
+
+	frozenUntil := mmp.Freeze(now, "party1")
+	assert.Equal(t, now.Add(30*time.Second), frozenUntil)
+	assert.True(t, mmp.IsFrozen(now, "party1"))
+	assert.True(t, mmp.IsFrozen(frozenUntil.Add(-time.Nanosecond), "party1"))
+	assert.False(t, mmp.IsFrozen(frozenUntil, "party1"))
+	assert.False(t, mmp.IsFrozen(now, "party2"))
+}
+
+func testMarketMakerProtectionRemovalWhileFrozen(t *testing.T) {
+	now := time.Unix(1000, 0)
+	mmp := common.NewMarketMakerProtections()
+	p := newProtection("party1")
+	p.MaxFilledVolume = ptr.From(uint64(1))
+	mmp.Update(now, p)
+
+	mmp.RecordFill(now, "party1", types.SideBuy, 2, num.NewUint(20))
+	require.Len(t, mmp.Triggered(), 1)
+	frozenUntil := mmp.Freeze(now, "party1")
+
+	mmp.Update(now, newProtection("party1"))
+	assert.True(t, mmp.IsFrozen(now, "party1"))
+
+	// no more fills are tracked for the party.
+	mmp.RecordFill(now, "party1", types.SideBuy, 2, num.NewUint(20))
+	assert.False(t, mmp.HasTriggered())
+
+	// the party is dropped once its freeze is over.
+	mmp.OnTick(frozenUntil)
+	assert.False(t, mmp.IsFrozen(frozenUntil, "party1"))
+	assert.Empty(t, mmp.GetState())
+}
+
+func testMarketMakerProtectionNotConfigured(t *testing.T) {
+	now := time.Unix(1000, 0)
+	mmp := common.NewMarketMakerProtections()
+
+	mmp.RecordFill(now, "party1", types.SideBuy, 1000, num.NewUint(1000))
+	assert.False(t, mmp.HasTriggered())
+	assert.Equal(t, now, mmp.Freeze(now, "party1"))
+	assert.False(t, mmp.IsFrozen(now, "party1"))
+	assert.Empty(t, mmp.GetState())
+}
+
+func testMarketMakerProtectionSnapshot(t *testing.T) {
+	now := time.Unix(1000, 0)
+	mmp := common.NewMarketMakerProtections()
+
+	p1 := newProtection("party1")
+	p1.MaxFilledVolume = ptr.From(uint64(10))
+	p1.MaxFilledNotional = num.NewUint(1000)
+	mmp.Update(now, p1)
+	p2 := newProtection("party2")
+	p2.MaxFilledDelta = ptr.From(uint64(1))
+	mmp.Update(now, p2)
+
+	mmp.RecordFill(now, "party1", types.SideBuy, 5, num.NewUint(500))
+	mmp.RecordFill(now, "party2", types.SideSell, 2, num.NewUint(200))
+	require.Len(t, mmp.Triggered(), 1)
+	mmp.Freeze(now, "party2")
+
+	state := mmp.GetState()
+	require.Len(t, state, 2)
+	assert.Equal(t, "party1", state[0].Party)
+	assert.Equal(t, "party2", state[1].Party)
+
+	restored := common.NewMarketMakerProtectionsFromSnapshot("market", state)
+	assert.Equal(t, state, restored.GetState())
+	assert.True(t, restored.IsFrozen(now, "party2"))
+
+	// the restored fills still count towards the limits.
+	restored.RecordFill(now, "party1", types.SideBuy, 6, num.NewUint(600))
+	triggered := restored.Triggered()
+	require.Len(t, triggered, 1)
+	assert.Equal(t, types.MarketMakerProtectionLimitVolume, triggered[0].Limit)
+}
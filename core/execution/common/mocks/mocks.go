@@ -354,6 +354,36 @@ func (mr *MockCollateralMockRecorder) ClearSpotMarket(arg0, arg1, arg2, arg3 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearSpotMarket", reflect.TypeOf((*MockCollateral)(nil).ClearSpotMarket), arg0, arg1, arg2, arg3)
 }
 
+// CompleteSetMarginUpdate mocks base method.
+func (m *MockCollateral) CompleteSetMarginUpdate(arg0 context.Context, arg1 string, arg2 *types.Transfer) (*types.LedgerMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteSetMarginUpdate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.LedgerMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteSetMarginUpdate indicates an expected call of CompleteSetMarginUpdate.
+func (mr *MockCollateralMockRecorder) CompleteSetMarginUpdate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteSetMarginUpdate", reflect.TypeOf((*MockCollateral)(nil).CompleteSetMarginUpdate), arg0, arg1, arg2)
+}
+
+// CompleteSetUpdate mocks base method.
+func (m *MockCollateral) CompleteSetUpdate(arg0 context.Context, arg1 string, arg2 *types.Transfer) (*types.LedgerMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteSetUpdate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.LedgerMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteSetUpdate indicates an expected call of CompleteSetUpdate.
+func (mr *MockCollateralMockRecorder) CompleteSetUpdate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteSetUpdate", reflect.TypeOf((*MockCollateral)(nil).CompleteSetUpdate), arg0, arg1, arg2)
+}
+
 // CreateMarketAccounts mocks base method.
 func (m *MockCollateral) CreateMarketAccounts(arg0 context.Context, arg1, arg2 string) (string, string, error) {
 	m.ctrl.T.Helper()
@@ -877,6 +907,18 @@ func (mr *MockCollateralMockRecorder) RollbackMarginUpdateOnOrder(arg0, arg1, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackMarginUpdateOnOrder", reflect.TypeOf((*MockCollateral)(nil).RollbackMarginUpdateOnOrder), arg0, arg1, arg2, arg3)
 }
 
+// ShareInsurancePool mocks base method.
+func (m *MockCollateral) ShareInsurancePool(arg0, arg1 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ShareInsurancePool", arg0, arg1)
+}
+
+// ShareInsurancePool indicates an expected call of ShareInsurancePool.
+func (mr *MockCollateralMockRecorder) ShareInsurancePool(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareInsurancePool", reflect.TypeOf((*MockCollateral)(nil).ShareInsurancePool), arg0, arg1)
+}
+
 // SubAccountClosed mocks base method.
 func (m *MockCollateral) SubAccountClosed(arg0 context.Context, arg1, arg2, arg3, arg4 string) ([]*types.LedgerMovement, error) {
 	m.ctrl.T.Helper()
@@ -1301,6 +1343,18 @@ func (mr *MockAuctionStateMockRecorder) ExtendAuction(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendAuction", reflect.TypeOf((*MockAuctionState)(nil).ExtendAuction), arg0)
 }
 
+// ExtendAuctionIndexDivergence mocks base method.
+func (m *MockAuctionState) ExtendAuctionIndexDivergence(arg0 types.AuctionDuration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ExtendAuctionIndexDivergence", arg0)
+}
+
+// ExtendAuctionIndexDivergence indicates an expected call of ExtendAuctionIndexDivergence.
+func (mr *MockAuctionStateMockRecorder) ExtendAuctionIndexDivergence(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendAuctionIndexDivergence", reflect.TypeOf((*MockAuctionState)(nil).ExtendAuctionIndexDivergence), arg0)
+}
+
 // ExtendAuctionLongBlock mocks base method.
 func (m *MockAuctionState) ExtendAuctionLongBlock(arg0 types.AuctionDuration) {
 	m.ctrl.T.Helper()
@@ -1503,6 +1557,18 @@ func (mr *MockAuctionStateMockRecorder) Start() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockAuctionState)(nil).Start))
 }
 
+// StartBatchAuction mocks base method.
+func (m *MockAuctionState) StartBatchAuction(arg0 time.Time, arg1 int64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StartBatchAuction", arg0, arg1)
+}
+
+// StartBatchAuction indicates an expected call of StartBatchAuction.
+func (mr *MockAuctionStateMockRecorder) StartBatchAuction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBatchAuction", reflect.TypeOf((*MockAuctionState)(nil).StartBatchAuction), arg0, arg1)
+}
+
 // StartGovernanceSuspensionAuction mocks base method.
 func (m *MockAuctionState) StartGovernanceSuspensionAuction(arg0 time.Time) {
 	m.ctrl.T.Helper()
@@ -1515,6 +1581,18 @@ func (mr *MockAuctionStateMockRecorder) StartGovernanceSuspensionAuction(arg0 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartGovernanceSuspensionAuction", reflect.TypeOf((*MockAuctionState)(nil).StartGovernanceSuspensionAuction), arg0)
 }
 
+// StartIndexDivergenceAuction mocks base method.
+func (m *MockAuctionState) StartIndexDivergenceAuction(arg0 time.Time, arg1 *types.AuctionDuration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StartIndexDivergenceAuction", arg0, arg1)
+}
+
+// StartIndexDivergenceAuction indicates an expected call of StartIndexDivergenceAuction.
+func (mr *MockAuctionStateMockRecorder) StartIndexDivergenceAuction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartIndexDivergenceAuction", reflect.TypeOf((*MockAuctionState)(nil).StartIndexDivergenceAuction), arg0, arg1)
+}
+
 // StartLongBlockAuction mocks base method.
 func (m *MockAuctionState) StartLongBlockAuction(arg0 time.Time, arg1 int64) {
 	m.ctrl.T.Helper()
@@ -1553,6 +1631,18 @@ func (mr *MockAuctionStateMockRecorder) Trigger() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trigger", reflect.TypeOf((*MockAuctionState)(nil).Trigger))
 }
 
+// UpdateDefaultMode mocks base method.
+func (m *MockAuctionState) UpdateDefaultMode(arg0 vega.Market_TradingMode) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateDefaultMode", arg0)
+}
+
+// UpdateDefaultMode indicates an expected call of UpdateDefaultMode.
+func (mr *MockAuctionStateMockRecorder) UpdateDefaultMode(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDefaultMode", reflect.TypeOf((*MockAuctionState)(nil).UpdateDefaultMode), arg0)
+}
+
 // UpdateMaxDuration mocks base method.
 func (m *MockAuctionState) UpdateMaxDuration(arg0 context.Context, arg1 time.Duration) {
 	m.ctrl.T.Helper()
@@ -31,6 +31,9 @@ type PeggedOrders struct {
 	// parked list
 	parked   []*types.Order
 	isParked map[string]struct{}
+	// reference prices not derived from the book, as of the last repricing
+	lastMarkPrice  *num.Uint
+	lastIndexPrice *num.Uint
 }
 
 func NewPeggedOrders(log *logging.Logger, ts TimeService) *PeggedOrders {
@@ -52,6 +55,8 @@ func NewPeggedOrdersFromSnapshot(
 	for _, v := range p.parked {
 		p.isParked[v.ID] = struct{}{}
 	}
+	p.lastMarkPrice = state.LastMarkPrice
+	p.lastIndexPrice = state.LastIndexPrice
 	return p
 }
 
@@ -69,9 +74,16 @@ func (p *PeggedOrders) GetState() *types.PeggedOrdersState {
 		parkedCopy = append(parkedCopy, v.Clone())
 	}
 
-	return &types.PeggedOrdersState{
+	state := &types.PeggedOrdersState{
 		Parked: parkedCopy,
 	}
+	if p.lastMarkPrice != nil {
+		state.LastMarkPrice = p.lastMarkPrice.Clone()
+	}
+	if p.lastIndexPrice != nil {
+		state.LastIndexPrice = p.lastIndexPrice.Clone()
+	}
+	return state
 }
 
 func (p *PeggedOrders) IsParked(id string) bool {
@@ -79,6 +91,35 @@ func (p *PeggedOrders) IsParked(id string) bool {
 	return parked
 }
 
+// ReferencePricesMoved records the current mark and index prices of the market and returns
+// the PriceMove flags for those which changed since the previous call, so the pegged orders
+// referencing them get repriced.
+func (p *PeggedOrders) ReferencePricesMoved(markPrice, indexPrice *num.Uint) uint8 {
+	var changes uint8
+	if referencePriceMoved(p.lastMarkPrice, markPrice) {
+		changes |= PriceMoveMarkPrice
+	}
+	if referencePriceMoved(p.lastIndexPrice, indexPrice) {
+		changes |= PriceMoveIndexPrice
+	}
+
+	p.lastMarkPrice, p.lastIndexPrice = nil, nil
+	if markPrice != nil {
+		p.lastMarkPrice = markPrice.Clone()
+	}
+	if indexPrice != nil {
+		p.lastIndexPrice = indexPrice.Clone()
+	}
+	return changes
+}
+
+func referencePriceMoved(last, current *num.Uint) bool {
+	if last == nil || current == nil {
+		return last != current
+	}
+	return !last.EQ(current)
+}
+
 func (p *PeggedOrders) Park(o *types.Order) {
 	o.UpdatedAt = p.timeService.GetTimeNow().UnixNano()
 	o.Status = types.OrderStatusParked
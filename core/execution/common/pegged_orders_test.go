@@ -58,6 +58,7 @@ func getTestOrders() []*types.Order {
 
 func TestPeggedOrders(t *testing.T) {
 	t.Run("snapshot ", testPeggedOrdersSnapshot)
+	t.Run("reference prices moved", testPeggedOrdersReferencePricesMoved)
 }
 
 func testPeggedOrdersSnapshot(t *testing.T) {
@@ -108,6 +109,9 @@ func testPeggedOrdersSnapshot(t *testing.T) {
 	p.GetParkedByID("id-2")
 	p.GetParkedOrdersCount()
 
+	// Test reference prices are part of the state
+	p.ReferencePricesMoved(num.NewUint(100), num.NewUint(101))
+
 	// Test restore state
 	s = p.GetState()
 
@@ -115,3 +119,51 @@ func testPeggedOrdersSnapshot(t *testing.T) {
 	a.Equal(s, newP.GetState())
 	a.Equal(len(p.GetParkedIDs()), len(newP.GetParkedIDs()))
 }
+
+func testPeggedOrdersReferencePricesMoved(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tm := mocks.NewMockTimeService(ctrl)
+	p := common.NewPeggedOrders(logging.NewTestLogger(), tm)
+
+	// no mark or index price yet
+	assert.Equal(t, uint8(0), p.ReferencePricesMoved(nil, nil))
+
+	// first prices are moves
+	assert.Equal(t, uint8(common.PriceMoveMarkPrice|common.PriceMoveIndexPrice), p.ReferencePricesMoved(num.NewUint(100), num.NewUint(101)))
+	assert.Equal(t, uint8(0), p.ReferencePricesMoved(num.NewUint(100), num.NewUint(101)))
+
+	// only the mark price moves
+	assert.Equal(t, uint8(common.PriceMoveMarkPrice), p.ReferencePricesMoved(num.NewUint(99), num.NewUint(101)))
+
+	// only the index price moves, and the mark price check is not affected by the previous call
+	assert.Equal(t, uint8(common.PriceMoveIndexPrice), p.ReferencePricesMoved(num.NewUint(99), num.NewUint(102)))
+
+	// losing the index price is a move too
+	assert.Equal(t, uint8(common.PriceMoveIndexPrice), p.ReferencePricesMoved(num.NewUint(99), nil))
+}
+
+func TestOrderReferenceCheckHasMoved(t *testing.T) {
+	peggedOrder := func(side types.Side, reference types.PeggedReference) common.OrderReferenceCheck {
+		return common.OrderReferenceCheck(types.Order{
+			Side:        side,
+			PeggedOrder: &types.PeggedOrder{Reference: reference},
+		})
+	}
+
+	markBuy := peggedOrder(types.SideBuy, types.PeggedReferenceMarkPrice)
+	assert.True(t, markBuy.HasMoved(common.PriceMoveMarkPrice))
+	assert.False(t, markBuy.HasMoved(common.PriceMoveIndexPrice))
+	assert.False(t, markBuy.HasMoved(common.PriceMoveMid|common.PriceMoveBestBid))
+	// the order may have been parked because it would cross the best ask
+	assert.True(t, markBuy.HasMoved(common.PriceMoveBestAsk))
+
+	indexSell := peggedOrder(types.SideSell, types.PeggedReferenceIndexPrice)
+	assert.True(t, indexSell.HasMoved(common.PriceMoveIndexPrice))
+	assert.False(t, indexSell.HasMoved(common.PriceMoveMarkPrice))
+	assert.False(t, indexSell.HasMoved(common.PriceMoveBestAsk))
+	assert.True(t, indexSell.HasMoved(common.PriceMoveBestBid))
+
+	for _, o := range []common.OrderReferenceCheck{markBuy, indexSell, peggedOrder(types.SideBuy, types.PeggedReferenceMid)} {
+		assert.True(t, o.HasMoved(common.PriceMoveAll))
+	}
+}
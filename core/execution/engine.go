@@ -28,9 +28,12 @@ import (
 	"code.vegaprotocol.io/vega/core/execution/future"
 	"code.vegaprotocol.io/vega/core/execution/spot"
 	"code.vegaprotocol.io/vega/core/fee"
+	"code.vegaprotocol.io/vega/core/idgeneration"
 	"code.vegaprotocol.io/vega/core/metrics"
 	"code.vegaprotocol.io/vega/core/monitor"
+	"code.vegaprotocol.io/vega/core/risk"
 	"code.vegaprotocol.io/vega/core/types"
+	vegacontext "code.vegaprotocol.io/vega/libs/context"
 	"code.vegaprotocol.io/vega/libs/crypto"
 	"code.vegaprotocol.io/vega/libs/num"
 	"code.vegaprotocol.io/vega/logging"
@@ -43,9 +46,6 @@ var (
 	// ErrMarketDoesNotExist is returned when the market does not exist.
 	ErrMarketDoesNotExist = errors.New("market does not exist")
 
-	// ErrNotAFutureMarket is returned when the market isn't a future market.
-	ErrNotAFutureMarket = errors.New("not a future market")
-
 	// ErrNoMarketID is returned when invalid (empty) market id was supplied during market creation.
 	ErrNoMarketID = errors.New("no valid market id was supplied")
 
@@ -100,6 +100,21 @@ type Engine struct {
 
 	npv netParamsValues
 
+	// nets the margins of cross margin parties across groups of correlated markets
+	portfolio *risk.Portfolio
+	// number of portfolio reassessments in the block, to seed their ID generators
+	portfolioRounds uint64
+
+	// party ID to the deadline after which its orders are cancelled
+	cancelOnTimeouts map[string]*cancelOnTimeout
+
+	// open requests for quote by ID, and the active quotes made on them by ID
+	quoteRequests map[string]*types.QuoteRequest
+	quotes        map[string]*types.Quote
+
+	// prediction ID to the IDs of its outcome markets, by outcome index
+	predictions map[string][]string
+
 	snapshotSerialised    []byte
 	newGeneratedProviders []types.StateProvider // new providers generated during the last state change
 
@@ -159,6 +174,11 @@ func NewEngine(
 		broker:                        broker,
 		oracle:                        oracle,
 		npv:                           defaultNetParamsValues(),
+		portfolio:                     risk.NewPortfolio(),
+		cancelOnTimeouts:              map[string]*cancelOnTimeout{},
+		quoteRequests:                 map[string]*types.QuoteRequest{},
+		quotes:                        map[string]*types.Quote{},
+		predictions:                   map[string][]string{},
 		generatedProviders:            map[string]struct{}{},
 		stateVarEngine:                stateVarEngine,
 		marketActivityTracker:         marketActivityTracker,
@@ -245,16 +265,15 @@ func (e *Engine) Hash() []byte {
 	return crypto.Hash(bytes)
 }
 
-func (e *Engine) ensureIsFutureMarket(market string) error {
-	if _, exist := e.allMarkets[market]; !exist {
-		return ErrMarketDoesNotExist
+// ammMarket returns the future or spot market an AMM command is targeting.
+func (e *Engine) ammMarket(marketID string) (common.CommonMarket, error) {
+	if mkt, ok := e.futureMarkets[marketID]; ok {
+		return mkt, nil
 	}
-
-	if _, isFuture := e.futureMarkets[market]; !isFuture {
-		return ErrNotAFutureMarket
+	if mkt, ok := e.spotMarkets[marketID]; ok {
+		return mkt, nil
 	}
-
-	return nil
+	return nil, ErrMarketDoesNotExist
 }
 
 func (e *Engine) SubmitAMM(
@@ -262,11 +281,14 @@ func (e *Engine) SubmitAMM(
 	submit *types.SubmitAMM,
 	deterministicID string,
 ) error {
-	if err := e.ensureIsFutureMarket(submit.MarketID); err != nil {
+	defer e.reassessPortfolio(ctx)
+
+	mkt, err := e.ammMarket(submit.MarketID)
+	if err != nil {
 		return err
 	}
 
-	return e.allMarkets[submit.MarketID].SubmitAMM(ctx, submit, deterministicID)
+	return mkt.SubmitAMM(ctx, submit, deterministicID)
 }
 
 func (e *Engine) AmendAMM(
@@ -274,11 +296,14 @@ func (e *Engine) AmendAMM(
 	submit *types.AmendAMM,
 	deterministicID string,
 ) error {
-	if err := e.ensureIsFutureMarket(submit.MarketID); err != nil {
+	defer e.reassessPortfolio(ctx)
+
+	mkt, err := e.ammMarket(submit.MarketID)
+	if err != nil {
 		return err
 	}
 
-	return e.allMarkets[submit.MarketID].AmendAMM(ctx, submit, deterministicID)
+	return mkt.AmendAMM(ctx, submit, deterministicID)
 }
 
 func (e *Engine) CancelAMM(
@@ -286,17 +311,39 @@ func (e *Engine) CancelAMM(
 	cancel *types.CancelAMM,
 	deterministicID string,
 ) error {
-	if err := e.ensureIsFutureMarket(cancel.MarketID); err != nil {
+	defer e.reassessPortfolio(ctx)
+
+	mkt, err := e.ammMarket(cancel.MarketID)
+	if err != nil {
 		return err
 	}
 
-	return e.allMarkets[cancel.MarketID].CancelAMM(ctx, cancel, deterministicID)
+	return mkt.CancelAMM(ctx, cancel, deterministicID)
+}
+
+// UpdateMarketMakerProtection sets, or removes, the market maker protection of a party in a market.
+func (e *Engine) UpdateMarketMakerProtection(ctx context.Context, protection *types.MarketMakerProtection) error {
+	mkt, ok := e.allMarkets[protection.MarketID]
+	if !ok {
+		return types.ErrInvalidMarketID
+	}
+	return mkt.UpdateMarketMakerProtection(ctx, protection)
 }
 
 // RejectMarket will stop the execution of the market
 // and refund into the general account any funds in margins accounts from any parties
 // This works only if the market is in a PROPOSED STATE.
+// Rejecting a prediction market rejects all its outcome markets.
 func (e *Engine) RejectMarket(ctx context.Context, marketID string) error {
+	for _, id := range e.predictionMarketIDs(marketID) {
+		if err := e.rejectMarket(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *Engine) rejectMarket(ctx context.Context, marketID string) error {
 	if e.log.IsDebug() {
 		e.log.Debug("reject market", logging.MarketID(marketID))
 	}
@@ -336,16 +383,22 @@ func (e *Engine) RejectMarket(ctx context.Context, marketID string) error {
 
 // StartOpeningAuction will start the opening auction of the given market.
 // This will work only if the market is currently in a PROPOSED state.
+// Starting the opening auction of a prediction market starts it for all its outcome markets.
 func (e *Engine) StartOpeningAuction(ctx context.Context, marketID string) error {
-	if e.log.IsDebug() {
-		e.log.Debug("start opening auction", logging.MarketID(marketID))
-	}
+	for _, id := range e.predictionMarketIDs(marketID) {
+		if e.log.IsDebug() {
+			e.log.Debug("start opening auction", logging.MarketID(id))
+		}
 
-	if mkt, ok := e.allMarkets[marketID]; ok {
-		return mkt.StartOpeningAuction(ctx)
+		mkt, ok := e.allMarkets[id]
+		if !ok {
+			return ErrMarketDoesNotExist
+		}
+		if err := mkt.StartOpeningAuction(ctx); err != nil {
+			return err
+		}
 	}
-
-	return ErrMarketDoesNotExist
+	return nil
 }
 
 func (e *Engine) EnterLongBlockAuction(ctx context.Context, duration int64) {
@@ -450,8 +503,18 @@ func (e *Engine) IsEligibleForProposerBonus(marketID string, value *num.Uint) bo
 }
 
 // SubmitMarket submits a new market configuration to the network.
+// A prediction market is submitted as one market per outcome, if one of them fails the ones already submitted are rejected.
 func (e *Engine) SubmitMarket(ctx context.Context, marketConfig *types.Market, proposer string, oos time.Time) error {
-	return e.submitOrRestoreMarket(ctx, marketConfig, proposer, true, oos)
+	markets := predictionOutcomeMarkets(marketConfig)
+	for i, mkt := range markets {
+		if err := e.submitOrRestoreMarket(ctx, mkt, proposer, true, oos); err != nil {
+			for _, submitted := range markets[:i] {
+				_ = e.rejectMarket(ctx, submitted.ID)
+			}
+			return err
+		}
+	}
+	return nil
 }
 
 // SubmitSpotMarket submits a new spot market configuration to the network.
@@ -465,6 +528,15 @@ func (e *Engine) RestoreMarket(ctx context.Context, marketConfig *types.Market)
 	if len(proposer) == 0 {
 		return ErrMarketDoesNotExist
 	}
+	for _, mkt := range predictionOutcomeMarkets(marketConfig) {
+		if err := e.restoreMarketFromCheckpoint(ctx, mkt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *Engine) restoreMarketFromCheckpoint(ctx context.Context, marketConfig *types.Market) error {
 	// restoring a market means starting it as though the proposal was accepted now.
 	if err := e.submitOrRestoreMarket(ctx, marketConfig, "", false, e.timeService.GetTimeNow()); err != nil {
 		return err
@@ -676,6 +748,9 @@ func (e *Engine) submitMarket(ctx context.Context, marketConfig *types.Market, o
 			logging.AssetID(asset))
 	}
 
+	// the outcome markets of a prediction share its insurance pool, which has to be known before the accounts are created
+	e.trackPredictionOutcome(marketConfig)
+
 	// ignore the response, this cannot fail as the asset
 	// is already proven to exists a few line before
 	_, _, _ = e.collateral.CreateMarketAccounts(ctx, marketConfig.ID, asset)
@@ -798,6 +873,7 @@ func (e *Engine) submitSpotMarket(ctx context.Context, marketConfig *types.Marke
 		e.volumeDiscountService,
 		e.volumeRebateService,
 		e.banking,
+		e.parties,
 	)
 	if err != nil {
 		e.log.Error("failed to instantiate market",
@@ -835,8 +911,9 @@ func (e *Engine) removeMarket(mktID string) {
 			break
 		}
 	}
-	if _, ok := e.futureMarkets[mktID]; ok {
+	if fmkt, ok := e.futureMarkets[mktID]; ok {
 		delete(e.futureMarkets, mktID)
+		e.untrackPredictionOutcome(fmkt.Mkt())
 		for i, mkt := range e.futureMarketsCpy {
 			if mkt.GetID() == mktID {
 				mkt.StopSnapshots()
@@ -845,6 +922,7 @@ func (e *Engine) removeMarket(mktID string) {
 				e.futureMarketsCpy[len(e.futureMarketsCpy)-1] = nil
 				e.futureMarketsCpy = e.futureMarketsCpy[:len(e.futureMarketsCpy)-1]
 				e.marketActivityTracker.RemoveMarket(mkt.GetSettlementAsset(), mktID)
+				e.portfolio.RemoveMarket(mktID)
 				e.log.Debug("removed in total", logging.String("id", mktID))
 				return
 			}
@@ -883,6 +961,8 @@ func (e *Engine) SubmitStopOrders(
 	fallsBelowID *string,
 	risesAboveID *string,
 ) (*types.OrderConfirmation, error) {
+	defer e.reassessPortfolio(ctx)
+
 	var market string
 	if submission.FallsBelow != nil {
 		market = submission.FallsBelow.OrderSubmission.MarketID
@@ -958,6 +1038,8 @@ func (e *Engine) cancelAllPartyStopOrders(ctx context.Context, party string) err
 
 // SubmitOrder checks the incoming order and submits it to a Vega market.
 func (e *Engine) SubmitOrder(ctx context.Context, submission *types.OrderSubmission, party string, idgen common.IDGenerator, orderID string) (*types.OrderConfirmation, error) {
+	defer e.reassessPortfolio(ctx)
+
 	timer := metrics.NewTimeCounter(submission.MarketID, "execution", "SubmitOrder")
 	defer func() {
 		timer.EngineTimeCounterAdd()
@@ -996,6 +1078,8 @@ func (e *Engine) ValidateSettlementData(mID string, data *num.Uint) bool {
 // AmendOrder takes order amendment details and attempts to amend the order
 // if it exists and is in a editable state.
 func (e *Engine) AmendOrder(ctx context.Context, amendment *types.OrderAmendment, party string, idgen common.IDGenerator) (*types.OrderConfirmation, error) {
+	defer e.reassessPortfolio(ctx)
+
 	timer := metrics.NewTimeCounter(amendment.MarketID, "execution", "AmendOrder")
 	defer func() {
 		timer.EngineTimeCounterAdd()
@@ -1192,6 +1276,11 @@ func (e *Engine) OnTick(ctx context.Context, t time.Time) {
 
 	e.log.Debug("updating engine on new time update")
 
+	// cancel the orders of the parties which didn't refresh their deadline in time
+	// before the markets get to act on them.
+	e.checkCancelOnTimeouts(ctx, t)
+	e.checkQuoteRequests(ctx, t)
+
 	// notify markets of the time expiration
 	toDelete := []string{}
 	parentStates := e.getParentStates()
@@ -1394,6 +1483,24 @@ func (e *Engine) BlockEnd(ctx context.Context) {
 	for _, mkt := range e.allMarketsCpy {
 		mkt.BlockEnd(ctx)
 	}
+	e.reassessPortfolio(ctx)
+	e.portfolioRounds = 0
+}
+
+// reassessPortfolio checks the margins of the parties in the markets of their portfolio once their position changed
+// in another market of the group, or they opted in or out of portfolio margin, until no further closeout happens.
+func (e *Engine) reassessPortfolio(ctx context.Context) {
+	for toReassess := e.portfolio.PopChanges(); len(toReassess) > 0; toReassess = e.portfolio.PopChanges() {
+		var tID string
+		ctx, tID = vegacontext.TraceIDFromContext(ctx)
+		e.portfolioRounds++
+		idgen := idgeneration.New(crypto.HashStrToHex(fmt.Sprintf("%sportfolio%d", tID, e.portfolioRounds)))
+		for _, mkt := range e.futureMarketsCpy {
+			if parties, ok := toReassess[mkt.GetID()]; ok {
+				mkt.ReassessPortfolioParties(ctx, parties, idgen)
+			}
+		}
+	}
 }
 
 func (e *Engine) BeginBlock(ctx context.Context, prevBlockDuration time.Duration) {
@@ -1499,7 +1606,7 @@ func (e *Engine) OnChainIDUpdate(cID uint64) error {
 	return nil
 }
 
-func (e *Engine) UpdateMarginMode(ctx context.Context, party, marketID string, marginMode types.MarginMode, marginFactor num.Decimal) error {
+func (e *Engine) UpdateMarginMode(ctx context.Context, party, marketID string, marginMode types.MarginMode, marginFactor num.Decimal, portfolioMargin bool) error {
 	if _, ok := e.futureMarkets[marketID]; !ok {
 		return types.ErrInvalidMarketID
 	}
@@ -1512,7 +1619,10 @@ func (e *Engine) UpdateMarginMode(ctx context.Context, party, marketID string, m
 		}
 	}
 
-	return market.UpdateMarginMode(ctx, party, marginMode, marginFactor)
+	// the portfolio margin of the party changes in the other markets of the group as well
+	defer e.reassessPortfolio(ctx)
+
+	return market.UpdateMarginMode(ctx, party, marginMode, marginFactor, portfolioMargin)
 }
 
 func (e *Engine) OnMinimalMarginQuantumMultipleUpdate(_ context.Context, multiplier num.Decimal) error {
@@ -319,6 +319,25 @@ func (e *Engine) OnMarketMarginScalingFactorsUpdate(ctx context.Context, v inter
 	return nil
 }
 
+func (e *Engine) OnMarketMarginPortfolioGroupsUpdate(_ context.Context, v interface{}) error {
+	if e.log.IsDebug() {
+		e.log.Debug("update market margin portfolio groups",
+			logging.Reflect("portfolio-groups", v),
+		)
+	}
+
+	pgroups, ok := v.(*vega.PortfolioMarginGroups)
+	if !ok {
+		return errors.New("invalid types for margin portfolio groups")
+	}
+	groups, err := types.PortfolioMarginGroupsFromProto(pgroups)
+	if err != nil {
+		return err
+	}
+	e.portfolio.UpdateGroups(groups)
+	return nil
+}
+
 func (e *Engine) OnMarketFeeFactorsMakerFeeUpdate(ctx context.Context, d num.Decimal) error {
 	if e.log.IsDebug() {
 		e.log.Debug("update maker fee in market fee factors",
@@ -574,6 +593,9 @@ func (e *Engine) propagateSpotInitialNetParams(ctx context.Context, mkt *spot.Ma
 	mkt.OnMarketPartiesMaximumStopOrdersUpdate(ctx, e.npv.marketPartiesMaximumStopOrdersUpdate)
 	mkt.OnMinimalHoldingQuantumMultipleUpdate(e.minHoldingQuantumMultiplier)
 
+	mkt.OnAMMMinCommitmentQuantumUpdate(ctx, e.npv.ammCommitmentQuantum)
+	mkt.OnMarketAMMMaxCalculationLevels(ctx, e.npv.ammCalculationLevels)
+
 	e.propagateSLANetParams(ctx, mkt, isRestore)
 
 	if !e.npv.liquidityELSFeeFraction.IsZero() {
@@ -583,6 +605,9 @@ func (e *Engine) propagateSpotInitialNetParams(ctx context.Context, mkt *spot.Ma
 }
 
 func (e *Engine) propagateInitialNetParamsToFutureMarket(ctx context.Context, mkt *future.Market, isRestore bool) error {
+	mkt.SetPortfolio(e.portfolio)
+	e.portfolio.RegisterMarket(mkt)
+
 	if !e.npv.probabilityOfTradingTauScaling.Equal(num.DecimalFromInt64(-1)) {
 		mkt.OnMarketProbabilityOfTradingTauScalingUpdate(ctx, e.npv.probabilityOfTradingTauScaling)
 	}
@@ -140,6 +140,7 @@ func (e *Engine) restoreSpotMarket(ctx context.Context, em *types.ExecSpotMarket
 		e.volumeDiscountService,
 		e.volumeRebateService,
 		e.banking,
+		e.parties,
 	)
 	if err != nil {
 		e.log.Error("failed to instantiate market",
@@ -191,6 +192,8 @@ func (e *Engine) restoreMarket(ctx context.Context, em *types.ExecMarket) (*futu
 		return nil, err
 	}
 
+	e.trackPredictionOutcome(marketConfig)
+
 	nextMTM := time.Unix(0, em.NextMTM)
 	nextInternalCompositePriceCalc := time.Unix(0, em.NextInternalCompositePriceCalc)
 
@@ -320,11 +323,13 @@ func (e *Engine) serialise() (snapshot []byte, providers []types.StateProvider,
 	pl := types.Payload{
 		Data: &types.PayloadExecutionMarkets{
 			ExecutionMarkets: &types.ExecutionMarkets{
-				Markets:        mkts,
-				SpotMarkets:    spotMkts,
-				SettledMarkets: cpStates,
-				Successors:     successors,
-				AllMarketIDs:   allMarketIDs,
+				Markets:          mkts,
+				SpotMarkets:      spotMkts,
+				SettledMarkets:   cpStates,
+				Successors:       successors,
+				AllMarketIDs:     allMarketIDs,
+				CancelOnTimeouts: e.serialiseCancelOnTimeouts(),
+				RequestsForQuote: e.serialiseRequestsForQuote(),
 			},
 		},
 	}
@@ -372,6 +377,10 @@ func (e *Engine) LoadState(ctx context.Context, payload *types.Payload) ([]types
 			e.marketCPStates[m.Market.ID] = cpy
 		}
 		e.restoreSuccessorMaps(pl.ExecutionMarkets.Successors)
+		e.restoreCancelOnTimeouts(pl.ExecutionMarkets.CancelOnTimeouts)
+		if err := e.restoreRequestsForQuote(pl.ExecutionMarkets.RequestsForQuote); err != nil {
+			return nil, fmt.Errorf("failed to restore requests for quote: %w", err)
+		}
 		e.snapshotSerialised, err = proto.Marshal(payload.IntoProto())
 		if err != nil {
 			return nil, err
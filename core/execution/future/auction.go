@@ -133,6 +133,11 @@ func (m *Market) checkAuction(ctx context.Context, now time.Time, idgen common.I
 		}
 	}
 
+	// the current batch has expired, the book can be uncrossed unless price monitoring extends the batch
+	if m.as.IsFBA() && m.as.ExtensionTrigger() != types.AuctionTriggerGovernanceSuspension {
+		m.as.SetReadyToLeave()
+	}
+
 	// price and liquidity auctions
 	isPrice := m.as.IsPriceAuction() || m.as.IsPriceExtension()
 	if !isPrice {
@@ -154,6 +159,4 @@ func (m *Market) checkAuction(ctx context.Context, now time.Time, idgen common.I
 		// can we leave based on the book state?
 		m.leaveAuction(ctx, now)
 	}
-
-	// This is where FBA handling will go
 }
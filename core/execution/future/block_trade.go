@@ -0,0 +1,240 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package future
+
+import (
+	"context"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/execution/common"
+	"code.vegaprotocol.io/vega/core/positions"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+)
+
+// SubmitBlockTrade executes a trade of the given size and price, in market precision, between the party
+// which requested a quote (the taker) and the party which quoted (the maker), without going through the book.
+// The taker is the aggressor and pays the fees, and both parties go through the same margin checks as an
+// aggressive order trading immediately, and the same position limits. If the maker cannot cover the margin,
+// ErrBlockTradeMakerMarginCheckFailed is returned so the quote can be rejected, ErrBlockTradeMakerPositionLimitExceeded
+// if the trade would take its position above the limits.
+func (m *Market) SubmitBlockTrade(
+	ctx context.Context,
+	taker, maker string,
+	side types.Side,
+	size uint64,
+	price *num.Uint,
+	reference string,
+	idgen common.IDGenerator,
+) (*types.Trade, error) {
+	defer m.onTxProcessed()
+	defer m.applyMarketMakerProtections(ctx)
+
+	m.idgen = idgen
+	defer func() { m.idgen = nil }()
+
+	if !m.canTrade() || m.inNonBatchAuction() {
+		return nil, common.ErrTradingNotAllowed
+	}
+
+	mktPrice, _ := num.UintFromDecimal(price.ToDecimal().Mul(m.priceFactor))
+	if mktPrice.IsZero() || (m.capMax != nil && mktPrice.GTE(m.capMax)) {
+		return nil, common.ErrInvalidOrderPrice
+	}
+	minPrice, maxPrice := m.pMonitor.GetValidPriceRange()
+	if mktPrice.LT(minPrice.Representation()) || mktPrice.GT(maxPrice.Representation()) {
+		return nil, common.ErrBlockTradePriceOutOfBounds
+	}
+
+	now := m.timeService.GetTimeNow().UnixNano()
+	newOrder := func(party string, side types.Side) *types.Order {
+		return &types.Order{
+			ID:            idgen.NextID(),
+			MarketID:      m.mkt.ID,
+			Party:         party,
+			Side:          side,
+			Price:         mktPrice.Clone(),
+			OriginalPrice: price.Clone(),
+			Size:          size,
+			Remaining:     size,
+			TimeInForce:   types.OrderTimeInForceFOK,
+			Type:          types.OrderTypeLimit,
+			Status:        types.OrderStatusActive,
+			Version:       common.InitialOrderVersion,
+			CreatedAt:     now,
+			Reference:     reference,
+		}
+	}
+	takerOrder := newOrder(taker, side)
+	makerOrder := newOrder(maker, types.OtherSide(side))
+
+	for _, order := range []*types.Order{takerOrder, makerOrder} {
+		if err := m.validateAccounts(ctx, order); err != nil {
+			return nil, err
+		}
+		if err := m.position.ValidateOrder(order); err != nil {
+			return nil, err
+		}
+	}
+	if err := m.checkUnregisteredPositionLimits(takerOrder); err != nil {
+		takerOrder.Status = types.OrderStatusRejected
+		if oerr, ok := types.IsOrderError(err); ok {
+			takerOrder.Reason = oerr
+		}
+		m.broker.Send(events.NewOrderEvent(ctx, takerOrder))
+		return nil, err
+	}
+	if err := m.checkUnregisteredPositionLimits(makerOrder); err != nil {
+		return nil, common.ErrBlockTradeMakerPositionLimitExceeded
+	}
+
+	trade := &types.Trade{
+		Type:        types.TradeTypeBlock,
+		MarketID:    m.mkt.ID,
+		Price:       mktPrice.Clone(),
+		MarketPrice: price.Clone(),
+		Size:        size,
+		Aggressor:   side,
+		Buyer:       takerOrder.Party,
+		Seller:      makerOrder.Party,
+		Timestamp:   now,
+	}
+	if side == types.SideSell {
+		trade.Buyer, trade.Seller = trade.Seller, trade.Buyer
+	}
+	trades := []*types.Trade{trade}
+
+	fees, err := m.calcFees(trades)
+	if err != nil {
+		return nil, err
+	}
+	var takerFee *num.Uint
+	if fees != nil {
+		takerFee = fees.TotalFeesAmountPerParty()[taker]
+	}
+
+	// make sure both parties can cover the trade before moving any funds, so neither is left with margin
+	// moved for a trade that doesn't happen. The synthetic maker order isn't reported, only the quote is rejected.
+	if err := m.canCoverBlockTrade(takerOrder, trades); err != nil {
+		if m.log.GetLevel() <= logging.DebugLevel {
+			m.log.Debug("taker cannot cover the block trade", logging.Order(*takerOrder), logging.Error(err))
+		}
+		takerOrder.Status = types.OrderStatusRejected
+		takerOrder.Reason = types.OrderErrorMarginCheckFailed
+		m.broker.Send(events.NewOrderEvent(ctx, takerOrder))
+		return nil, common.ErrMarginCheckFailed
+	}
+	if err := m.canCoverBlockTrade(makerOrder, trades); err != nil {
+		if m.log.GetLevel() <= logging.DebugLevel {
+			m.log.Debug("maker cannot cover the block trade", logging.Order(*makerOrder), logging.Error(err))
+		}
+		return nil, common.ErrBlockTradeMakerMarginCheckFailed
+	}
+
+	takerPos := m.position.RegisterOrder(ctx, takerOrder)
+	makerPos := m.position.RegisterOrder(ctx, makerOrder)
+
+	// once the trade is done, or if moving the funds fails after all, bring the margin of both parties
+	// back in line with their positions.
+	defer m.recheckBlockTradeMargin(ctx, taker, maker)
+
+	if err := m.checkBlockTradeMargin(ctx, takerPos, takerOrder, trades, takerFee); err != nil {
+		m.log.Error("failed to transfer the taker margin for the block trade", logging.Order(*takerOrder), logging.Error(err))
+		_ = m.position.UnregisterOrder(ctx, makerOrder)
+		_ = m.unregisterAndReject(ctx, takerOrder, types.OrderErrorMarginCheckFailed)
+		return nil, common.ErrMarginCheckFailed
+	}
+	if err := m.checkBlockTradeMargin(ctx, makerPos, makerOrder, trades, nil); err != nil {
+		m.log.Error("failed to transfer the maker margin for the block trade", logging.Order(*makerOrder), logging.Error(err))
+		_ = m.position.UnregisterOrder(ctx, makerOrder)
+		_ = m.unregisterAndReject(ctx, takerOrder, types.OrderErrorMarginCheckFailed)
+		return nil, common.ErrBlockTradeMakerMarginCheckFailed
+	}
+
+	if fees != nil {
+		if err := m.applyFees(ctx, takerOrder, fees); err != nil {
+			m.log.Panic("failed to apply fees on block trade", logging.Order(takerOrder), logging.Error(err))
+		}
+	}
+
+	for _, order := range []*types.Order{takerOrder, makerOrder} {
+		order.Remaining = 0
+		order.Status = types.OrderStatusFilled
+		order.UpdatedAt = now
+	}
+	m.broker.Send(events.NewOrderEvent(ctx, takerOrder))
+
+	tradeType := types.TradeTypeBlock
+	m.handleConfirmation(ctx, &types.OrderConfirmation{
+		Order:                 takerOrder,
+		Trades:                trades,
+		PassiveOrdersAffected: []*types.Order{makerOrder},
+	}, &tradeType)
+
+	return trade, nil
+}
+
+// canCoverBlockTrade checks the general account of the party covers the margin for its position once the block
+// trade is executed, and the fees if any, without moving any funds.
+func (m *Market) canCoverBlockTrade(order *types.Order, trades []*types.Trade) error {
+	required, err := m.collateralForTrades(order.Party, order, trades)
+	if err != nil {
+		return err
+	}
+	general, err := m.collateral.GetPartyGeneralAccount(order.Party, m.settlementAsset)
+	if err != nil {
+		return err
+	}
+	if general.Balance.LT(required) {
+		return common.ErrMarginCheckFailed
+	}
+	return nil
+}
+
+// recheckBlockTradeMargin recalculates the margin of the parties to a block trade, releasing the excess
+// of a position the trade reduced rather than waiting for the next mark to market.
+func (m *Market) recheckBlockTradeMargin(ctx context.Context, parties ...string) {
+	pos := make([]events.MarketPosition, 0, len(parties))
+	for _, party := range parties {
+		p, ok := m.position.GetPositionByPartyID(party)
+		if !ok || (p.Size() == 0 && p.Buy() == 0 && p.Sell() == 0) {
+			m.releaseMarginExcess(ctx, party)
+			continue
+		}
+		pos = append(pos, p)
+	}
+	m.recheckMargin(ctx, pos)
+}
+
+// checkBlockTradeMargin makes sure the party can cover the margin for its position once the block trade
+// is executed, and the fees if any, transferring the required margin.
+func (m *Market) checkBlockTradeMargin(ctx context.Context, pos *positions.MarketPosition, order *types.Order, trades []*types.Trade, fee *num.Uint) error {
+	posWithTrades := pos.UpdateInPlaceOnTrades(m.log, order.Side, trades, order)
+	if m.getMarginMode(order.Party) == types.MarginModeIsolatedMargin {
+		return m.updateIsolatedMarginOnAggressor(ctx, posWithTrades, order, trades, false, fee)
+	}
+
+	if fee != nil {
+		if err := m.collateral.PartyCanCoverFees(m.settlementAsset, m.mkt.ID, order.Party, fee); err != nil {
+			return err
+		}
+	}
+	if pos.OrderReducesExposure(order) {
+		return nil
+	}
+	return m.checkMarginForOrder(ctx, posWithTrades, order)
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package future_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/execution/common"
+	"code.vegaprotocol.io/vega/core/types"
+	vegacontext "code.vegaprotocol.io/vega/libs/context"
+	vgcrypto "code.vegaprotocol.io/vega/libs/crypto"
+	"code.vegaprotocol.io/vega/libs/num"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitBlockTrade(t *testing.T) {
+	taker := "taker"
+	maker := "maker"
+	auxParty := "auxParty"
+	auxParty2 := "auxParty2"
+	now := time.Unix(10, 0)
+	tm := getTestMarket2(t, now, nil, &types.AuctionDuration{
+		Duration: 1,
+	}, true, 1.05)
+	ctx := vegacontext.WithTraceID(context.Background(), vgcrypto.RandomHash())
+
+	addAccount(t, tm, taker)
+	addAccount(t, tm, maker)
+	addAccount(t, tm, auxParty)
+	addAccount(t, tm, auxParty2)
+	addAccountWithAmount(tm, "lpprov", 10000000)
+	addAccountWithAmount(tm, "poor", 10)
+
+	tm.market.OnMarketAuctionMinimumDurationUpdate(ctx, time.Second)
+
+	auxOrders := []*types.Order{
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "alwaysOnBid", types.SideBuy, auxParty, 1, 1),
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "alwaysOnAsk", types.SideSell, auxParty, 1, 10000),
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "aux1", types.SideSell, auxParty, 1, 55),
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "aux2", types.SideBuy, auxParty2, 1, 55),
+	}
+	for _, o := range auxOrders {
+		conf, err := tm.market.SubmitOrder(ctx, o)
+		require.NoError(t, err)
+		require.NotNil(t, conf)
+	}
+	lp := &types.LiquidityProvisionSubmission{
+		MarketID:         tm.market.GetID(),
+		CommitmentAmount: num.NewUint(25000),
+		Fee:              num.DecimalFromFloat(0.01),
+	}
+	require.NoError(t, tm.market.SubmitLiquidityProvision(ctx, lp, "lpprov", vgcrypto.RandomHash()))
+	// leave opening auction
+	now = now.Add(2 * time.Second)
+	tm.now = now
+	tm.market.OnTick(ctx, now)
+	require.Equal(t, types.MarketStateActive, tm.market.State())
+
+	balances := func(party string) (general, margin *num.Uint) {
+		gen, err := tm.collateralEngine.GetPartyGeneralAccount(party, tm.asset)
+		require.NoError(t, err)
+		mgn, err := tm.collateralEngine.GetPartyMarginAccount(tm.market.GetID(), party, tm.asset)
+		if err != nil {
+			return gen.Balance, num.UintZero()
+		}
+		return gen.Balance, mgn.Balance
+	}
+
+	// the maker cannot cover the trade: no funds are moved for either party,
+	// and no order is reported for the maker.
+	tm.events = nil
+	takerGeneral, _ := balances(taker)
+	_, err := tm.market.SubmitBlockTrade(ctx, taker, "poor", types.SideBuy, 10, num.NewUint(55), "ref", newTestIDGenerator())
+	require.ErrorIs(t, err, common.ErrBlockTradeMakerMarginCheckFailed)
+	general, margin := balances(taker)
+	assert.Equal(t, takerGeneral.String(), general.String())
+	assert.True(t, margin.IsZero())
+	for _, e := range tm.events {
+		if evt, ok := e.(*events.Order); ok {
+			assert.NotEqual(t, "poor", evt.Order().PartyId)
+		}
+	}
+
+	trade, err := tm.market.SubmitBlockTrade(ctx, taker, maker, types.SideBuy, 3, num.NewUint(55), "ref", newTestIDGenerator())
+	require.NoError(t, err)
+	assert.Equal(t, types.TradeTypeBlock, trade.Type)
+	_, takerMargin := balances(taker)
+	require.False(t, takerMargin.IsZero())
+
+	// reducing the position releases the excess margin straight away.
+	_, err = tm.market.SubmitBlockTrade(ctx, taker, maker, types.SideSell, 2, num.NewUint(55), "ref", newTestIDGenerator())
+	require.NoError(t, err)
+	_, margin = balances(taker)
+	assert.True(t, margin.LT(takerMargin))
+	assert.False(t, margin.IsZero())
+
+	// both parties are subject to the position limits of the market, the taker being long 1 and auxParty2 long 1.
+	tm.mktCfg.PositionLimits = &types.PositionLimits{MaxPositionSize: 3}
+	_, err = tm.market.SubmitBlockTrade(ctx, taker, maker, types.SideBuy, 3, num.NewUint(55), "ref", newTestIDGenerator())
+	require.ErrorIs(t, err, types.ErrPositionSizeLimitExceeded)
+	_, err = tm.market.SubmitBlockTrade(ctx, taker, auxParty2, types.SideSell, 3, num.NewUint(55), "ref", newTestIDGenerator())
+	require.ErrorIs(t, err, common.ErrBlockTradeMakerPositionLimitExceeded)
+	_, err = tm.market.SubmitBlockTrade(ctx, taker, auxParty2, types.SideSell, 2, num.NewUint(55), "ref", newTestIDGenerator())
+	require.NoError(t, err)
+}
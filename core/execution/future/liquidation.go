@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/execution/liquidation"
 	"code.vegaprotocol.io/vega/core/types"
 	"code.vegaprotocol.io/vega/libs/num"
 	"code.vegaprotocol.io/vega/logging"
@@ -29,9 +30,12 @@ func (m *Market) checkNetwork(ctx context.Context, now time.Time) error {
 	if m.as.InAuction() {
 		return nil
 	}
-	// this only returns an error if we couldn't get the price range, incidating no orders on book
-	order, _ := m.liquidation.OnTick(ctx, now, m.midPrice())
-	if order == nil {
+	// whether or not the network gets to dispose of some of its position, what it still holds may have to be
+	// absorbed by the opposite positions, e.g. when the book only allows it to dispose of a fraction at a time.
+	defer m.checkAutoDeleveraging(ctx)
+	// this only returns an error if the network position is due to be reduced, but there is no volume on the book to do so
+	order, err := m.liquidation.OnTick(ctx, now, m.midPrice())
+	if err != nil || order == nil {
 		return nil
 	}
 	// register the network order on the positions engine
@@ -94,3 +98,85 @@ func (m *Market) checkNetwork(ctx context.Context, now time.Time) error {
 	m.checkForReferenceMoves(ctx, conf.PassiveOrdersAffected, false)
 	return nil
 }
+
+// rankAutoDeleveraging ranks the profitable positions on both sides of the market for auto-deleveraging.
+func (m *Market) rankAutoDeleveraging(positions []events.MarketPosition) (long, short []*liquidation.AutoDeleveragingRank) {
+	margins := make([]events.Margin, 0, len(positions))
+	for _, pos := range positions {
+		if pos.Party() == types.NetworkParty || pos.Size() == 0 {
+			continue
+		}
+		margin, err := m.collateral.GetPartyMargin(pos, m.settlementAsset, m.GetID())
+		if err != nil {
+			m.log.Error("couldn't get party margin",
+				logging.PartyID(pos.Party()),
+				logging.Error(err))
+			continue
+		}
+		margins = append(margins, margin)
+	}
+	return m.liquidation.RankAutoDeleveraging(margins, m.getCurrentMarkPrice(), m.positionFactor)
+}
+
+// updateAutoDeleveragingRanking sends out the ranking of the positions of markets with auto-deleveraging enabled once they are marked to market,
+// the ranking is only computed again if the mark price or the positions changed.
+func (m *Market) updateAutoDeleveragingRanking(ctx context.Context) {
+	if !m.liquidation.AutoDeleveraging() {
+		return
+	}
+	positions := m.position.Positions()
+	if !m.liquidation.AutoDeleveragingRankingStale(positions, m.getCurrentMarkPrice()) {
+		return
+	}
+	long, short := m.rankAutoDeleveraging(positions)
+	m.liquidation.SendAutoDeleveragingRankings(ctx, long, short)
+}
+
+// checkAutoDeleveraging reduces the highest ranked positions opposite the network's position in markets with auto-deleveraging enabled,
+// while the network holds a position and the insurance pool cannot cover the losses of the position at the mark price.
+// The positions are reduced at the bankruptcy price of the network position.
+func (m *Market) checkAutoDeleveraging(ctx context.Context) {
+	if !m.liquidation.AutoDeleveraging() {
+		return
+	}
+	netSize := m.liquidation.GetNetworkPosition().Size()
+	if netSize == 0 {
+		return
+	}
+	balance, _ := m.collateral.GetInsurancePoolBalance(m.GetID(), m.settlementAsset)
+	if balance == nil {
+		balance = num.UintZero()
+	}
+	bp := m.liquidation.BankruptcyPrice(m.settlement.LastMarkPrice(), balance, m.positionFactor)
+	if bp == nil {
+		return
+	}
+	// the insurance pool still covers the losses of the network position at the mark price
+	mp := m.getCurrentMarkPrice()
+	if (netSize > 0 && mp.GT(bp)) || (netSize < 0 && mp.LT(bp)) {
+		return
+	}
+	long, short := m.rankAutoDeleveraging(m.position.Positions())
+	ranking := short
+	if netSize < 0 {
+		ranking = long
+	}
+	if len(ranking) == 0 {
+		return
+	}
+	// the positions are updated by the trades, keep track of the sizes and entry prices to record the realised position
+	preTradeSizes := make(map[string]int64, len(ranking))
+	entryPrices := make(map[string]*num.Uint, len(ranking))
+	for _, r := range ranking {
+		preTradeSizes[r.Position.Party()] = r.Position.Size()
+		entryPrices[r.Position.Party()] = r.Position.AverageEntryPrice()
+	}
+	trades := m.liquidation.AutoDeleverage(ctx, m.idgen, ranking, bp, m.priceToMarketPrecision(bp))
+	if len(trades) == 0 {
+		return
+	}
+	// the trades are settled with the next mark to market, like the close-out trades
+	m.settlement.ReduceDistressed(trades)
+	// release the margin of the reduced positions
+	m.recheckMargin(ctx, m.recordNetworkReductions(trades, preTradeSizes, entryPrices))
+}
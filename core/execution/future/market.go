@@ -139,7 +139,9 @@ type Market struct {
 	positionFactor        num.Decimal // 10^pdp
 	assetDP               uint32
 
-	settlementDataInMarket          *num.Numeric
+	settlementDataInMarket *num.Numeric
+	// settlingOutcome is set while the deferred final settlement of the winning outcome of a prediction is carried out
+	settlingOutcome                 bool
 	nextMTM                         time.Time
 	nextInternalCompositePriceCalc  time.Time
 	mtmDelta                        time.Duration
@@ -166,12 +168,18 @@ type Market struct {
 	epoch              types.Epoch
 
 	// party ID to isolated margin factor
-	partyMarginFactor                map[string]num.Decimal
+	partyMarginFactor map[string]num.Decimal
+	// cross margin parties which opted in to portfolio margin
+	partyPortfolioMargin             map[string]struct{}
+	portfolio                        *risk.Portfolio
 	markPriceCalculator              *common.CompositePriceCalculator
 	internalCompositePriceCalculator *common.CompositePriceCalculator
 
 	amm *amm.Engine
 
+	// parties' protection against their orders being filled too fast
+	mmProtections *common.MarketMakerProtections
+
 	fCap   *types.FutureCap
 	capMax *num.Uint
 }
@@ -331,6 +339,7 @@ func NewMarket(
 		tsCalc:                        tsCalc,
 		peggedOrders:                  common.NewPeggedOrders(log, timeService),
 		expiringOrders:                common.NewExpiringOrders(),
+		mmProtections:                 common.NewMarketMakerProtections(),
 		feeSplitter:                   common.NewFeeSplitter(),
 		equityShares:                  equityShares,
 		lastBestAskPrice:              num.UintZero(),
@@ -351,6 +360,7 @@ func NewMarket(
 		volumeDiscountService:         volumeDiscountService,
 		volumeRebateService:           volumeRebateService,
 		partyMarginFactor:             map[string]num.Decimal{},
+		partyPortfolioMargin:          map[string]struct{}{},
 		banking:                       banking,
 		markPriceCalculator:           common.NewCompositePriceCalculator(ctx, mkt.MarkPriceConfiguration, oracleEngine, timeService),
 		amm:                           ammEngine,
@@ -360,10 +370,16 @@ func NewMarket(
 	market.liquidation = le
 
 	market.markPriceCalculator.SetOraclePriceScalingFunc(market.scaleOracleData)
+	market.pMonitor.SetIndexPriceProvider(market.indexPrice)
+	book.SetMarkPriceSource(market.getCurrentMarkPrice)
+	book.SetMatchingAlgorithm(mkt.MatchingAlgorithm)
 	if fCap := mkt.TradableInstrument.Instrument.Product.Cap(); fCap != nil {
 		market.fCap = fCap
 		market.capMax, _ = num.UintFromDecimal(fCap.MaxPrice.ToDecimal().Mul(priceFactor))
 		market.markPriceCalculator.SetMaxPriceCap(market.capMax.Clone())
+		if future, ok := market.tradableInstrument.Instrument.Product.(*products.Future); ok && future.IsPredictionOutcome() {
+			future.SetPredictionPayout(market.capMax.Clone())
+		}
 	}
 
 	if market.IsPerp() {
@@ -383,7 +399,7 @@ func NewMarket(
 	liquidityEngine.SetGetStaticPricesFunc(market.getBestStaticPricesDecimal)
 
 	switch marketType {
-	case types.MarketTypeFuture:
+	case types.MarketTypeFuture, types.MarketTypeOption:
 		market.tradableInstrument.Instrument.Product.NotifyOnTradingTerminated(market.tradingTerminated)
 		market.tradableInstrument.Instrument.Product.NotifyOnSettlementData(market.settlementData)
 	case types.MarketTypePerp:
@@ -392,10 +408,86 @@ func NewMarket(
 	default:
 		log.Panic("unexpected market type", logging.Int("type", int(marketType)))
 	}
+	market.setOptionRisk()
 	market.assetDP = uint32(assetDecimals)
 	return market, nil
 }
 
+// setOptionRisk makes the risk engine margin an option market on the option delta,
+// using the volatility of the risk model if the market has one.
+func (m *Market) setOptionRisk() {
+	option, ok := m.tradableInstrument.Instrument.Product.(*products.Option)
+	if !ok {
+		return
+	}
+	volatility := num.DecimalZero()
+	switch rm := m.mkt.TradableInstrument.RiskModel.(type) {
+	case *types.TradableInstrumentLogNormalRiskModel:
+		if rm.LogNormalRiskModel != nil && rm.LogNormalRiskModel.Params != nil {
+			volatility = rm.LogNormalRiskModel.Params.Sigma
+		}
+	case *types.TradableInstrumentHistoricalSimulationRiskModel:
+		if rm.HistoricalSimulationRiskModel != nil {
+			volatility = rm.HistoricalSimulationRiskModel.FallbackSigma
+		}
+	}
+	m.risk.SetOptionProduct(option, volatility)
+}
+
+// optionProduct returns the product of the market if it's an option, nil otherwise.
+func (m *Market) optionProduct() *products.Option {
+	option, _ := m.tradableInstrument.Instrument.Product.(*products.Option)
+	return option
+}
+
+// expireOption terminates the trading of an option market once it reaches its expiry, and settles it
+// with the latest settlement data received before expiry, if any.
+// NB this must be called with the lock already acquired.
+func (m *Market) expireOption(ctx context.Context, t time.Time) {
+	option := m.optionProduct()
+	if option == nil || t.Before(option.Expiry()) {
+		return
+	}
+	if m.canTrade() {
+		targetState := types.MarketStateSettled
+		if m.mkt.State == types.MarketStatePending {
+			targetState = types.MarketStateCancelled
+		}
+		m.terminateMarket(ctx, targetState, nil)
+		return
+	}
+	// trading was terminated before expiry, the settlement data kept until now can be used
+	if m.mkt.State == types.MarketStateTradingTerminated && m.settlementDataInMarket != nil {
+		m.settleWithSettlementDataInMarket(ctx, types.MarketStateSettled)
+	}
+}
+
+// beforeOptionExpiry returns true if the market is an option which hasn't reached its expiry yet.
+func (m *Market) beforeOptionExpiry() bool {
+	option := m.optionProduct()
+	return option != nil && m.timeService.GetTimeNow().Before(option.Expiry())
+}
+
+// finalMarkPrice returns the final mark price of the market given the settlement data,
+// an option is marked at its payoff rather than at the price of the underlying.
+func (m *Market) finalMarkPrice(settlementDataInAsset *num.Uint) *num.Uint {
+	if option := m.optionProduct(); option != nil {
+		return option.Payoff(settlementDataInAsset)
+	}
+	return settlementDataInAsset.Clone()
+}
+
+// recordRiskModelPrice feeds the current mark price to risk models built from the price history.
+func (m *Market) recordRiskModelPrice() {
+	hm, ok := m.tradableInstrument.RiskModel.(risk.PriceHistoryModel)
+	if !ok {
+		return
+	}
+	if mp := m.markPriceCalculator.GetPrice(); mp != nil && !mp.IsZero() {
+		hm.AddPrice(m.timeService.GetTimeNow(), mp)
+	}
+}
+
 func (m *Market) OnEpochEvent(ctx context.Context, epoch types.Epoch) {
 	if m.closed {
 		return
@@ -647,6 +739,7 @@ func (m *Market) Update(ctx context.Context, config *types.Market, oracleEngine
 		return err
 	}
 	m.risk.UpdateModel(m.stateVarEngine, m.tradableInstrument.MarginCalculator, m.tradableInstrument.RiskModel, m.mkt.LinearSlippageFactor, m.mkt.QuadraticSlippageFactor)
+	m.setOptionRisk()
 	m.settlement.UpdateProduct(m.tradableInstrument.Instrument.Product)
 	m.tsCalc.UpdateParameters(*m.mkt.LiquidityMonitoringParameters.TargetStakeParameters)
 	m.pMonitor.UpdateSettings(m.tradableInstrument.RiskModel, m.mkt.PriceMonitoringSettings, m.as)
@@ -708,6 +801,8 @@ func (m *Market) Update(ctx context.Context, config *types.Market, oracleEngine
 		}
 	}
 	m.updateLiquidityFee(ctx)
+	m.updateFrequentBatchAuction(ctx)
+	m.matching.SetMatchingAlgorithm(m.mkt.MatchingAlgorithm)
 	// risk model hasn't changed -> return
 	if !recalcMargins {
 		return nil
@@ -969,6 +1064,18 @@ func (m *Market) RollbackInherit(ctx context.Context) {
 	}
 }
 
+// updateFrequentBatchAuction applies a change to the frequent batch auction configuration. A market switched to frequent
+// batch auctions goes into its first batch straight away, a market switched back to continuous trading does so once its
+// current batch ends.
+func (m *Market) updateFrequentBatchAuction(ctx context.Context) {
+	if m.mkt.FrequentBatchAuction == nil {
+		m.as.UpdateDefaultMode(types.MarketTradingModeContinuous)
+		return
+	}
+	m.as.UpdateDefaultMode(types.MarketTradingModeBatchAuction)
+	m.checkBatchAuction(ctx, m.timeService.GetTimeNow())
+}
+
 func (m *Market) StartOpeningAuction(ctx context.Context) error {
 	if m.mkt.State != types.MarketStateProposed {
 		return common.ErrCannotStartOpeningAuctionForMarketNotInProposedState
@@ -1045,6 +1152,14 @@ func (m *Market) OnTick(ctx context.Context, t time.Time) bool {
 		return true
 	}
 
+	if m.settleWinningOutcome(ctx) {
+		return true
+	}
+
+	if m.expireOption(ctx, t); m.closed {
+		return true
+	}
+
 	// first we check if we should reduce the network position, then we expire orders
 	if !m.closed && m.canTrade() {
 		m.checkNetwork(ctx, t)
@@ -1089,6 +1204,8 @@ func (m *Market) OnTick(ctx context.Context, t time.Time) bool {
 
 	// check auction, if any. If we leave auction, MTM is performed in this call
 	m.checkAuction(ctx, t, m.idgen)
+	m.mmProtections.OnTick(t)
+	m.applyMarketMakerProtections(ctx)
 	// check the position of the network, may place orders to close the network out
 	timer.EngineTimeCounterAdd()
 
@@ -1121,7 +1238,7 @@ func (m *Market) BlockEnd(ctx context.Context) {
 		m.idgen = nil
 	}()
 
-	if !m.as.InAuction() {
+	if !m.inNonBatchAuction() {
 		m.markPriceCalculator.CalculateBookMarkPriceAtTimeT(m.tradableInstrument.MarginCalculator.ScalingFactors.InitialMargin, m.mkt.LinearSlippageFactor, m.risk.GetRiskFactors().Short, m.risk.GetRiskFactors().Long, t.UnixNano(), m.matching)
 		if m.internalCompositePriceCalculator != nil {
 			m.internalCompositePriceCalculator.CalculateBookMarkPriceAtTimeT(m.tradableInstrument.MarginCalculator.ScalingFactors.InitialMargin, m.mkt.LinearSlippageFactor, m.risk.GetRiskFactors().Short, m.risk.GetRiskFactors().Long, t.UnixNano(), m.matching)
@@ -1131,7 +1248,7 @@ func (m *Market) BlockEnd(ctx context.Context) {
 	// if we do have a separate configuration for the intenal composite price and we have a new intenal composite price we push it to the perp
 	if m.internalCompositePriceCalculator != nil && (m.nextInternalCompositePriceCalc.IsZero() ||
 		!m.nextInternalCompositePriceCalc.After(t) &&
-			!m.as.InAuction()) {
+			!m.inNonBatchAuction()) {
 		prevInternalCompositePrice := m.internalCompositePriceCalculator.GetPrice()
 		m.internalCompositePriceCalculator.CalculateMarkPrice(
 			ctx,
@@ -1148,8 +1265,8 @@ func (m *Market) BlockEnd(ctx context.Context) {
 		}
 	}
 
-	// if it's time for mtm, let's do it
-	if (m.nextMTM.IsZero() || !m.nextMTM.After(t)) && !m.as.InAuction() {
+	// if it's time for mtm, let's do it, the batches of a market trading in frequent batch auctions are normal trading
+	if (m.nextMTM.IsZero() || !m.nextMTM.After(t)) && !m.inNonBatchAuction() {
 		prevMarkPrice := m.markPriceCalculator.GetPrice()
 		m.markPriceLock.Lock()
 		_, err := m.markPriceCalculator.CalculateMarkPrice(
@@ -1172,6 +1289,7 @@ func (m *Market) BlockEnd(ctx context.Context) {
 				m.enterAuction(ctx)
 			}
 		} else {
+			m.recordRiskModelPrice()
 			// if we don't have an alternative configuration (and schedule) for the mark price the we push the mark price to the perp as a new datapoint
 			// on the standard mark price
 			if m.internalCompositePriceCalculator == nil && m.perp &&
@@ -1183,7 +1301,7 @@ func (m *Market) BlockEnd(ctx context.Context) {
 		m.nextMTM = t.Add(m.mtmDelta)
 
 		// mark price mustn't be zero, except for capped futures, where a zero price may well be possible
-		if !m.as.InAuction() && (prevMarkPrice == nil || !m.markPriceCalculator.GetPrice().EQ(prevMarkPrice) || m.settlement.HasTraded()) &&
+		if !m.inNonBatchAuction() && (prevMarkPrice == nil || !m.markPriceCalculator.GetPrice().EQ(prevMarkPrice) || m.settlement.HasTraded()) &&
 			(!m.getCurrentMarkPrice().IsZero() || m.capMax != nil) {
 			if m.confirmMTM(ctx, false) {
 				closedPositions := m.position.GetClosedPositions()
@@ -1200,6 +1318,14 @@ func (m *Market) BlockEnd(ctx context.Context) {
 		}
 	}
 
+	// the mark and index prices may have moved without any trade
+	// happening, check for stop orders triggered by them, and reprice
+	// the pegged orders referencing them.
+	if !m.inNonBatchAuction() {
+		m.triggerStopOrders(ctx, m.idgen)
+		m.checkForMarkAndIndexPriceMoves(ctx)
+	}
+
 	m.releaseExcessMargin(ctx, m.position.Positions()...)
 	// send position events
 	m.position.FlushPositionEvents(ctx)
@@ -1419,7 +1545,7 @@ func (m *Market) unregisterAndReject(ctx context.Context, order *types.Order, er
 }
 
 func (m *Market) getNewPeggedPrice(order *types.Order) (*num.Uint, error) {
-	if m.as.InAuction() {
+	if !m.canPricePeggedOrder(order) {
 		return num.UintZero(), common.ErrCannotRepriceDuringAuction
 	}
 
@@ -1435,6 +1561,14 @@ func (m *Market) getNewPeggedPrice(order *types.Order) (*num.Uint, error) {
 		price, err = m.getBestStaticBidPrice()
 	case types.PeggedReferenceBestAsk:
 		price, err = m.getBestStaticAskPrice()
+	case types.PeggedReferenceMarkPrice:
+		if price = m.getCurrentMarkPrice(); price.IsZero() {
+			err = common.ErrUnableToReprice
+		}
+	case types.PeggedReferenceIndexPrice:
+		if price = m.indexPrice(); price == nil {
+			err = common.ErrUnableToReprice
+		}
 	}
 	if err != nil {
 		return num.UintZero(), common.ErrUnableToReprice
@@ -1499,6 +1633,9 @@ func (m *Market) parkAllPeggedOrders(ctx context.Context) []*types.Order {
 
 	parked := make([]*types.Order, 0, len(toParkIDs))
 	for _, order := range toParkIDs {
+		if o, err := m.matching.GetOrderByID(order); err == nil && m.canPricePeggedOrder(o) {
+			continue
+		}
 		parked = append(parked, m.parkOrder(ctx, order))
 	}
 	return parked
@@ -1590,6 +1727,9 @@ func (m *Market) UpdateMarketState(ctx context.Context, changes *types.MarketSta
 			if m.as.GetState().Trigger == types.AuctionTriggerOpening {
 				m.mkt.State = types.MarketStatePending
 				m.mkt.TradingMode = types.MarketTradingModeOpeningAuction
+			} else if m.as.IsFBA() {
+				m.mkt.State = types.MarketStateActive
+				m.mkt.TradingMode = types.MarketTradingModeBatchAuction
 			} else {
 				m.mkt.State = types.MarketStateSuspended
 				m.mkt.TradingMode = types.MarketTradingModeMonitoringAuction
@@ -1634,6 +1774,48 @@ func (m *Market) enterAuction(ctx context.Context) {
 	}
 }
 
+// startBatchAuction starts the next batch of a market trading in frequent batch auctions.
+func (m *Market) startBatchAuction(ctx context.Context, now time.Time) {
+	m.as.StartBatchAuction(now, m.mkt.FrequentBatchAuction.BatchDuration)
+	m.enterAuction(ctx)
+	if m.mkt.TradingMode != types.MarketTradingModeBatchAuction {
+		m.mkt.TradingMode = types.MarketTradingModeBatchAuction
+		m.broker.Send(events.NewMarketUpdatedEvent(ctx, *m.mkt))
+	}
+}
+
+// checkBatchAuction is called once an active market is out of auction, markets trading in frequent batch auctions
+// go straight into the next batch, markets which no longer do return to continuous trading.
+func (m *Market) checkBatchAuction(ctx context.Context, now time.Time) {
+	if m.as.InAuction() || m.mkt.State != types.MarketStateActive {
+		return
+	}
+	if m.mkt.FrequentBatchAuction != nil {
+		m.startBatchAuction(ctx, now)
+		return
+	}
+	if m.mkt.TradingMode == types.MarketTradingModeBatchAuction {
+		m.mkt.TradingMode = types.MarketTradingModeContinuous
+		m.broker.Send(events.NewMarketUpdatedEvent(ctx, *m.mkt))
+	}
+}
+
+// inNonBatchAuction returns true if the market is in an auction other than a batch of a market trading in frequent batch
+// auctions, the batches are the normal trading of such a market.
+func (m *Market) inNonBatchAuction() bool {
+	return m.as.InAuction() && !m.as.IsFBA()
+}
+
+// canPricePeggedOrder returns true if the pegged order can be priced. Pegged orders are parked during auctions, except for
+// the ones referencing the mark or index price in a market trading in frequent batch auctions, as they don't depend on the book.
+func (m *Market) canPricePeggedOrder(order *types.Order) bool {
+	if !m.as.InAuction() {
+		return true
+	}
+	ref := order.PeggedOrder.Reference
+	return m.as.IsFBA() && (ref == types.PeggedReferenceMarkPrice || ref == types.PeggedReferenceIndexPrice)
+}
+
 func (m *Market) uncrossOnLeaveAuction(ctx context.Context) ([]*types.OrderConfirmation, []*types.Order) {
 	uncrossedOrders, ordersToCancel, err := m.matching.LeaveAuction(m.timeService.GetTimeNow())
 	if err != nil {
@@ -1714,6 +1896,8 @@ func (m *Market) OnAuctionEnded() {
 
 // leaveAuction : Return the orderbook and market to continuous trading.
 func (m *Market) leaveAuction(ctx context.Context, now time.Time) {
+	// deferred first so it runs once the market state has been updated below
+	defer m.checkBatchAuction(ctx, now)
 	defer func() {
 		if !m.as.InAuction() && (m.mkt.State == types.MarketStateSuspended || m.mkt.State == types.MarketStatePending || m.mkt.State == types.MarketStateSuspendedViaGovernance) {
 			if m.mkt.State == types.MarketStatePending {
@@ -1723,8 +1907,10 @@ func (m *Market) leaveAuction(ctx context.Context, now time.Time) {
 			}
 
 			m.mkt.State = types.MarketStateActive
-			// this probably should get the default trading mode from the market definition.
 			m.mkt.TradingMode = types.MarketTradingModeContinuous
+			if m.mkt.FrequentBatchAuction != nil {
+				m.mkt.TradingMode = types.MarketTradingModeBatchAuction
+			}
 			m.broker.Send(events.NewMarketUpdatedEvent(ctx, *m.mkt))
 
 			m.updateLiquidityFee(ctx)
@@ -1753,12 +1939,16 @@ func (m *Market) leaveAuction(ctx context.Context, now time.Time) {
 	}
 
 	wasOpeningAuction := m.IsOpeningAuction()
+	wasBatchAuction := m.as.IsFBA()
 
 	// update auction state, so we know what the new tradeMode ought to be
 	endEvt := m.as.Left(ctx, now)
 	// we tell the perp that we've left auction, we might re-enter just a bit down but thats fine as
-	// we will at least keep the in/out orders in sync
-	m.tradableInstrument.Instrument.UpdateAuctionState(ctx, false)
+	// we will at least keep the in/out orders in sync. Batch auctions count as trading time so the perp
+	// is never told about them.
+	if !wasBatchAuction {
+		m.tradableInstrument.Instrument.UpdateAuctionState(ctx, false)
+	}
 
 	for _, uncrossedOrder := range uncrossedOrders {
 		updatedOrders = append(updatedOrders, uncrossedOrder.Order)
@@ -1790,6 +1980,8 @@ func (m *Market) leaveAuction(ctx context.Context, now time.Time) {
 		if m.as.AuctionStart() {
 			m.enterAuction(ctx)
 		}
+	} else {
+		m.recordRiskModelPrice()
 	}
 	m.markPriceLock.Unlock()
 	if wasOpeningAuction && !m.as.IsOpeningAuction() && m.getCurrentMarkPrice().IsZero() {
@@ -1901,6 +2093,13 @@ func (m *Market) validateOrder(ctx context.Context, order *types.Order) (err err
 		return types.ErrInvalidMarketID
 	}
 
+	// a party frozen by its market maker protection can still hedge,
+	// but not quote.
+	if order.IsPersistent() && m.mmProtections.IsFrozen(m.timeService.GetTimeNow(), order.Party) {
+		order.Reason = types.OrderErrorMarketMakerProtectionFrozen
+		return common.ErrMarketMakerProtectionFrozen
+	}
+
 	// Validate pegged orders
 	if order.PeggedOrder != nil {
 		if m.getMarginMode(order.Party) != types.MarginModeCrossMargin {
@@ -1922,6 +2121,10 @@ func (m *Market) validateOrder(ctx context.Context, order *types.Order) (err err
 				return fmt.Errorf("invalid offset - pegged mid will cross")
 			}
 		}
+		if order.PeggedOrder.Reference == types.PeggedReferenceIndexPrice && !m.hasIndexPrice() {
+			order.Reason = types.OrderErrorPeggedReferenceNotSupported
+			return types.ErrPeggedReferenceNotSupported
+		}
 		return m.validateTickSize(order.PeggedOrder.Offset)
 	}
 
@@ -2145,6 +2348,13 @@ func (m *Market) SubmitStopOrdersWithIDGeneratorAndOrderIDs(
 		}
 	}
 
+	for _, so := range []*types.StopOrder{fallsBelow, risesAbove} {
+		if so != nil && !m.hasIndexPrice() && so.Trigger.PriceReference() == types.StopOrderTriggerReferenceIndexPrice {
+			rejectStopOrders(types.StopOrderRejectionTriggerReferenceUnsupported, fallsBelow, risesAbove)
+			return nil, common.ErrStopOrderTriggerReferenceNotSupported
+		}
+	}
+
 	// now check if that party hasn't exceeded the max amount per market
 	if m.stopOrders.CountForParty(party)+uint64(orderCnt) > m.maxStopOrdersPerParties.Uint64() {
 		rejectStopOrders(types.StopOrderRejectionMaxStopOrdersPerPartyReached, fallsBelow, risesAbove)
@@ -2187,7 +2397,7 @@ func (m *Market) SubmitStopOrdersWithIDGeneratorAndOrderIDs(
 	// if we are in an auction
 	// or no order is triggered
 	// let's just submit it straight away
-	if m.as.InAuction() || !triggered {
+	if m.inNonBatchAuction() || !triggered {
 		m.poolStopOrders(fallsBelow, risesAbove)
 		return nil, nil
 	}
@@ -2245,34 +2455,106 @@ func (m *Market) poolStopOrders(
 func (m *Market) stopOrderWouldTriggerAtSubmission(
 	stopOrder *types.StopOrder,
 ) bool {
-	if m.lastTradedPrice == nil || stopOrder == nil || stopOrder.Trigger.IsTrailingPercentOffset() {
+	if stopOrder == nil || stopOrder.Trigger.IsTrailingPercentOffset() {
 		return false
 	}
 
-	lastTradedPrice := m.priceToMarketPrecision(m.getLastTradedPrice())
+	price := m.stopOrderReferencePrice(stopOrder.Trigger.PriceReference())
+	if price == nil {
+		return false
+	}
+	price = m.priceToMarketPrecision(price)
 
 	switch stopOrder.Trigger.Direction {
 	case types.StopOrderTriggerDirectionFallsBelow:
-		if lastTradedPrice.LTE(stopOrder.Trigger.Price()) {
+		if price.LTE(stopOrder.Trigger.Price()) {
 			return true
 		}
 	case types.StopOrderTriggerDirectionRisesAbove:
-		if lastTradedPrice.GTE(stopOrder.Trigger.Price()) {
+		if price.GTE(stopOrder.Trigger.Price()) {
+			return true
+		}
+	}
+	return false
+}
+
+// stopOrderReferencePrice returns the current value of the reference price a stop order
+// can be triggered by, or nil if the market does not have one.
+func (m *Market) stopOrderReferencePrice(reference types.StopOrderTriggerReference) *num.Uint {
+	switch reference {
+	case types.StopOrderTriggerReferenceMarkPrice:
+		if mp := m.markPriceCalculator.GetPrice(); mp != nil && !mp.IsZero() {
+			return mp.Clone()
+		}
+	case types.StopOrderTriggerReferenceIndexPrice:
+		return m.indexPrice()
+	case types.StopOrderTriggerReferenceMidPrice:
+		// the book is crossed during auctions, no mid price to speak of.
+		if mid := m.midPrice(); !m.as.InAuction() && !mid.IsZero() {
+			return mid
+		}
+	default:
+		if m.lastTradedPrice != nil {
+			return m.lastTradedPrice.Clone()
+		}
+	}
+	return nil
+}
+
+// hasIndexPrice returns true if the market has an index price, either because it has composite price oracles
+// or because it's a perpetual.
+func (m *Market) hasIndexPrice() bool {
+	if m.perp {
+		return true
+	}
+	if cfg := m.markPriceCalculator.GetConfig(); cfg != nil && len(cfg.DataSources) > 0 {
+		return true
+	}
+	if m.internalCompositePriceCalculator != nil {
+		if cfg := m.internalCompositePriceCalculator.GetConfig(); cfg != nil && len(cfg.DataSources) > 0 {
 			return true
 		}
 	}
 	return false
 }
 
+// indexPrice returns the index price of the market: the median of its composite price oracles,
+// falling back to the underlying price of a perpetual. It's never derived from the trades or the book
+// of the market, nil is returned if there is no index price. Stop orders, pegged orders and the index
+// divergence trigger of price monitoring all use this same price.
+func (m *Market) indexPrice() *num.Uint {
+	if p := m.markPriceCalculator.GetOraclePrice(); p != nil && !p.IsZero() {
+		return p
+	}
+	if m.internalCompositePriceCalculator != nil {
+		if p := m.internalCompositePriceCalculator.GetOraclePrice(); p != nil && !p.IsZero() {
+			return p
+		}
+	}
+	if perp, ok := m.tradableInstrument.Instrument.Product.(*products.Perpetual); ok {
+		if p := perp.UnderlyingIndexPrice(); p != nil && !p.IsZero() {
+			return p
+		}
+	}
+	return nil
+}
+
 func (m *Market) triggerStopOrders(
 	ctx context.Context,
 	idgen common.IDGenerator,
 ) []*types.OrderConfirmation {
-	if m.lastTradedPrice == nil {
-		return nil
+	var triggered, cancelled []*types.StopOrder
+	for _, reference := range stoporders.TriggerReferences {
+		// each reference is only checked once it has a price, the mark or index price
+		// can be known before the market has traded
+		price := m.stopOrderReferencePrice(reference)
+		if price == nil {
+			continue
+		}
+		t, c := m.stopOrders.ReferencePriceUpdated(reference, m.priceToMarketPrecision(price))
+		triggered = append(triggered, t...)
+		cancelled = append(cancelled, c...)
 	}
-	lastTradedPrice := m.priceToMarketPrecision(m.getLastTradedPrice())
-	triggered, cancelled := m.stopOrders.PriceUpdated(lastTradedPrice)
 
 	// See if there are any linked orders that are the wrong direction
 	cancelled = append(cancelled, m.stopOrders.CheckDirection(m.position)...)
@@ -2329,6 +2611,7 @@ func (m *Market) SubmitOrderWithIDGeneratorAndOrderID(
 	checkForTriggers bool,
 ) (oc *types.OrderConfirmation, _ error) {
 	defer m.onTxProcessed()
+	defer m.applyMarketMakerProtections(ctx)
 
 	m.idgen = idgen
 	defer func() { m.idgen = nil }()
@@ -2435,7 +2718,7 @@ func (m *Market) submitValidatedOrder(ctx context.Context, order *types.Order) (
 		order.Status = types.OrderStatusParked
 		order.Reason = types.OrderErrorUnspecified
 
-		if m.as.InAuction() {
+		if !m.canPricePeggedOrder(order) {
 			order.SetIcebergPeaks()
 
 			m.peggedOrders.Park(order)
@@ -2471,6 +2754,9 @@ func (m *Market) submitValidatedOrder(ctx context.Context, order *types.Order) (
 		// keep track of the eventual reduce only size
 		order.ReduceOnlyAdjustRemaining(extraSize)
 	}
+	if err := m.checkPositionLimits(pos, order); err != nil {
+		return nil, nil, m.unregisterAndReject(ctx, order, err)
+	}
 	marginMode := m.getMarginMode(order.Party)
 
 	// Perform check and allocate margin unless the order is (partially) closing the party position
@@ -2512,7 +2798,7 @@ func (m *Market) submitValidatedOrder(ctx context.Context, order *types.Order) (
 
 	// if an auction was trigger, and we are a pegged order
 	// or a liquidity order, let's return now.
-	if m.as.InAuction() && isPegged {
+	if isPegged && !m.canPricePeggedOrder(order) {
 		if isPegged {
 			m.peggedOrders.Park(order)
 		}
@@ -2579,6 +2865,7 @@ func (m *Market) submitValidatedOrder(ctx context.Context, order *types.Order) (
 	if err != nil {
 		return nil, nil, m.unregisterAndReject(ctx, order, err)
 	}
+	m.handleSelfTradeReductions(ctx, confirmation)
 
 	// this is no op for non reduce-only orders
 	order.ClearUpExtraRemaining()
@@ -2781,6 +3068,48 @@ func (m *Market) handleConfirmationPassiveOrders(
 	}
 }
 
+// handleSelfTradeReductions removes the volume cancelled or decremented by self-trade prevention
+// from the potential positions, and cleans up and publishes the affected resting orders.
+func (m *Market) handleSelfTradeReductions(ctx context.Context, conf *types.OrderConfirmation) {
+	if len(conf.SelfTradeReductions) == 0 {
+		return
+	}
+
+	evts := make([]events.Event, 0, len(conf.SelfTradeReductions))
+	for _, st := range conf.SelfTradeReductions {
+		// unregister only the volume which was taken away, whatever is left
+		// of the order is handled by the usual flow
+		cpy := st.Order.Clone()
+		cpy.Remaining = st.Volume
+		cpy.IcebergOrder = nil
+		_ = m.position.UnregisterOrder(ctx, cpy)
+
+		// the aggressive order is published by the caller
+		if st.Order == conf.Order {
+			continue
+		}
+
+		order := st.Order
+		if order.Status == types.OrderStatusCancelled {
+			if order.IsExpireable() {
+				m.expiringOrders.RemoveOrder(order.ExpiresAt, order.ID)
+			}
+			if order.PeggedOrder != nil {
+				m.removePeggedOrder(order)
+			}
+			if m.getMarginMode(order.Party) == types.MarginModeIsolatedMargin {
+				pos, _ := m.position.GetPositionByPartyID(order.Party)
+				// this can only release funds from the order margin account
+				_ = m.updateIsolatedMarginOnOrderCancel(ctx, pos, order)
+			}
+		}
+
+		order.UpdatedAt = m.timeService.GetTimeNow().UnixNano()
+		evts = append(evts, events.NewOrderEvent(ctx, order))
+	}
+	m.broker.SendBatch(evts)
+}
+
 func decreasedPosition(p1, p2 events.MarketPosition) int64 {
 	// was long, still long (or 0)
 	if p1.Size() > 0 && p2.Size() >= 0 && p2.Size() < p1.Size() {
@@ -2824,7 +3153,10 @@ func (m *Market) handleConfirmation(ctx context.Context, conf *types.OrderConfir
 	if len(conf.Trades) == 0 {
 		return orderUpdates
 	}
-	m.setLastTradedPrice(conf.Trades[len(conf.Trades)-1])
+	// block trades are negotiated off the book, their price shouldn't move the last traded price
+	if tradeT == nil || *tradeT != types.TradeTypeBlock {
+		m.setLastTradedPrice(conf.Trades[len(conf.Trades)-1])
+	}
 
 	// Insert all trades resulted from the executed order
 	tradeEvts := make([]events.Event, 0, len(conf.Trades))
@@ -2832,6 +3164,7 @@ func (m *Market) handleConfirmation(ctx context.Context, conf *types.OrderConfir
 		conf.TradedValue().ToDecimal().Div(m.positionFactor))
 	for idx, trade := range conf.Trades {
 		trade.SetIDs(m.idgen.NextID(), conf.Order, conf.PassiveOrdersAffected[idx])
+		trade.StrategyID = conf.Order.StrategyID
 		if tradeT != nil {
 			trade.Type = *tradeT
 		} else {
@@ -2842,22 +3175,26 @@ func (m *Market) handleConfirmation(ctx context.Context, conf *types.OrderConfir
 		}
 
 		tradeEvts = append(tradeEvts, events.NewTradeEvent(ctx, *trade))
+		m.recordMarketMakerProtectionFills(trade)
 
 		preTradePositions := m.position.GetPositionsByParty(trade.Buyer, trade.Seller)
 		for i, mp := range m.position.Update(ctx, trade, conf.PassiveOrdersAffected[idx], conf.Order) {
 			m.marketActivityTracker.RecordPosition(m.settlementAsset, mp.Party(), m.mkt.ID, mp.Size(), trade.Price, m.positionFactor, m.timeService.GetTimeNow())
+			if m.portfolio != nil {
+				m.portfolio.RecordPositionChange(m.mkt.ID, mp.Party())
+			}
 			if closedPosition := decreasedPosition(preTradePositions[i], mp); closedPosition > 0 {
-				var reaslisedPosition num.Decimal
+				var realisedPosition num.Decimal
 				if preTradePositions[i].Size() > 0 {
 					// a party **reduces** their **LONG** position
 					// (trade price - average entry price) * position delta$$
-					reaslisedPosition = trade.Price.ToDecimal().Sub(preTradePositions[i].AverageEntryPrice().ToDecimal()).Mul(num.DecimalFromInt64(closedPosition)).Div(m.positionFactor)
+					realisedPosition = trade.Price.ToDecimal().Sub(preTradePositions[i].AverageEntryPrice().ToDecimal()).Mul(num.DecimalFromInt64(closedPosition)).Div(m.positionFactor)
 				} else {
 					// a party **reduces** their **SHORT** position
 					// (average entry price - trade price) * position delta$$
-					reaslisedPosition = preTradePositions[i].AverageEntryPrice().ToDecimal().Sub(trade.Price.ToDecimal()).Mul(num.DecimalFromInt64(closedPosition)).Div(m.positionFactor)
+					realisedPosition = preTradePositions[i].AverageEntryPrice().ToDecimal().Sub(trade.Price.ToDecimal()).Mul(num.DecimalFromInt64(closedPosition)).Div(m.positionFactor)
 				}
-				m.marketActivityTracker.RecordRealisedPosition(m.GetSettlementAsset(), mp.Party(), m.mkt.ID, reaslisedPosition)
+				m.marketActivityTracker.RecordRealisedPosition(m.GetSettlementAsset(), mp.Party(), m.mkt.ID, realisedPosition)
 			}
 		}
 		// if the passive party is in isolated margin we need to update the margin on the position change
@@ -2937,6 +3274,7 @@ func (m *Market) confirmMTM(ctx context.Context, skipMargin bool) bool {
 	// Only process collateral and risk once per order, not for every trade
 	margins, isolatedMarginPartiesToClose := m.collateralAndRisk(ctx, settle)
 	orderUpdates := m.handleRiskEvts(ctx, margins, isolatedMarginPartiesToClose)
+	m.updateAutoDeleveragingRanking(ctx)
 
 	// orders updated -> check reference moves
 	// force check
@@ -3167,6 +3505,10 @@ func (m *Market) resolveClosedOutParties(ctx context.Context, distressedMarginEv
 
 	currentMP := m.getCurrentMarkPrice()
 	mCmp := m.priceToMarketPrecision(currentMP)
+	closed = m.partiallyCloseOutParties(ctx, closed, currentMP, mCmp)
+	if len(closed) == 0 {
+		return orderUpdates
+	}
 	closedMPs, closedParties, _ := m.liquidation.ClearDistressedParties(ctx, m.idgen, closed, currentMP, mCmp)
 	dp, sp := m.position.MarkDistressed(closedParties)
 	if len(dp) > 0 || len(sp) > 0 {
@@ -3177,6 +3519,74 @@ func (m *Market) resolveClosedOutParties(ctx context.Context, distressedMarginEv
 	return orderUpdates
 }
 
+// partiallyCloseOutParties transfers only part of the positions of distressed parties to the network if the liquidation
+// strategy allows it. The parties keep the remainder of their position, the distressed parties to close out in full are returned.
+func (m *Market) partiallyCloseOutParties(ctx context.Context, closed []events.Margin, currentMP, mCmp *num.Uint) []events.Margin {
+	if !m.mkt.LiquidationStrategy.PartialCloseOut() {
+		return closed
+	}
+	// parties in isolated margin mode are always closed out in full
+	full := make([]events.Margin, 0, len(closed))
+	cross := make([]events.Margin, 0, len(closed))
+	for _, v := range closed {
+		if m.getMarginMode(v.Party()) == types.MarginModeCrossMargin {
+			cross = append(cross, v)
+			continue
+		}
+		full = append(full, v)
+	}
+	increment := m.tradableInstrument.Instrument.Product.GetMarginIncrease(m.timeService.GetTimeNow().UnixNano())
+	auctionPrice := m.getAuctionPrice()
+	closedFully, partial := m.liquidation.PartialCloseOuts(cross, func(evt events.Margin) *num.Uint {
+		return m.risk.MaintenanceMargin(evt, currentMP, increment, auctionPrice)
+	})
+	if len(partial) == 0 {
+		return closed
+	}
+	full = append(full, closedFully...)
+
+	// the positions are updated by the close-out trades, keep track of the sizes and entry prices to record the realised position
+	preTradeSizes := make(map[string]int64, len(partial))
+	entryPrices := make(map[string]*num.Uint, len(partial))
+	for _, pc := range partial {
+		preTradeSizes[pc.Position.Party()] = pc.Position.Size()
+		entryPrices[pc.Position.Party()] = pc.Position.AverageEntryPrice()
+	}
+	trades := m.liquidation.ClearPartialCloseOuts(ctx, m.idgen, partial, currentMP, mCmp)
+	// the network takes over the closed out volume as it does for parties closed out in full
+	m.settlement.ReduceDistressed(trades)
+	pos := m.recordNetworkReductions(trades, preTradeSizes, entryPrices)
+	// the remaining positions are covered by the collateral of the parties, top up their margin accounts
+	m.recheckMargin(ctx, pos)
+	return full
+}
+
+// recordNetworkReductions records the realised positions of the parties whose position was reduced by trading with the network,
+// and returns their updated positions.
+func (m *Market) recordNetworkReductions(trades []*types.Trade, preTradeSizes map[string]int64, entryPrices map[string]*num.Uint) []events.MarketPosition {
+	pos := make([]events.MarketPosition, 0, len(trades))
+	for _, trade := range trades {
+		party := trade.Buyer
+		if party == types.NetworkParty {
+			party = trade.Seller
+		}
+		mp, ok := m.position.GetPositionByPartyID(party)
+		if !ok {
+			continue
+		}
+		var realisedPosition num.Decimal
+		if preTradeSizes[party] > 0 {
+			realisedPosition = trade.Price.ToDecimal().Sub(entryPrices[party].ToDecimal()).Mul(num.DecimalFromInt64(int64(trade.Size))).Div(m.positionFactor)
+		} else {
+			realisedPosition = entryPrices[party].ToDecimal().Sub(trade.Price.ToDecimal()).Mul(num.DecimalFromInt64(int64(trade.Size))).Div(m.positionFactor)
+		}
+		m.marketActivityTracker.RecordRealisedPosition(m.settlementAsset, party, m.mkt.ID, realisedPosition)
+		m.marketActivityTracker.RecordPosition(m.settlementAsset, party, m.mkt.ID, mp.Size(), trade.Price, m.positionFactor, m.timeService.GetTimeNow())
+		pos = append(pos, mp)
+	}
+	return pos
+}
+
 func (m *Market) finalizePartiesCloseOut(
 	ctx context.Context,
 	closed []events.Margin,
@@ -3191,17 +3601,17 @@ func (m *Market) finalizePartiesCloseOut(
 		if m.getMarginMode(mp.Party()) == types.MarginModeCrossMargin || (mp.Buy() == 0 && mp.Sell() == 0) {
 			toRemoveFromPosition = append(toRemoveFromPosition, mp)
 		}
-		var reaslisedPosition num.Decimal
+		var realisedPosition num.Decimal
 		if mp.Size() > 0 {
 			// a party **closed out** on their **LONG** position
 			// (trade price - average entry price) * position delta$$
-			reaslisedPosition = m.getCurrentMarkPrice().ToDecimal().Sub(mp.AverageEntryPrice().ToDecimal()).Mul(num.DecimalFromInt64(mp.Size())).Div(m.positionFactor)
+			realisedPosition = m.getCurrentMarkPrice().ToDecimal().Sub(mp.AverageEntryPrice().ToDecimal()).Mul(num.DecimalFromInt64(mp.Size())).Div(m.positionFactor)
 		} else {
 			// a party **closed out** their **SHORT** position
 			// (average entry price - trade price) * position delta$$
-			reaslisedPosition = mp.AverageEntryPrice().ToDecimal().Sub(m.getCurrentMarkPrice().ToDecimal()).Mul(num.DecimalFromInt64(-mp.Size())).Div(m.positionFactor)
+			realisedPosition = mp.AverageEntryPrice().ToDecimal().Sub(m.getCurrentMarkPrice().ToDecimal()).Mul(num.DecimalFromInt64(-mp.Size())).Div(m.positionFactor)
 		}
-		m.marketActivityTracker.RecordRealisedPosition(m.settlementAsset, mp.Party(), m.mkt.ID, reaslisedPosition)
+		m.marketActivityTracker.RecordRealisedPosition(m.settlementAsset, mp.Party(), m.mkt.ID, realisedPosition)
 	}
 	m.position.RemoveDistressed(toRemoveFromPosition)
 	// but we want to update the market activity tracker on their 0 position for all of the closed parties
@@ -3743,6 +4153,7 @@ func (m *Market) AmendOrderWithIDGenerator(
 ) (oc *types.OrderConfirmation, _ error,
 ) {
 	defer m.onTxProcessed()
+	defer m.applyMarketMakerProtections(ctx)
 
 	m.idgen = idgen
 	defer func() { m.idgen = nil }()
@@ -4021,6 +4432,15 @@ func (m *Market) amendOrder(
 	// Update potential new position after the amend
 	pos := m.position.AmendOrder(ctx, existingOrder, amendedOrder)
 
+	// an amendment increasing the size or moving the price of the order could breach the position limits of the market
+	if sizeIncrease || priceShift {
+		if err := m.checkPositionLimits(pos, amendedOrder); err != nil {
+			// Undo the position registering
+			_ = m.position.AmendOrder(ctx, amendedOrder, existingOrder)
+			return nil, nil, err
+		}
+	}
+
 	// Perform check and allocate margin if price or order size is increased
 	// ignore rollback return here, as if we amend it means the order
 	// is already on the book, not rollback will be needed, the margin
@@ -4133,6 +4553,8 @@ func (m *Market) validateOrderAmendment(
 	} else if amendment.Price != nil {
 		// We cannot change the price on a pegged order
 		return types.OrderErrorUnableToAmendPriceOnPeggedOrder
+	} else if amendment.PeggedReference == types.PeggedReferenceIndexPrice && !m.hasIndexPrice() {
+		return types.OrderErrorPeggedReferenceNotSupported
 	}
 	return nil
 }
@@ -4247,6 +4669,7 @@ func (m *Market) orderCancelReplace(
 	if err != nil {
 		m.log.Panic("unable to submit order", logging.Error(err))
 	}
+	m.handleSelfTradeReductions(ctx, conf)
 
 	// replace the trades in the confirmation to have
 	// the ones with the fees embedded
@@ -4719,22 +5142,11 @@ func (m *Market) terminateMarket(ctx context.Context, finalState types.MarketSta
 		m.tradableInstrument.Instrument.Product.UnsubscribeTradingTerminated(ctx)
 
 		m.broker.Send(events.NewMarketUpdatedEvent(ctx, *m.mkt))
-		var err error
 		if settlementDataInAsset != nil && m.validateSettlementData(settlementDataInAsset) {
 			m.settlementDataWithLock(ctx, finalState, settlementDataInAsset)
-		} else if m.settlementDataInMarket != nil {
+		} else if m.settlementDataInMarket != nil && !m.beforeOptionExpiry() {
 			// because we need to be able to perform the MTM settlement, only update market state now
-			settlementDataInAsset, err = m.tradableInstrument.Instrument.Product.ScaleSettlementDataToDecimalPlaces(m.settlementDataInMarket, m.assetDP)
-			if err != nil {
-				m.log.Error(err.Error())
-				return
-			}
-			if !m.validateSettlementData(settlementDataInAsset) {
-				m.log.Warn("invalid settlement data", logging.MarketID(m.GetID()))
-				m.settlementDataInMarket = nil
-				return
-			}
-			m.settlementDataWithLock(ctx, finalState, settlementDataInAsset)
+			m.settleWithSettlementDataInMarket(ctx, finalState)
 		} else {
 			m.log.Debug("no settlement data", logging.MarketID(m.GetID()))
 		}
@@ -4759,6 +5171,22 @@ func (m *Market) terminateMarket(ctx context.Context, finalState types.MarketSta
 	}
 }
 
+// settleWithSettlementDataInMarket settles the market with the settlement data received so far,
+// which is dropped if it's not valid.
+func (m *Market) settleWithSettlementDataInMarket(ctx context.Context, finalState types.MarketState) {
+	settlementDataInAsset, err := m.tradableInstrument.Instrument.Product.ScaleSettlementDataToDecimalPlaces(m.settlementDataInMarket, m.assetDP)
+	if err != nil {
+		m.log.Error(err.Error())
+		return
+	}
+	if !m.validateSettlementData(settlementDataInAsset) {
+		m.log.Warn("invalid settlement data", logging.MarketID(m.GetID()))
+		m.settlementDataInMarket = nil
+		return
+	}
+	m.settlementDataWithLock(ctx, finalState, settlementDataInAsset)
+}
+
 func (m *Market) scaleOracleData(ctx context.Context, price *num.Numeric, dp int64) *num.Uint {
 	if price == nil {
 		return nil
@@ -4780,6 +5208,14 @@ func (m *Market) settlementData(ctx context.Context, settlementData *num.Numeric
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	// an option settles against the value of the underlying at expiry, the latest value received
+	// before then is kept and the market settles with it on expiry if no newer value is received.
+	if m.beforeOptionExpiry() {
+		m.log.Debug("settlement data received before option expiry", logging.MarketID(m.GetID()))
+		m.settlementDataInMarket = settlementData
+		return
+	}
+
 	m.settlementDataInMarket = settlementData
 	settlementDataInAsset, err := m.tradableInstrument.Instrument.Product.ScaleSettlementDataToDecimalPlaces(m.settlementDataInMarket, m.assetDP)
 	if err != nil {
@@ -4919,6 +5355,9 @@ func (m *Market) settlementDataWithLock(ctx context.Context, finalState types.Ma
 			return
 		}
 	}
+	if m.deferOutcomeSettlement(settlementDataInAsset) {
+		return
+	}
 
 	if m.mkt.State == types.MarketStateTradingTerminated && settlementDataInAsset != nil {
 		err := m.closeMarket(ctx, m.timeService.GetTimeNow(), finalState, settlementDataInAsset)
@@ -4929,7 +5368,7 @@ func (m *Market) settlementDataWithLock(ctx context.Context, finalState types.Ma
 
 		// mark price should be updated here
 		if settlementDataInAsset != nil {
-			m.lastTradedPrice = settlementDataInAsset.Clone()
+			m.lastTradedPrice = m.finalMarkPrice(settlementDataInAsset)
 			// the settlement price is the final mark price
 			m.markPriceCalculator.OverridePrice(m.lastTradedPrice)
 			if m.internalCompositePriceCalculator != nil {
@@ -5114,13 +5553,14 @@ func (m *Market) GetRiskFactors() *types.RiskFactor {
 	return m.risk.GetRiskFactors()
 }
 
-func (m *Market) UpdateMarginMode(ctx context.Context, party string, marginMode types.MarginMode, marginFactor num.Decimal) error {
+func (m *Market) UpdateMarginMode(ctx context.Context, party string, marginMode types.MarginMode, marginFactor num.Decimal, portfolioMargin bool) error {
 	if m.fCap != nil && m.fCap.FullyCollateralised {
 		return common.ErrIsolatedMarginFullyCollateralised
 	}
 	if err := m.switchMarginMode(ctx, party, marginMode, marginFactor); err != nil {
 		return err
 	}
+	m.setPortfolioMargin(party, marginMode == types.MarginModeCrossMargin && portfolioMargin)
 
 	m.emitPartyMarginModeUpdated(ctx, party, marginMode, marginFactor)
 
@@ -5437,23 +5877,6 @@ func (m *Market) needsRebase(fairPrice *num.Uint) (bool, types.Side, *num.Uint)
 	return false, types.SideUnspecified, nil
 }
 
-func VerifyAMMBounds(baseParam *num.Uint, lowerParam *num.Uint, upperParam *num.Uint, priceFactor num.Decimal) error {
-	base, _ := num.UintFromDecimal(baseParam.ToDecimal().Mul(priceFactor))
-	if lowerParam != nil {
-		lower, _ := num.UintFromDecimal(lowerParam.ToDecimal().Mul(priceFactor))
-		if lower.GTE(base) {
-			return fmt.Errorf(fmt.Sprintf("base (%s) as factored by market and asset decimals must be greater than lower bound (%s)", base.String(), lower.String()))
-		}
-	}
-	if upperParam != nil {
-		upper, _ := num.UintFromDecimal(upperParam.ToDecimal().Mul(priceFactor))
-		if base.GTE(upper) {
-			return fmt.Errorf(fmt.Sprintf("upper bound (%s) as factored by market and asset decimals must be greater than base (%s)", upper.String(), base.String()))
-		}
-	}
-	return nil
-}
-
 func (m *Market) SubmitAMM(ctx context.Context, submit *types.SubmitAMM, deterministicID string) error {
 	if !m.canTrade() {
 		return common.ErrTradingNotAllowed
@@ -5464,7 +5887,7 @@ func (m *Market) SubmitAMM(ctx context.Context, submit *types.SubmitAMM, determi
 
 	// create the AMM curves but do not confirm it with the engine
 	var order *types.Order
-	if err := VerifyAMMBounds(submit.Parameters.Base, submit.Parameters.LowerBound, submit.Parameters.UpperBound, m.priceFactor); err != nil {
+	if err := common.VerifyAMMBounds(submit.Parameters.Base, submit.Parameters.LowerBound, submit.Parameters.UpperBound, m.priceFactor); err != nil {
 		return err
 	}
 
@@ -5473,6 +5896,18 @@ func (m *Market) SubmitAMM(ctx context.Context, submit *types.SubmitAMM, determi
 		return err
 	}
 
+	if err := m.checkAMMPositionLimits(pool); err != nil {
+		m.broker.Send(
+			events.NewAMMPoolEvent(
+				ctx, submit.Party, m.GetID(), pool.AMMParty, pool.ID,
+				submit.CommitmentAmount, submit.Parameters,
+				types.AMMPoolStatusRejected, types.AMMStatusReasonPositionLimitExceeded,
+				pool.ProposedFee, nil, nil,
+			),
+		)
+		return err
+	}
+
 	// create a rebasing order if the AMM needs it i.e its base if not within best-bid/best-ask
 	if ok, side, quote := m.needsRebase(pool.BestPrice(nil)); ok {
 		order, err = m.getRebasingOrder(quote, side, submit.SlippageTolerance, pool)
@@ -5545,7 +5980,7 @@ func (m *Market) AmendAMM(ctx context.Context, amend *types.AmendAMM, determinis
 	defer func() { m.idgen = nil }()
 
 	if amend.Parameters != nil {
-		if err := VerifyAMMBounds(amend.Parameters.Base, amend.Parameters.LowerBound, amend.Parameters.UpperBound, m.priceFactor); err != nil {
+		if err := common.VerifyAMMBounds(amend.Parameters.Base, amend.Parameters.LowerBound, amend.Parameters.UpperBound, m.priceFactor); err != nil {
 			return err
 		}
 	}
@@ -5562,6 +5997,10 @@ func (m *Market) AmendAMM(ctx context.Context, amend *types.AmendAMM, determinis
 		}
 	}()
 
+	if err = m.checkAMMPositionLimits(pool); err != nil {
+		return err
+	}
+
 	var order *types.Order
 	if ok, side, quote := m.needsRebase(pool.BestPrice(nil)); ok {
 		order, err = m.getRebasingOrder(quote, side, amend.SlippageTolerance, pool)
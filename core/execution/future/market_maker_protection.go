@@ -0,0 +1,62 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package future
+
+import (
+	"context"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/execution/common"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+)
+
+// UpdateMarketMakerProtection sets, or removes, the market maker protection of a party.
+func (m *Market) UpdateMarketMakerProtection(ctx context.Context, protection *types.MarketMakerProtection) error {
+	if !m.canTrade() {
+		return common.ErrTradingNotAllowed
+	}
+	m.mmProtections.Update(m.timeService.GetTimeNow(), protection)
+	return nil
+}
+
+func (m *Market) recordMarketMakerProtectionFills(trade *types.Trade) {
+	now := m.timeService.GetTimeNow()
+	notional, _ := num.UintFromDecimal(trade.Price.ToDecimal().Mul(num.DecimalFromInt64(int64(trade.Size))).Div(m.positionFactor))
+	m.mmProtections.RecordFill(now, trade.Buyer, types.SideBuy, trade.Size, notional)
+	m.mmProtections.RecordFill(now, trade.Seller, types.SideSell, trade.Size, notional)
+}
+
+// applyMarketMakerProtections cancels all the orders of the parties which breached
+// one of their limits, and freezes them.
+func (m *Market) applyMarketMakerProtections(ctx context.Context) {
+	if !m.mmProtections.HasTriggered() {
+		return
+	}
+
+	now := m.timeService.GetTimeNow()
+	for _, t := range m.mmProtections.Triggered() {
+		if _, err := m.CancelAllOrders(ctx, t.Party); err != nil {
+			m.log.Error("could not cancel orders of party on market maker protection trigger",
+				logging.MarketID(m.mkt.ID),
+				logging.PartyID(t.Party),
+				logging.Error(err))
+		}
+		frozenUntil := m.mmProtections.Freeze(now, t.Party)
+		m.broker.Send(events.NewMarketMakerProtectionTriggeredEvent(ctx, m.mkt.ID, t.Party, t.Limit, t.Filled, frozenUntil))
+	}
+}
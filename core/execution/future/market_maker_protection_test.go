@@ -0,0 +1,149 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package future_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/execution/common"
+	"code.vegaprotocol.io/vega/core/types"
+	vegacontext "code.vegaprotocol.io/vega/libs/context"
+	vgcrypto "code.vegaprotocol.io/vega/libs/crypto"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/ptr"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarketMakerProtectionCancelsAndFreezes(t *testing.T) {
+	mm := "mm"
+	taker := "taker"
+	auxParty := "auxParty"
+	auxParty2 := "auxParty2"
+	now := time.Unix(10, 0)
+	tm := getTestMarket2(t, now, nil, &types.AuctionDuration{
+		Duration: 1,
+	}, true, 1.05)
+	ctx := vegacontext.WithTraceID(context.Background(), vgcrypto.RandomHash())
+
+	addAccount(t, tm, mm)
+	addAccount(t, tm, taker)
+	addAccount(t, tm, auxParty)
+	addAccount(t, tm, auxParty2)
+	addAccountWithAmount(tm, "lpprov", 10000000)
+
+	tm.market.OnMarketAuctionMinimumDurationUpdate(ctx, time.Second)
+
+	auxOrders := []*types.Order{
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "alwaysOnBid", types.SideBuy, auxParty, 1, 1),
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "alwaysOnAsk", types.SideSell, auxParty, 1, 10000),
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "aux1", types.SideSell, auxParty, 1, 55),
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "aux2", types.SideBuy, auxParty2, 1, 55),
+	}
+	for _, o := range auxOrders {
+		conf, err := tm.market.SubmitOrder(ctx, o)
+		require.NoError(t, err)
+		require.NotNil(t, conf)
+	}
+	lp := &types.LiquidityProvisionSubmission{
+		MarketID:         tm.market.GetID(),
+		CommitmentAmount: num.NewUint(25000),
+		Fee:              num.DecimalFromFloat(0.01),
+	}
+	require.NoError(t, tm.market.SubmitLiquidityProvision(ctx, lp, "lpprov", vgcrypto.RandomHash()))
+	// leave opening auction
+	now = now.Add(2 * time.Second)
+	tm.now = now
+	tm.market.OnTick(ctx, now)
+	require.Equal(t, types.MarketStateActive, tm.market.State())
+
+	require.NoError(t, tm.market.UpdateMarketMakerProtection(ctx, &types.MarketMakerProtection{
+		MarketID:        tm.market.GetID(),
+		Party:           mm,
+		WindowDuration:  10 * time.Second,
+		FreezeDuration:  30 * time.Second,
+		MaxFilledVolume: ptr.From(uint64(2)),
+	}))
+
+	quotes := []*types.Order{
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "mmAsk1", types.SideSell, mm, 1, 60),
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "mmAsk2", types.SideSell, mm, 1, 61),
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "mmAsk3", types.SideSell, mm, 1, 62),
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "mmBid1", types.SideBuy, mm, 1, 50),
+	}
+	quoteIDs := make([]string, 0, len(quotes))
+	for _, o := range quotes {
+		conf, err := tm.market.SubmitOrder(ctx, o)
+		require.NoError(t, err)
+		require.Equal(t, types.OrderStatusActive, conf.Order.Status)
+		quoteIDs = append(quoteIDs, conf.Order.ID)
+	}
+
+	// filling two quotes stays within the limit.
+	buy := getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceIOC, "buy1", types.SideBuy, taker, 2, 61)
+	conf, err := tm.market.SubmitOrder(ctx, buy)
+	require.NoError(t, err)
+	require.Len(t, conf.Trades, 2)
+
+	// the third fill breaches it, all the remaining quotes get cancelled.
+	buy = getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceIOC, "buy2", types.SideBuy, taker, 1, 62)
+	conf, err = tm.market.SubmitOrder(ctx, buy)
+	require.NoError(t, err)
+	require.Len(t, conf.Trades, 1)
+
+	var (
+		cancelled *events.CancelledOrders
+		triggered *events.MarketMakerProtectionTriggered
+	)
+	for _, e := range tm.events {
+		switch evt := e.(type) {
+		case *events.CancelledOrders:
+			cancelled = evt
+		case *events.MarketMakerProtectionTriggered:
+			triggered = evt
+		}
+	}
+	require.NotNil(t, cancelled)
+	assert.Equal(t, mm, cancelled.PartyID())
+	assert.Equal(t, []string{quoteIDs[3]}, cancelled.OrderIDs())
+	require.NotNil(t, triggered)
+	assert.Equal(t, mm, triggered.PartyID())
+	assert.Equal(t, types.MarketMakerProtectionLimitVolume, triggered.Proto().Limit)
+	assert.Equal(t, "3", triggered.Proto().Filled)
+	assert.Equal(t, now.Add(30*time.Second).UnixNano(), triggered.Proto().FrozenUntil)
+
+	// quoting is frozen, but the party can still hedge.
+	quote := getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "mmAsk4", types.SideSell, mm, 1, 60)
+	_, err = tm.market.SubmitOrder(ctx, quote)
+	require.ErrorIs(t, err, common.ErrMarketMakerProtectionFrozen)
+
+	hedge := getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceIOC, "mmHedge", types.SideBuy, mm, 1, 55)
+	_, err = tm.market.SubmitOrder(ctx, hedge)
+	require.NoError(t, err)
+
+	// once the freeze is over, the party can quote again.
+	now = now.Add(30 * time.Second)
+	tm.now = now
+	tm.market.OnTick(ctx, now)
+	quote = getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "mmAsk5", types.SideSell, mm, 1, 60)
+	conf, err = tm.market.SubmitOrder(ctx, quote)
+	require.NoError(t, err)
+	assert.Equal(t, types.OrderStatusActive, conf.Order.Status)
+}
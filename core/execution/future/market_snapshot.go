@@ -86,6 +86,12 @@ func NewMarketFromSnapshot(
 		return nil, fmt.Errorf("unable to instantiate a new market: %w", err)
 	}
 
+	if hm, ok := tradableInstrument.RiskModel.(risk.PriceHistoryModel); ok {
+		if err := hm.Restore(em.RiskModelPriceHistory); err != nil {
+			return nil, fmt.Errorf("unable to restore the risk model price history: %w", err)
+		}
+	}
+
 	asset := tradableInstrument.Instrument.Product.GetAsset()
 	exp := int(assetDecimals) - int(mkt.DecimalPlaces)
 	priceFactor := num.DecimalFromInt64(10).Pow(num.DecimalFromInt64(int64(exp)))
@@ -211,6 +217,10 @@ func NewMarketFromSnapshot(
 		mkt.LiquidationStrategy.DisposalSlippage = mkt.LiquiditySLAParams.PriceRange
 	}
 
+	partyPortfolioMargin := make(map[string]struct{}, len(em.PortfolioMarginParties))
+	for _, party := range em.PortfolioMarginParties {
+		partyPortfolioMargin[party] = struct{}{}
+	}
 	partyMargin := make(map[string]num.Decimal, len(em.PartyMarginFactors))
 	for _, pmf := range em.PartyMarginFactors {
 		partyMargin[pmf.Party], _ = num.DecimalFromString(pmf.MarginFactor)
@@ -245,6 +255,7 @@ func NewMarketFromSnapshot(
 		pMonitor:                      pMonitor,
 		peggedOrders:                  common.NewPeggedOrdersFromSnapshot(log, timeService, em.PeggedOrders),
 		expiringOrders:                common.NewExpiringOrdersFromState(em.ExpiringOrders),
+		mmProtections:                 common.NewMarketMakerProtectionsFromSnapshot(mkt.ID, em.MarketMakerProtection),
 		equityShares:                  equityShares,
 		lastBestBidPrice:              em.LastBestBid.Clone(),
 		lastBestAskPrice:              em.LastBestAsk.Clone(),
@@ -263,16 +274,23 @@ func NewMarketFromSnapshot(
 		expiringStopOrders:            expiringStopOrders,
 		perp:                          marketType == types.MarketTypePerp,
 		partyMarginFactor:             partyMargin,
+		partyPortfolioMargin:          partyPortfolioMargin,
 		banking:                       banking,
 		markPriceCalculator:           markPriceCalculator,
 		amm:                           ammEngine,
 	}
 
 	markPriceCalculator.SetOraclePriceScalingFunc(market.scaleOracleData)
+	market.pMonitor.SetIndexPriceProvider(market.indexPrice)
+	book.SetMarkPriceSource(market.getCurrentMarkPrice)
+	book.SetMatchingAlgorithm(mkt.MatchingAlgorithm)
 	if fCap := mkt.TradableInstrument.Instrument.Product.Cap(); fCap != nil {
 		market.fCap = fCap
 		market.capMax, _ = num.UintFromDecimal(fCap.MaxPrice.ToDecimal().Mul(priceFactor))
 		markPriceCalculator.SetMaxPriceCap(market.capMax.Clone())
+		if future, ok := market.tradableInstrument.Instrument.Product.(*products.Future); ok && future.IsPredictionOutcome() {
+			future.SetPredictionPayout(market.capMax.Clone())
+		}
 	}
 
 	if em.InternalCompositePriceCalculator != nil {
@@ -289,7 +307,7 @@ func NewMarketFromSnapshot(
 
 	market.assetDP = uint32(assetDecimals)
 	switch marketType {
-	case types.MarketTypeFuture:
+	case types.MarketTypeFuture, types.MarketTypeOption:
 		market.tradableInstrument.Instrument.Product.NotifyOnTradingTerminated(market.tradingTerminated)
 		market.tradableInstrument.Instrument.Product.NotifyOnSettlementData(market.settlementData)
 	case types.MarketTypePerp:
@@ -298,6 +316,7 @@ func NewMarketFromSnapshot(
 	default:
 		log.Panic("unexpected market type", logging.Int("type", int(marketType)))
 	}
+	market.setOptionRisk()
 
 	if em.SettlementData != nil {
 		// ensure oracle has the settlement data
@@ -348,6 +367,11 @@ func (m *Market) GetState() *types.ExecMarket {
 	sort.Slice(partyMarginFactors, func(i, j int) bool {
 		return partyMarginFactors[i].Party < partyMarginFactors[j].Party
 	})
+	portfolioMarginParties := make([]string, 0, len(m.partyPortfolioMargin))
+	for party := range m.partyPortfolioMargin {
+		portfolioMarginParties = append(portfolioMarginParties, party)
+	}
+	sort.Strings(portfolioMarginParties)
 
 	em := &types.ExecMarket{
 		Market:                         m.mkt.DeepClone(),
@@ -377,9 +401,14 @@ func (m *Market) GetState() *types.ExecMarket {
 		Product:                        m.tradableInstrument.Instrument.Product.Serialize(),
 		FeesStats:                      m.fee.GetState(assetQuantum),
 		PartyMarginFactors:             partyMarginFactors,
+		PortfolioMarginParties:         portfolioMarginParties,
 		MarkPriceCalculator:            m.markPriceCalculator.IntoProto(),
 		Amm:                            m.amm.IntoProto(),
 		MarketLiquidity:                m.liquidity.GetState(),
+		MarketMakerProtection:          m.mmProtections.GetState(),
+	}
+	if hm, ok := m.tradableInstrument.RiskModel.(risk.PriceHistoryModel); ok {
+		em.RiskModelPriceHistory = hm.Serialise()
 	}
 	if m.perp && m.internalCompositePriceCalculator != nil {
 		em.InternalCompositePriceCalculator = m.internalCompositePriceCalculator.IntoProto()
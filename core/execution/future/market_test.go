@@ -551,6 +551,26 @@ func getTestMarket2WithDP(
 	startOpeningAuction bool,
 	decimalPlaces uint64,
 	lpRange float64,
+) *testMarket {
+	t.Helper()
+	if pMonitorSettings == nil {
+		pMonitorSettings = &types.PriceMonitoringSettings{
+			Parameters: &types.PriceMonitoringParameters{
+				Triggers: []*types.PriceMonitoringTrigger{},
+			},
+		}
+	}
+	mkt := getMarketWithDP(pMonitorSettings, openingAuctionDuration, decimalPlaces, lpRange)
+	return getTestMarketFromConfig(t, now, mkt, openingAuctionDuration, startOpeningAuction)
+}
+
+// getTestMarketFromConfig returns a test market running the given market configuration.
+func getTestMarketFromConfig(
+	t *testing.T,
+	now time.Time,
+	mkt types.Market,
+	openingAuctionDuration *types.AuctionDuration,
+	startOpeningAuction bool,
 ) *testMarket {
 	t.Helper()
 	ctrl := gomock.NewController(t)
@@ -630,15 +650,7 @@ func getTestMarket2WithDP(
 	}
 
 	err = collateralEngine.EnableAsset(context.Background(), tokAsset)
-	if pMonitorSettings == nil {
-		pMonitorSettings = &types.PriceMonitoringSettings{
-			Parameters: &types.PriceMonitoringParameters{
-				Triggers: []*types.PriceMonitoringTrigger{},
-			},
-		}
-	}
 	require.NoError(t, err)
-	mkt := getMarketWithDP(pMonitorSettings, openingAuctionDuration, decimalPlaces, lpRange)
 	// ensure nextMTM is happening every block
 	mktCfg := &mkt
 	mktCfg.DecimalPlaces = cfgAsset.DecimalPlaces()
@@ -6720,15 +6732,3 @@ func TestLiquidityFeeSettingsConstantFee(t *testing.T) {
 	// doesn't matter what the LP's set in their nomination, the fee is going to be a constant 0.8
 	assert.Equal(t, "0.8", fee)
 }
-
-func TestVerifyAMMBounds(t *testing.T) {
-	require.Equal(t, "base (8) as factored by market and asset decimals must be greater than lower bound (8)", future.VerifyAMMBounds(num.NewUint(85), num.NewUint(82), num.NewUint(88), num.NewDecimalFromFloat(0.1)).Error())
-	require.Equal(t, "upper bound (8) as factored by market and asset decimals must be greater than base (8)", future.VerifyAMMBounds(num.NewUint(85), num.NewUint(78), num.NewUint(88), num.NewDecimalFromFloat(0.1)).Error())
-	require.Equal(t, "base (8) as factored by market and asset decimals must be greater than lower bound (8)", future.VerifyAMMBounds(num.NewUint(85), num.NewUint(80), num.NewUint(90), num.NewDecimalFromFloat(0.1)).Error())
-	require.NoError(t, future.VerifyAMMBounds(num.NewUint(85), num.NewUint(78), num.NewUint(90), num.NewDecimalFromFloat(0.1)))
-
-	require.NoError(t, future.VerifyAMMBounds(num.NewUint(85), num.NewUint(82), num.NewUint(88), num.NewDecimalFromFloat(1.1)))
-	require.NoError(t, future.VerifyAMMBounds(num.NewUint(85), num.NewUint(78), num.NewUint(88), num.NewDecimalFromFloat(1.1)))
-	require.NoError(t, future.VerifyAMMBounds(num.NewUint(85), num.NewUint(80), num.NewUint(90), num.NewDecimalFromFloat(1.1)))
-	require.NoError(t, future.VerifyAMMBounds(num.NewUint(85), num.NewUint(78), num.NewUint(90), num.NewDecimalFromFloat(1.1)))
-}
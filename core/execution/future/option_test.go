@@ -0,0 +1,196 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package future_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dstypes "code.vegaprotocol.io/vega/core/datasource/common"
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/types"
+	vegacontext "code.vegaprotocol.io/vega/libs/context"
+	vgcrypto "code.vegaprotocol.io/vega/libs/crypto"
+	"code.vegaprotocol.io/vega/libs/num"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// getOptionMarket returns the test market configuration with an option struck at 900 in place of the future.
+func getOptionMarket(optionType types.OptionType, expiry time.Time) types.Market {
+	mkt := getMarket(&types.PriceMonitoringSettings{
+		Parameters: &types.PriceMonitoringParameters{
+			Triggers: []*types.PriceMonitoringTrigger{},
+		},
+	}, &types.AuctionDuration{Duration: 1})
+	future := mkt.TradableInstrument.Instrument.Product.(*types.InstrumentFuture).Future
+	mkt.TradableInstrument.Instrument.Product = &types.InstrumentOption{
+		Option: &types.Option{
+			SettlementAsset:                     future.SettlementAsset,
+			QuoteName:                           future.QuoteName,
+			OptionType:                          optionType,
+			StrikePrice:                         num.DecimalFromInt64(900),
+			ExpiryTimestamp:                     expiry.Unix(),
+			DataSourceSpecForSettlementData:     future.DataSourceSpecForSettlementData,
+			DataSourceSpecForTradingTermination: future.DataSourceSpecForTradingTermination,
+			DataSourceSpecBinding:               future.DataSourceSpecBinding,
+		},
+	}
+	return mkt
+}
+
+// startOptionMarket returns a call option market out of its opening auction with a position
+// of 1 between party1 and party2, and a function broadcasting oracle data to it.
+func startOptionMarket(t *testing.T, now, expiry time.Time) (*testMarket, context.Context, func(key, value string)) {
+	t.Helper()
+	mkt := getOptionMarket(types.OptionTypeCall, expiry)
+	tm := getTestMarketFromConfig(t, now, mkt, &types.AuctionDuration{Duration: 1}, true)
+	ctx := vegacontext.WithTraceID(context.Background(), vgcrypto.RandomHash())
+
+	addAccount(t, tm, "party1")
+	addAccount(t, tm, "party2")
+	addAccountWithAmount(tm, "lpprov", 10000000)
+	tm.market.OnMarketAuctionMinimumDurationUpdate(ctx, time.Second)
+
+	orders := []*types.Order{
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "bid", types.SideBuy, "party1", 1, 1),
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "ask", types.SideSell, "party2", 1, 1000),
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "buy", types.SideBuy, "party1", 1, 50),
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "sell", types.SideSell, "party2", 1, 50),
+	}
+	for _, o := range orders {
+		_, err := tm.market.SubmitOrder(ctx, o)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tm.market.SubmitLiquidityProvision(ctx, &types.LiquidityProvisionSubmission{
+		MarketID:         tm.market.GetID(),
+		CommitmentAmount: num.NewUint(25000),
+		Fee:              num.DecimalFromFloat(0.01),
+	}, "lpprov", vgcrypto.RandomHash()))
+	now = now.Add(2 * time.Second)
+	tm.now = now
+	tm.market.OnTick(ctx, now)
+	require.Equal(t, types.MarketStateActive, tm.market.State())
+
+	pubKeys := []*dstypes.Signer{
+		dstypes.CreateSignerFromString("0xDEADBEEF", dstypes.SignerTypePubKey),
+	}
+	broadcast := func(key, value string) {
+		require.NoError(t, tm.oracleEngine.BroadcastData(ctx, dstypes.Data{
+			Signers: pubKeys,
+			Data:    map[string]string{key: value},
+		}))
+	}
+	return tm, ctx, broadcast
+}
+
+// settledPrice returns the price the market was settled at, nil if it wasn't.
+func settledPrice(tm *testMarket) *num.Uint {
+	var settled *events.SettleMarket
+	for _, e := range tm.events {
+		if evt, ok := e.(*events.SettleMarket); ok {
+			settled = evt
+		}
+	}
+	if settled == nil {
+		return nil
+	}
+	return settled.SettledPrice()
+}
+
+func TestOptionSettlement(t *testing.T) {
+	expiry := time.Unix(100, 0)
+	tm, _, broadcast := startOptionMarket(t, time.Unix(10, 0), expiry)
+
+	// the value of the underlying before expiry is not the settlement data
+	broadcast("prices.ETH.value", "900")
+	broadcast("trading.terminated", "true")
+	require.Equal(t, types.MarketStateTradingTerminated, tm.market.State())
+
+	// the option is settled against the value of the underlying at expiry,
+	// the payoff of the call is the final mark price.
+	tm.now = expiry
+	tm.events = nil
+	broadcast("prices.ETH.value", "1000")
+	require.Equal(t, types.MarketStateSettled, tm.market.State())
+
+	settled := settledPrice(tm)
+	require.NotNil(t, settled)
+	assert.Equal(t, "100", settled.String())
+}
+
+func TestOptionSettlementDataBeforeExpiry(t *testing.T) {
+	t.Run("trading still open", func(t *testing.T) {
+		expiry := time.Unix(100, 0)
+		tm, ctx, broadcast := startOptionMarket(t, time.Unix(10, 0), expiry)
+
+		// the oracle publishes once, before the market reaches its expiry
+		broadcast("prices.ETH.value", "900")
+		broadcast("prices.ETH.value", "1000")
+		require.Equal(t, types.MarketStateActive, tm.market.State())
+
+		tm.now = expiry
+		tm.events = nil
+		tm.market.OnTick(ctx, expiry)
+		require.Equal(t, types.MarketStateSettled, tm.market.State())
+
+		// the latest value received is used
+		settled := settledPrice(tm)
+		require.NotNil(t, settled)
+		assert.Equal(t, "100", settled.String())
+	})
+
+	t.Run("trading terminated before expiry", func(t *testing.T) {
+		expiry := time.Unix(100, 0)
+		tm, ctx, broadcast := startOptionMarket(t, time.Unix(10, 0), expiry)
+
+		broadcast("prices.ETH.value", "1000")
+		broadcast("trading.terminated", "true")
+		require.Equal(t, types.MarketStateTradingTerminated, tm.market.State())
+
+		tm.now = expiry.Add(-time.Second)
+		tm.market.OnTick(ctx, tm.now)
+		require.Equal(t, types.MarketStateTradingTerminated, tm.market.State())
+
+		tm.now = expiry
+		tm.events = nil
+		tm.market.OnTick(ctx, expiry)
+		require.Equal(t, types.MarketStateSettled, tm.market.State())
+
+		settled := settledPrice(tm)
+		require.NotNil(t, settled)
+		assert.Equal(t, "100", settled.String())
+	})
+}
+
+func TestOptionExpiry(t *testing.T) {
+	now := time.Unix(10, 0)
+	expiry := time.Unix(100, 0)
+	mkt := getOptionMarket(types.OptionTypePut, expiry)
+	tm := getTestMarketFromConfig(t, now, mkt, &types.AuctionDuration{Duration: 1}, true)
+	ctx := vegacontext.WithTraceID(context.Background(), vgcrypto.RandomHash())
+
+	tm.now = expiry.Add(-time.Second)
+	tm.market.OnTick(ctx, tm.now)
+	require.Equal(t, types.MarketStatePending, tm.market.State())
+
+	// the market expires without leaving its opening auction
+	tm.now = expiry
+	tm.market.OnTick(ctx, tm.now)
+	require.Equal(t, types.MarketStateCancelled, tm.market.State())
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package future
+
+import (
+	"context"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/execution/common"
+	"code.vegaprotocol.io/vega/core/risk"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+)
+
+// SetPortfolio makes the market margin the parties which opted in to portfolio margin on their net
+// positions across the markets of its portfolio group, and report position changes to the portfolio.
+func (m *Market) SetPortfolio(portfolio *risk.Portfolio) {
+	m.portfolio = portfolio
+	m.risk.SetPortfolio(portfolio)
+	m.liquidation.SetPortfolio(portfolio)
+}
+
+// IsPortfolioMargin returns true if the party is margined in cross margin mode in the market
+// and opted in to portfolio margin.
+func (m *Market) IsPortfolioMargin(party string) bool {
+	if m.getMarginMode(party) != types.MarginModeCrossMargin {
+		return false
+	}
+	_, ok := m.partyPortfolioMargin[party]
+	return ok
+}
+
+// setPortfolioMargin opts the party in or out of portfolio margin, the margin of its positions
+// in the markets of the group is then checked again.
+func (m *Market) setPortfolioMargin(party string, enabled bool) {
+	if _, ok := m.partyPortfolioMargin[party]; ok == enabled {
+		return
+	}
+	if enabled {
+		m.partyPortfolioMargin[party] = struct{}{}
+	} else {
+		delete(m.partyPortfolioMargin, party)
+	}
+	if m.portfolio != nil {
+		m.portfolio.RecordPortfolioMarginChange(m.mkt.ID, party)
+	}
+}
+
+// PortfolioExposure returns the maintenance margin of the position of the party in the market
+// on its own, signed with the direction of the position. Zero unless the party opted in to portfolio margin.
+func (m *Market) PortfolioExposure(party string) num.Decimal {
+	if !m.IsPortfolioMargin(party) {
+		return num.DecimalZero()
+	}
+	pos, ok := m.position.GetPositionByPartyID(party)
+	if !ok || pos.Size() == 0 {
+		return num.DecimalZero()
+	}
+	return m.risk.PortfolioExposure(pos.Size(), m.getCurrentMarkPriceForMargin())
+}
+
+// ReassessPortfolioParties checks the margins of the given parties again after their positions in another market
+// of the portfolio changed, or they opted in or out of portfolio margin, closing them out if needed.
+func (m *Market) ReassessPortfolioParties(ctx context.Context, parties []string, idgen common.IDGenerator) {
+	if m.closed || !m.canTrade() || m.as.InAuction() {
+		return
+	}
+
+	pos := make([]events.MarketPosition, 0, len(parties))
+	for _, party := range parties {
+		if m.getMarginMode(party) != types.MarginModeCrossMargin {
+			continue
+		}
+		if p, ok := m.position.GetPositionByPartyID(party); ok && p.Size() != 0 {
+			pos = append(pos, p)
+		}
+	}
+	if len(pos) == 0 {
+		return
+	}
+
+	defer m.onTxProcessed()
+	m.idgen = idgen
+	defer func() {
+		m.idgen = nil
+	}()
+
+	orderUpdates := m.handleRiskEvts(ctx, m.updateMargin(ctx, pos), nil)
+	m.checkForReferenceMoves(ctx, orderUpdates, false)
+}
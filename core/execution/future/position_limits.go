@@ -0,0 +1,120 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package future
+
+import (
+	"code.vegaprotocol.io/vega/core/execution/amm"
+	"code.vegaprotocol.io/vega/core/positions"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+)
+
+// checkPositionLimits returns an error if the order, already registered on the position of the party, could take the position
+// of the party or the open interest of the market above the limits of the market, should all the orders of the party on the side
+// of the order trade. Orders reducing the exposure of the party are always accepted.
+func (m *Market) checkPositionLimits(pos *positions.MarketPosition, order *types.Order) error {
+	limits := m.mkt.PositionLimits
+	if limits == nil || pos.OrderReducesExposure(order) {
+		return nil
+	}
+	// the current and largest potential exposure of the party on the side of the order
+	current, potential := pos.Size(), pos.Size()+pos.Buy()
+	if order.Side == types.SideSell {
+		current, potential = -pos.Size(), pos.Sell()-pos.Size()
+	}
+	if potential <= 0 {
+		return nil
+	}
+	if limits.MaxPositionSize > 0 && uint64(potential) > limits.MaxPositionSize {
+		return types.ErrPositionSizeLimitExceeded
+	}
+	if limits.MaxPositionNotional != nil && !limits.MaxPositionNotional.IsZero() {
+		// price the position at the order price, or at the mark price if it's higher or the order has no price
+		price := m.getCurrentMarkPrice()
+		if order.Price != nil && order.Price.GT(price) {
+			price = order.Price
+		}
+		notional := num.DecimalFromUint(price).Mul(num.DecimalFromInt64(potential)).Div(m.positionFactor)
+		if notional.GreaterThan(num.DecimalFromUint(limits.MaxPositionNotional)) {
+			return types.ErrPositionNotionalLimitExceeded
+		}
+	}
+	if limits.MaxOpenInterest > 0 {
+		// only the part of the order increasing the exposure of the party can add to the open interest
+		increase := potential - num.MaxV(current, 0)
+		if remaining := int64(order.Remaining); increase > remaining {
+			increase = remaining
+		}
+		if increase > 0 && m.position.GetOpenInterest()+uint64(increase) > limits.MaxOpenInterest {
+			return types.ErrOpenInterestLimitExceeded
+		}
+	}
+	return nil
+}
+
+// checkUnregisteredPositionLimits checks the position limits for an order which isn't registered on the position of
+// the party, such as the orders of block trades, complete sets and spread legs which trade without resting on the book.
+func (m *Market) checkUnregisteredPositionLimits(order *types.Order) error {
+	if m.mkt.PositionLimits == nil {
+		return nil
+	}
+	pos, ok := m.position.GetPositionByPartyID(order.Party)
+	if !ok {
+		pos = positions.NewMarketPosition(order.Party)
+	}
+	pos.RegisterOrder(m.log, order)
+	return m.checkPositionLimits(pos, order)
+}
+
+// checkAMMPositionLimits returns an error if the pool would hold a position above the limits of the market
+// at one of its bounds, which is where its position is the largest.
+func (m *Market) checkAMMPositionLimits(pool *amm.Pool) error {
+	limits := m.mkt.PositionLimits
+	if limits == nil {
+		return nil
+	}
+	current := int64(0)
+	if pos, ok := m.position.GetPositionByPartyID(pool.AMMParty); ok {
+		current = pos.Size()
+	}
+	long, short := pool.PositionsAtBounds()
+	bounds := []struct {
+		size, current uint64
+		price         *num.Uint
+	}{
+		{size: long, current: uint64(num.MaxV(current, 0)), price: pool.Parameters.LowerBound},
+		{size: short, current: uint64(num.MaxV(-current, 0)), price: pool.Parameters.UpperBound},
+	}
+	for _, b := range bounds {
+		if b.size == 0 {
+			continue
+		}
+		if limits.MaxPositionSize > 0 && b.size > limits.MaxPositionSize {
+			return types.ErrPositionSizeLimitExceeded
+		}
+		if limits.MaxPositionNotional != nil && !limits.MaxPositionNotional.IsZero() {
+			// the bounds of the pool are in market precision
+			notional := b.price.ToDecimal().Mul(m.priceFactor).Mul(num.DecimalFromInt64(int64(b.size))).Div(m.positionFactor)
+			if notional.GreaterThan(num.DecimalFromUint(limits.MaxPositionNotional)) {
+				return types.ErrPositionNotionalLimitExceeded
+			}
+		}
+		if limits.MaxOpenInterest > 0 && b.size > b.current && m.position.GetOpenInterest()+b.size-b.current > limits.MaxOpenInterest {
+			return types.ErrOpenInterestLimitExceeded
+		}
+	}
+	return nil
+}
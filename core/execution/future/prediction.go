@@ -0,0 +1,216 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package future
+
+import (
+	"context"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/execution/common"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+)
+
+// CheckCompleteSet makes sure the party can mint or redeem the given number of complete sets involving this outcome market.
+// Complete sets are traded at the mark price, so the market has to be trading continuously with a mark price. To redeem,
+// the party has to hold a long position of at least the size of the complete sets, covered by its margin account.
+func (m *Market) CheckCompleteSet(party string, size uint64, action types.CompleteSetAction) error {
+	if m.mkt.GetPredictionOutcome() == nil {
+		return common.ErrPredictionDoesNotExist
+	}
+	if !m.canTrade() || m.as.InAuction() || m.mkt.State != types.MarketStateActive {
+		return common.ErrCompleteSetNotAllowed
+	}
+	mp := m.getCurrentMarkPrice()
+	if mp == nil || mp.IsZero() {
+		return common.ErrCompleteSetNotAllowed
+	}
+	if m.getMarginMode(party) == types.MarginModeIsolatedMargin {
+		return common.ErrCompleteSetIsolatedMargin
+	}
+	if action != types.CompleteSetActionRedeem {
+		// minting buys the outcome contract at the mark price
+		return m.checkUnregisteredPositionLimits(&types.Order{
+			MarketID:  m.mkt.ID,
+			Party:     party,
+			Side:      types.SideBuy,
+			Price:     mp,
+			Size:      size,
+			Remaining: size,
+			Type:      types.OrderTypeNetwork,
+		})
+	}
+
+	pos, ok := m.position.GetPositionByPartyID(party)
+	if !ok || pos.Size() < 0 || uint64(pos.Size()) < size {
+		return common.ErrCompleteSetPositionTooSmall
+	}
+	margin, err := m.collateral.GetPartyMarginAccount(m.mkt.ID, party, m.settlementAsset)
+	if err != nil || margin.Balance.LT(m.completeSetPositionValue(mp, size)) {
+		return common.ErrCompleteSetPositionTooSmall
+	}
+	return nil
+}
+
+// CompleteSetValue returns the collateral backing the given number of complete sets, which is what exactly one of the
+// outcome positions is worth at settlement.
+func (m *Market) CompleteSetValue(size uint64) *num.Uint {
+	return m.completeSetPositionValue(m.capMax, size)
+}
+
+func (m *Market) completeSetPositionValue(price *num.Uint, size uint64) *num.Uint {
+	value, _ := num.UintFromDecimal(price.ToDecimal().Mul(num.DecimalFromUint(num.NewUint(size))).Div(m.positionFactor))
+	return value
+}
+
+// CompleteSetTrade trades the outcome contract of the market with the network at the mark price for the part of the complete
+// sets the party mints or redeems. The network takes the other side of the trade, its position being backed by the collateral
+// of the complete sets held in the insurance pool shared by the outcome markets. On mint the party buys the contract and the
+// insurance pool funds the margin of the position, on redeem the party sells it back and pays the value of the position back
+// into the insurance pool. The trade does not move the last traded price.
+func (m *Market) CompleteSetTrade(ctx context.Context, party string, size uint64, action types.CompleteSetAction, idgen common.IDGenerator) error {
+	defer m.onTxProcessed()
+
+	m.idgen = idgen
+	defer func() { m.idgen = nil }()
+
+	if _, err := m.collateral.CreatePartyMarginAccount(ctx, party, m.mkt.ID, m.settlementAsset); err != nil {
+		return err
+	}
+	if m.addParty(party) {
+		// First time seeing the party, we report his margin mode.
+		m.emitPartyMarginModeUpdated(ctx, party, m.getMarginMode(party), m.getMarginFactor(party))
+	}
+
+	price := m.getCurrentMarkPrice()
+	transfer := &types.Transfer{
+		Owner: party,
+		Amount: &types.FinancialAmount{
+			Amount: m.completeSetPositionValue(price, size),
+			Asset:  m.settlementAsset,
+		},
+		Type: types.TransferTypeCompleteSetMint,
+	}
+	side, reference := types.SideBuy, "complete-set-mint"
+	if action == types.CompleteSetActionRedeem {
+		side, reference = types.SideSell, "complete-set-redeem"
+		transfer.Type = types.TransferTypeCompleteSetRedeem
+		// the party pays back the value of the position first, so it is taken from the margin account holding it
+		ledgerMovement, err := m.collateral.CompleteSetMarginUpdate(ctx, m.mkt.ID, transfer)
+		if err != nil {
+			return err
+		}
+		m.broker.Send(events.NewLedgerMovements(ctx, []*types.LedgerMovement{ledgerMovement}))
+	}
+
+	now := m.timeService.GetTimeNow().UnixNano()
+	dpPrice, _ := num.UintFromDecimal(price.ToDecimal().Div(m.priceFactor))
+	newOrder := func(party string, side types.Side) *types.Order {
+		return &types.Order{
+			ID:            idgen.NextID(),
+			MarketID:      m.mkt.ID,
+			Party:         party,
+			Side:          side,
+			Price:         price.Clone(),
+			OriginalPrice: dpPrice.Clone(),
+			Size:          size,
+			Remaining:     size,
+			TimeInForce:   types.OrderTimeInForceFOK,
+			Type:          types.OrderTypeNetwork,
+			Status:        types.OrderStatusActive,
+			Version:       common.InitialOrderVersion,
+			CreatedAt:     now,
+			Reference:     reference,
+		}
+	}
+	networkOrder := newOrder(types.NetworkParty, types.OtherSide(side))
+	partyOrder := newOrder(party, side)
+	m.position.RegisterOrder(ctx, networkOrder)
+	m.position.RegisterOrder(ctx, partyOrder)
+
+	trade := &types.Trade{
+		Type:        types.TradeTypeDefault,
+		MarketID:    m.mkt.ID,
+		Price:       price.Clone(),
+		MarketPrice: dpPrice.Clone(),
+		Size:        size,
+		Aggressor:   networkOrder.Side,
+		Buyer:       party,
+		Seller:      types.NetworkParty,
+		Timestamp:   now,
+		BuyerFee:    types.NewFee(),
+		SellerFee:   types.NewFee(),
+	}
+	if side == types.SideSell {
+		trade.Buyer, trade.Seller = trade.Seller, trade.Buyer
+	}
+
+	for _, order := range []*types.Order{networkOrder, partyOrder} {
+		order.Remaining = 0
+		order.Status = types.OrderStatusFilled
+		order.UpdatedAt = now
+	}
+	m.broker.Send(events.NewOrderEvent(ctx, networkOrder))
+
+	lastTraded := m.getLastTradedPrice()
+	tradeType := types.TradeTypeDefault
+	m.handleConfirmation(ctx, &types.OrderConfirmation{
+		Order:                 networkOrder,
+		Trades:                []*types.Trade{trade},
+		PassiveOrdersAffected: []*types.Order{partyOrder},
+	}, &tradeType)
+	m.lastTradedPrice = lastTraded
+
+	if action == types.CompleteSetActionMint {
+		ledgerMovement, err := m.collateral.CompleteSetMarginUpdate(ctx, m.mkt.ID, transfer)
+		if err != nil {
+			m.log.Panic("unable to fund the margin of complete sets",
+				logging.MarketID(m.mkt.ID),
+				logging.PartyID(party),
+				logging.Error(err))
+		}
+		m.broker.Send(events.NewLedgerMovements(ctx, []*types.LedgerMovement{ledgerMovement}))
+	}
+
+	// the margin of the position is checked on the next mark to market, which tops it up from the general account if the
+	// insurance pool could not fund it in full
+	return nil
+}
+
+// deferOutcomeSettlement returns whether the final settlement of the outcome market has to wait for the next tick. The winning
+// outcome is paid out of the collateral the losing outcomes hand over to the insurance pool shared by the outcome markets, so it
+// only settles once they have, the losing outcome markets settling as soon as they get the settlement data.
+func (m *Market) deferOutcomeSettlement(settlementDataInAsset *num.Uint) bool {
+	return m.mkt.GetPredictionOutcome() != nil && m.settlementDataInMarket != nil && !settlementDataInAsset.IsZero() && !m.settlingOutcome
+}
+
+// settleWinningOutcome carries out the deferred final settlement of the winning outcome market, and returns whether the market is
+// now closed.
+func (m *Market) settleWinningOutcome(ctx context.Context) bool {
+	if m.mkt.GetPredictionOutcome() == nil || m.mkt.State != types.MarketStateTradingTerminated || m.settlementDataInMarket == nil {
+		return false
+	}
+	settlementDataInAsset, err := m.tradableInstrument.Instrument.Product.ScaleSettlementDataToDecimalPlaces(m.settlementDataInMarket, m.assetDP)
+	if err != nil {
+		m.log.Error(err.Error())
+		return false
+	}
+	m.settlingOutcome = true
+	defer func() { m.settlingOutcome = false }()
+	m.settlementDataWithLock(ctx, types.MarketStateSettled, settlementDataInAsset)
+	return m.closed
+}
@@ -35,7 +35,7 @@ func (m *Market) checkForReferenceMoves(
 	newMidSell, _ := m.getStaticMidPrice(types.SideSell)
 
 	// Look for a move
-	var changes uint8
+	changes := m.peggedOrders.ReferencePricesMoved(m.getCurrentMarkPrice(), m.indexPrice())
 	if !forceUpdate {
 		if newMidBuy.NEQ(m.lastMidBuyPrice) || newMidSell.NEQ(m.lastMidSellPrice) {
 			changes |= common.PriceMoveMid
@@ -69,3 +69,16 @@ func (m *Market) checkForReferenceMoves(
 		m.checkForReferenceMoves(ctx, orderUpdates, false)
 	}
 }
+
+// checkForMarkAndIndexPriceMoves reprices the pegged orders referencing the mark or
+// index price, which can move without anything happening on the book.
+func (m *Market) checkForMarkAndIndexPriceMoves(ctx context.Context) {
+	if m.as.InAuction() {
+		return
+	}
+
+	changes := m.peggedOrders.ReferencePricesMoved(m.getCurrentMarkPrice(), m.indexPrice())
+	if orderUpdates := m.repriceAllSpecialOrders(ctx, changes, nil); len(orderUpdates) > 0 {
+		m.checkForReferenceMoves(ctx, orderUpdates, false)
+	}
+}
@@ -109,6 +109,11 @@ func (m *Market) reSubmitPeggedOrders(
 
 	// Reinsert all the orders
 	for _, order := range toSubmitOrders {
+		if m.peggedOrderWouldCross(order) {
+			m.peggedOrders.Park(order)
+			evts = append(evts, events.NewOrderEvent(ctx, order))
+			continue
+		}
 		m.matching.ReSubmitSpecialOrders(order)
 		partiesPos[order.Party] = m.position.RegisterOrder(ctx, order)
 		updatedOrders = append(updatedOrders, order)
@@ -121,6 +126,21 @@ func (m *Market) reSubmitPeggedOrders(
 	return updatedOrders, partiesPos
 }
 
+// peggedOrderWouldCross returns true if the order is pegged to a price which is not derived
+// from the book, i.e. the mark or index price, and its new price would trade on resubmission.
+func (m *Market) peggedOrderWouldCross(order *types.Order) bool {
+	if order.PeggedOrder.Reference != types.PeggedReferenceMarkPrice &&
+		order.PeggedOrder.Reference != types.PeggedReferenceIndexPrice {
+		return false
+	}
+	if order.Side == types.SideBuy {
+		bestAsk, err := m.matching.GetBestAskPrice()
+		return err == nil && bestAsk.LTE(order.Price)
+	}
+	bestBid, err := m.matching.GetBestBidPrice()
+	return err == nil && bestBid.GTE(order.Price)
+}
+
 func (m *Market) repriceAllSpecialOrders(
 	ctx context.Context,
 	changes uint8,
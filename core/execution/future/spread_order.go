@@ -0,0 +1,332 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package future
+
+import (
+	"context"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/execution/common"
+	"code.vegaprotocol.io/vega/core/positions"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+)
+
+// SpreadLeg is the leg of a spread order checked against its market, with the margin
+// it requires set aside, ready to be either executed or released.
+type SpreadLeg struct {
+	order    *types.Order
+	trades   []*types.Trade
+	fees     events.FeesTransfer
+	reserved *num.Uint
+}
+
+// PreviewSpreadLeg runs a fill-or-kill market order for the leg of a spread
+// against the book without changing it, and returns the volume weighted average
+// price it would trade at, in market precision, along with the amount the general
+// account of the party has to cover for the margin and fees of the leg. An error is
+// returned if the order would not fill in full, would trade outside of the price
+// monitoring bounds, would take the position of the party above the position limits
+// of the market, or would fail the margin checks of the market. Neither the book
+// nor the accounts of the party are changed.
+func (m *Market) PreviewSpreadLeg(ctx context.Context, party string, side types.Side, size uint64) (num.Decimal, *num.Uint, error) {
+	if !m.canTrade() || m.as.InAuction() {
+		return num.DecimalZero(), nil, common.ErrTradingNotAllowed
+	}
+	if !m.collateral.HasGeneralAccount(party, m.settlementAsset) {
+		return num.DecimalZero(), nil, common.ErrPartyInsufficientAssetBalance
+	}
+
+	order := m.newSpreadLegOrder(party, side, size)
+	if err := m.checkUnregisteredPositionLimits(order); err != nil {
+		return num.DecimalZero(), nil, err
+	}
+
+	trades, err := m.spreadLegTrades(order)
+	if err != nil {
+		return num.DecimalZero(), nil, err
+	}
+
+	required, err := m.collateralForTrades(party, order, trades)
+	if err != nil {
+		return num.DecimalZero(), nil, err
+	}
+
+	notional := num.UintZero()
+	for _, t := range trades {
+		notional.AddSum(num.UintZero().Mul(t.Price, num.NewUint(t.Size)))
+	}
+	return notional.ToDecimal().Div(num.DecimalFromInt64(int64(size))).Div(m.priceFactor), required, nil
+}
+
+// ReserveSpreadLeg checks the fill-or-kill market order for the leg of a spread as PreviewSpreadLeg does,
+// registers it against the position of the party and moves the margin it requires to its margin account.
+// The book is left untouched, so once every leg of the spread is reserved each of them trades exactly
+// as it was checked when executed with ExecuteSpreadLeg. A leg that is not executed must be released
+// with ReleaseSpreadLeg.
+func (m *Market) ReserveSpreadLeg(ctx context.Context, party string, side types.Side, size uint64, reference, strategyID string, idgen common.IDGenerator) (*SpreadLeg, error) {
+	defer m.onTxProcessed()
+
+	if !m.canTrade() || m.as.InAuction() {
+		return nil, common.ErrTradingNotAllowed
+	}
+
+	order := m.newSpreadLegOrder(party, side, size)
+	order.ID = idgen.NextID()
+	order.Reference = reference
+	order.StrategyID = strategyID
+	order.Version = common.InitialOrderVersion
+
+	if err := m.validateAccounts(ctx, order); err != nil {
+		return nil, err
+	}
+	if err := m.position.ValidateOrder(order); err != nil {
+		return nil, err
+	}
+
+	trades, err := m.spreadLegTrades(order)
+	if err != nil {
+		return nil, err
+	}
+	fees, err := m.calcFees(trades)
+	if err != nil {
+		return nil, err
+	}
+	var fee *num.Uint
+	if fees != nil {
+		fee = fees.TotalFeesAmountPerParty()[party]
+	}
+
+	pos := m.position.RegisterOrder(ctx, order)
+	if err := m.checkPositionLimits(pos, order); err != nil {
+		_ = m.position.UnregisterOrder(ctx, order)
+		return nil, err
+	}
+
+	leg := &SpreadLeg{
+		order:    order,
+		trades:   trades,
+		fees:     fees,
+		reserved: num.UintZero(),
+	}
+	before := m.marginBalance(party)
+	err = m.checkBlockTradeMargin(ctx, pos, order, trades, fee)
+	if after := m.marginBalance(party); after.GT(before) {
+		leg.reserved.Sub(after, before)
+	}
+	if err != nil {
+		m.ReleaseSpreadLeg(ctx, leg)
+		return nil, common.ErrMarginCheckFailed
+	}
+	return leg, nil
+}
+
+// ExecuteSpreadLeg trades the reserved leg of a spread against the book, and returns its confirmation.
+func (m *Market) ExecuteSpreadLeg(ctx context.Context, leg *SpreadLeg, idgen common.IDGenerator) *types.OrderConfirmation {
+	defer m.onTxProcessed()
+	defer m.applyMarketMakerProtections(ctx)
+
+	m.idgen = idgen
+	defer func() { m.idgen = nil }()
+	defer m.triggerStopOrders(ctx, idgen)
+	defer m.releaseMarginExcess(ctx, leg.order.Party)
+
+	order := leg.order
+	confirmation, err := m.matching.SubmitOrder(order)
+	// the book hasn't changed since the leg was reserved, so it fills as it did when it was checked
+	if err != nil || order.Remaining > 0 {
+		m.log.Panic("reserved spread order leg did not fill",
+			logging.Order(order),
+			logging.Error(err))
+	}
+	m.handleSelfTradeReductions(ctx, confirmation)
+	confirmation.Trades = leg.trades
+
+	if leg.fees != nil {
+		if err := m.applyFees(ctx, order, leg.fees); err != nil {
+			m.log.Panic("failed to apply fees on spread order leg", logging.Order(order), logging.Error(err))
+		}
+	}
+	m.broker.Send(events.NewOrderEvent(ctx, order))
+
+	orderUpdates := m.handleConfirmation(ctx, confirmation, nil)
+	allUpdatedOrders := append([]*types.Order{order}, confirmation.PassiveOrdersAffected...)
+	m.checkForReferenceMoves(ctx, append(allUpdatedOrders, orderUpdates...), false)
+	return confirmation
+}
+
+// ReleaseSpreadLeg unregisters the order of a reserved leg which is not executed and moves the margin
+// set aside for it back to the general account of the party. The order never reached the book and is
+// not reported.
+func (m *Market) ReleaseSpreadLeg(ctx context.Context, leg *SpreadLeg) {
+	_ = m.position.UnregisterOrder(ctx, leg.order)
+	if leg.reserved.IsZero() {
+		return
+	}
+	transfer := &types.Transfer{
+		Owner: leg.order.Party,
+		Amount: &types.FinancialAmount{
+			Asset:  m.settlementAsset,
+			Amount: leg.reserved.Clone(),
+		},
+		MinAmount: leg.reserved.Clone(),
+		Type:      types.TransferTypeMarginHigh,
+	}
+	movement, err := m.collateral.RollbackMarginUpdateOnOrder(ctx, m.mkt.ID, m.settlementAsset, transfer)
+	if err != nil {
+		m.log.Panic("failed to release the margin of a spread order leg",
+			logging.Order(leg.order),
+			logging.Error(err))
+	}
+	m.broker.Send(events.NewLedgerMovements(ctx, []*types.LedgerMovement{movement}))
+}
+
+func (m *Market) newSpreadLegOrder(party string, side types.Side, size uint64) *types.Order {
+	return &types.Order{
+		MarketID:    m.mkt.ID,
+		Party:       party,
+		Side:        side,
+		Price:       num.UintZero(),
+		Size:        size,
+		Remaining:   size,
+		TimeInForce: types.OrderTimeInForceFOK,
+		Type:        types.OrderTypeMarket,
+		Status:      types.OrderStatusActive,
+		CreatedAt:   m.timeService.GetTimeNow().UnixNano(),
+	}
+}
+
+// spreadLegTrades returns the trades the order for the leg of a spread would generate, checking they
+// fill it in full within the price monitoring bounds.
+func (m *Market) spreadLegTrades(order *types.Order) ([]*types.Trade, error) {
+	trades, err := m.matching.GetTrades(order)
+	if err != nil {
+		return nil, err
+	}
+
+	minPrice, maxPrice := m.pMonitor.GetValidPriceRange()
+	var filled uint64
+	for _, t := range trades {
+		if t.Price.LT(minPrice.Representation()) || t.Price.GT(maxPrice.Representation()) {
+			return nil, types.OrderErrorNonPersistentOrderOutOfPriceBounds
+		}
+		filled += t.Size
+	}
+
+	// the FOK order would be stopped, either for lack of volume or because of self trade prevention
+	if filled < order.Size {
+		return nil, common.ErrSpreadLegCannotFill
+	}
+	return trades, nil
+}
+
+// marginBalance returns the balance of the margin account of the party, zero if it has none.
+func (m *Market) marginBalance(party string) *num.Uint {
+	margin, err := m.collateral.GetPartyMarginAccount(m.mkt.ID, party, m.settlementAsset)
+	if err != nil {
+		return num.UintZero()
+	}
+	return margin.Balance.Clone()
+}
+
+// partyMargin returns the margin of the party for the position. A party without a margin account
+// on the market is treated as if it had an empty one, so it isn't created just to check an order.
+func (m *Market) partyMargin(pos events.MarketPosition) (events.Margin, error) {
+	if _, err := m.collateral.GetPartyMarginAccount(m.mkt.ID, pos.Party(), m.settlementAsset); err == nil {
+		return m.collateral.GetPartyMargin(pos, m.settlementAsset, m.mkt.ID)
+	}
+	general, err := m.collateral.GetPartyGeneralAccount(pos.Party(), m.settlementAsset)
+	if err != nil {
+		return nil, err
+	}
+	return &emptyMargin{
+		MarketPosition: pos,
+		asset:          m.settlementAsset,
+		marketID:       m.mkt.ID,
+		general:        general.Balance.Clone(),
+	}, nil
+}
+
+// collateralForTrades runs the margin checks the market applies to an order trading immediately on the position the party
+// would hold once the trades are executed, and returns the amount its general account has to cover for the margin and
+// the fees it pays. The positions and accounts are left untouched.
+func (m *Market) collateralForTrades(party string, order *types.Order, trades []*types.Trade) (*num.Uint, error) {
+	fees, err := m.calcFees(trades)
+	if err != nil {
+		return nil, err
+	}
+	required := num.UintZero()
+	if fees != nil {
+		if fee, ok := fees.TotalFeesAmountPerParty()[party]; ok {
+			required.AddSum(fee)
+		}
+	}
+
+	pos := positions.NewMarketPosition(party)
+	if current, ok := m.position.GetPositionByPartyID(party); ok {
+		pos = current.Clone()
+	}
+	pos.RegisterOrder(m.log, order)
+	posWithTrades := pos.UpdateInPlaceOnTrades(m.log, order.Side, trades, order)
+
+	if m.getMarginMode(party) == types.MarginModeIsolatedMargin {
+		mpos, err := m.partyMargin(posWithTrades)
+		if err != nil {
+			return nil, err
+		}
+		marketObservable := m.getMarketObservable(order.Price.Clone())
+		increment := m.tradableInstrument.Instrument.Product.GetMarginIncrease(m.timeService.GetTimeNow().UnixNano())
+		marginFactor := m.getMarginFactor(party)
+		orders := m.matching.GetOrdersPerParty(party)
+		margin, err := m.risk.IsolatedMarginOnAggressor(mpos, marketObservable, increment, orders, trades, marginFactor, order.Side, required)
+		if err != nil {
+			return nil, common.ErrMarginCheckFailed
+		}
+		return required.AddSum(margin), nil
+	}
+
+	if pos.OrderReducesExposure(order) {
+		return required, nil
+	}
+	mpos, err := m.partyMargin(posWithTrades)
+	if err != nil {
+		return nil, err
+	}
+	increment := m.tradableInstrument.Instrument.Product.GetMarginIncrease(m.timeService.GetTimeNow().UnixNano())
+	initial := m.risk.InitialMarginOnNewOrder(mpos, m.getMarketObservable(order.Price.Clone()), increment, m.getAuctionPrice())
+	if initial.GT(mpos.MarginBalance()) {
+		required.AddSum(num.UintZero().Sub(initial, mpos.MarginBalance()))
+	}
+	return required, nil
+}
+
+// emptyMargin is the margin of a party which doesn't have a margin account on the market yet.
+type emptyMargin struct {
+	events.MarketPosition
+	asset    string
+	marketID string
+	general  *num.Uint
+}
+
+func (e emptyMargin) Asset() string                    { return e.asset }
+func (e emptyMargin) MarketID() string                 { return e.marketID }
+func (e emptyMargin) MarginBalance() *num.Uint         { return num.UintZero() }
+func (e emptyMargin) OrderMarginBalance() *num.Uint    { return num.UintZero() }
+func (e emptyMargin) GeneralBalance() *num.Uint        { return e.general.Clone() }
+func (e emptyMargin) GeneralAccountBalance() *num.Uint { return e.general.Clone() }
+func (e emptyMargin) BondBalance() *num.Uint           { return num.UintZero() }
+func (e emptyMargin) MarginShortFall() *num.Uint       { return num.UintZero() }
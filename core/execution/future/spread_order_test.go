@@ -0,0 +1,176 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package future_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/execution/common"
+	"code.vegaprotocol.io/vega/core/idgeneration"
+	"code.vegaprotocol.io/vega/core/types"
+	vegacontext "code.vegaprotocol.io/vega/libs/context"
+	vgcrypto "code.vegaprotocol.io/vega/libs/crypto"
+	"code.vegaprotocol.io/vega/libs/num"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreviewSpreadLeg(t *testing.T) {
+	taker := "taker"
+	auxParty := "auxParty"
+	auxParty2 := "auxParty2"
+	now := time.Unix(10, 0)
+	tm := getTestMarket2(t, now, nil, &types.AuctionDuration{
+		Duration: 1,
+	}, true, 1.05)
+	ctx := vegacontext.WithTraceID(context.Background(), vgcrypto.RandomHash())
+
+	addAccount(t, tm, taker)
+	addAccount(t, tm, auxParty)
+	addAccount(t, tm, auxParty2)
+	addAccountWithAmount(tm, "lpprov", 10000000)
+	addAccountWithAmount(tm, "poor", 10)
+
+	tm.market.OnMarketAuctionMinimumDurationUpdate(ctx, time.Second)
+
+	// trading is not allowed during the opening auction
+	_, _, err := tm.market.PreviewSpreadLeg(ctx, taker, types.SideBuy, 1)
+	require.ErrorIs(t, err, common.ErrTradingNotAllowed)
+
+	auxOrders := []*types.Order{
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "alwaysOnBid", types.SideBuy, auxParty, 1, 1),
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "alwaysOnAsk", types.SideSell, auxParty, 1, 10000),
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "aux1", types.SideSell, auxParty, 1, 55),
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "aux2", types.SideBuy, auxParty2, 1, 55),
+	}
+	for _, o := range auxOrders {
+		conf, err := tm.market.SubmitOrder(ctx, o)
+		require.NoError(t, err)
+		require.NotNil(t, conf)
+	}
+	lp := &types.LiquidityProvisionSubmission{
+		MarketID:         tm.market.GetID(),
+		CommitmentAmount: num.NewUint(25000),
+		Fee:              num.DecimalFromFloat(0.01),
+	}
+	require.NoError(t, tm.market.SubmitLiquidityProvision(ctx, lp, "lpprov", vgcrypto.RandomHash()))
+	// leave opening auction
+	now = now.Add(2 * time.Second)
+	tm.now = now
+	tm.market.OnTick(ctx, now)
+	require.Equal(t, types.MarketStateActive, tm.market.State())
+
+	asks := []*types.Order{
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "ask1", types.SideSell, auxParty, 2, 60),
+		getMarketOrder(tm, now, types.OrderTypeLimit, types.OrderTimeInForceGTC, "ask2", types.SideSell, auxParty, 1, 63),
+	}
+	for _, o := range asks {
+		_, err := tm.market.SubmitOrder(ctx, o)
+		require.NoError(t, err)
+	}
+
+	general, err := tm.collateralEngine.GetPartyGeneralAccount(taker, tm.asset)
+	require.NoError(t, err)
+	balance := general.Balance.Clone()
+
+	// the preview walks the book without touching it, so it can be repeated,
+	// and reports the collateral the leg requires without moving any of it.
+	var required *num.Uint
+	for i := 0; i < 2; i++ {
+		vwap, collateral, err := tm.market.PreviewSpreadLeg(ctx, taker, types.SideBuy, 3)
+		require.NoError(t, err)
+		assert.Equal(t, "61", vwap.String())
+		require.False(t, collateral.IsZero())
+		if required != nil {
+			assert.Equal(t, required.String(), collateral.String())
+		}
+		required = collateral
+	}
+	general, err = tm.collateralEngine.GetPartyGeneralAccount(taker, tm.asset)
+	require.NoError(t, err)
+	assert.Equal(t, balance.String(), general.Balance.String())
+	// not even the margin account is created.
+	_, err = tm.collateralEngine.GetPartyMarginAccount(tm.market.GetID(), taker, tm.asset)
+	require.Error(t, err)
+
+	// the leg requires more collateral than the party has, the spread order would be rejected before any leg trades.
+	_, collateral, err := tm.market.PreviewSpreadLeg(ctx, "poor", types.SideBuy, 3)
+	require.NoError(t, err)
+	assert.True(t, collateral.GT(num.NewUint(10)))
+
+	// there's not enough volume on the book to fill the whole size.
+	_, _, err = tm.market.PreviewSpreadLeg(ctx, taker, types.SideBuy, 5)
+	require.ErrorIs(t, err, common.ErrSpreadLegCannotFill)
+
+	// the first leg of a spread sets its margin aside without trading.
+	idgen := idgeneration.New(vgcrypto.RandomHash())
+	leg, err := tm.market.ReserveSpreadLeg(ctx, taker, types.SideBuy, 3, "spread", "strategy", idgen)
+	require.NoError(t, err)
+	general, err = tm.collateralEngine.GetPartyGeneralAccount(taker, tm.asset)
+	require.NoError(t, err)
+	assert.True(t, general.Balance.LT(balance))
+	margin, err := tm.collateralEngine.GetPartyMarginAccount(tm.market.GetID(), taker, tm.asset)
+	require.NoError(t, err)
+	assert.False(t, margin.Balance.IsZero())
+	assert.Equal(t, balance.String(), num.Sum(general.Balance, margin.Balance).String())
+
+	// the second leg fails, the first one is released and the party is left as it was, without having traded.
+	tm.events = nil
+	_, err = tm.market.ReserveSpreadLeg(ctx, taker, types.SideBuy, 5, "spread", "strategy", idgen)
+	require.ErrorIs(t, err, common.ErrSpreadLegCannotFill)
+	tm.market.ReleaseSpreadLeg(ctx, leg)
+	general, err = tm.collateralEngine.GetPartyGeneralAccount(taker, tm.asset)
+	require.NoError(t, err)
+	assert.Equal(t, balance.String(), general.Balance.String())
+	margin, err = tm.collateralEngine.GetPartyMarginAccount(tm.market.GetID(), taker, tm.asset)
+	require.NoError(t, err)
+	assert.True(t, margin.Balance.IsZero())
+	for _, e := range tm.events {
+		_, ok := e.(*events.Trade)
+		require.False(t, ok)
+	}
+	vwap, _, err := tm.market.PreviewSpreadLeg(ctx, taker, types.SideBuy, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "61", vwap.String())
+
+	// once all the legs are reserved they're executed, and their trades are tagged with the strategy ID.
+	leg, err = tm.market.ReserveSpreadLeg(ctx, taker, types.SideBuy, 3, "spread", "strategy", idgen)
+	require.NoError(t, err)
+	tm.events = nil
+	conf := tm.market.ExecuteSpreadLeg(ctx, leg, idgen)
+	require.Equal(t, types.OrderStatusFilled, conf.Order.Status)
+	assert.Equal(t, "strategy", conf.Order.StrategyID)
+
+	trades := 0
+	for _, e := range tm.events {
+		if evt, ok := e.(*events.Trade); ok {
+			assert.Equal(t, "strategy", evt.Trade().StrategyId)
+			trades++
+		}
+	}
+	assert.Equal(t, 2, trades)
+
+	// the leg cannot take the position of the party above the position limits of the market.
+	tm.mktCfg.PositionLimits = &types.PositionLimits{MaxPositionSize: 3}
+	_, _, err = tm.market.PreviewSpreadLeg(ctx, taker, types.SideBuy, 1)
+	require.ErrorIs(t, err, types.ErrPositionSizeLimitExceeded)
+	_, _, err = tm.market.PreviewSpreadLeg(ctx, taker, types.SideSell, 1)
+	require.NoError(t, err)
+}
@@ -0,0 +1,232 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package liquidation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+)
+
+// AutoDeleveragingRank is a profitable position ranked for auto-deleveraging, rank 1 being the first position to be reduced.
+type AutoDeleveragingRank struct {
+	Position events.Margin
+	Rank     uint64
+	Score    num.Decimal
+}
+
+// adlPosition is the size and entry price of a position when the auto-deleveraging ranking was last computed.
+type adlPosition struct {
+	size  int64
+	entry *num.Uint
+}
+
+// AutoDeleveraging returns true if the liquidation strategy reduces the positions opposite the network's position
+// once the network can neither dispose of its position nor cover its losses.
+func (e *Engine) AutoDeleveraging() bool {
+	return e.cfg.AutoDeleveraging
+}
+
+// BankruptcyPrice returns the price at which the loss on the network position, relative to the price it was last marked to market at,
+// uses up the balance of the insurance pool. Beyond this price the losses of the network position can no longer be covered.
+func (e *Engine) BankruptcyPrice(settled, balance *num.Uint, positionFactor num.Decimal) *num.Uint {
+	size := e.pos.open
+	if size == 0 || settled == nil {
+		return settled
+	}
+	// the price move covered by the balance, rounded down so the loss at the bankruptcy price never exceeds the balance
+	move, _ := num.UintFromDecimal(num.DecimalFromUint(balance).Mul(positionFactor).Div(num.DecimalFromInt64(size).Abs()))
+	if size < 0 {
+		return num.UintZero().Add(settled, move)
+	}
+	if move.GTE(settled) {
+		return num.UintZero()
+	}
+	return num.UintZero().Sub(settled, move)
+}
+
+// AutoDeleveragingRankingStale returns true if the mark price or any of the positions changed since the ranking was last computed.
+func (e *Engine) AutoDeleveragingRankingStale(positions []events.MarketPosition, mp *num.Uint) bool {
+	if mp == nil {
+		return false
+	}
+	stale := e.adlMarkPrice == nil || !e.adlMarkPrice.EQ(mp) || len(positions) != len(e.adlPositions)
+	for i := 0; !stale && i < len(positions); i++ {
+		p, ok := e.adlPositions[positions[i].Party()]
+		stale = !ok || p.size != positions[i].Size() || !p.entry.EQ(positions[i].AverageEntryPrice())
+	}
+	if !stale {
+		return false
+	}
+	e.adlMarkPrice = mp.Clone()
+	e.adlPositions = make(map[string]adlPosition, len(positions))
+	for _, pos := range positions {
+		e.adlPositions[pos.Party()] = adlPosition{size: pos.Size(), entry: pos.AverageEntryPrice()}
+	}
+	return true
+}
+
+// RankAutoDeleveraging ranks the profitable positions on each side of the market. A position is scored on its profit as a fraction
+// of its entry notional, multiplied by its leverage: its notional at the mark price over its margin balance.
+func (e *Engine) RankAutoDeleveraging(positions []events.Margin, mp *num.Uint, positionFactor num.Decimal) (long, short []*AutoDeleveragingRank) {
+	if mp == nil || mp.IsZero() {
+		return nil, nil
+	}
+	mpDec := num.DecimalFromUint(mp)
+	for _, pos := range positions {
+		size := pos.Size()
+		if size == 0 || pos.Party() == types.NetworkParty {
+			continue
+		}
+		entry := num.DecimalFromUint(pos.AverageEntryPrice())
+		if entry.IsZero() {
+			continue
+		}
+		profit := mpDec.Sub(entry).Div(entry)
+		if size < 0 {
+			profit = profit.Neg()
+		}
+		if !profit.IsPositive() {
+			continue
+		}
+		notional := mpDec.Mul(num.DecimalFromInt64(size).Abs()).Div(positionFactor)
+		// a position without margin is as levered as it gets, count at least one unit of collateral
+		collateral := num.MaxD(num.DecimalFromUint(pos.MarginBalance()), num.DecimalOne())
+		r := &AutoDeleveragingRank{
+			Position: pos,
+			Score:    profit.Mul(notional.Div(collateral)),
+		}
+		if size > 0 {
+			long = append(long, r)
+			continue
+		}
+		short = append(short, r)
+	}
+	rankPositions(long)
+	rankPositions(short)
+	return long, short
+}
+
+// SendAutoDeleveragingRankings sends out the ranking of the positions on both sides of the market, unless it is the same as the last one sent.
+func (e *Engine) SendAutoDeleveragingRankings(ctx context.Context, long, short []*AutoDeleveragingRank) {
+	rankings := make([]*eventspb.AutoDeleveragingRank, 0, len(long)+len(short))
+	for _, ranking := range [][]*AutoDeleveragingRank{long, short} {
+		for _, r := range ranking {
+			rankings = append(rankings, &eventspb.AutoDeleveragingRank{
+				PartyId:    r.Position.Party(),
+				OpenVolume: r.Position.Size(),
+				Rank:       r.Rank,
+				Score:      r.Score.String(),
+			})
+		}
+	}
+	if sameRankings(rankings, e.adlRankings) {
+		return
+	}
+	e.adlRankings = rankings
+	e.broker.Send(events.NewAutoDeleveragingRankingsEvent(ctx, e.mID, rankings))
+}
+
+func sameRankings(a, b []*eventspb.AutoDeleveragingRank) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].PartyId != b[i].PartyId || a[i].OpenVolume != b[i].OpenVolume || a[i].Rank != b[i].Rank || a[i].Score != b[i].Score {
+			return false
+		}
+	}
+	return true
+}
+
+// rankPositions sorts the positions from the highest score to the lowest, ties are broken on the party ID
+// to keep the ranking deterministic.
+func rankPositions(ranking []*AutoDeleveragingRank) {
+	sort.SliceStable(ranking, func(i, j int) bool {
+		if ranking[i].Score.Equal(ranking[j].Score) {
+			return ranking[i].Position.Party() < ranking[j].Position.Party()
+		}
+		return ranking[i].Score.GreaterThan(ranking[j].Score)
+	})
+	for i, r := range ranking {
+		r.Rank = uint64(i + 1)
+	}
+}
+
+// AutoDeleverage transfers the network's position to the ranked positions, reducing them in order until the network no longer holds a position.
+// The ranking has to be of the positions opposite the network's position, which are reduced at the bankruptcy price of the network position.
+// The trades with the network are returned so the market can settle them.
+func (e *Engine) AutoDeleverage(ctx context.Context, idgen IDGen, ranking []*AutoDeleveragingRank, price, mktPrice *num.Uint) []*types.Trade {
+	remaining := e.pos.open
+	if remaining < 0 {
+		remaining = -remaining
+	}
+	if remaining == 0 || len(ranking) == 0 {
+		return nil
+	}
+	orders := make([]events.Event, 0, len(ranking)*2)
+	trades := make([]events.Event, 0, len(ranking))
+	netTrades := make([]*types.Trade, 0, len(ranking))
+	positions := make([]*eventspb.AutoDeleveragedPosition, 0, len(ranking))
+	now := e.tSvc.GetTimeNow()
+	for _, r := range ranking {
+		if remaining == 0 {
+			break
+		}
+		// the position is updated by the trade, so get the size before reducing it
+		open := r.Position.Size()
+		size := open
+		if size < 0 {
+			size = -size
+		}
+		if size > remaining {
+			size = remaining
+		}
+		remaining -= size
+		if open < 0 {
+			size = -size
+		}
+		e.pos.open += size
+		o1, o2, t := e.getOrdersAndTrade(ctx, r.Position, size, idgen, now, price, mktPrice)
+		o1.Reference, o2.Reference = "auto-deleveraging", fmt.Sprintf("auto-deleveraging-%s", r.Position.Party())
+		t.Type = types.TradeTypeAutoDeleveraging
+		orders = append(orders, events.NewOrderEvent(ctx, o1), events.NewOrderEvent(ctx, o2))
+		trades = append(trades, events.NewTradeEvent(ctx, *t))
+		netTrades = append(netTrades, t)
+		positions = append(positions, &eventspb.AutoDeleveragedPosition{
+			PartyId:           r.Position.Party(),
+			ReducedSize:       size,
+			RemainingPosition: open - size,
+			Price:             price.String(),
+			Rank:              r.Rank,
+		})
+	}
+	e.broker.SendBatch(orders)
+	e.broker.SendBatch(trades)
+	e.broker.Send(events.NewAutoDeleveragingEvent(ctx, e.mID, positions))
+	e.log.Info("network position after auto-deleveraging", logging.Int64("network-position", e.pos.open))
+	if e.pos.open == 0 {
+		e.nextStep = time.Time{}
+	}
+	return netTrades
+}
@@ -17,6 +17,7 @@ package liquidation
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"time"
@@ -30,10 +31,14 @@ import (
 	"code.vegaprotocol.io/vega/libs/crypto"
 	"code.vegaprotocol.io/vega/libs/num"
 	"code.vegaprotocol.io/vega/logging"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
 )
 
 //go:generate go run github.com/golang/mock/mockgen -destination mocks/mocks.go -package mocks code.vegaprotocol.io/vega/core/execution/liquidation Book,IDGen,Positions,PriceMonitor,AMM
 
+// ErrDisposalFailed is returned when the network position is due to be reduced, but the book has no volume to reduce it against.
+var ErrDisposalFailed = errors.New("no volume available to dispose of the network position")
+
 type PriceMonitor interface {
 	GetValidPriceRange() (num.WrappedDecimal, num.WrappedDecimal)
 }
@@ -50,11 +55,23 @@ type IDGen interface {
 	NextID() string
 }
 
+// Portfolio is notified of the positions the network trades with, the positions of the
+// party in the other markets of a portfolio no longer get the same offset.
+type Portfolio interface {
+	RecordPositionChange(marketID, party string)
+}
+
 type Positions interface {
 	RegisterOrder(ctx context.Context, order *types.Order) *positions.MarketPosition
 	Update(ctx context.Context, trade *types.Trade, passiveOrder, aggressiveOrder *types.Order) []events.MarketPosition
 }
 
+// PartialCloseOut is a distressed position of which only part of the volume is transferred to the network.
+type PartialCloseOut struct {
+	Position events.Margin
+	Size     uint64
+}
+
 type Engine struct {
 	// settings, orderbook, network pos data
 	log      *logging.Logger
@@ -70,6 +87,12 @@ type Engine struct {
 	stopped  bool
 	pmon     PriceMonitor
 	amm      AMM
+	// nil unless portfolio margining is available
+	portfolio Portfolio
+	// the mark price and positions the auto-deleveraging ranking was last computed from, and the last ranking sent out
+	adlMarkPrice *num.Uint
+	adlPositions map[string]adlPosition
+	adlRankings  []*eventspb.AutoDeleveragingRank
 }
 
 // protocol upgrade - default values for existing markets/proposals.
@@ -125,6 +148,11 @@ func New(log *logging.Logger, cfg *types.LiquidationStrategy, mktID string, brok
 	}
 }
 
+// SetPortfolio sets the portfolio to notify of the positions the network trades with.
+func (e *Engine) SetPortfolio(p Portfolio) {
+	e.portfolio = p
+}
+
 func (e *Engine) Update(cfg *types.LiquidationStrategy) {
 	if !e.nextStep.IsZero() {
 		since := e.nextStep.Add(-e.cfg.DisposalTimeStep) // work out when the network position was last updated
@@ -135,9 +163,13 @@ func (e *Engine) Update(cfg *types.LiquidationStrategy) {
 }
 
 func (e *Engine) OnTick(ctx context.Context, now time.Time, midPrice *num.Uint) (*types.Order, error) {
-	if e.pos.open == 0 || e.as.InAuction() || e.nextStep.After(now) || midPrice.IsZero() {
+	if e.pos.open == 0 || e.as.InAuction() || e.nextStep.After(now) {
 		return nil, nil
 	}
+	// without a mid price, there is no book to dispose of the position on
+	if midPrice.IsZero() {
+		return nil, ErrDisposalFailed
+	}
 
 	one := num.DecimalOne()
 	// get the min/max price from the range based on slippage parameter
@@ -174,7 +206,7 @@ func (e *Engine) OnTick(ctx context.Context, now time.Time, midPrice *num.Uint)
 	available := e.book.GetVolumeAtPrice(bound, bookSide)
 	available += e.amm.GetVolumeAtPrice(price, side)
 	if available == 0 {
-		return nil, nil
+		return nil, ErrDisposalFailed
 	}
 	// round up, avoid a value like 0.1 to be floored, favour closing out a position of 1 at least
 	maxCons := uint64(num.DecimalFromFloat(float64(available)).Mul(e.cfg.MaxFractionConsumed).Ceil().IntPart())
@@ -229,7 +261,7 @@ func (e *Engine) ClearDistressedParties(ctx context.Context, idgen IDGen, closed
 	for _, cp := range closed {
 		e.pos.open += cp.Size()
 		// get the orders and trades so we can send events to update the datanode
-		o1, o2, t := e.getOrdersAndTrade(ctx, cp, idgen, now, mp, mmp)
+		o1, o2, t := e.getOrdersAndTrade(ctx, cp, cp.Size(), idgen, now, mp, mmp)
 		orders = append(orders, events.NewOrderEvent(ctx, o1), events.NewOrderEvent(ctx, o2))
 		trades = append(trades, events.NewTradeEvent(ctx, *t))
 		netTrades = append(netTrades, t)
@@ -250,6 +282,95 @@ func (e *Engine) ClearDistressedParties(ctx context.Context, idgen IDGen, closed
 	return mps, parties, netTrades
 }
 
+// PartialCloseOuts splits the distressed parties into the ones to close out in full, and the ones keeping part of their position.
+// The position is reduced step by step until the collateral of the party covers the maintenance margin of what remains, plus the
+// configured buffer. maintenance returns the maintenance margin required for a given position.
+func (e *Engine) PartialCloseOuts(closed []events.Margin, maintenance func(events.Margin) *num.Uint) ([]events.Margin, []*PartialCloseOut) {
+	if len(closed) == 0 || !e.cfg.PartialCloseOut() {
+		return closed, nil
+	}
+	full := make([]events.Margin, 0, len(closed))
+	partial := make([]*PartialCloseOut, 0, len(closed))
+	for _, cp := range closed {
+		if size := e.partialCloseOutSize(cp, maintenance); size > 0 {
+			partial = append(partial, &PartialCloseOut{
+				Position: cp,
+				Size:     size,
+			})
+			continue
+		}
+		full = append(full, cp)
+	}
+	return full, partial
+}
+
+// partialCloseOutSize returns the volume to transfer to the network, zero means the position has to be closed out in full.
+func (e *Engine) partialCloseOutSize(cp events.Margin, maintenance func(events.Margin) *num.Uint) uint64 {
+	open, sign := cp.Size(), int64(1)
+	if open < 0 {
+		open, sign = -open, -1
+	}
+	if open == 0 {
+		return 0
+	}
+	// round up, a step should close out a position of 1 at least
+	step := num.DecimalFromInt64(open).Mul(e.cfg.PartialCloseOutStep).Ceil().IntPart()
+	collateral := num.DecimalFromUint(num.Sum(cp.MarginBalance(), cp.GeneralBalance()))
+	buffer := num.DecimalOne().Add(e.cfg.PartialCloseOutBuffer)
+	for size := step; size < open; size += step {
+		remaining := reducedPos{
+			Margin: cp,
+			size:   sign * (open - size),
+		}
+		if collateral.GreaterThan(num.DecimalFromUint(maintenance(remaining)).Mul(buffer)) {
+			return uint64(size)
+		}
+	}
+	return 0
+}
+
+// ClearPartialCloseOuts transfers part of the distressed positions to the network, the parties keep the remainder of their position.
+// The trades with the network are returned so the market can settle them.
+func (e *Engine) ClearPartialCloseOuts(ctx context.Context, idgen IDGen, partial []*PartialCloseOut, mp, mmp *num.Uint) []*types.Trade {
+	if len(partial) == 0 {
+		return nil
+	}
+	if e.pos.open == 0 || e.nextStep.IsZero() {
+		e.nextStep = e.tSvc.GetTimeNow().Add(e.cfg.DisposalTimeStep)
+	}
+	orders := make([]events.Event, 0, len(partial)*2)
+	trades := make([]events.Event, 0, len(partial))
+	netTrades := make([]*types.Trade, 0, len(partial))
+	closeOuts := make([]*eventspb.PartialCloseOut, 0, len(partial))
+	now := e.tSvc.GetTimeNow()
+	for _, pc := range partial {
+		// the position is updated by the trade, so get the size before closing out
+		open, size := pc.Position.Size(), int64(pc.Size)
+		if open < 0 {
+			size = -size
+		}
+		e.pos.open += size
+		o1, o2, t := e.getOrdersAndTrade(ctx, pc.Position, size, idgen, now, mp, mmp)
+		orders = append(orders, events.NewOrderEvent(ctx, o1), events.NewOrderEvent(ctx, o2))
+		trades = append(trades, events.NewTradeEvent(ctx, *t))
+		netTrades = append(netTrades, t)
+		closeOuts = append(closeOuts, &eventspb.PartialCloseOut{
+			PartyId:           pc.Position.Party(),
+			ClosedOutSize:     size,
+			RemainingPosition: open - size,
+			Price:             mp.String(),
+		})
+	}
+	e.broker.SendBatch(orders)
+	e.broker.SendBatch(trades)
+	e.broker.Send(events.NewPartialCloseOutsEvent(ctx, e.mID, closeOuts))
+	e.log.Info("network position after partial close-out", logging.Int64("network-position", e.pos.open))
+	if e.pos.open == 0 {
+		e.nextStep = time.Time{}
+	}
+	return netTrades
+}
+
 func (e *Engine) UpdateMarkPrice(mp *num.Uint) {
 	e.pos.price = mp
 }
@@ -274,9 +395,8 @@ func (e *Engine) UpdateNetworkPosition(trades []*types.Trade) {
 	}
 }
 
-func (e *Engine) getOrdersAndTrade(ctx context.Context, pos events.Margin, idgen IDGen, now time.Time, price, dpPrice *num.Uint) (*types.Order, *types.Order, *types.Trade) {
+func (e *Engine) getOrdersAndTrade(ctx context.Context, pos events.Margin, s int64, idgen IDGen, now time.Time, price, dpPrice *num.Uint) (*types.Order, *types.Order, *types.Trade) {
 	tSide, nSide := types.SideSell, types.SideBuy // one of them will have to sell
-	s := pos.Size()
 	size := uint64(s)
 	if s < 0 {
 		size = uint64(-s)
@@ -344,6 +464,9 @@ func (e *Engine) getOrdersAndTrade(ctx context.Context, pos events.Margin, idgen
 	}
 	// the for the rest of the core, this should not seem like a wash trade though...
 	e.position.Update(ctx, &trade, &order, &partyOrder)
+	if e.portfolio != nil {
+		e.portfolio.RecordPositionChange(e.mID, pos.Party())
+	}
 	return &order, &partyOrder, &trade
 }
 
@@ -50,9 +50,12 @@ type tstEngine struct {
 }
 
 type marginStub struct {
-	party  string
-	size   int64
-	market string
+	party   string
+	size    int64
+	market  string
+	margin  *num.Uint
+	general *num.Uint
+	entry   *num.Uint
 }
 
 type SliceLenMatcher[T any] int
@@ -276,7 +279,7 @@ func testOrderbookHasNoVolume(t *testing.T) {
 	// the side should represent the side of the order the network places.
 	eng.amm.EXPECT().GetVolumeAtPrice(gomock.Any(), types.SideSell).Times(1).Return(uint64(0))
 	order, err := eng.OnTick(ctx, now, midPrice)
-	require.NoError(t, err)
+	require.ErrorIs(t, err, liquidation.ErrDisposalFailed)
 	require.Nil(t, order)
 }
 
@@ -588,6 +591,261 @@ func TestLegacySupport(t *testing.T) {
 	require.True(t, eng.Stopped())
 }
 
+func TestPartialCloseOuts(t *testing.T) {
+	mID := "partialMkt"
+	ctx := vegacontext.WithTraceID(context.Background(), vgcrypto.RandomHash())
+	config := &types.LiquidationStrategy{
+		DisposalTimeStep:      5 * time.Second,
+		DisposalFraction:      num.DecimalFromFloat(0.1),
+		FullDisposalSize:      10,
+		MaxFractionConsumed:   num.DecimalFromFloat(0.2),
+		PartialCloseOutStep:   num.DecimalFromFloat(0.1), // close out 10% of the position per step
+		PartialCloseOutBuffer: num.DecimalFromFloat(0.1), // cover 110% of the maintenance margin
+	}
+	eng := getTestEngine(t, mID, config.DeepClone())
+	defer eng.Finish()
+
+	// maintenance margin of 10 per unit of position
+	maintenance := func(evt events.Margin) *num.Uint {
+		size := evt.Size()
+		if size < 0 {
+			size = -size
+		}
+		return num.NewUint(uint64(size) * 10)
+	}
+	closed := []events.Margin{
+		// can cover 110% of the maintenance margin for 6 out of 10
+		&marginStub{party: "long", market: mID, size: 10, margin: num.NewUint(50), general: num.NewUint(20)},
+		// can cover 110% of the maintenance margin for 10 out of 20
+		&marginStub{party: "short", market: mID, size: -20, margin: num.NewUint(100), general: num.NewUint(20)},
+		// can't cover any part of the position
+		&marginStub{party: "broke", market: mID, size: 10, margin: num.NewUint(10), general: num.UintZero()},
+	}
+	full, partial := eng.PartialCloseOuts(closed, maintenance)
+	require.Len(t, full, 1)
+	require.Equal(t, "broke", full[0].Party())
+	require.Len(t, partial, 2)
+	require.Equal(t, "long", partial[0].Position.Party())
+	require.Equal(t, uint64(4), partial[0].Size)
+	require.Equal(t, "short", partial[1].Position.Party())
+	require.Equal(t, uint64(10), partial[1].Size)
+
+	now := time.Now()
+	eng.tSvc.EXPECT().GetTimeNow().Times(2).Return(now)
+	eng.idgen.EXPECT().NextID().Times(len(partial) * 3).Return("nextID")
+	// 2 orders per partial close-out
+	eng.broker.EXPECT().SendBatch(SliceLenMatcher[events.Event](2 * len(partial))).Times(1)
+	// 1 trade per partial close-out
+	eng.broker.EXPECT().SendBatch(SliceLenMatcher[events.Event](len(partial))).Times(1)
+	eng.broker.EXPECT().Send(gomock.Any()).Times(1).Do(func(evt events.Event) {
+		pco, ok := evt.(*events.PartialCloseOuts)
+		require.True(t, ok)
+		require.Len(t, pco.CloseOuts(), 2)
+		require.Equal(t, int64(4), pco.CloseOuts()[0].ClosedOutSize)
+		require.Equal(t, int64(6), pco.CloseOuts()[0].RemainingPosition)
+		require.Equal(t, int64(-10), pco.CloseOuts()[1].ClosedOutSize)
+		require.Equal(t, int64(-10), pco.CloseOuts()[1].RemainingPosition)
+	})
+	eng.pos.EXPECT().RegisterOrder(gomock.Any(), gomock.Any()).Times(2 * len(partial))
+	eng.pos.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(len(partial))
+	trades := eng.ClearPartialCloseOuts(ctx, eng.idgen, partial, num.NewUint(100), num.NewUint(100))
+	require.Len(t, trades, 2)
+	require.Equal(t, uint64(4), trades[0].Size)
+	require.Equal(t, types.NetworkParty, trades[0].Buyer)
+	require.Equal(t, uint64(10), trades[1].Size)
+	require.Equal(t, types.NetworkParty, trades[1].Seller)
+	// the network took over a long position of 4, and a short position of 10
+	require.Equal(t, int64(-6), eng.GetNetworkPosition().Size())
+
+	// without a partial close-out step, all parties are closed out in full
+	config.PartialCloseOutStep = num.DecimalZero()
+	eng.Update(config.DeepClone())
+	full, partial = eng.PartialCloseOuts(closed, maintenance)
+	require.Len(t, full, len(closed))
+	require.Empty(t, partial)
+}
+
+func TestAutoDeleveraging(t *testing.T) {
+	mID := "adlMkt"
+	ctx := vegacontext.WithTraceID(context.Background(), vgcrypto.RandomHash())
+	config := &types.LiquidationStrategy{
+		DisposalTimeStep:    5 * time.Second,
+		DisposalFraction:    num.DecimalFromFloat(0.1),
+		FullDisposalSize:    10,
+		MaxFractionConsumed: num.DecimalFromFloat(0.2),
+		AutoDeleveraging:    true,
+	}
+	eng := getTestEngine(t, mID, config.DeepClone())
+	defer eng.Finish()
+	require.True(t, eng.AutoDeleveraging())
+
+	// the network takes over a short position of 10
+	now := time.Now()
+	closed := []events.Margin{
+		createMarginEvent("distressed", mID, -10),
+	}
+	eng.tSvc.EXPECT().GetTimeNow().Times(2).Return(now)
+	eng.idgen.EXPECT().NextID().Times(3).Return("nextID")
+	eng.broker.EXPECT().SendBatch(SliceLenMatcher[events.Event](2)).Times(1)
+	eng.broker.EXPECT().SendBatch(SliceLenMatcher[events.Event](1)).Times(1)
+	eng.pos.EXPECT().RegisterOrder(gomock.Any(), gomock.Any()).Times(2)
+	eng.pos.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+	eng.ClearDistressedParties(ctx, eng.idgen, closed, num.NewUint(100), num.NewUint(100))
+	require.Equal(t, int64(-10), eng.GetNetworkPosition().Size())
+
+	mp := num.NewUint(120)
+	positions := []events.Margin{
+		// 20% profit, notional 960 on a margin of 100
+		&marginStub{party: "levered", market: mID, size: 8, margin: num.NewUint(100), entry: num.NewUint(100)},
+		// 20% profit, notional 1200 on a margin of 1000
+		&marginStub{party: "safe", market: mID, size: 10, margin: num.NewUint(1000), entry: num.NewUint(100)},
+		// at a loss, not ranked
+		&marginStub{party: "losing", market: mID, size: 10, margin: num.NewUint(100), entry: num.NewUint(150)},
+		// 20% profit on a short position
+		&marginStub{party: "short", market: mID, size: -5, margin: num.NewUint(100), entry: num.NewUint(150)},
+	}
+	long, short := eng.RankAutoDeleveraging(positions, mp, num.DecimalOne())
+	require.Len(t, long, 2)
+	require.Equal(t, "levered", long[0].Position.Party())
+	require.Equal(t, uint64(1), long[0].Rank)
+	require.Equal(t, "safe", long[1].Position.Party())
+	require.Equal(t, uint64(2), long[1].Rank)
+	require.True(t, long[0].Score.GreaterThan(long[1].Score))
+	require.Len(t, short, 1)
+	require.Equal(t, "short", short[0].Position.Party())
+
+	// the network is short, so the long positions are reduced: all of the first one, and 2 out of the second one
+	eng.tSvc.EXPECT().GetTimeNow().Times(1).Return(now)
+	eng.idgen.EXPECT().NextID().Times(len(long) * 3).Return("nextID")
+	eng.broker.EXPECT().SendBatch(SliceLenMatcher[events.Event](2 * len(long))).Times(1)
+	eng.broker.EXPECT().SendBatch(SliceLenMatcher[events.Event](len(long))).Times(1)
+	eng.broker.EXPECT().Send(gomock.Any()).Times(1).Do(func(evt events.Event) {
+		adl, ok := evt.(*events.AutoDeleveraging)
+		require.True(t, ok)
+		require.Len(t, adl.Positions(), 2)
+		require.Equal(t, int64(8), adl.Positions()[0].ReducedSize)
+		require.Equal(t, int64(0), adl.Positions()[0].RemainingPosition)
+		require.Equal(t, int64(2), adl.Positions()[1].ReducedSize)
+		require.Equal(t, int64(8), adl.Positions()[1].RemainingPosition)
+		require.Equal(t, mp.String(), adl.Positions()[1].Price)
+	})
+	eng.pos.EXPECT().RegisterOrder(gomock.Any(), gomock.Any()).Times(2 * len(long))
+	eng.pos.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(len(long))
+	trades := eng.AutoDeleverage(ctx, eng.idgen, long, mp, mp)
+	require.Len(t, trades, 2)
+	for _, trade := range trades {
+		require.Equal(t, types.TradeTypeAutoDeleveraging, trade.Type)
+		require.Equal(t, types.NetworkParty, trade.Buyer)
+		require.Equal(t, mp, trade.Price)
+	}
+	require.Equal(t, uint64(8), trades[0].Size)
+	require.Equal(t, uint64(2), trades[1].Size)
+	require.Equal(t, int64(0), eng.GetNetworkPosition().Size())
+	require.Equal(t, int64(0), eng.GetNextCloseoutTS())
+
+	// nothing left to reduce
+	require.Empty(t, eng.AutoDeleverage(ctx, eng.idgen, short, mp, mp))
+}
+
+func TestAutoDeleveragingBankruptcyPrice(t *testing.T) {
+	mID := "adlMkt"
+	ctx := vegacontext.WithTraceID(context.Background(), vgcrypto.RandomHash())
+	config := &types.LiquidationStrategy{
+		DisposalTimeStep:    5 * time.Second,
+		DisposalFraction:    num.DecimalFromFloat(0.1),
+		FullDisposalSize:    10,
+		MaxFractionConsumed: num.DecimalFromFloat(0.2),
+		DisposalSlippage:    num.DecimalFromFloat(0.1),
+		AutoDeleveraging:    true,
+	}
+	eng := getTestEngine(t, mID, config.DeepClone())
+	defer eng.Finish()
+
+	// without a network position, the bankruptcy price is the price the position was last settled at
+	settled := num.NewUint(100)
+	require.Equal(t, settled, eng.BankruptcyPrice(settled, num.NewUint(50), num.DecimalOne()))
+
+	// the network takes over a long position of 10
+	now := time.Now()
+	eng.tSvc.EXPECT().GetTimeNow().Times(2).Return(now)
+	eng.idgen.EXPECT().NextID().Times(3).Return("nextID")
+	eng.broker.EXPECT().SendBatch(gomock.Any()).Times(2)
+	eng.pos.EXPECT().RegisterOrder(gomock.Any(), gomock.Any()).Times(2)
+	eng.pos.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+	eng.ClearDistressedParties(ctx, eng.idgen, []events.Margin{createMarginEvent("distressed", mID, 10)}, settled, settled)
+
+	// with an empty insurance pool, the network cannot cover any loss
+	require.Equal(t, settled, eng.BankruptcyPrice(settled, num.UintZero(), num.DecimalOne()))
+	// a balance of 55 covers a price move of 5 on a long position of 10, rounded down
+	require.Equal(t, num.NewUint(95), eng.BankruptcyPrice(settled, num.NewUint(55), num.DecimalOne()))
+	// the position factor is taken into account
+	require.Equal(t, num.NewUint(50), eng.BankruptcyPrice(settled, num.NewUint(50), num.DecimalFromInt64(10)))
+	// the price cannot go below zero
+	require.True(t, eng.BankruptcyPrice(settled, num.NewUint(5000), num.DecimalOne()).IsZero())
+
+	// the book has no volume, the network cannot dispose of its position once the time step has passed
+	now = now.Add(config.DisposalTimeStep)
+	eng.as.EXPECT().InAuction().Times(2).Return(false)
+	order, err := eng.OnTick(ctx, now, num.UintZero())
+	require.ErrorIs(t, err, liquidation.ErrDisposalFailed)
+	require.Nil(t, order)
+	eng.pmon.EXPECT().GetValidPriceRange().Times(1).Return(
+		num.NewWrappedDecimal(num.UintZero(), num.DecimalZero()),
+		num.NewWrappedDecimal(num.MaxUint(), num.MaxDecimal()),
+	)
+	eng.book.EXPECT().GetVolumeAtPrice(gomock.Any(), gomock.Any()).Times(1).Return(uint64(0))
+	eng.amm.EXPECT().GetVolumeAtPrice(gomock.Any(), gomock.Any()).Times(1).Return(uint64(0))
+	order, err = eng.OnTick(ctx, now, settled)
+	require.ErrorIs(t, err, liquidation.ErrDisposalFailed)
+	require.Nil(t, order)
+}
+
+func TestAutoDeleveragingRankingChanges(t *testing.T) {
+	mID := "adlMkt"
+	ctx := vegacontext.WithTraceID(context.Background(), vgcrypto.RandomHash())
+	config := &types.LiquidationStrategy{
+		DisposalTimeStep:    5 * time.Second,
+		DisposalFraction:    num.DecimalFromFloat(0.1),
+		FullDisposalSize:    10,
+		MaxFractionConsumed: num.DecimalFromFloat(0.2),
+		AutoDeleveraging:    true,
+	}
+	eng := getTestEngine(t, mID, config.DeepClone())
+	defer eng.Finish()
+
+	mp := num.NewUint(120)
+	positions := []events.MarketPosition{
+		&marginStub{party: "long", market: mID, size: 8, margin: num.NewUint(100), entry: num.NewUint(100)},
+		&marginStub{party: "short", market: mID, size: -8, margin: num.NewUint(100), entry: num.NewUint(150)},
+	}
+	margins := []events.Margin{positions[0].(events.Margin), positions[1].(events.Margin)}
+
+	// the first ranking is always computed and sent
+	require.True(t, eng.AutoDeleveragingRankingStale(positions, mp))
+	long, short := eng.RankAutoDeleveraging(margins, mp, num.DecimalOne())
+	eng.broker.EXPECT().Send(gomock.Any()).Times(1)
+	eng.SendAutoDeleveragingRankings(ctx, long, short)
+
+	// nothing changed, the ranking is not computed again
+	require.False(t, eng.AutoDeleveragingRankingStale(positions, mp.Clone()))
+
+	// the positions did not change, but the mark price did
+	mp = num.NewUint(125)
+	require.True(t, eng.AutoDeleveragingRankingStale(positions, mp))
+	require.False(t, eng.AutoDeleveragingRankingStale(positions, mp))
+
+	// a position changed
+	positions[0] = &marginStub{party: "long", market: mID, size: 4, margin: num.NewUint(100), entry: num.NewUint(100)}
+	require.True(t, eng.AutoDeleveragingRankingStale(positions, mp))
+
+	// the same ranking is not sent out twice
+	long, short = eng.RankAutoDeleveraging(margins, num.NewUint(120), num.DecimalOne())
+	eng.SendAutoDeleveragingRankings(ctx, long, short)
+	long, short = eng.RankAutoDeleveraging(margins, mp, num.DecimalOne())
+	eng.broker.EXPECT().Send(gomock.Any()).Times(1)
+	eng.SendAutoDeleveragingRankings(ctx, long, short)
+}
+
 func createMarginEvent(party, market string, size int64) events.Margin {
 	return &marginStub{
 		party:  party,
@@ -633,7 +891,7 @@ func (m *marginStub) VWSell() *num.Uint {
 }
 
 func (m *marginStub) AverageEntryPrice() *num.Uint {
-	return nil
+	return m.entry
 }
 
 func (m *marginStub) Asset() string {
@@ -641,7 +899,7 @@ func (m *marginStub) Asset() string {
 }
 
 func (m *marginStub) MarginBalance() *num.Uint {
-	return nil
+	return m.margin
 }
 
 func (m *marginStub) OrderMarginBalance() *num.Uint {
@@ -649,7 +907,7 @@ func (m *marginStub) OrderMarginBalance() *num.Uint {
 }
 
 func (m *marginStub) GeneralBalance() *num.Uint {
-	return nil
+	return m.general
 }
 
 func (m *marginStub) GeneralAccountBalance() *num.Uint {
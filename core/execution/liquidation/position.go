@@ -16,6 +16,7 @@
 package liquidation
 
 import (
+	"code.vegaprotocol.io/vega/core/events"
 	"code.vegaprotocol.io/vega/core/types"
 	"code.vegaprotocol.io/vega/libs/num"
 )
@@ -70,3 +71,13 @@ func (p *Pos) AverageEntryPrice() *num.Uint {
 	}
 	return num.UintZero() // shouldn't be used
 }
+
+// reducedPos is a distressed position as it would be after part of it is transferred to the network.
+type reducedPos struct {
+	events.Margin
+	size int64
+}
+
+func (r reducedPos) Size() int64 {
+	return r.size
+}
@@ -0,0 +1,155 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution
+
+import (
+	"context"
+	"fmt"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/execution/common"
+	"code.vegaprotocol.io/vega/core/execution/future"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/crypto"
+	"code.vegaprotocol.io/vega/logging"
+)
+
+// predictionOutcomeMarkets returns the markets to create for the market configuration. A prediction market proposal creates one
+// outcome market per outcome, the first one using the ID of the proposal, which is also the ID of the prediction, and the others
+// an ID derived from it and their index.
+func predictionOutcomeMarkets(marketConfig *types.Market) []*types.Market {
+	outcome := marketConfig.GetPredictionOutcome()
+	if outcome == nil || len(outcome.PredictionID) > 0 {
+		return []*types.Market{marketConfig}
+	}
+
+	markets := make([]*types.Market, 0, len(outcome.Outcomes))
+	for i, name := range outcome.Outcomes {
+		mkt := marketConfig.DeepClone()
+		if i > 0 {
+			mkt.ID = crypto.HashStrToHex(fmt.Sprintf("%s-%d", marketConfig.ID, i))
+		}
+		// the product is not deep cloned with the market
+		product := *marketConfig.GetFuture().Future
+		product.PredictionOutcome = &types.PredictionOutcome{
+			PredictionID: marketConfig.ID,
+			Index:        uint32(i),
+			Outcomes:     outcome.DeepClone().Outcomes,
+		}
+		mkt.TradableInstrument.Instrument.Product = &types.InstrumentFuture{Future: &product}
+		mkt.TradableInstrument.Instrument.Name = fmt.Sprintf("%s - %s", mkt.TradableInstrument.Instrument.Name, name)
+		mkt.TradableInstrument.Instrument.Code = fmt.Sprintf("%s-%s", mkt.TradableInstrument.Instrument.Code, name)
+		markets = append(markets, mkt)
+	}
+	return markets
+}
+
+// trackPredictionOutcome keeps track of the outcome markets of the predictions, which share the insurance pool of the prediction.
+func (e *Engine) trackPredictionOutcome(marketConfig *types.Market) {
+	outcome := marketConfig.GetPredictionOutcome()
+	if outcome == nil {
+		return
+	}
+	markets, ok := e.predictions[outcome.PredictionID]
+	if !ok {
+		markets = make([]string, len(outcome.Outcomes))
+		e.predictions[outcome.PredictionID] = markets
+	}
+	markets[outcome.Index] = marketConfig.ID
+	e.collateral.ShareInsurancePool(marketConfig.ID, outcome.PredictionID)
+}
+
+// untrackPredictionOutcome stops tracking the prediction of the market once none of its outcome markets are left.
+func (e *Engine) untrackPredictionOutcome(marketConfig *types.Market) {
+	outcome := marketConfig.GetPredictionOutcome()
+	if outcome == nil {
+		return
+	}
+	for _, id := range e.predictions[outcome.PredictionID] {
+		if _, ok := e.allMarkets[id]; ok {
+			return
+		}
+	}
+	delete(e.predictions, outcome.PredictionID)
+}
+
+// predictionMarketIDs returns the IDs of the outcome markets if the market ID is the ID of a prediction, or the market ID otherwise.
+func (e *Engine) predictionMarketIDs(marketID string) []string {
+	if markets, ok := e.predictions[marketID]; ok {
+		return append([]string{}, markets...)
+	}
+	return []string{marketID}
+}
+
+// SubmitCompleteSet mints or redeems complete sets of the outcome contracts of a prediction. Minting locks up the collateral
+// one of the outcomes is worth at settlement in the insurance pool of the prediction, against a long position in every outcome
+// market, redeeming gives the collateral back against the positions.
+func (e *Engine) SubmitCompleteSet(ctx context.Context, sub *types.CompleteSetSubmission, party string, idgen common.IDGenerator) error {
+	ids, ok := e.predictions[sub.PredictionID]
+	if !ok {
+		return common.ErrPredictionDoesNotExist
+	}
+	markets := make([]*future.Market, 0, len(ids))
+	for _, id := range ids {
+		mkt, ok := e.futureMarkets[id]
+		if !ok {
+			return common.ErrCompleteSetNotAllowed
+		}
+		if err := mkt.CheckCompleteSet(party, sub.Size, sub.Action); err != nil {
+			return err
+		}
+		markets = append(markets, mkt)
+	}
+
+	transfer := &types.Transfer{
+		Owner: party,
+		Amount: &types.FinancialAmount{
+			Amount: markets[0].CompleteSetValue(sub.Size),
+			Asset:  markets[0].GetSettlementAsset(),
+		},
+		Type: types.TransferTypeCompleteSetMint,
+	}
+	if sub.Action == types.CompleteSetActionMint {
+		ledgerMovement, err := e.collateral.CompleteSetUpdate(ctx, sub.PredictionID, transfer)
+		if err != nil {
+			return err
+		}
+		e.broker.Send(events.NewLedgerMovements(ctx, []*types.LedgerMovement{ledgerMovement}))
+	}
+
+	for _, mkt := range markets {
+		// the checks above make sure every outcome can be traded
+		if err := mkt.CompleteSetTrade(ctx, party, sub.Size, sub.Action, idgen); err != nil {
+			e.log.Panic("unable to trade complete sets",
+				logging.MarketID(mkt.GetID()),
+				logging.PartyID(party),
+				logging.Error(err))
+		}
+	}
+
+	if sub.Action == types.CompleteSetActionRedeem {
+		transfer.Type = types.TransferTypeCompleteSetRedeem
+		ledgerMovement, err := e.collateral.CompleteSetUpdate(ctx, sub.PredictionID, transfer)
+		if err != nil {
+			e.log.Panic("unable to redeem complete sets",
+				logging.String("prediction-id", sub.PredictionID),
+				logging.PartyID(party),
+				logging.Error(err))
+		}
+		e.broker.Send(events.NewLedgerMovements(ctx, []*types.LedgerMovement{ledgerMovement}))
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	dstypes "code.vegaprotocol.io/vega/core/datasource/common"
+	"code.vegaprotocol.io/vega/core/execution/common"
+	"code.vegaprotocol.io/vega/core/idgeneration"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/crypto"
+	"code.vegaprotocol.io/vega/libs/num"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPredictionMarkets(t *testing.T) {
+	engine, ctrl := createEngine(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	pubKey := &dstypes.SignerPubKey{
+		PubKey: &dstypes.PubKey{
+			Key: "0xDEADBEEF",
+		},
+	}
+	predictionID := crypto.RandomHash()
+	mkt := newMarketWithAuctionDuration(predictionID, pubKey, &types.AuctionDuration{Duration: 10})
+	mkt.TradableInstrument.MarginCalculator.FullyCollateralised = true
+	future := mkt.GetFuture().Future
+	future.Cap = &types.FutureCap{
+		MaxPrice:            num.NewUint(100),
+		Binary:              true,
+		FullyCollateralised: true,
+	}
+	outcomes := []string{"yes", "no", "maybe"}
+	future.PredictionOutcome = &types.PredictionOutcome{Outcomes: outcomes}
+
+	require.NoError(t, engine.SubmitMarket(ctx, mkt, "zohar", time.Now()))
+
+	// one market is created per outcome, the first one using the ID of the prediction
+	ids := []string{predictionID, crypto.HashStrToHex(predictionID + "-1"), crypto.HashStrToHex(predictionID + "-2")}
+	for i, id := range ids {
+		outcomeMkt, ok := engine.GetMarket(id, false)
+		require.True(t, ok)
+		outcome := outcomeMkt.GetPredictionOutcome()
+		require.NotNil(t, outcome)
+		require.Equal(t, predictionID, outcome.PredictionID)
+		require.Equal(t, uint32(i), outcome.Index)
+		require.Equal(t, outcomes, outcome.Outcomes)
+		require.Equal(t, fmt.Sprintf("%s - %s", mkt.TradableInstrument.Instrument.Name, outcomes[i]), outcomeMkt.TradableInstrument.Instrument.Name)
+	}
+	// the market configuration submitted is left untouched
+	require.Empty(t, future.PredictionOutcome.PredictionID)
+
+	idgen := idgeneration.New(crypto.RandomHash())
+	sub := &types.CompleteSetSubmission{
+		PredictionID: crypto.RandomHash(),
+		Size:         10,
+		Action:       types.CompleteSetActionMint,
+	}
+	require.ErrorIs(t, engine.SubmitCompleteSet(ctx, sub, "party", idgen), common.ErrPredictionDoesNotExist)
+
+	// the opening auction starts on every outcome market, where complete sets can't be traded
+	require.NoError(t, engine.StartOpeningAuction(ctx, predictionID))
+	for _, id := range ids {
+		state, err := engine.GetMarketState(id)
+		require.NoError(t, err)
+		require.Equal(t, types.MarketStatePending, state)
+	}
+	sub.PredictionID = predictionID
+	require.ErrorIs(t, engine.SubmitCompleteSet(ctx, sub, "party", idgen), common.ErrCompleteSetNotAllowed)
+}
@@ -0,0 +1,247 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/execution/common"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/logging"
+	"code.vegaprotocol.io/vega/protos/vega"
+	snapshot "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+
+	"golang.org/x/exp/maps"
+)
+
+// SubmitQuoteRequest opens a request for quote on a futures or perpetual market. It stays open
+// until a quote is accepted, it is cancelled, or it expires.
+func (e *Engine) SubmitQuoteRequest(ctx context.Context, sub *types.QuoteRequestSubmission, party, requestID string) error {
+	if _, ok := e.futureMarkets[sub.MarketID]; !ok {
+		if _, ok := e.allMarkets[sub.MarketID]; ok {
+			return common.ErrBlockTradeMarketNotSupported
+		}
+		return ErrMarketDoesNotExist
+	}
+
+	now := e.timeService.GetTimeNow()
+	qr := &types.QuoteRequest{
+		ID:        requestID,
+		MarketID:  sub.MarketID,
+		Party:     party,
+		Side:      sub.Side,
+		Size:      sub.Size,
+		Status:    types.QuoteRequestStatusOpen,
+		CreatedAt: now,
+		ExpiresAt: now.Add(sub.ExpiresIn),
+		UpdatedAt: now,
+		Reference: sub.Reference,
+	}
+	e.quoteRequests[qr.ID] = qr
+	e.broker.Send(events.NewQuoteRequestEvent(ctx, qr))
+	return nil
+}
+
+// SubmitQuote quotes a price on an open request for quote. A party has at most one active quote
+// per request, quoting again replaces the previous one.
+func (e *Engine) SubmitQuote(ctx context.Context, sub *types.QuoteSubmission, party, quoteID string) error {
+	qr, ok := e.quoteRequests[sub.QuoteRequestID]
+	if !ok {
+		return common.ErrQuoteRequestNotFound
+	}
+	if qr.Party == party {
+		return common.ErrCannotQuoteOwnRequest
+	}
+
+	now := e.timeService.GetTimeNow()
+	if previous := e.activeQuote(qr.ID, party); previous != nil {
+		e.closeQuote(ctx, previous, types.QuoteStatusReplaced, now)
+	}
+
+	// a quote never outlives its request
+	expiresAt := now.Add(sub.ExpiresIn)
+	if expiresAt.After(qr.ExpiresAt) {
+		expiresAt = qr.ExpiresAt
+	}
+	q := &types.Quote{
+		ID:             quoteID,
+		QuoteRequestID: qr.ID,
+		MarketID:       qr.MarketID,
+		Party:          party,
+		Price:          sub.Price,
+		Status:         types.QuoteStatusActive,
+		CreatedAt:      now,
+		ExpiresAt:      expiresAt,
+		UpdatedAt:      now,
+	}
+	e.quotes[q.ID] = q
+	e.broker.Send(events.NewQuoteEvent(ctx, q))
+	return nil
+}
+
+// AcceptQuote executes the block trade between the requesting party and the quoting party at the
+// quoted price. If the quoting party cannot cover the margin for the trade, its quote is rejected
+// and the request stays open for the other quotes. Any other error leaves the quote active.
+func (e *Engine) AcceptQuote(ctx context.Context, quoteID, party string, idgen common.IDGenerator) (*types.Trade, error) {
+	defer e.reassessPortfolio(ctx)
+
+	q, ok := e.quotes[quoteID]
+	if !ok {
+		return nil, common.ErrQuoteNotFound
+	}
+	qr := e.quoteRequests[q.QuoteRequestID]
+	if qr.Party != party {
+		return nil, common.ErrQuoteRequestNotOwned
+	}
+	mkt, ok := e.futureMarkets[qr.MarketID]
+	if !ok {
+		return nil, ErrMarketDoesNotExist
+	}
+
+	trade, err := mkt.SubmitBlockTrade(ctx, qr.Party, q.Party, qr.Side, qr.Size, q.Price, qr.Reference, idgen)
+	now := e.timeService.GetTimeNow()
+	if err != nil {
+		if errors.Is(err, common.ErrBlockTradeMakerMarginCheckFailed) || errors.Is(err, common.ErrBlockTradeMakerPositionLimitExceeded) {
+			e.closeQuote(ctx, q, types.QuoteStatusRejected, now)
+		}
+		return nil, err
+	}
+
+	e.closeQuote(ctx, q, types.QuoteStatusAccepted, now)
+	qr.AcceptedQuoteID = q.ID
+	qr.TradeID = trade.ID
+	e.closeQuoteRequest(ctx, qr, types.QuoteRequestStatusFilled, now)
+	return trade, nil
+}
+
+// CancelQuoteRequest cancels one of the party's open requests for quote, and all the quotes made on it.
+func (e *Engine) CancelQuoteRequest(ctx context.Context, requestID, party string) error {
+	qr, ok := e.quoteRequests[requestID]
+	if !ok {
+		return common.ErrQuoteRequestNotFound
+	}
+	if qr.Party != party {
+		return common.ErrQuoteRequestNotOwned
+	}
+	e.closeQuoteRequest(ctx, qr, types.QuoteRequestStatusCancelled, e.timeService.GetTimeNow())
+	return nil
+}
+
+// checkQuoteRequests expires the requests for quote and the quotes which reached their expiry,
+// and cancels the requests on markets which are no longer trading.
+func (e *Engine) checkQuoteRequests(ctx context.Context, now time.Time) {
+	if len(e.quoteRequests) == 0 {
+		return
+	}
+
+	for _, id := range sortedKeys(e.quoteRequests) {
+		qr := e.quoteRequests[id]
+		if _, ok := e.futureMarkets[qr.MarketID]; !ok {
+			e.closeQuoteRequest(ctx, qr, types.QuoteRequestStatusCancelled, now)
+		} else if !qr.ExpiresAt.After(now) {
+			e.closeQuoteRequest(ctx, qr, types.QuoteRequestStatusExpired, now)
+		}
+	}
+
+	for _, id := range sortedKeys(e.quotes) {
+		if q := e.quotes[id]; !q.ExpiresAt.After(now) {
+			e.closeQuote(ctx, q, types.QuoteStatusExpired, now)
+		}
+	}
+}
+
+// activeQuote returns the party's active quote on the request, if any.
+func (e *Engine) activeQuote(requestID, party string) *types.Quote {
+	for _, q := range e.quotes {
+		if q.QuoteRequestID == requestID && q.Party == party {
+			return q
+		}
+	}
+	return nil
+}
+
+// closeQuoteRequest closes the request for quote, cancelling all the quotes still active on it.
+func (e *Engine) closeQuoteRequest(ctx context.Context, qr *types.QuoteRequest, status types.QuoteRequestStatus, now time.Time) {
+	for _, id := range sortedKeys(e.quotes) {
+		if q := e.quotes[id]; q.QuoteRequestID == qr.ID {
+			e.closeQuote(ctx, q, types.QuoteStatusCancelled, now)
+		}
+	}
+	delete(e.quoteRequests, qr.ID)
+	qr.Status = status
+	qr.UpdatedAt = now
+	e.broker.Send(events.NewQuoteRequestEvent(ctx, qr))
+
+	if e.log.IsDebug() {
+		e.log.Debug("request for quote closed",
+			logging.String("quote-request-id", qr.ID),
+			logging.String("status", status.String()))
+	}
+}
+
+func (e *Engine) closeQuote(ctx context.Context, q *types.Quote, status types.QuoteStatus, now time.Time) {
+	delete(e.quotes, q.ID)
+	q.Status = status
+	q.UpdatedAt = now
+	e.broker.Send(events.NewQuoteEvent(ctx, q))
+}
+
+func (e *Engine) serialiseRequestsForQuote() *snapshot.RequestsForQuote {
+	if len(e.quoteRequests) == 0 {
+		return nil
+	}
+
+	state := &snapshot.RequestsForQuote{
+		QuoteRequests: make([]*vega.QuoteRequest, 0, len(e.quoteRequests)),
+		Quotes:        make([]*vega.Quote, 0, len(e.quotes)),
+	}
+	for _, id := range sortedKeys(e.quoteRequests) {
+		state.QuoteRequests = append(state.QuoteRequests, e.quoteRequests[id].IntoProto())
+	}
+	for _, id := range sortedKeys(e.quotes) {
+		state.Quotes = append(state.Quotes, e.quotes[id].IntoProto())
+	}
+	return state
+}
+
+func (e *Engine) restoreRequestsForQuote(state *snapshot.RequestsForQuote) error {
+	if state == nil {
+		return nil
+	}
+
+	for _, qr := range state.QuoteRequests {
+		e.quoteRequests[qr.Id] = types.QuoteRequestFromProto(qr)
+	}
+	for _, pq := range state.Quotes {
+		q, err := types.QuoteFromProto(pq)
+		if err != nil {
+			return err
+		}
+		e.quotes[q.ID] = q
+	}
+	return nil
+}
+
+// sortedKeys returns the IDs of the requests for quote, or quotes, in a deterministic order.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := maps.Keys(m)
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,201 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dstypes "code.vegaprotocol.io/vega/core/datasource/common"
+	"code.vegaprotocol.io/vega/core/execution"
+	"code.vegaprotocol.io/vega/core/execution/common"
+	"code.vegaprotocol.io/vega/core/idgeneration"
+	"code.vegaprotocol.io/vega/core/types"
+	vgcontext "code.vegaprotocol.io/vega/libs/context"
+	"code.vegaprotocol.io/vega/libs/crypto"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/proto"
+	snapshot "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestForQuote(t *testing.T) {
+	t.Run("requests for quote are only accepted on existing markets", testQuoteRequestUnknownMarket)
+	t.Run("quoting again replaces the previous quote", testQuoteReplaced)
+	t.Run("a party cannot quote on its own request", testQuoteOwnRequest)
+	t.Run("only the requesting party can cancel or accept", testQuoteRequestNotOwned)
+	t.Run("cancelling a request cancels its quotes", testCancelQuoteRequest)
+	t.Run("a quote cannot be accepted while the market is in auction", testAcceptQuoteInAuction)
+	t.Run("snapshot", testQuoteRequestSnapshot)
+}
+
+func getRFQEngine(t *testing.T) *execution.Engine {
+	t.Helper()
+	engine, ctrl := createEngine(t)
+	t.Cleanup(ctrl.Finish)
+
+	pubKey := &dstypes.SignerPubKey{
+		PubKey: &dstypes.PubKey{
+			Key: "0xDEADBEEF",
+		},
+	}
+	require.NoError(t, engine.SubmitMarket(context.Background(), newMarket("market", pubKey), "zohar", time.Now()))
+	return engine
+}
+
+func submitQuoteRequest(t *testing.T, engine *execution.Engine, party string) string {
+	t.Helper()
+	id := crypto.RandomHash()
+	require.NoError(t, engine.SubmitQuoteRequest(context.Background(), &types.QuoteRequestSubmission{
+		MarketID:  "market",
+		Side:      types.SideBuy,
+		Size:      10,
+		ExpiresIn: time.Minute,
+	}, party, id))
+	return id
+}
+
+func submitQuote(t *testing.T, engine *execution.Engine, requestID, party string, price uint64) string {
+	t.Helper()
+	id := crypto.RandomHash()
+	require.NoError(t, engine.SubmitQuote(context.Background(), &types.QuoteSubmission{
+		QuoteRequestID: requestID,
+		Price:          num.NewUint(price),
+		ExpiresIn:      time.Minute,
+	}, party, id))
+	return id
+}
+
+func testQuoteRequestUnknownMarket(t *testing.T) {
+	engine := getRFQEngine(t)
+
+	err := engine.SubmitQuoteRequest(context.Background(), &types.QuoteRequestSubmission{
+		MarketID:  "unknown",
+		Side:      types.SideBuy,
+		Size:      10,
+		ExpiresIn: time.Minute,
+	}, "taker", crypto.RandomHash())
+	require.ErrorIs(t, err, execution.ErrMarketDoesNotExist)
+}
+
+func testQuoteReplaced(t *testing.T) {
+	ctx := context.Background()
+	engine := getRFQEngine(t)
+
+	requestID := submitQuoteRequest(t, engine, "taker")
+	first := submitQuote(t, engine, requestID, "maker", 100)
+	second := submitQuote(t, engine, requestID, "maker", 101)
+
+	// the first quote is no longer active so it cannot be accepted anymore
+	_, err := engine.AcceptQuote(ctx, first, "taker", idgeneration.New(crypto.RandomHash()))
+	require.ErrorIs(t, err, common.ErrQuoteNotFound)
+
+	_, err = engine.AcceptQuote(ctx, second, "taker", idgeneration.New(crypto.RandomHash()))
+	require.NotErrorIs(t, err, common.ErrQuoteNotFound)
+}
+
+func testQuoteOwnRequest(t *testing.T) {
+	engine := getRFQEngine(t)
+
+	requestID := submitQuoteRequest(t, engine, "taker")
+	err := engine.SubmitQuote(context.Background(), &types.QuoteSubmission{
+		QuoteRequestID: requestID,
+		Price:          num.NewUint(100),
+		ExpiresIn:      time.Minute,
+	}, "taker", crypto.RandomHash())
+	require.ErrorIs(t, err, common.ErrCannotQuoteOwnRequest)
+}
+
+func testQuoteRequestNotOwned(t *testing.T) {
+	ctx := context.Background()
+	engine := getRFQEngine(t)
+
+	requestID := submitQuoteRequest(t, engine, "taker")
+	quoteID := submitQuote(t, engine, requestID, "maker", 100)
+
+	_, err := engine.AcceptQuote(ctx, quoteID, "maker", idgeneration.New(crypto.RandomHash()))
+	require.ErrorIs(t, err, common.ErrQuoteRequestNotOwned)
+	require.ErrorIs(t, engine.CancelQuoteRequest(ctx, requestID, "maker"), common.ErrQuoteRequestNotOwned)
+}
+
+func testCancelQuoteRequest(t *testing.T) {
+	ctx := context.Background()
+	engine := getRFQEngine(t)
+
+	requestID := submitQuoteRequest(t, engine, "taker")
+	quoteID := submitQuote(t, engine, requestID, "maker", 100)
+
+	require.NoError(t, engine.CancelQuoteRequest(ctx, requestID, "taker"))
+	require.ErrorIs(t, engine.CancelQuoteRequest(ctx, requestID, "taker"), common.ErrQuoteRequestNotFound)
+
+	_, err := engine.AcceptQuote(ctx, quoteID, "taker", idgeneration.New(crypto.RandomHash()))
+	require.ErrorIs(t, err, common.ErrQuoteNotFound)
+
+	err = engine.SubmitQuote(ctx, &types.QuoteSubmission{
+		QuoteRequestID: requestID,
+		Price:          num.NewUint(100),
+		ExpiresIn:      time.Minute,
+	}, "maker", crypto.RandomHash())
+	require.ErrorIs(t, err, common.ErrQuoteRequestNotFound)
+}
+
+func testAcceptQuoteInAuction(t *testing.T) {
+	ctx := vgcontext.WithTraceID(context.Background(), crypto.RandomHash())
+	engine := getRFQEngine(t)
+
+	requestID := submitQuoteRequest(t, engine, "taker")
+	quoteID := submitQuote(t, engine, requestID, "maker", 100)
+
+	// the market is still in its opening auction
+	_, err := engine.AcceptQuote(ctx, quoteID, "taker", idgeneration.New(crypto.RandomHash()))
+	require.ErrorIs(t, err, common.ErrTradingNotAllowed)
+
+	// the quote is still active and can be accepted later on
+	_, err = engine.AcceptQuote(ctx, quoteID, "taker", idgeneration.New(crypto.RandomHash()))
+	require.ErrorIs(t, err, common.ErrTradingNotAllowed)
+}
+
+func testQuoteRequestSnapshot(t *testing.T) {
+	ctx := vgcontext.WithTraceID(context.Background(), crypto.RandomHash())
+	engine := getRFQEngine(t)
+
+	requestID := submitQuoteRequest(t, engine, "taker")
+	submitQuote(t, engine, requestID, "maker1", 100)
+	submitQuote(t, engine, requestID, "maker2", 99)
+
+	key := engine.Keys()[0]
+	b, _, err := engine.GetState(key)
+	require.NoError(t, err)
+
+	snap := &snapshot.Payload{}
+	require.NoError(t, proto.Unmarshal(b, snap))
+	rfqs := snap.GetExecutionMarkets().RequestsForQuote
+	require.Len(t, rfqs.QuoteRequests, 1)
+	require.Len(t, rfqs.Quotes, 2)
+	assert.Equal(t, requestID, rfqs.QuoteRequests[0].Id)
+
+	engine2, ctrl := createEngine(t)
+	defer ctrl.Finish()
+	_, err = engine2.LoadState(ctx, types.PayloadFromProto(snap))
+	require.NoError(t, err)
+
+	b2, _, err := engine2.GetState(key)
+	require.NoError(t, err)
+	assert.Equal(t, b, b2)
+}
@@ -117,6 +117,11 @@ func (m *Market) checkAuction(ctx context.Context, now time.Time, idgen common.I
 		}
 	}
 
+	// the current batch has expired, the book can be uncrossed unless price monitoring extends the batch
+	if m.as.IsFBA() && m.as.ExtensionTrigger() != types.AuctionTriggerGovernanceSuspension {
+		m.as.SetReadyToLeave()
+	}
+
 	isPrice := m.as.IsPriceAuction() || m.as.IsPriceExtension()
 	if isPrice || m.as.CanLeave() {
 		m.pMonitor.CheckPrice(ctx, m.as, indicativeUncrossingPrice, true, true)
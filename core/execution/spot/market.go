@@ -25,6 +25,7 @@ import (
 
 	"code.vegaprotocol.io/vega/core/assets"
 	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/execution/amm"
 	"code.vegaprotocol.io/vega/core/execution/common"
 	"code.vegaprotocol.io/vega/core/execution/stoporders"
 	"code.vegaprotocol.io/vega/core/fee"
@@ -85,6 +86,10 @@ type Market struct {
 	volumeRebateService           fee.VolumeRebateService
 	liquidity                     *common.MarketLiquidity
 	liquidityEngine               common.LiquidityEngine
+	amm                           *amm.Engine
+
+	// parties' protection against their orders being filled too fast
+	mmProtections *common.MarketMakerProtections
 
 	// deps engines
 	collateral common.Collateral
@@ -162,6 +167,7 @@ func NewMarket(
 	volumeDiscountService fee.VolumeDiscountService,
 	volumeRebateService fee.VolumeRebateService,
 	banking common.Banking,
+	parties common.Parties,
 ) (*Market, error) {
 	if len(mkt.ID) == 0 {
 		return nil, common.ErrEmptyMarketID
@@ -185,6 +191,8 @@ func NewMarket(
 
 	baseAsset := assets[BaseAssetIndex]
 	quoteAsset := assets[QuoteAssetIndex]
+	ammEngine := amm.NewSpot(log, broker, collateralEngine, mkt.GetID(), quoteAsset, baseAsset, priceFactor, positionFactor, baseFactor, marketActivityTracker, parties)
+	book.SetOffbookSource(ammEngine)
 	feeEngine, err := fee.New(log, feeConfig, *mkt.Fees, quoteAsset, positionFactor)
 	if err != nil {
 		return nil, fmt.Errorf("unable to instantiate fee engine: %w", err)
@@ -221,8 +229,7 @@ func NewMarket(
 	mkt.MarketTimestamps = ts
 	liquidity := liquidity.NewSnapshotEngine(liquidityConfig, log, timeService, broker, riskModel, pMonitor, book, as, quoteAsset, mkt.ID, stateVarEngine, positionFactor, mkt.LiquiditySLAParams)
 	els := common.NewEquityShares(num.DecimalZero())
-	// @TODO pass in AMM
-	marketLiquidity := common.NewMarketLiquidity(log, liquidity, collateralEngine, broker, book, els, marketActivityTracker, feeEngine, common.SpotMarketType, mkt.ID, quoteAsset, priceFactor, mkt.LiquiditySLAParams.PriceRange, nil)
+	marketLiquidity := common.NewMarketLiquidity(log, liquidity, collateralEngine, broker, book, els, marketActivityTracker, feeEngine, common.SpotMarketType, mkt.ID, quoteAsset, priceFactor, mkt.LiquiditySLAParams.PriceRange, ammEngine)
 	market := &Market{
 		log:                           log,
 		idgen:                         nil,
@@ -240,9 +247,11 @@ func NewMarket(
 		pMonitor:                      pMonitor,
 		liquidity:                     marketLiquidity,
 		liquidityEngine:               liquidity,
+		amm:                           ammEngine,
 		tsCalc:                        tsCalc,
 		peggedOrders:                  common.NewPeggedOrders(log, timeService),
 		expiringOrders:                common.NewExpiringOrders(),
+		mmProtections:                 common.NewMarketMakerProtections(),
 		feeSplitter:                   common.NewFeeSplitter(),
 		equityShares:                  els,
 		lastBestAskPrice:              num.UintZero(),
@@ -265,6 +274,8 @@ func NewMarket(
 		banking:                       banking,
 	}
 	liquidity.SetGetStaticPricesFunc(market.getBestStaticPricesDecimal)
+	book.SetMarkPriceSource(market.getCurrentMarkPrice)
+	book.SetMatchingAlgorithm(mkt.MatchingAlgorithm)
 	return market, nil
 }
 
@@ -304,6 +315,8 @@ func (m *Market) Update(ctx context.Context, config *types.Market) error {
 	m.pMonitor.UpdateSettings(riskModel, m.mkt.PriceMonitoringSettings, m.as)
 	m.liquidity.UpdateMarketConfig(riskModel, m.pMonitor)
 	m.updateLiquidityFee(ctx)
+	m.updateFrequentBatchAuction(ctx)
+	m.matching.SetMatchingAlgorithm(m.mkt.MatchingAlgorithm)
 
 	if tickSizeChanged {
 		tickSizeInAsset, _ := num.UintFromDecimal(m.mkt.TickSize.ToDecimal().Mul(m.priceFactor))
@@ -339,10 +352,9 @@ func (m *Market) GetEquityShares() *common.EquityShares {
 
 func (m *Market) GetEquitySharesForParty(partyID string) num.Decimal {
 	primary := m.equityShares.SharesFromParty(partyID)
-	// AMM for spot has not been implemented yet
-	// if sub, err := m.amm.GetAMMParty(partyID); err == nil {
-	// return primary.Add(m.equityShares.SharesFromParty(sub))
-	// }
+	if sub, err := m.amm.GetAMMParty(partyID); err == nil {
+		return primary.Add(m.equityShares.SharesFromParty(sub))
+	}
 	return primary
 }
 
@@ -530,6 +542,18 @@ func (m *Market) EnterLongBlockAuction(ctx context.Context, duration int64) {
 	}
 }
 
+// updateFrequentBatchAuction applies a change to the frequent batch auction configuration. A market switched to frequent
+// batch auctions goes into its first batch straight away, a market switched back to continuous trading does so once its
+// current batch ends.
+func (m *Market) updateFrequentBatchAuction(ctx context.Context) {
+	if m.mkt.FrequentBatchAuction == nil {
+		m.as.UpdateDefaultMode(types.MarketTradingModeContinuous)
+		return
+	}
+	m.as.UpdateDefaultMode(types.MarketTradingModeBatchAuction)
+	m.checkBatchAuction(ctx, m.timeService.GetTimeNow())
+}
+
 func (m *Market) UpdateMarketState(ctx context.Context, changes *types.MarketStateUpdateConfiguration) error {
 	_, blockHash := vegacontext.TraceIDFromContext(ctx)
 	// make deterministic ID for this market, concatenate
@@ -566,6 +590,9 @@ func (m *Market) UpdateMarketState(ctx context.Context, changes *types.MarketSta
 			if m.as.GetState().Trigger == types.AuctionTriggerOpening {
 				m.mkt.State = types.MarketStatePending
 				m.mkt.TradingMode = types.MarketTradingModeOpeningAuction
+			} else if m.as.IsFBA() {
+				m.mkt.State = types.MarketStateActive
+				m.mkt.TradingMode = types.MarketTradingModeBatchAuction
 			} else {
 				m.mkt.State = types.MarketStateSuspended
 				m.mkt.TradingMode = types.MarketTradingModeMonitoringAuction
@@ -694,10 +721,13 @@ func (m *Market) OnTick(ctx context.Context, t time.Time) bool {
 	}
 
 	m.checkAuction(ctx, t, m.idgen)
+	m.mmProtections.OnTick(t)
+	m.applyMarketMakerProtections(ctx)
 	timer.EngineTimeCounterAdd()
 	m.updateMarketValueProxy()
 	m.updateLiquidityFee(ctx)
 	m.liquidity.OnTick(ctx, t)
+	m.amm.OnTick(ctx, t)
 	m.broker.Send(events.NewMarketTick(ctx, m.mkt.ID, t))
 	return m.closed
 }
@@ -735,6 +765,10 @@ func (m *Market) BlockEnd(ctx context.Context) {
 	}
 	m.tsCalc.RecordTotalStake(m.liquidity.CalculateSuppliedStake().Uint64(), m.timeService.GetTimeNow())
 	m.liquidity.EndBlock(m.markPrice, m.midPrice(), m.positionFactor)
+
+	// spot markets have no MTM, but reduce-only pools which have sold off
+	// their position still need to be removed at some point
+	m.amm.OnMTM(ctx)
 }
 
 func (m *Market) updateMarketValueProxy() {
@@ -785,6 +819,10 @@ func (m *Market) cleanMarketWithState(ctx context.Context, mktState types.Market
 		m.broker.Send(events.NewLedgerMovements(ctx, clearMarketTransfers))
 	}
 
+	if err := m.amm.MarketClosing(ctx); err != nil {
+		return err
+	}
+
 	m.mkt.State = mktState
 	m.mkt.TradingMode = types.MarketTradingModeNoTrading
 	m.broker.Send(events.NewMarketUpdatedEvent(ctx, *m.mkt))
@@ -975,6 +1013,8 @@ func (m *Market) OnAuctionEnded() {
 
 // leaveAuction : Return the orderbook and market to continuous trading.
 func (m *Market) leaveAuction(ctx context.Context, now time.Time) {
+	// deferred first so it runs once the market state has been updated below
+	defer m.checkBatchAuction(ctx, now)
 	defer func() {
 		if !m.as.InAuction() && (m.mkt.State == types.MarketStateSuspended || m.mkt.State == types.MarketStatePending || m.mkt.State == types.MarketStateSuspendedViaGovernance) {
 			if m.mkt.State == types.MarketStatePending {
@@ -988,6 +1028,9 @@ func (m *Market) leaveAuction(ctx context.Context, now time.Time) {
 			}
 			m.mkt.State = types.MarketStateActive
 			m.mkt.TradingMode = types.MarketTradingModeContinuous
+			if m.mkt.FrequentBatchAuction != nil {
+				m.mkt.TradingMode = types.MarketTradingModeBatchAuction
+			}
 			m.broker.Send(events.NewMarketUpdatedEvent(ctx, *m.mkt))
 			m.updateLiquidityFee(ctx)
 			m.OnAuctionEnded()
@@ -1009,6 +1052,7 @@ func (m *Market) leaveAuction(ctx context.Context, now time.Time) {
 		}
 	}
 
+	wasBatchAuction := m.as.IsFBA()
 	// update auction state, so we know what the new tradeMode ought to be
 	endEvt := m.as.Left(ctx, now)
 
@@ -1023,6 +1067,10 @@ func (m *Market) leaveAuction(ctx context.Context, now time.Time) {
 		// only send the auction-left event if we actually *left* the auction.
 		m.broker.Send(endEvt)
 		m.nextMTM = m.timeService.GetTimeNow().Add(m.mtmDelta)
+		// an active market leaving a batch auction won't go through the release of the fees locked for the auction above
+		if wasBatchAuction && m.mkt.State == types.MarketStateActive {
+			m.processFeesReleaseOnLeaveAuction(ctx)
+		}
 	} else {
 		// revert to old mark price if we're not leaving the auction after all
 		m.markPriceLock.Lock()
@@ -1031,6 +1079,32 @@ func (m *Market) leaveAuction(ctx context.Context, now time.Time) {
 	}
 }
 
+// startBatchAuction starts the next batch of a market trading in frequent batch auctions.
+func (m *Market) startBatchAuction(ctx context.Context, now time.Time) {
+	m.as.StartBatchAuction(now, m.mkt.FrequentBatchAuction.BatchDuration)
+	m.enterAuction(ctx)
+	if m.mkt.TradingMode != types.MarketTradingModeBatchAuction {
+		m.mkt.TradingMode = types.MarketTradingModeBatchAuction
+		m.broker.Send(events.NewMarketUpdatedEvent(ctx, *m.mkt))
+	}
+}
+
+// checkBatchAuction is called once an active market is out of auction, markets trading in frequent batch auctions
+// go straight into the next batch, markets which no longer do return to continuous trading.
+func (m *Market) checkBatchAuction(ctx context.Context, now time.Time) {
+	if m.as.InAuction() || m.mkt.State != types.MarketStateActive {
+		return
+	}
+	if m.mkt.FrequentBatchAuction != nil {
+		m.startBatchAuction(ctx, now)
+		return
+	}
+	if m.mkt.TradingMode == types.MarketTradingModeBatchAuction {
+		m.mkt.TradingMode = types.MarketTradingModeContinuous
+		m.broker.Send(events.NewMarketUpdatedEvent(ctx, *m.mkt))
+	}
+}
+
 // validateOrder checks that the order parameters are valid for the market.
 // NB: price in market, tickSize in market decimals.
 func (m *Market) validateOrder(ctx context.Context, order *types.Order) (err error) {
@@ -1093,6 +1167,13 @@ func (m *Market) validateOrder(ctx context.Context, order *types.Order) (err err
 		return types.ErrInvalidMarketID
 	}
 
+	// a party frozen by its market maker protection can still hedge,
+	// but not quote.
+	if order.IsPersistent() && m.mmProtections.IsFrozen(m.timeService.GetTimeNow(), order.Party) {
+		order.Reason = types.OrderErrorMarketMakerProtectionFrozen
+		return common.ErrMarketMakerProtectionFrozen
+	}
+
 	// Validate pegged orders
 	if order.PeggedOrder != nil {
 		if reason := order.ValidatePeggedOrder(); reason != types.OrderErrorUnspecified {
@@ -1111,6 +1192,10 @@ func (m *Market) validateOrder(ctx context.Context, order *types.Order) (err err
 				return fmt.Errorf("invalid offset - pegged mid will cross")
 			}
 		}
+		if !isBookPeggedReference(order.PeggedOrder.Reference) {
+			order.Reason = types.OrderErrorPeggedReferenceNotSupported
+			return types.ErrPeggedReferenceNotSupported
+		}
 		return m.validateTickSize(order.PeggedOrder.Offset)
 	}
 
@@ -1259,6 +1344,14 @@ func (m *Market) SubmitStopOrdersWithIDGeneratorAndOrderIDs(
 		}
 	}
 
+	// spot markets only trigger stop orders from the last traded price
+	for _, so := range []*types.StopOrder{fallsBelow, risesAbove} {
+		if so != nil && so.Trigger.PriceReference() != types.StopOrderTriggerReferenceLastTradedPrice {
+			rejectStopOrders(types.StopOrderRejectionTriggerReferenceUnsupported, fallsBelow, risesAbove)
+			return nil, common.ErrStopOrderTriggerReferenceNotSupported
+		}
+	}
+
 	// now check if that party hasn't exceeded the max amount per market
 	if m.stopOrders.CountForParty(party)+uint64(orderCnt) > m.maxStopOrdersPerParties.Uint64() {
 		rejectStopOrders(types.StopOrderRejectionMaxStopOrdersPerPartyReached, fallsBelow, risesAbove)
@@ -1394,6 +1487,8 @@ func (m *Market) SubmitOrder(ctx context.Context, orderSubmission *types.OrderSu
 
 // SubmitOrderWithIDGeneratorAndOrderID submits the given order.
 func (m *Market) SubmitOrderWithIDGeneratorAndOrderID(ctx context.Context, orderSubmission *types.OrderSubmission, party string, idgen common.IDGenerator, orderID string, checkForTriggers bool) (oc *types.OrderConfirmation, _ error) {
+	defer m.applyMarketMakerProtections(ctx)
+
 	m.idgen = idgen
 	defer func() { m.idgen = nil }()
 
@@ -1562,6 +1657,7 @@ func (m *Market) submitValidatedOrder(ctx context.Context, order *types.Order) (
 	if err != nil {
 		return nil, nil, m.unregisterAndReject(ctx, order, err)
 	}
+	m.handleSelfTradeReductions(ctx, confirmation)
 
 	// if the order is not finished and remaining is non zero, we need to transfer the remaining base/quote from the general account
 	// to the holding account for the market/asset. If an auction is on-going we also need to account for potential fees (applicable for buy orders only)
@@ -1688,6 +1784,51 @@ func (m *Market) handleConfirmationPassiveOrders(ctx context.Context, conf *type
 	}
 }
 
+// handleSelfTradeReductions releases the holdings of resting orders cancelled or decremented
+// by self-trade prevention and publishes them.
+func (m *Market) handleSelfTradeReductions(ctx context.Context, conf *types.OrderConfirmation) {
+	if len(conf.SelfTradeReductions) == 0 {
+		return
+	}
+
+	evts := make([]events.Event, 0, len(conf.SelfTradeReductions))
+	le := []*types.LedgerMovement{}
+	for _, st := range conf.SelfTradeReductions {
+		// the aggressive order has nothing in the holding account yet and is published by the caller
+		if st.Order == conf.Order {
+			continue
+		}
+
+		order := st.Order
+		if order.Status == types.OrderStatusCancelled {
+			m.releaseOrderFromHoldingAccount(ctx, order.ID, order.Party, order.Side)
+			if order.IsExpireable() {
+				m.expiringOrders.RemoveOrder(order.ExpiresAt, order.ID)
+			}
+			if order.PeggedOrder != nil {
+				m.removePeggedOrder(order)
+			}
+		} else {
+			asset, quantity := m.quoteAsset, scaleQuoteQuantityToAssetDP(st.Volume, order.Price, m.positionFactor)
+			if order.Side == types.SideSell {
+				asset, quantity = m.baseAsset, scaleBaseQuantityToAssetDP(st.Volume, m.baseFactor)
+			}
+			transfer, err := m.orderHoldingTracker.ReleaseQuantityHoldingAccount(ctx, order.ID, order.Party, asset, quantity, num.UintZero())
+			if err != nil {
+				m.log.Panic("could not release funds from holding account", logging.Order(order), logging.Error(err))
+			}
+			le = append(le, transfer)
+		}
+
+		order.UpdatedAt = m.timeService.GetTimeNow().UnixNano()
+		evts = append(evts, events.NewOrderEvent(ctx, order))
+	}
+	if len(le) > 0 {
+		m.broker.Send(events.NewLedgerMovements(ctx, le))
+	}
+	m.broker.SendBatch(evts)
+}
+
 func (m *Market) handleConfirmation(ctx context.Context, conf *types.OrderConfirmation) []*types.Order {
 	// When re-submitting liquidity order, it happen that the pricing is putting
 	// the order at a price which makes it uncross straight away.
@@ -1727,6 +1868,7 @@ func (m *Market) handleConfirmation(ctx context.Context, conf *types.OrderConfir
 		tradeTransfers := m.handleTrade(ctx, trade)
 		transfers = append(transfers, tradeTransfers...)
 		tradeEvts = append(tradeEvts, events.NewTradeEvent(ctx, *trade))
+		m.recordMarketMakerProtectionFills(trade)
 	}
 	if conf.Order.IsFinished() {
 		m.releaseOrderFromHoldingAccount(ctx, conf.Order.ID, conf.Order.Party, conf.Order.Side)
@@ -1760,14 +1902,23 @@ func (m *Market) handleConfirmation(ctx context.Context, conf *types.OrderConfir
 // updateLiquidityFee computes the current LiquidityProvision fee and updates
 // the fee engine.
 func (m *Market) updateLiquidityFee(ctx context.Context) {
+	provisions := m.liquidityEngine.ProvisionsPerParty().Clone()
+	for party, pool := range m.amm.GetAMMPoolsBySubAccount() {
+		provisions[party] = &types.LiquidityProvision{
+			Party:            party,
+			CommitmentAmount: pool.CommitmentAmount(),
+			Fee:              pool.LiquidityFee(),
+		}
+	}
+
 	var fee num.Decimal
 	switch m.mkt.Fees.LiquidityFeeSettings.Method {
 	case types.LiquidityFeeMethodConstant:
 		fee = m.mkt.Fees.LiquidityFeeSettings.FeeConstant
 	case types.LiquidityFeeMethodMarginalCost:
-		fee = m.liquidityEngine.ProvisionsPerParty().FeeForTarget(m.getTargetStake())
+		fee = provisions.FeeForTarget(m.getTargetStake())
 	case types.LiquidityFeeMethodWeightedAverage:
-		fee = m.liquidityEngine.ProvisionsPerParty().FeeForWeightedAverage()
+		fee = provisions.FeeForWeightedAverage()
 	default:
 		m.log.Panic("unknown liquidity fee method")
 	}
@@ -2020,6 +2171,8 @@ func (m *Market) AmendOrder(ctx context.Context, orderAmendment *types.OrderAmen
 
 // AmendOrderWithIDGenerator amends an order.
 func (m *Market) AmendOrderWithIDGenerator(ctx context.Context, orderAmendment *types.OrderAmendment, party string, idgen common.IDGenerator) (oc *types.OrderConfirmation, _ error) {
+	defer m.applyMarketMakerProtections(ctx)
+
 	m.idgen = idgen
 	defer func() { m.idgen = nil }()
 
@@ -2360,6 +2513,8 @@ func (m *Market) validateOrderAmendment(order *types.Order, amendment *types.Ord
 	} else if amendment.Price != nil {
 		// We cannot change the price on a pegged order
 		return types.OrderErrorUnableToAmendPriceOnPeggedOrder
+	} else if amendment.PeggedReference != types.PeggedReferenceUnspecified && !isBookPeggedReference(amendment.PeggedReference) {
+		return types.OrderErrorPeggedReferenceNotSupported
 	}
 
 	// if side is buy we need to check that the party has sufficient funds in their general account to cover for the change in quote asset required
@@ -2525,6 +2680,7 @@ func (m *Market) orderCancelReplace(ctx context.Context, existingOrder, newOrder
 	if err != nil {
 		m.log.Panic("unable to submit order", logging.Error(err))
 	}
+	m.handleSelfTradeReductions(ctx, conf)
 
 	// replace the trades in the confirmation to have
 	// the ones with the fees embedded
@@ -3049,39 +3205,45 @@ func (m *Market) handleTrade(ctx context.Context, trade *types.Trade) []*types.L
 	if err != nil {
 		m.log.Panic("failed to calculate fees for trade", logging.Trade(trade))
 	}
+	// AMM orders are never placed on the book, the pool trades straight out of its general accounts
+	// so there is nothing to release from a holding account for its side of the trade.
+	buyerIsAMM, sellerIsAMM := m.amm.IsAMMPartyID(trade.Buyer), m.amm.IsAMMPartyID(trade.Seller)
 	if trade.Aggressor == types.SideUnspecified {
 		fee := num.UintZero()
 		if fees != nil {
 			fee = fees.TotalFeesAmountPerParty()[trade.Buyer]
 		}
 
-		// release buyer's trade + fees quote quantity from the holding account
-		var transfer *types.LedgerMovement
-		var err error
-		// in auction the buyer and seller split the fees, but that just means that total they need to have in the holding account
-		// is still quantity + fees/2 because the other half of the fees (the seller half) is paid out of what is supposed to go to the seller
-		transfer, err = m.orderHoldingTracker.ReleaseQuantityHoldingAccountAuctionEnd(ctx, trade.BuyOrder, trade.Buyer, m.quoteAsset, scaleQuoteQuantityToAssetDP(trade.Size, trade.Price, m.positionFactor), fee)
-
-		if err != nil {
-			m.log.Panic("failed to release funds from holding account for trade", logging.Trade(trade), logging.Error(err))
+		if !buyerIsAMM {
+			// release buyer's trade + fees quote quantity from the holding account
+			// in auction the buyer and seller split the fees, but that just means that total they need to have in the holding account
+			// is still quantity + fees/2 because the other half of the fees (the seller half) is paid out of what is supposed to go to the seller
+			transfer, err := m.orderHoldingTracker.ReleaseQuantityHoldingAccountAuctionEnd(ctx, trade.BuyOrder, trade.Buyer, m.quoteAsset, scaleQuoteQuantityToAssetDP(trade.Size, trade.Price, m.positionFactor), fee)
+			if err != nil {
+				m.log.Panic("failed to release funds from holding account for trade", logging.Trade(trade), logging.Error(err))
+			}
+			transfers = append(transfers, transfer)
 		}
-		transfers = append(transfers, transfer)
 
-		// release seller's base quantity from the holding account
-		transfer, err = m.orderHoldingTracker.ReleaseQuantityHoldingAccount(ctx, trade.SellOrder, trade.Seller, m.baseAsset, scaleBaseQuantityToAssetDP(trade.Size, m.baseFactor), num.UintZero())
-		if err != nil {
-			m.log.Panic("failed to release funds from holding account for trade", logging.Trade(trade), logging.Error(err))
+		if !sellerIsAMM {
+			// release seller's base quantity from the holding account
+			transfer, err := m.orderHoldingTracker.ReleaseQuantityHoldingAccount(ctx, trade.SellOrder, trade.Seller, m.baseAsset, scaleBaseQuantityToAssetDP(trade.Size, m.baseFactor), num.UintZero())
+			if err != nil {
+				m.log.Panic("failed to release funds from holding account for trade", logging.Trade(trade), logging.Error(err))
+			}
+			transfers = append(transfers, transfer)
 		}
-		transfers = append(transfers, transfer)
 	} else {
 		// if there is an aggressor, then we need to release the passive side from the holding account
 		if trade.Aggressor == types.SideSell { // the aggressor is the seller so we need to release funds for the buyer from holding
-			transfer, err := m.orderHoldingTracker.ReleaseQuantityHoldingAccount(ctx, trade.BuyOrder, trade.Buyer, m.quoteAsset, scaleQuoteQuantityToAssetDP(trade.Size, trade.Price, m.positionFactor), num.UintZero())
-			if err != nil {
-				m.log.Panic("failed to release funds from holding account for trade", logging.Trade(trade))
+			if !buyerIsAMM {
+				transfer, err := m.orderHoldingTracker.ReleaseQuantityHoldingAccount(ctx, trade.BuyOrder, trade.Buyer, m.quoteAsset, scaleQuoteQuantityToAssetDP(trade.Size, trade.Price, m.positionFactor), num.UintZero())
+				if err != nil {
+					m.log.Panic("failed to release funds from holding account for trade", logging.Trade(trade))
+				}
+				transfers = append(transfers, transfer)
 			}
-			transfers = append(transfers, transfer)
-		} else { // the aggressor is the buyer, we release the funds for the seller from holding account
+		} else if !sellerIsAMM { // the aggressor is the buyer, we release the funds for the seller from holding account
 			transfer, err := m.orderHoldingTracker.ReleaseQuantityHoldingAccount(ctx, trade.SellOrder, trade.Seller, m.baseAsset, scaleBaseQuantityToAssetDP(trade.Size, m.baseFactor), num.UintZero())
 			if err != nil {
 				m.log.Panic("failed to release funds from holding account for trade", logging.Trade(trade))
@@ -3314,16 +3476,148 @@ func (m *Market) GetMarketCounters() *types.MarketCounters {
 	}
 }
 
-func (m *Market) SubmitAMM(context.Context, *types.SubmitAMM, string) error {
-	return errors.New("unimplemented")
+// crossesBook returns whether an AMM quoting at the given fair-price would cross the current spread on the market.
+// Unlike on a futures market a spot AMM cannot submit a rebasing order since it has no margin to trade with.
+func (m *Market) crossesBook(fairPrice *num.Uint) bool {
+	if m.as.InAuction() {
+		return false
+	}
+
+	if ask, err := m.matching.GetBestAskPrice(); err == nil && fairPrice.GT(ask) {
+		return true
+	}
+
+	if bid, err := m.matching.GetBestBidPrice(); err == nil && fairPrice.LT(bid) {
+		return true
+	}
+	return false
 }
 
-func (m *Market) AmendAMM(context.Context, *types.AmendAMM, string) error {
-	return errors.New("unimplemented")
+func (m *Market) SubmitAMM(ctx context.Context, submit *types.SubmitAMM, deterministicID string) error {
+	if !m.canTrade() {
+		return common.ErrTradingNotAllowed
+	}
+
+	m.idgen = idgeneration.New(deterministicID)
+	defer func() { m.idgen = nil }()
+
+	if err := common.VerifyAMMBounds(submit.Parameters.Base, submit.Parameters.LowerBound, submit.Parameters.UpperBound, m.priceFactor); err != nil {
+		return err
+	}
+
+	// create the AMM curves but do not confirm it with the engine
+	pool, err := m.amm.Create(ctx, submit, m.idgen.NextID(), nil, nil, num.DecimalZero())
+	if err != nil {
+		return err
+	}
+
+	if err := m.amm.UpdateSpotSubAccountBalances(ctx, pool, nil); err != nil {
+		m.broker.Send(
+			events.NewAMMPoolEvent(
+				ctx, submit.Party, m.GetID(), pool.AMMParty, pool.ID,
+				submit.CommitmentAmount, submit.Parameters,
+				types.AMMPoolStatusRejected, types.AMMStatusReasonCannotFillCommitment,
+				pool.ProposedFee, nil, nil,
+			).WithBaseCommitment(pool.BaseCommitment),
+		)
+		return err
+	}
+
+	// the pool's position comes from its base balance so it can only be priced once funded, and since
+	// it cannot rebase it must not cross the book
+	if m.crossesBook(pool.BestPrice(nil)) {
+		ledgerMovements := []*types.LedgerMovement{}
+		for _, asset := range []string{m.quoteAsset, m.baseAsset} {
+			lm, _, err := m.collateral.SubAccountRelease(ctx, submit.Party, pool.AMMParty, asset, m.GetID(), nil)
+			if err != nil {
+				m.log.Panic("unable to release spot AMM sub-account balance", logging.Error(err))
+			}
+			ledgerMovements = append(ledgerMovements, lm...)
+		}
+		m.broker.Send(events.NewLedgerMovements(ctx, ledgerMovements))
+		m.broker.Send(
+			events.NewAMMPoolEvent(
+				ctx, submit.Party, m.GetID(), pool.AMMParty, pool.ID,
+				submit.CommitmentAmount, submit.Parameters,
+				types.AMMPoolStatusRejected, types.AMMStatusReasonCannotRebase,
+				pool.ProposedFee, nil, nil,
+			).WithBaseCommitment(pool.BaseCommitment),
+		)
+		return common.ErrAMMCannotRebase
+	}
+
+	m.amm.Confirm(ctx, pool)
+	// now tell the matching engine something new has appeared incase it needs to update its auction IPV cache
+	m.matching.UpdateAMM(pool.AMMParty)
+	m.checkForReferenceMoves(ctx, false)
+	return nil
+}
+
+func (m *Market) AmendAMM(ctx context.Context, amend *types.AmendAMM, deterministicID string) error {
+	if !m.canTrade() {
+		return common.ErrTradingNotAllowed
+	}
+
+	m.idgen = idgeneration.New(deterministicID)
+	defer func() { m.idgen = nil }()
+
+	if amend.Parameters != nil {
+		if err := common.VerifyAMMBounds(amend.Parameters.Base, amend.Parameters.LowerBound, amend.Parameters.UpperBound, m.priceFactor); err != nil {
+			return err
+		}
+	}
+
+	// get an amended AMM and the existing AMM
+	pool, existing, err := m.amm.Amend(ctx, amend, nil, nil, num.DecimalZero())
+	if err != nil {
+		return err
+	}
+	// if the amended pool cannot be used be sure to reinstate the old one
+	defer func() {
+		if err != nil {
+			m.amm.Confirm(ctx, existing)
+		}
+	}()
+
+	if err = m.amm.UpdateSpotSubAccountBalances(ctx, pool, existing); err != nil {
+		return err
+	}
+
+	if m.crossesBook(pool.BestPrice(nil)) {
+		if err := m.amm.UpdateSpotSubAccountBalances(ctx, existing, pool); err != nil {
+			m.log.Panic("unable to restore AMM balances after failed amend", logging.Error(err))
+		}
+		err = common.ErrAMMCannotRebase // set it to err so that the defer runs
+		return err
+	}
+
+	m.amm.Confirm(ctx, pool)
+	m.matching.UpdateAMM(pool.AMMParty)
+	m.checkForReferenceMoves(ctx, false)
+	return nil
 }
 
-func (m *Market) CancelAMM(context.Context, *types.CancelAMM, string) error {
-	return errors.New("unimplemented")
+func (m *Market) CancelAMM(ctx context.Context, cancel *types.CancelAMM, _ string) error {
+	if !m.canTrade() {
+		return common.ErrTradingNotAllowed
+	}
+
+	ammParty, err := m.amm.GetAMMParty(cancel.Party)
+	if err != nil {
+		return err
+	}
+
+	// a spot AMM holds no position that needs closing out, its assets are returned to the owner in full
+	if _, err := m.amm.CancelAMM(ctx, cancel); err != nil {
+		return err
+	}
+
+	// tell matching incase it needs to remove the AMM's contribution to the IPV cache
+	m.matching.UpdateAMM(ammParty)
+
+	// rejig any pegged orders that might need re-pricing now an AMM is not longer there, or is no longer quoting one side
+	m.checkForReferenceMoves(ctx, false)
+	return nil
 }
 
 func (m *Market) ValidateSettlementData(_ *num.Uint) bool {
@@ -0,0 +1,198 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package spot_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/execution/amm"
+	"code.vegaprotocol.io/vega/core/execution/common"
+	"code.vegaprotocol.io/vega/core/types"
+	vegacontext "code.vegaprotocol.io/vega/libs/context"
+	"code.vegaprotocol.io/vega/libs/crypto"
+	"code.vegaprotocol.io/vega/libs/num"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpotAMM(t *testing.T) {
+	t.Run("submit, amend and cancel a spot AMM", testSpotAMMLifecycle)
+	t.Run("spot AMM is rejected without enough base asset", testSpotAMMNotEnoughBase)
+	t.Run("spot AMM is rejected if it crosses the book", testSpotAMMCrossesBook)
+	t.Run("spot AMM trades with an incoming order", testSpotAMMTrades)
+}
+
+func getSpotAMMSubmission(tm *testMarket, party string, commitment, baseCommitment, base uint64) *types.SubmitAMM {
+	return &types.SubmitAMM{
+		AMMBaseCommand: types.AMMBaseCommand{
+			MarketID:          tm.market.GetID(),
+			Party:             party,
+			SlippageTolerance: num.DecimalFromFloat(0.1),
+			ProposedFee:       num.DecimalFromFloat(0.01),
+		},
+		CommitmentAmount:     num.NewUint(commitment),
+		BaseCommitmentAmount: num.NewUint(baseCommitment),
+		Parameters: &types.ConcentratedLiquidityParameters{
+			Base:       num.NewUint(base),
+			LowerBound: num.NewUint(base - 15),
+			UpperBound: num.NewUint(base + 50),
+		},
+	}
+}
+
+func newSpotAMMTestMarket(t *testing.T, now time.Time) (*testMarket, context.Context) {
+	t.Helper()
+	ctx := vegacontext.WithTraceID(context.Background(), crypto.RandomHash())
+	tm := newTestMarket(t, defaultPriceMonitorSettings, &types.AuctionDuration{Duration: 1}, now)
+	tm.market.OnMarketAMMMaxCalculationLevels(ctx, num.NewUint(1000))
+	tm.market.OnAMMMinCommitmentQuantumUpdate(ctx, num.NewUint(1))
+	tm.market.StartOpeningAuction(ctx)
+	return tm, ctx
+}
+
+// leaveOpeningAuction uncrosses a trade at 100 leaving a bid at 90 and an ask at 110 on the book.
+func leaveOpeningAuction(t *testing.T, tm *testMarket, ctx context.Context, now time.Time) {
+	t.Helper()
+	addAccountWithAmount(tm, "party1", 10000, tm.quoteAsset)
+	addAccountWithAmount(tm, "party2", 10, tm.baseAsset)
+	for _, o := range []*types.Order{
+		getGTCLimitOrder(tm, now, crypto.RandomHash(), types.SideBuy, "party1", 1, 90),
+		getGTCLimitOrder(tm, now, crypto.RandomHash(), types.SideBuy, "party1", 1, 100),
+		getGTCLimitOrder(tm, now, crypto.RandomHash(), types.SideSell, "party2", 1, 100),
+		getGTCLimitOrder(tm, now, crypto.RandomHash(), types.SideSell, "party2", 1, 110),
+	} {
+		_, err := tm.market.SubmitOrder(ctx, o.IntoSubmission(), o.Party, crypto.RandomHash())
+		require.NoError(t, err)
+	}
+	tm.market.OnTick(ctx, now.Add(2*time.Second))
+	require.Equal(t, types.MarketTradingModeContinuous, tm.market.GetMarketData().MarketTradingMode)
+}
+
+func requireGeneralBalance(t *testing.T, tm *testMarket, party, asset, expected string) {
+	t.Helper()
+	acc, err := tm.collateralEngine.GetPartyGeneralAccount(party, asset)
+	require.NoError(t, err)
+	require.Equal(t, expected, acc.Balance.String())
+}
+
+func testSpotAMMLifecycle(t *testing.T) {
+	now := time.Unix(100000, 0)
+	tm, ctx := newSpotAMMTestMarket(t, now)
+	defer tm.ctrl.Finish()
+
+	owner := "amm-owner"
+	addAccountWithAmount(tm, owner, 10000, tm.quoteAsset)
+	addAccountWithAmount(tm, owner, 200, tm.baseAsset)
+
+	require.NoError(t, tm.market.SubmitAMM(ctx, getSpotAMMSubmission(tm, owner, 10000, 100, 100), crypto.RandomHash()))
+
+	// both commitments are moved into the pool's sub-account
+	sub := amm.DeriveAMMParty(owner, tm.market.GetID(), amm.V1, 0)
+	requireGeneralBalance(t, tm, sub, tm.quoteAsset, "10000")
+	requireGeneralBalance(t, tm, sub, tm.baseAsset, "100")
+	requireGeneralBalance(t, tm, owner, tm.quoteAsset, "0")
+	requireGeneralBalance(t, tm, owner, tm.baseAsset, "100")
+
+	// amending the commitments moves the difference between the owner and the pool
+	amend := &types.AmendAMM{
+		AMMBaseCommand: types.AMMBaseCommand{
+			MarketID:          tm.market.GetID(),
+			Party:             owner,
+			SlippageTolerance: num.DecimalFromFloat(0.1),
+		},
+		CommitmentAmount:     num.NewUint(6000),
+		BaseCommitmentAmount: num.NewUint(150),
+	}
+	require.NoError(t, tm.market.AmendAMM(ctx, amend, crypto.RandomHash()))
+	requireGeneralBalance(t, tm, sub, tm.quoteAsset, "6000")
+	requireGeneralBalance(t, tm, sub, tm.baseAsset, "150")
+	requireGeneralBalance(t, tm, owner, tm.quoteAsset, "4000")
+	requireGeneralBalance(t, tm, owner, tm.baseAsset, "50")
+
+	// cancelling returns everything to the owner
+	cancel := &types.CancelAMM{
+		MarketID: tm.market.GetID(),
+		Party:    owner,
+		Method:   types.AMMCancellationMethodImmediate,
+	}
+	require.NoError(t, tm.market.CancelAMM(ctx, cancel, crypto.RandomHash()))
+	requireGeneralBalance(t, tm, owner, tm.quoteAsset, "10000")
+	requireGeneralBalance(t, tm, owner, tm.baseAsset, "200")
+}
+
+func testSpotAMMNotEnoughBase(t *testing.T) {
+	now := time.Unix(100000, 0)
+	tm, ctx := newSpotAMMTestMarket(t, now)
+	defer tm.ctrl.Finish()
+
+	owner := "amm-owner"
+	addAccountWithAmount(tm, owner, 10000, tm.quoteAsset)
+	addAccountWithAmount(tm, owner, 50, tm.baseAsset)
+
+	err := tm.market.SubmitAMM(ctx, getSpotAMMSubmission(tm, owner, 10000, 100, 100), crypto.RandomHash())
+	require.Error(t, err)
+
+	// nothing has left the owner's accounts
+	requireGeneralBalance(t, tm, owner, tm.quoteAsset, "10000")
+	requireGeneralBalance(t, tm, owner, tm.baseAsset, "50")
+}
+
+func testSpotAMMCrossesBook(t *testing.T) {
+	now := time.Unix(100000, 0)
+	tm, ctx := newSpotAMMTestMarket(t, now)
+	defer tm.ctrl.Finish()
+	leaveOpeningAuction(t, tm, ctx, now)
+
+	owner := "amm-owner"
+	addAccountWithAmount(tm, owner, 10000, tm.quoteAsset)
+	addAccountWithAmount(tm, owner, 100, tm.baseAsset)
+
+	// the pool's fair price is above the best ask and a spot pool cannot rebase
+	err := tm.market.SubmitAMM(ctx, getSpotAMMSubmission(tm, owner, 10000, 100, 120), crypto.RandomHash())
+	require.ErrorIs(t, err, common.ErrAMMCannotRebase)
+
+	// the commitments are given back
+	requireGeneralBalance(t, tm, owner, tm.quoteAsset, "10000")
+	requireGeneralBalance(t, tm, owner, tm.baseAsset, "100")
+}
+
+func testSpotAMMTrades(t *testing.T) {
+	now := time.Unix(100000, 0)
+	tm, ctx := newSpotAMMTestMarket(t, now)
+	defer tm.ctrl.Finish()
+	leaveOpeningAuction(t, tm, ctx, now)
+
+	owner := "amm-owner"
+	addAccountWithAmount(tm, owner, 10000, tm.quoteAsset)
+	addAccountWithAmount(tm, owner, 100, tm.baseAsset)
+	require.NoError(t, tm.market.SubmitAMM(ctx, getSpotAMMSubmission(tm, owner, 10000, 100, 100), crypto.RandomHash()))
+
+	// the pool quotes inside the spread so it takes the incoming buy at its fair price
+	order := getGTCLimitOrder(tm, now, crypto.RandomHash(), types.SideBuy, "party1", 1, 105)
+	conf, err := tm.market.SubmitOrder(ctx, order.IntoSubmission(), order.Party, crypto.RandomHash())
+	require.NoError(t, err)
+	require.Len(t, conf.Trades, 1)
+
+	sub := amm.DeriveAMMParty(owner, tm.market.GetID(), amm.V1, 0)
+	require.Equal(t, sub, conf.Trades[0].Seller)
+	require.Equal(t, "100", conf.Trades[0].Price.String())
+
+	// the pool is paid in quote, plus its maker fee, and hands over base straight from its sub-account
+	requireGeneralBalance(t, tm, sub, tm.quoteAsset, "10101")
+	requireGeneralBalance(t, tm, sub, tm.baseAsset, "99")
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"code.vegaprotocol.io/vega/core/assets"
+	"code.vegaprotocol.io/vega/core/execution/amm"
 	"code.vegaprotocol.io/vega/core/execution/common"
 	"code.vegaprotocol.io/vega/core/execution/stoporders"
 	"code.vegaprotocol.io/vega/core/fee"
@@ -66,6 +67,7 @@ func NewMarketFromSnapshot(
 	volumeDiscountService fee.VolumeDiscountService,
 	volumeRebateService fee.VolumeRebateService,
 	banking common.Banking,
+	parties common.Parties,
 ) (*Market, error) {
 	mkt := em.Market
 	if len(em.Market.ID) == 0 {
@@ -95,6 +97,17 @@ func NewMarketFromSnapshot(
 	baseAsset := assets[BaseAssetIndex]
 	quoteAsset := assets[QuoteAssetIndex]
 
+	var ammEngine *amm.Engine
+	if em.Amm == nil {
+		ammEngine = amm.NewSpot(log, broker, collateralEngine, mkt.GetID(), quoteAsset, baseAsset, priceFactor, positionFactor, baseFactor, marketActivityTracker, parties)
+	} else {
+		ammEngine, err = amm.NewSpotFromProto(log, broker, collateralEngine, mkt.GetID(), quoteAsset, baseAsset, em.Amm, priceFactor, positionFactor, baseFactor, marketActivityTracker, parties)
+		if err != nil {
+			return nil, err
+		}
+	}
+	book.SetOffbookSource(ammEngine)
+
 	var feeEngine *fee.Engine
 	if em.FeesStats != nil {
 		feeEngine, err = fee.NewFromState(log, feeConfig, *mkt.Fees, quoteAsset, positionFactor, em.FeesStats)
@@ -127,7 +140,7 @@ func NewMarketFromSnapshot(
 		}
 	}
 
-	marketLiquidity, err := common.NewMarketLiquidityFromSnapshot(log, liquidity, collateralEngine, broker, book, els, marketActivityTracker, feeEngine, common.SpotMarketType, mkt.ID, quoteAsset, priceFactor, em.MarketLiquidity, nil)
+	marketLiquidity, err := common.NewMarketLiquidityFromSnapshot(log, liquidity, collateralEngine, broker, book, els, marketActivityTracker, feeEngine, common.SpotMarketType, mkt.ID, quoteAsset, priceFactor, em.MarketLiquidity, ammEngine)
 	if err != nil {
 		return nil, err
 	}
@@ -162,6 +175,7 @@ func NewMarketFromSnapshot(
 		volumeRebateService:           volumeRebateService,
 		liquidity:                     marketLiquidity,
 		liquidityEngine:               liquidity,
+		amm:                           ammEngine,
 		parties:                       map[string]struct{}{},
 		tsCalc:                        tsCalc,
 		feeSplitter:                   common.NewFeeSplitterFromSnapshot(em.FeeSplitter, now),
@@ -169,6 +183,7 @@ func NewMarketFromSnapshot(
 		pMonitor:                      pMonitor,
 		peggedOrders:                  common.NewPeggedOrdersFromSnapshot(log, timeService, em.PeggedOrders),
 		expiringOrders:                common.NewExpiringOrdersFromState(em.ExpiringOrders),
+		mmProtections:                 common.NewMarketMakerProtectionsFromSnapshot(mkt.ID, em.MarketMakerProtection),
 		equityShares:                  els,
 		lastBestBidPrice:              em.LastBestBid.Clone(),
 		lastBestAskPrice:              em.LastBestAsk.Clone(),
@@ -192,6 +207,8 @@ func NewMarketFromSnapshot(
 		banking:                       banking,
 	}
 	liquidity.SetGetStaticPricesFunc(market.getBestStaticPricesDecimal)
+	book.SetMarkPriceSource(market.getCurrentMarkPrice)
+	book.SetMatchingAlgorithm(mkt.MatchingAlgorithm)
 	for _, p := range em.Parties {
 		market.parties[p] = struct{}{}
 	}
@@ -232,6 +249,8 @@ func (m *Market) GetState() *types.ExecSpotMarket {
 		MarketLiquidity:            m.liquidity.GetState(),
 		StopOrders:                 m.stopOrders.ToProto(),
 		ExpiringStopOrders:         m.expiringStopOrders.GetState(),
+		Amm:                        m.amm.IntoProto(),
+		MarketMakerProtection:      m.mmProtections.GetState(),
 	}
 
 	return em
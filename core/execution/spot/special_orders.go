@@ -150,3 +150,13 @@ func (m *Market) enterAuctionSpecialOrders(ctx context.Context) {
 	m.broker.SendBatch(ordersEvts)
 	m.parkAllPeggedOrders(ctx)
 }
+
+// isBookPeggedReference returns true if the pegged reference is derived from the book,
+// spot markets do not support pegging orders to the mark or index price.
+func isBookPeggedReference(reference types.PeggedReference) bool {
+	switch reference {
+	case types.PeggedReferenceMid, types.PeggedReferenceBestBid, types.PeggedReferenceBestAsk:
+		return true
+	}
+	return false
+}
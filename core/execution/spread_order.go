@@ -0,0 +1,99 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution
+
+import (
+	"context"
+	"fmt"
+
+	"code.vegaprotocol.io/vega/core/execution/common"
+	"code.vegaprotocol.io/vega/core/execution/future"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+)
+
+// SubmitSpreadOrder trades every leg of the spread as a fill-or-kill market order.
+// All the legs are first run against their books without changing them, and none
+// of them is executed unless they would all fill in full at a net price within the
+// limit, and the general account of the party covers the margin and fees of all of
+// them. The margin of every leg is then set aside on its market, and should any leg
+// fail to reserve it, the legs already reserved are released so the spread is rejected
+// as a whole. Only once every leg is reserved are they executed, against books which
+// haven't changed since they were checked. All the trades are tagged with the same
+// strategy ID.
+func (e *Engine) SubmitSpreadOrder(ctx context.Context, spread *types.SpreadOrderSubmission, party string, idgen common.IDGenerator) ([]*types.OrderConfirmation, error) {
+	defer e.reassessPortfolio(ctx)
+
+	markets := make([]*future.Market, 0, len(spread.Legs))
+	for _, leg := range spread.Legs {
+		mkt, ok := e.futureMarkets[leg.MarketID]
+		if !ok {
+			if _, ok := e.allMarkets[leg.MarketID]; ok {
+				return nil, common.ErrSpreadOrderMarketNotSupported
+			}
+			return nil, types.ErrInvalidMarketID
+		}
+		if len(markets) > 0 {
+			first := markets[0]
+			if mkt.GetSettlementAsset() != first.GetSettlementAsset() || mkt.Mkt().DecimalPlaces != first.Mkt().DecimalPlaces {
+				return nil, common.ErrSpreadOrderMarketsMismatch
+			}
+		}
+		markets = append(markets, mkt)
+	}
+
+	netPrice := num.DecimalZero()
+	required := num.UintZero()
+	for i, leg := range spread.Legs {
+		vwap, collateral, err := markets[i].PreviewSpreadLeg(ctx, party, leg.Side, leg.Size(spread.Size))
+		if err != nil {
+			return nil, fmt.Errorf("spread order leg %d on market %s: %w", i, leg.MarketID, err)
+		}
+		required.AddSum(collateral)
+		legPrice := vwap.Mul(num.DecimalFromInt64(int64(leg.Ratio)))
+		if leg.Side == types.SideSell {
+			legPrice = legPrice.Neg()
+		}
+		netPrice = netPrice.Add(legPrice)
+	}
+	if netPrice.GreaterThan(spread.MaxNetPrice) {
+		return nil, common.ErrSpreadOrderNetPriceTooHigh
+	}
+	// the legs all draw on the general account of the settlement asset they share
+	general, err := e.collateral.GetPartyGeneralAccount(party, markets[0].GetSettlementAsset())
+	if err != nil || general.Balance.LT(required) {
+		return nil, common.ErrSpreadOrderMarginCheckFailed
+	}
+
+	strategyID := idgen.NextID()
+	reserved := make([]*future.SpreadLeg, 0, len(spread.Legs))
+	for i, leg := range spread.Legs {
+		r, err := markets[i].ReserveSpreadLeg(ctx, party, leg.Side, leg.Size(spread.Size), spread.Reference, strategyID, idgen)
+		if err != nil {
+			for j := len(reserved) - 1; j >= 0; j-- {
+				markets[j].ReleaseSpreadLeg(ctx, reserved[j])
+			}
+			return nil, fmt.Errorf("spread order leg %d on market %s: %w", i, leg.MarketID, err)
+		}
+		reserved = append(reserved, r)
+	}
+
+	confs := make([]*types.OrderConfirmation, 0, len(spread.Legs))
+	for i, leg := range reserved {
+		confs = append(confs, markets[i].ExecuteSpreadLeg(ctx, leg, idgen))
+	}
+	return confs, nil
+}
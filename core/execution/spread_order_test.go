@@ -0,0 +1,64 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dstypes "code.vegaprotocol.io/vega/core/datasource/common"
+	"code.vegaprotocol.io/vega/core/execution/common"
+	"code.vegaprotocol.io/vega/core/idgeneration"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/crypto"
+	"code.vegaprotocol.io/vega/libs/num"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitSpreadOrderChecksAllLegsBeforeTrading(t *testing.T) {
+	engine, ctrl := createEngine(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	pubKey := &dstypes.SignerPubKey{
+		PubKey: &dstypes.PubKey{
+			Key: "0xDEADBEEF",
+		},
+	}
+	require.NoError(t, engine.SubmitMarket(ctx, newMarket("quarterly", pubKey), "zohar", time.Now()))
+	require.NoError(t, engine.SubmitMarket(ctx, newMarket("perp", pubKey), "zohar", time.Now()))
+
+	spread := &types.SpreadOrderSubmission{
+		Legs: []*types.SpreadOrderLeg{
+			{MarketID: "quarterly", Side: types.SideBuy, Ratio: 1},
+			{MarketID: "unknown", Side: types.SideSell, Ratio: 1},
+		},
+		Size:        10,
+		MaxNetPrice: num.DecimalFromInt64(5),
+	}
+	idgen := idgeneration.New(crypto.RandomHash())
+	confs, err := engine.SubmitSpreadOrder(ctx, spread, "party", idgen)
+	require.ErrorIs(t, err, types.ErrInvalidMarketID)
+	require.Empty(t, confs)
+
+	// neither market has left its opening auction, the legs can't be traded
+	spread.Legs[1].MarketID = "perp"
+	confs, err = engine.SubmitSpreadOrder(ctx, spread, "party", idgen)
+	require.ErrorIs(t, err, common.ErrTradingNotAllowed)
+	require.Empty(t, confs)
+}
@@ -28,23 +28,50 @@ import (
 	"golang.org/x/exp/maps"
 )
 
+// TriggerReferences lists all the reference prices stop orders
+// can be triggered by, in the order they should be evaluated.
+var TriggerReferences = []types.StopOrderTriggerReference{
+	types.StopOrderTriggerReferenceLastTradedPrice,
+	types.StopOrderTriggerReferenceMarkPrice,
+	types.StopOrderTriggerReferenceIndexPrice,
+	types.StopOrderTriggerReferenceMidPrice,
+}
+
+// triggerTrees holds the priced and trailing trigger trees for
+// a single reference price.
+type triggerTrees struct {
+	priced   *PricedStopOrders
+	trailing *TrailingStopOrders
+}
+
+func newTriggerTrees() *triggerTrees {
+	return &triggerTrees{
+		priced:   NewPricedStopOrders(),
+		trailing: NewTrailingStopOrders(),
+	}
+}
+
 type Pool struct {
 	log *logging.Logger
 	// map partyId * map orderId * StopOrder
 	orders map[string]map[string]*types.StopOrder
 	// useful to find back a party from an order
 	orderToParty map[string]string
-	priced       *PricedStopOrders
-	trailing     *TrailingStopOrders
+	// trigger trees for each of the reference prices
+	triggers map[types.StopOrderTriggerReference]*triggerTrees
 }
 
 func New(log *logging.Logger) *Pool {
+	triggers := make(map[types.StopOrderTriggerReference]*triggerTrees, len(TriggerReferences))
+	for _, ref := range TriggerReferences {
+		triggers[ref] = newTriggerTrees()
+	}
+
 	return &Pool{
 		log:          log,
 		orders:       map[string]map[string]*types.StopOrder{},
 		orderToParty: map[string]string{},
-		priced:       NewPricedStopOrders(),
-		trailing:     NewTrailingStopOrders(),
+		triggers:     triggers,
 	}
 }
 
@@ -66,8 +93,19 @@ func NewFromProto(log *logging.Logger, p *v1.StopOrders) *Pool {
 		pool.orderToParty[order.ID] = order.Party
 	}
 
-	pool.priced = NewPricedStopOrdersFromProto(p.PricedStopOrders)
-	pool.trailing = NewTrailingStopOrdersFromProto(p.TrailingStopOrders)
+	// the last traded price trees predate the other references
+	// and are kept in their own fields.
+	pool.triggers[types.StopOrderTriggerReferenceLastTradedPrice] = &triggerTrees{
+		priced:   NewPricedStopOrdersFromProto(p.PricedStopOrders),
+		trailing: NewTrailingStopOrdersFromProto(p.TrailingStopOrders),
+	}
+
+	for _, v := range p.ReferencedStopOrders {
+		pool.triggers[v.Reference] = &triggerTrees{
+			priced:   NewPricedStopOrdersFromProto(v.PricedStopOrders),
+			trailing: NewTrailingStopOrdersFromProto(v.TrailingStopOrders),
+		}
+	}
 
 	return pool
 }
@@ -85,8 +123,17 @@ func (p *Pool) ToProto() *v1.StopOrders {
 		return out.StopOrders[i].StopOrder.Id < out.StopOrders[j].StopOrder.Id
 	})
 
-	out.PricedStopOrders = p.priced.ToProto()
-	out.TrailingStopOrders = p.trailing.ToProto()
+	lastTraded := p.triggers[types.StopOrderTriggerReferenceLastTradedPrice]
+	out.PricedStopOrders = lastTraded.priced.ToProto()
+	out.TrailingStopOrders = lastTraded.trailing.ToProto()
+
+	for _, ref := range TriggerReferences[1:] {
+		out.ReferencedStopOrders = append(out.ReferencedStopOrders, &v1.ReferencedStopOrders{
+			Reference:          ref,
+			PricedStopOrders:   p.triggers[ref].priced.ToProto(),
+			TrailingStopOrders: p.triggers[ref].trailing.ToProto(),
+		})
+	}
 
 	return out
 }
@@ -144,11 +191,26 @@ func (p *Pool) CheckDirection(positions *positions.SnapshotEngine) []*types.Stop
 	return toCancel
 }
 
+// PriceUpdated updates the last traded price of the market.
 func (p *Pool) PriceUpdated(newPrice *num.Uint) (triggered, cancelled []*types.StopOrder) {
+	return p.ReferencePriceUpdated(types.StopOrderTriggerReferenceLastTradedPrice, newPrice)
+}
+
+// ReferencePriceUpdated updates the given reference price, and returns all the stop
+// orders triggered by it, alongside the other side of their OCO which get cancelled.
+func (p *Pool) ReferencePriceUpdated(
+	reference types.StopOrderTriggerReference,
+	newPrice *num.Uint,
+) (triggered, cancelled []*types.StopOrder) {
+	trees, ok := p.triggers[reference]
+	if !ok {
+		p.log.Panic("unsupported stop order trigger reference", logging.String("reference", reference.String()))
+	}
+
 	// first update prices and get triggered orders
 	ids := append(
-		p.priced.PriceUpdated(newPrice.Clone()),
-		p.trailing.PriceUpdated(newPrice.Clone())...,
+		trees.priced.PriceUpdated(newPrice.Clone()),
+		trees.trailing.PriceUpdated(newPrice.Clone())...,
 	)
 
 	// first get all the orders which got triggered
@@ -222,12 +284,24 @@ func (p *Pool) Insert(order *types.StopOrder) {
 
 	p.orders[order.Party][order.ID] = order
 	p.orderToParty[order.ID] = order.Party
+
+	trees := p.triggersFor(order)
 	switch {
 	case order.Trigger.IsPrice():
-		p.priced.Insert(order.ID, order.Trigger.Price().Clone(), order.Trigger.Direction)
+		trees.priced.Insert(order.ID, order.Trigger.Price().Clone(), order.Trigger.Direction)
 	case order.Trigger.IsTrailingPercentOffset():
-		p.trailing.Insert(order.ID, order.Trigger.TrailingPercentOffset(), order.Trigger.Direction)
+		trees.trailing.Insert(order.ID, order.Trigger.TrailingPercentOffset(), order.Trigger.Direction)
+	}
+}
+
+func (p *Pool) triggersFor(order *types.StopOrder) *triggerTrees {
+	trees, ok := p.triggers[order.Trigger.PriceReference()]
+	if !ok {
+		p.log.Panic("unsupported stop order trigger reference",
+			logging.OrderID(order.ID),
+			logging.String("reference", order.Trigger.Reference.String()))
 	}
+	return trees
 }
 
 func (p *Pool) Cancel(
@@ -294,11 +368,12 @@ func (p *Pool) remove(orders []*types.StopOrder) {
 			delete(p.orders, order.Party)
 		}
 
+		trees := p.triggersFor(order)
 		switch {
 		case order.Trigger.IsPrice():
-			p.priced.Remove(order.ID)
+			trees.priced.Remove(order.ID)
 		case order.Trigger.IsTrailingPercentOffset():
-			p.trailing.Remove(order.ID)
+			trees.trailing.Remove(order.ID)
 		}
 	}
 }
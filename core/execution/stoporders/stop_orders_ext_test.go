@@ -103,11 +103,19 @@ func (p *Pool) Len() int {
 }
 
 func (p *Pool) Trailing() *TrailingStopOrders {
-	return p.trailing
+	return p.triggers[types.StopOrderTriggerReferenceLastTradedPrice].trailing
 }
 
 func (p *Pool) Priced() *PricedStopOrders {
-	return p.priced
+	return p.triggers[types.StopOrderTriggerReferenceLastTradedPrice].priced
+}
+
+func (p *Pool) ReferencedTrailing(reference types.StopOrderTriggerReference) *TrailingStopOrders {
+	return p.triggers[reference].trailing
+}
+
+func (p *Pool) ReferencedPriced(reference types.StopOrderTriggerReference) *PricedStopOrders {
+	return p.triggers[reference].priced
 }
 
 func (p *PricedStopOrders) Equal(p2 *PricedStopOrders) bool {
@@ -188,8 +196,21 @@ func (p *TrailingStopOrders) Equal(p2 *TrailingStopOrders) bool {
 }
 
 func (p *Pool) Equal(p2 *Pool) bool {
-	if !p.trailing.lastSeenPrice.EQ(p2.trailing.lastSeenPrice) {
-		return false
+	for ref, trees := range p.triggers {
+		trees2, ok := p2.triggers[ref]
+		if !ok {
+			return false
+		}
+		if (trees.trailing.lastSeenPrice == nil) != (trees2.trailing.lastSeenPrice == nil) {
+			return false
+		}
+		if trees.trailing.lastSeenPrice != nil && !trees.trailing.lastSeenPrice.EQ(trees2.trailing.lastSeenPrice) {
+			return false
+		}
+		if !trees.priced.Equal(trees2.priced) || !trees2.priced.Equal(trees.priced) ||
+			!trees.trailing.Equal(trees2.trailing) || !trees2.trailing.Equal(trees.trailing) {
+			return false
+		}
 	}
 
 	for k, v := range p.orderToParty {
@@ -244,5 +265,5 @@ func (p *Pool) Equal(p2 *Pool) bool {
 		}
 	}
 
-	return p.priced.Equal(p2.priced) && p2.priced.Equal(p.priced) && p.trailing.Equal(p2.trailing) && p2.trailing.Equal(p.trailing)
+	return len(p.triggers) == len(p2.triggers)
 }
@@ -52,6 +52,21 @@ func TestStopOrdersSnapshot(t *testing.T) {
 	// same with new offset
 	pool.Insert(newTrailingStopOrder("i", "p2", "", num.MustDecimalFromString("0.2"), types.StopOrderTriggerDirectionRisesAbove))
 
+	// orders triggered by other reference prices
+	pool.ReferencePriceUpdated(types.StopOrderTriggerReferenceMarkPrice, num.NewUint(48))
+	pool.Insert(withTriggerReference(
+		newPricedStopOrder("j", "p4", "k", num.NewUint(30), types.StopOrderTriggerDirectionFallsBelow),
+		types.StopOrderTriggerReferenceMarkPrice,
+	))
+	pool.Insert(withTriggerReference(
+		newTrailingStopOrder("k", "p4", "j", num.MustDecimalFromString("0.1"), types.StopOrderTriggerDirectionRisesAbove),
+		types.StopOrderTriggerReferenceMarkPrice,
+	))
+	pool.Insert(withTriggerReference(
+		newPricedStopOrder("l", "p4", "", num.NewUint(60), types.StopOrderTriggerDirectionRisesAbove),
+		types.StopOrderTriggerReferenceMidPrice,
+	))
+
 	// now we get the protos
 	serialized := pool.ToProto()
 
@@ -287,6 +287,67 @@ func TestOCOStopOrders(t *testing.T) {
 	})
 }
 
+func TestReferencedStopOrders(t *testing.T) {
+	pool := stoporders.New(logging.NewTestLogger())
+
+	pool.PriceUpdated(num.NewUint(50))
+	pool.ReferencePriceUpdated(types.StopOrderTriggerReferenceMarkPrice, num.NewUint(50))
+
+	// triggered by the last traded price
+	pool.Insert(newPricedStopOrder("a", "p1", "", num.NewUint(40), types.StopOrderTriggerDirectionFallsBelow))
+	// triggered by the mark price, and cancelling its OCO triggered by the mid price
+	pool.Insert(withTriggerReference(
+		newPricedStopOrder("b", "p2", "c", num.NewUint(40), types.StopOrderTriggerDirectionFallsBelow),
+		types.StopOrderTriggerReferenceMarkPrice,
+	))
+	pool.Insert(withTriggerReference(
+		newPricedStopOrder("c", "p2", "b", num.NewUint(60), types.StopOrderTriggerDirectionRisesAbove),
+		types.StopOrderTriggerReferenceMidPrice,
+	))
+	// trailing from the mark price
+	pool.Insert(withTriggerReference(
+		newTrailingStopOrder("d", "p3", "", num.MustDecimalFromString("0.1"), types.StopOrderTriggerDirectionFallsBelow),
+		types.StopOrderTriggerReferenceMarkPrice,
+	))
+
+	assert.Equal(t, 1, pool.Priced().Len(types.StopOrderTriggerDirectionFallsBelow))
+	assert.Equal(t, 1, pool.ReferencedPriced(types.StopOrderTriggerReferenceMarkPrice).Len(types.StopOrderTriggerDirectionFallsBelow))
+	assert.Equal(t, 1, pool.ReferencedPriced(types.StopOrderTriggerReferenceMidPrice).Len(types.StopOrderTriggerDirectionRisesAbove))
+	assert.Equal(t, 1, pool.ReferencedTrailing(types.StopOrderTriggerReferenceMarkPrice).Len(types.StopOrderTriggerDirectionFallsBelow))
+
+	t.Run("last traded price only triggers its own orders", func(t *testing.T) {
+		// a thin book print doesn't move the mark price
+		triggeredOrders, cancelledOrders := pool.PriceUpdated(num.NewUint(30))
+		assert.Len(t, triggeredOrders, 1)
+		assert.Len(t, cancelledOrders, 0)
+		assert.Equal(t, "a", triggeredOrders[0].ID)
+		assert.Equal(t, 3, pool.Len())
+	})
+
+	t.Run("mark price triggers the trailing order", func(t *testing.T) {
+		triggeredOrders, cancelledOrders := pool.ReferencePriceUpdated(types.StopOrderTriggerReferenceMarkPrice, num.NewUint(45))
+		assert.Len(t, triggeredOrders, 1)
+		assert.Len(t, cancelledOrders, 0)
+		assert.Equal(t, "d", triggeredOrders[0].ID)
+		assert.Equal(t, 2, pool.Len())
+	})
+
+	t.Run("mark price triggers the priced order and cancels its OCO", func(t *testing.T) {
+		triggeredOrders, cancelledOrders := pool.ReferencePriceUpdated(types.StopOrderTriggerReferenceMarkPrice, num.NewUint(39))
+		assert.Len(t, triggeredOrders, 1)
+		assert.Len(t, cancelledOrders, 1)
+		assert.Equal(t, "b", triggeredOrders[0].ID)
+		assert.Equal(t, "c", cancelledOrders[0].ID)
+		assert.Equal(t, 0, pool.Len())
+		assert.Equal(t, 0, pool.ReferencedPriced(types.StopOrderTriggerReferenceMidPrice).Len(types.StopOrderTriggerDirectionRisesAbove))
+	})
+}
+
+func withTriggerReference(order *types.StopOrder, reference types.StopOrderTriggerReference) *types.StopOrder {
+	order.Trigger.Reference = reference
+	return order
+}
+
 func newPricedStopOrder(
 	id, party, ocoLinkID string,
 	price *num.Uint,
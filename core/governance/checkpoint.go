@@ -42,11 +42,12 @@ func (e *Engine) Name() types.CheckpointName {
 }
 
 func (e *Engine) Checkpoint() ([]byte, error) {
-	if len(e.enactedProposals) == 0 && len(e.activeProposals) == 0 {
+	if len(e.enactedProposals) == 0 && len(e.activeProposals) == 0 && len(e.delegations) == 0 {
 		return nil, nil
 	}
 	cp := &checkpointpb.Proposals{
-		Proposals: e.getCheckpointProposals(),
+		Proposals:   e.getCheckpointProposals(),
+		Delegations: delegationsAsProtoSlice(e.delegations),
 	}
 	return proto.Marshal(cp)
 }
@@ -156,7 +157,12 @@ func (e *Engine) Load(ctx context.Context, data []byte) error {
 		e.markets.UpdateMarket(ctx, mkt)
 	}
 
-	// send events for restored proposals
+	e.delegations = delegationsFromProto(cp.Delegations)
+	for _, d := range e.delegations.asSlice() {
+		evts = append(evts, events.NewGovernanceDelegationEvent(ctx, *d))
+	}
+
+	// send events for restored proposals and delegations
 	e.broker.SendBatch(evts)
 	// @TODO ensure OnTick is called
 	return nil
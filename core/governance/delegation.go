@@ -0,0 +1,213 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package governance
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/logging"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+)
+
+var (
+	ErrCannotDelegateToSelf   = errors.New("a party cannot be its own governance delegate")
+	ErrNoGovernanceDelegation = errors.New("no governance delegation to revoke")
+)
+
+// delegations holds the governance delegates registered by the parties, by
+// delegator and by scope. The scope is the proposal type the delegation applies
+// to, or ProposalTypeUnspecified for a delegation applying to all proposal types.
+//
+// Delegation is not transitive: the voting weight of a party only counts toward
+// the vote of its delegate if the delegate voted itself.
+type delegations map[string]map[types.ProposalType]*types.GovernanceDelegation
+
+func (d delegations) set(delegation *types.GovernanceDelegation) {
+	scopes, ok := d[delegation.Delegator]
+	if !ok {
+		scopes = map[types.ProposalType]*types.GovernanceDelegation{}
+		d[delegation.Delegator] = scopes
+	}
+	scopes[delegation.Scope()] = delegation
+}
+
+func (d delegations) remove(delegator string, scope types.ProposalType) bool {
+	scopes, ok := d[delegator]
+	if !ok {
+		return false
+	}
+	if _, ok := scopes[scope]; !ok {
+		return false
+	}
+	delete(scopes, scope)
+	if len(scopes) == 0 {
+		delete(d, delegator)
+	}
+	return true
+}
+
+// delegateFor returns the delegate of the party for the given proposal type. A
+// delegation specific to the proposal type overrides the one applying to all
+// proposal types.
+func (d delegations) delegateFor(delegator string, proposalType types.ProposalType) (string, bool) {
+	scopes, ok := d[delegator]
+	if !ok {
+		return "", false
+	}
+	if delegation, ok := scopes[proposalType]; ok {
+		return delegation.Delegate, true
+	}
+	if delegation, ok := scopes[types.ProposalTypeUnspecified]; ok {
+		return delegation.Delegate, true
+	}
+	return "", false
+}
+
+// delegatorsByDelegate returns the parties delegating their voting weight on
+// the given proposal type, grouped by delegate.
+func (d delegations) delegatorsByDelegate(proposalType types.ProposalType) map[string][]string {
+	delegators := map[string][]string{}
+	for delegator := range d {
+		if delegate, ok := d.delegateFor(delegator, proposalType); ok {
+			delegators[delegate] = append(delegators[delegate], delegator)
+		}
+	}
+	return delegators
+}
+
+// asSlice returns the delegations sorted by delegator and scope.
+func (d delegations) asSlice() []*types.GovernanceDelegation {
+	all := make([]*types.GovernanceDelegation, 0, len(d))
+	for _, scopes := range d {
+		for _, delegation := range scopes {
+			all = append(all, delegation)
+		}
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Delegator == all[j].Delegator {
+			return all[i].Scope() < all[j].Scope()
+		}
+		return all[i].Delegator < all[j].Delegator
+	})
+	return all
+}
+
+func delegationsAsProtoSlice(d delegations) []*vegapb.GovernanceDelegation {
+	all := d.asSlice()
+	ret := make([]*vegapb.GovernanceDelegation, 0, len(all))
+	for _, delegation := range all {
+		ret = append(ret, delegation.IntoProto())
+	}
+	return ret
+}
+
+func delegationsFromProto(pbs []*vegapb.GovernanceDelegation) delegations {
+	d := delegations{}
+	for _, pb := range pbs {
+		d.set(types.GovernanceDelegationFromProto(pb))
+	}
+	return d
+}
+
+// UpdateGovernanceDelegation registers, updates or revokes the governance
+// delegate of a party. If the party doesn't vote on a proposal its voting
+// weight counts toward the vote of its delegate, when the proposal closes.
+func (e *Engine) UpdateGovernanceDelegation(ctx context.Context, sub types.GovernanceDelegationSubmission, party string) error {
+	if sub.Delegate == party {
+		return ErrCannotDelegateToSelf
+	}
+
+	delegation := &types.GovernanceDelegation{
+		Delegator:    party,
+		Delegate:     sub.Delegate,
+		ProposalType: sub.ProposalType,
+		UpdatedAt:    e.timeService.GetTimeNow().UnixNano(),
+	}
+
+	if len(sub.Delegate) == 0 {
+		if !e.delegations.remove(party, delegation.Scope()) {
+			return ErrNoGovernanceDelegation
+		}
+	} else {
+		e.delegations.set(delegation)
+	}
+
+	if e.log.IsDebug() {
+		e.log.Debug("governance delegation updated",
+			logging.PartyID(party),
+			logging.String("delegation", sub.String()),
+		)
+	}
+	e.broker.Send(events.NewGovernanceDelegationEvent(ctx, *delegation))
+
+	return nil
+}
+
+// updateDelegationsValidatorKey moves the delegations from and to the old key
+// of a validator to its new key.
+func (e *Engine) updateDelegationsValidatorKey(ctx context.Context, oldKey, newKey string) {
+	now := e.timeService.GetTimeNow().UnixNano()
+	evts := []events.Event{}
+	revoke := func(delegator string, proposalType *types.ProposalType) {
+		evts = append(evts, events.NewGovernanceDelegationEvent(ctx, types.GovernanceDelegation{
+			Delegator:    delegator,
+			ProposalType: proposalType,
+			UpdatedAt:    now,
+		}))
+	}
+
+	for _, old := range e.delegations.asSlice() {
+		switch {
+		case old.Delegator == oldKey:
+			e.delegations.remove(oldKey, old.Scope())
+			revoke(oldKey, old.ProposalType)
+			if _, ok := e.delegations[newKey][old.Scope()]; ok || old.Delegate == newKey {
+				// the new key already has a delegate, or would be its own delegate
+				continue
+			}
+			updated := &types.GovernanceDelegation{
+				Delegator:    newKey,
+				Delegate:     old.Delegate,
+				ProposalType: old.ProposalType,
+				UpdatedAt:    now,
+			}
+			e.delegations.set(updated)
+			evts = append(evts, events.NewGovernanceDelegationEvent(ctx, *updated))
+		case old.Delegate == oldKey:
+			if old.Delegator == newKey {
+				// the validator can't be its own delegate
+				e.delegations.remove(newKey, old.Scope())
+				revoke(newKey, old.ProposalType)
+				continue
+			}
+			updated := &types.GovernanceDelegation{
+				Delegator:    old.Delegator,
+				Delegate:     newKey,
+				ProposalType: old.ProposalType,
+				UpdatedAt:    now,
+			}
+			e.delegations.set(updated)
+			evts = append(evts, events.NewGovernanceDelegationEvent(ctx, *updated))
+		}
+	}
+	if len(evts) > 0 {
+		e.broker.SendBatch(evts)
+	}
+}
@@ -140,6 +140,7 @@ type Engine struct {
 	activeBatchProposals map[string]*batchProposal
 	activeProposals      []*proposal
 	enactedProposals     []*proposal
+	delegations          delegations
 
 	// snapshot state
 	gss *governanceSnapshotState
@@ -169,6 +170,7 @@ func NewEngine(
 		activeProposals:        []*proposal{},
 		enactedProposals:       []*proposal{},
 		activeBatchProposals:   map[string]*batchProposal{},
+		delegations:            delegations{},
 		nodeProposalValidation: NewNodeValidation(log, assets, tm.GetTimeNow(), witness),
 		timeService:            tm,
 		broker:                 broker,
@@ -881,6 +883,7 @@ func (e *Engine) ValidatorKeyChanged(ctx context.Context, oldKey, newKey string)
 		e.updateValidatorKey(ctx, p.no, oldKey, newKey)
 		e.updateValidatorKey(ctx, p.invalidVotes, oldKey, newKey)
 	}
+	e.updateDelegationsValidatorKey(ctx, oldKey, newKey)
 }
 
 // AddVote adds a vote onto an existing active proposal.
@@ -920,6 +923,15 @@ func (e *Engine) addVote(ctx context.Context, cmd types.VoteSubmission, proposal
 		return err
 	}
 
+	if err := validateVoteOptions(proposal.Proposal, cmd); err != nil {
+		e.log.Debug("invalid vote submission",
+			logging.PartyID(party),
+			logging.String("vote", cmd.String()),
+			logging.Error(err),
+		)
+		return err
+	}
+
 	vote := types.Vote{
 		PartyID:                     party,
 		ProposalID:                  cmd.ProposalID,
@@ -928,6 +940,7 @@ func (e *Engine) addVote(ctx context.Context, cmd types.VoteSubmission, proposal
 		TotalGovernanceTokenBalance: getTokensBalance(e.accs, party),
 		TotalGovernanceTokenWeight:  num.DecimalZero(),
 		TotalEquityLikeShareWeight:  num.DecimalZero(),
+		Options:                     cmd.Options,
 	}
 	if proposal.IsMarketUpdate() {
 		mID := proposal.MarketUpdate().MarketID
@@ -1199,7 +1212,7 @@ func (e *Engine) closeProposal(ctx context.Context, proposal *proposal) {
 		return
 	}
 
-	proposal.Close(e.accs, e.markets)
+	proposal.Close(e.accs, e.markets, e.delegations)
 	if proposal.IsPassed() {
 		e.log.Debug("Proposal passed", logging.ProposalID(proposal.ID))
 	} else if proposal.IsDeclined() {
@@ -1273,6 +1286,8 @@ func (e *Engine) updatedSpotMarketFromProposal(p *proposal) (*types.Market, type
 			TickSize:                  terms.Changes.TickSize,
 			LiquidityFeeSettings:      terms.Changes.LiquidityFeeSettings,
 			EnableTxReordering:        terms.Changes.EnableTxReordering,
+			FrequentBatchAuction:      terms.Changes.FrequentBatchAuction,
+			MatchingAlgorithm:         terms.Changes.MatchingAlgorithm,
 		},
 	}
 
@@ -1325,6 +1340,9 @@ func (e *Engine) updatedMarketFromProposal(p *proposal) (*types.Market, types.Pr
 			MarkPriceConfiguration:        terms.Changes.MarkPriceConfiguration,
 			TickSize:                      terms.Changes.TickSize,
 			EnableTxReordering:            terms.Changes.EnableTxReordering,
+			FrequentBatchAuction:          terms.Changes.FrequentBatchAuction,
+			PositionLimits:                terms.Changes.PositionLimits,
+			MatchingAlgorithm:             terms.Changes.MatchingAlgorithm,
 		},
 	}
 
@@ -1339,10 +1357,22 @@ func (e *Engine) updatedMarketFromProposal(p *proposal) (*types.Market, types.Pr
 		newMarket.Changes.RiskParameters = &types.NewMarketConfigurationLogNormal{
 			LogNormal: riskModel.LogNormal,
 		}
+	case *types.UpdateMarketConfigurationHistoricalSimulation:
+		newMarket.Changes.RiskParameters = &types.NewMarketConfigurationHistoricalSimulation{
+			HistoricalSimulation: riskModel.HistoricalSimulation,
+		}
 	default:
 		return nil, types.ProposalErrorUnknownRiskParameterType, ErrUnsupportedRiskParameters
 	}
 
+	// the historical simulation model recalculates its risk factors on a timer which
+	// is set up when the market is created, so the model family cannot change afterwards
+	_, isHistorical := terms.Changes.RiskParameters.(*types.UpdateMarketConfigurationHistoricalSimulation)
+	_, wasHistorical := existingMarket.TradableInstrument.RiskModel.(*types.TradableInstrumentHistoricalSimulationRiskModel)
+	if isHistorical != wasHistorical {
+		return nil, types.ProposalErrorInvalidRiskParameter, ErrHistoricalSimulationRiskModelChange
+	}
+
 	switch product := terms.Changes.Instrument.Product.(type) {
 	case nil:
 		return nil, types.ProposalErrorNoProduct, ErrMissingProduct
@@ -1375,6 +1405,7 @@ func (e *Engine) updatedMarketFromProposal(p *proposal) (*types.Market, types.Pr
 				DataSourceSpecForSettlementSchedule: product.Perps.DataSourceSpecForSettlementSchedule,
 				DataSourceSpecBinding:               product.Perps.DataSourceSpecBinding,
 				InternalCompositePriceConfig:        product.Perps.InternalCompositePrice,
+				IndexBasketConfig:                   product.Perps.IndexBasket,
 			},
 		}
 	default:
@@ -1389,7 +1420,15 @@ func (e *Engine) updatedMarketFromProposal(p *proposal) (*types.Market, types.Pr
 	}
 
 	previousAuctionDuration := time.Duration(existingMarket.OpeningAuction.Duration) * time.Second
-	return buildMarketFromProposal(existingMarket.ID, newMarket, e.netp, previousAuctionDuration)
+	mkt, perr, err := buildMarketFromProposal(existingMarket.ID, newMarket, e.netp, previousAuctionDuration)
+	if err != nil {
+		return nil, perr, err
+	}
+	// an outcome market of a prediction keeps its outcome
+	if outcome := existingMarket.GetPredictionOutcome(); outcome != nil {
+		mkt.GetFuture().Future.PredictionOutcome = outcome.DeepClone()
+	}
+	return mkt, perr, nil
 }
 
 func (e *Engine) updatedAssetFromProposal(p *proposal) (*types.Asset, types.ProposalError, error) {
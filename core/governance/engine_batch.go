@@ -210,7 +210,7 @@ func (e *Engine) evaluateBatchProposals(
 			// or, if the parent market state is gone (ie succession window has expired), the proposal simply
 			// loses its parent market reference
 			if proposal.ShouldClose(now) {
-				proposal.Close(e.accs, e.markets)
+				proposal.Close(e.accs, e.markets, e.delegations)
 				if proposal.IsPassed() {
 					e.log.Debug("Proposal passed",
 						logging.ProposalID(proposal.ID),
@@ -349,6 +349,11 @@ func (e *Engine) startBatchProposal(p *types.BatchProposal) {
 }
 
 func (e *Engine) addBatchVote(ctx context.Context, batchProposal *batchProposal, cmd types.VoteSubmission, party string) error {
+	// a batch proposal cannot contain a poll.
+	if len(cmd.Options) > 0 {
+		return ErrVoteOptionsNotExpected
+	}
+
 	validationErrs := vgerrors.NewCumulatedErrors()
 
 	perMarketELS := map[string]num.Decimal{}
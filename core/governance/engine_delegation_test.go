@@ -0,0 +1,250 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package governance_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/governance"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/ptr"
+	vgrand "code.vegaprotocol.io/vega/libs/rand"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGovernanceDelegation(t *testing.T) {
+	t.Run("Delegating to oneself fails", testDelegatingToOneselfFails)
+	t.Run("Revoking a non-existing delegation fails", testRevokingNonExistingDelegationFails)
+	t.Run("Weight of a party that doesn't vote counts toward its delegate's vote", testDelegatedWeightCountsTowardDelegateVote)
+	t.Run("Voting overrides the delegation", testVotingOverridesDelegation)
+	t.Run("Delegation for a proposal type overrides the one for all types", testProposalTypeDelegationOverridesGlobalOne)
+	t.Run("Revoked delegation is not counted", testRevokedDelegationIsNotCounted)
+	t.Run("Delegation is not transitive", testDelegationIsNotTransitive)
+	t.Run("Delegations are moved to the new key of a validator", testDelegationsMovedToNewValidatorKey)
+}
+
+func testDelegatingToOneselfFails(t *testing.T) {
+	eng := getTestEngine(t, time.Now())
+	party := vgrand.RandomStr(5)
+
+	err := eng.updateDelegation(t, party, party, nil)
+	assert.ErrorIs(t, err, governance.ErrCannotDelegateToSelf)
+}
+
+func testRevokingNonExistingDelegationFails(t *testing.T) {
+	eng := getTestEngine(t, time.Now())
+	party := vgrand.RandomStr(5)
+
+	err := eng.updateDelegation(t, party, "", nil)
+	assert.ErrorIs(t, err, governance.ErrNoGovernanceDelegation)
+
+	// a delegation for all proposal types doesn't make a delegation for a single type revocable
+	eng.expectGovernanceDelegationEvent(t, party, "delegate", nil)
+	require.NoError(t, eng.updateDelegation(t, party, "delegate", nil))
+	err = eng.updateDelegation(t, party, "", ptr.From(types.ProposalTypeNewFreeform))
+	assert.ErrorIs(t, err, governance.ErrNoGovernanceDelegation)
+}
+
+func testDelegatedWeightCountsTowardDelegateVote(t *testing.T) {
+	eng := getTestEngine(t, time.Now())
+
+	// without the delegated weight, the yes votes would only reach 50%
+	closeDelegatedFreeformVote(t, eng, types.ProposalStatePassed, func(delegate, _, delegator string) {
+		eng.expectGovernanceDelegationEvent(t, delegator, delegate, nil)
+		require.NoError(t, eng.updateDelegation(t, delegator, delegate, nil))
+	}, func(delegate, opponent string, votes map[string]vegapb.Vote) {
+		assert.Equal(t, "30", votes[delegate].DelegatedGovernanceTokenBalance)
+		assert.Equal(t, "0.8", votes[delegate].TotalGovernanceTokenWeight)
+		assert.Empty(t, votes[opponent].DelegatedGovernanceTokenBalance)
+		assert.Equal(t, "0.2", votes[opponent].TotalGovernanceTokenWeight)
+	})
+}
+
+func testVotingOverridesDelegation(t *testing.T) {
+	eng := getTestEngine(t, time.Now())
+
+	closeDelegatedFreeformVote(t, eng, types.ProposalStateDeclined, func(delegate, _, delegator string) {
+		eng.expectGovernanceDelegationEvent(t, delegator, delegate, nil)
+		require.NoError(t, eng.updateDelegation(t, delegator, delegate, nil))
+		eng.expectVoteEvent(t, delegator, "proposal-id-1")
+		require.NoError(t, eng.addNoVote(t, delegator, "proposal-id-1"))
+	}, func(delegate, _ string, votes map[string]vegapb.Vote) {
+		assert.Empty(t, votes[delegate].DelegatedGovernanceTokenBalance)
+		assert.Equal(t, "0.2", votes[delegate].TotalGovernanceTokenWeight)
+	})
+}
+
+func testProposalTypeDelegationOverridesGlobalOne(t *testing.T) {
+	eng := getTestEngine(t, time.Now())
+
+	closeDelegatedFreeformVote(t, eng, types.ProposalStateDeclined, func(delegate, opponent, delegator string) {
+		eng.expectGovernanceDelegationEvent(t, delegator, delegate, nil)
+		require.NoError(t, eng.updateDelegation(t, delegator, delegate, nil))
+		eng.expectGovernanceDelegationEvent(t, delegator, opponent, ptr.From(types.ProposalTypeNewFreeform))
+		require.NoError(t, eng.updateDelegation(t, delegator, opponent, ptr.From(types.ProposalTypeNewFreeform)))
+		// a delegation for another proposal type is ignored
+		eng.expectGovernanceDelegationEvent(t, delegator, delegate, ptr.From(types.ProposalTypeNewAsset))
+		require.NoError(t, eng.updateDelegation(t, delegator, delegate, ptr.From(types.ProposalTypeNewAsset)))
+	}, func(delegate, opponent string, votes map[string]vegapb.Vote) {
+		assert.Empty(t, votes[delegate].DelegatedGovernanceTokenBalance)
+		assert.Equal(t, "30", votes[opponent].DelegatedGovernanceTokenBalance)
+		assert.Equal(t, "0.8", votes[opponent].TotalGovernanceTokenWeight)
+	})
+}
+
+func testRevokedDelegationIsNotCounted(t *testing.T) {
+	eng := getTestEngine(t, time.Now())
+
+	closeDelegatedFreeformVote(t, eng, types.ProposalStateDeclined, func(delegate, _, delegator string) {
+		eng.expectGovernanceDelegationEvent(t, delegator, delegate, nil)
+		require.NoError(t, eng.updateDelegation(t, delegator, delegate, nil))
+		eng.expectGovernanceDelegationEvent(t, delegator, "", nil)
+		require.NoError(t, eng.updateDelegation(t, delegator, "", nil))
+	}, func(delegate, _ string, votes map[string]vegapb.Vote) {
+		assert.Empty(t, votes[delegate].DelegatedGovernanceTokenBalance)
+	})
+}
+
+func testDelegationIsNotTransitive(t *testing.T) {
+	eng := getTestEngine(t, time.Now())
+
+	closeDelegatedFreeformVote(t, eng, types.ProposalStateDeclined, func(delegate, _, delegator string) {
+		intermediary := vgrand.RandomStr(5)
+		eng.ensureTokenBalanceForParty(t, intermediary, 0)
+		eng.expectGovernanceDelegationEvent(t, delegator, intermediary, nil)
+		require.NoError(t, eng.updateDelegation(t, delegator, intermediary, nil))
+		eng.expectGovernanceDelegationEvent(t, intermediary, delegate, nil)
+		require.NoError(t, eng.updateDelegation(t, intermediary, delegate, nil))
+	}, func(delegate, _ string, votes map[string]vegapb.Vote) {
+		assert.Empty(t, votes[delegate].DelegatedGovernanceTokenBalance)
+	})
+}
+
+func testDelegationsMovedToNewValidatorKey(t *testing.T) {
+	eng := getTestEngine(t, time.Now())
+
+	oldKey := vgrand.RandomStr(5)
+	newKey := vgrand.RandomStr(5)
+	delegator := vgrand.RandomStr(5)
+	delegate := vgrand.RandomStr(5)
+
+	eng.expectGovernanceDelegationEvent(t, delegator, oldKey, nil)
+	require.NoError(t, eng.updateDelegation(t, delegator, oldKey, nil))
+	eng.expectGovernanceDelegationEvent(t, oldKey, delegate, nil)
+	require.NoError(t, eng.updateDelegation(t, oldKey, delegate, nil))
+
+	var delegations []vegapb.GovernanceDelegation
+	eng.broker.EXPECT().SendBatch(gomock.Any()).Times(1).Do(func(evts []events.Event) {
+		for _, evt := range evts {
+			delegations = append(delegations, evt.(*events.GovernanceDelegation).GovernanceDelegation())
+		}
+	})
+	eng.ValidatorKeyChanged(context.Background(), oldKey, newKey)
+
+	got := map[string]string{}
+	for _, d := range delegations {
+		got[d.Delegator] = d.Delegate
+	}
+	assert.Equal(t, map[string]string{
+		oldKey:    "",
+		newKey:    delegate,
+		delegator: newKey,
+	}, got)
+}
+
+// closeDelegatedFreeformVote runs a freeform proposal on which a delegate with
+// 10 tokens votes yes and an opponent with 10 tokens votes no, while a delegator
+// with 30 tokens doesn't vote unless told to by setup.
+func closeDelegatedFreeformVote(
+	t *testing.T,
+	eng *tstEngine,
+	expectedState types.ProposalState,
+	setup func(delegate, opponent, delegator string),
+	check func(delegate, opponent string, votes map[string]vegapb.Vote),
+) {
+	t.Helper()
+
+	proposer := vgrand.RandomStr(5)
+	delegate := vgrand.RandomStr(5)
+	opponent := vgrand.RandomStr(5)
+	delegator := vgrand.RandomStr(5)
+
+	eng.ensureAllAssetEnabled(t)
+	eng.ensureStakingAssetTotalSupply(t, 100)
+	eng.ensureTokenBalanceForParty(t, proposer, 1)
+	eng.ensureTokenBalanceForParty(t, delegate, 10)
+	eng.ensureTokenBalanceForParty(t, opponent, 10)
+	eng.ensureTokenBalanceForParty(t, delegator, 30)
+
+	proposal := eng.newFreeformProposal(proposer, eng.tsvc.GetTimeNow().Add(48*time.Hour))
+	require.Equal(t, "proposal-id-1", proposal.ID)
+	eng.expectOpenProposalEvent(t, proposer, proposal.ID)
+	_, err := eng.submitProposal(t, proposal)
+	require.NoError(t, err)
+
+	eng.expectVoteEvent(t, delegate, proposal.ID)
+	require.NoError(t, eng.addYesVote(t, delegate, proposal.ID))
+	eng.expectVoteEvent(t, opponent, proposal.ID)
+	require.NoError(t, eng.addNoVote(t, opponent, proposal.ID))
+
+	setup(delegate, opponent, delegator)
+
+	if expectedState == types.ProposalStatePassed {
+		eng.expectPassedProposalEvent(t, proposal.ID)
+	} else {
+		eng.expectDeclinedProposalEvent(t, proposal.ID, types.ProposalErrorMajorityThresholdNotReached)
+	}
+	votes := map[string]vegapb.Vote{}
+	eng.broker.EXPECT().SendBatch(gomock.Any()).Times(1).Do(func(evts []events.Event) {
+		for _, evt := range evts {
+			v := evt.(*events.Vote).Vote()
+			votes[v.PartyId] = v
+		}
+	})
+	eng.expectGetMarketState(t, proposal.ID)
+
+	afterClosing := time.Unix(proposal.Terms.ClosingTimestamp, 0).Add(time.Second)
+	eng.OnTick(context.Background(), afterClosing)
+
+	check(delegate, opponent, votes)
+}
+
+func (e *tstEngine) updateDelegation(t *testing.T, party, delegate string, proposalType *types.ProposalType) error {
+	t.Helper()
+	return e.UpdateGovernanceDelegation(context.Background(), types.GovernanceDelegationSubmission{
+		Delegate:     delegate,
+		ProposalType: proposalType,
+	}, party)
+}
+
+func (e *tstEngine) expectGovernanceDelegationEvent(t *testing.T, delegator, delegate string, proposalType *types.ProposalType) {
+	t.Helper()
+	e.broker.EXPECT().Send(gomock.Any()).Times(1).Do(func(evt events.Event) {
+		de, ok := evt.(*events.GovernanceDelegation)
+		require.True(t, ok)
+		d := de.GovernanceDelegation()
+		assert.Equal(t, delegator, d.Delegator)
+		assert.Equal(t, delegate, d.Delegate)
+		assert.Equal(t, proposalType, d.ProposalType)
+	})
+}
@@ -47,6 +47,8 @@ func TestProposalForNewMarket(t *testing.T) {
 	t.Run("Submitting a proposal for new perps market succeeds", testSubmittingProposalForNewPerpsMarketSucceeds)
 	t.Run("Submitting a proposal for new perps market succeeds 2", testSubmittingProposalForNewPerpsMarketWithCustomInitialTimeSucceeds)
 	t.Run("Submitting a proposal for new perps market with initial time in past fails", testSubmittingProposalForNewPerpsMarketWithPastInitialTimeFails)
+	t.Run("Submitting a proposal for new option market succeeds", testSubmittingProposalForNewOptionMarketSucceeds)
+	t.Run("Submitting a proposal for new option market expiring before enactment fails", testSubmittingProposalForNewOptionMarketExpiringBeforeEnactmentFails)
 	t.Run("Submitting a proposal with internal time termination for new market succeeds", testSubmittingProposalWithInternalTimeTerminationForNewMarketSucceeds)
 	t.Run("Submitting a proposal with internal time termination with `less than equal` condition fails", testSubmittingProposalWithInternalTimeTerminationWithLessThanEqualConditionForNewMarketFails)
 	t.Run("Submitting a proposal with internal time settling for new market fails", testSubmittingProposalWithInternalTimeSettlingForNewMarketFails)
@@ -1816,6 +1818,73 @@ func testSubmittingProposalForNewPerpsMarketWithPastInitialTimeFails(t *testing.
 	require.Nil(t, toSubmit)
 }
 
+// newProposalForNewOptionMarket turns a future market proposal into a call option on the same data sources.
+func (e *tstEngine) newProposalForNewOptionMarket(partyID string, now time.Time, expiry int64) types.Proposal {
+	proposal := e.newProposalForNewMarket(partyID, now, nil, nil, true)
+	instrument := proposal.Terms.GetNewMarket().Changes.Instrument
+	future := instrument.Product.(*types.InstrumentConfigurationFuture).Future
+	instrument.Product = &types.InstrumentConfigurationOption{
+		Option: &types.OptionProduct{
+			SettlementAsset:                     future.SettlementAsset,
+			QuoteName:                           future.QuoteName,
+			OptionType:                          types.OptionTypeCall,
+			StrikePrice:                         num.DecimalFromInt64(2000),
+			ExpiryTimestamp:                     expiry,
+			DataSourceSpecForSettlementData:     future.DataSourceSpecForSettlementData,
+			DataSourceSpecForTradingTermination: future.DataSourceSpecForTradingTermination,
+			DataSourceSpecBinding:               future.DataSourceSpecBinding,
+		},
+	}
+	return proposal
+}
+
+func testSubmittingProposalForNewOptionMarketSucceeds(t *testing.T) {
+	eng := getTestEngine(t, time.Now())
+	defer eng.ctrl.Finish()
+
+	// given
+	party := eng.newValidParty("a-valid-party", 123456789)
+	now := eng.tsvc.GetTimeNow().Add(2 * time.Hour)
+	proposal := eng.newProposalForNewOptionMarket(party.Id, now, now.Add(24*365*time.Hour).Unix())
+
+	// setup
+	eng.ensureAllAssetEnabled(t)
+	eng.expectOpenProposalEvent(t, party.Id, proposal.ID)
+
+	// when
+	toSubmit, err := eng.submitProposal(t, proposal)
+
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, toSubmit)
+	assert.True(t, toSubmit.IsNewMarket())
+	option := toSubmit.NewMarket().Market().GetOption()
+	require.NotNil(t, option)
+	assert.Equal(t, types.OptionTypeCall, option.Option.OptionType)
+	assert.Equal(t, "2000", option.Option.StrikePrice.String())
+}
+
+func testSubmittingProposalForNewOptionMarketExpiringBeforeEnactmentFails(t *testing.T) {
+	eng := getTestEngine(t, time.Now())
+	defer eng.ctrl.Finish()
+
+	// given
+	party := eng.newValidParty("a-valid-party", 123456789)
+	now := eng.tsvc.GetTimeNow().Add(2 * time.Hour)
+	proposal := eng.newProposalForNewOptionMarket(party.Id, now, now.Unix())
+
+	// setup
+	eng.ensureAllAssetEnabled(t)
+	eng.expectRejectedProposalEvent(t, party.Id, proposal.ID, types.ProposalErrorInvalidOptionProduct)
+
+	// when
+	toSubmit, err := eng.submitProposal(t, proposal)
+
+	// then
+	require.ErrorIs(t, err, governance.ErrOptionExpiryBeforeEnactment)
+	require.Nil(t, toSubmit)
+}
+
 func testInvalidDecimalPlace(t *testing.T) {
 	eng := getTestEngine(t, time.Now())
 	defer eng.ctrl.Finish()
@@ -0,0 +1,299 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package governance_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/governance"
+	"code.vegaprotocol.io/vega/core/types"
+	vgrand "code.vegaprotocol.io/vega/libs/rand"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolls(t *testing.T) {
+	t.Run("Plurality poll is won by the option with the greatest weight", testPluralityPollWinner)
+	t.Run("Approval poll counts the full weight of a vote toward each of its options", testApprovalPollWinner)
+	t.Run("Ranked-choice poll eliminates options until one has a majority", testRankedChoicePollWinner)
+	t.Run("Poll ending in a tie is declined", testTiedPollIsDeclined)
+	t.Run("Poll not reaching participation is declined", testPollWithoutParticipationIsDeclined)
+	t.Run("Weight delegated to a voter counts toward its options", testPollCountsDelegatedWeight)
+	t.Run("Invalid votes on a poll are refused", testInvalidPollVotesAreRefused)
+}
+
+type pollVote struct {
+	balance uint64
+	options []uint32
+}
+
+func testPluralityPollWinner(t *testing.T) {
+	result := closePoll(t, types.PollTallyMethodPlurality, 3, types.ProposalStatePassed, types.ProposalErrorUnspecified, []pollVote{
+		{balance: 10, options: []uint32{0}},
+		{balance: 20, options: []uint32{1}},
+		{balance: 5, options: []uint32{1}},
+	})
+
+	require.NotNil(t, result.WinningOption)
+	assert.Equal(t, uint32(1), *result.WinningOption)
+	assert.Equal(t, uint32(1), result.Rounds)
+	assert.Equal(t, []string{"10", "25", "0"}, tallyWeights(result))
+}
+
+func testApprovalPollWinner(t *testing.T) {
+	result := closePoll(t, types.PollTallyMethodApproval, 2, types.ProposalStatePassed, types.ProposalErrorUnspecified, []pollVote{
+		{balance: 10, options: []uint32{0, 1}},
+		{balance: 15, options: []uint32{1}},
+		{balance: 20, options: []uint32{0}},
+	})
+
+	require.NotNil(t, result.WinningOption)
+	assert.Equal(t, uint32(0), *result.WinningOption)
+	assert.Equal(t, []string{"30", "25"}, tallyWeights(result))
+}
+
+func testRankedChoicePollWinner(t *testing.T) {
+	// the first preferences favour option 0, but once option 2 is eliminated,
+	// its votes go to option 1.
+	result := closePoll(t, types.PollTallyMethodRankedChoice, 4, types.ProposalStatePassed, types.ProposalErrorUnspecified, []pollVote{
+		{balance: 40, options: []uint32{0}},
+		{balance: 35, options: []uint32{1, 2}},
+		{balance: 25, options: []uint32{2, 1}},
+	})
+
+	require.NotNil(t, result.WinningOption)
+	assert.Equal(t, uint32(1), *result.WinningOption)
+	assert.Equal(t, uint32(3), result.Rounds)
+	assert.Equal(t, []string{"40", "60", "25", "0"}, tallyWeights(result))
+	assert.Equal(t, uint32(0), result.Tallies[0].EliminatedInRound)
+	assert.Equal(t, uint32(0), result.Tallies[1].EliminatedInRound)
+	assert.Equal(t, uint32(2), result.Tallies[2].EliminatedInRound)
+	assert.Equal(t, uint32(1), result.Tallies[3].EliminatedInRound)
+}
+
+func testTiedPollIsDeclined(t *testing.T) {
+	result := closePoll(t, types.PollTallyMethodPlurality, 2, types.ProposalStateDeclined, types.ProposalErrorPollTied, []pollVote{
+		{balance: 10, options: []uint32{0}},
+		{balance: 10, options: []uint32{1}},
+	})
+	assert.Nil(t, result.WinningOption)
+
+	result = closePoll(t, types.PollTallyMethodRankedChoice, 2, types.ProposalStateDeclined, types.ProposalErrorPollTied, []pollVote{
+		{balance: 10, options: []uint32{0, 1}},
+		{balance: 10, options: []uint32{1, 0}},
+	})
+	assert.Nil(t, result.WinningOption)
+	assert.Equal(t, uint32(1), result.Rounds)
+}
+
+func testPollWithoutParticipationIsDeclined(t *testing.T) {
+	eng := getTestEngine(t, time.Now())
+	eng.ensureAllAssetEnabled(t)
+	// with a total supply this large, the votes don't reach the participation threshold.
+	eng.ensureStakingAssetTotalSupply(t, 100_000_000)
+
+	proposal := eng.submitPoll(t, types.PollTallyMethodPlurality, 2)
+	voter := vgrand.RandomStr(5)
+	eng.ensureTokenBalanceForParty(t, voter, 1)
+	eng.expectVoteEvent(t, voter, proposal.ID)
+	require.NoError(t, eng.addPollVote(t, voter, proposal.ID, 0))
+
+	result := eng.closePoll(t, proposal, types.ProposalStateDeclined, types.ProposalErrorParticipationThresholdNotReached)
+	require.NotNil(t, result.WinningOption)
+	assert.Equal(t, uint32(0), *result.WinningOption)
+}
+
+func testPollCountsDelegatedWeight(t *testing.T) {
+	eng := getTestEngine(t, time.Now())
+	eng.ensureAllAssetEnabled(t)
+	eng.ensureStakingAssetTotalSupply(t, 100)
+
+	proposal := eng.submitPoll(t, types.PollTallyMethodPlurality, 2)
+
+	delegate := vgrand.RandomStr(5)
+	opponent := vgrand.RandomStr(5)
+	delegator := vgrand.RandomStr(5)
+	eng.ensureTokenBalanceForParty(t, delegate, 10)
+	eng.ensureTokenBalanceForParty(t, opponent, 20)
+	eng.ensureTokenBalanceForParty(t, delegator, 30)
+
+	eng.expectVoteEvent(t, delegate, proposal.ID)
+	require.NoError(t, eng.addPollVote(t, delegate, proposal.ID, 0))
+	eng.expectVoteEvent(t, opponent, proposal.ID)
+	require.NoError(t, eng.addPollVote(t, opponent, proposal.ID, 1))
+	eng.expectGovernanceDelegationEvent(t, delegator, delegate, nil)
+	require.NoError(t, eng.updateDelegation(t, delegator, delegate, nil))
+
+	result := eng.closePoll(t, proposal, types.ProposalStatePassed, types.ProposalErrorUnspecified)
+	require.NotNil(t, result.WinningOption)
+	assert.Equal(t, uint32(0), *result.WinningOption)
+	assert.Equal(t, []string{"40", "20"}, tallyWeights(result))
+}
+
+func testInvalidPollVotesAreRefused(t *testing.T) {
+	eng := getTestEngine(t, time.Now())
+	eng.ensureAllAssetEnabled(t)
+
+	poll := eng.submitPoll(t, types.PollTallyMethodPlurality, 2)
+
+	freeform := eng.newFreeformProposal(vgrand.RandomStr(5), eng.tsvc.GetTimeNow().Add(48*time.Hour))
+	eng.ensureTokenBalanceForParty(t, freeform.Party, 1)
+	eng.expectOpenProposalEvent(t, freeform.Party, freeform.ID)
+	_, err := eng.submitProposal(t, freeform)
+	require.NoError(t, err)
+
+	voter := vgrand.RandomStr(5)
+	eng.ensureTokenBalanceForParty(t, voter, 10)
+
+	tcs := []struct {
+		name       string
+		proposalID string
+		value      types.VoteValue
+		options    []uint32
+		err        error
+	}{
+		{
+			name:       "options on a proposal that isn't a poll",
+			proposalID: freeform.ID,
+			value:      types.VoteValueYes,
+			options:    []uint32{0},
+			err:        governance.ErrVoteOptionsNotExpected,
+		}, {
+			name:       "no options on a poll",
+			proposalID: poll.ID,
+			value:      types.VoteValueYes,
+			err:        governance.ErrVoteOptionsRequired,
+		}, {
+			name:       "no vote on a poll",
+			proposalID: poll.ID,
+			value:      types.VoteValueNo,
+			err:        governance.ErrPollVoteMustBeYes,
+		}, {
+			name:       "option that doesn't exist",
+			proposalID: poll.ID,
+			value:      types.VoteValueYes,
+			options:    []uint32{2},
+			err:        governance.ErrVoteOptionDoesNotExist,
+		}, {
+			name:       "multiple options on a plurality poll",
+			proposalID: poll.ID,
+			value:      types.VoteValueYes,
+			options:    []uint32{0, 1},
+			err:        governance.ErrVoteRequiresSingleOption,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(tt *testing.T) {
+			err := eng.AddVote(context.Background(), types.VoteSubmission{
+				ProposalID: tc.proposalID,
+				Value:      tc.value,
+				Options:    tc.options,
+			}, voter)
+			assert.ErrorIs(tt, err, tc.err)
+		})
+	}
+}
+
+// closePoll runs a poll with the given votes, each from a different party, and
+// returns its result.
+func closePoll(t *testing.T, method types.PollTallyMethod, optionsCount int, expectedState types.ProposalState, expectedReason types.ProposalError, votes []pollVote) *vegapb.PollResult {
+	t.Helper()
+
+	eng := getTestEngine(t, time.Now())
+	eng.ensureAllAssetEnabled(t)
+	eng.ensureStakingAssetTotalSupply(t, 100)
+
+	proposal := eng.submitPoll(t, method, optionsCount)
+	for _, v := range votes {
+		voter := vgrand.RandomStr(5)
+		eng.ensureTokenBalanceForParty(t, voter, v.balance)
+		eng.expectVoteEvent(t, voter, proposal.ID)
+		require.NoError(t, eng.addPollVote(t, voter, proposal.ID, v.options...))
+	}
+
+	return eng.closePoll(t, proposal, expectedState, expectedReason)
+}
+
+func (e *tstEngine) submitPoll(t *testing.T, method types.PollTallyMethod, optionsCount int) types.Proposal {
+	t.Helper()
+
+	options := make([]string, 0, optionsCount)
+	for i := 0; i < optionsCount; i++ {
+		options = append(options, vgrand.RandomStr(5))
+	}
+
+	proposer := vgrand.RandomStr(5)
+	e.ensureTokenBalanceForParty(t, proposer, 1)
+	proposal := e.newFreeformProposal(proposer, e.tsvc.GetTimeNow().Add(48*time.Hour))
+	proposal.Terms.Change = &types.ProposalTermsNewFreeform{
+		NewFreeform: &types.NewFreeform{
+			Options:     options,
+			TallyMethod: method,
+		},
+	}
+
+	e.expectOpenProposalEvent(t, proposer, proposal.ID)
+	_, err := e.submitProposal(t, proposal)
+	require.NoError(t, err)
+	return proposal
+}
+
+func (e *tstEngine) addPollVote(t *testing.T, party, proposal string, options ...uint32) error {
+	t.Helper()
+	return e.AddVote(context.Background(), types.VoteSubmission{
+		ProposalID: proposal,
+		Value:      types.VoteValueYes,
+		Options:    options,
+	}, party)
+}
+
+func (e *tstEngine) closePoll(t *testing.T, proposal types.Proposal, expectedState types.ProposalState, expectedReason types.ProposalError) *vegapb.PollResult {
+	t.Helper()
+
+	var result *vegapb.PollResult
+	e.broker.EXPECT().Send(gomock.Any()).Times(1).Do(func(evt events.Event) {
+		pe, ok := evt.(*events.Proposal)
+		require.True(t, ok)
+		p := pe.Proposal()
+		assert.Equal(t, proposal.ID, p.Id)
+		assert.Equal(t, expectedState.String(), p.State.String())
+		assert.Equal(t, expectedReason.String(), p.GetReason().String())
+		result = p.PollResult
+	})
+	e.broker.EXPECT().SendBatch(gomock.Any()).Times(1)
+	e.expectGetMarketState(t, proposal.ID)
+
+	afterClosing := time.Unix(proposal.Terms.ClosingTimestamp, 0).Add(time.Second)
+	e.OnTick(context.Background(), afterClosing)
+
+	require.NotNil(t, result)
+	return result
+}
+
+func tallyWeights(result *vegapb.PollResult) []string {
+	weights := make([]string, 0, len(result.Tallies))
+	for _, t := range result.Tallies {
+		weights = append(weights, t.Weight)
+	}
+	return weights
+}
@@ -0,0 +1,226 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package impact estimates the effect a governance proposal would have if it was enacted.
+// It works on a copy of the state it's given and never mutates consensus state, so it can
+// be used outside of the core, e.g. by the data node, to dry-run a proposal before it is submitted.
+package impact
+
+import (
+	"errors"
+
+	"code.vegaprotocol.io/vega/core/monitor/price"
+	"code.vegaprotocol.io/vega/core/risk"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+)
+
+var (
+	ErrNoMarket                            = errors.New("no market to estimate the impact on")
+	ErrNoMarkPrice                         = errors.New("market has no mark price")
+	ErrMissingRiskParameters               = errors.New("missing risk parameters")
+	ErrUnsupportedRiskParameters           = errors.New("unsupported risk parameters")
+	ErrHistoricalSimulationRiskModelChange = errors.New("risk model cannot be changed to or from historical simulation")
+)
+
+// Position is the state of a party's position in the market, used as the baseline of the estimate.
+type Position struct {
+	PartyID    string
+	OpenVolume int64
+	// Collateral is the amount, in asset decimals, the party has available to cover its margin
+	// requirement in the market.
+	Collateral num.Decimal
+}
+
+// MarketState is the current state of a market. The mark price is expressed in market decimals,
+// the price factor converts it into asset decimals.
+type MarketState struct {
+	Market      *types.Market
+	MarkPrice   num.Decimal
+	PriceFactor num.Decimal
+	// RiskFactors are the risk factors currently used by the market, when nil they are computed
+	// from the market's risk model. They are ignored for a historical simulation risk model.
+	RiskFactors *types.RiskFactor
+	Positions   []Position
+}
+
+// PartyImpact describes how the maintenance margin of a party would change.
+type PartyImpact struct {
+	PartyID                   string
+	OpenVolume                int64
+	Collateral                num.Decimal
+	CurrentMaintenanceMargin  num.Decimal
+	ProposedMaintenanceMargin num.Decimal
+	CurrentlyDistressed       bool
+	Distressed                bool
+}
+
+// MarketImpact is the report of the estimated impact of an update to a market.
+type MarketImpact struct {
+	MarketID            string
+	MarkPrice           num.Decimal
+	CurrentRiskFactors  *types.RiskFactor
+	ProposedRiskFactors *types.RiskFactor
+	CurrentBounds       []*types.PriceMonitoringBounds
+	ProposedBounds      []*types.PriceMonitoringBounds
+	Parties             []*PartyImpact
+	// NewlyDistressedParties is the number of parties that would become distressed
+	// under the proposed parameters but aren't currently.
+	NewlyDistressedParties uint64
+}
+
+// EstimateUpdateMarket applies the proposed changes to a copy of the market and estimates the
+// risk factors, the price monitoring bounds around the mark price, and the maintenance margin of
+// every position, before and after the change.
+//
+// Only the open volume of the positions is considered, and any funding payment is ignored.
+// The historical simulation risk model is built from the history of the mark price which isn't
+// available outside of the core, so its fallback volatility is used to estimate both the current
+// and the proposed risk factors.
+func EstimateUpdateMarket(state MarketState, changes *types.UpdateMarketConfiguration) (*MarketImpact, error) {
+	if state.Market == nil || state.Market.TradableInstrument == nil {
+		return nil, ErrNoMarket
+	}
+	if !state.MarkPrice.IsPositive() {
+		return nil, ErrNoMarkPrice
+	}
+
+	proposedRiskModel, err := proposedRiskModel(state.Market, changes)
+	if err != nil {
+		return nil, err
+	}
+	assets, err := state.Market.GetAssets()
+	if err != nil {
+		return nil, err
+	}
+	asset := assets[0]
+	currentModel, err := risk.NewModel(state.Market.TradableInstrument.RiskModel, asset)
+	if err != nil {
+		return nil, err
+	}
+	proposedModel, err := risk.NewModel(proposedRiskModel, asset)
+	if err != nil {
+		return nil, err
+	}
+
+	// both sides of a historical simulation market are estimated from the fallback volatility,
+	// so they compare like for like with each other rather than with the market's actual factors.
+	currentRF := state.RiskFactors
+	if _, ok := currentModel.(risk.PriceHistoryModel); ok || currentRF == nil {
+		currentRF = riskFactors(currentModel)
+	}
+	proposedRF := riskFactors(proposedModel)
+
+	impact := &MarketImpact{
+		MarketID:            state.Market.ID,
+		MarkPrice:           state.MarkPrice,
+		CurrentRiskFactors:  currentRF,
+		ProposedRiskFactors: proposedRF,
+		Parties:             make([]*PartyImpact, 0, len(state.Positions)),
+	}
+
+	if settings := state.Market.PriceMonitoringSettings; settings != nil && settings.Parameters != nil {
+		if impact.CurrentBounds, err = price.EstimateBounds(currentModel, settings, state.MarkPrice); err != nil {
+			return nil, err
+		}
+	}
+	if changes.PriceMonitoringParameters != nil {
+		settings := &types.PriceMonitoringSettings{Parameters: changes.PriceMonitoringParameters}
+		if impact.ProposedBounds, err = price.EstimateBounds(proposedModel, settings, state.MarkPrice); err != nil {
+			return nil, err
+		}
+	}
+
+	markPrice := state.MarkPrice
+	if !state.PriceFactor.IsZero() {
+		markPrice = markPrice.Mul(state.PriceFactor)
+	}
+	positionFactor := num.DecimalFromInt64(10).Pow(num.DecimalFromInt64(state.Market.PositionDecimalPlaces))
+
+	for _, pos := range state.Positions {
+		if pos.OpenVolume == 0 {
+			continue
+		}
+		current := risk.CalculateMaintenanceMarginWithSlippageFactors(
+			pos.OpenVolume, nil, nil, markPrice, positionFactor,
+			state.Market.LinearSlippageFactor, state.Market.QuadraticSlippageFactor,
+			currentRF.Long, currentRF.Short, num.DecimalZero(), false, num.DecimalZero(),
+		)
+		proposed := risk.CalculateMaintenanceMarginWithSlippageFactors(
+			pos.OpenVolume, nil, nil, markPrice, positionFactor,
+			changes.LinearSlippageFactor, changes.QuadraticSlippageFactor,
+			proposedRF.Long, proposedRF.Short, num.DecimalZero(), false, num.DecimalZero(),
+		)
+		pi := &PartyImpact{
+			PartyID:                   pos.PartyID,
+			OpenVolume:                pos.OpenVolume,
+			Collateral:                pos.Collateral,
+			CurrentMaintenanceMargin:  current,
+			ProposedMaintenanceMargin: proposed,
+			CurrentlyDistressed:       pos.Collateral.LessThan(current),
+			Distressed:                pos.Collateral.LessThan(proposed),
+		}
+		if pi.Distressed && !pi.CurrentlyDistressed {
+			impact.NewlyDistressedParties++
+		}
+		impact.Parties = append(impact.Parties, pi)
+	}
+
+	return impact, nil
+}
+
+// proposedRiskModel returns the risk model of the market once the changes are applied, following
+// the same rules as the governance engine.
+func proposedRiskModel(mkt *types.Market, changes *types.UpdateMarketConfiguration) (interface{}, error) {
+	if changes == nil {
+		return nil, ErrMissingRiskParameters
+	}
+
+	var rm interface{}
+	switch parameters := changes.RiskParameters.(type) {
+	case nil:
+		return nil, ErrMissingRiskParameters
+	case *types.UpdateMarketConfigurationSimple:
+		rm = &types.TradableInstrumentSimpleRiskModel{
+			SimpleRiskModel: &types.SimpleRiskModel{
+				Params: parameters.Simple,
+			},
+		}
+	case *types.UpdateMarketConfigurationLogNormal:
+		rm = &types.TradableInstrumentLogNormalRiskModel{
+			LogNormalRiskModel: parameters.LogNormal,
+		}
+	case *types.UpdateMarketConfigurationHistoricalSimulation:
+		rm = &types.TradableInstrumentHistoricalSimulationRiskModel{
+			HistoricalSimulationRiskModel: parameters.HistoricalSimulation,
+		}
+	default:
+		return nil, ErrUnsupportedRiskParameters
+	}
+
+	_, isHistorical := rm.(*types.TradableInstrumentHistoricalSimulationRiskModel)
+	_, wasHistorical := mkt.TradableInstrument.RiskModel.(*types.TradableInstrumentHistoricalSimulationRiskModel)
+	if isHistorical != wasHistorical {
+		return nil, ErrHistoricalSimulationRiskModelChange
+	}
+	return rm, nil
+}
+
+func riskFactors(model risk.Model) *types.RiskFactor {
+	if _, ok := model.(risk.PriceHistoryModel); ok {
+		return model.DefaultRiskFactors()
+	}
+	return model.CalculateRiskFactors()
+}
@@ -0,0 +1,215 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package impact_test
+
+import (
+	"testing"
+
+	"code.vegaprotocol.io/vega/core/governance/impact"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateUpdateMarket(t *testing.T) {
+	t.Run("margins, risk factors and bounds are estimated", testEstimateUpdateMarket)
+	t.Run("market state is not modified", testEstimateUpdateMarketDoesNotMutateMarket)
+	t.Run("missing risk parameters are rejected", testEstimateUpdateMarketMissingRiskParameters)
+	t.Run("change of risk model family is rejected", testEstimateUpdateMarketHistoricalModelChange)
+	t.Run("historical simulation risk factors are compared like for like", testEstimateUpdateMarketHistoricalRiskFactors)
+	t.Run("mark price is required", testEstimateUpdateMarketNoMarkPrice)
+}
+
+func testEstimateUpdateMarket(t *testing.T) {
+	state := testMarketState()
+	changes := testUpdateMarketConfiguration()
+
+	report, err := impact.EstimateUpdateMarket(state, changes)
+	require.NoError(t, err)
+
+	assert.Equal(t, "market-1", report.MarketID)
+	assert.Equal(t, "0.1", report.CurrentRiskFactors.Long.String())
+	assert.Equal(t, "0.2", report.ProposedRiskFactors.Long.String())
+	assert.Equal(t, "0.3", report.ProposedRiskFactors.Short.String())
+
+	require.Len(t, report.CurrentBounds, 1)
+	assert.Equal(t, "90", report.CurrentBounds[0].MinValidPrice.String())
+	assert.Equal(t, "110", report.CurrentBounds[0].MaxValidPrice.String())
+	require.Len(t, report.ProposedBounds, 1)
+	assert.Equal(t, "95", report.ProposedBounds[0].MinValidPrice.String())
+	assert.Equal(t, "105", report.ProposedBounds[0].MaxValidPrice.String())
+
+	// the party without a position is skipped
+	require.Len(t, report.Parties, 3)
+	expected := []struct {
+		party               string
+		current, proposed   string
+		currentlyDistressed bool
+		distressed          bool
+	}{
+		// long 10 @ 100: 10*100*0.1 -> 10*100*0.01 + 10*100*0.2
+		{party: "party-1", current: "100", proposed: "210", distressed: true},
+		// short 5 @ 100: 5*100*0.1 -> 5*100*0.01 + 5*100*0.3
+		{party: "party-2", current: "50", proposed: "155"},
+		{party: "party-3", current: "100", proposed: "310", currentlyDistressed: true, distressed: true},
+	}
+	for i, e := range expected {
+		p := report.Parties[i]
+		assert.Equal(t, e.party, p.PartyID)
+		assert.Equal(t, e.current, p.CurrentMaintenanceMargin.String(), e.party)
+		assert.Equal(t, e.proposed, p.ProposedMaintenanceMargin.String(), e.party)
+		assert.Equal(t, e.currentlyDistressed, p.CurrentlyDistressed, e.party)
+		assert.Equal(t, e.distressed, p.Distressed, e.party)
+	}
+	assert.Equal(t, uint64(1), report.NewlyDistressedParties)
+}
+
+func testEstimateUpdateMarketDoesNotMutateMarket(t *testing.T) {
+	state := testMarketState()
+	riskModel := state.Market.TradableInstrument.RiskModel.String()
+	priceMonitoring := state.Market.PriceMonitoringSettings.String()
+
+	_, err := impact.EstimateUpdateMarket(state, testUpdateMarketConfiguration())
+	require.NoError(t, err)
+	assert.Equal(t, riskModel, state.Market.TradableInstrument.RiskModel.String())
+	assert.Equal(t, priceMonitoring, state.Market.PriceMonitoringSettings.String())
+	assert.True(t, state.Market.LinearSlippageFactor.IsZero())
+}
+
+func testEstimateUpdateMarketMissingRiskParameters(t *testing.T) {
+	changes := testUpdateMarketConfiguration()
+	changes.RiskParameters = nil
+
+	_, err := impact.EstimateUpdateMarket(testMarketState(), changes)
+	require.ErrorIs(t, err, impact.ErrMissingRiskParameters)
+}
+
+func testEstimateUpdateMarketHistoricalModelChange(t *testing.T) {
+	changes := testUpdateMarketConfiguration()
+	changes.RiskParameters = &types.UpdateMarketConfigurationHistoricalSimulation{
+		HistoricalSimulation: &types.HistoricalSimulationRiskModel{},
+	}
+
+	_, err := impact.EstimateUpdateMarket(testMarketState(), changes)
+	require.ErrorIs(t, err, impact.ErrHistoricalSimulationRiskModelChange)
+}
+
+func testEstimateUpdateMarketHistoricalRiskFactors(t *testing.T) {
+	model := &types.HistoricalSimulationRiskModel{
+		RiskAversionParameter: num.DecimalFromFloat(0.01),
+		Tau:                   num.DecimalFromFloat(1.0 / 365.25 / 24),
+		WindowSize:            100,
+		MinObservations:       10,
+		DecayFactor:           num.DecimalZero(),
+		FallbackSigma:         num.DecimalFromFloat(0.8),
+	}
+	state := testMarketState()
+	state.Market.TradableInstrument.RiskModel = &types.TradableInstrumentHistoricalSimulationRiskModel{
+		HistoricalSimulationRiskModel: model,
+	}
+	// the factors derived from the price history are ignored, the proposal can only use the fallback volatility
+	state.RiskFactors = &types.RiskFactor{
+		Long:  num.DecimalFromFloat(0.9),
+		Short: num.DecimalFromFloat(0.9),
+	}
+	changes := testUpdateMarketConfiguration()
+	changes.RiskParameters = &types.UpdateMarketConfigurationHistoricalSimulation{
+		HistoricalSimulation: model,
+	}
+
+	report, err := impact.EstimateUpdateMarket(state, changes)
+	require.NoError(t, err)
+	assert.True(t, report.CurrentRiskFactors.Long.Equal(report.ProposedRiskFactors.Long))
+	assert.True(t, report.CurrentRiskFactors.Short.Equal(report.ProposedRiskFactors.Short))
+	assert.False(t, report.CurrentRiskFactors.Long.Equal(state.RiskFactors.Long))
+}
+
+func testEstimateUpdateMarketNoMarkPrice(t *testing.T) {
+	state := testMarketState()
+	state.MarkPrice = num.DecimalZero()
+
+	_, err := impact.EstimateUpdateMarket(state, testUpdateMarketConfiguration())
+	require.ErrorIs(t, err, impact.ErrNoMarkPrice)
+}
+
+func testMarketState() impact.MarketState {
+	return impact.MarketState{
+		Market: &types.Market{
+			ID: "market-1",
+			TradableInstrument: &types.TradableInstrument{
+				Instrument: &types.Instrument{
+					Product: &types.InstrumentFuture{
+						Future: &types.Future{SettlementAsset: "asset-1"},
+					},
+				},
+				RiskModel: &types.TradableInstrumentSimpleRiskModel{
+					SimpleRiskModel: &types.SimpleRiskModel{
+						Params: simpleParams("0.1", "0.1", "10"),
+					},
+				},
+			},
+			PriceMonitoringSettings: &types.PriceMonitoringSettings{
+				Parameters: testPriceMonitoringParameters(),
+			},
+			LinearSlippageFactor:    num.DecimalZero(),
+			QuadraticSlippageFactor: num.DecimalZero(),
+		},
+		MarkPrice:   num.DecimalFromInt64(100),
+		PriceFactor: num.DecimalOne(),
+		Positions: []impact.Position{
+			{PartyID: "party-1", OpenVolume: 10, Collateral: num.DecimalFromInt64(150)},
+			{PartyID: "party-2", OpenVolume: -5, Collateral: num.DecimalFromInt64(1000)},
+			{PartyID: "party-3", OpenVolume: -10, Collateral: num.DecimalFromInt64(50)},
+			{PartyID: "party-4", OpenVolume: 0, Collateral: num.DecimalFromInt64(10)},
+		},
+	}
+}
+
+func testUpdateMarketConfiguration() *types.UpdateMarketConfiguration {
+	return &types.UpdateMarketConfiguration{
+		PriceMonitoringParameters: testPriceMonitoringParameters(),
+		RiskParameters: &types.UpdateMarketConfigurationSimple{
+			Simple: simpleParams("0.2", "0.3", "5"),
+		},
+		LinearSlippageFactor:    num.MustDecimalFromString("0.01"),
+		QuadraticSlippageFactor: num.DecimalZero(),
+	}
+}
+
+func testPriceMonitoringParameters() *types.PriceMonitoringParameters {
+	return &types.PriceMonitoringParameters{
+		Triggers: []*types.PriceMonitoringTrigger{
+			{
+				Horizon:          3600,
+				HorizonDec:       num.DecimalFromInt64(3600),
+				Probability:      num.MustDecimalFromString("0.99"),
+				AuctionExtension: 60,
+			},
+		},
+	}
+}
+
+func simpleParams(long, short, move string) *types.SimpleModelParams {
+	return &types.SimpleModelParams{
+		FactorLong:           num.MustDecimalFromString(long),
+		FactorShort:          num.MustDecimalFromString(short),
+		MaxMoveUp:            num.MustDecimalFromString(move),
+		MinMoveDown:          num.MustDecimalFromString(move),
+		ProbabilityOfTrading: num.MustDecimalFromString("0.1"),
+	}
+}
@@ -18,6 +18,7 @@ package governance
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"time"
 
@@ -62,15 +63,30 @@ var (
 	ErrMissingPerpsProduct = errors.New("missing perps product")
 	// ErrMissingFutureProduct is returned when future product is absent from the instrument.
 	ErrMissingFutureProduct = errors.New("missing future product")
+	// ErrMissingOptionProduct is returned when option product is absent from the instrument.
+	ErrMissingOptionProduct = errors.New("missing option product")
+	// ErrInvalidOptionType is returned when an option is neither a call nor a put.
+	ErrInvalidOptionType = errors.New("option type must be call or put")
+	// ErrInvalidOptionStrikePrice is returned when the strike price of an option is not positive.
+	ErrInvalidOptionStrikePrice = errors.New("option strike price must be positive")
+	// ErrOptionExpiryBeforeEnactment is returned when an option expires before the market is enacted.
+	ErrOptionExpiryBeforeEnactment = errors.New("option expiry before enactment")
 	// ErrMissingSpotProduct is returned when spot product is absent from the instrument.
 	ErrMissingSpotProduct = errors.New("missing spot product")
 	// ErrInvalidRiskParameter ...
 	ErrInvalidRiskParameter = errors.New("invalid risk parameter")
+	// ErrHistoricalSimulationRiskModelChange is returned when a market update switches to or from a historical simulation risk model.
+	ErrHistoricalSimulationRiskModelChange = errors.New("cannot switch to or from a historical simulation risk model")
 	// ErrInvalidInsurancePoolFraction is returned if the insurance pool fraction parameter is outside of the 0-1 range.
 	ErrInvalidInsurancePoolFraction          = errors.New("insurnace pool fraction invalid")
 	ErrUpdateMarketDifferentProduct          = errors.New("cannot update a market to a different product type")
 	ErrInvalidEVMChainIDInEthereumOracleSpec = errors.New("invalid source chain id in ethereum oracle spec")
 	ErrMaxPriceInvalid                       = errors.New("max price for capped future must be greater than zero")
+	ErrPredictionOutcomesTooFew              = errors.New("a prediction market must have at least 2 outcomes")
+	ErrPredictionOutcomeInvalidName          = errors.New("prediction outcome names must be unique and not empty")
+	ErrPredictionMustBeFullyCollateralised   = errors.New("a prediction market must be a binary and fully collateralised capped future")
+	ErrPredictionSettlementNotInteger        = errors.New("the settlement data of a prediction market must be the integer index of the winning outcome")
+	ErrPredictionCannotBeSuccessor           = errors.New("a prediction market cannot be a successor market")
 )
 
 func assignProduct(
@@ -103,6 +119,7 @@ func assignProduct(
 				DataSourceSpecForTradingTermination: datasource.SpecFromDefinition(product.Future.DataSourceSpecForTradingTermination),
 				DataSourceSpecBinding:               product.Future.DataSourceSpecBinding,
 				Cap:                                 product.Future.Cap,
+				PredictionOutcome:                   newPredictionOutcome(product.Future.PredictionOutcomes),
 			},
 		}
 	case *types.InstrumentConfigurationPerps:
@@ -137,6 +154,27 @@ func assignProduct(
 				DataSourceSpecForSettlementSchedule: datasource.SpecFromDefinition(product.Perps.DataSourceSpecForSettlementSchedule),
 				DataSourceSpecBinding:               product.Perps.DataSourceSpecBinding,
 				InternalCompositePriceConfig:        product.Perps.InternalCompositePriceConfig,
+				IndexBasketConfig:                   product.Perps.IndexBasketConfig,
+			},
+		}
+	case *types.InstrumentConfigurationOption:
+		if product.Option == nil {
+			return types.ProposalErrorInvalidOptionProduct, ErrMissingOptionProduct
+		}
+		if product.Option.DataSourceSpecBinding == nil {
+			return types.ProposalErrorInvalidOptionProduct, ErrMissingDataSourceSpecBinding
+		}
+
+		target.Product = &types.InstrumentOption{
+			Option: &types.Option{
+				SettlementAsset:                     product.Option.SettlementAsset,
+				QuoteName:                           product.Option.QuoteName,
+				OptionType:                          product.Option.OptionType,
+				StrikePrice:                         product.Option.StrikePrice,
+				ExpiryTimestamp:                     product.Option.ExpiryTimestamp,
+				DataSourceSpecForSettlementData:     datasource.SpecFromDefinition(product.Option.DataSourceSpecForSettlementData),
+				DataSourceSpecForTradingTermination: datasource.SpecFromDefinition(product.Option.DataSourceSpecForTradingTermination),
+				DataSourceSpecBinding:               product.Option.DataSourceSpecBinding,
 			},
 		}
 	case *types.InstrumentConfigurationSpot:
@@ -188,6 +226,10 @@ func assignRiskModel(definition *types.NewMarketConfiguration, target *types.Tra
 		target.RiskModel = &types.TradableInstrumentLogNormalRiskModel{
 			LogNormalRiskModel: parameters.LogNormal,
 		}
+	case *types.NewMarketConfigurationHistoricalSimulation:
+		target.RiskModel = &types.TradableInstrumentHistoricalSimulationRiskModel{
+			HistoricalSimulationRiskModel: parameters.HistoricalSimulation,
+		}
 	default:
 		return ErrUnsupportedRiskParameters
 	}
@@ -290,6 +332,9 @@ func buildMarketFromProposal(
 		MarkPriceConfiguration:        definition.Changes.MarkPriceConfiguration,
 		TickSize:                      definition.Changes.TickSize,
 		EnableTxReordering:            definition.Changes.EnableTxReordering,
+		FrequentBatchAuction:          definition.Changes.FrequentBatchAuction,
+		PositionLimits:                definition.Changes.PositionLimits,
+		MatchingAlgorithm:             definition.Changes.MatchingAlgorithm,
 	}
 	if fCap := market.TradableInstrument.Instrument.Product.Cap(); fCap != nil {
 		marginCalc.FullyCollateralised = fCap.FullyCollateralised
@@ -379,6 +424,8 @@ func buildSpotMarketFromProposal(
 		MarkPriceConfiguration:        defaultMarkPriceConfig,
 		TickSize:                      definition.Changes.TickSize,
 		EnableTxReordering:            definition.Changes.EnableTxReordering,
+		FrequentBatchAuction:          definition.Changes.FrequentBatchAuction,
+		MatchingAlgorithm:             definition.Changes.MatchingAlgorithm,
 	}
 	if err := assignSpotRiskModel(definition.Changes, market.TradableInstrument); err != nil {
 		return nil, types.ProposalErrorUnspecified, err
@@ -548,10 +595,80 @@ func validateFuture(future *types.FutureProduct, decimals uint64, positionDecima
 	if err := validateFutureCap(future.Cap, tickSize); err != nil {
 		return types.ProposalErrorInvalidFutureProduct, fmt.Errorf("invalid capped future configuration: %w", err)
 	}
+	if err := validatePredictionOutcomes(future); err != nil {
+		return types.ProposalErrorInvalidFutureProduct, fmt.Errorf("invalid prediction market configuration: %w", err)
+	}
 
 	return validateAsset(future.SettlementAsset, decimals, positionDecimals, assets, deepCheck)
 }
 
+// newPredictionOutcome returns the template of the outcome contracts of a prediction market, the market
+// is split into one market per outcome when it is submitted. Nil is returned for any other future.
+func newPredictionOutcome(outcomes []string) *types.PredictionOutcome {
+	if len(outcomes) == 0 {
+		return nil
+	}
+	return &types.PredictionOutcome{
+		Outcomes: slices.Clone(outcomes),
+	}
+}
+
+func validatePredictionOutcomes(future *types.FutureProduct) error {
+	if len(future.PredictionOutcomes) == 0 {
+		return nil
+	}
+	if len(future.PredictionOutcomes) < 2 {
+		return ErrPredictionOutcomesTooFew
+	}
+	names := make(map[string]struct{}, len(future.PredictionOutcomes))
+	for _, name := range future.PredictionOutcomes {
+		if _, ok := names[name]; ok || len(name) == 0 {
+			return ErrPredictionOutcomeInvalidName
+		}
+		names[name] = struct{}{}
+	}
+	// the outcome contracts settle at either zero or the max price, and complete sets are backed by the max price
+	if future.Cap == nil || !future.Cap.Binary || !future.Cap.FullyCollateralised {
+		return ErrPredictionMustBeFullyCollateralised
+	}
+	for _, f := range future.DataSourceSpecForSettlementData.GetFilters() {
+		if f.Key.Name == future.DataSourceSpecBinding.SettlementDataProperty && f.Key.Type != datapb.PropertyKey_TYPE_INTEGER {
+			return ErrPredictionSettlementNotInteger
+		}
+	}
+	return nil
+}
+
+// validateOption applies the checks of a future to the oracle set up of the option,
+// and then validates the terms of the option itself.
+func validateOption(option *types.OptionProduct, decimals uint64, positionDecimals int64, assets Assets, et *enactmentTime, deepCheck bool, evmChainIDs []uint64, tickSize *num.Uint) (types.ProposalError, error) {
+	if option.OptionType != types.OptionTypeCall && option.OptionType != types.OptionTypePut {
+		return types.ProposalErrorInvalidOptionProduct, ErrInvalidOptionType
+	}
+	if !option.StrikePrice.IsPositive() {
+		return types.ProposalErrorInvalidOptionProduct, ErrInvalidOptionStrikePrice
+	}
+	if !et.shouldNotVerify && option.ExpiryTimestamp <= et.current {
+		return types.ProposalErrorInvalidOptionProduct, ErrOptionExpiryBeforeEnactment
+	}
+
+	future := &types.FutureProduct{
+		SettlementAsset:                     option.SettlementAsset,
+		QuoteName:                           option.QuoteName,
+		DataSourceSpecForSettlementData:     option.DataSourceSpecForSettlementData,
+		DataSourceSpecForTradingTermination: option.DataSourceSpecForTradingTermination,
+		DataSourceSpecBinding:               option.DataSourceSpecBinding,
+	}
+	perr, err := validateFuture(future, decimals, positionDecimals, assets, et, deepCheck, evmChainIDs, tickSize)
+	// the future validation sets the defaults of the data sources
+	option.DataSourceSpecForSettlementData = future.DataSourceSpecForSettlementData
+	option.DataSourceSpecForTradingTermination = future.DataSourceSpecForTradingTermination
+	if err != nil && perr == types.ProposalErrorInvalidFutureProduct {
+		return types.ProposalErrorInvalidOptionProduct, err
+	}
+	return perr, err
+}
+
 func validateFutureCap(fCap *types.FutureCap, tickSize *num.Uint) error {
 	if fCap == nil {
 		return nil
@@ -675,6 +792,17 @@ func validatePerps(perps *types.PerpsProduct, decimals uint64, positionDecimals
 		}
 	}
 
+	if perps.IndexBasketConfig != nil {
+		for _, c := range perps.IndexBasketConfig.Constituents {
+			if !c.DataSource.Data.EnsureValidChainID(evmChainIDs) {
+				return types.ProposalErrorInvalidPerpsProduct, ErrInvalidEVMChainIDInEthereumOracleSpec
+			}
+			if _, err := spec.New(*datasource.SpecFromDefinition(*c.DataSource.Data)); err != nil {
+				return types.ProposalErrorInvalidPerpsProduct, fmt.Errorf("invalid index basket data source: %w", err)
+			}
+		}
+	}
+
 	return validateAsset(perps.SettlementAsset, decimals, positionDecimals, assets, deepCheck)
 }
 
@@ -684,6 +812,8 @@ func validateNewInstrument(instrument *types.InstrumentConfiguration, decimals u
 		return types.ProposalErrorNoProduct, ErrMissingProduct
 	case *types.InstrumentConfigurationFuture:
 		return validateFuture(product.Future, decimals, positionDecimals, assets, et, deepCheck, evmChainIDs, tickSize)
+	case *types.InstrumentConfigurationOption:
+		return validateOption(product.Option, decimals, positionDecimals, assets, et, deepCheck, evmChainIDs, tickSize)
 	case *types.InstrumentConfigurationPerps:
 		return validatePerps(product.Perps, decimals, positionDecimals, assets, et, *currentTime, deepCheck, evmChainIDs)
 	case *types.InstrumentConfigurationSpot:
@@ -708,6 +838,22 @@ func validateLogNormalRiskParams(lnm *types.LogNormalRiskModel) (types.ProposalE
 	return types.ProposalErrorUnspecified, nil
 }
 
+func validateHistoricalSimulationRiskParams(hsm *types.HistoricalSimulationRiskModel) (types.ProposalError, error) {
+	if hsm == nil {
+		return types.ProposalErrorInvalidRiskParameter, ErrInvalidRiskParameter
+	}
+
+	if hsm.RiskAversionParameter.LessThan(num.DecimalFromFloat(1e-8)) || hsm.RiskAversionParameter.GreaterThan(num.DecimalFromFloat(0.1)) || // 1e-8 <= lambda <= 0.1
+		hsm.Tau.LessThan(num.DecimalFromFloat(1e-8)) || hsm.Tau.GreaterThan(num.DecimalOne()) || // 1e-8 <= tau <=1
+		hsm.WindowSize < 2 || hsm.WindowSize > 100000 || // 2 <= window size <= 100000
+		hsm.MinObservations < 2 || hsm.MinObservations > hsm.WindowSize || // 2 <= min observations <= window size
+		hsm.DecayFactor.IsNegative() || hsm.DecayFactor.GreaterThanOrEqual(num.DecimalOne()) || // 0 <= decay factor < 1
+		hsm.FallbackSigma.LessThan(num.DecimalFromFloat(1e-3)) || hsm.FallbackSigma.GreaterThan(num.DecimalFromInt64(50)) { // 1e-3 <= sigma <= 50
+		return types.ProposalErrorInvalidRiskParameter, ErrInvalidRiskParameter
+	}
+	return types.ProposalErrorUnspecified, nil
+}
+
 func validateRiskParameters(rp interface{}) (types.ProposalError, error) {
 	switch r := rp.(type) {
 	case *types.NewMarketConfigurationSimple:
@@ -718,6 +864,10 @@ func validateRiskParameters(rp interface{}) (types.ProposalError, error) {
 		return validateLogNormalRiskParams(r.LogNormal)
 	case *types.UpdateMarketConfigurationLogNormal:
 		return validateLogNormalRiskParams(r.LogNormal)
+	case *types.NewMarketConfigurationHistoricalSimulation:
+		return validateHistoricalSimulationRiskParams(r.HistoricalSimulation)
+	case *types.UpdateMarketConfigurationHistoricalSimulation:
+		return validateHistoricalSimulationRiskParams(r.HistoricalSimulation)
 	case *types.NewSpotMarketConfigurationSimple:
 		return types.ProposalErrorUnspecified, nil
 	case *types.UpdateSpotMarketConfigurationSimple:
@@ -753,6 +903,12 @@ func validateLiquidationStrategy(ls *types.LiquidationStrategy) (types.ProposalE
 	if ls.DisposalSlippage.IsZero() || ls.DisposalSlippage.IsNegative() {
 		return types.ProposalErrorInvalidMarket, fmt.Errorf("liquidation strategy must specify a disposal slippage range > 0")
 	}
+	if ls.PartialCloseOutStep.IsNegative() || ls.PartialCloseOutStep.GreaterThan(num.DecimalOne()) {
+		return types.ProposalErrorInvalidMarket, fmt.Errorf("liquidation strategy partial close-out step must be in the 0-1 range")
+	}
+	if ls.PartialCloseOutBuffer.IsNegative() {
+		return types.ProposalErrorInvalidMarket, fmt.Errorf("liquidation strategy partial close-out buffer can't be negative")
+	}
 	return types.ProposalErrorUnspecified, nil
 }
 
@@ -884,6 +1040,9 @@ func validateNewMarketChange(
 	if perr, err := validateSuccessorMarket(terms, parent, restore); err != nil {
 		return perr, err
 	}
+	if future, ok := terms.Changes.Instrument.Product.(*types.InstrumentConfigurationFuture); ok && len(future.Future.PredictionOutcomes) > 0 && terms.Successor() != nil {
+		return types.ProposalErrorInvalidSuccessorMarket, ErrPredictionCannotBeSuccessor
+	}
 	if perr, err := validateRiskParameters(terms.Changes.RiskParameters); err != nil {
 		return perr, err
 	}
@@ -0,0 +1,232 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package governance
+
+import (
+	"errors"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/ptr"
+)
+
+var (
+	ErrVoteOptionsNotExpected   = errors.New("options can only be set on votes for a poll")
+	ErrVoteOptionsRequired      = errors.New("a vote for a poll requires options")
+	ErrVoteOptionDoesNotExist   = errors.New("vote option does not exist in the poll")
+	ErrVoteRequiresSingleOption = errors.New("a vote for a plurality poll requires a single option")
+	ErrPollVoteMustBeYes        = errors.New("a vote for a poll must be a yes vote")
+)
+
+// validateVoteOptions checks the options of a vote against the proposal it is
+// cast on.
+func validateVoteOptions(proposal *types.Proposal, cmd types.VoteSubmission) error {
+	if !proposal.IsPoll() {
+		if len(cmd.Options) > 0 {
+			return ErrVoteOptionsNotExpected
+		}
+		return nil
+	}
+
+	if cmd.Value != types.VoteValueYes {
+		return ErrPollVoteMustBeYes
+	}
+	if len(cmd.Options) == 0 {
+		return ErrVoteOptionsRequired
+	}
+
+	poll := proposal.Terms.GetNewFreeform()
+	if poll.TallyMethod == types.PollTallyMethodPlurality && len(cmd.Options) != 1 {
+		return ErrVoteRequiresSingleOption
+	}
+	for _, option := range cmd.Options {
+		if int(option) >= len(poll.Options) {
+			return ErrVoteOptionDoesNotExist
+		}
+	}
+	return nil
+}
+
+// computeVoteStateForPoll tallies the votes of a poll. A poll is passed when
+// the participation threshold is reached and one option wins, the majority
+// threshold doesn't apply.
+func (p *proposal) computeVoteStateForPoll(accounts StakingAccounts, delegators map[string][]string) (types.ProposalState, types.ProposalError) {
+	totalStake := accounts.GetStakingAssetTotalSupply()
+
+	// votes on a poll are all in favour of it.
+	totalTokens := p.countTokens(p.yes, accounts)
+	totalTokens.AddSum(p.countDelegatedTokens(p.yes, delegators, accounts))
+	totalTokensDec := num.DecimalFromUint(totalTokens)
+	p.weightVotesFromToken(p.yes, totalTokensDec)
+
+	p.PollResult = tallyPoll(p.Terms.GetNewFreeform(), p.yes)
+
+	participationThreshold := num.DecimalFromUint(totalStake).Mul(p.RequiredParticipation)
+	if totalTokens.IsZero() || totalTokensDec.LessThan(participationThreshold) {
+		return types.ProposalStateDeclined, types.ProposalErrorParticipationThresholdNotReached
+	}
+
+	if p.PollResult.WinningOption == nil {
+		return types.ProposalStateDeclined, types.ProposalErrorPollTied
+	}
+
+	return types.ProposalStatePassed, types.ProposalErrorUnspecified
+}
+
+func tallyPoll(poll *types.NewFreeform, votes map[string]*types.Vote) *types.PollResult {
+	if poll.TallyMethod == types.PollTallyMethodRankedChoice {
+		return tallyRankedChoicePoll(len(poll.Options), votes)
+	}
+
+	// with plurality, votes have a single option, so it is tallied like
+	// approval.
+	weights := zeroWeights(len(poll.Options))
+	for _, v := range votes {
+		w := voteTokens(v)
+		for _, option := range v.Options {
+			weights[option].AddSum(w)
+		}
+	}
+
+	result := &types.PollResult{
+		Tallies:       make([]*types.PollOptionTally, 0, len(weights)),
+		WinningOption: leadingOption(weights),
+		Rounds:        1,
+	}
+	for _, w := range weights {
+		result.Tallies = append(result.Tallies, &types.PollOptionTally{Weight: w})
+	}
+	return result
+}
+
+// tallyRankedChoicePoll runs an instant runoff: at every round, each vote counts
+// toward the option it prefers among the ones still running, and the options
+// with the least weight are eliminated, until an option has a majority of the
+// weight counted in the round. If all the options still running are tied, the
+// poll has no winner.
+func tallyRankedChoicePoll(optionsCount int, votes map[string]*types.Vote) *types.PollResult {
+	result := &types.PollResult{
+		Tallies: make([]*types.PollOptionTally, 0, optionsCount),
+	}
+	for i := 0; i < optionsCount; i++ {
+		result.Tallies = append(result.Tallies, &types.PollOptionTally{Weight: num.UintZero()})
+	}
+
+	running := func(option uint32) bool {
+		return result.Tallies[option].EliminatedInRound == 0
+	}
+
+	for {
+		result.Rounds++
+
+		weights := zeroWeights(optionsCount)
+		total := num.UintZero()
+		for _, v := range votes {
+			for _, option := range v.Options {
+				if running(option) {
+					w := voteTokens(v)
+					weights[option].AddSum(w)
+					total.AddSum(w)
+					break
+				}
+			}
+		}
+
+		var (
+			least        *num.Uint
+			runningCount int
+		)
+		for i, w := range weights {
+			if !running(uint32(i)) {
+				continue
+			}
+			result.Tallies[i].Weight = w
+			runningCount++
+			if least == nil || w.LT(least) {
+				least = w
+			}
+		}
+
+		if total.IsZero() {
+			return result
+		}
+
+		for i, w := range weights {
+			if running(uint32(i)) && num.Sum(w, w).GT(total) {
+				result.WinningOption = ptr.From(uint32(i))
+				return result
+			}
+		}
+
+		var eliminated []int
+		for i, w := range weights {
+			if running(uint32(i)) && w.EQ(least) {
+				eliminated = append(eliminated, i)
+			}
+		}
+		if len(eliminated) == runningCount {
+			return result
+		}
+		for _, i := range eliminated {
+			result.Tallies[i].EliminatedInRound = result.Rounds
+		}
+	}
+}
+
+// leadingOption returns the option with the greatest weight, or nil if there
+// is a tie or if no option has any weight.
+func leadingOption(weights []*num.Uint) *uint32 {
+	var (
+		leader *uint32
+		tied   bool
+	)
+	for i, w := range weights {
+		if w.IsZero() {
+			continue
+		}
+		if leader == nil || w.GT(weights[*leader]) {
+			leader = ptr.From(uint32(i))
+			tied = false
+		} else if w.EQ(weights[*leader]) {
+			tied = true
+		}
+	}
+	if tied {
+		return nil
+	}
+	return leader
+}
+
+// voteTokens returns the number of governance tokens a vote is weighted by,
+// including the tokens delegated to the voter.
+func voteTokens(v *types.Vote) *num.Uint {
+	tokens := num.UintZero()
+	if v.TotalGovernanceTokenBalance != nil {
+		tokens.AddSum(v.TotalGovernanceTokenBalance)
+	}
+	if v.DelegatedGovernanceTokenBalance != nil {
+		tokens.AddSum(v.DelegatedGovernanceTokenBalance)
+	}
+	return tokens
+}
+
+func zeroWeights(n int) []*num.Uint {
+	weights := make([]*num.Uint, 0, n)
+	for i := 0; i < n; i++ {
+		weights = append(weights, num.UintZero())
+	}
+	return weights
+}
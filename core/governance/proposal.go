@@ -106,10 +106,11 @@ func (p *proposal) AddVote(vote types.Vote) error {
 }
 
 // Close determines the state of the proposal, passed or declined based on the
-// vote balance and weight.
+// vote balance and weight. The weight of the parties that didn't vote counts
+// toward the vote of their governance delegate, if any.
 // Warning: this method should only be called once. Use ShouldClose() to know
 // when to call.
-func (p *proposal) Close(accounts StakingAccounts, markets Markets) {
+func (p *proposal) Close(accounts StakingAccounts, markets Markets, delegations delegations) {
 	if !p.IsOpen() {
 		return
 	}
@@ -119,7 +120,13 @@ func (p *proposal) Close(accounts StakingAccounts, markets Markets) {
 		p.purgeBlankVotes(p.no)
 	}()
 
-	tokenVoteState, tokenVoteError := p.computeVoteStateUsingTokens(accounts)
+	delegators := delegations.delegatorsByDelegate(p.Terms.Change.GetTermType().ProposalType())
+	if p.IsPoll() {
+		p.State, p.Reason = p.computeVoteStateForPoll(accounts, delegators)
+		return
+	}
+
+	tokenVoteState, tokenVoteError := p.computeVoteStateUsingTokens(accounts, delegators)
 
 	p.State = tokenVoteState
 	p.Reason = tokenVoteError
@@ -132,18 +139,20 @@ func (p *proposal) Close(accounts StakingAccounts, markets Markets) {
 	}
 
 	if tokenVoteState == types.ProposalStateDeclined && tokenVoteError == types.ProposalErrorParticipationThresholdNotReached {
-		elsVoteState, elsVoteError := p.computeVoteStateUsingEquityLikeShare(markets)
+		elsVoteState, elsVoteError := p.computeVoteStateUsingEquityLikeShare(markets, delegators)
 		p.State = elsVoteState
 		p.Reason = elsVoteError
 	}
 }
 
-func (p *proposal) computeVoteStateUsingTokens(accounts StakingAccounts) (types.ProposalState, types.ProposalError) {
+func (p *proposal) computeVoteStateUsingTokens(accounts StakingAccounts, delegators map[string][]string) (types.ProposalState, types.ProposalError) {
 	totalStake := accounts.GetStakingAssetTotalSupply()
 
 	yes := p.countTokens(p.yes, accounts)
+	yes.AddSum(p.countDelegatedTokens(p.yes, delegators, accounts))
 	yesDec := num.DecimalFromUint(yes)
 	no := p.countTokens(p.no, accounts)
+	no.AddSum(p.countDelegatedTokens(p.no, delegators, accounts))
 	totalTokens := num.Sum(yes, no)
 	totalTokensDec := num.DecimalFromUint(totalTokens)
 	p.weightVotesFromToken(p.yes, totalTokensDec)
@@ -169,9 +178,9 @@ func (p *proposal) computeVoteStateUsingTokens(accounts StakingAccounts) (types.
 	return types.ProposalStateDeclined, types.ProposalErrorMajorityThresholdNotReached
 }
 
-func (p *proposal) computeVoteStateUsingEquityLikeShare(markets Markets) (types.ProposalState, types.ProposalError) {
-	yes := p.countEquityLikeShare(p.yes, markets)
-	no := p.countEquityLikeShare(p.no, markets)
+func (p *proposal) computeVoteStateUsingEquityLikeShare(markets Markets, delegators map[string][]string) (types.ProposalState, types.ProposalError) {
+	yes := p.countEquityLikeShare(p.yes, markets).Add(p.countDelegatedEquityLikeShare(p.yes, delegators, markets))
+	no := p.countEquityLikeShare(p.no, markets).Add(p.countDelegatedEquityLikeShare(p.no, delegators, markets))
 	totalEquityLikeShare := yes.Add(no)
 	threshold := totalEquityLikeShare.Mul(p.RequiredLPMajority)
 
@@ -196,15 +205,36 @@ func (p *proposal) countTokens(votes map[string]*types.Vote, accounts StakingAcc
 	return tally
 }
 
+// countDelegatedTokens adds the tokens of the parties that didn't vote to the
+// votes of their delegates, and returns the total number of delegated tokens.
+func (p *proposal) countDelegatedTokens(votes map[string]*types.Vote, delegators map[string][]string, accounts StakingAccounts) *num.Uint {
+	tally := num.UintZero()
+	for _, v := range votes {
+		// the votes are shared by the proposals of a batch, so anything
+		// delegated on a previous proposal has to be reset.
+		v.DelegatedGovernanceTokenBalance = nil
+		delegated := num.UintZero()
+		for _, delegator := range delegators[v.PartyID] {
+			if p.hasVoted(delegator) {
+				continue
+			}
+			if balance := getTokensBalance(accounts, delegator); balance != nil {
+				delegated.AddSum(balance)
+			}
+		}
+		if !delegated.IsZero() {
+			v.DelegatedGovernanceTokenBalance = delegated
+			tally.AddSum(delegated)
+		}
+	}
+
+	return tally
+}
+
 func (p *proposal) countEquityLikeShare(votes map[string]*types.Vote, markets Markets) num.Decimal {
 	tally := num.DecimalZero()
+	marketID := p.updatedMarketID()
 	for _, v := range votes {
-		var marketID string
-		if p.MarketUpdate() != nil {
-			marketID = p.MarketUpdate().MarketID
-		} else {
-			marketID = p.SpotMarketUpdate().MarketID
-		}
 		v.TotalEquityLikeShareWeight, _ = markets.GetEquityLikeShareForMarketAndParty(marketID, v.PartyID)
 		tally = tally.Add(v.TotalEquityLikeShareWeight)
 	}
@@ -212,6 +242,42 @@ func (p *proposal) countEquityLikeShare(votes map[string]*types.Vote, markets Ma
 	return tally
 }
 
+// countDelegatedEquityLikeShare adds the equity-like share of the parties that
+// didn't vote to the votes of their delegates, and returns the total delegated
+// equity-like share.
+func (p *proposal) countDelegatedEquityLikeShare(votes map[string]*types.Vote, delegators map[string][]string, markets Markets) num.Decimal {
+	tally := num.DecimalZero()
+	marketID := p.updatedMarketID()
+	for _, v := range votes {
+		v.DelegatedEquityLikeShareWeight = num.DecimalZero()
+		for _, delegator := range delegators[v.PartyID] {
+			if p.hasVoted(delegator) {
+				continue
+			}
+			els, _ := markets.GetEquityLikeShareForMarketAndParty(marketID, delegator)
+			v.DelegatedEquityLikeShareWeight = v.DelegatedEquityLikeShareWeight.Add(els)
+		}
+		tally = tally.Add(v.DelegatedEquityLikeShareWeight)
+	}
+
+	return tally
+}
+
+func (p *proposal) updatedMarketID() string {
+	if p.MarketUpdate() != nil {
+		return p.MarketUpdate().MarketID
+	}
+	return p.SpotMarketUpdate().MarketID
+}
+
+// hasVoted tells if the party voted itself on the proposal, which overrides its
+// governance delegation.
+func (p *proposal) hasVoted(party string) bool {
+	_, yes := p.yes[party]
+	_, no := p.no[party]
+	return yes || no
+}
+
 func (p *proposal) weightVotesFromToken(votes map[string]*types.Vote, totalVotes num.Decimal) {
 	if totalVotes.IsZero() {
 		return
@@ -219,16 +285,22 @@ func (p *proposal) weightVotesFromToken(votes map[string]*types.Vote, totalVotes
 
 	for _, v := range votes {
 		tokenBalanceDec := num.DecimalFromUint(v.TotalGovernanceTokenBalance)
+		if v.DelegatedGovernanceTokenBalance != nil {
+			tokenBalanceDec = tokenBalanceDec.Add(num.DecimalFromUint(v.DelegatedGovernanceTokenBalance))
+		}
 		v.TotalGovernanceTokenWeight = tokenBalanceDec.Div(totalVotes)
 	}
 }
 
 // purgeBlankVotes removes votes that don't have tokens or equity-like share
-// associated. The user may have withdrawn their governance token or their
-// equity-like share before the end of the vote.
+// associated, either their own or delegated to them. The user may have withdrawn
+// their governance token or their equity-like share before the end of the vote.
 // We will then purge them from the map if it's the case.
 func (p *proposal) purgeBlankVotes(votes map[string]*types.Vote) {
 	for k, v := range votes {
+		if v.DelegatedGovernanceTokenBalance != nil || !v.DelegatedEquityLikeShareWeight.IsZero() {
+			continue
+		}
 		if v.TotalGovernanceTokenBalance.IsZero() && v.TotalEquityLikeShareWeight.IsZero() {
 			p.invalidVotes[k] = v
 			delete(votes, k)
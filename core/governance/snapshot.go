@@ -36,12 +36,14 @@ var (
 	enactedKey        = (&types.PayloadGovernanceEnacted{}).Key()
 	nodeValidationKey = (&types.PayloadGovernanceNode{}).Key()
 	batchActiveKey    = (&types.PayloadGovernanceBatchActive{}).Key()
+	delegationsKey    = (&types.PayloadGovernanceDelegations{}).Key()
 
 	hashKeys = []string{
 		activeKey,
 		enactedKey,
 		nodeValidationKey,
 		batchActiveKey,
+		delegationsKey,
 	}
 	defaultMarkPriceConfig = &types.CompositePriceConfiguration{
 		DecayWeight:        num.DecimalZero(),
@@ -56,6 +58,7 @@ type governanceSnapshotState struct {
 	serialisedEnacted        []byte
 	serialisedNodeValidation []byte
 	serialisedBatchActive    []byte
+	serialisedDelegations    []byte
 }
 
 func (e *Engine) OnStateLoaded(ctx context.Context) error {
@@ -231,6 +234,18 @@ func (e *Engine) serialiseNodeProposals() ([]byte, error) {
 	return proto.Marshal(pl.IntoProto())
 }
 
+// serialiseDelegations returns the governance delegations registered by the parties.
+func (e *Engine) serialiseDelegations() ([]byte, error) {
+	pl := types.Payload{
+		Data: &types.PayloadGovernanceDelegations{
+			GovernanceDelegations: &snapshotpb.GovernanceDelegations{
+				Delegations: delegationsAsProtoSlice(e.delegations),
+			},
+		},
+	}
+	return proto.Marshal(pl.IntoProto())
+}
+
 func (e *Engine) serialiseK(serialFunc func() ([]byte, error), dataField *[]byte) ([]byte, error) {
 	data, err := serialFunc()
 	if err != nil {
@@ -250,6 +265,8 @@ func (e *Engine) serialise(k string) ([]byte, error) {
 		return e.serialiseK(e.serialiseNodeProposals, &e.gss.serialisedNodeValidation)
 	case batchActiveKey:
 		return e.serialiseK(e.serialiseBatchActiveProposals, &e.gss.serialisedBatchActive)
+	case delegationsKey:
+		return e.serialiseK(e.serialiseDelegations, &e.gss.serialisedDelegations)
 	default:
 		return nil, types.ErrSnapshotKeyDoesNotExist
 	}
@@ -287,6 +304,8 @@ func (e *Engine) LoadState(ctx context.Context, p *types.Payload) ([]types.State
 		return nil, e.restoreNodeProposals(ctx, pl.GovernanceNode, p)
 	case *types.PayloadGovernanceBatchActive:
 		return nil, e.restoreBatchActiveProposals(ctx, pl.GovernanceBatchActive, p)
+	case *types.PayloadGovernanceDelegations:
+		return nil, e.restoreDelegations(ctx, pl.GovernanceDelegations, p)
 	default:
 		return nil, types.ErrUnknownSnapshotType
 	}
@@ -338,6 +357,20 @@ func (e *Engine) restoreActiveProposals(ctx context.Context, active *types.Gover
 	return err
 }
 
+func (e *Engine) restoreDelegations(ctx context.Context, gd *snapshotpb.GovernanceDelegations, p *types.Payload) error {
+	e.delegations = delegationsFromProto(gd.Delegations)
+
+	evts := make([]events.Event, 0, len(gd.Delegations))
+	for _, d := range e.delegations.asSlice() {
+		evts = append(evts, events.NewGovernanceDelegationEvent(ctx, *d))
+	}
+	e.broker.SendBatch(evts)
+
+	var err error
+	e.gss.serialisedDelegations, err = proto.Marshal(p.IntoProto())
+	return err
+}
+
 func setLiquidationSlippage(p *types.Proposal) {
 	if p.IsNewMarket() {
 		if !p.NewMarket().Changes.LiquidationStrategy.DisposalSlippage.IsZero() {
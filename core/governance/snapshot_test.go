@@ -27,6 +27,7 @@ import (
 	"code.vegaprotocol.io/vega/core/stats"
 	"code.vegaprotocol.io/vega/core/types"
 	"code.vegaprotocol.io/vega/libs/proto"
+	"code.vegaprotocol.io/vega/libs/ptr"
 	vgrand "code.vegaprotocol.io/vega/libs/rand"
 	vgtest "code.vegaprotocol.io/vega/libs/test"
 	"code.vegaprotocol.io/vega/logging"
@@ -311,6 +312,42 @@ func TestGovernanceSnapshotRoundTrip(t *testing.T) {
 	require.True(t, bytes.Equal(s2, s3))
 }
 
+func TestGovernanceSnapshotDelegationsRoundTrip(t *testing.T) {
+	delegationsKey := (&types.PayloadGovernanceDelegations{}).Key()
+	eng := getTestEngine(t, time.Now())
+	defer eng.ctrl.Finish()
+	ctx := context.Background()
+
+	emptyState, _, err := eng.GetState(delegationsKey)
+	require.Nil(t, err)
+
+	eng.broker.EXPECT().Send(gomock.Any()).Times(2)
+	require.NoError(t, eng.UpdateGovernanceDelegation(ctx, types.GovernanceDelegationSubmission{Delegate: "delegate"}, "delegator-1"))
+	require.NoError(t, eng.UpdateGovernanceDelegation(ctx, types.GovernanceDelegationSubmission{
+		Delegate:     "delegate",
+		ProposalType: ptr.From(types.ProposalTypeNewMarket),
+	}, "delegator-2"))
+
+	state, _, err := eng.GetState(delegationsKey)
+	require.Nil(t, err)
+	assert.False(t, bytes.Equal(emptyState, state))
+
+	snap := &snapshotpb.Payload{}
+	err = proto.Unmarshal(state, snap)
+	require.Nil(t, err)
+
+	snapEng := getTestEngine(t, time.Now())
+	defer snapEng.ctrl.Finish()
+
+	snapEng.broker.EXPECT().SendBatch(gomock.Any()).Times(1)
+	_, err = snapEng.LoadState(ctx, types.PayloadFromProto(snap))
+	require.Nil(t, err)
+
+	restored, _, err := snapEng.GetState(delegationsKey)
+	require.Nil(t, err)
+	require.True(t, bytes.Equal(state, restored))
+}
+
 func TestGovernanceWithInternalTimeTerminationSnapshotRoundTrip(t *testing.T) {
 	activeKey := (&types.PayloadGovernanceActive{}).Key()
 	eng := getTestEngine(t, time.Now())
@@ -241,6 +241,15 @@ func (e *exEng) CancelAMM(ctx context.Context, cancel *types.CancelAMM) error {
 	return nil
 }
 
+func (e *exEng) SubmitCompleteSet(ctx context.Context, submission *types.CompleteSetSubmission, party string) error {
+	idgen := idgeneration.New(vgcrypto.RandomHash())
+	if err := e.Engine.SubmitCompleteSet(ctx, submission, party, idgen); err != nil {
+		e.broker.Send(events.NewTxErrEvent(ctx, err, party, submission.IntoProto(), "submitCompleteSet"))
+		return err
+	}
+	return nil
+}
+
 type noopValidation struct{}
 
 func (n noopValidation) CheckOrderCancellation(cancel *commandspb.OrderCancellation) error {
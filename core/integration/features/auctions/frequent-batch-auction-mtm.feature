@@ -0,0 +1,65 @@
+Feature: Markets trading in frequent batch auctions are marked to market during their batches
+
+  Background:
+    Given the following assets are registered:
+      | id  | decimal places |
+      | ETH | 0              |
+    And the simple risk model named "simple-risk-model":
+      | long | short | max move up | min move down | probability of trading |
+      | 0.1  | 0.1   | 100         | -100          | 0.2                    |
+    And the following network parameters are set:
+      | name                                    | value |
+      | network.markPriceUpdateMaximumFrequency | 0s    |
+      | market.auction.minimumDuration          | 1     |
+    And the average block duration is "1"
+    And the composite price oracles from "0xCAFECAFE1":
+      | name    | price property   | price type   | price decimals |
+      | oracle1 | prices.ETH.value | TYPE_INTEGER | 0              |
+    And the markets:
+      | id        | quote name | asset | risk model        | margin calculator         | auction duration | fees         | price monitoring | data source config     | linear slippage factor | quadratic slippage factor | sla params      | price type | decay weight | decay power | cash amount | source weights | source staleness tolerance | oracle1 | batch duration |
+      | ETH/DEC20 | ETH        | ETH   | simple-risk-model | default-margin-calculator | 1                | default-none | default-none     | default-eth-for-future | 0.25                   | 0                         | default-futures | weight     | 0            | 0           | 0           | 0,0,1,0        | 0s,0s,1h,0s                | oracle1 | 10             |
+    And the parties deposit on asset's general account the following amount:
+      | party  | asset | amount    |
+      | party1 | ETH   | 100000000 |
+      | party2 | ETH   | 100000000 |
+      | aux1   | ETH   | 100000000 |
+      | aux2   | ETH   | 100000000 |
+      | lpprov | ETH   | 100000000 |
+    And the parties submit the following liquidity provision:
+      | id  | party  | market id | commitment amount | fee | lp type    |
+      | lp1 | lpprov | ETH/DEC20 | 90000             | 0.1 | submission |
+    And the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux1   | ETH/DEC20 | buy  | 1      | 900   | 0                | TYPE_LIMIT | TIF_GTC |
+      | party1 | ETH/DEC20 | buy  | 2      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | party2 | ETH/DEC20 | sell | 2      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2   | ETH/DEC20 | sell | 1      | 1100  | 0                | TYPE_LIMIT | TIF_GTC |
+    And the oracles broadcast data with block time signed with "0xCAFECAFE1":
+      | name             | value | time offset |
+      | prices.ETH.value | 1000  | -1s         |
+    When the opening auction period ends for market "ETH/DEC20"
+    Then the market data for the market "ETH/DEC20" should be:
+      | mark price | trading mode               | auction trigger       |
+      | 1000       | TRADING_MODE_BATCH_AUCTION | AUCTION_TRIGGER_BATCH |
+
+  Scenario: The mark price moves in the middle of a batch, the positions are marked to market straight away
+    Given the parties should have the following profit and loss:
+      | party  | volume | unrealised pnl | realised pnl |
+      | party1 | 2      | 0              | 0            |
+      | party2 | -2     | 0              | 0            |
+
+    When the oracles broadcast data with block time signed with "0xCAFECAFE1":
+      | name             | value | time offset |
+      | prices.ETH.value | 1010  | -1s         |
+    And the network moves ahead "1" blocks
+    Then the market data for the market "ETH/DEC20" should be:
+      | mark price | trading mode               | auction trigger       |
+      | 1010       | TRADING_MODE_BATCH_AUCTION | AUCTION_TRIGGER_BATCH |
+    And the parties should have the following profit and loss:
+      | party  | volume | unrealised pnl | realised pnl |
+      | party1 | 2      | 20             | 0            |
+      | party2 | -2     | -20            | 0            |
+    And the following transfers should happen:
+      | from   | to     | from account            | to account              | market id | amount | asset |
+      | party2 | market | ACCOUNT_TYPE_MARGIN     | ACCOUNT_TYPE_SETTLEMENT | ETH/DEC20 | 20     | ETH   |
+      | market | party1 | ACCOUNT_TYPE_SETTLEMENT | ACCOUNT_TYPE_MARGIN     | ETH/DEC20 | 20     | ETH   |
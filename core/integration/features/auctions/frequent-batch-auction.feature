@@ -0,0 +1,106 @@
+Feature: Markets trading in frequent batch auctions uncross their book at the end of every batch
+
+  Background:
+    Given the following assets are registered:
+      | id  | decimal places |
+      | ETH | 0              |
+    And the simple risk model named "simple-risk-model":
+      | long | short | max move up | min move down | probability of trading |
+      | 0.1  | 0.1   | 100         | -100          | 0.2                    |
+    And the fees configuration named "fees-config":
+      | maker fee | infrastructure fee |
+      | 0.001     | 0.001              |
+    And the following network parameters are set:
+      | name                                    | value |
+      | network.markPriceUpdateMaximumFrequency | 0s    |
+      | market.auction.minimumDuration          | 1     |
+    And the average block duration is "1"
+    And the markets:
+      | id        | quote name | asset | risk model        | margin calculator         | auction duration | fees        | price monitoring | data source config     | linear slippage factor | quadratic slippage factor | sla params      | batch duration |
+      | ETH/DEC20 | ETH        | ETH   | simple-risk-model | default-margin-calculator | 1                | fees-config | default-none     | default-eth-for-future | 0.25                   | 0                         | default-futures | 5              |
+    And the parties deposit on asset's general account the following amount:
+      | party  | asset | amount    |
+      | party1 | ETH   | 100000000 |
+      | party2 | ETH   | 100000000 |
+      | aux1   | ETH   | 100000000 |
+      | aux2   | ETH   | 100000000 |
+      | lpprov | ETH   | 100000000 |
+    And the parties submit the following liquidity provision:
+      | id  | party  | market id | commitment amount | fee | lp type    |
+      | lp1 | lpprov | ETH/DEC20 | 90000             | 0.1 | submission |
+    And the parties place the following orders:
+      | party | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux1  | ETH/DEC20 | buy  | 1      | 900   | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux1  | ETH/DEC20 | buy  | 1      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/DEC20 | sell | 1      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/DEC20 | sell | 1      | 1100  | 0                | TYPE_LIMIT | TIF_GTC |
+    When the opening auction period ends for market "ETH/DEC20"
+    Then the market data for the market "ETH/DEC20" should be:
+      | mark price | trading mode               | auction trigger       |
+      | 1000       | TRADING_MODE_BATCH_AUCTION | AUCTION_TRIGGER_BATCH |
+
+  Scenario: Crossing orders only trade at the end of the batch
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     | reference |
+      | party1 | ETH/DEC20 | buy  | 2      | 1020  | 0                | TYPE_LIMIT | TIF_GTC | buy-1     |
+      | party2 | ETH/DEC20 | sell | 2      | 1000  | 0                | TYPE_LIMIT | TIF_GTC | sell-1    |
+    Then the trading mode should be "TRADING_MODE_BATCH_AUCTION" for the market "ETH/DEC20"
+    And the orders should have the following status:
+      | party  | reference | status        |
+      | party1 | buy-1     | STATUS_ACTIVE |
+      | party2 | sell-1    | STATUS_ACTIVE |
+
+    # orders that would only ever trade continuously are rejected
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     | error                                                       |
+      | party1 | ETH/DEC20 | buy  | 1      | 1100  | 0                | TYPE_LIMIT | TIF_IOC | ioc order received during auction trading|
+
+    When the network moves ahead "6" blocks
+    Then the following trades should be executed:
+      | buyer  | price | size | seller |
+      | party1 | 1010  | 2    | party2 |
+    And the orders should have the following status:
+      | party  | reference | status          |
+      | party1 | buy-1     | STATUS_FILLED   |
+      | party2 | sell-1    | STATUS_FILLED   |
+    # the next batch starts straight away, the positions have been marked to market at the uncrossing price
+    And the market data for the market "ETH/DEC20" should be:
+      | mark price | trading mode               | auction trigger       |
+      | 1010       | TRADING_MODE_BATCH_AUCTION | AUCTION_TRIGGER_BATCH |
+    And the parties should have the following profit and loss:
+      | party  | volume | unrealised pnl | realised pnl |
+      | party1 | 2      | 0              | 0            |
+      | party2 | -2     | 0              | 0            |
+
+  Scenario: A market can be switched between continuous trading and frequent batch auctions via governance
+    When the markets are updated:
+      | id        | batch duration |
+      | ETH/DEC20 | 0              |
+    # the current batch runs to completion
+    Then the trading mode should be "TRADING_MODE_BATCH_AUCTION" for the market "ETH/DEC20"
+
+    When the network moves ahead "6" blocks
+    Then the market data for the market "ETH/DEC20" should be:
+      | trading mode            | auction trigger             |
+      | TRADING_MODE_CONTINUOUS | AUCTION_TRIGGER_UNSPECIFIED |
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     |
+      | party1 | ETH/DEC20 | buy  | 1      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | party2 | ETH/DEC20 | sell | 1      | 1000  | 1                | TYPE_LIMIT | TIF_GTC |
+
+    # the market goes straight into its first batch
+    When the markets are updated:
+      | id        | batch duration |
+      | ETH/DEC20 | 3              |
+    Then the market data for the market "ETH/DEC20" should be:
+      | trading mode               | auction trigger       |
+      | TRADING_MODE_BATCH_AUCTION | AUCTION_TRIGGER_BATCH |
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     |
+      | party1 | ETH/DEC20 | buy  | 1      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | party2 | ETH/DEC20 | sell | 1      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+    And the network moves ahead "3" blocks
+    Then the following trades should be executed:
+      | buyer  | price | size | seller |
+      | party1 | 1000  | 1    | party2 |
+    And the trading mode should be "TRADING_MODE_BATCH_AUCTION" for the market "ETH/DEC20"
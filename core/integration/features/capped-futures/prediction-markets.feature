@@ -0,0 +1,129 @@
+Feature: Multi-outcome prediction markets with complete sets
+
+  Background:
+    Given the average block duration is "1"
+    And the following network parameters are set:
+      | name                                    | value |
+      | market.fee.factors.makerFee             | 0     |
+      | market.fee.factors.infrastructureFee    | 0     |
+      | network.markPriceUpdateMaximumFrequency | 0s    |
+    And the following assets are registered:
+      | id       | decimal places | quantum |
+      | USD-1-10 | 0              | 1       |
+    And the parties deposit on asset's general account the following amount:
+      | party  | asset    | amount     |
+      | lp     | USD-1-10 | 1000000000 |
+      | aux1   | USD-1-10 | 1000000000 |
+      | aux2   | USD-1-10 | 1000000000 |
+      | trader | USD-1-10 | 1000       |
+
+    # one row creates an outcome market per outcome, identified as <prediction>/<outcome>
+    And the markets:
+      | id       | quote name | asset    | risk model                    | margin calculator         | auction duration | fees         | price monitoring | data source config     | linear slippage factor | quadratic slippage factor | sla params      | max price cap | binary | fully collateralised | prediction outcomes |
+      | ELECTION | ETH        | USD-1-10 | default-log-normal-risk-model | default-margin-calculator | 1                | default-none | default-none     | default-eth-for-future | 1e-3                   | 0                         | default-futures | 100           | true   | true                 | yes,no              |
+    And the parties submit the following liquidity provision:
+      | id  | party | market id    | commitment amount | fee | lp type    |
+      | lp1 | lp    | ELECTION/yes | 100000            | 0   | submission |
+      | lp2 | lp    | ELECTION/no  | 100000            | 0   | submission |
+    And the parties place the following orders:
+      | party | market id    | side | volume | price | resulting trades | type       | tif     |
+      | aux1  | ELECTION/yes | buy  | 1      | 60    | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ELECTION/yes | sell | 1      | 60    | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux1  | ELECTION/no  | buy  | 1      | 40    | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ELECTION/no  | sell | 1      | 40    | 0                | TYPE_LIMIT | TIF_GTC |
+    When the network moves ahead "2" blocks
+    Then the trading mode should be "TRADING_MODE_CONTINUOUS" for the market "ELECTION/yes"
+    And the trading mode should be "TRADING_MODE_CONTINUOUS" for the market "ELECTION/no"
+    And the mark price should be "60" for the market "ELECTION/yes"
+    And the mark price should be "40" for the market "ELECTION/no"
+
+  @CappedF @NoPerp
+  Scenario: Minting and redeeming complete sets moves collateral between the party and the outcome markets
+    When the parties submit the following complete sets:
+      | party  | prediction id | size | action | error                                                                   |
+      | trader | ELECTION      | 20   | mint   | not enough collateral in general account to mint complete sets          |
+      | trader | UNKNOWN       | 1    | mint   | prediction market does not exist                                        |
+      | trader | ELECTION      | 1    | redeem | party does not hold enough of every outcome to redeem the complete sets |
+      | trader | ELECTION      | 5    | mint   |                                                                         |
+    Then the parties should have the following account balances:
+      | party  | asset    | market id    | margin | general |
+      | trader | USD-1-10 | ELECTION/yes | 300    | 500     |
+      | trader | USD-1-10 | ELECTION/no  | 200    | 500     |
+    And the insurance pool balance should be "0" for the market "ELECTION"
+
+    When the network moves ahead "1" blocks
+    Then the parties should have the following account balances:
+      | party  | asset    | market id    | margin | general |
+      | trader | USD-1-10 | ELECTION/yes | 300    | 500     |
+      | trader | USD-1-10 | ELECTION/no  | 200    | 500     |
+    And the parties should have the following profit and loss:
+      | party   | volume | unrealised pnl | realised pnl | market id    |
+      | trader  | 5      | 0              | 0            | ELECTION/yes |
+      | trader  | 5      | 0              | 0            | ELECTION/no  |
+      | network | -5     | 0              | 0            | ELECTION/yes |
+      | network | -5     | 0              | 0            | ELECTION/no  |
+    And the mark price should be "60" for the market "ELECTION/yes"
+    And the mark price should be "40" for the market "ELECTION/no"
+
+    When the parties submit the following complete sets:
+      | party  | prediction id | size | action | error |
+      | trader | ELECTION      | 2    | redeem |       |
+    Then the parties should have the following account balances:
+      | party  | asset    | market id    | margin | general |
+      | trader | USD-1-10 | ELECTION/yes | 180    | 700     |
+      | trader | USD-1-10 | ELECTION/no  | 120    | 700     |
+    And the insurance pool balance should be "0" for the market "ELECTION"
+
+    When the network moves ahead "1" blocks
+    Then the parties should have the following profit and loss:
+      | party   | volume | unrealised pnl | realised pnl | market id    |
+      | trader  | 3      | 0              | 0            | ELECTION/yes |
+      | trader  | 3      | 0              | 0            | ELECTION/no  |
+      | network | -3     | 0              | 0            | ELECTION/yes |
+      | network | -3     | 0              | 0            | ELECTION/no  |
+
+  @CappedF @NoPerp
+  Scenario: Exactly one outcome settles at the cap price
+    Given the parties submit the following complete sets:
+      | party  | prediction id | size | action | error |
+      | trader | ELECTION      | 3    | mint   |       |
+    And the parties should have the following account balances:
+      | party  | asset    | market id    | margin | general |
+      | trader | USD-1-10 | ELECTION/yes | 180    | 700     |
+      | trader | USD-1-10 | ELECTION/no  | 120    | 700     |
+
+    When the oracles broadcast data signed with "0xCAFEDOOD":
+      | name               | value |
+      | trading.terminated | true  |
+    And the network moves ahead "1" blocks
+    Then the market state should be "STATE_TRADING_TERMINATED" for the market "ELECTION/yes"
+    And the market state should be "STATE_TRADING_TERMINATED" for the market "ELECTION/no"
+
+    # the settlement data is the index of the winning outcome, "yes" wins
+    When the oracles broadcast data signed with "0xCAFEDOOD":
+      | name             | value |
+      | prices.ETH.value | 0     |
+    And the network moves ahead "2" blocks
+    Then the last market state should be "STATE_SETTLED" for the market "ELECTION/yes"
+    And the last market state should be "STATE_SETTLED" for the market "ELECTION/no"
+    # the complete sets are worth the cap price
+    And the parties should have the following account balances:
+      | party  | asset    | market id    | margin | general |
+      | trader | USD-1-10 | ELECTION/yes | 0      | 1000    |
+      | trader | USD-1-10 | ELECTION/no  | 0      | 1000    |
+    Then debug transfers
+
+  @CappedF @NoPerp
+  Scenario: Minting complete sets cannot take a position above the position limits of an outcome market
+    When the markets are updated:
+      | id          | max position size |
+      | ELECTION/no | 3                 |
+    And the parties submit the following complete sets:
+      | party  | prediction id | size | action | error                                    |
+      | trader | ELECTION      | 4    | mint   | OrderError: position size limit exceeded |
+      | trader | ELECTION      | 3    | mint   |                                          |
+    And the network moves ahead "1" blocks
+    Then the parties should have the following profit and loss:
+      | party  | volume | unrealised pnl | realised pnl | market id    |
+      | trader | 3      | 0              | 0            | ELECTION/yes |
+      | trader | 3      | 0              | 0            | ELECTION/no  |
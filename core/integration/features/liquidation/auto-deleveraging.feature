@@ -0,0 +1,212 @@
+Feature: Auto-deleveraging of profitable positions when the network cannot close out
+
+  Background:
+
+    # Configure the network
+    Given the average block duration is "1"
+    And the following network parameters are set:
+      | name                                    | value |
+      | network.markPriceUpdateMaximumFrequency | 0s    |
+    And the following assets are registered:
+      | id      | decimal places | quantum |
+      | USD.0.1 | 0              | 1       |
+
+    # Configure the markets, the network never gets to dispose of all of its position in these scenarios: without auto-deleveraging
+    # the disposal step is never due, with auto-deleveraging the book has no volume within the disposal slippage range, or the network
+    # can only consume a fraction of it on every step
+    Given the liquidation strategies:
+      | name       | disposal step | disposal fraction | full disposal size | max fraction consumed | disposal slippage range | auto deleveraging |
+      | no-adl     | 3600          | 0.5               | 0                  | 1                     | 0.1                     | false             |
+      | adl-strat  | 1             | 0.5               | 0                  | 1                     | 0.01                    | true              |
+      | adl-capped | 1             | 1                 | 0                  | 0.1                   | 0.1                     | true              |
+
+    And the markets:
+      | id        | quote name | asset    | risk model                    | margin calculator         | auction duration | fees         | price monitoring | data source config     | linear slippage factor | quadratic slippage factor | liquidation strategy | sla params    |
+      | ETH/MAR22 | ETH        | USD.0.10 | default-log-normal-risk-model | default-margin-calculator | 1                | default-none | default-none     | default-eth-for-future | 0.001                  | 0                         | no-adl               | default-basic |
+      | ETH/MAR23 | ETH        | USD.0.10 | default-log-normal-risk-model | default-margin-calculator | 1                | default-none | default-none     | default-eth-for-future | 0.001                  | 0                         | adl-strat            | default-basic |
+      | ETH/MAR24 | ETH        | USD.0.10 | default-log-normal-risk-model | default-margin-calculator | 1                | default-none | default-none     | default-eth-for-future | 0.001                  | 0                         | adl-capped           | default-basic |
+
+    # Setup the markets
+    Given the parties deposit on asset's general account the following amount:
+      | party       | asset    | amount       |
+      | lp1         | USD.0.10 | 100000000000 |
+      | aux1        | USD.0.10 | 10000000000  |
+      | aux2        | USD.0.10 | 10000000000  |
+      | atRiskParty | USD.0.10 | 300          |
+    When the parties place the following orders:
+      | party | market id | side | volume | price | resulting trades | type       | tif     | reference  |
+      | lp1   | ETH/MAR22 | buy  | 1000   | 199   | 0                | TYPE_LIMIT | TIF_GTC | best-bid-1 |
+      | lp1   | ETH/MAR22 | sell | 1000   | 201   | 0                | TYPE_LIMIT | TIF_GTC | best-ask-1 |
+      | lp1   | ETH/MAR23 | buy  | 1000   | 199   | 0                | TYPE_LIMIT | TIF_GTC | best-bid-2 |
+      | lp1   | ETH/MAR23 | sell | 1000   | 201   | 0                | TYPE_LIMIT | TIF_GTC | best-ask-2 |
+      | lp1   | ETH/MAR24 | buy  | 10     | 199   | 0                | TYPE_LIMIT | TIF_GTC | best-bid-3 |
+      | lp1   | ETH/MAR24 | sell | 10     | 201   | 0                | TYPE_LIMIT | TIF_GTC | best-ask-3 |
+      | aux1  | ETH/MAR22 | buy  | 1      | 200   | 0                | TYPE_LIMIT | TIF_GTC |            |
+      | aux2  | ETH/MAR22 | sell | 1      | 200   | 0                | TYPE_LIMIT | TIF_GTC |            |
+      | aux1  | ETH/MAR23 | buy  | 1      | 200   | 0                | TYPE_LIMIT | TIF_GTC |            |
+      | aux2  | ETH/MAR23 | sell | 1      | 200   | 0                | TYPE_LIMIT | TIF_GTC |            |
+      | aux1  | ETH/MAR24 | buy  | 1      | 200   | 0                | TYPE_LIMIT | TIF_GTC |            |
+      | aux2  | ETH/MAR24 | sell | 1      | 200   | 0                | TYPE_LIMIT | TIF_GTC |            |
+    And the opening auction period ends for market "ETH/MAR22"
+    Then the market data for the market "ETH/MAR22" should be:
+      | mark price | trading mode            |
+      | 200        | TRADING_MODE_CONTINUOUS |
+    And the market data for the market "ETH/MAR23" should be:
+      | mark price | trading mode            |
+      | 200        | TRADING_MODE_CONTINUOUS |
+
+  @Liquidation
+  Scenario: Without auto-deleveraging, the network keeps its position and the losses are socialised
+    Given the parties place the following orders:
+      | party       | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux1        | ETH/MAR22 | sell | 10     | 200   | 0                | TYPE_LIMIT | TIF_GTC |
+      | atRiskParty | ETH/MAR22 | buy  | 10     | 200   | 1                | TYPE_LIMIT | TIF_GTC |
+    When the network moves ahead "1" blocks
+
+    # the market moves against atRiskParty, who is closed out
+    When the parties amend the following orders:
+      | party | reference  | price | size delta | tif     |
+      | lp1   | best-bid-1 | 179   | 0          | TIF_GTC |
+      | lp1   | best-ask-1 | 181   | 0          | TIF_GTC |
+    And the parties place the following orders:
+      | party | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux1  | ETH/MAR22 | buy  | 1      | 180   | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/MAR22 | sell | 1      | 180   | 1                | TYPE_LIMIT | TIF_GTC |
+    And the network moves ahead "1" blocks
+    Then the parties should have the following profit and loss:
+      | party       | volume | unrealised pnl | realised pnl |
+      | atRiskParty | 0      | 0              | -300         |
+      | network     | 10     | 0              | 0            |
+
+    # the market keeps moving against the network, the insurance pool cannot cover its losses
+    When the parties amend the following orders:
+      | party | reference  | price | size delta | tif     |
+      | lp1   | best-bid-1 | 159   | 0          | TIF_GTC |
+      | lp1   | best-ask-1 | 161   | 0          | TIF_GTC |
+    And the parties place the following orders:
+      | party | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux1  | ETH/MAR22 | buy  | 1      | 160   | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/MAR22 | sell | 1      | 160   | 1                | TYPE_LIMIT | TIF_GTC |
+    And the network moves ahead "1" blocks
+    Then the mark price should be "160" for the market "ETH/MAR22"
+    And the insurance pool balance should be "0" for the market "ETH/MAR22"
+    And the parties should have the following profit and loss:
+      | party   | market id | volume | unrealised pnl | realised pnl |
+      | network | ETH/MAR22 | 10     | -200           | 0            |
+      | aux1    | ETH/MAR22 | -7     | 280            | -20          |
+
+  @Liquidation
+  Scenario: With auto-deleveraging, the most profitable and levered positions opposite the network absorb its position
+    Given the parties place the following orders:
+      | party       | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux1        | ETH/MAR23 | sell | 10     | 200   | 0                | TYPE_LIMIT | TIF_GTC |
+      | atRiskParty | ETH/MAR23 | buy  | 10     | 200   | 1                | TYPE_LIMIT | TIF_GTC |
+    When the network moves ahead "1" blocks
+
+    # the market moves against atRiskParty, who is closed out
+    When the parties amend the following orders:
+      | party | reference  | price | size delta | tif     |
+      | lp1   | best-bid-2 | 170   | 0          | TIF_GTC |
+      | lp1   | best-ask-2 | 190   | 0          | TIF_GTC |
+    And the parties place the following orders:
+      | party | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux1  | ETH/MAR23 | buy  | 1      | 180   | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/MAR23 | sell | 1      | 180   | 1                | TYPE_LIMIT | TIF_GTC |
+    And the network moves ahead "1" blocks
+    Then the parties should have the following profit and loss:
+      | party       | volume | unrealised pnl | realised pnl |
+      | atRiskParty | 0      | 0              | -300         |
+      | network     | 10     | 0              | 0            |
+    # the network cannot dispose of its position, but the insurance pool still covers its losses
+    When the network moves ahead "1" blocks
+    Then the insurance pool balance should be "100" for the market "ETH/MAR23"
+    And the following events should NOT be emitted:
+      | type                  |
+      | AutoDeleveragingEvent |
+    And the auto-deleveraging ranking for market "ETH/MAR23" should be:
+      | party | open volume | rank |
+      | aux1  | -8          | 1    |
+      | aux2  | -2          | 2    |
+
+    # the market keeps moving against the network, the insurance pool is depleted and the short positions are reduced
+    When the parties amend the following orders:
+      | party | reference  | price | size delta | tif     |
+      | lp1   | best-bid-2 | 150   | 0          | TIF_GTC |
+      | lp1   | best-ask-2 | 170   | 0          | TIF_GTC |
+    And the parties place the following orders:
+      | party | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux1  | ETH/MAR23 | buy  | 1      | 160   | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/MAR23 | sell | 1      | 160   | 1                | TYPE_LIMIT | TIF_GTC |
+    And the network moves ahead "1" blocks
+    Then the mark price should be "160" for the market "ETH/MAR23"
+    And the insurance pool balance should be "0" for the market "ETH/MAR23"
+    # aux1 is the most profitable short position, and is reduced first at the bankruptcy price of the network's position
+    And the following positions should be auto-deleveraged for market "ETH/MAR23":
+      | party | reduced size | remaining position | price |
+      | aux1  | -7           | 0                  | 160   |
+      | aux2  | -3           | 0                  | 160   |
+    And the following trades should be executed:
+      | buyer | price | size | seller  |
+      | aux1  | 160   | 7    | network |
+      | aux2  | 160   | 3    | network |
+    # the auto-deleveraging trades are settled with the next mark to market
+    When the network moves ahead "1" blocks
+    Then the parties should have the following profit and loss:
+      | party   | market id | volume | unrealised pnl | realised pnl |
+      | network | ETH/MAR23 | 0      | 0              | -200         |
+      | aux1    | ETH/MAR23 | 0      | 0              | 260          |
+      | aux2    | ETH/MAR23 | 0      | 0              | 40           |
+
+  @Liquidation
+  Scenario: With auto-deleveraging, positions absorb the network's position while it can only dispose of part of it
+    Given the parties place the following orders:
+      | party       | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux1        | ETH/MAR24 | sell | 10     | 200   | 0                | TYPE_LIMIT | TIF_GTC |
+      | atRiskParty | ETH/MAR24 | buy  | 10     | 200   | 1                | TYPE_LIMIT | TIF_GTC |
+    When the network moves ahead "1" blocks
+
+    # the market moves against atRiskParty, who is closed out
+    When the parties amend the following orders:
+      | party | reference  | price | size delta | tif     |
+      | lp1   | best-bid-3 | 170   | 0          | TIF_GTC |
+      | lp1   | best-ask-3 | 190   | 0          | TIF_GTC |
+    And the parties place the following orders:
+      | party | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux1  | ETH/MAR24 | buy  | 1      | 180   | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/MAR24 | sell | 1      | 180   | 1                | TYPE_LIMIT | TIF_GTC |
+    And the network moves ahead "1" blocks
+    Then the parties should have the following profit and loss:
+      | party       | market id | volume | unrealised pnl | realised pnl |
+      | atRiskParty | ETH/MAR24 | 0      | 0              | -300         |
+      | network     | ETH/MAR24 | 10     | 0              | 0            |
+    When the network moves ahead "1" blocks
+    Then the parties should have the following profit and loss:
+      | party   | market id | volume | unrealised pnl | realised pnl |
+      | network | ETH/MAR24 | 9      | 0              | -10          |
+
+    # the market keeps moving against the network while it can only dispose of a unit on every step,
+    # once the insurance pool is depleted the short positions absorb what's left of its position
+    When the parties amend the following orders:
+      | party | reference  | price | size delta | tif     |
+      | lp1   | best-bid-3 | 150   | 0          | TIF_GTC |
+      | lp1   | best-ask-3 | 170   | 0          | TIF_GTC |
+    And the parties place the following orders:
+      | party | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux1  | ETH/MAR24 | buy  | 1      | 160   | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/MAR24 | sell | 1      | 160   | 1                | TYPE_LIMIT | TIF_GTC |
+    And the network moves ahead "1" blocks
+    Then the mark price should be "160" for the market "ETH/MAR24"
+    And the insurance pool balance should be "0" for the market "ETH/MAR24"
+    And the following positions should be auto-deleveraged for market "ETH/MAR24":
+      | party | reduced size | remaining position | price |
+      | aux1  | -7           | 0                  | 160   |
+    And the following trades should be executed:
+      | buyer | price | size | seller  |
+      | aux1  | 160   | 7    | network |
+    # the auto-deleveraging trades are settled with the next mark to market
+    When the network moves ahead "1" blocks
+    Then the parties should have the following profit and loss:
+      | party   | market id | volume | unrealised pnl | realised pnl |
+      | network | ETH/MAR24 | 0      | 0              | -190         |
+      | aux1    | ETH/MAR24 | 0      | 0              | 276          |
+      | aux2    | ETH/MAR24 | -3     | 60             | -16          |
@@ -0,0 +1,127 @@
+Feature: Partial close-out of distressed positions
+
+  Background:
+
+    # Configure the network
+    Given the average block duration is "1"
+    And the following network parameters are set:
+      | name                                    | value |
+      | network.markPriceUpdateMaximumFrequency | 0s    |
+    And the following assets are registered:
+      | id      | decimal places | quantum |
+      | USD.0.1 | 0              | 1       |
+
+    # Configure the markets
+    Given the liquidation strategies:
+      | name          | disposal step | disposal fraction | full disposal size | max fraction consumed | disposal slippage range | partial close-out step | partial close-out buffer |
+      | full-strat    | 3600          | 0.5               | 0                  | 1                     | 0.1                     |                        |                          |
+      | partial-strat | 3600          | 0.5               | 0                  | 1                     | 0.1                     | 0.1                    | 0.1                      |
+
+    And the markets:
+      | id        | quote name | asset    | risk model                    | margin calculator         | auction duration | fees         | price monitoring | data source config     | linear slippage factor | quadratic slippage factor | liquidation strategy | sla params    |
+      | ETH/MAR22 | ETH        | USD.0.10 | default-log-normal-risk-model | default-margin-calculator | 1                | default-none | default-none     | default-eth-for-future | 0.001                  | 0                         | full-strat           | default-basic |
+      | ETH/MAR23 | ETH        | USD.0.10 | default-log-normal-risk-model | default-margin-calculator | 1                | default-none | default-none     | default-eth-for-future | 0.001                  | 0                         | partial-strat        | default-basic |
+
+    # Setup the markets
+    Given the initial insurance pool balance is "10000" for all the markets
+    And the parties deposit on asset's general account the following amount:
+      | party       | asset    | amount       |
+      | lp1         | USD.0.10 | 100000000000 |
+      | aux1        | USD.0.10 | 10000000000  |
+      | aux2        | USD.0.10 | 10000000000  |
+      | atRiskParty | USD.0.10 | 300          |
+    When the parties place the following orders:
+      | party | market id | side | volume | price | resulting trades | type       | tif     | reference  |
+      | lp1   | ETH/MAR22 | buy  | 1000   | 199   | 0                | TYPE_LIMIT | TIF_GTC | best-bid-1 |
+      | lp1   | ETH/MAR22 | sell | 1000   | 201   | 0                | TYPE_LIMIT | TIF_GTC | best-ask-1 |
+      | lp1   | ETH/MAR23 | buy  | 1000   | 199   | 0                | TYPE_LIMIT | TIF_GTC | best-bid-2 |
+      | lp1   | ETH/MAR23 | sell | 1000   | 201   | 0                | TYPE_LIMIT | TIF_GTC | best-ask-2 |
+      | aux1  | ETH/MAR22 | buy  | 1      | 200   | 0                | TYPE_LIMIT | TIF_GTC |            |
+      | aux2  | ETH/MAR22 | sell | 1      | 200   | 0                | TYPE_LIMIT | TIF_GTC |            |
+      | aux1  | ETH/MAR23 | buy  | 1      | 200   | 0                | TYPE_LIMIT | TIF_GTC |            |
+      | aux2  | ETH/MAR23 | sell | 1      | 200   | 0                | TYPE_LIMIT | TIF_GTC |            |
+    And the opening auction period ends for market "ETH/MAR22"
+    Then the market data for the market "ETH/MAR22" should be:
+      | mark price | trading mode            |
+      | 200        | TRADING_MODE_CONTINUOUS |
+    And the market data for the market "ETH/MAR23" should be:
+      | mark price | trading mode            |
+      | 200        | TRADING_MODE_CONTINUOUS |
+
+  @Liquidation
+  Scenario: Without partial close-outs, the whole position of a distressed party is transferred to the network
+    Given the parties place the following orders:
+      | party       | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux1        | ETH/MAR22 | sell | 10     | 200   | 0                | TYPE_LIMIT | TIF_GTC |
+      | atRiskParty | ETH/MAR22 | buy  | 10     | 200   | 1                | TYPE_LIMIT | TIF_GTC |
+    When the network moves ahead "1" blocks
+    Then the parties should have the following profit and loss:
+      | party       | volume | unrealised pnl | realised pnl |
+      | atRiskParty | 10     | 0              | 0            |
+    And the parties should have the following margin levels:
+      | party       | market id | maintenance | search | initial | release |
+      | atRiskParty | ETH/MAR22 | 142         | 156    | 170     | 198     |
+
+    # the market moves against atRiskParty
+    When the parties amend the following orders:
+      | party | reference  | price | size delta | tif     |
+      | lp1   | best-bid-1 | 179   | 0          | TIF_GTC |
+      | lp1   | best-ask-1 | 181   | 0          | TIF_GTC |
+    And the parties place the following orders:
+      | party | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux1  | ETH/MAR22 | buy  | 1      | 180   | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/MAR22 | sell | 1      | 180   | 1                | TYPE_LIMIT | TIF_GTC |
+    And the network moves ahead "1" blocks
+    Then the mark price should be "180" for the market "ETH/MAR22"
+    And the parties should have the following profit and loss:
+      | party       | volume | unrealised pnl | realised pnl |
+      | atRiskParty | 0      | 0              | -300         |
+      | network     | 10     | 0              | 0            |
+    And the parties should have the following position changes for market "ETH/MAR22":
+      | party       | status                        |
+      | atRiskParty | POSITION_STATUS_CLOSED_OUT    |
+
+  @Liquidation
+  Scenario: With partial close-outs, only the volume needed to cover the maintenance margin plus the buffer is transferred to the network
+    Given the parties place the following orders:
+      | party       | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux1        | ETH/MAR23 | sell | 10     | 200   | 0                | TYPE_LIMIT | TIF_GTC |
+      | atRiskParty | ETH/MAR23 | buy  | 10     | 200   | 1                | TYPE_LIMIT | TIF_GTC |
+    When the network moves ahead "1" blocks
+    Then the parties should have the following profit and loss:
+      | party       | volume | unrealised pnl | realised pnl |
+      | atRiskParty | 10     | 0              | 0            |
+    And the parties should have the following margin levels:
+      | party       | market id | maintenance | search | initial | release |
+      | atRiskParty | ETH/MAR23 | 142         | 156    | 170     | 198     |
+
+    # the market moves against atRiskParty, who can only afford part of their position
+    When the parties amend the following orders:
+      | party | reference  | price | size delta | tif     |
+      | lp1   | best-bid-2 | 179   | 0          | TIF_GTC |
+      | lp1   | best-ask-2 | 181   | 0          | TIF_GTC |
+    And the parties place the following orders:
+      | party | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux1  | ETH/MAR23 | buy  | 1      | 180   | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/MAR23 | sell | 1      | 180   | 1                | TYPE_LIMIT | TIF_GTC |
+    And the network moves ahead "1" blocks
+    Then the mark price should be "180" for the market "ETH/MAR23"
+    And the following partial close-outs should happen for market "ETH/MAR23":
+      | party       | closed out size | remaining position |
+      | atRiskParty | 3               | 7                  |
+    And the following trades should be executed:
+      | buyer   | price | size | seller      |
+      | network | 180   | 3    | atRiskParty |
+    # the close-out trades are settled with the next mark to market
+    When the network moves ahead "1" blocks
+    Then the parties should have the following profit and loss:
+      | party       | volume | unrealised pnl | realised pnl |
+      | atRiskParty | 7      | -140           | -60          |
+      | network     | 3      | 0              | 0            |
+    And the insurance pool balance should be "10000" for the market "ETH/MAR23"
+    And the parties should have the following margin levels:
+      | party       | market id | maintenance | search | initial | release |
+      | atRiskParty | ETH/MAR23 | 89          | 97     | 106     | 124     |
+    And the parties should have the following account balances:
+      | party       | asset    | market id | margin | general |
+      | atRiskParty | USD.0.10 | ETH/MAR23 | 100    | 0       |
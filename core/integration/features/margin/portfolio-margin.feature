@@ -0,0 +1,97 @@
+Feature: Portfolio margin nets the positions of the parties which opted in across correlated markets
+    Background:
+        Given the following network parameters are set:
+            | name                                    | value                                                                                                                                                                         |
+            | network.markPriceUpdateMaximumFrequency | 0s                                                                                                                                                                            |
+            | market.margin.portfolio.groups          | {"groups": [{"name": "eth", "asset": "USD", "markets": ["ETH/FEB23", "ETH/MAR23"], "correlations": [{"market_a": "ETH/FEB23", "market_b": "ETH/MAR23", "correlation": "0.5"}]}]} |
+        And the average block duration is "1"
+        And the liquidity monitoring parameters:
+            | name       | triggering ratio | time window | scaling factor |
+            | lqm-params | 0.00             | 24h         | 1e-9           |
+        And the simple risk model named "simple-risk-model":
+            | long | short | max move up | min move down | probability of trading |
+            | 0.1  | 0.1   | 100         | -100          | 0.2                    |
+        And the price monitoring named "price-monitoring":
+            | horizon | probability | auction extension |
+            | 3600    | 0.95        | 3                 |
+        And the markets:
+            | id        | quote name | asset | liquidity monitoring | risk model        | margin calculator         | auction duration | fees         | price monitoring | data source config     | linear slippage factor | quadratic slippage factor | sla params      |
+            | ETH/FEB23 | ETH        | USD   | lqm-params           | simple-risk-model | default-margin-calculator | 1                | default-none | price-monitoring | default-eth-for-future | 0.25                   | 0                         | default-futures |
+            | ETH/MAR23 | ETH        | USD   | lqm-params           | simple-risk-model | default-margin-calculator | 1                | default-none | price-monitoring | default-eth-for-future | 0.25                   | 0                         | default-futures |
+        And the parties deposit on asset's general account the following amount:
+            | party     | asset | amount       |
+            | lpprov    | USD   | 100000000000 |
+            | aux_buys  | USD   | 100000000000 |
+            | aux_sells | USD   | 100000000000 |
+            | hedger    | USD   | 100000       |
+            | solo      | USD   | 100000       |
+
+        When the parties place the following orders:
+            | party     | market id | side | volume | price | resulting trades | type       | tif     |
+            | aux_buys  | ETH/FEB23 | buy  | 10     | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+            | aux_sells | ETH/FEB23 | sell | 10     | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+            | aux_buys  | ETH/MAR23 | buy  | 10     | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+            | aux_sells | ETH/MAR23 | sell | 10     | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+            | lpprov    | ETH/FEB23 | buy  | 100    | 900   | 0                | TYPE_LIMIT | TIF_GTC |
+            | lpprov    | ETH/FEB23 | sell | 100    | 1100  | 0                | TYPE_LIMIT | TIF_GTC |
+            | lpprov    | ETH/MAR23 | buy  | 100    | 900   | 0                | TYPE_LIMIT | TIF_GTC |
+            | lpprov    | ETH/MAR23 | sell | 100    | 1100  | 0                | TYPE_LIMIT | TIF_GTC |
+        And the parties submit the following liquidity provision:
+            | id  | party  | market id | commitment amount | fee | lp type    |
+            | lp1 | lpprov | ETH/FEB23 | 900000            | 0.1 | submission |
+            | lp2 | lpprov | ETH/MAR23 | 900000            | 0.1 | submission |
+
+        Then the opening auction period ends for market "ETH/FEB23"
+        And the mark price should be "1000" for the market "ETH/FEB23"
+        And the mark price should be "1000" for the market "ETH/MAR23"
+        And the network moves ahead "1" blocks
+
+    Scenario: The margin of a position is reduced as soon as the party opens an offsetting position in a correlated market
+        Given the parties submit update margin mode:
+            | party  | market    | margin_mode  | portfolio_margin |
+            | hedger | ETH/FEB23 | cross margin | true             |
+            | hedger | ETH/MAR23 | cross margin | true             |
+        When the parties place the following orders:
+            | party     | market id | side | volume | price | resulting trades | type       | tif     |
+            | aux_sells | ETH/FEB23 | sell | 10     | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+            | hedger    | ETH/FEB23 | buy  | 10     | 1000  | 1                | TYPE_LIMIT | TIF_GTC |
+            | aux_sells | ETH/FEB23 | sell | 10     | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+            | solo      | ETH/FEB23 | buy  | 10     | 1000  | 1                | TYPE_LIMIT | TIF_GTC |
+        And the network moves ahead "1" blocks
+        Then the parties should have the following margin levels:
+            | party  | market id | maintenance | search | initial | release |
+            | hedger | ETH/FEB23 | 3500        | 3850   | 4200    | 4900    |
+            | solo   | ETH/FEB23 | 3500        | 3850   | 4200    | 4900    |
+
+        # the short position offsets half of the long position, the margin is released straight away
+        When the parties place the following orders:
+            | party    | market id | side | volume | price | resulting trades | type       | tif     |
+            | aux_buys | ETH/MAR23 | buy  | 10     | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+            | hedger   | ETH/MAR23 | sell | 10     | 1000  | 1                | TYPE_LIMIT | TIF_GTC |
+            | aux_buys | ETH/MAR23 | buy  | 10     | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+            | solo     | ETH/MAR23 | sell | 10     | 1000  | 1                | TYPE_LIMIT | TIF_GTC |
+        Then the parties should have the following margin levels:
+            | party  | market id | maintenance | search | initial | release |
+            | hedger | ETH/FEB23 | 1750        | 1925   | 2100    | 2450    |
+            | solo   | ETH/FEB23 | 3500        | 3850   | 4200    | 4900    |
+        And the parties should have the following account balances:
+            | party  | asset | market id | margin | general |
+            | hedger | USD   | ETH/FEB23 | 2100   |         |
+            | solo   | USD   | ETH/FEB23 | 4200   |         |
+
+        When the network moves ahead "1" blocks
+        Then the parties should have the following margin levels:
+            | party  | market id | maintenance | search | initial | release |
+            | hedger | ETH/FEB23 | 1750        | 1925   | 2100    | 2450    |
+            | hedger | ETH/MAR23 | 1750        | 1925   | 2100    | 2450    |
+            | solo   | ETH/FEB23 | 3500        | 3850   | 4200    | 4900    |
+            | solo   | ETH/MAR23 | 3500        | 3850   | 4200    | 4900    |
+
+        # opting out of portfolio margin in one market margins both positions on their own again
+        When the parties submit update margin mode:
+            | party  | market    | margin_mode  | portfolio_margin |
+            | hedger | ETH/MAR23 | cross margin | false            |
+        Then the parties should have the following margin levels:
+            | party  | market id | maintenance | search | initial | release |
+            | hedger | ETH/FEB23 | 3500        | 3850   | 4200    | 4900    |
+            | hedger | ETH/MAR23 | 3500        | 3850   | 4200    | 4900    |
@@ -0,0 +1,84 @@
+Feature: Market orders with a maximum slippage
+
+  Background:
+    Given the following assets are registered:
+      | id  | decimal places |
+      | ETH | 0              |
+    And the simple risk model named "simple-risk-model":
+      | long | short | max move up | min move down | probability of trading |
+      | 0.1  | 0.1   | 100         | -100          | 0.2                    |
+    And the following network parameters are set:
+      | name                                    | value |
+      | network.markPriceUpdateMaximumFrequency | 0s    |
+    And the average block duration is "1"
+    And the markets:
+      | id        | quote name | asset | risk model        | margin calculator         | auction duration | fees         | price monitoring | data source config     | linear slippage factor | quadratic slippage factor | sla params      |
+      | ETH/DEC20 | ETH        | ETH   | simple-risk-model | default-margin-calculator | 1                | default-none | default-none     | default-eth-for-future | 0.25                   | 0                         | default-futures |
+    And the parties deposit on asset's general account the following amount:
+      | party  | asset | amount    |
+      | party1 | ETH   | 100000000 |
+      | party2 | ETH   | 100000000 |
+      | aux1   | ETH   | 100000000 |
+      | aux2   | ETH   | 100000000 |
+    And the parties place the following orders:
+      | party | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux1  | ETH/DEC20 | buy  | 1      | 900   | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux1  | ETH/DEC20 | buy  | 1      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/DEC20 | sell | 1      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/DEC20 | sell | 1      | 1100  | 0                | TYPE_LIMIT | TIF_GTC |
+    When the opening auction period ends for market "ETH/DEC20"
+    Then the market data for the market "ETH/DEC20" should be:
+      | mark price | trading mode            |
+      | 1000       | TRADING_MODE_CONTINUOUS |
+
+  Scenario: An IOC market order stops trading before going beyond its maximum slippage from the best price
+    Given the parties place the following orders:
+      | party | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux2  | ETH/DEC20 | sell | 2      | 1010  | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/DEC20 | sell | 2      | 1030  | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/DEC20 | sell | 5      | 1060  | 0                | TYPE_LIMIT | TIF_GTC |
+
+    # 3% from the best ask of 1010 bounds the order at 1040
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type        | tif     | reference | max slippage |
+      | party1 | ETH/DEC20 | buy  | 10     | 0     | 2                | TYPE_MARKET | TIF_IOC | buy-1     | 300          |
+    Then the following trades should be executed:
+      | buyer  | price | size | seller |
+      | party1 | 1010  | 2    | aux2   |
+      | party1 | 1030  | 2    | aux2   |
+    # the average price of 1020 is 0.99% above the best ask
+    And the orders should have the following states:
+      | party  | market id | side | volume | remaining | price | status                  | reference | achieved slippage |
+      | party1 | ETH/DEC20 | buy  | 10     | 6         | 0     | STATUS_PARTIALLY_FILLED | buy-1     | 99                |
+    And the order book should have the following volumes for market "ETH/DEC20":
+      | side | price | volume |
+      | sell | 1060  | 5      |
+      | sell | 1100  | 1      |
+
+    # the bound moves with the book, 4% from the best ask of 1060 now reaches 1100
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type        | tif     | reference | max slippage |
+      | party1 | ETH/DEC20 | buy  | 6      | 0     | 2                | TYPE_MARKET | TIF_IOC | buy-2     | 400          |
+    Then the orders should have the following states:
+      | party  | market id | side | volume | remaining | price | status        | reference | achieved slippage |
+      | party1 | ETH/DEC20 | buy  | 6      | 0         | 0     | STATUS_FILLED | buy-2     | 62                |
+
+  Scenario: A FOK market order which cannot be filled within its maximum slippage is stopped
+    Given the parties place the following orders:
+      | party | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux1  | ETH/DEC20 | buy  | 3      | 990   | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux1  | ETH/DEC20 | buy  | 2      | 980   | 0                | TYPE_LIMIT | TIF_GTC |
+
+    # 1% from the best bid of 990 bounds the order at 981
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type        | tif     | reference | max slippage |
+      | party2 | ETH/DEC20 | sell | 5      | 0     | 0                | TYPE_MARKET | TIF_FOK | sell-1    | 100          |
+      | party2 | ETH/DEC20 | sell | 5      | 0     | 2                | TYPE_MARKET | TIF_FOK | sell-2    | 200          |
+    Then the orders should have the following status:
+      | party  | reference | status         |
+      | party2 | sell-1    | STATUS_STOPPED |
+      | party2 | sell-2    | STATUS_FILLED  |
+    # the average price of 986 is 0.4% below the best bid
+    And the orders should have the following states:
+      | party  | market id | side | volume | remaining | price | status        | reference | achieved slippage |
+      | party2 | ETH/DEC20 | sell | 5      | 0         | 0     | STATUS_FILLED | sell-2    | 40                |
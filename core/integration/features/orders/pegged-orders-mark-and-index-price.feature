@@ -0,0 +1,200 @@
+Feature: pegged orders referencing the mark price and the index price
+
+  Background:
+    Given the following assets are registered:
+      | id  | decimal places |
+      | ETH | 5              |
+    And the perpetual oracles from "0xCAFECAFE1":
+      | name        | asset | settlement property | settlement type | schedule property | schedule type  | margin funding factor | interest rate | clamp lower bound | clamp upper bound | quote name | settlement decimals | source weights | source staleness tolerance |
+      | perp-oracle | ETH   | perp.ETH.value      | TYPE_INTEGER    | perp.funding.cue  | TYPE_TIMESTAMP | 0                     | 0             | 0                 | 0                 | ETH        | 5                   | 1,0,0,0        | 100s,0s,0s,0s              |
+    And the liquidity sla params named "SLA":
+      | price range | commitment min time fraction | performance hysteresis epochs | sla competition factor |
+      | 1.0         | 0.5                          | 1                             | 1.0                    |
+    And the liquidity monitoring parameters:
+      | name       | triggering ratio | time window | scaling factor |
+      | lqm-params | 0.01             | 10s         | 5              |
+    And the following network parameters are set:
+      | name                                    | value |
+      | network.markPriceUpdateMaximumFrequency | 0s    |
+      | market.auction.minimumDuration          | 1     |
+      | limits.markets.maxPeggedOrders          | 10    |
+    And the average block duration is "1"
+    And the parties deposit on asset's general account the following amount:
+      | party   | asset | amount                     |
+      | lpprov  | ETH   | 10000000000000000000000000 |
+      | trader1 | ETH   | 10000000000000000000000000 |
+      | trader2 | ETH   | 10000000000000000000000000 |
+      | trader3 | ETH   | 10000000000000000000000000 |
+
+  Scenario: Orders pegged to the mark and index prices of a perpetual market are repriced when those prices move
+    Given the markets:
+      | id        | quote name | asset | liquidity monitoring | risk model            | margin calculator         | auction duration | fees         | price monitoring | data source config | linear slippage factor | quadratic slippage factor | decimal places | position decimal places | market type | sla params |
+      | ETH/DEC19 | ETH        | ETH   | lqm-params           | default-st-risk-model | default-margin-calculator | 1                | default-none | default-none     | perp-oracle        | 0.1                    | 0                         | 5              | 5                       | perp        | SLA        |
+    And the parties submit the following liquidity provision:
+      | id  | party  | market id | commitment amount | fee | lp type    |
+      | lp1 | lpprov | ETH/DEC19 | 3905000000000000  | 0.3 | submission |
+    And the parties place the following orders:
+      | party   | market id | side | volume | price  | resulting trades | type       | tif     | reference |
+      | trader1 | ETH/DEC19 | buy  | 5      | 1001   | 0                | TYPE_LIMIT | TIF_GTC | t1-b-1    |
+      | trader1 | ETH/DEC19 | buy  | 5      | 900    | 0                | TYPE_LIMIT | TIF_GTC | t1-b-2    |
+      | trader2 | ETH/DEC19 | sell | 5      | 1200   | 0                | TYPE_LIMIT | TIF_GTC | t2-s-1    |
+      | trader2 | ETH/DEC19 | sell | 5      | 951    | 0                | TYPE_LIMIT | TIF_GTC | t2-s-2    |
+    When the opening auction period ends for market "ETH/DEC19"
+    Then the market data for the market "ETH/DEC19" should be:
+      | mark price | trading mode            |
+      | 976        | TRADING_MODE_CONTINUOUS |
+
+    # no oracle data has been received yet, so there is no index price to peg to
+    When the parties place the following orders:
+      | party   | market id | side | volume | price | resulting trades | type       | tif     | reference | pegged reference | pegged offset |
+      | trader3 | ETH/DEC19 | buy  | 1      | 0     | 0                | TYPE_LIMIT | TIF_GTC | mark-buy  | MARK             | 50            |
+      | trader3 | ETH/DEC19 | sell | 1      | 0     | 0                | TYPE_LIMIT | TIF_GTC | mark-sell | MARK             | 50            |
+      | trader3 | ETH/DEC19 | buy  | 1      | 0     | 0                | TYPE_LIMIT | TIF_GTC | idx-buy   | INDEX            | 10            |
+    Then the orders should have the following states:
+      | party   | market id | side | volume | remaining | price | status        | reference |
+      | trader3 | ETH/DEC19 | buy  | 1      | 1         | 926   | STATUS_ACTIVE | mark-buy  |
+      | trader3 | ETH/DEC19 | sell | 1      | 1         | 1026  | STATUS_ACTIVE | mark-sell |
+      | trader3 | ETH/DEC19 | buy  | 1      | 1         | 0     | STATUS_PARKED | idx-buy   |
+
+    # the index price is picked up at the end of the block
+    When the oracles broadcast data with block time signed with "0xCAFECAFE1":
+      | name           | value | time offset |
+      | perp.ETH.value | 960   | -1s         |
+    And the network moves ahead "1" blocks
+    Then the orders should have the following states:
+      | party   | market id | side | volume | remaining | price | status        | reference |
+      | trader3 | ETH/DEC19 | buy  | 1      | 1         | 950   | STATUS_ACTIVE | idx-buy   |
+
+    When the oracles broadcast data with block time signed with "0xCAFECAFE1":
+      | name           | value | time offset |
+      | perp.ETH.value | 970   | -1s         |
+    And the network moves ahead "1" blocks
+    Then the orders should have the following states:
+      | party   | market id | side | volume | remaining | price | status        | reference |
+      | trader3 | ETH/DEC19 | buy  | 1      | 1         | 960   | STATUS_ACTIVE | idx-buy   |
+
+    # a trade within the spread of the mark pegged orders moves the mark price at the end of the block
+    When the parties place the following orders:
+      | party   | market id | side | volume | price | resulting trades | type       | tif     |
+      | trader1 | ETH/DEC19 | buy  | 1      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | trader2 | ETH/DEC19 | sell | 1      | 1000  | 1                | TYPE_LIMIT | TIF_GTC |
+    Then the orders should have the following states:
+      | party   | market id | side | volume | remaining | price | status        | reference |
+      | trader3 | ETH/DEC19 | buy  | 1      | 1         | 926   | STATUS_ACTIVE | mark-buy  |
+      | trader3 | ETH/DEC19 | sell | 1      | 1         | 1026  | STATUS_ACTIVE | mark-sell |
+
+    When the network moves ahead "1" blocks
+    Then the mark price should be "1000" for the market "ETH/DEC19"
+    And the orders should have the following states:
+      | party   | market id | side | volume | remaining | price | status        | reference |
+      | trader3 | ETH/DEC19 | buy  | 1      | 1         | 950   | STATUS_ACTIVE | mark-buy  |
+      | trader3 | ETH/DEC19 | sell | 1      | 1         | 1050  | STATUS_ACTIVE | mark-sell |
+
+  Scenario: An order pegged to the mark price is parked rather than crossing the book, and unparked once the book moves
+    Given the markets:
+      | id        | quote name | asset | liquidity monitoring | risk model            | margin calculator         | auction duration | fees         | price monitoring | data source config | linear slippage factor | quadratic slippage factor | decimal places | position decimal places | market type | sla params |
+      | ETH/DEC19 | ETH        | ETH   | lqm-params           | default-st-risk-model | default-margin-calculator | 1                | default-none | default-none     | perp-oracle        | 0.1                    | 0                         | 5              | 5                       | perp        | SLA        |
+    And the parties submit the following liquidity provision:
+      | id  | party  | market id | commitment amount | fee | lp type    |
+      | lp1 | lpprov | ETH/DEC19 | 3905000000000000  | 0.3 | submission |
+    And the parties place the following orders:
+      | party   | market id | side | volume | price  | resulting trades | type       | tif     | reference |
+      | trader1 | ETH/DEC19 | buy  | 5      | 1001   | 0                | TYPE_LIMIT | TIF_GTC | t1-b-1    |
+      | trader1 | ETH/DEC19 | buy  | 5      | 900    | 0                | TYPE_LIMIT | TIF_GTC | t1-b-2    |
+      | trader2 | ETH/DEC19 | sell | 5      | 1200   | 0                | TYPE_LIMIT | TIF_GTC | t2-s-1    |
+      | trader2 | ETH/DEC19 | sell | 5      | 951    | 0                | TYPE_LIMIT | TIF_GTC | t2-s-2    |
+    When the opening auction period ends for market "ETH/DEC19"
+    Then the market data for the market "ETH/DEC19" should be:
+      | mark price | trading mode            |
+      | 976        | TRADING_MODE_CONTINUOUS |
+
+    When the parties place the following orders:
+      | party   | market id | side | volume | price | resulting trades | type       | tif     | reference | pegged reference | pegged offset |
+      | trader3 | ETH/DEC19 | buy  | 1      | 0     | 0                | TYPE_LIMIT | TIF_GTC | mark-buy  | MARK             | 10            |
+    Then the orders should have the following states:
+      | party   | market id | side | volume | remaining | price | status        | reference |
+      | trader3 | ETH/DEC19 | buy  | 1      | 1         | 966   | STATUS_ACTIVE | mark-buy  |
+
+    # trade at 1200, then offer at 1100 before the mark price moves
+    When the parties place the following orders:
+      | party   | market id | side | volume | price | resulting trades | type       | tif     | reference |
+      | trader1 | ETH/DEC19 | buy  | 1      | 1200  | 1                | TYPE_LIMIT | TIF_GTC |           |
+      | trader2 | ETH/DEC19 | sell | 1      | 1100  | 0                | TYPE_LIMIT | TIF_GTC | t2-s-3    |
+
+    # the mark price moves to 1200, pegging the buy at 1190 would cross the offer at 1100
+    When the network moves ahead "1" blocks
+    Then the mark price should be "1200" for the market "ETH/DEC19"
+    And the orders should have the following states:
+      | party   | market id | side | volume | remaining | price | status        | reference |
+      | trader3 | ETH/DEC19 | buy  | 1      | 1         | 0     | STATUS_PARKED | mark-buy  |
+
+    # the offer goes away, the pegged order can now rest on the book
+    When the parties cancel the following orders:
+      | party   | reference |
+      | trader2 | t2-s-3    |
+    Then the orders should have the following states:
+      | party   | market id | side | volume | remaining | price | status        | reference |
+      | trader3 | ETH/DEC19 | buy  | 1      | 1         | 1190  | STATUS_ACTIVE | mark-buy  |
+
+  Scenario: Orders pegged to the index price of a future are repriced from its composite price oracles
+    Given the composite price oracles from "0xCAFECAFE2":
+      | name    | price property   | price type   | price decimals |
+      | oracle1 | prices.ETH.value | TYPE_INTEGER | 5              |
+    And the markets:
+      | id        | quote name | asset | liquidity monitoring | risk model            | margin calculator         | auction duration | fees         | price monitoring | data source config     | linear slippage factor | quadratic slippage factor | decimal places | position decimal places | sla params | price type | decay weight | decay power | cash amount | source weights | source staleness tolerance | oracle1 | market type |
+      | ETH/DEC20 | ETH        | ETH   | lqm-params           | default-st-risk-model | default-margin-calculator | 1                | default-none | default-none     | default-eth-for-future | 0.1                    | 0                         | 5              | 5                       | SLA        | weight     | 1            | 1           | 0           | 1,0,0,0        | 0s,0s,100s,0s              | oracle1 | future      |
+    And the parties submit the following liquidity provision:
+      | id  | party  | market id | commitment amount | fee | lp type    |
+      | lp1 | lpprov | ETH/DEC20 | 3905000000000000  | 0.3 | submission |
+    And the parties place the following orders:
+      | party   | market id | side | volume | price  | resulting trades | type       | tif     | reference |
+      | trader1 | ETH/DEC20 | buy  | 5      | 1001   | 0                | TYPE_LIMIT | TIF_GTC | t1-b-1    |
+      | trader1 | ETH/DEC20 | buy  | 5      | 900    | 0                | TYPE_LIMIT | TIF_GTC | t1-b-2    |
+      | trader2 | ETH/DEC20 | sell | 5      | 1200   | 0                | TYPE_LIMIT | TIF_GTC | t2-s-1    |
+      | trader2 | ETH/DEC20 | sell | 5      | 951    | 0                | TYPE_LIMIT | TIF_GTC | t2-s-2    |
+    When the opening auction period ends for market "ETH/DEC20"
+    Then the market data for the market "ETH/DEC20" should be:
+      | mark price | trading mode            |
+      | 976        | TRADING_MODE_CONTINUOUS |
+
+    # no oracle data has been received yet, so there is no index price to peg to
+    When the parties place the following orders:
+      | party   | market id | side | volume | price | resulting trades | type       | tif     | reference | pegged reference | pegged offset |
+      | trader3 | ETH/DEC20 | buy  | 1      | 0     | 0                | TYPE_LIMIT | TIF_GTC | idx-buy   | INDEX            | 10            |
+    Then the orders should have the following states:
+      | party   | market id | side | volume | remaining | price | status        | reference |
+      | trader3 | ETH/DEC20 | buy  | 1      | 1         | 0     | STATUS_PARKED | idx-buy   |
+
+    When the oracles broadcast data with block time signed with "0xCAFECAFE2":
+      | name             | value | time offset |
+      | prices.ETH.value | 960   | -1s         |
+    And the network moves ahead "1" blocks
+    Then the orders should have the following states:
+      | party   | market id | side | volume | remaining | price | status        | reference |
+      | trader3 | ETH/DEC20 | buy  | 1      | 1         | 950   | STATUS_ACTIVE | idx-buy   |
+
+    # a trade doesn't move the index price
+    When the parties place the following orders:
+      | party   | market id | side | volume | price | resulting trades | type       | tif     |
+      | trader1 | ETH/DEC20 | buy  | 1      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | trader2 | ETH/DEC20 | sell | 1      | 1000  | 1                | TYPE_LIMIT | TIF_GTC |
+    And the network moves ahead "1" blocks
+    Then the orders should have the following states:
+      | party   | market id | side | volume | remaining | price | status        | reference |
+      | trader3 | ETH/DEC20 | buy  | 1      | 1         | 950   | STATUS_ACTIVE | idx-buy   |
+
+    When the oracles broadcast data with block time signed with "0xCAFECAFE2":
+      | name             | value | time offset |
+      | prices.ETH.value | 970   | -1s         |
+    And the network moves ahead "1" blocks
+    Then the orders should have the following states:
+      | party   | market id | side | volume | remaining | price | status        | reference |
+      | trader3 | ETH/DEC20 | buy  | 1      | 1         | 960   | STATUS_ACTIVE | idx-buy   |
+
+  Scenario: Orders cannot be pegged to the index price of a market without one
+    Given the markets:
+      | id        | quote name | asset | liquidity monitoring | risk model            | margin calculator         | auction duration | fees         | price monitoring | data source config     | linear slippage factor | quadratic slippage factor | decimal places | position decimal places | sla params |
+      | ETH/DEC20 | ETH        | ETH   | lqm-params           | default-st-risk-model | default-margin-calculator | 1                | default-none | default-none     | default-eth-for-future | 0.1                    | 0                         | 5              | 5                       | SLA        |
+    When the parties place the following orders:
+      | party   | market id | side | volume | price | resulting trades | type       | tif     | reference | pegged reference | pegged offset | error                                                     |
+      | trader3 | ETH/DEC20 | buy  | 1      | 0     | 0                | TYPE_LIMIT | TIF_GTC | idx-buy   | INDEX            | 10            | OrderError: pegged reference not supported by the market |
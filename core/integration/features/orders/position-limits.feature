@@ -0,0 +1,142 @@
+Feature: Per-party position limits and open interest caps
+
+  Background:
+    Given the following assets are registered:
+      | id  | decimal places |
+      | ETH | 0              |
+    And the simple risk model named "simple-risk-model":
+      | long | short | max move up | min move down | probability of trading |
+      | 0.1  | 0.1   | 100         | -100          | 0.2                    |
+    And the following network parameters are set:
+      | name                                    | value |
+      | network.markPriceUpdateMaximumFrequency | 0s    |
+      | market.amm.minCommitmentQuantum         | 1     |
+    And the average block duration is "1"
+    And the markets:
+      | id        | quote name | asset | risk model        | margin calculator         | auction duration | fees         | price monitoring | data source config     | linear slippage factor | quadratic slippage factor | sla params      | max position size | max position notional | max open interest |
+      | ETH/DEC20 | ETH        | ETH   | simple-risk-model | default-margin-calculator | 1                | default-none | default-none     | default-eth-for-future | 0.25                   | 0                         | default-futures | 10                | 0                     | 0                 |
+      | ETH/DEC21 | ETH        | ETH   | simple-risk-model | default-margin-calculator | 1                | default-none | default-none     | default-eth-for-future | 0.25                   | 0                         | default-futures | 0                 | 5000                  | 0                 |
+      | ETH/DEC22 | ETH        | ETH   | simple-risk-model | default-margin-calculator | 1                | default-none | default-none     | default-eth-for-future | 0.25                   | 0                         | default-futures | 0                 | 0                     | 6                 |
+    And the parties deposit on asset's general account the following amount:
+      | party  | asset | amount    |
+      | party1 | ETH   | 100000000 |
+      | party2 | ETH   | 100000000 |
+      | party3 | ETH   | 100000000 |
+      | aux1   | ETH   | 100000000 |
+      | aux2   | ETH   | 100000000 |
+      | vamm1  | ETH   | 100000000 |
+    And the parties place the following orders:
+      | party | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux1  | ETH/DEC20 | buy  | 1      | 900   | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux1  | ETH/DEC20 | buy  | 1      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/DEC20 | sell | 1      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/DEC20 | sell | 1      | 1100  | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux1  | ETH/DEC21 | buy  | 1      | 900   | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux1  | ETH/DEC21 | buy  | 1      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/DEC21 | sell | 1      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/DEC21 | sell | 1      | 1100  | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux1  | ETH/DEC22 | buy  | 1      | 900   | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux1  | ETH/DEC22 | buy  | 1      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/DEC22 | sell | 1      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/DEC22 | sell | 1      | 1100  | 0                | TYPE_LIMIT | TIF_GTC |
+    When the opening auction period ends for market "ETH/DEC20"
+    Then the market data for the market "ETH/DEC20" should be:
+      | mark price | trading mode            | open interest |
+      | 1000       | TRADING_MODE_CONTINUOUS | 1             |
+
+  Scenario: Orders which could take the position of a party above the maximum position size are rejected
+    # the resting orders of the party count towards its potential position
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     | reference | error                                     |
+      | party1 | ETH/DEC20 | buy  | 6      | 950   | 0                | TYPE_LIMIT | TIF_GTC | buy-1     |                                           |
+      | party1 | ETH/DEC20 | buy  | 5      | 950   | 0                | TYPE_LIMIT | TIF_GTC | buy-2     | OrderError: position size limit exceeded |
+      | party1 | ETH/DEC20 | buy  | 4      | 950   | 0                | TYPE_LIMIT | TIF_GTC | buy-3     |                                           |
+      | party2 | ETH/DEC20 | sell | 11     | 1050  | 0                | TYPE_LIMIT | TIF_GTC | sell-1    | OrderError: position size limit exceeded |
+    Then the orders should have the following status:
+      | party  | reference | status          |
+      | party1 | buy-1     | STATUS_ACTIVE   |
+      | party1 | buy-2     | STATUS_REJECTED |
+      | party1 | buy-3     | STATUS_ACTIVE   |
+      | party2 | sell-1    | STATUS_REJECTED |
+
+    # amending an order above the limit is rejected, and the order is left untouched
+    When the parties amend the following orders:
+      | party  | reference | price | size delta | tif     | error                                     |
+      | party1 | buy-1     | 950   | 1          | TIF_GTC | OrderError: position size limit exceeded |
+      | party1 | buy-1     | 950   | -1         | TIF_GTC |                                           |
+    Then the orders should have the following states:
+      | party  | market id | side | volume | remaining | price | status        |
+      | party1 | ETH/DEC20 | buy  | 5      | 5         | 950   | STATUS_ACTIVE |
+
+    # orders reducing the exposure of the party are always accepted
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     |
+      | party2 | ETH/DEC20 | sell | 9      | 950   | 2                | TYPE_LIMIT | TIF_GTC |
+    And the network moves ahead "1" blocks
+    Then the parties should have the following profit and loss:
+      | party  | volume | unrealised pnl | realised pnl |
+      | party1 | 9      | 0              | 0            |
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     | error                                     |
+      | party1 | ETH/DEC20 | buy  | 2      | 950   | 0                | TYPE_LIMIT | TIF_GTC | OrderError: position size limit exceeded |
+      | party1 | ETH/DEC20 | sell | 20     | 1050  | 0                | TYPE_LIMIT | TIF_GTC | OrderError: position size limit exceeded |
+      | party1 | ETH/DEC20 | sell | 9      | 1050  | 0                | TYPE_LIMIT | TIF_GTC |                                           |
+
+    # removing the limit lets the party build a larger position
+    When the markets are updated:
+      | id        | max position size |
+      | ETH/DEC20 | 0                 |
+    And the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     | error |
+      | party3 | ETH/DEC20 | buy  | 20     | 900   | 0                | TYPE_LIMIT | TIF_GTC |       |
+
+  Scenario: Orders which could take the notional of the position of a party above the maximum notional are rejected
+    # the position is priced at the order price, or the mark price if it's higher
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     | error                                         |
+      | party1 | ETH/DEC21 | buy  | 6      | 1000  | 0                | TYPE_LIMIT | TIF_GTC | OrderError: position notional limit exceeded |
+      | party1 | ETH/DEC21 | buy  | 6      | 900   | 0                | TYPE_LIMIT | TIF_GTC | OrderError: position notional limit exceeded |
+      | party1 | ETH/DEC21 | buy  | 5      | 900   | 0                | TYPE_LIMIT | TIF_GTC |                                               |
+      | party2 | ETH/DEC21 | sell | 5      | 1100  | 0                | TYPE_LIMIT | TIF_GTC | OrderError: position notional limit exceeded |
+      | party2 | ETH/DEC21 | sell | 4      | 1100  | 0                | TYPE_LIMIT | TIF_GTC |                                               |
+
+  Scenario: Orders which could take the open interest of the market above its maximum are rejected
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     | reference | error                                     |
+      | party1 | ETH/DEC22 | buy  | 4      | 1000  | 0                | TYPE_LIMIT | TIF_GTC | buy-1     |                                           |
+      | party2 | ETH/DEC22 | sell | 4      | 1000  | 1                | TYPE_LIMIT | TIF_GTC | sell-1    |                                           |
+      | party3 | ETH/DEC22 | buy  | 2      | 950   | 0                | TYPE_LIMIT | TIF_GTC | buy-2     | OrderError: open interest limit exceeded |
+      | party3 | ETH/DEC22 | buy  | 1      | 950   | 0                | TYPE_LIMIT | TIF_GTC | buy-3     |                                           |
+    And the network moves ahead "1" blocks
+    Then the market data for the market "ETH/DEC22" should be:
+      | mark price | trading mode            | open interest |
+      | 1000       | TRADING_MODE_CONTINUOUS | 5             |
+
+    # a party reducing its position doesn't add to the open interest
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     | error |
+      | party2 | ETH/DEC22 | buy  | 4      | 950   | 0                | TYPE_LIMIT | TIF_GTC |       |
+
+  Scenario: AMM pools which could hold a position above the limits at one of their bounds are rejected
+    # the pool is long about 25 at its lower bound
+    When the parties submit the following AMM:
+      | party | market id | amount | slippage | base | lower bound | upper bound | lower leverage | upper leverage | proposed fee | error                                    |
+      | vamm1 | ETH/DEC20 | 100000 | 0.1      | 1000 | 995         | 1005        | 0.25           | 0.25           | 0.01         | OrderError: position size limit exceeded |
+    Then the following AMM pool events should be emitted:
+      | party | market id | amount | status          | reason                                | base | lower bound | upper bound | lower leverage | upper leverage |
+      | vamm1 | ETH/DEC20 | 100000 | STATUS_REJECTED | STATUS_REASON_POSITION_LIMIT_EXCEEDED | 1000 | 995         | 1005        | 0.25           | 0.25           |
+
+    When the parties submit the following AMM:
+      | party | market id | amount | slippage | base | lower bound | upper bound | lower leverage | upper leverage | proposed fee |
+      | vamm1 | ETH/DEC20 | 30000  | 0.1      | 1000 | 995         | 1005        | 0.25           | 0.25           | 0.01         |
+    Then the AMM pool status should be:
+      | party | market id | amount | status        | base | lower bound | upper bound | lower leverage | upper leverage |
+      | vamm1 | ETH/DEC20 | 30000  | STATUS_ACTIVE | 1000 | 995         | 1005        | 0.25           | 0.25           |
+
+    # amending the pool above the limits is rejected and the pool is left untouched
+    When the parties amend the following AMM:
+      | party | market id | amount | slippage | error                                    |
+      | vamm1 | ETH/DEC20 | 100000 | 0.1      | OrderError: position size limit exceeded |
+    Then the AMM pool status should be:
+      | party | market id | amount | status        | base | lower bound | upper bound | lower leverage | upper leverage |
+      | vamm1 | ETH/DEC20 | 30000  | STATUS_ACTIVE | 1000 | 995         | 1005        | 0.25           | 0.25           |
@@ -0,0 +1,132 @@
+Feature: Pro-rata matching of orders at a price level
+
+  Background:
+    Given the following assets are registered:
+      | id  | decimal places |
+      | ETH | 0              |
+    And the simple risk model named "simple-risk-model":
+      | long | short | max move up | min move down | probability of trading |
+      | 0.1  | 0.1   | 100         | -100          | 0.2                    |
+    And the following network parameters are set:
+      | name                                    | value |
+      | network.markPriceUpdateMaximumFrequency | 0s    |
+      | market.amm.minCommitmentQuantum         | 1     |
+    And the average block duration is "1"
+    And the markets:
+      | id        | quote name | asset | risk model        | margin calculator         | auction duration | fees         | price monitoring | data source config     | linear slippage factor | quadratic slippage factor | sla params      | matching algorithm                      |
+      | ETH/DEC20 | ETH        | ETH   | simple-risk-model | default-margin-calculator | 1                | default-none | default-none     | default-eth-for-future | 0.25                   | 0                         | default-futures | MATCHING_ALGORITHM_PRO_RATA             |
+      | ETH/DEC21 | ETH        | ETH   | simple-risk-model | default-margin-calculator | 1                | default-none | default-none     | default-eth-for-future | 0.25                   | 0                         | default-futures | MATCHING_ALGORITHM_PRO_RATA_TOP_OF_BOOK |
+    And the parties deposit on asset's general account the following amount:
+      | party  | asset | amount    |
+      | party1 | ETH   | 100000000 |
+      | party2 | ETH   | 100000000 |
+      | party3 | ETH   | 100000000 |
+      | party4 | ETH   | 100000000 |
+      | aux1   | ETH   | 100000000 |
+      | aux2   | ETH   | 100000000 |
+      | vamm1  | ETH   | 100000000 |
+    And the parties place the following orders:
+      | party | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux1  | ETH/DEC20 | buy  | 1      | 900   | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux1  | ETH/DEC20 | buy  | 1      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/DEC20 | sell | 1      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/DEC20 | sell | 1      | 1100  | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux1  | ETH/DEC21 | buy  | 1      | 900   | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux1  | ETH/DEC21 | buy  | 1      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/DEC21 | sell | 1      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/DEC21 | sell | 1      | 1100  | 0                | TYPE_LIMIT | TIF_GTC |
+    When the opening auction period ends for market "ETH/DEC20"
+    Then the market data for the market "ETH/DEC20" should be:
+      | mark price | trading mode            |
+      | 1000       | TRADING_MODE_CONTINUOUS |
+
+  Scenario: The volume of an aggressive order is shared between the orders at a level in proportion to their size
+    Given the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     | reference |
+      | party1 | ETH/DEC20 | sell | 10     | 1050  | 0                | TYPE_LIMIT | TIF_GTC | sell-1    |
+      | party2 | ETH/DEC20 | sell | 30     | 1050  | 0                | TYPE_LIMIT | TIF_GTC | sell-2    |
+      | party3 | ETH/DEC20 | sell | 60     | 1050  | 0                | TYPE_LIMIT | TIF_GTC | sell-3    |
+
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     |
+      | party4 | ETH/DEC20 | buy  | 50     | 1050  | 3                | TYPE_LIMIT | TIF_GTC |
+    Then the following trades should be executed:
+      | buyer  | price | size | seller |
+      | party4 | 1050  | 5    | party1 |
+      | party4 | 1050  | 15   | party2 |
+      | party4 | 1050  | 30   | party3 |
+    And the order book should have the following volumes for market "ETH/DEC20":
+      | side | price | volume |
+      | sell | 1050  | 50     |
+
+    # the shares of 0.3, 0.9 and 1.8 are rounded down, and the remaining lots go to the oldest orders
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     |
+      | party4 | ETH/DEC20 | buy  | 3      | 1050  | 3                | TYPE_LIMIT | TIF_GTC |
+    Then the orders should have the following states:
+      | party  | market id | side | volume | remaining | price | status        | reference |
+      | party1 | ETH/DEC20 | sell | 10     | 4         | 1050  | STATUS_ACTIVE | sell-1    |
+      | party2 | ETH/DEC20 | sell | 30     | 14        | 1050  | STATUS_ACTIVE | sell-2    |
+      | party3 | ETH/DEC20 | sell | 60     | 29        | 1050  | STATUS_ACTIVE | sell-3    |
+
+  Scenario: The oldest order at a level is filled first with top of book priority
+    Given the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     | reference |
+      | party1 | ETH/DEC21 | sell | 10     | 1050  | 0                | TYPE_LIMIT | TIF_GTC | sell-1    |
+      | party2 | ETH/DEC21 | sell | 20     | 1050  | 0                | TYPE_LIMIT | TIF_GTC | sell-2    |
+      | party3 | ETH/DEC21 | sell | 20     | 1050  | 0                | TYPE_LIMIT | TIF_GTC | sell-3    |
+
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     |
+      | party4 | ETH/DEC21 | buy  | 30     | 1050  | 3                | TYPE_LIMIT | TIF_GTC |
+    Then the following trades should be executed:
+      | buyer  | price | size | seller |
+      | party4 | 1050  | 10   | party1 |
+      | party4 | 1050  | 10   | party2 |
+      | party4 | 1050  | 10   | party3 |
+    And the orders should have the following status:
+      | party  | reference | status        |
+      | party1 | sell-1    | STATUS_FILLED |
+
+    # switching the market back to price-time priority fills the oldest order first
+    When the markets are updated:
+      | id        | matching algorithm            |
+      | ETH/DEC21 | MATCHING_ALGORITHM_PRICE_TIME |
+    And the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     |
+      | party4 | ETH/DEC21 | buy  | 15     | 1050  | 2                | TYPE_LIMIT | TIF_GTC |
+    Then the orders should have the following states:
+      | party  | market id | side | volume | remaining | price | status        | reference |
+      | party2 | ETH/DEC21 | sell | 20     | 0         | 1050  | STATUS_FILLED | sell-2    |
+      | party3 | ETH/DEC21 | sell | 20     | 5         | 1050  | STATUS_ACTIVE | sell-3    |
+
+  Scenario: The volume of an AMM at the price of a level is shared with the orders resting there
+    Given the parties submit the following AMM:
+      | party | market id | amount | slippage | base | lower bound | upper bound | lower leverage | upper leverage | proposed fee |
+      | vamm1 | ETH/DEC20 | 100000 | 0.1      | 1000 | 995         | 1005        | 0.25           | 0.25           | 0.01         |
+    And the AMM pool status should be:
+      | party | market id | amount | status        | base | lower bound | upper bound | lower leverage | upper leverage |
+      | vamm1 | ETH/DEC20 | 100000 | STATUS_ACTIVE | 1000 | 995         | 1005        | 0.25           | 0.25           |
+    And set the following AMM sub account aliases:
+      | party | market id | alias    |
+      | vamm1 | ETH/DEC20 | vamm1-id |
+    And the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     | reference |
+      | party1 | ETH/DEC20 | sell | 10     | 1002  | 0                | TYPE_LIMIT | TIF_GTC | sell-1    |
+      | party2 | ETH/DEC20 | sell | 10     | 1002  | 0                | TYPE_LIMIT | TIF_GTC | sell-2    |
+
+    # the pool's volume up to the tick before 1002 trades first, the 16 left are then shared between the two
+    # orders and the 3 the pool has at 1002, with price-time priority the pool would have taken its 3 first
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     |
+      | party4 | ETH/DEC20 | buy  | 20     | 1002  | 4                | TYPE_LIMIT | TIF_GTC |
+    Then the following trades should be executed:
+      | buyer  | price | size | seller   | is amm |
+      | party4 | 1000  | 4    | vamm1-id | true   |
+      | party4 | 1002  | 7    | party1   | false  |
+      | party4 | 1002  | 7    | party2   | false  |
+      | party4 | 1001  | 2    | vamm1-id | true   |
+    And the orders should have the following states:
+      | party  | market id | side | volume | remaining | price | status        | reference |
+      | party1 | ETH/DEC20 | sell | 10     | 3         | 1002  | STATUS_ACTIVE | sell-1    |
+      | party2 | ETH/DEC20 | sell | 10     | 3         | 1002  | STATUS_ACTIVE | sell-2    |
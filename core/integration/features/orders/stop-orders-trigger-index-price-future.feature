@@ -0,0 +1,85 @@
+Feature: stop orders triggered by the index price of a future from its composite price oracles
+
+  Background:
+    Given the following network parameters are set:
+      | name                                    | value |
+      | market.auction.minimumDuration          | 1     |
+      | network.markPriceUpdateMaximumFrequency | 0s    |
+      | limits.markets.maxPeggedOrders          | 1500  |
+      | spam.protection.max.stopOrdersPerMarket | 5     |
+    And the composite price oracles from "0xCAFECAFE1":
+      | name    | price property   | price type   | price decimals |
+      | oracle1 | prices.ETH.value | TYPE_INTEGER | 0              |
+    And the markets:
+      | id        | quote name | asset | risk model                  | margin calculator         | auction duration | fees         | price monitoring | data source config     | linear slippage factor | quadratic slippage factor | sla params      | price type | decay weight | decay power | cash amount | source weights | source staleness tolerance | oracle1 | market type |
+      | ETH/FEB23 | BTC        | BTC   | default-simple-risk-model-3 | default-margin-calculator | 1                | default-none | default-none     | default-eth-for-future | 0.25                   | 0                         | default-futures | weight     | 1            | 1           | 0           | 1,0,0,0        | 0s,0s,100s,0s              | oracle1 | future      |
+
+    Given the parties deposit on asset's general account the following amount:
+      | party  | asset | amount   |
+      | party1 | BTC   | 10000    |
+      | party2 | BTC   | 10000    |
+      | party3 | BTC   | 10000    |
+      | aux    | BTC   | 100000   |
+      | aux2   | BTC   | 100000   |
+      | lpprov | BTC   | 90000000 |
+
+    When the parties submit the following liquidity provision:
+      | id  | party  | market id | commitment amount | fee | lp type    |
+      | lp1 | lpprov | ETH/FEB23 | 90000000          | 0.1 | submission |
+    And the parties place the following pegged iceberg orders:
+      | party  | market id | peak size | minimum visible size | side | pegged reference | volume | offset |
+      | lpprov | ETH/FEB23 | 2         | 1                    | buy  | BID              | 50     | 100    |
+      | lpprov | ETH/FEB23 | 2         | 1                    | sell | ASK              | 50     | 100    |
+    # place auxiliary orders so we always have best bid and best offer as to not trigger the liquidity auction
+    When the parties place the following orders:
+      | party | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux   | ETH/FEB23 | buy  | 1      | 1     | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux   | ETH/FEB23 | sell | 1      | 10001 | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/FEB23 | buy  | 5      | 50    | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux   | ETH/FEB23 | sell | 5      | 50    | 0                | TYPE_LIMIT | TIF_GTC |
+
+    Then the opening auction period ends for market "ETH/FEB23"
+    And the trading mode should be "TRADING_MODE_CONTINUOUS" for the market "ETH/FEB23"
+
+    # setup party1 position, open a 10 long position
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     |
+      | party1 | ETH/FEB23 | buy  | 10     | 50    | 0                | TYPE_LIMIT | TIF_GTC |
+      | party2 | ETH/FEB23 | sell | 10     | 50    | 1                | TYPE_LIMIT | TIF_GTC |
+
+  Scenario: A stop order triggered by the index price of a future follows its oracles, not the trades.
+
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type        | tif     | only   | fb price trigger | fb trigger reference          | reference |
+      | party1 | ETH/FEB23 | sell | 10     | 0     | 0                | TYPE_MARKET | TIF_IOC | reduce | 25               | TRIGGER_REFERENCE_INDEX_PRICE | stop1     |
+
+    # volume for the stop trade
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     |
+      | party3 | ETH/FEB23 | buy  | 10     | 20    | 0                | TYPE_LIMIT | TIF_GTC |
+
+    When the oracles broadcast data with block time signed with "0xCAFECAFE1":
+      | name             | value | time offset |
+      | prices.ETH.value | 30    | -1s         |
+    And the network moves ahead "1" blocks
+
+    # a trade at 24 breaches the trigger, the index price doesn't
+    When the parties place the following orders:
+      | party | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux2  | ETH/FEB23 | buy  | 1      | 24    | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux   | ETH/FEB23 | sell | 1      | 24    | 1                | TYPE_LIMIT | TIF_GTC |
+    And the network moves ahead "1" blocks
+    Then the stop orders should have the following states
+      | party  | market id | status         | reference |
+      | party1 | ETH/FEB23 | STATUS_PENDING | stop1     |
+
+    When the oracles broadcast data with block time signed with "0xCAFECAFE1":
+      | name             | value | time offset |
+      | prices.ETH.value | 24    | -1s         |
+    And the network moves ahead "1" blocks
+    Then the stop orders should have the following states
+      | party  | market id | status           | reference |
+      | party1 | ETH/FEB23 | STATUS_TRIGGERED | stop1     |
+    And the orders should have the following states:
+      | party  | market id | side | volume | remaining | price | status        | reference |
+      | party1 | ETH/FEB23 | sell | 10     | 0         | 0     | STATUS_FILLED | stop1     |
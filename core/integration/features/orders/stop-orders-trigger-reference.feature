@@ -0,0 +1,110 @@
+Feature: stop orders triggered by a reference price other than the last traded price
+
+  Background:
+    Given the markets:
+      | id        | quote name | asset | risk model                  | margin calculator         | auction duration | fees         | price monitoring | data source config     | linear slippage factor | quadratic slippage factor | sla params      |
+      | ETH/DEC19 | BTC        | BTC   | default-simple-risk-model-3 | default-margin-calculator | 1                | default-none | default-none     | default-eth-for-future | 0.25                   | 0                         | default-futures |
+    And the following network parameters are set:
+      | name                                    | value |
+      | market.auction.minimumDuration          | 1     |
+      | network.markPriceUpdateMaximumFrequency | 0s    |
+      | limits.markets.maxPeggedOrders          | 1500  |
+      | spam.protection.max.stopOrdersPerMarket | 5     |
+
+    Given the parties deposit on asset's general account the following amount:
+      | party  | asset | amount   |
+      | party1 | BTC   | 10000    |
+      | party2 | BTC   | 10000    |
+      | party3 | BTC   | 10000    |
+      | aux    | BTC   | 100000   |
+      | aux2   | BTC   | 100000   |
+      | lpprov | BTC   | 90000000 |
+
+    When the parties submit the following liquidity provision:
+      | id  | party  | market id | commitment amount | fee | lp type    |
+      | lp1 | lpprov | ETH/DEC19 | 90000000          | 0.1 | submission |
+    And the parties place the following pegged iceberg orders:
+      | party  | market id | peak size | minimum visible size | side | pegged reference | volume | offset |
+      | lpprov | ETH/DEC19 | 2         | 1                    | buy  | BID              | 50     | 100    |
+      | lpprov | ETH/DEC19 | 2         | 1                    | sell | ASK              | 50     | 100    |
+    # place auxiliary orders so we always have best bid and best offer as to not trigger the liquidity auction
+    When the parties place the following orders:
+      | party | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux   | ETH/DEC19 | buy  | 1      | 1     | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux   | ETH/DEC19 | sell | 1      | 10001 | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux2  | ETH/DEC19 | buy  | 5      | 50    | 0                | TYPE_LIMIT | TIF_GTC |
+      | aux   | ETH/DEC19 | sell | 5      | 50    | 0                | TYPE_LIMIT | TIF_GTC |
+
+    Then the opening auction period ends for market "ETH/DEC19"
+    And the trading mode should be "TRADING_MODE_CONTINUOUS" for the market "ETH/DEC19"
+
+    # setup party1 position, open a 10 long position
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     |
+      | party1 | ETH/DEC19 | buy  | 10     | 50    | 0                | TYPE_LIMIT | TIF_GTC |
+      | party2 | ETH/DEC19 | sell | 10     | 50    | 1                | TYPE_LIMIT | TIF_GTC |
+
+  Scenario: A stop order triggered by the mark price is not triggered by a single trade, only once the mark price moves.
+
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type        | tif     | only   | fb price trigger | fb trigger reference         | reference |
+      | party1 | ETH/DEC19 | sell | 10     | 0     | 0                | TYPE_MARKET | TIF_IOC | reduce | 25               | TRIGGER_REFERENCE_MARK_PRICE | stop1     |
+
+    # volume for the stop trade
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     |
+      | party3 | ETH/DEC19 | buy  | 10     | 20    | 0                | TYPE_LIMIT | TIF_GTC |
+
+    # now we trade at 25, the last traded price breaches the trigger but not the mark price
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     |
+      | party3 | ETH/DEC19 | sell | 1      | 25    | 0                | TYPE_LIMIT | TIF_GTC |
+      | party2 | ETH/DEC19 | buy  | 1      | 25    | 1                | TYPE_LIMIT | TIF_GTC |
+
+    Then the mark price should be "50" for the market "ETH/DEC19"
+    And the stop orders should have the following states
+      | party  | market id | status         | reference |
+      | party1 | ETH/DEC19 | STATUS_PENDING | stop1     |
+
+    # the mark price is updated at the end of the block
+    When the network moves ahead "1" blocks
+    Then the mark price should be "25" for the market "ETH/DEC19"
+    And the stop orders should have the following states
+      | party  | market id | status           | reference |
+      | party1 | ETH/DEC19 | STATUS_TRIGGERED | stop1     |
+    And the orders should have the following states:
+      | party  | market id | side | volume | remaining | price | status        | reference |
+      | party1 | ETH/DEC19 | sell | 10     | 0         | 0     | STATUS_FILLED | stop1     |
+
+  Scenario: A stop order triggered by the mid price is triggered as soon as the book moves.
+
+    # volume for the stop trade, mid price is now (40+10001)/2
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     |
+      | party3 | ETH/DEC19 | buy  | 10     | 40    | 0                | TYPE_LIMIT | TIF_GTC |
+
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type        | tif     | only   | fb price trigger | fb trigger reference        | reference |
+      | party1 | ETH/DEC19 | sell | 10     | 0     | 0                | TYPE_MARKET | TIF_IOC | reduce | 4000             | TRIGGER_REFERENCE_MID_PRICE | stop1     |
+
+    Then the stop orders should have the following states
+      | party  | market id | status         | reference |
+      | party1 | ETH/DEC19 | STATUS_PENDING | stop1     |
+
+    # best ask moves from 10001 to 5000, pulling the mid price below 4000 without any trade
+    When the parties place the following orders:
+      | party | market id | side | volume | price | resulting trades | type       | tif     |
+      | aux   | ETH/DEC19 | sell | 1      | 5000  | 0                | TYPE_LIMIT | TIF_GTC |
+
+    Then the stop orders should have the following states
+      | party  | market id | status           | reference |
+      | party1 | ETH/DEC19 | STATUS_TRIGGERED | stop1     |
+    And the orders should have the following states:
+      | party  | market id | side | volume | remaining | price | status        | reference |
+      | party1 | ETH/DEC19 | sell | 10     | 0         | 0     | STATUS_FILLED | stop1     |
+
+  Scenario: A stop order cannot be triggered by the index price of a market without one.
+
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type        | tif     | only   | fb price trigger | fb trigger reference          | reference | error                                                       |
+      | party1 | ETH/DEC19 | sell | 10     | 0     | 0                | TYPE_MARKET | TIF_IOC | reduce | 25               | TRIGGER_REFERENCE_INDEX_PRICE | stop1     | stop order trigger reference is not supported by the market |
@@ -0,0 +1,95 @@
+Feature: Perpetual market with an index basket as underlying.
+
+  Background:
+    Given the following assets are registered:
+      | id  | decimal places |
+      | ETH | 5              |
+    And the liquidity sla params named "SLA":
+      | price range | commitment min time fraction | performance hysteresis epochs | sla competition factor |
+      | 1.0         | 0.5                          | 1                             | 1.0                    |
+    And the liquidity monitoring parameters:
+      | name       | triggering ratio | time window | scaling factor |
+      | lqm-params | 0.01             | 10s         | 5              |
+
+    And the following network parameters are set:
+      | name                                             | value |
+      | network.markPriceUpdateMaximumFrequency          | 1s    |
+      | network.internalCompositePriceUpdateFrequency    | 1s    |
+      | market.auction.minimumDuration                   | 1     |
+      | market.fee.factors.infrastructureFee             | 0.001 |
+      | market.fee.factors.makerFee                      | 0.004 |
+      | market.value.windowLength                        | 60s   |
+      | market.liquidity.bondPenaltyParameter            | 0.1   |
+      | validators.epoch.length                          | 5s    |
+      | limits.markets.maxPeggedOrders                   | 2     |
+      | market.liquidity.providersFeeCalculationTimeStep | 5s    |
+
+    And the average block duration is "1"
+
+    # All parties have 1,000,000.000,000,000,000,000,000
+    # Add as many parties as needed here
+    And the parties deposit on asset's general account the following amount:
+      | party   | asset | amount                     |
+      | lpprov  | ETH   | 10000000000000000000000000 |
+      | trader1 | ETH   | 10000000000000000000000000 |
+      | trader2 | ETH   | 10000000000000000000000000 |
+
+  Scenario: The underlying index of the perpetual is the value of a basket of price sources
+    Given the composite price oracles from "0xCAFECAFE2":
+      | name    | price property   | price type   | price decimals |
+      | oracle1 | prices.BTC.value | TYPE_INTEGER | 5              |
+      | oracle2 | prices.ETH.value | TYPE_INTEGER | 5              |
+    And the perpetual oracles from "0xCAFECAFE1":
+      | name        | asset | settlement property | settlement type | schedule property | schedule type  | margin funding factor | interest rate | clamp lower bound | clamp upper bound | quote name | settlement decimals | price type | cash amount | source weights | source staleness tolerance | index constituents | index weights | index staleness tolerance | index rebalance interval |
+      | perp-oracle | ETH   | perp.ETH.value      | TYPE_INTEGER    | perp.funding.cue  | TYPE_TIMESTAMP | 0                     | 0             | 0                 | 0                 | ETH        | 5                   | weight     | 1000        | 1,0,0,0        | 0s,0s,0s,0s                | oracle1,oracle2    | 1,1           | 1h,1h                     | 24h                      |
+    And the markets:
+      | id        | quote name | asset | liquidity monitoring | risk model            | margin calculator         | auction duration | fees         | price monitoring | data source config | linear slippage factor | quadratic slippage factor | decimal places | position decimal places | market type | sla params | price type |
+      | ETH/DEC19 | ETH        | ETH   | lqm-params           | default-st-risk-model | default-margin-calculator | 1                | default-none | default-none     | perp-oracle        | 0.1                    | 0                         | 5              | 5                       | perp        | SLA        | last trade |
+    Given the parties submit the following liquidity provision:
+      | id  | party  | market id | commitment amount | fee | lp type    |
+      | lp1 | lpprov | ETH/DEC19 | 3905000000000000  | 0.3 | submission |
+    And the parties place the following pegged iceberg orders:
+      | party  | market id | peak size        | minimum visible size | side | pegged reference | volume           | offset | reference   |
+      | lpprov | ETH/DEC19 | 4000000000000000 | 3905000000000000     | buy  | BID              | 4000000000000000 | 1      | lp-ice-buy  |
+      | lpprov | ETH/DEC19 | 4000000000000000 | 3905000000000000     | sell | ASK              | 4000000000000000 | 1      | lp-ice-sell |
+    And the parties place the following orders:
+      | party   | market id | side | volume | price  | resulting trades | type       | tif     | reference |
+      | trader1 | ETH/DEC19 | buy  | 5      | 1001   | 0                | TYPE_LIMIT | TIF_GTC | t1-b-1    |
+      | trader1 | ETH/DEC19 | buy  | 1      | 100    | 0                | TYPE_LIMIT | TIF_GTC | t1-b-3    |
+      | trader2 | ETH/DEC19 | sell | 5      | 1200   | 0                | TYPE_LIMIT | TIF_GTC | t2-s-1    |
+      | trader2 | ETH/DEC19 | sell | 1      | 100000 | 0                | TYPE_LIMIT | TIF_GTC | t2-s-2    |
+      | trader2 | ETH/DEC19 | sell | 5      | 951    | 0                | TYPE_LIMIT | TIF_GTC | t2-s-3    |
+    When the opening auction period ends for market "ETH/DEC19"
+    Then the market data for the market "ETH/DEC19" should be:
+      | mark price | trading mode            |
+      | 976        | TRADING_MODE_CONTINUOUS |
+
+    # the index is only computed once all the constituents have a price, with equal weights
+    # it starts as the average of the prices
+    When the network moves ahead "2" blocks
+    And the oracles broadcast data with block time signed with "0xCAFECAFE2":
+      | name             | value | time offset |
+      | prices.BTC.value | 1000  | -1s         |
+    And the oracles broadcast data with block time signed with "0xCAFECAFE2":
+      | name             | value | time offset |
+      | prices.ETH.value | 3000  | -1s         |
+    Then the product data for the market "ETH/DEC19" should be:
+      | internal twap | external twap | underlying index price |
+      | 976           | 2000          | 2000                   |
+
+    # the settlement data source does not drive the index of a basket perpetual
+    When the oracles broadcast data with block time signed with "0xCAFECAFE1":
+      | name           | value | time offset |
+      | perp.ETH.value | 140   | 0s          |
+    Then the product data for the market "ETH/DEC19" should be:
+      | internal twap | external twap | underlying index price |
+      | 976           | 2000          | 2000                   |
+
+    # the basket holds 1 BTC and 1/3 ETH until it is rebalanced
+    When the network moves ahead "1" blocks
+    And the oracles broadcast data with block time signed with "0xCAFECAFE2":
+      | name             | value | time offset |
+      | prices.BTC.value | 2000  | 0s          |
+    Then the product data for the market "ETH/DEC19" should be:
+      | internal twap | external twap | underlying index price |
+      | 976           | 2000          | 3000                   |
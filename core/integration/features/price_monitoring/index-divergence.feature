@@ -0,0 +1,103 @@
+Feature: price monitoring auctions triggered by the traded price diverging from the index price
+
+  Background:
+    Given the price monitoring named "index-divergence" has the index divergence:
+      | max divergence | auction extension |
+      | 0.05           | 5                 |
+    And the following assets are registered:
+      | id  | decimal places |
+      | ETH | 5              |
+    And the perpetual oracles from "0xCAFECAFE1":
+      | name        | asset | settlement property | settlement type | schedule property | schedule type  | margin funding factor | interest rate | clamp lower bound | clamp upper bound | quote name | settlement decimals | source weights | source staleness tolerance |
+      | perp-oracle | ETH   | perp.ETH.value      | TYPE_INTEGER    | perp.funding.cue  | TYPE_TIMESTAMP | 0                     | 0             | 0                 | 0                 | ETH        | 5                   | 1,0,0,0        | 100s,0s,0s,0s              |
+    And the liquidity sla params named "SLA":
+      | price range | commitment min time fraction | performance hysteresis epochs | sla competition factor |
+      | 1.0         | 0.5                          | 1                             | 1.0                    |
+    And the liquidity monitoring parameters:
+      | name       | triggering ratio | time window | scaling factor |
+      | lqm-params | 0.01             | 10s         | 5              |
+    And the following network parameters are set:
+      | name                                    | value |
+      | network.markPriceUpdateMaximumFrequency | 0s    |
+      | market.auction.minimumDuration          | 1     |
+    And the average block duration is "1"
+    And the parties deposit on asset's general account the following amount:
+      | party   | asset | amount                     |
+      | lpprov  | ETH   | 10000000000000000000000000 |
+      | trader1 | ETH   | 10000000000000000000000000 |
+      | trader2 | ETH   | 10000000000000000000000000 |
+    And the markets:
+      | id        | quote name | asset | liquidity monitoring | risk model            | margin calculator         | auction duration | fees         | price monitoring | data source config | linear slippage factor | quadratic slippage factor | decimal places | position decimal places | market type | sla params |
+      | ETH/DEC19 | ETH        | ETH   | lqm-params           | default-st-risk-model | default-margin-calculator | 1                | default-none | index-divergence | perp-oracle        | 0.1                    | 0                         | 5              | 5                       | perp        | SLA        |
+    And the parties submit the following liquidity provision:
+      | id  | party  | market id | commitment amount | fee | lp type    |
+      | lp1 | lpprov | ETH/DEC19 | 3905000000000000  | 0.3 | submission |
+    And the parties place the following orders:
+      | party   | market id | side | volume | price | resulting trades | type       | tif     |
+      | trader1 | ETH/DEC19 | buy  | 5      | 900   | 0                | TYPE_LIMIT | TIF_GTC |
+      | trader1 | ETH/DEC19 | buy  | 5      | 1001  | 0                | TYPE_LIMIT | TIF_GTC |
+      | trader2 | ETH/DEC19 | sell | 5      | 951   | 0                | TYPE_LIMIT | TIF_GTC |
+      | trader2 | ETH/DEC19 | sell | 5      | 1200  | 0                | TYPE_LIMIT | TIF_GTC |
+    When the opening auction period ends for market "ETH/DEC19"
+    Then the market data for the market "ETH/DEC19" should be:
+      | mark price | trading mode            |
+      | 976        | TRADING_MODE_CONTINUOUS |
+
+  Scenario: A trade diverging from the index price by more than the maximum divergence starts a price monitoring auction
+    # no index price has been received yet, so nothing is checked
+    When the parties place the following orders:
+      | party   | market id | side | volume | price | resulting trades | type       | tif     |
+      | trader1 | ETH/DEC19 | buy  | 1      | 1100  | 0                | TYPE_LIMIT | TIF_GTC |
+      | trader2 | ETH/DEC19 | sell | 1      | 1100  | 1                | TYPE_LIMIT | TIF_GTC |
+      | trader1 | ETH/DEC19 | buy  | 1      | 1000  | 0                | TYPE_LIMIT | TIF_GTC |
+      | trader2 | ETH/DEC19 | sell | 1      | 1000  | 1                | TYPE_LIMIT | TIF_GTC |
+    Then the trading mode should be "TRADING_MODE_CONTINUOUS" for the market "ETH/DEC19"
+
+    When the oracles broadcast data with block time signed with "0xCAFECAFE1":
+      | name           | value | time offset |
+      | perp.ETH.value | 1000  | -1s         |
+    And the network moves ahead "1" blocks
+
+    # 1040 is 4% away from the index price of 1000
+    When the parties place the following orders:
+      | party   | market id | side | volume | price | resulting trades | type       | tif     |
+      | trader1 | ETH/DEC19 | buy  | 1      | 1040  | 0                | TYPE_LIMIT | TIF_GTC |
+      | trader2 | ETH/DEC19 | sell | 1      | 1040  | 1                | TYPE_LIMIT | TIF_GTC |
+    Then the trading mode should be "TRADING_MODE_CONTINUOUS" for the market "ETH/DEC19"
+
+    # 1060 is 6% away from the index price
+    When the parties place the following orders:
+      | party   | market id | side | volume | price | resulting trades | type       | tif     |
+      | trader1 | ETH/DEC19 | buy  | 1      | 1060  | 0                | TYPE_LIMIT | TIF_GTC |
+      | trader2 | ETH/DEC19 | sell | 1      | 1060  | 0                | TYPE_LIMIT | TIF_GTC |
+    Then the market data for the market "ETH/DEC19" should be:
+      | trading mode                    | auction trigger                  |
+      | TRADING_MODE_MONITORING_AUCTION | AUCTION_TRIGGER_INDEX_DIVERGENCE |
+
+    # the auction would be triggered again as soon as it ends unless the index price moves
+    When the network moves ahead "6" blocks
+    Then the market data for the market "ETH/DEC19" should be:
+      | trading mode                    | auction trigger                  |
+      | TRADING_MODE_MONITORING_AUCTION | AUCTION_TRIGGER_INDEX_DIVERGENCE |
+
+    When the oracles broadcast data with block time signed with "0xCAFECAFE1":
+      | name           | value | time offset |
+      | perp.ETH.value | 1050  | -1s         |
+    And the network moves ahead "6" blocks
+    Then the market data for the market "ETH/DEC19" should be:
+      | last traded price | trading mode            |
+      | 1060              | TRADING_MODE_CONTINUOUS |
+
+  Scenario: A non-persistent order diverging from the index price is stopped
+    When the oracles broadcast data with block time signed with "0xCAFECAFE1":
+      | name           | value | time offset |
+      | perp.ETH.value | 1000  | -1s         |
+    And the network moves ahead "1" blocks
+    And the parties place the following orders:
+      | party   | market id | side | volume | price | resulting trades | type       | tif     | reference | error                                                                       |
+      | trader1 | ETH/DEC19 | buy  | 1      | 1060  | 0                | TYPE_LIMIT | TIF_GTC |           |                                                                             |
+      | trader2 | ETH/DEC19 | sell | 1      | 1060  | 0                | TYPE_LIMIT | TIF_FOK | fok-sell  | OrderError: non-persistent order trades out of price bounds                 |
+    Then the trading mode should be "TRADING_MODE_CONTINUOUS" for the market "ETH/DEC19"
+    And the orders should have the following status:
+      | party   | reference | status         |
+      | trader2 | fok-sell  | STATUS_STOPPED |
@@ -0,0 +1,119 @@
+Feature: AMM liquidity pools on spot markets
+
+  Background:
+    Given time is updated to "2024-01-01T00:00:00Z"
+    And the average block duration is "1"
+    And the fees configuration named "fees-config-1":
+      | maker fee | infrastructure fee |
+      | 0         | 0                  |
+    And the log normal risk model named "lognormal-risk-model-1":
+      | risk aversion | tau  | mu | r   | sigma |
+      | 0.001         | 0.01 | 0  | 0.0 | 1.2   |
+    And the price monitoring named "price-monitoring-1":
+      | horizon | probability | auction extension |
+      | 36000   | 0.999       | 3                 |
+    And the liquidity sla params named "SLA-1":
+      | price range | commitment min time fraction | performance hysteresis epochs | sla competition factor |
+      | 1           | 0.6                          | 2                             | 0.2                    |
+    And the following assets are registered:
+      | id  | decimal places |
+      | ETH | 0              |
+      | BTC | 0              |
+    And the following network parameters are set:
+      | name                                    | value |
+      | network.markPriceUpdateMaximumFrequency | 0s    |
+      | market.amm.minCommitmentQuantum         | 1     |
+      | validators.epoch.length                 | 10s   |
+    And the spot markets:
+      | id      | name    | base asset | quote asset | risk model             | auction duration | fees          | price monitoring   | sla params |
+      | BTC/ETH | BTC/ETH | BTC        | ETH         | lognormal-risk-model-1 | 1                | fees-config-1 | price-monitoring-1 | SLA-1      |
+    And the parties deposit on asset's general account the following amount:
+      | party  | asset | amount |
+      | party1 | ETH   | 100000 |
+      | party1 | BTC   | 1000   |
+      | party2 | ETH   | 100000 |
+      | party2 | BTC   | 1000   |
+      | vamm1  | ETH   | 10000  |
+      | vamm1  | BTC   | 200    |
+    And the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     |
+      | party1 | BTC/ETH   | buy  | 1      | 90    | 0                | TYPE_LIMIT | TIF_GTC |
+      | party1 | BTC/ETH   | buy  | 1      | 100   | 0                | TYPE_LIMIT | TIF_GTC |
+      | party2 | BTC/ETH   | sell | 1      | 100   | 0                | TYPE_LIMIT | TIF_GTC |
+      | party2 | BTC/ETH   | sell | 1      | 110   | 0                | TYPE_LIMIT | TIF_GTC |
+    When the opening auction period ends for market "BTC/ETH"
+    Then the market data for the market "BTC/ETH" should be:
+      | mark price | trading mode            |
+      | 100        | TRADING_MODE_CONTINUOUS |
+
+  Scenario: A spot AMM is funded with both assets, trades from its sub-account and returns its balances on cancellation
+    When the parties submit the following AMM:
+      | party | market id | amount | base amount | slippage | base | lower bound | upper bound | proposed fee |
+      | vamm1 | BTC/ETH   | 10000  | 100         | 0.1      | 100  | 85          | 150         | 0.01         |
+    Then the AMM pool status should be:
+      | party | market id | amount | status        | base | lower bound | upper bound |
+      | vamm1 | BTC/ETH   | 10000  | STATUS_ACTIVE | 100  | 85          | 150         |
+    And set the following AMM sub account aliases:
+      | party | market id | alias     |
+      | vamm1 | BTC/ETH   | vamm1-acc |
+    And parties have the following AMM account balances:
+      | account alias | balance | asset |
+      | vamm1-acc     | 10000   | ETH   |
+      | vamm1-acc     | 100     | BTC   |
+
+    # the pool quotes inside the book's spread so it takes the aggressive buy at its fair price
+    When the parties place the following orders:
+      | party  | market id | side | volume | price | resulting trades | type       | tif     |
+      | party1 | BTC/ETH   | buy  | 1      | 105   | 1                | TYPE_LIMIT | TIF_GTC |
+    Then the following trades should be executed:
+      | buyer  | price | size | seller    | is amm |
+      | party1 | 100   | 1    | vamm1-acc | true   |
+    And parties have the following AMM account balances:
+      | account alias | balance | asset |
+      | vamm1-acc     | 10100   | ETH   |
+      | vamm1-acc     | 99      | BTC   |
+
+    When the parties cancel the following AMM:
+      | party | market id | method           |
+      | vamm1 | BTC/ETH   | METHOD_IMMEDIATE |
+    Then the AMM pool status should be:
+      | party | market id | amount | status           | base | lower bound | upper bound |
+      | vamm1 | BTC/ETH   | 10000  | STATUS_CANCELLED | 100  | 85          | 150         |
+    And the parties should have the following account balances:
+      | party | asset | market id | general |
+      | vamm1 | ETH   |           | 10100   |
+      | vamm1 | BTC   |           | 199     |
+
+  Scenario: Amending a spot AMM's commitments moves both assets between the owner and the pool
+    When the parties submit the following AMM:
+      | party | market id | amount | base amount | slippage | base | lower bound | upper bound | proposed fee |
+      | vamm1 | BTC/ETH   | 10000  | 100         | 0.1      | 100  | 85          | 150         | 0.01         |
+    And set the following AMM sub account aliases:
+      | party | market id | alias     |
+      | vamm1 | BTC/ETH   | vamm1-acc |
+    When the parties amend the following AMM:
+      | party | market id | amount | base amount | slippage |
+      | vamm1 | BTC/ETH   | 6000   | 150         | 0.1      |
+    Then the AMM pool status should be:
+      | party | market id | amount | status        | base | lower bound | upper bound |
+      | vamm1 | BTC/ETH   | 6000   | STATUS_ACTIVE | 100  | 85          | 150         |
+    And parties have the following AMM account balances:
+      | account alias | balance | asset |
+      | vamm1-acc     | 6000    | ETH   |
+      | vamm1-acc     | 150     | BTC   |
+    And the parties should have the following account balances:
+      | party | asset | market id | general |
+      | vamm1 | ETH   |           | 4000    |
+      | vamm1 | BTC   |           | 50      |
+
+  Scenario: A spot AMM is rejected if its owner cannot fund the base commitment
+    When the parties submit the following AMM:
+      | party | market id | amount | base amount | slippage | base | lower bound | upper bound | proposed fee | error                                    |
+      | vamm1 | BTC/ETH   | 10000  | 1000        | 0.1      | 100  | 85          | 150         | 0.01         | not enough base asset in general account |
+    Then the AMM pool status should be:
+      | party | market id | amount | status          | base | lower bound | upper bound | reason                               |
+      | vamm1 | BTC/ETH   | 10000  | STATUS_REJECTED | 100  | 85          | 150         | STATUS_REASON_CANNOT_FILL_COMMITMENT |
+    And the parties should have the following account balances:
+      | party | asset | market id | general |
+      | vamm1 | ETH   |           | 10000   |
+      | vamm1 | BTC   |           | 200     |
@@ -162,6 +162,9 @@ func InitializeScenario(s *godog.ScenarioContext) {
 	s.Step(`the price monitoring named "([^"]*)":$`, func(name string, table *godog.Table) error {
 		return steps.ThePriceMonitoring(marketConfig, name, table)
 	})
+	s.Step(`the price monitoring named "([^"]*)" has the index divergence:$`, func(name string, table *godog.Table) error {
+		return steps.ThePriceMonitoringIndexDivergence(marketConfig, name, table)
+	})
 	s.Step(`the liquidity sla params named "([^"]*)":$`, func(name string, table *godog.Table) error {
 		return steps.TheLiquiditySLAPArams(marketConfig, name, table)
 	})
@@ -174,6 +177,9 @@ func InitializeScenario(s *godog.ScenarioContext) {
 	s.Step(`^the parties submit update margin mode:$`, func(table *godog.Table) error {
 		return steps.ThePartiesUpdateMarginMode(execsetup.executionEngine, table)
 	})
+	s.Step(`^the parties submit the following complete sets:$`, func(table *godog.Table) error {
+		return steps.PartiesSubmitTheFollowingCompleteSets(execsetup.executionEngine, table)
+	})
 
 	s.Step(`^the markets:$`, func(table *godog.Table) error {
 		markets, err := steps.TheMarkets(marketConfig, execsetup.executionEngine, execsetup.collateralEngine, execsetup.netParams, execsetup.timeService.GetTimeNow(), table)
@@ -752,6 +758,16 @@ func InitializeScenario(s *godog.ScenarioContext) {
 		return steps.PartiesShouldHaveTheFollowingPositionStatusAgg(execsetup.broker, mkt, table)
 	})
 
+	s.Step(`^the following partial close-outs should happen for market "([^)]+)":$`, func(mkt string, table *godog.Table) error {
+		return steps.TheFollowingPartialCloseOutsShouldHappen(execsetup.broker, mkt, table)
+	})
+	s.Step(`^the following positions should be auto-deleveraged for market "([^)]+)":$`, func(mkt string, table *godog.Table) error {
+		return steps.TheFollowingPositionsShouldBeAutoDeleveraged(execsetup.broker, mkt, table)
+	})
+	s.Step(`^the auto-deleveraging ranking for market "([^)]+)" should be:$`, func(mkt string, table *godog.Table) error {
+		return steps.TheAutoDeleveragingRankingShouldBe(execsetup.broker, mkt, table)
+	})
+
 	s.Step(`^the volume discount program tiers named "([^"]*)":$`, func(vdp string, table *godog.Table) error {
 		return steps.VolumeDiscountProgramTiers(volumeDiscountTiers, vdp, table)
 	})
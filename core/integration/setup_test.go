@@ -202,7 +202,7 @@ func newExecutionTestSetup() *executionTestSetup {
 	execsetup.epochEngine.NotifyOnEpoch(execsetup.volumeDiscountProgram.OnEpoch, execsetup.volumeDiscountProgram.OnEpochRestore)
 	execsetup.volumeRebateProgram = volumerebate.New(execsetup.broker, execsetup.marketActivityTracker)
 
-	execsetup.banking = banking.New(execsetup.log, banking.NewDefaultConfig(), execsetup.collateralEngine, execsetup.witness, execsetup.timeService, execsetup.assetsEngine, execsetup.notary, execsetup.broker, execsetup.topology, execsetup.marketActivityTracker, stubs.NewBridgeViewStub(), stubs.NewBridgeViewStub(), eventHeartbeat, execsetup.profilesEngine)
+	execsetup.banking = banking.New(execsetup.log, banking.NewDefaultConfig(), execsetup.collateralEngine, execsetup.witness, execsetup.timeService, execsetup.assetsEngine, execsetup.notary, execsetup.broker, execsetup.topology, execsetup.marketActivityTracker, stubs.NewBridgeViewStub(), stubs.NewBridgeViewStub(), eventHeartbeat, execsetup.profilesEngine, execsetup.oracleEngine)
 
 	execsetup.executionEngine = newExEng(
 		execution.NewEngine(
@@ -324,6 +324,10 @@ func (e *executionTestSetup) registerNetParamsCallbacks() error {
 			Param:   netparams.MarketMarginScalingFactors,
 			Watcher: e.executionEngine.OnMarketMarginScalingFactorsUpdate,
 		},
+		netparams.WatchParam{
+			Param:   netparams.MarketMarginPortfolioGroups,
+			Watcher: e.executionEngine.OnMarketMarginPortfolioGroupsUpdate,
+		},
 		netparams.WatchParam{
 			Param:   netparams.MarketFeeFactorsBuyBackFee,
 			Watcher: e.executionEngine.OnMarketFeeFactorsBuyBackFeeUpdate,
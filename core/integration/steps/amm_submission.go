@@ -97,6 +97,7 @@ func parseSubmitAMMTable(table *godog.Table) []RowWrapper {
 		"upper bound",    // uint
 		"lower leverage", // dec
 		"upper leverage", // dec
+		"base amount",    // uint, spot markets only
 		"error",
 	})
 }
@@ -114,6 +115,7 @@ func parseAmendAMMTable(table *godog.Table) []RowWrapper {
 		"upper bound",    // uint
 		"lower leverage", // dec
 		"upper leverage", // dec
+		"base amount",    // uint, spot markets only
 		"error",
 	})
 }
@@ -153,6 +155,7 @@ func (a ammRow) toSubmission() *types.SubmitAMM {
 			LeverageAtLowerBound: a.lowerLeverage(),
 			LeverageAtUpperBound: a.upperLeverage(),
 		},
+		BaseCommitmentAmount: a.baseAmount(),
 	}
 }
 
@@ -168,6 +171,7 @@ func (a ammRow) toAmendment() *types.AmendAMM {
 	if a.r.HasColumn("amount") {
 		ret.CommitmentAmount = a.amount()
 	}
+	ret.BaseCommitmentAmount = a.baseAmount()
 	params := &types.ConcentratedLiquidityParameters{}
 	paramSet := false
 	if a.r.HasColumn("base") {
@@ -235,6 +239,13 @@ func (a ammRow) base() *num.Uint {
 	return a.r.MustUint("base")
 }
 
+func (a ammRow) baseAmount() *num.Uint {
+	if !a.r.HasColumn("base amount") {
+		return nil
+	}
+	return a.r.MustUint("base amount")
+}
+
 func (a ammRow) lowerBound() *num.Uint {
 	if !a.r.HasColumn("lower bound") {
 		return nil
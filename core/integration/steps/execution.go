@@ -56,7 +56,8 @@ type Execution interface {
 	ProcessBatch(ctx context.Context, party string) error
 	OnEpochEvent(ctx context.Context, epoch types.Epoch)
 	UpdateMarketState(ctx context.Context, changes *types.MarketStateUpdateConfiguration) error
-	UpdateMarginMode(ctx context.Context, party, marketID string, marginMode types.MarginMode, marginFactor num.Decimal) error
+	UpdateMarginMode(ctx context.Context, party, marketID string, marginMode types.MarginMode, marginFactor num.Decimal, portfolioMargin bool) error
+	SubmitCompleteSet(ctx context.Context, submission *types.CompleteSetSubmission, party string) error
 
 	// AMM stuff
 	SubmitAMM(ctx context.Context, submit *types.SubmitAMM) error
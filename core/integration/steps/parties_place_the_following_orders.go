@@ -290,6 +290,7 @@ func PartiesPlaceTheFollowingOrders(
 			Type:        row.OrderType(),
 			TimeInForce: row.TimeInForce(),
 			Reference:   row.Reference(),
+			MaxSlippage: row.MaxSlippage(),
 		}
 		only := row.Only()
 		switch only {
@@ -542,6 +543,13 @@ func buildStopOrder(
 		}
 	}
 
+	if row.row.HasColumn("fb trigger reference") && sub.FallsBelow != nil {
+		sub.FallsBelow.Trigger.Reference = row.FallsBelowTriggerReference()
+	}
+	if row.row.HasColumn("ra trigger reference") && sub.RisesAbove != nil {
+		sub.RisesAbove.Trigger.Reference = row.RisesAboveTriggerReference()
+	}
+
 	// Handle OCO references
 	if sub.RisesAbove != nil && sub.FallsBelow != nil {
 		sub.FallsBelow.OrderSubmission.Reference += "-1"
@@ -602,6 +610,8 @@ func parseSubmitHackedOrderTable(table *godog.Table) []RowWrapper {
 		"ra size override percentage",
 		"fb size override setting",
 		"fb size override percentage",
+		"ra trigger reference",
+		"fb trigger reference",
 		"is amm",
 	})
 }
@@ -635,6 +645,9 @@ func parseSubmitOrderTable(table *godog.Table) []RowWrapper {
 		"ra size override percentage",
 		"fb size override setting",
 		"fb size override percentage",
+		"ra trigger reference",
+		"fb trigger reference",
+		"max slippage",
 	})
 }
 
@@ -930,6 +943,13 @@ func (r submitOrderRow) PeggedReference() types.PeggedReference {
 	return r.row.MustPeggedReference("pegged reference")
 }
 
+func (r submitOrderRow) MaxSlippage() *uint64 {
+	if !r.row.HasColumn("max slippage") {
+		return nil
+	}
+	return ptr.From(r.row.MustU64("max slippage"))
+}
+
 func (r submitOrderRow) PeggedOffset() *num.Uint {
 	if !r.row.HasColumn("pegged offset") {
 		return nil
@@ -958,3 +978,11 @@ func (r submitOrderRow) FallsBelowSizeOverrideSetting() types.StopOrderSizeOverr
 func (r submitOrderRow) FallsBelowSizeOverridePercentage() string {
 	return r.row.MustStr("fb size override percentage")
 }
+
+func (r submitOrderRow) RisesAboveTriggerReference() types.StopOrderTriggerReference {
+	return r.row.MustStopOrderTriggerReference("ra trigger reference")
+}
+
+func (r submitOrderRow) FallsBelowTriggerReference() types.StopOrderTriggerReference {
+	return r.row.MustStopOrderTriggerReference("fb trigger reference")
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package steps
+
+import (
+	"context"
+	"fmt"
+
+	"code.vegaprotocol.io/vega/core/types"
+
+	"github.com/cucumber/godog"
+)
+
+func PartiesSubmitTheFollowingCompleteSets(
+	exec Execution,
+	table *godog.Table,
+) error {
+	for _, r := range parseSubmitCompleteSetTable(table) {
+		row := completeSetRow{row: r}
+
+		sub := &types.CompleteSetSubmission{
+			PredictionID: row.PredictionID(),
+			Size:         row.Size(),
+			Action:       row.Action(),
+		}
+		err := exec.SubmitCompleteSet(context.Background(), sub, row.Party())
+		if err := checkExpectedError(row, err, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type completeSetRow struct {
+	row RowWrapper
+}
+
+func parseSubmitCompleteSetTable(table *godog.Table) []RowWrapper {
+	return StrictParseTable(table, []string{
+		"party",
+		"prediction id",
+		"size",
+		"action",
+	}, []string{
+		"error",
+	})
+}
+
+func (r completeSetRow) Party() string {
+	return r.row.MustStr("party")
+}
+
+func (r completeSetRow) PredictionID() string {
+	return r.row.MustStr("prediction id")
+}
+
+func (r completeSetRow) Size() uint64 {
+	return r.row.MustU64("size")
+}
+
+func (r completeSetRow) Action() types.CompleteSetAction {
+	switch action := r.row.MustStr("action"); action {
+	case "mint":
+		return types.CompleteSetActionMint
+	case "redeem":
+		return types.CompleteSetActionRedeem
+	default:
+		panic(fmt.Errorf("unsupported complete set action %q", action))
+	}
+}
+
+func (r completeSetRow) Reference() string {
+	return r.PredictionID()
+}
+
+func (r completeSetRow) Error() string {
+	return r.row.Str("error")
+}
+
+func (r completeSetRow) ExpectError() bool {
+	return r.row.HasColumn("error") && len(r.row.Str("error")) > 0
+}
@@ -508,6 +508,20 @@ func OrderType(rawValue string) (types.OrderType, error) {
 	return types.OrderType(ty), nil
 }
 
+func (r RowWrapper) MustMatchingAlgorithm(name string) types.MatchingAlgorithm {
+	algorithm, err := MatchingAlgorithm(r.MustStr(name))
+	panicW(name, err)
+	return algorithm
+}
+
+func MatchingAlgorithm(rawValue string) (types.MatchingAlgorithm, error) {
+	ty, ok := proto.MatchingAlgorithm_value[rawValue]
+	if !ok {
+		return types.MatchingAlgorithm(ty), fmt.Errorf("invalid matching algorithm: %v", rawValue)
+	}
+	return types.MatchingAlgorithm(ty), nil
+}
+
 func (r RowWrapper) MustOrderStatus(name string) types.OrderStatus {
 	s, err := OrderStatus(r.MustStr(name))
 	panicW(name, err)
@@ -596,6 +610,20 @@ func ExpiryStrategy(rawValue string) (types.StopOrderExpiryStrategy, error) {
 	return types.StopOrderExpiryStrategy(es), nil
 }
 
+func (r RowWrapper) MustStopOrderTriggerReference(name string) types.StopOrderTriggerReference {
+	ref, err := StopOrderTriggerReference(r.MustStr(name))
+	panicW(name, err)
+	return ref
+}
+
+func StopOrderTriggerReference(rawValue string) (types.StopOrderTriggerReference, error) {
+	ref, ok := proto.StopOrder_TriggerReference_value[rawValue]
+	if !ok {
+		return types.StopOrderTriggerReference(ref), fmt.Errorf("invalid stop order trigger reference: %v", rawValue)
+	}
+	return types.StopOrderTriggerReference(ref), nil
+}
+
 func (r RowWrapper) MustSide(name string) types.Side {
 	side, err := Side(r.MustStr(name))
 	panicW(name, err)
@@ -625,6 +653,10 @@ func peggedReference(rawValue string) types.PeggedReference {
 		return types.PeggedReferenceBestAsk
 	case "BID":
 		return types.PeggedReferenceBestBid
+	case "MARK":
+		return types.PeggedReferenceMarkPrice
+	case "INDEX":
+		return types.PeggedReferenceIndexPrice
 	}
 	return types.PeggedReferenceUnspecified
 }
@@ -0,0 +1,62 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package steps
+
+import (
+	"fmt"
+
+	"code.vegaprotocol.io/vega/core/integration/stubs"
+
+	"github.com/cucumber/godog"
+)
+
+func TheFollowingPartialCloseOutsShouldHappen(broker *stubs.BrokerStub, market string, table *godog.Table) error {
+	closeOuts := broker.GetPartialCloseOuts()
+	for _, row := range parsePartialCloseOutsTable(table) {
+		var (
+			party     = row.MustStr("party")
+			closedOut = row.MustI64("closed out size")
+			remaining = row.MustI64("remaining position")
+		)
+
+		found := false
+		for _, e := range closeOuts {
+			if e.MarketID() != market {
+				continue
+			}
+			for _, co := range e.CloseOuts() {
+				if co.PartyId == party && co.ClosedOutSize == closedOut && co.RemainingPosition == remaining {
+					found = true
+					break
+				}
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("expected partial close-out missing for party %s: closed out size %d, remaining position %d", party, closedOut, remaining)
+		}
+	}
+
+	return nil
+}
+
+func parsePartialCloseOutsTable(table *godog.Table) []RowWrapper {
+	return StrictParseTable(table, []string{
+		"party",
+		"closed out size",
+		"remaining position",
+	}, []string{})
+}
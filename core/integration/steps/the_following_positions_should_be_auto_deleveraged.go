@@ -0,0 +1,104 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package steps
+
+import (
+	"fmt"
+
+	"code.vegaprotocol.io/vega/core/integration/stubs"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+
+	"github.com/cucumber/godog"
+)
+
+func TheFollowingPositionsShouldBeAutoDeleveraged(broker *stubs.BrokerStub, market string, table *godog.Table) error {
+	adl := broker.GetAutoDeleveraging()
+	for _, row := range parseAutoDeleveragedPositionsTable(table) {
+		var (
+			party     = row.MustStr("party")
+			reduced   = row.MustI64("reduced size")
+			remaining = row.MustI64("remaining position")
+			price     = row.MustStr("price")
+		)
+
+		found := false
+		for _, e := range adl {
+			if e.MarketID() != market {
+				continue
+			}
+			for _, p := range e.Positions() {
+				if p.PartyId == party && p.ReducedSize == reduced && p.RemainingPosition == remaining && p.Price == price {
+					found = true
+					break
+				}
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("expected auto-deleveraging missing for party %s: reduced size %d, remaining position %d, price %s", party, reduced, remaining, price)
+		}
+	}
+
+	return nil
+}
+
+func parseAutoDeleveragedPositionsTable(table *godog.Table) []RowWrapper {
+	return StrictParseTable(table, []string{
+		"party",
+		"reduced size",
+		"remaining position",
+		"price",
+	}, []string{})
+}
+
+// TheAutoDeleveragingRankingShouldBe checks the latest ranking sent out for the market contains the given ranks.
+func TheAutoDeleveragingRankingShouldBe(broker *stubs.BrokerStub, market string, table *godog.Table) error {
+	var latest []*eventspb.AutoDeleveragingRank
+	for _, e := range broker.GetAutoDeleveragingRankings() {
+		if e.MarketID() == market {
+			latest = e.Rankings()
+		}
+	}
+	for _, row := range parseAutoDeleveragingRankingTable(table) {
+		var (
+			party  = row.MustStr("party")
+			volume = row.MustI64("open volume")
+			rank   = row.MustU64("rank")
+		)
+
+		found := false
+		for _, r := range latest {
+			if r.PartyId == party && r.OpenVolume == volume && r.Rank == rank {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("expected auto-deleveraging rank missing for party %s: open volume %d, rank %d", party, volume, rank)
+		}
+	}
+
+	return nil
+}
+
+func parseAutoDeleveragingRankingTable(table *godog.Table) []RowWrapper {
+	return StrictParseTable(table, []string{
+		"party",
+		"open volume",
+		"rank",
+	}, []string{})
+}
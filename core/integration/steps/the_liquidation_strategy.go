@@ -44,7 +44,11 @@ func parseLiquidationStrategyTable(table *godog.Table) []RowWrapper {
 		"full disposal size",
 		"max fraction consumed",
 		"disposal slippage range",
-	}, nil)
+	}, []string{
+		"partial close-out step",
+		"partial close-out buffer",
+		"auto deleveraging",
+	})
 }
 
 type lsRow struct {
@@ -53,11 +57,14 @@ type lsRow struct {
 
 func (l lsRow) liquidationStrategy() *types.LiquidationStrategy {
 	return &types.LiquidationStrategy{
-		DisposalTimeStep:    l.disposalStep(),
-		DisposalFraction:    l.disposalFraction(),
-		FullDisposalSize:    l.fullDisposalSize(),
-		MaxFractionConsumed: l.maxFraction(),
-		DisposalSlippage:    l.disposalSlippage(),
+		DisposalTimeStep:      l.disposalStep(),
+		DisposalFraction:      l.disposalFraction(),
+		FullDisposalSize:      l.fullDisposalSize(),
+		MaxFractionConsumed:   l.maxFraction(),
+		DisposalSlippage:      l.disposalSlippage(),
+		PartialCloseOutStep:   l.partialCloseOutStep(),
+		PartialCloseOutBuffer: l.partialCloseOutBuffer(),
+		AutoDeleveraging:      l.autoDeleveraging(),
 	}
 }
 
@@ -85,3 +92,24 @@ func (l lsRow) maxFraction() num.Decimal {
 func (l lsRow) disposalSlippage() num.Decimal {
 	return l.r.MustDecimal("disposal slippage range")
 }
+
+func (l lsRow) partialCloseOutStep() num.Decimal {
+	if !l.r.HasColumn("partial close-out step") {
+		return num.DecimalZero()
+	}
+	return l.r.MustDecimal("partial close-out step")
+}
+
+func (l lsRow) partialCloseOutBuffer() num.Decimal {
+	if !l.r.HasColumn("partial close-out buffer") {
+		return num.DecimalZero()
+	}
+	return l.r.MustDecimal("partial close-out buffer")
+}
+
+func (l lsRow) autoDeleveraging() bool {
+	if !l.r.HasColumn("auto deleveraging") {
+		return false
+	}
+	return l.r.MustBool("auto deleveraging")
+}
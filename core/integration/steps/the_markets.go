@@ -83,6 +83,7 @@ func TheMarkets(
 ) ([]types.Market, error) {
 	rows := parseMarketsTable(table)
 	markets := make([]types.Market, 0, len(rows))
+	marketRows := make([]RowWrapper, 0, len(rows))
 
 	for _, row := range rows {
 		mRow := marketRow{row: row}
@@ -99,7 +100,10 @@ func TheMarkets(
 		} else {
 			mkt = newMarket(config, mRow)
 		}
-		markets = append(markets, mkt)
+		for _, m := range predictionOutcomeMarkets(mkt, mRow) {
+			markets = append(markets, m)
+			marketRows = append(marketRows, row)
+		}
 	}
 
 	if err := enableMarketAssets(markets, collateralEngine); err != nil {
@@ -110,7 +114,7 @@ func TheMarkets(
 		return nil, err
 	}
 
-	for i, row := range rows {
+	for i, row := range marketRows {
 		if err := executionEngine.SubmitMarket(context.Background(), &markets[i], "proposerID", now); err != nil {
 			return nil, fmt.Errorf("couldn't submit market(%s): %v", markets[i].ID, err)
 		}
@@ -416,6 +420,18 @@ func marketUpdate(config *market.Config, existing *types.Market, row marketUpdat
 		existing.MarkPriceConfiguration = markPriceConfig
 	}
 	update.Changes.TickSize = row.tickSize()
+	if fba, ok := row.frequentBatchAuction(); ok {
+		existing.FrequentBatchAuction = fba
+	}
+	update.Changes.FrequentBatchAuction = existing.FrequentBatchAuction
+	if limits, ok := row.positionLimits(); ok {
+		existing.PositionLimits = limits
+	}
+	update.Changes.PositionLimits = existing.PositionLimits
+	if algorithm, ok := row.matchingAlgorithm(); ok {
+		existing.MatchingAlgorithm = algorithm
+	}
+	update.Changes.MatchingAlgorithm = existing.MatchingAlgorithm
 	return update, nil
 }
 
@@ -516,6 +532,9 @@ func newPerpMarket(config *market.Config, row marketRow) types.Market {
 		LiquiditySLAParams:            types.LiquiditySLAParamsFromProto(slaParams),
 		MarkPriceConfiguration:        markPriceConfig,
 		TickSize:                      row.tickSize(),
+		FrequentBatchAuction:          row.frequentBatchAuction(),
+		PositionLimits:                row.positionLimits(),
+		MatchingAlgorithm:             row.matchingAlgorithm(),
 	}
 
 	if row.isSuccessor() {
@@ -645,6 +664,9 @@ func newMarket(config *market.Config, row marketRow) types.Market {
 		LiquiditySLAParams:            types.LiquiditySLAParamsFromProto(slaParams),
 		MarkPriceConfiguration:        markPriceConfig,
 		TickSize:                      row.tickSize(),
+		FrequentBatchAuction:          row.frequentBatchAuction(),
+		PositionLimits:                row.positionLimits(),
+		MatchingAlgorithm:             row.matchingAlgorithm(),
 	}
 
 	if row.isSuccessor() {
@@ -667,6 +689,32 @@ func newMarket(config *market.Config, row marketRow) types.Market {
 	return m
 }
 
+// predictionOutcomeMarkets expands the market into one outcome market per prediction outcome, identified as <id>/<outcome>.
+// The prediction is identified by the ID of the row, and its outcome markets share the insurance pool of the prediction.
+func predictionOutcomeMarkets(mkt types.Market, row marketRow) []types.Market {
+	outcomes := row.predictionOutcomes()
+	if len(outcomes) == 0 {
+		return []types.Market{mkt}
+	}
+	markets := make([]types.Market, 0, len(outcomes))
+	for i, outcome := range outcomes {
+		m := *mkt.DeepClone()
+		m.ID = fmt.Sprintf("%s/%s", mkt.ID, outcome)
+		product := *mkt.GetFuture().Future
+		product.PredictionOutcome = &types.PredictionOutcome{
+			PredictionID: mkt.ID,
+			Index:        uint32(i),
+			Outcomes:     append([]string{}, outcomes...),
+		}
+		m.TradableInstrument.Instrument.Product = &types.InstrumentFuture{Future: &product}
+		m.TradableInstrument.Instrument.ID = fmt.Sprintf("Crypto/%s/Futures", m.ID)
+		m.TradableInstrument.Instrument.Code = fmt.Sprintf("CRYPTO/%v", m.ID)
+		m.TradableInstrument.Instrument.Name = fmt.Sprintf("%s future", m.ID)
+		markets = append(markets, m)
+	}
+	return markets
+}
+
 func openingAuction(row marketRow) *types.AuctionDuration {
 	auction := &types.AuctionDuration{
 		Duration: row.auctionDuration(),
@@ -717,6 +765,12 @@ func parseMarketsTable(table *godog.Table) []RowWrapper {
 		"max price cap",
 		"binary",
 		"fully collateralised",
+		"batch duration",
+		"max position size",
+		"max position notional",
+		"max open interest",
+		"matching algorithm",
+		"prediction outcomes",
 	})
 }
 
@@ -745,6 +799,11 @@ func parseMarketsUpdateTable(table *godog.Table) []RowWrapper {
 		"oracle4",
 		"oracle5",
 		"tick size",
+		"batch duration",
+		"max position size",
+		"max position notional",
+		"max open interest",
+		"matching algorithm",
 	})
 }
 
@@ -767,6 +826,30 @@ func (r marketUpdateRow) tickSize() *num.Uint {
 	return num.UintOne()
 }
 
+// frequentBatchAuction returns the frequent batch auction parameters, a batch duration of 0 switches the market
+// back to continuous trading.
+func (r marketUpdateRow) frequentBatchAuction() (*types.FrequentBatchAuctionParameters, bool) {
+	if !r.row.HasColumn("batch duration") {
+		return nil, false
+	}
+	return frequentBatchAuctionParameters(r.row.MustI64("batch duration")), true
+}
+
+// positionLimits returns the position limits of the market if any of the limits are set, limits of 0 are not enforced.
+func (r marketUpdateRow) positionLimits() (*types.PositionLimits, bool) {
+	if !r.row.HasColumn("max position size") && !r.row.HasColumn("max position notional") && !r.row.HasColumn("max open interest") {
+		return nil, false
+	}
+	return positionLimits(r.row), true
+}
+
+func (r marketUpdateRow) matchingAlgorithm() (types.MatchingAlgorithm, bool) {
+	if !r.row.HasColumn("matching algorithm") {
+		return types.MatchingAlgorithmUnspecified, false
+	}
+	return r.row.MustMatchingAlgorithm("matching algorithm"), true
+}
+
 func (r marketUpdateRow) oracleConfig() (string, bool) {
 	if r.row.HasColumn("data source config") {
 		oc := r.row.MustStr("data source config")
@@ -917,6 +1000,57 @@ func (r marketRow) tickSize() *num.Uint {
 	return num.UintOne()
 }
 
+func (r marketRow) frequentBatchAuction() *types.FrequentBatchAuctionParameters {
+	if !r.row.HasColumn("batch duration") {
+		return nil
+	}
+	return frequentBatchAuctionParameters(r.row.MustI64("batch duration"))
+}
+
+func frequentBatchAuctionParameters(batchDuration int64) *types.FrequentBatchAuctionParameters {
+	if batchDuration <= 0 {
+		return nil
+	}
+	return &types.FrequentBatchAuctionParameters{
+		BatchDuration: batchDuration,
+	}
+}
+
+func (r marketRow) positionLimits() *types.PositionLimits {
+	if !r.row.HasColumn("max position size") && !r.row.HasColumn("max position notional") && !r.row.HasColumn("max open interest") {
+		return nil
+	}
+	return positionLimits(r.row)
+}
+
+func positionLimits(row RowWrapper) *types.PositionLimits {
+	limits := &types.PositionLimits{}
+	if row.HasColumn("max position size") {
+		limits.MaxPositionSize = row.MustU64("max position size")
+	}
+	if row.HasColumn("max position notional") {
+		limits.MaxPositionNotional = row.MustUint("max position notional")
+	}
+	if row.HasColumn("max open interest") {
+		limits.MaxOpenInterest = row.MustU64("max open interest")
+	}
+	return limits
+}
+
+func (r marketRow) matchingAlgorithm() types.MatchingAlgorithm {
+	if !r.row.HasColumn("matching algorithm") {
+		return types.MatchingAlgorithmUnspecified
+	}
+	return r.row.MustMatchingAlgorithm("matching algorithm")
+}
+
+func (r marketRow) predictionOutcomes() []string {
+	if !r.row.HasColumn("prediction outcomes") {
+		return nil
+	}
+	return r.row.MustStrSlice("prediction outcomes", ",")
+}
+
 func (r marketRow) id() string {
 	return r.row.MustStr("id")
 }
@@ -16,6 +16,7 @@
 package steps
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
@@ -123,6 +124,13 @@ func ThePerpsOracleSpec(config *market.Config, keys string, table *godog.Table)
 			DataSourceSpecBinding:        binding,
 			InternalCompositePriceConfig: internalCompositePriceConfig,
 		}
+		if row.row.HasColumn("index constituents") {
+			basket, err := row.IndexBasket(config)
+			if err != nil {
+				return err
+			}
+			perp.IndexBasketConfig = basket
+		}
 		if err := config.OracleConfigs.AddPerp(name, perp); err != nil {
 			return err
 		}
@@ -154,6 +162,10 @@ func parseOraclePerpsTable(table *godog.Table) []RowWrapper {
 		"cash amount",
 		"source weights",
 		"source staleness tolerance",
+		"index constituents",
+		"index weights",
+		"index staleness tolerance",
+		"index rebalance interval",
 	})
 }
 
@@ -293,3 +305,39 @@ func (r perpOracleRow) PriceSourceStalnessTolerance() []string {
 	}
 	return durations
 }
+
+// IndexBasket builds the index basket from the composite price oracles named in the constituents column.
+func (p perpOracleRow) IndexBasket(config *market.Config) (*protoTypes.IndexBasketConfiguration, error) {
+	names := p.row.MustStrSlice("index constituents", ",")
+	weights := p.row.MustStrSlice("index weights", ",")
+	if len(weights) != len(names) {
+		return nil, fmt.Errorf("expected %d index weights, got %d", len(names), len(weights))
+	}
+	tolerances := make([]string, len(names))
+	if p.row.HasColumn("index staleness tolerance") {
+		tolerances = p.row.MustStrSlice("index staleness tolerance", ",")
+		if len(tolerances) != len(names) {
+			return nil, fmt.Errorf("expected %d index staleness tolerances, got %d", len(names), len(tolerances))
+		}
+	}
+
+	basket := &protoTypes.IndexBasketConfiguration{
+		Constituents: make([]*protoTypes.IndexBasketConstituent, 0, len(names)),
+	}
+	if p.row.HasColumn("index rebalance interval") {
+		basket.RebalanceInterval = int64(p.row.MustDurationStr("index rebalance interval") / time.Second)
+	}
+	for i, name := range names {
+		spec, binding, err := config.OracleConfigs.GetOracleDefinitionForCompositePrice(name)
+		if err != nil {
+			return nil, err
+		}
+		basket.Constituents = append(basket.Constituents, &protoTypes.IndexBasketConstituent{
+			DataSourceSpec:        spec.ExternalDataSourceSpec.Spec.Data,
+			DataSourceSpecBinding: binding,
+			Weight:                num.MustDecimalFromString(weights[i]).String(),
+			StalenessTolerance:    tolerances[i],
+		})
+	}
+	return basket, nil
+}
@@ -36,6 +36,7 @@ func TheOrdersShouldHaveTheFollowingStates(broker *stubs.BrokerStub, table *godo
 		remaining := row.MustU64("remaining")
 		status := row.MustOrderStatus("status")
 		ref, hasRef := row.StrB("reference")
+		slippage, hasSlippage := row.U64B("achieved slippage")
 
 		checkCancel := status == types.Order_STATUS_CANCELLED
 
@@ -71,6 +72,9 @@ func TheOrdersShouldHaveTheFollowingStates(broker *stubs.BrokerStub, table *godo
 			if o.PartyId != party || (o.Status != status && !cancelled) || o.MarketId != marketID || o.Side != side || o.Size != size || stringToU64(o.Price) != price || o.Remaining != remaining {
 				continue
 			}
+			if hasSlippage && (o.AchievedSlippage == nil || *o.AchievedSlippage != slippage) {
+				continue
+			}
 			match = true
 			break
 		}
@@ -92,5 +96,6 @@ func parseOrdersStatesTable(table *godog.Table) []RowWrapper {
 		"status",
 	}, []string{
 		"reference",
+		"achieved slippage",
 	})
 }
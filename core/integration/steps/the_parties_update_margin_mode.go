@@ -44,11 +44,12 @@ func ThePartiesUpdateMarginMode(
 		if r.HasColumn("margin_factor") && marginMode == types.MarginModeIsolatedMargin {
 			factor = num.MustDecimalFromString(r.MustStr("margin_factor"))
 		}
+		portfolioMargin := r.HasColumn("portfolio_margin") && r.MustBool("portfolio_margin")
 		expErr := ""
 		if r.HasColumn("error") && len(r.Str("error")) > 0 {
 			expErr = r.Str("error")
 		}
-		err := execution.UpdateMarginMode(context.Background(), party, market, marginMode, factor)
+		err := execution.UpdateMarginMode(context.Background(), party, market, marginMode, factor, portfolioMargin)
 		if err != nil && len(expErr) == 0 {
 			return fmt.Errorf("unexpected error when updating margin mode: %v", err)
 		}
@@ -67,6 +68,7 @@ func parseUpdateMarginModeTable(table *godog.Table) []RowWrapper {
 		"margin_mode",
 	}, []string{
 		"margin_factor",
+		"portfolio_margin",
 		"error",
 	})
 }
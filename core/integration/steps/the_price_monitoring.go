@@ -70,3 +70,37 @@ func (r priceMonitoringRow) probability() float64 {
 func (r priceMonitoringRow) auctionExtension() int64 {
 	return r.row.MustI64("auction extension")
 }
+
+func ThePriceMonitoringIndexDivergence(config *market.Config, name string, table *godog.Table) error {
+	// price monitoring without any triggers can be configured by setting the index divergence alone
+	pm, err := config.PriceMonitoring.Get(name)
+	if err != nil {
+		pm = &types.PriceMonitoringSettings{}
+	}
+	if pm.Parameters == nil {
+		pm.Parameters = &types.PriceMonitoringParameters{}
+	}
+
+	row := priceMonitoringIndexDivergenceRow{row: StrictParseFirstRow(table, []string{
+		"max divergence",
+		"auction extension",
+	}, []string{})}
+	pm.Parameters.IndexDivergence = &types.PriceMonitoringIndexDivergence{
+		MaxDivergence:    row.maxDivergence(),
+		AuctionExtension: row.auctionExtension(),
+	}
+
+	return config.PriceMonitoring.Add(name, pm)
+}
+
+type priceMonitoringIndexDivergenceRow struct {
+	row RowWrapper
+}
+
+func (r priceMonitoringIndexDivergenceRow) maxDivergence() string {
+	return r.row.MustDecimal("max divergence").String()
+}
+
+func (r priceMonitoringIndexDivergenceRow) auctionExtension() int64 {
+	return r.row.MustI64("auction extension")
+}
@@ -75,6 +75,12 @@ func checkProductData(pd types.ProductData, row ProductDataWrapper) error {
 		return fmt.Errorf("expected '%s' for funding rate, instead got '%s'", expectedFundingRate, actualFundingRate)
 	}
 
+	expectedUnderlyingIndexPrice, b := row.UnderlyingIndexPrice()
+	actualUnderlyingIndexPrice := perpData.UnderlyingIndexPrice
+	if b && expectedUnderlyingIndexPrice != actualUnderlyingIndexPrice {
+		return fmt.Errorf("expected '%s' for underlying index price, instead got '%s'", expectedUnderlyingIndexPrice, actualUnderlyingIndexPrice)
+	}
+
 	expectedInternalCompositePriceType, b := row.PriceType()
 	actualInternalCompositePriceType := perpData.InternalCompositePriceType
 	if b && expectedInternalCompositePriceType != actualInternalCompositePriceType {
@@ -93,6 +99,7 @@ func parseProductDataTable(table *godog.Table) []RowWrapper {
 		"funding rate",
 		"internal composite price",
 		"price type",
+		"underlying index price",
 	})
 }
 
@@ -120,6 +127,10 @@ func (f ProductDataWrapper) InternalCompositePrice() (string, bool) {
 	return f.row.StrB("internal composite price")
 }
 
+func (f ProductDataWrapper) UnderlyingIndexPrice() (string, bool) {
+	return f.row.StrB("underlying index price")
+}
+
 func (f ProductDataWrapper) PriceType() (vega.CompositePriceType, bool) {
 	if !f.row.HasColumn("price type") {
 		return types.CompositePriceTypeByLastTrade, false
@@ -190,6 +190,8 @@ func newSpotMarket(config *market.Config, row spotMarketRow) types.Market {
 		LiquidityMonitoringParameters: liqMon,
 		LiquiditySLAParams:            types.LiquiditySLAParamsFromProto(slaParams),
 		TickSize:                      row.tickSize(),
+		FrequentBatchAuction:          row.frequentBatchAuction(),
+		MatchingAlgorithm:             row.matchingAlgorithm(),
 	}
 
 	tip := m.TradableInstrument.IntoProto()
@@ -272,6 +274,14 @@ func spotMarketUpdate(config *market.Config, existing *types.Market, row spotMar
 		existing.TradableInstrument = current
 	}
 	update.Changes.TickSize = row.tickSize()
+	if fba, ok := row.frequentBatchAuction(); ok {
+		existing.FrequentBatchAuction = fba
+	}
+	update.Changes.FrequentBatchAuction = existing.FrequentBatchAuction
+	if algorithm, ok := row.matchingAlgorithm(); ok {
+		existing.MatchingAlgorithm = algorithm
+	}
+	update.Changes.MatchingAlgorithm = existing.MatchingAlgorithm
 	return update
 }
 
@@ -302,6 +312,8 @@ func parseSpotMarketsTable(table *godog.Table) []RowWrapper {
 		"position decimal places",
 		"tick size",
 		"liquidity monitoring",
+		"batch duration",
+		"matching algorithm",
 	})
 }
 
@@ -350,6 +362,20 @@ func (r spotMarketRow) tickSize() *num.Uint {
 	return num.UintOne()
 }
 
+func (r spotMarketRow) frequentBatchAuction() *types.FrequentBatchAuctionParameters {
+	if !r.row.HasColumn("batch duration") {
+		return nil
+	}
+	return frequentBatchAuctionParameters(r.row.MustI64("batch duration"))
+}
+
+func (r spotMarketRow) matchingAlgorithm() types.MatchingAlgorithm {
+	if !r.row.HasColumn("matching algorithm") {
+		return types.MatchingAlgorithmUnspecified
+	}
+	return r.row.MustMatchingAlgorithm("matching algorithm")
+}
+
 func (r spotMarketRow) fees() string {
 	return r.row.MustStr("fees")
 }
@@ -381,6 +407,20 @@ func (r spotMarketUpdateRow) tickSize() *num.Uint {
 	return num.UintOne()
 }
 
+func (r spotMarketUpdateRow) frequentBatchAuction() (*types.FrequentBatchAuctionParameters, bool) {
+	if !r.row.HasColumn("batch duration") {
+		return nil, false
+	}
+	return frequentBatchAuctionParameters(r.row.MustI64("batch duration")), true
+}
+
+func (r spotMarketUpdateRow) matchingAlgorithm() (types.MatchingAlgorithm, bool) {
+	if !r.row.HasColumn("matching algorithm") {
+		return types.MatchingAlgorithmUnspecified, false
+	}
+	return r.row.MustMatchingAlgorithm("matching algorithm"), true
+}
+
 func (r spotMarketUpdateRow) priceMonitoring() (string, bool) {
 	if r.row.HasColumn("price monitoring") {
 		pm := r.row.MustStr("price monitoring")
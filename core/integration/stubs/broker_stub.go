@@ -211,6 +211,48 @@ func (b *BrokerStub) GetDistressedOrders() []events.DistressedOrders {
 	return ret
 }
 
+func (b *BrokerStub) GetPartialCloseOuts() []events.PartialCloseOuts {
+	batch := b.GetImmBatch(events.PartialCloseOutsEvent)
+	ret := make([]events.PartialCloseOuts, 0, len(batch))
+	for _, e := range batch {
+		switch et := e.(type) {
+		case *events.PartialCloseOuts:
+			ret = append(ret, *et)
+		case events.PartialCloseOuts:
+			ret = append(ret, et)
+		}
+	}
+	return ret
+}
+
+func (b *BrokerStub) GetAutoDeleveraging() []events.AutoDeleveraging {
+	batch := b.GetImmBatch(events.AutoDeleveragingEvent)
+	ret := make([]events.AutoDeleveraging, 0, len(batch))
+	for _, e := range batch {
+		switch et := e.(type) {
+		case *events.AutoDeleveraging:
+			ret = append(ret, *et)
+		case events.AutoDeleveraging:
+			ret = append(ret, et)
+		}
+	}
+	return ret
+}
+
+func (b *BrokerStub) GetAutoDeleveragingRankings() []events.AutoDeleveragingRankings {
+	batch := b.GetImmBatch(events.AutoDeleveragingRankingsEvent)
+	ret := make([]events.AutoDeleveragingRankings, 0, len(batch))
+	for _, e := range batch {
+		switch et := e.(type) {
+		case *events.AutoDeleveragingRankings:
+			ret = append(ret, *et)
+		case events.AutoDeleveragingRankings:
+			ret = append(ret, et)
+		}
+	}
+	return ret
+}
+
 func (b *BrokerStub) GetSettleDistressed() []events.SettleDistressed {
 	batch := b.GetImmBatch(events.SettleDistressedEvent)
 	ret := make([]events.SettleDistressed, 0, len(batch))
@@ -82,6 +82,7 @@ type StateVarEngine interface {
 type AuctionState interface {
 	InAuction() bool
 	IsOpeningAuction() bool
+	IsFBA() bool
 }
 
 type slaPerformance struct {
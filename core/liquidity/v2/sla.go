@@ -156,7 +156,9 @@ func (e *Engine) doesLPMeetsCommitment(
 	}
 
 	var minPrice, maxPrice num.Decimal
-	if e.auctionState.InAuction() {
+	// the book of a market trading in frequent batch auctions is always in auction,
+	// the batches are normal trading and the range is based on the mid price.
+	if e.auctionState.InAuction() && !e.auctionState.IsFBA() {
 		minPriceFactor := num.Min(e.orderBook.GetLastTradedPrice(), e.orderBook.GetIndicativePrice()).ToDecimal()
 		maxPriceFactor := num.Max(e.orderBook.GetLastTradedPrice(), e.orderBook.GetIndicativePrice()).ToDecimal()
 
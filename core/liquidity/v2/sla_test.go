@@ -256,6 +256,7 @@ func TestSLAPerformanceSingleEpochFeePenalty(t *testing.T) {
 				te.broker.EXPECT().Send(gomock.Any()).AnyTimes()
 				te.auctionState.EXPECT().IsOpeningAuction().Return(false).AnyTimes()
 				te.auctionState.EXPECT().InAuction().Return(inAuction).AnyTimes()
+				te.auctionState.EXPECT().IsFBA().Return(false).AnyTimes()
 
 				lps := &types.LiquidityProvisionSubmission{
 					MarketID:         te.marketID,
@@ -304,6 +305,65 @@ func TestSLAPerformanceSingleEpochFeePenalty(t *testing.T) {
 	}
 }
 
+func TestSLAPerformanceFrequentBatchAuction(t *testing.T) {
+	// the last traded and indicative prices are far from the orders on the book,
+	// a market in a monitoring auction measures the range around those,
+	// a market trading in frequent batch auctions around the mid price.
+	for _, fba := range []bool{false, true} {
+		t.Run(fmt.Sprintf("frequent batch auction %v", fba), func(t *testing.T) {
+			te := newTestEngine(t)
+			te.engine.UpdateMarketConfig(te.riskModel, te.priceMonitor)
+			te.engine.UpdateSLAParameters(te.defaultSLAParams.DeepClone())
+
+			idGen := &stubIDGen{}
+			ctx := context.Background()
+			party := "lp-party-1"
+
+			te.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+			te.auctionState.EXPECT().IsOpeningAuction().Return(false).AnyTimes()
+			te.auctionState.EXPECT().InAuction().Return(true).AnyTimes()
+			te.auctionState.EXPECT().IsFBA().Return(fba).AnyTimes()
+
+			_, err := te.engine.SubmitLiquidityProvision(ctx, &types.LiquidityProvisionSubmission{
+				MarketID:         te.marketID,
+				CommitmentAmount: num.NewUint(100),
+				Fee:              num.NewDecimalFromFloat(0.5),
+				Reference:        fmt.Sprintf("provision-by-%s", party),
+			}, party, idGen)
+			require.NoError(t, err)
+
+			te.orderbook.EXPECT().GetLastTradedPrice().Return(num.NewUint(100)).AnyTimes()
+			te.orderbook.EXPECT().GetIndicativePrice().Return(num.NewUint(100)).AnyTimes()
+
+			orders := generateOrders(*idGen, te.marketID, []uint64{15, 15, 17, 18, 12, 12, 12}, []uint64{15, 15, 17, 18, 12, 12, 12})
+			te.orderbook.EXPECT().GetOrdersPerParty(party).Return(orders).AnyTimes()
+
+			epochLength := 3 * time.Second
+			epochStart := time.Now().Add(-epochLength)
+			epochEnd := epochStart.Add(epochLength)
+
+			one := num.UintOne()
+			positionFactor := num.DecimalOne()
+			midPrice := num.NewUint(15)
+
+			te.engine.ResetSLAEpoch(epochStart, one, midPrice, positionFactor)
+			te.engine.ApplyPendingProvisions(ctx, time.Now())
+
+			for i := 0; i < 3; i++ {
+				te.tsvc.SetTime(epochStart.Add(time.Duration(i) * time.Second))
+				te.engine.EndBlock(one, midPrice, positionFactor)
+			}
+
+			sla := te.engine.CalculateSLAPenalties(epochEnd).PenaltiesPerParty[party]
+			if fba {
+				require.True(t, sla.Fee.IsZero(), "actual penalty: %s", sla.Fee)
+			} else {
+				require.True(t, sla.Fee.Equal(num.DecimalOne()), "actual penalty: %s", sla.Fee)
+			}
+		})
+	}
+}
+
 func TestSLAPerformanceMultiEpochFeePenalty(t *testing.T) {
 	testCases := []struct {
 		desc            string
@@ -63,6 +63,15 @@ func (i *TradableInstrument) UpdateInstrument(ctx context.Context, log *logging.
 	if err != nil {
 		return fmt.Errorf("unable to instantiate risk model: %w", err)
 	}
+	// keep the price history collected so far when the parameters of the model change,
+	// the new model truncates it if its window is smaller
+	if prev, ok := i.RiskModel.(risk.PriceHistoryModel); ok {
+		if next, ok := riskModel.(risk.PriceHistoryModel); ok {
+			if err := next.Restore(prev.Serialise()); err != nil {
+				return fmt.Errorf("unable to carry over the risk model price history: %w", err)
+			}
+		}
+	}
 
 	i.RiskModel = riskModel
 	i.MarginCalculator = ti.MarginCalculator
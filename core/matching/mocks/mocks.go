@@ -79,6 +79,20 @@ func (mr *MockOffbookSourceMockRecorder) OrderbookShape(arg0, arg1, arg2 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderbookShape", reflect.TypeOf((*MockOffbookSource)(nil).OrderbookShape), arg0, arg1, arg2)
 }
 
+// SelfTradeOrder mocks base method.
+func (m *MockOffbookSource) SelfTradeOrder(arg0 *types.Order, arg1, arg2 *num.Uint) *types.Order {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelfTradeOrder", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.Order)
+	return ret0
+}
+
+// SelfTradeOrder indicates an expected call of SelfTradeOrder.
+func (mr *MockOffbookSourceMockRecorder) SelfTradeOrder(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelfTradeOrder", reflect.TypeOf((*MockOffbookSource)(nil).SelfTradeOrder), arg0, arg1, arg2)
+}
+
 // SubmitOrder mocks base method.
 func (m *MockOffbookSource) SubmitOrder(arg0 *types.Order, arg1, arg2 *num.Uint) []*types.Order {
 	m.ctrl.T.Helper()
@@ -92,3 +106,18 @@ func (mr *MockOffbookSourceMockRecorder) SubmitOrder(arg0, arg1, arg2 interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitOrder", reflect.TypeOf((*MockOffbookSource)(nil).SubmitOrder), arg0, arg1, arg2)
 }
+
+// VolumeAtPrice mocks base method.
+func (m *MockOffbookSource) VolumeAtPrice(arg0 *types.Order, arg1 *num.Uint) (uint64, *num.Uint) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeAtPrice", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(*num.Uint)
+	return ret0, ret1
+}
+
+// VolumeAtPrice indicates an expected call of VolumeAtPrice.
+func (mr *MockOffbookSourceMockRecorder) VolumeAtPrice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeAtPrice", reflect.TypeOf((*MockOffbookSource)(nil).VolumeAtPrice), arg0, arg1)
+}
@@ -47,6 +47,8 @@ var (
 type OffbookSource interface {
 	BestPricesAndVolumes() (*num.Uint, uint64, *num.Uint, uint64)
 	SubmitOrder(agg *types.Order, inner, outer *num.Uint) []*types.Order
+	SelfTradeOrder(agg *types.Order, inner, outer *num.Uint) *types.Order
+	VolumeAtPrice(agg *types.Order, price *num.Uint) (uint64, *num.Uint)
 	NotifyFinished()
 	OrderbookShape(st, nd *num.Uint, id *string) ([]*types.Order, []*types.Order)
 }
@@ -76,6 +78,10 @@ type OrderBook struct {
 
 	peggedOrdersCount uint64
 	peggedCountNotify func(int64)
+
+	// markPrice returns the mark price of the market, used as the reference price
+	// for the slippage of market orders when the opposite side of the book is empty
+	markPrice func() *num.Uint
 }
 
 // CumulativeVolumeLevel represents the cumulative volume at a price level for both bid and ask.
@@ -611,7 +617,7 @@ func (b *OrderBook) uncrossBookSide(
 		}
 
 		// try to get the market price value from the order
-		trades, affectedOrders, _, err := opSide.uncross(order, false, uncrossBound)
+		trades, affectedOrders, _, _, err := opSide.uncross(order, false, uncrossBound)
 		if err != nil {
 			return nil, err
 		}
@@ -909,7 +915,13 @@ func (b *OrderBook) GetTrades(order *types.Order) ([]*types.Trade, error) {
 	}
 
 	idealPrice := b.theoreticalBestTradePrice(order)
+	restore, ok := b.applySlippageBound(order)
+	if !ok {
+		// the slippage of the order cannot be bounded, it won't trade
+		return nil, nil
+	}
 	trades, err := b.getOppositeSide(order.Side).fakeUncross(order, true, idealPrice)
+	restore()
 	// it's fine for the error to be a wash trade here,
 	// it's just be stopped when really uncrossing.
 	if err != nil && err != ErrWashTrade {
@@ -994,6 +1006,7 @@ func (b *OrderBook) SubmitOrder(order *types.Order) (*types.OrderConfirmation, e
 	var (
 		trades          []*types.Trade
 		impactedOrders  []*types.Order
+		selfTrades      []*types.SelfTradeReduction
 		lastTradedPrice *num.Uint
 		err             error
 	)
@@ -1002,9 +1015,14 @@ func (b *OrderBook) SubmitOrder(order *types.Order) (*types.OrderConfirmation, e
 	if !b.auction {
 		// uncross with opposite
 		idealPrice := b.theoreticalBestTradePrice(order)
-		trades, impactedOrders, lastTradedPrice, err = b.getOppositeSide(order.Side).uncross(order, true, idealPrice)
-		if !lastTradedPrice.IsZero() {
-			b.lastTradedPrice = lastTradedPrice
+		// a market order whose slippage cannot be bounded for lack of a reference price doesn't trade,
+		// it is stopped like any other market order which cannot trade
+		if restore, ok := b.applySlippageBound(order); ok {
+			trades, impactedOrders, selfTrades, lastTradedPrice, err = b.getOppositeSide(order.Side).uncross(order, true, idealPrice)
+			setAchievedSlippage(order, restore(), trades)
+			if !lastTradedPrice.IsZero() {
+				b.lastTradedPrice = lastTradedPrice
+			}
 		}
 	}
 
@@ -1064,6 +1082,14 @@ func (b *OrderBook) SubmitOrder(order *types.Order) (*types.OrderConfirmation, e
 		}
 	}
 
+	// resting orders cancelled by self-trade prevention are already out of the price levels
+	// so they only need removing from the lookup tables
+	for _, st := range selfTrades {
+		if st.Order != order && st.Order.Status == types.OrderStatusCancelled {
+			b.remove(st.Order)
+		}
+	}
+
 	// if we did hit a wash trade, set the status to STOPPED
 	if err == ErrWashTrade {
 		if order.Size > order.Remaining {
@@ -1079,6 +1105,7 @@ func (b *OrderBook) SubmitOrder(order *types.Order) (*types.OrderConfirmation, e
 	}
 
 	orderConfirmation := makeResponse(order, trades, impactedOrders)
+	orderConfirmation.SelfTradeReductions = selfTrades
 	return orderConfirmation, nil
 }
 
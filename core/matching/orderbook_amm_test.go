@@ -400,6 +400,128 @@ func TestUncrossedBookDoesNotExpandAMMs(t *testing.T) {
 	assert.Equal(t, "0", tst.book.GetIndicativePrice().String())
 }
 
+func TestOrderbookAMMSelfTradePrevention(t *testing.T) {
+	t.Run("cancel newest stops the order before the AMMs", testAMMSelfTradeCancelNewest)
+	t.Run("cancel oldest trades with the other AMMs", testAMMSelfTradeCancelOldest)
+	t.Run("cancel both stops the order before the AMMs", testAMMSelfTradeCancelBoth)
+	t.Run("decrement reduces the order by the pool's volume", testAMMSelfTradeDecrement)
+	t.Run("decrement stops the order when the pool is larger", testAMMSelfTradeDecrementLargerPool)
+	t.Run("fill or kill is stopped without trading", testAMMSelfTradeFOK)
+}
+
+func testAMMSelfTradeCancelNewest(t *testing.T) {
+	tst := getTestOrderBookWithAMM(t)
+	defer tst.ctrl.Finish()
+	price := num.NewUint(100)
+
+	o := createOrder(t, tst, 20, price)
+	o.SelfTradePrevention = types.OrderSelfTradePreventionCancelNewest
+	expectSelfTradeOrder(t, tst, price, 10)
+	tst.obs.EXPECT().NotifyFinished().Times(1)
+
+	conf, err := tst.book.SubmitOrder(o)
+	require.NoError(t, err)
+	assert.Len(t, conf.Trades, 0)
+	assert.Len(t, conf.SelfTradeReductions, 0)
+	assert.Equal(t, types.OrderStatusStopped, o.Status)
+	assert.Equal(t, types.OrderErrorSelfTrading, o.Reason)
+}
+
+func testAMMSelfTradeCancelOldest(t *testing.T) {
+	tst := getTestOrderBookWithAMM(t)
+	defer tst.ctrl.Finish()
+	price := num.NewUint(100)
+
+	o := createOrder(t, tst, 20, price)
+	o.SelfTradePrevention = types.OrderSelfTradePreventionCancelOldest
+	expectSelfTradeOrder(t, tst, price, 10)
+	expectOffbookOrders(t, tst, price, nil, price)
+	tst.obs.EXPECT().NotifyFinished().Times(1)
+
+	conf, err := tst.book.SubmitOrder(o)
+	require.NoError(t, err)
+	assertConf(t, conf, 2, 10)
+	assert.Len(t, conf.SelfTradeReductions, 0)
+	assert.Equal(t, types.OrderStatusFilled, o.Status)
+}
+
+func testAMMSelfTradeCancelBoth(t *testing.T) {
+	tst := getTestOrderBookWithAMM(t)
+	defer tst.ctrl.Finish()
+	price := num.NewUint(100)
+
+	o := createOrder(t, tst, 20, price)
+	o.SelfTradePrevention = types.OrderSelfTradePreventionCancelBoth
+	expectSelfTradeOrder(t, tst, price, 10)
+	tst.obs.EXPECT().NotifyFinished().Times(1)
+
+	conf, err := tst.book.SubmitOrder(o)
+	require.NoError(t, err)
+	assert.Len(t, conf.Trades, 0)
+	assert.Equal(t, types.OrderStatusStopped, o.Status)
+	assert.Equal(t, types.OrderErrorSelfTrading, o.Reason)
+}
+
+func testAMMSelfTradeDecrement(t *testing.T) {
+	tst := getTestOrderBookWithAMM(t)
+	defer tst.ctrl.Finish()
+	price := num.NewUint(100)
+
+	o := createOrder(t, tst, 20, price)
+	o.SelfTradePrevention = types.OrderSelfTradePreventionDecrementAndCancel
+	expectSelfTradeOrder(t, tst, price, 5)
+	expectOffbookOrders(t, tst, price, nil, price)
+	tst.obs.EXPECT().NotifyFinished().Times(1)
+
+	conf, err := tst.book.SubmitOrder(o)
+	require.NoError(t, err)
+	require.Len(t, conf.Trades, 2)
+	assert.Equal(t, uint64(10), conf.Trades[0].Size)
+	assert.Equal(t, uint64(5), conf.Trades[1].Size)
+
+	// the order is reduced by the pool's volume before trading with the other AMMs
+	require.Len(t, conf.SelfTradeReductions, 1)
+	assert.Equal(t, o, conf.SelfTradeReductions[0].Order)
+	assert.Equal(t, uint64(5), conf.SelfTradeReductions[0].Volume)
+	assert.Equal(t, uint64(15), o.Size)
+	assert.Equal(t, uint64(0), o.Remaining)
+	assert.Equal(t, types.OrderStatusFilled, o.Status)
+}
+
+func testAMMSelfTradeDecrementLargerPool(t *testing.T) {
+	tst := getTestOrderBookWithAMM(t)
+	defer tst.ctrl.Finish()
+	price := num.NewUint(100)
+
+	o := createOrder(t, tst, 20, price)
+	o.SelfTradePrevention = types.OrderSelfTradePreventionDecrementAndCancel
+	expectSelfTradeOrder(t, tst, price, 30)
+	tst.obs.EXPECT().NotifyFinished().Times(1)
+
+	conf, err := tst.book.SubmitOrder(o)
+	require.NoError(t, err)
+	assert.Len(t, conf.Trades, 0)
+	assert.Len(t, conf.SelfTradeReductions, 0)
+	assert.Equal(t, types.OrderStatusStopped, o.Status)
+}
+
+func testAMMSelfTradeFOK(t *testing.T) {
+	tst := getTestOrderBookWithAMM(t)
+	defer tst.ctrl.Finish()
+	price := num.NewUint(100)
+
+	o := createOrder(t, tst, 20, price)
+	o.TimeInForce = types.OrderTimeInForceFOK
+	o.SelfTradePrevention = types.OrderSelfTradePreventionCancelNewest
+	expectSelfTradeOrder(t, tst, price, 10)
+	tst.obs.EXPECT().NotifyFinished().Times(1)
+
+	conf, err := tst.book.SubmitOrder(o)
+	require.NoError(t, err)
+	assert.Len(t, conf.Trades, 0)
+	assert.Equal(t, types.OrderStatusStopped, o.Status)
+}
+
 func assertConf(t *testing.T, conf *types.OrderConfirmation, n int, size uint64) {
 	t.Helper()
 	assert.Len(t, conf.PassiveOrdersAffected, n)
@@ -416,6 +538,15 @@ func expectOffbookOrders(t *testing.T, tst *tstOrderbook, price, first, last *nu
 	tst.obs.EXPECT().SubmitOrder(gomock.Any(), first, last).Times(1).Return(generated)
 }
 
+func expectSelfTradeOrder(t *testing.T, tst *tstOrderbook, price *num.Uint, size uint64) {
+	t.Helper()
+	own := createOrder(t, tst, size, price)
+	own.Side = types.OtherSide(own.Side)
+	own.Party = "A-amm"
+	own.GeneratedOffbook = true
+	tst.obs.EXPECT().SelfTradeOrder(gomock.Any(), nil, price).Times(1).Return(own)
+}
+
 func expectCrossedAMMs(t *testing.T, tst *tstOrderbook, min, max int) {
 	t.Helper()
 	tst.obs.EXPECT().BestPricesAndVolumes().Return(num.NewUint(uint64(max)), uint64(10), num.NewUint(uint64(min)), uint64(10)).AnyTimes()
@@ -162,6 +162,78 @@ func (l *PriceLevel) uncrossIcebergs(agg *types.Order, tracked []*trackIceberg,
 	return newTrades, newImpacted
 }
 
+// selfTrade describes how a potential trade between an aggressive order and a resting
+// order from the same party is resolved.
+type selfTrade struct {
+	// cancelPassive is true if the resting order is cancelled.
+	cancelPassive bool
+	// decrementPassive is the size the resting order is reduced by.
+	decrementPassive uint64
+	// decrementAggressive is the size the aggressive order is reduced by.
+	decrementAggressive uint64
+	// stopAggressive is true if the aggressive order must stop matching.
+	stopAggressive bool
+}
+
+// preventSelfTrade returns the outcome of the aggressive order's self-trade prevention mode
+// when, with the given remaining size, it meets a resting order from the same party.
+func preventSelfTrade(mode types.OrderSelfTradePrevention, aggRemaining uint64, pass *types.Order) selfTrade {
+	switch mode {
+	case types.OrderSelfTradePreventionCancelOldest:
+		return selfTrade{cancelPassive: true}
+	case types.OrderSelfTradePreventionCancelBoth:
+		return selfTrade{cancelPassive: true, stopAggressive: true}
+	case types.OrderSelfTradePreventionDecrementAndCancel:
+		passRemaining := pass.TrueRemaining()
+		switch {
+		case aggRemaining > passRemaining:
+			return selfTrade{cancelPassive: true, decrementAggressive: passRemaining}
+		case aggRemaining < passRemaining:
+			return selfTrade{decrementPassive: aggRemaining, stopAggressive: true}
+		default:
+			return selfTrade{cancelPassive: true, stopAggressive: true}
+		}
+	default:
+		// unspecified and cancel newest both just stop the aggressive order
+		return selfTrade{stopAggressive: true}
+	}
+}
+
+// decrementOrder reduces the size of the order by the given amount without it trading,
+// for icebergs the hidden volume is used up before the visible peak.
+func decrementOrder(o *types.Order, by uint64) {
+	o.Size -= by
+	if o.IcebergOrder != nil {
+		fromReserve := min(by, o.IcebergOrder.ReservedRemaining)
+		o.IcebergOrder.ReservedRemaining -= fromReserve
+		by -= fromReserve
+	}
+	o.Remaining -= by
+}
+
+// applySelfTrade resolves a self-trade between the aggressive order and the resting order, and returns
+// the resulting reductions. The resting order is not removed from the price level here.
+func (l *PriceLevel) applySelfTrade(agg, pass *types.Order, st selfTrade) []*types.SelfTradeReduction {
+	reductions := []*types.SelfTradeReduction{}
+	if st.cancelPassive {
+		volume := pass.TrueRemaining()
+		l.volume -= volume
+		pass.Status = types.OrderStatusCancelled
+		pass.Reason = types.OrderErrorSelfTrading
+		reductions = append(reductions, &types.SelfTradeReduction{Order: pass, Volume: volume})
+	}
+	if st.decrementPassive > 0 {
+		l.volume -= st.decrementPassive
+		decrementOrder(pass, st.decrementPassive)
+		reductions = append(reductions, &types.SelfTradeReduction{Order: pass, Volume: st.decrementPassive})
+	}
+	if st.decrementAggressive > 0 {
+		decrementOrder(agg, st.decrementAggressive)
+		reductions = append(reductions, &types.SelfTradeReduction{Order: agg, Volume: st.decrementAggressive})
+	}
+	return reductions
+}
+
 // fakeUncross - this updates a copy of the order passed to it, the copied order is returned.
 func (l *PriceLevel) fakeUncross(o *types.Order, checkWashTrades bool) (agg *types.Order, trades []*types.Trade, err error) {
 	// work on a copy of the order, so we can submit it a second time
@@ -173,11 +245,17 @@ func (l *PriceLevel) fakeUncross(o *types.Order, checkWashTrades bool) (agg *typ
 
 	icebergs := []*trackIceberg{}
 	for i, order := range l.orders {
-		if checkWashTrades {
-			if order.Party == agg.Party {
+		if checkWashTrades && order.Party == agg.Party {
+			// only the aggressive order is affected here, the resting orders are left untouched
+			st := preventSelfTrade(agg.SelfTradePrevention, agg.Remaining, order)
+			if st.decrementAggressive > 0 {
+				decrementOrder(agg, st.decrementAggressive)
+			}
+			if st.stopAggressive {
 				err = ErrWashTrade
 				return
 			}
+			continue
 		}
 
 		// Get size and make newTrade
@@ -230,7 +308,7 @@ func (l *PriceLevel) fakeUncross(o *types.Order, checkWashTrades bool) (agg *typ
 	return agg, trades, err
 }
 
-func (l *PriceLevel) uncross(agg *types.Order, checkWashTrades bool) (filled bool, trades []*types.Trade, impactedOrders []*types.Order, err error) {
+func (l *PriceLevel) uncross(agg *types.Order, checkWashTrades bool) (filled bool, trades []*types.Trade, impactedOrders []*types.Order, selfTrades []*types.SelfTradeReduction, err error) {
 	// for some reason sometimes it seems the pricelevels are not deleted when getting empty
 	// no big deal, just return early
 	if len(l.orders) <= 0 {
@@ -240,17 +318,22 @@ func (l *PriceLevel) uncross(agg *types.Order, checkWashTrades bool) (filled boo
 	var (
 		icebergs []*trackIceberg
 		toRemove []int
-		removed  int
 	)
 
 	// l.orders is always sorted by timestamps, that is why when iterating we always start from the beginning
 	for i, order := range l.orders {
 		// prevent wash trade
-		if checkWashTrades {
-			if order.Party == agg.Party {
+		if checkWashTrades && order.Party == agg.Party {
+			st := preventSelfTrade(agg.SelfTradePrevention, agg.Remaining, order)
+			selfTrades = append(selfTrades, l.applySelfTrade(agg, order, st)...)
+			if st.cancelPassive {
+				toRemove = append(toRemove, i)
+			}
+			if st.stopAggressive {
 				err = ErrWashTrade
 				break
 			}
+			continue
 		}
 
 		// Get size and make newTrade
@@ -313,21 +396,29 @@ func (l *PriceLevel) uncross(agg *types.Order, checkWashTrades bool) (filled boo
 				toRemove = append(toRemove, t.idx)
 			}
 		}
-		sort.Ints(toRemove)
 	}
 
+	l.removeOrders(toRemove)
+	return agg.Remaining == 0, trades, impactedOrders, selfTrades, err
+}
+
+// removeOrders removes the orders at the given indexes from the price level, their volume
+// must already have been taken out of the level.
+func (l *PriceLevel) removeOrders(toRemove []int) {
+	if len(toRemove) == 0 {
+		return
+	}
+	sort.Ints(toRemove)
+
 	// FIXME(jeremy): these need to be optimized, we can make a single copy
 	// just by keep the index of the last order which is to remove as they
 	// are all order, then just copy the second part of the slice in the actual s[0]
-	if len(toRemove) > 0 {
-		for _, idx := range toRemove {
-			copy(l.orders[idx-removed:], l.orders[idx-removed+1:])
-			removed++
-		}
-		l.orders = l.orders[:len(l.orders)-removed]
+	removed := 0
+	for _, idx := range toRemove {
+		copy(l.orders[idx-removed:], l.orders[idx-removed+1:])
+		removed++
 	}
-
-	return agg.Remaining == 0, trades, impactedOrders, err
+	l.orders = l.orders[:len(l.orders)-removed]
 }
 
 func (l *PriceLevel) getVolumeAllocation(agg, pass *types.Order) uint64 {
@@ -102,7 +102,7 @@ func TestUncross(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, uint64(0), order.Remaining)
 
-	filled, trades, impactedOrders, err := l.uncross(aggresiveOrder, true)
+	filled, trades, impactedOrders, _, err := l.uncross(aggresiveOrder, true)
 	assert.Equal(t, true, filled)
 	assert.Equal(t, 1, len(trades))
 	assert.Equal(t, 1, len(impactedOrders))
@@ -148,7 +148,7 @@ func TestUncrossDecimals(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, uint64(0), order.Remaining)
 
-	filled, trades, impactedOrders, err := l.uncross(aggresiveOrder, true)
+	filled, trades, impactedOrders, _, err := l.uncross(aggresiveOrder, true)
 	assert.Equal(t, true, filled)
 	assert.Equal(t, 1, len(trades))
 	assert.Equal(t, 1, len(impactedOrders))
@@ -0,0 +1,281 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package matching
+
+import (
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+)
+
+// SetMatchingAlgorithm sets the algorithm used to share the volume of aggressive orders between
+// the resting orders at each price level of the book.
+func (b *OrderBook) SetMatchingAlgorithm(algorithm types.MatchingAlgorithm) {
+	b.buy.matchingAlgorithm = algorithm
+	b.sell.matchingAlgorithm = algorithm
+}
+
+func isProRata(algorithm types.MatchingAlgorithm) bool {
+	return algorithm == types.MatchingAlgorithmProRata || algorithm == types.MatchingAlgorithmProRataTopOfBook
+}
+
+// uncrossLevel uncrosses the aggressive order with the given price level using the matching algorithm of the side.
+func (s *OrderBookSide) uncrossLevel(lvl *PriceLevel, agg *types.Order, checkWashTrades bool) (bool, []*types.Trade, []*types.Order, []*types.SelfTradeReduction, error) {
+	if isProRata(s.matchingAlgorithm) {
+		return s.uncrossProRata(lvl, agg, checkWashTrades)
+	}
+	return lvl.uncross(agg, checkWashTrades)
+}
+
+// fakeUncrossLevel returns the trades the aggressive order would generate with the given price level using
+// the matching algorithm of the side, along with an updated copy of the aggressive order.
+func (s *OrderBookSide) fakeUncrossLevel(lvl *PriceLevel, agg *types.Order, checkWashTrades bool) (*types.Order, []*types.Trade, error) {
+	if isProRata(s.matchingAlgorithm) {
+		return s.fakeUncrossProRata(lvl, agg, checkWashTrades)
+	}
+	return lvl.fakeUncross(agg, checkWashTrades)
+}
+
+// uncrossProRata uncrosses the aggressive order with the price level, sharing its volume between the resting orders
+// and the offbook volume at the price of the level.
+func (s *OrderBookSide) uncrossProRata(lvl *PriceLevel, agg *types.Order, checkWashTrades bool) (filled bool, trades []*types.Trade, impactedOrders []*types.Order, selfTrades []*types.SelfTradeReduction, err error) {
+	volume, submitted, selfTrades, err := s.offbookAtLevel(lvl.price, agg, checkWashTrades)
+	if err != nil {
+		return false, nil, nil, selfTrades, err
+	}
+
+	var (
+		nself     []*types.SelfTradeReduction
+		toRemove  []int
+		allocated uint64
+	)
+	trades, impactedOrders, nself, toRemove, allocated, err = lvl.matchProRata(agg, volume, checkWashTrades, s.matchingAlgorithm == types.MatchingAlgorithmProRataTopOfBook, false)
+	lvl.removeOrders(toRemove)
+	selfTrades = append(selfTrades, nself...)
+
+	if allocated > 0 {
+		ot, oo := s.tradeOffbookAtLevel(lvl.price, agg, submitted, allocated, false)
+		trades = append(trades, ot...)
+		impactedOrders = append(impactedOrders, oo...)
+	}
+	return agg.Remaining == 0, trades, impactedOrders, selfTrades, err
+}
+
+// fakeUncrossProRata works on a copy of the aggressive order and returns it along with the trades
+// it would generate with the price level, the resting orders are left untouched.
+func (s *OrderBookSide) fakeUncrossProRata(lvl *PriceLevel, o *types.Order, checkWashTrades bool) (agg *types.Order, trades []*types.Trade, err error) {
+	agg = o.Clone()
+	volume, submitted, _, err := s.offbookAtLevel(lvl.price, agg, checkWashTrades)
+	if err != nil {
+		return agg, nil, err
+	}
+
+	var allocated uint64
+	trades, _, _, _, allocated, err = lvl.matchProRata(agg, volume, checkWashTrades, s.matchingAlgorithm == types.MatchingAlgorithmProRataTopOfBook, true)
+	if allocated > 0 {
+		ot, _ := s.tradeOffbookAtLevel(lvl.price, agg, submitted, allocated, true)
+		trades = append(trades, ot...)
+	}
+	return agg, trades, err
+}
+
+// offbookAtLevel returns the offbook volume the aggressive order can trade at the given level price, once its
+// self-trade prevention has been applied to the party's own pool, along with the order to submit for that volume.
+func (s *OrderBookSide) offbookAtLevel(price *num.Uint, agg *types.Order, checkWashTrades bool) (uint64, *types.Order, []*types.SelfTradeReduction, error) {
+	if s.offbook == nil {
+		return 0, agg, nil, nil
+	}
+
+	var selfTrades []*types.SelfTradeReduction
+	submitted := agg
+	if agg.SelfTradePrevention != types.OrderSelfTradePreventionUnspecified {
+		if checkWashTrades {
+			var err error
+			if selfTrades, err = s.preventOffbookSelfTrade(agg, price, price); err != nil {
+				return 0, agg, selfTrades, err
+			}
+		} else {
+			submitted = agg.Clone()
+			submitted.SelfTradePrevention = types.OrderSelfTradePreventionUnspecified
+		}
+	}
+
+	volume, _ := s.offbook.VolumeAtPrice(submitted, price)
+	return volume, submitted, selfTrades, nil
+}
+
+// tradeOffbookAtLevel trades the volume allocated to the offbook source at the given level price with the aggressive
+// order. The allocated volume has already been taken off the aggressive order by the pro-rata allocation.
+func (s *OrderBookSide) tradeOffbookAtLevel(price *num.Uint, agg, submitted *types.Order, allocated uint64, fake bool) ([]*types.Trade, []*types.Order) {
+	submitted = submitted.Clone()
+	submitted.Remaining = allocated
+	orders := s.offbook.SubmitOrder(submitted, price, price)
+
+	trades := make([]*types.Trade, 0, len(orders))
+	for _, o := range orders {
+		size := min(allocated, o.Remaining)
+		trades = append(trades, newTrade(agg, o, size))
+		allocated -= size
+		if !fake {
+			o.Remaining -= size
+		}
+	}
+	return trades, orders
+}
+
+// matchProRata shares the volume of the aggressive order between the visible volume of the resting orders at the
+// price level and the given offbook volume at the price of the level, in proportion to their size. The offbook volume
+// comes last in time priority. If topOfBook is set the oldest order at the level is filled first and only the rest of
+// the volume is shared. Once the visible volume is used up, the aggressive order trades with the hidden volume of the
+// iceberg orders at the level. If fake is set the resting orders and the level are not updated. The indexes of the
+// orders to remove from the level are returned, along with the volume allocated to the offbook source which is taken
+// off the aggressive order but left for the caller to trade.
+func (l *PriceLevel) matchProRata(agg *types.Order, offbook uint64, checkWashTrades, topOfBook, fake bool) (trades []*types.Trade, impactedOrders []*types.Order, selfTrades []*types.SelfTradeReduction, toRemove []int, offbookAllocation uint64, err error) {
+	// every order at the level takes part in the match, so the aggressive order meets all the orders of its party
+	// at once and self-trade prevention is applied to them before any volume is shared
+	eligible := make([]int, 0, len(l.orders))
+	for i, order := range l.orders {
+		if !checkWashTrades || order.Party != agg.Party {
+			eligible = append(eligible, i)
+			continue
+		}
+
+		st := preventSelfTrade(agg.SelfTradePrevention, agg.Remaining, order)
+		if fake {
+			// only the aggressive order is affected here, the resting orders are left untouched
+			if st.decrementAggressive > 0 {
+				decrementOrder(agg, st.decrementAggressive)
+			}
+		} else {
+			selfTrades = append(selfTrades, l.applySelfTrade(agg, order, st)...)
+			if st.cancelPassive {
+				toRemove = append(toRemove, i)
+			}
+		}
+		if st.stopAggressive {
+			return nil, nil, selfTrades, toRemove, 0, ErrWashTrade
+		}
+	}
+
+	sizes := make([]uint64, 0, len(eligible)+1)
+	for _, i := range eligible {
+		sizes = append(sizes, l.orders[i].Remaining)
+	}
+	sizes = append(sizes, offbook)
+	allocations := proRataAllocation(agg.Remaining, sizes, topOfBook)
+
+	// the offbook volume is visible volume too, so it's taken off before trading with the hidden volume of the icebergs
+	offbookAllocation = allocations[len(eligible)]
+	agg.Remaining -= offbookAllocation
+
+	var icebergs []*trackIceberg
+	for k, i := range eligible {
+		order := l.orders[i]
+		size := allocations[k]
+		if size == 0 {
+			// an iceberg with only hidden volume left can still trade once the visible volume is used up
+			if order.IcebergOrder != nil && order.Remaining == 0 && order.IcebergOrder.ReservedRemaining != 0 {
+				icebergs = append(icebergs, &trackIceberg{order, nil, i})
+			}
+			continue
+		}
+
+		trade := newTrade(agg, order, size)
+		trade.SellOrder, trade.BuyOrder = agg.ID, order.ID
+		if agg.Side == types.SideBuy {
+			trade.SellOrder, trade.BuyOrder = trade.BuyOrder, trade.SellOrder
+		}
+
+		agg.Remaining -= size
+		if !fake {
+			order.Remaining -= size
+			l.volume -= size
+			if order.TrueRemaining() == 0 {
+				toRemove = append(toRemove, i)
+			}
+		}
+
+		trades = append(trades, trade)
+		impactedOrders = append(impactedOrders, order)
+
+		if order.IcebergOrder != nil && order.IcebergOrder.ReservedRemaining > 0 {
+			icebergs = append(icebergs, &trackIceberg{order, trade, i})
+		}
+	}
+
+	// the aggressive order can only have volume left if all the visible volume was traded,
+	// the hidden volume of the icebergs is then shared in proportion to their reserve
+	if agg.Remaining > 0 && len(icebergs) > 0 {
+		newTrades, newImpacted := l.uncrossIcebergs(agg, icebergs, fake)
+		trades = append(trades, newTrades...)
+		impactedOrders = append(impactedOrders, newImpacted...)
+
+		if !fake {
+			for _, t := range icebergs {
+				if t.order.TrueRemaining() == 0 {
+					toRemove = append(toRemove, t.idx)
+				}
+			}
+		}
+	}
+
+	return trades, impactedOrders, selfTrades, toRemove, offbookAllocation, nil
+}
+
+// proRataAllocation shares the volume between orders of the given sizes, listed in time priority, in proportion
+// to their size. If topOfBook is set the first order is allocated as much as it can take before the rest is shared.
+// Allocations are rounded down and the remainder is handed out one at a time to the orders in time priority.
+func proRataAllocation(volume uint64, sizes []uint64, topOfBook bool) []uint64 {
+	allocations := make([]uint64, len(sizes))
+	offset := 0
+	if topOfBook && len(sizes) > 0 {
+		allocations[0] = min(volume, sizes[0])
+		volume -= allocations[0]
+		offset = 1
+	}
+
+	shared := sizes[offset:]
+	var total uint64
+	for _, size := range shared {
+		total += size
+	}
+	if volume == 0 || total == 0 {
+		return allocations
+	}
+
+	// there's enough volume to fill every order
+	if volume >= total {
+		copy(allocations[offset:], shared)
+		return allocations
+	}
+
+	var allocated uint64
+	v, t := num.NewUint(volume), num.NewUint(total)
+	for k, size := range shared {
+		share := num.UintZero().Mul(v, num.NewUint(size))
+		allocations[offset+k] = share.Div(share, t).Uint64()
+		allocated += allocations[offset+k]
+	}
+
+	// each order is rounded down by less than one, so every order has room for one more
+	// and a single pass is enough to hand out the remainder
+	for k := 0; k < len(shared) && allocated < volume; k++ {
+		if allocations[offset+k] < shared[k] {
+			allocations[offset+k]++
+			allocated++
+		}
+	}
+	return allocations
+}
@@ -0,0 +1,332 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package matching
+
+import (
+	"fmt"
+	"testing"
+
+	"code.vegaprotocol.io/vega/core/matching/mocks"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderBook_ProRataMatching(t *testing.T) {
+	t.Run("Volume is shared in proportion to the size of the resting orders", testProRataSharesVolume)
+	t.Run("Oldest order is filled first with top of book priority", testProRataTopOfBook)
+	t.Run("Rounding remainders go to the oldest orders", testProRataRoundingRemainder)
+	t.Run("Iceberg reserves are only used once the visible volume is traded", testProRataIcebergReserves)
+	t.Run("Potential trades match the executed trades", testProRataGetTrades)
+	t.Run("Self trades are prevented before sharing the volume", testProRataSelfTrade)
+	t.Run("AMM volume at the level is shared with the resting orders", testProRataAMMVolume)
+	t.Run("Allocation of the volume between orders", testProRataAllocation)
+}
+
+func submitProRataTestOrder(t *testing.T, book *tstOB, party string, side types.Side, price, size uint64) *types.Order {
+	t.Helper()
+	order := &types.Order{
+		ID:            fmt.Sprintf("%064s", party),
+		MarketID:      book.marketID,
+		Party:         party,
+		Side:          side,
+		Price:         num.NewUint(price),
+		OriginalPrice: num.NewUint(price),
+		Size:          size,
+		Remaining:     size,
+		TimeInForce:   types.OrderTimeInForceGTC,
+		Type:          types.OrderTypeLimit,
+	}
+	_, err := book.SubmitOrder(order)
+	require.NoError(t, err)
+	return order
+}
+
+func tradeSizesByOrder(trades []*types.Trade) map[string]uint64 {
+	sizes := map[string]uint64{}
+	for _, t := range trades {
+		sizes[t.SellOrder] += t.Size
+	}
+	return sizes
+}
+
+func testProRataSharesVolume(t *testing.T) {
+	book := getTestOrderBook(t, "testMarket")
+	defer book.Finish()
+	book.SetMatchingAlgorithm(types.MatchingAlgorithmProRata)
+
+	first := submitProRataTestOrder(t, book, "p1", types.SideSell, 100, 10)
+	second := submitProRataTestOrder(t, book, "p2", types.SideSell, 100, 30)
+	third := submitProRataTestOrder(t, book, "p3", types.SideSell, 100, 60)
+
+	confirm, err := book.SubmitOrder(&types.Order{
+		ID: fmt.Sprintf("%064s", "aggressive"), MarketID: book.marketID, Party: "aggressive", Side: types.SideBuy,
+		Price: num.NewUint(100), Size: 50, Remaining: 50, TimeInForce: types.OrderTimeInForceIOC, Type: types.OrderTypeLimit,
+	})
+	require.NoError(t, err)
+
+	// the trades are in time priority
+	require.Len(t, confirm.Trades, 3)
+	assert.Equal(t, first.ID, confirm.Trades[0].SellOrder)
+	assert.Equal(t, uint64(5), confirm.Trades[0].Size)
+	assert.Equal(t, second.ID, confirm.Trades[1].SellOrder)
+	assert.Equal(t, uint64(15), confirm.Trades[1].Size)
+	assert.Equal(t, third.ID, confirm.Trades[2].SellOrder)
+	assert.Equal(t, uint64(30), confirm.Trades[2].Size)
+
+	assert.Equal(t, uint64(5), first.Remaining)
+	assert.Equal(t, uint64(15), second.Remaining)
+	assert.Equal(t, uint64(30), third.Remaining)
+	_, volume, err := book.BestOfferPriceAndVolume()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(50), volume)
+}
+
+func testProRataTopOfBook(t *testing.T) {
+	book := getTestOrderBook(t, "testMarket")
+	defer book.Finish()
+	book.SetMatchingAlgorithm(types.MatchingAlgorithmProRataTopOfBook)
+
+	first := submitProRataTestOrder(t, book, "p1", types.SideSell, 100, 10)
+	second := submitProRataTestOrder(t, book, "p2", types.SideSell, 100, 20)
+	third := submitProRataTestOrder(t, book, "p3", types.SideSell, 100, 20)
+
+	confirm, err := book.SubmitOrder(&types.Order{
+		ID: fmt.Sprintf("%064s", "aggressive"), MarketID: book.marketID, Party: "aggressive", Side: types.SideBuy,
+		Price: num.NewUint(100), Size: 30, Remaining: 30, TimeInForce: types.OrderTimeInForceIOC, Type: types.OrderTypeLimit,
+	})
+	require.NoError(t, err)
+
+	// the oldest order is filled, and what's left is shared between the others
+	sizes := tradeSizesByOrder(confirm.Trades)
+	assert.Equal(t, uint64(10), sizes[first.ID])
+	assert.Equal(t, uint64(10), sizes[second.ID])
+	assert.Equal(t, uint64(10), sizes[third.ID])
+	// the filled order is removed from the book
+	_, err = book.GetOrderByID(first.ID)
+	assert.Error(t, err)
+}
+
+func testProRataRoundingRemainder(t *testing.T) {
+	book := getTestOrderBook(t, "testMarket")
+	defer book.Finish()
+	book.SetMatchingAlgorithm(types.MatchingAlgorithmProRata)
+
+	orders := []*types.Order{
+		submitProRataTestOrder(t, book, "p1", types.SideBuy, 100, 1),
+		submitProRataTestOrder(t, book, "p2", types.SideBuy, 100, 1),
+		submitProRataTestOrder(t, book, "p3", types.SideBuy, 100, 1),
+	}
+
+	confirm, err := book.SubmitOrder(&types.Order{
+		ID: fmt.Sprintf("%064s", "aggressive"), MarketID: book.marketID, Party: "aggressive", Side: types.SideSell,
+		Price: num.NewUint(100), Size: 2, Remaining: 2, TimeInForce: types.OrderTimeInForceIOC, Type: types.OrderTypeLimit,
+	})
+	require.NoError(t, err)
+
+	// every order is allocated nothing once rounded down, the remaining lots go to the oldest orders
+	require.Len(t, confirm.Trades, 2)
+	assert.Equal(t, orders[0].ID, confirm.Trades[0].BuyOrder)
+	assert.Equal(t, orders[1].ID, confirm.Trades[1].BuyOrder)
+	assert.Equal(t, uint64(1), orders[2].Remaining)
+}
+
+func testProRataIcebergReserves(t *testing.T) {
+	book := getTestOrderBook(t, "testMarket")
+	defer book.Finish()
+	book.SetMatchingAlgorithm(types.MatchingAlgorithmProRata)
+
+	iceberg := &types.Order{
+		ID: fmt.Sprintf("%064s", "p1"), MarketID: book.marketID, Party: "p1", Side: types.SideSell,
+		Price: num.NewUint(100), OriginalPrice: num.NewUint(100), Size: 100, Remaining: 100,
+		TimeInForce: types.OrderTimeInForceGTC, Type: types.OrderTypeLimit, Status: types.OrderStatusActive,
+		IcebergOrder: &types.IcebergOrder{PeakSize: 10, MinimumVisibleSize: 5},
+	}
+	_, err := book.SubmitOrder(iceberg)
+	require.NoError(t, err)
+	other := submitProRataTestOrder(t, book, "p2", types.SideSell, 100, 10)
+
+	// only the visible volume is shared
+	confirm, err := book.SubmitOrder(&types.Order{
+		ID: fmt.Sprintf("%064s", "aggressive1"), MarketID: book.marketID, Party: "aggressive", Side: types.SideBuy,
+		Price: num.NewUint(100), Size: 10, Remaining: 10, TimeInForce: types.OrderTimeInForceIOC, Type: types.OrderTypeLimit,
+	})
+	require.NoError(t, err)
+	sizes := tradeSizesByOrder(confirm.Trades)
+	assert.Equal(t, uint64(5), sizes[iceberg.ID])
+	assert.Equal(t, uint64(5), sizes[other.ID])
+	assert.Equal(t, uint64(90), iceberg.IcebergOrder.ReservedRemaining)
+
+	// once the visible volume is traded the aggressive order eats into the reserve
+	confirm, err = book.SubmitOrder(&types.Order{
+		ID: fmt.Sprintf("%064s", "aggressive2"), MarketID: book.marketID, Party: "aggressive", Side: types.SideBuy,
+		Price: num.NewUint(100), Size: 30, Remaining: 30, TimeInForce: types.OrderTimeInForceIOC, Type: types.OrderTypeLimit,
+	})
+	require.NoError(t, err)
+	sizes = tradeSizesByOrder(confirm.Trades)
+	assert.Equal(t, uint64(25), sizes[iceberg.ID])
+	assert.Equal(t, uint64(5), sizes[other.ID])
+	assert.Equal(t, types.OrderStatusFilled, confirm.Order.Status)
+	assert.Equal(t, uint64(70), iceberg.TrueRemaining())
+}
+
+func testProRataGetTrades(t *testing.T) {
+	book := getTestOrderBook(t, "testMarket")
+	defer book.Finish()
+	book.SetMatchingAlgorithm(types.MatchingAlgorithmProRataTopOfBook)
+
+	submitProRataTestOrder(t, book, "p1", types.SideSell, 100, 7)
+	submitProRataTestOrder(t, book, "p2", types.SideSell, 100, 13)
+	submitProRataTestOrder(t, book, "p3", types.SideSell, 101, 20)
+
+	order := &types.Order{
+		ID: fmt.Sprintf("%064s", "aggressive"), MarketID: book.marketID, Party: "aggressive", Side: types.SideBuy,
+		Price: num.NewUint(101), Size: 25, Remaining: 25, TimeInForce: types.OrderTimeInForceIOC, Type: types.OrderTypeLimit,
+	}
+	potential, err := book.GetTrades(order)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(25), order.Remaining)
+
+	confirm, err := book.SubmitOrder(order)
+	require.NoError(t, err)
+	require.Len(t, potential, len(confirm.Trades))
+	for i := range potential {
+		assert.Equal(t, confirm.Trades[i].Size, potential[i].Size)
+		assert.Equal(t, confirm.Trades[i].SellOrder, potential[i].SellOrder)
+		assert.Equal(t, confirm.Trades[i].Price.Uint64(), potential[i].Price.Uint64())
+	}
+}
+
+func testProRataSelfTrade(t *testing.T) {
+	book := getTestOrderBook(t, "testMarket")
+	defer book.Finish()
+	book.SetMatchingAlgorithm(types.MatchingAlgorithmProRata)
+
+	submitProRataTestOrder(t, book, "p1", types.SideSell, 100, 10)
+	submitProRataTestOrder(t, book, "p2", types.SideSell, 100, 10)
+
+	// the aggressive order would trade with its own order at the level, so it's stopped without trading
+	confirm, err := book.SubmitOrder(&types.Order{
+		ID: fmt.Sprintf("%064s", "aggressive"), MarketID: book.marketID, Party: "p2", Side: types.SideBuy,
+		Price: num.NewUint(100), Size: 10, Remaining: 10, TimeInForce: types.OrderTimeInForceIOC, Type: types.OrderTypeLimit,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, confirm.Trades)
+	assert.Equal(t, types.OrderStatusStopped, confirm.Order.Status)
+	assert.Equal(t, types.OrderErrorSelfTrading, confirm.Order.Reason)
+	_, volume, err := book.BestOfferPriceAndVolume()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(20), volume)
+}
+
+func testProRataAMMVolume(t *testing.T) {
+	book := getTestOrderBook(t, "testMarket")
+	defer book.Finish()
+	book.SetMatchingAlgorithm(types.MatchingAlgorithmProRata)
+
+	submitProRataTestOrder(t, book, "p1", types.SideSell, 100, 10)
+	submitProRataTestOrder(t, book, "p2", types.SideSell, 100, 30)
+
+	obs := mocks.NewMockOffbookSource(gomock.NewController(t))
+	book.SetOffbookSource(obs)
+
+	ammOrder := func(price, size uint64) *types.Order {
+		return &types.Order{
+			MarketID: book.marketID, Party: "amm", Side: types.SideSell, Price: num.NewUint(price), OriginalPrice: num.NewUint(price),
+			Size: size, Remaining: size, TimeInForce: types.OrderTimeInForceGTC, Type: types.OrderTypeLimit,
+		}
+	}
+
+	// the pool has 10 to sell below the level, which trades first, and 40 at the level which is shared with the
+	// resting orders, the volume before the level stops one tick short of it
+	expectAMM := func() {
+		obs.EXPECT().VolumeAtPrice(gomock.Any(), num.NewUint(100)).Times(2).Return(uint64(40), num.NewUint(99))
+		obs.EXPECT().SubmitOrder(gomock.Any(), gomock.Any(), num.NewUint(99)).Times(1).Return([]*types.Order{ammOrder(99, 10)})
+		obs.EXPECT().SubmitOrder(gomock.Any(), num.NewUint(100), num.NewUint(100)).Times(1).DoAndReturn(
+			func(agg *types.Order, _, _ *num.Uint) []*types.Order {
+				// only the volume allocated to the pool is submitted
+				assert.Equal(t, uint64(25), agg.Remaining)
+				return []*types.Order{ammOrder(100, agg.Remaining)}
+			},
+		)
+		obs.EXPECT().NotifyFinished().Times(1)
+	}
+
+	order := &types.Order{
+		ID: fmt.Sprintf("%064s", "aggressive"), MarketID: book.marketID, Party: "aggressive", Side: types.SideBuy,
+		Price: num.NewUint(100), Size: 60, Remaining: 60, TimeInForce: types.OrderTimeInForceIOC, Type: types.OrderTypeLimit,
+	}
+
+	expectAMM()
+	potential, err := book.GetTrades(order)
+	require.NoError(t, err)
+
+	expectAMM()
+	confirm, err := book.SubmitOrder(order)
+	require.NoError(t, err)
+	assert.Equal(t, types.OrderStatusFilled, confirm.Order.Status)
+
+	// the 50 left after the volume below the level are shared between 10, 30 and 40
+	// which is 6.25, 18.75 and 25 rounded down, and the remaining lot goes to the oldest order
+	require.Len(t, confirm.Trades, 4)
+	sizes := map[string]uint64{}
+	for _, trade := range confirm.Trades {
+		sizes[trade.Seller] += trade.Size
+	}
+	assert.Equal(t, map[string]uint64{"p1": 7, "p2": 18, "amm": 35}, sizes)
+	assert.Equal(t, "99", confirm.Trades[0].Price.String())
+
+	require.Len(t, potential, len(confirm.Trades))
+	for i := range potential {
+		assert.Equal(t, confirm.Trades[i].Size, potential[i].Size)
+		assert.Equal(t, confirm.Trades[i].Seller, potential[i].Seller)
+	}
+
+	// the pool has nothing left to sell
+	obs.EXPECT().BestPricesAndVolumes().Times(1).Return(nil, uint64(0), nil, uint64(0))
+	_, volume, err := book.BestOfferPriceAndVolume()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(15), volume)
+}
+
+func testProRataAllocation(t *testing.T) {
+	cases := []struct {
+		volume      uint64
+		sizes       []uint64
+		topOfBook   bool
+		allocations []uint64
+	}{
+		{volume: 10, sizes: []uint64{5, 5}, allocations: []uint64{5, 5}},
+		{volume: 20, sizes: []uint64{5, 5}, allocations: []uint64{5, 5}},
+		{volume: 7, sizes: []uint64{3, 3, 3}, allocations: []uint64{3, 2, 2}},
+		{volume: 7, sizes: []uint64{0, 3, 3, 3}, allocations: []uint64{0, 3, 2, 2}},
+		{volume: 10, sizes: []uint64{1, 99}, allocations: []uint64{1, 9}},
+		{volume: 10, sizes: []uint64{4, 10, 10}, topOfBook: true, allocations: []uint64{4, 3, 3}},
+		{volume: 3, sizes: []uint64{4, 10, 10}, topOfBook: true, allocations: []uint64{3, 0, 0}},
+		{volume: 0, sizes: []uint64{1, 1}, allocations: []uint64{0, 0}},
+		{volume: 1, sizes: []uint64{}, allocations: []uint64{}},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.allocations, proRataAllocation(c.volume, c.sizes, c.topOfBook), "volume %d, sizes %v", c.volume, c.sizes)
+	}
+
+	// large sizes don't overflow
+	assert.Equal(t, []uint64{1 << 61, 1 << 61}, proRataAllocation(1<<62, []uint64{1 << 62, 1 << 62}, false))
+}
@@ -0,0 +1,232 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package matching_test
+
+import (
+	"testing"
+
+	"code.vegaprotocol.io/vega/core/types"
+	vgcrypto "code.vegaprotocol.io/vega/libs/crypto"
+	"code.vegaprotocol.io/vega/libs/num"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelfTradePrevention(t *testing.T) {
+	t.Run("cancel newest stops the aggressive order", testSelfTradeCancelNewest)
+	t.Run("cancel oldest cancels the resting order and keeps matching", testSelfTradeCancelOldest)
+	t.Run("cancel both cancels the resting order and stops the aggressive order", testSelfTradeCancelBoth)
+	t.Run("decrement and cancel with a larger aggressive order", testSelfTradeDecrementLargerAggressive)
+	t.Run("decrement and cancel with a larger resting order", testSelfTradeDecrementLargerPassive)
+	t.Run("decrement and cancel uses the hidden volume of a resting iceberg first", testSelfTradeDecrementIceberg)
+	t.Run("FOK order is stopped before trading", testSelfTradeFOKStopped)
+	t.Run("FOK order is filled once decremented", testSelfTradeFOKDecremented)
+}
+
+func newSelfTradeOrder(party string, side types.Side, size uint64, tif types.OrderTimeInForce, stp types.OrderSelfTradePrevention) *types.Order {
+	return &types.Order{
+		ID:                  vgcrypto.RandomHash(),
+		Status:              types.OrderStatusActive,
+		MarketID:            "testMarket",
+		Party:               party,
+		Side:                side,
+		Price:               num.NewUint(100),
+		OriginalPrice:       num.NewUint(100),
+		Size:                size,
+		Remaining:           size,
+		TimeInForce:         tif,
+		Type:                types.OrderTypeLimit,
+		SelfTradePrevention: stp,
+	}
+}
+
+// setupSelfTradeBook places a sell order from party A followed by one from party B at the same price.
+func setupSelfTradeBook(t *testing.T, sizeA, sizeB uint64) (*tstOB, *types.Order, *types.Order) {
+	t.Helper()
+	book := getTestOrderBook(t, "testMarket")
+	own := newSelfTradeOrder("A", types.SideSell, sizeA, types.OrderTimeInForceGTC, types.OrderSelfTradePreventionUnspecified)
+	_, err := book.ob.SubmitOrder(own)
+	require.NoError(t, err)
+	other := newSelfTradeOrder("B", types.SideSell, sizeB, types.OrderTimeInForceGTC, types.OrderSelfTradePreventionUnspecified)
+	_, err = book.ob.SubmitOrder(other)
+	require.NoError(t, err)
+	return book, own, other
+}
+
+func testSelfTradeCancelNewest(t *testing.T) {
+	book, own, _ := setupSelfTradeBook(t, 5, 5)
+	defer book.Finish()
+
+	agg := newSelfTradeOrder("A", types.SideBuy, 5, types.OrderTimeInForceGTC, types.OrderSelfTradePreventionCancelNewest)
+	conf, err := book.ob.SubmitOrder(agg)
+	require.NoError(t, err)
+	assert.Len(t, conf.Trades, 0)
+	assert.Len(t, conf.SelfTradeReductions, 0)
+	assert.Equal(t, types.OrderStatusStopped, agg.Status)
+	assert.Equal(t, types.OrderStatusActive, own.Status)
+
+	_, volume, err := book.ob.BestOfferPriceAndVolume()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), volume)
+}
+
+func testSelfTradeCancelOldest(t *testing.T) {
+	book, own, other := setupSelfTradeBook(t, 5, 5)
+	defer book.Finish()
+
+	agg := newSelfTradeOrder("A", types.SideBuy, 5, types.OrderTimeInForceGTC, types.OrderSelfTradePreventionCancelOldest)
+	conf, err := book.ob.SubmitOrder(agg)
+	require.NoError(t, err)
+	require.Len(t, conf.Trades, 1)
+	assert.Equal(t, other.ID, conf.Trades[0].SellOrder)
+	assert.Equal(t, uint64(5), conf.Trades[0].Size)
+	assert.Equal(t, types.OrderStatusFilled, agg.Status)
+
+	require.Len(t, conf.SelfTradeReductions, 1)
+	assert.Equal(t, own, conf.SelfTradeReductions[0].Order)
+	assert.Equal(t, uint64(5), conf.SelfTradeReductions[0].Volume)
+	assert.Equal(t, types.OrderStatusCancelled, own.Status)
+	assert.Equal(t, types.OrderErrorSelfTrading, own.Reason)
+
+	_, err = book.ob.GetOrderByID(own.ID)
+	assert.Error(t, err)
+	_, _, err = book.ob.BestOfferPriceAndVolume()
+	assert.Error(t, err)
+}
+
+func testSelfTradeCancelBoth(t *testing.T) {
+	book, own, _ := setupSelfTradeBook(t, 5, 5)
+	defer book.Finish()
+
+	agg := newSelfTradeOrder("A", types.SideBuy, 5, types.OrderTimeInForceGTC, types.OrderSelfTradePreventionCancelBoth)
+	conf, err := book.ob.SubmitOrder(agg)
+	require.NoError(t, err)
+	assert.Len(t, conf.Trades, 0)
+	assert.Equal(t, types.OrderStatusStopped, agg.Status)
+
+	require.Len(t, conf.SelfTradeReductions, 1)
+	assert.Equal(t, own, conf.SelfTradeReductions[0].Order)
+	assert.Equal(t, types.OrderStatusCancelled, own.Status)
+
+	_, volume, err := book.ob.BestOfferPriceAndVolume()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), volume)
+}
+
+func testSelfTradeDecrementLargerAggressive(t *testing.T) {
+	book, own, other := setupSelfTradeBook(t, 3, 5)
+	defer book.Finish()
+
+	agg := newSelfTradeOrder("A", types.SideBuy, 5, types.OrderTimeInForceGTC, types.OrderSelfTradePreventionDecrementAndCancel)
+	conf, err := book.ob.SubmitOrder(agg)
+	require.NoError(t, err)
+	require.Len(t, conf.Trades, 1)
+	assert.Equal(t, other.ID, conf.Trades[0].SellOrder)
+	assert.Equal(t, uint64(2), conf.Trades[0].Size)
+
+	// the aggressive order lost the size of the resting order and then filled
+	assert.Equal(t, uint64(2), agg.Size)
+	assert.Equal(t, uint64(0), agg.Remaining)
+	assert.Equal(t, types.OrderStatusFilled, agg.Status)
+	assert.Equal(t, types.OrderStatusCancelled, own.Status)
+
+	require.Len(t, conf.SelfTradeReductions, 2)
+	assert.Equal(t, own, conf.SelfTradeReductions[0].Order)
+	assert.Equal(t, uint64(3), conf.SelfTradeReductions[0].Volume)
+	assert.Equal(t, agg, conf.SelfTradeReductions[1].Order)
+	assert.Equal(t, uint64(3), conf.SelfTradeReductions[1].Volume)
+
+	_, volume, err := book.ob.BestOfferPriceAndVolume()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), volume)
+}
+
+func testSelfTradeDecrementLargerPassive(t *testing.T) {
+	book, own, _ := setupSelfTradeBook(t, 10, 5)
+	defer book.Finish()
+
+	agg := newSelfTradeOrder("A", types.SideBuy, 4, types.OrderTimeInForceGTC, types.OrderSelfTradePreventionDecrementAndCancel)
+	conf, err := book.ob.SubmitOrder(agg)
+	require.NoError(t, err)
+	assert.Len(t, conf.Trades, 0)
+	assert.Equal(t, types.OrderStatusStopped, agg.Status)
+
+	// the resting order stays on the book with a reduced size
+	require.Len(t, conf.SelfTradeReductions, 1)
+	assert.Equal(t, own, conf.SelfTradeReductions[0].Order)
+	assert.Equal(t, uint64(4), conf.SelfTradeReductions[0].Volume)
+	assert.Equal(t, types.OrderStatusActive, own.Status)
+	assert.Equal(t, uint64(6), own.Size)
+	assert.Equal(t, uint64(6), own.Remaining)
+
+	_, volume, err := book.ob.BestOfferPriceAndVolume()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(11), volume)
+}
+
+func testSelfTradeDecrementIceberg(t *testing.T) {
+	book := getTestOrderBook(t, "testMarket")
+	defer book.Finish()
+
+	own := newSelfTradeOrder("A", types.SideSell, 10, types.OrderTimeInForceGTC, types.OrderSelfTradePreventionUnspecified)
+	own.IcebergOrder = &types.IcebergOrder{
+		PeakSize:           2,
+		MinimumVisibleSize: 1,
+	}
+	_, err := book.ob.SubmitOrder(own)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), own.Remaining)
+	require.Equal(t, uint64(8), own.IcebergOrder.ReservedRemaining)
+
+	agg := newSelfTradeOrder("A", types.SideBuy, 5, types.OrderTimeInForceGTC, types.OrderSelfTradePreventionDecrementAndCancel)
+	conf, err := book.ob.SubmitOrder(agg)
+	require.NoError(t, err)
+	assert.Len(t, conf.Trades, 0)
+	require.Len(t, conf.SelfTradeReductions, 1)
+
+	// the visible peak is untouched
+	assert.Equal(t, uint64(5), own.Size)
+	assert.Equal(t, uint64(2), own.Remaining)
+	assert.Equal(t, uint64(3), own.IcebergOrder.ReservedRemaining)
+}
+
+func testSelfTradeFOKStopped(t *testing.T) {
+	book, own, _ := setupSelfTradeBook(t, 5, 5)
+	defer book.Finish()
+
+	agg := newSelfTradeOrder("A", types.SideBuy, 5, types.OrderTimeInForceFOK, types.OrderSelfTradePreventionCancelBoth)
+	conf, err := book.ob.SubmitOrder(agg)
+	require.NoError(t, err)
+	assert.Len(t, conf.Trades, 0)
+	assert.Len(t, conf.SelfTradeReductions, 0)
+	assert.Equal(t, types.OrderStatusStopped, agg.Status)
+	assert.Equal(t, types.OrderStatusActive, own.Status)
+}
+
+func testSelfTradeFOKDecremented(t *testing.T) {
+	book, own, _ := setupSelfTradeBook(t, 3, 2)
+	defer book.Finish()
+
+	// the order can only be filled because the resting order of the party reduces its size
+	agg := newSelfTradeOrder("A", types.SideBuy, 5, types.OrderTimeInForceFOK, types.OrderSelfTradePreventionDecrementAndCancel)
+	conf, err := book.ob.SubmitOrder(agg)
+	require.NoError(t, err)
+	require.Len(t, conf.Trades, 1)
+	assert.Equal(t, uint64(2), conf.Trades[0].Size)
+	assert.Equal(t, types.OrderStatusFilled, agg.Status)
+	assert.Equal(t, types.OrderStatusCancelled, own.Status)
+}
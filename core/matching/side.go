@@ -37,6 +37,9 @@ type OrderBookSide struct {
 	log     *logging.Logger
 	levels  []*PriceLevel
 	offbook OffbookSource
+
+	// matchingAlgorithm decides how the volume of an aggressive order is shared between the orders at a price level
+	matchingAlgorithm types.MatchingAlgorithm
 }
 
 func (s *OrderBookSide) Hash() []byte {
@@ -427,40 +430,67 @@ func (s *OrderBookSide) fakeUncross(agg *types.Order, checkWashTrades bool, idea
 			checkPrice = func(levelPrice *num.Uint) bool { return levelPrice.GTE(agg.Price) }
 		}
 
+		// the volume needed to fill the order, this can shrink if self-trade prevention decrements the order
+		needed := agg.Remaining
+
 		// first check for volume between the theoretical best price and the first price level
-		_, oo := s.uncrossOffbook(len(s.levels), fake, idealPrice, true)
+		_, oo, ost, err := s.uncrossOffbook(len(s.levels), fake, idealPrice, true, checkWashTrades)
+		if err != nil {
+			return nil, err
+		}
 		for _, order := range oo {
 			totalVolumeToFill += order.Remaining
 		}
+		for _, st := range ost {
+			needed -= st.Volume
+		}
 
-		for i := len(s.levels) - 1; i >= 0; i-- {
+		for i := len(s.levels) - 1; i >= 0 && totalVolumeToFill < needed; i-- {
 			level := s.levels[i]
 			// we don't have to account for network orders, they don't apply in price monitoring
 			// nor do fees apply
 			if checkPrice(level.price) || agg.Type == types.OrderTypeMarket {
+				if isProRata(s.matchingAlgorithm) {
+					volume, _, ost, err := s.offbookAtLevel(level.price, fake, checkWashTrades)
+					if err != nil {
+						return nil, err
+					}
+					totalVolumeToFill += volume
+					for _, st := range ost {
+						needed -= st.Volume
+					}
+				}
+
 				for _, order := range level.orders {
 					if agg.Party == order.Party {
-						return nil, ErrWashTrade
+						st := preventSelfTrade(agg.SelfTradePrevention, needed-totalVolumeToFill, order)
+						if st.stopAggressive {
+							return nil, ErrWashTrade
+						}
+						needed -= st.decrementAggressive
+						continue
 					}
 					totalVolumeToFill += order.Remaining
-					if totalVolumeToFill >= agg.Remaining {
+					if totalVolumeToFill >= needed {
 						break
 					}
 				}
 
-				_, oo := s.uncrossOffbook(i, fake, idealPrice, true)
+				_, oo, ost, err := s.uncrossOffbook(i, fake, idealPrice, true, checkWashTrades)
+				if err != nil {
+					return nil, err
+				}
 				for _, order := range oo {
 					totalVolumeToFill += order.Remaining
 				}
-			}
-
-			if totalVolumeToFill >= agg.Remaining {
-				break
+				for _, st := range ost {
+					needed -= st.Volume
+				}
 			}
 		}
 
 		// FOK order could not be filled
-		if totalVolumeToFill < agg.Remaining {
+		if totalVolumeToFill < needed {
 			return nil, nil
 		}
 
@@ -483,17 +513,17 @@ func (s *OrderBookSide) fakeUncross(agg *types.Order, checkWashTrades bool, idea
 		checkPrice = func(levelPrice *num.Uint) bool { return levelPrice.LT(agg.Price) }
 	}
 
-	trades, offbookOrders = s.uncrossOffbook(idx+1, fake, idealPrice, true)
+	trades, offbookOrders, _, err = s.uncrossOffbook(idx+1, fake, idealPrice, true, checkWashTrades)
 
 	// in here we iterate from the end, as it's easier to remove the
 	// price levels from the back of the slice instead of from the front
 	// also it will allow us to reduce allocations
-	for idx >= 0 && fake.Remaining > 0 {
+	for err == nil && idx >= 0 && fake.Remaining > 0 {
 		// not a market order && buy side price is too low => break
 		if agg.Type != types.OrderTypeMarket && checkPrice(s.levels[idx].price) {
 			break
 		}
-		fake, ntrades, err = s.levels[idx].fakeUncross(fake, checkWashTrades)
+		fake, ntrades, err = s.fakeUncrossLevel(s.levels[idx], fake, checkWashTrades)
 		trades = append(trades, ntrades...)
 		// break if a wash trade is detected
 		if err != nil && err == ErrWashTrade {
@@ -501,7 +531,9 @@ func (s *OrderBookSide) fakeUncross(agg *types.Order, checkWashTrades bool, idea
 		}
 
 		if fake.Remaining != 0 {
-			obTrades, obOrders := s.uncrossOffbook(idx, fake, idealPrice, true)
+			var obTrades []*types.Trade
+			var obOrders []*types.Order
+			obTrades, obOrders, _, err = s.uncrossOffbook(idx, fake, idealPrice, true, checkWashTrades)
 			trades = append(trades, obTrades...)
 			offbookOrders = append(offbookOrders, obOrders...)
 		}
@@ -542,7 +574,7 @@ func (s *OrderBookSide) fakeUncrossAuction(orders []*types.Order, bound *num.Uin
 
 	for ; iOrder < len(orders); iOrder++ {
 		fake = orders[iOrder].Clone()
-		ntrades, _ = s.uncrossOffbook(len(s.levels), fake, bound, false)
+		ntrades, _, _, _ = s.uncrossOffbook(len(s.levels), fake, bound, false, false)
 		trades = append(trades, ntrades...)
 
 		// no more to trade in this pre-orderbook region for AMM's, we now need to move to orderbook
@@ -573,14 +605,14 @@ func (s *OrderBookSide) fakeUncrossAuction(orders []*types.Order, bound *num.Uin
 				break
 			}
 
-			_, ntrades, _, err = lvl.uncross(fake, false)
+			_, ntrades, _, _, err = s.uncrossLevel(lvl, fake, false)
 			if err != nil {
 				return nil, err
 			}
 			trades = append(trades, ntrades...)
 
 			if fake.Remaining != 0 {
-				ntrades, _ := s.uncrossOffbook(idx, fake, bound, true)
+				ntrades, _, _, _ := s.uncrossOffbook(idx, fake, bound, true, false)
 				trades = append(trades, ntrades...)
 
 				// if we couldn't consume the whole order with this AMM volume in this region
@@ -641,16 +673,44 @@ func (s *OrderBookSide) uncrossFinished() {
 	}
 }
 
-func (s *OrderBookSide) uncrossOffbook(idx int, agg *types.Order, idealPrice *num.Uint, fake bool) ([]*types.Trade, []*types.Order) {
+// uncrossOffbook uncrosses the aggressive order with the offbook volume between the price level at the given index
+// and the one before it. If checking for wash trades, the aggressive order's self-trade prevention is first applied to
+// the volume of the party's own pool and any reduction of the aggressive order is returned alongside the trades.
+func (s *OrderBookSide) uncrossOffbook(idx int, agg *types.Order, idealPrice *num.Uint, fake, checkWashTrades bool) ([]*types.Trade, []*types.Order, []*types.SelfTradeReduction, error) {
 	if s.offbook == nil {
-		return nil, nil
+		return nil, nil, nil, nil
 	}
 
 	// get the bounds between price levels for the given price level index
 	inner, outer := s.betweenLevels(idx, idealPrice, agg.Price)
 
+	// with pro-rata matching the offbook volume at the price of the next level is shared with the orders resting
+	// there, so the volume between the levels stops one tick before it
+	if isProRata(s.matchingAlgorithm) && idx > 0 && idx <= len(s.levels) {
+		_, outer = s.offbook.VolumeAtPrice(agg, outer)
+		if inner != nil && ((agg.Side == types.SideBuy && outer.LT(inner)) || (agg.Side == types.SideSell && outer.GT(inner))) {
+			return nil, nil, nil, nil
+		}
+	}
+
+	// the offbook source always leaves the party's own pool out of the match when the order has self-trade
+	// prevention set, so it is handed an order without it when we're not checking for wash trades
+	var selfTrades []*types.SelfTradeReduction
+	submitted := agg
+	if agg.SelfTradePrevention != types.OrderSelfTradePreventionUnspecified {
+		if checkWashTrades {
+			var err error
+			if selfTrades, err = s.preventOffbookSelfTrade(agg, inner, outer); err != nil {
+				return nil, nil, selfTrades, err
+			}
+		} else {
+			submitted = agg.Clone()
+			submitted.SelfTradePrevention = types.OrderSelfTradePreventionUnspecified
+		}
+	}
+
 	// submit the order to the offbook source for volume between those bounds
-	orders := s.offbook.SubmitOrder(agg, inner, outer)
+	orders := s.offbook.SubmitOrder(submitted, inner, outer)
 
 	trades := make([]*types.Trade, 0, len(orders))
 	for _, o := range orders {
@@ -663,16 +723,39 @@ func (s *OrderBookSide) uncrossOffbook(idx int, agg *types.Order, idealPrice *nu
 		trades = append(trades, trade)
 	}
 
-	return trades, orders
+	return trades, orders, selfTrades, nil
+}
+
+// preventOffbookSelfTrade applies the aggressive order's self-trade prevention to the volume the party's own pool
+// would trade with it between the bounds, as if the pool's volume was resting ahead of the rest of the offbook volume.
+// The pool's volume is not on the book so it is never cancelled or decremented, it is only left out of the match.
+func (s *OrderBookSide) preventOffbookSelfTrade(agg *types.Order, inner, outer *num.Uint) ([]*types.SelfTradeReduction, error) {
+	own := s.offbook.SelfTradeOrder(agg, inner, outer)
+	if own == nil {
+		return nil, nil
+	}
+
+	var selfTrades []*types.SelfTradeReduction
+	st := preventSelfTrade(agg.SelfTradePrevention, agg.Remaining, own)
+	if st.decrementAggressive > 0 {
+		decrementOrder(agg, st.decrementAggressive)
+		selfTrades = append(selfTrades, &types.SelfTradeReduction{Order: agg, Volume: st.decrementAggressive})
+	}
+	if st.stopAggressive {
+		return selfTrades, ErrWashTrade
+	}
+	return selfTrades, nil
 }
 
 // uncross returns trades after order book side gets uncrossed with the agg order supplied,
 // checkWashTrades checks non-FOK orders for wash trades if set to true (FOK orders are always checked for wash trades).
-func (s *OrderBookSide) uncross(agg *types.Order, checkWashTrades bool, theoreticalBestTrade *num.Uint) ([]*types.Trade, []*types.Order, *num.Uint, error) {
+// Any orders cancelled or decremented by self-trade prevention are returned alongside the trades.
+func (s *OrderBookSide) uncross(agg *types.Order, checkWashTrades bool, theoreticalBestTrade *num.Uint) ([]*types.Trade, []*types.Order, []*types.SelfTradeReduction, *num.Uint, error) {
 	defer s.uncrossFinished()
 	var (
 		trades            []*types.Trade
 		impactedOrders    []*types.Order
+		selfTrades        []*types.SelfTradeReduction
 		lastTradedPrice   = num.UintZero()
 		totalVolumeToFill uint64
 		checkPrice        func(*num.Uint) bool
@@ -687,47 +770,80 @@ func (s *OrderBookSide) uncross(agg *types.Order, checkWashTrades bool, theoreti
 	}
 
 	if agg.TimeInForce == types.OrderTimeInForceFOK {
-		_, oo := s.uncrossOffbook(len(s.levels), fake, theoreticalBestTrade, true)
+		// the volume needed to fill the order, this can shrink if self-trade prevention decrements the order
+		needed := agg.Remaining
+
+		_, oo, ost, err := s.uncrossOffbook(len(s.levels), fake, theoreticalBestTrade, true, checkWashTrades)
+		if err != nil {
+			agg.Status = types.OrderStatusStopped
+			return nil, nil, nil, lastTradedPrice, err
+		}
 		for _, order := range oo {
 			totalVolumeToFill += order.Remaining
 		}
+		for _, st := range ost {
+			needed -= st.Volume
+		}
 
 		// Process these backwards
-		for i := len(s.levels) - 1; i >= 0; i-- {
+		for i := len(s.levels) - 1; i >= 0 && totalVolumeToFill < needed; i-- {
 			level := s.levels[i]
 			if checkPrice(level.price) || agg.Type == types.OrderTypeMarket || agg.Type == types.OrderTypeNetwork {
+				// with pro-rata matching the offbook volume at the level's price trades alongside the orders
+				if isProRata(s.matchingAlgorithm) {
+					volume, _, ost, err := s.offbookAtLevel(level.price, fake, checkWashTrades)
+					if err != nil {
+						agg.Status = types.OrderStatusStopped
+						return nil, nil, nil, lastTradedPrice, err
+					}
+					totalVolumeToFill += volume
+					for _, st := range ost {
+						needed -= st.Volume
+					}
+				}
+
 				// We have to process every order to check for wash trades
 				for _, order := range level.orders {
-					// Check for wash trading
+					// Check for wash trading, if the order would be stopped by it we stop it now
+					// so that a FOK order never partially fills
 					if agg.Party == order.Party {
-						// Stop the order and return
-						agg.Status = types.OrderStatusStopped
-						return nil, nil, lastTradedPrice, ErrWashTrade
+						st := preventSelfTrade(agg.SelfTradePrevention, needed-totalVolumeToFill, order)
+						if st.stopAggressive {
+							// Stop the order and return
+							agg.Status = types.OrderStatusStopped
+							return nil, nil, nil, lastTradedPrice, ErrWashTrade
+						}
+						needed -= st.decrementAggressive
+						continue
 					}
 					// in case of network trades, we want to calculate an accurate average price to return
 					totalVolumeToFill += order.Remaining
 
-					_, oo := s.uncrossOffbook(i, fake, theoreticalBestTrade, true)
+					_, oo, ost, err := s.uncrossOffbook(i, fake, theoreticalBestTrade, true, checkWashTrades)
+					if err != nil {
+						agg.Status = types.OrderStatusStopped
+						return nil, nil, nil, lastTradedPrice, err
+					}
 					for _, order := range oo {
 						totalVolumeToFill += order.Remaining
 					}
+					for _, st := range ost {
+						needed -= st.Volume
+					}
 
-					if totalVolumeToFill >= agg.Remaining {
+					if totalVolumeToFill >= needed {
 						break
 					}
 				}
 			}
-			if totalVolumeToFill >= agg.Remaining {
-				break
-			}
 		}
 
 		if s.log.GetLevel() == logging.DebugLevel {
-			s.log.Debug(fmt.Sprintf("totalVolumeToFill %d until price %d, remaining %d\n", totalVolumeToFill, agg.Price, agg.Remaining))
+			s.log.Debug(fmt.Sprintf("totalVolumeToFill %d until price %d, remaining %d\n", totalVolumeToFill, agg.Price, needed))
 		}
 
-		if totalVolumeToFill < agg.Remaining {
-			return trades, impactedOrders, lastTradedPrice, nil
+		if totalVolumeToFill < needed {
+			return trades, impactedOrders, nil, lastTradedPrice, nil
 		}
 
 		// reset the offsource book so we can then do it all again....
@@ -739,21 +855,23 @@ func (s *OrderBookSide) uncross(agg *types.Order, checkWashTrades bool, theoreti
 		filled  bool
 		ntrades []*types.Trade
 		nimpact []*types.Order
+		nself   []*types.SelfTradeReduction
 		err     error
 	)
 
 	// first check for off source volume between the best theoretical price and the first price level
-	trades, impactedOrders = s.uncrossOffbook(idx+1, agg, theoreticalBestTrade, false)
+	trades, impactedOrders, selfTrades, err = s.uncrossOffbook(idx+1, agg, theoreticalBestTrade, false, checkWashTrades)
 	filled = agg.Remaining == 0
 
 	// in here we iterate from the end, as it's easier to remove the
 	// price levels from the back of the slice instead of from the front
 	// also it will allow us to reduce allocations
-	for !filled && idx >= 0 {
+	for !filled && err == nil && idx >= 0 {
 		if checkPrice(s.levels[idx].price) || agg.Type == types.OrderTypeMarket || agg.Type == types.OrderTypeNetwork {
-			filled, ntrades, nimpact, err = s.levels[idx].uncross(agg, checkWashTrades)
+			filled, ntrades, nimpact, nself, err = s.uncrossLevel(s.levels[idx], agg, checkWashTrades)
 			trades = append(trades, ntrades...)
 			impactedOrders = append(impactedOrders, nimpact...)
+			selfTrades = append(selfTrades, nself...)
 			// break if a wash trade is detected
 			if err != nil && err == ErrWashTrade {
 				break
@@ -761,9 +879,14 @@ func (s *OrderBookSide) uncross(agg *types.Order, checkWashTrades bool, theoreti
 
 			if !filled {
 				// now check for off source volume between the price levels
-				ot, oo := s.uncrossOffbook(idx, agg, theoreticalBestTrade, false)
+				var (
+					ot []*types.Trade
+					oo []*types.Order
+				)
+				ot, oo, nself, err = s.uncrossOffbook(idx, agg, theoreticalBestTrade, false, checkWashTrades)
 				trades = append(trades, ot...)
 				impactedOrders = append(impactedOrders, oo...)
+				selfTrades = append(selfTrades, nself...)
 				filled = agg.Remaining == 0
 			}
 
@@ -808,7 +931,7 @@ func (s *OrderBookSide) uncross(agg *types.Order, checkWashTrades bool, theoreti
 	if len(trades) > 0 {
 		lastTradedPrice = trades[len(trades)-1].Price.Clone()
 	}
-	return trades, impactedOrders, lastTradedPrice, err
+	return trades, impactedOrders, selfTrades, lastTradedPrice, err
 }
 
 func (s *OrderBookSide) getLevels() []*PriceLevel {
@@ -449,7 +449,7 @@ func TestFakeUncrossNormal(t *testing.T) {
 	assert.Len(t, fakeTrades, 5)
 	assert.NoError(t, err)
 
-	trades, _, _, err := buySide.uncross(&order, checkWashTrades, nil)
+	trades, _, _, _, err := buySide.uncross(&order, checkWashTrades, nil)
 	assert.Len(t, trades, 5)
 	assert.NoError(t, err)
 
@@ -478,7 +478,7 @@ func TestFakeUncrossSelfTradeFOKMarketOrder(t *testing.T) {
 	assert.Len(t, fakeTrades, 0)
 	assert.Error(t, err1)
 
-	trades, _, _, err2 := buySide.uncross(&order, checkWashTrades, nil)
+	trades, _, _, _, err2 := buySide.uncross(&order, checkWashTrades, nil)
 	assert.Len(t, trades, 0)
 	assert.Error(t, err2)
 
@@ -506,7 +506,7 @@ func TestFakeUncrossSelfTradeNonFOKLimitOrder_DontCheckWashTrades(t *testing.T)
 	assert.NoError(t, err)
 	assert.Equal(t, fakeTrades[0].SellOrder, order.ID)
 
-	trades, _, _, err := buySide.uncross(&order, checkWashTrades, nil)
+	trades, _, _, _, err := buySide.uncross(&order, checkWashTrades, nil)
 	assert.Len(t, trades, 1)
 	assert.NoError(t, err)
 
@@ -534,7 +534,7 @@ func TestFakeUncrossSelfTradeNonFOKLimitOrder_CheckWashTrades(t *testing.T) {
 	assert.Error(t, err1)
 	assert.Equal(t, "party attempted to submit wash trade", err1.Error())
 
-	trades, _, _, err2 := buySide.uncross(&order, checkWashTrades, nil)
+	trades, _, _, _, err2 := buySide.uncross(&order, checkWashTrades, nil)
 	assert.Len(t, trades, 0)
 	assert.Error(t, err2)
 	assert.Equal(t, "party attempted to submit wash trade", err2.Error())
@@ -560,7 +560,7 @@ func TestFakeUncrossNotEnoughVolume(t *testing.T) {
 	assert.Len(t, fakeTrades, 0)
 	assert.NoError(t, err)
 
-	trades, _, _, err := buySide.uncross(&order, checkWashTrades, nil)
+	trades, _, _, _, err := buySide.uncross(&order, checkWashTrades, nil)
 	assert.Len(t, trades, 0)
 	assert.NoError(t, err)
 }
@@ -600,7 +600,7 @@ func TestFakeUncrossAuction(t *testing.T) {
 
 	trades := []*types.Trade{}
 	for _, order := range orders {
-		trds, _, _, err := buySide.uncross(order, false, nil)
+		trds, _, _, _, err := buySide.uncross(order, false, nil)
 		assert.NoError(t, err)
 		trades = append(trades, trds...)
 	}
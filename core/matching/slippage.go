@@ -0,0 +1,117 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package matching
+
+import (
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/ptr"
+)
+
+// basisPoints is the number of basis points in 100%.
+var basisPoints = num.NewUint(10000)
+
+// SetMarkPriceSource sets the function returning the mark price of the market, which is used
+// as the reference price for the slippage of a market order when the opposite side of the book is empty.
+func (b *OrderBook) SetMarkPriceSource(markPrice func() *num.Uint) {
+	b.markPrice = markPrice
+}
+
+// slippageReferencePrice returns the price the slippage of the given order is measured from,
+// that is the best price on the opposite side of the book at the time the order is executed,
+// or the mark price if that side of the book is empty.
+func (b *OrderBook) slippageReferencePrice(order *types.Order) *num.Uint {
+	if price, _, err := b.getOppositeSide(order.Side).BestPriceAndVolume(); err == nil {
+		return price
+	}
+	if b.markPrice != nil {
+		if price := b.markPrice(); price != nil && !price.IsZero() {
+			return price.Clone()
+		}
+	}
+	return nil
+}
+
+// slippageBound returns the worst price the order can trade at without exceeding its maximum slippage
+// from the reference price.
+func slippageBound(order *types.Order, reference *num.Uint) *num.Uint {
+	maxSlippage := num.NewUint(*order.MaxSlippage)
+	if order.Side == types.SideBuy {
+		bound := num.UintZero().Add(basisPoints, maxSlippage)
+		bound.Mul(bound, reference)
+		return bound.Div(bound, basisPoints)
+	}
+
+	// a sell order can always trade down to a price of zero
+	if maxSlippage.GTE(basisPoints) {
+		return num.UintZero()
+	}
+	// round up so the order never trades below the bound
+	bound := num.UintZero().Sub(basisPoints, maxSlippage)
+	bound.Mul(bound, reference)
+	bound.Add(bound, num.UintZero().Sub(basisPoints, num.UintOne()))
+	return bound.Div(bound, basisPoints)
+}
+
+// applySlippageBound makes a market order with a maximum slippage behave like a limit order priced
+// at its slippage bound, so that it stops trading before going beyond it. The returned function turns
+// the order back into a market order and returns the reference price the bound was computed from,
+// which is nil if the order has no bound. False is returned if the order has a maximum slippage but
+// there is no reference price to measure it from, in which case the order must not trade.
+func (b *OrderBook) applySlippageBound(order *types.Order) (func() *num.Uint, bool) {
+	if order.Type != types.OrderTypeMarket || order.MaxSlippage == nil {
+		return func() *num.Uint { return nil }, true
+	}
+
+	reference := b.slippageReferencePrice(order)
+	if reference == nil {
+		return func() *num.Uint { return nil }, false
+	}
+
+	price := order.Price
+	order.Type = types.OrderTypeLimit
+	order.Price = slippageBound(order, reference)
+	return func() *num.Uint {
+		order.Type = types.OrderTypeMarket
+		order.Price = price
+		return reference
+	}, true
+}
+
+// setAchievedSlippage records on the order the slippage, in basis points, of the average price of its trades
+// from the reference price. Trades at a better price than the reference price give no slippage.
+func setAchievedSlippage(order *types.Order, reference *num.Uint, trades []*types.Trade) {
+	if reference == nil || len(trades) == 0 {
+		return
+	}
+
+	notional, volume := num.UintZero(), num.UintZero()
+	for _, t := range trades {
+		notional.AddSum(num.UintZero().Mul(t.Price, num.NewUint(t.Size)))
+		volume.AddSum(num.NewUint(t.Size))
+	}
+	referenceNotional := num.UintZero().Mul(reference, volume)
+
+	slippage := num.UintZero()
+	if order.Side == types.SideBuy && notional.GT(referenceNotional) {
+		slippage.Sub(notional, referenceNotional)
+	} else if order.Side == types.SideSell && referenceNotional.GT(notional) {
+		slippage.Sub(referenceNotional, notional)
+	}
+	slippage.Mul(slippage, basisPoints)
+	slippage.Div(slippage, referenceNotional)
+	order.AchievedSlippage = ptr.From(slippage.Uint64())
+}
@@ -0,0 +1,218 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package matching
+
+import (
+	"fmt"
+	"testing"
+
+	"code.vegaprotocol.io/vega/core/matching/mocks"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/ptr"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderBook_MarketOrderMaxSlippage(t *testing.T) {
+	t.Run("IOC order stops before trading beyond its slippage bound", testIOCOrderStopsAtSlippageBound)
+	t.Run("FOK order which cannot fill within its slippage bound is stopped", testFOKOrderStoppedAtSlippageBound)
+	t.Run("Slippage bound moves with the book", testSlippageBoundMovesWithBook)
+	t.Run("Potential trades are capped by the slippage bound", testGetTradesCappedBySlippageBound)
+	t.Run("Mark price is the reference price when the opposite side is empty", testMarkPriceIsSlippageReference)
+	t.Run("Slippage bound is rounded inside the maximum slippage", testSlippageBoundRounding)
+	t.Run("Order without a reference price is stopped", testNoSlippageReferenceStopsOrder)
+}
+
+func submitSlippageTestOrders(t *testing.T, book *tstOB, side types.Side, prices, sizes []uint64) {
+	t.Helper()
+	for i := range prices {
+		_, err := book.SubmitOrder(&types.Order{
+			ID:            fmt.Sprintf("%064d", len(book.ordersByID)+1),
+			MarketID:      book.marketID,
+			Party:         "passive",
+			Side:          side,
+			Price:         num.NewUint(prices[i]),
+			OriginalPrice: num.NewUint(prices[i]),
+			Size:          sizes[i],
+			Remaining:     sizes[i],
+			TimeInForce:   types.OrderTimeInForceGTC,
+			Type:          types.OrderTypeLimit,
+		})
+		require.NoError(t, err)
+	}
+}
+
+func newSlippageTestOrder(side types.Side, size uint64, tif types.OrderTimeInForce, maxSlippage uint64) *types.Order {
+	return &types.Order{
+		ID:          fmt.Sprintf("%064s", "aggressive"),
+		MarketID:    "testMarket",
+		Party:       "aggressive",
+		Side:        side,
+		Price:       num.UintZero(),
+		Size:        size,
+		Remaining:   size,
+		TimeInForce: tif,
+		Type:        types.OrderTypeMarket,
+		MaxSlippage: ptr.From(maxSlippage),
+	}
+}
+
+func testIOCOrderStopsAtSlippageBound(t *testing.T) {
+	book := getTestOrderBook(t, "testMarket")
+	defer book.Finish()
+	submitSlippageTestOrders(t, book, types.SideSell, []uint64{100, 104, 106}, []uint64{2, 2, 5})
+
+	// 5% from the best ask of 100 gives a bound of 105, so the level at 106 is never reached
+	order := newSlippageTestOrder(types.SideBuy, 10, types.OrderTimeInForceIOC, 500)
+	confirm, err := book.SubmitOrder(order)
+	require.NoError(t, err)
+
+	require.Len(t, confirm.Trades, 2)
+	assert.Equal(t, uint64(6), confirm.Order.Remaining)
+	assert.Equal(t, types.OrderStatusPartiallyFilled, confirm.Order.Status)
+	// the order is left as a market order
+	assert.Equal(t, types.OrderTypeMarket, confirm.Order.Type)
+	assert.True(t, confirm.Order.Price.IsZero())
+	// average price of 102 is 2% away from the best ask
+	require.NotNil(t, confirm.Order.AchievedSlippage)
+	assert.Equal(t, uint64(200), *confirm.Order.AchievedSlippage)
+
+	best, err := book.GetBestAskPrice()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(106), best.Uint64())
+}
+
+func testFOKOrderStoppedAtSlippageBound(t *testing.T) {
+	book := getTestOrderBook(t, "testMarket")
+	defer book.Finish()
+	submitSlippageTestOrders(t, book, types.SideBuy, []uint64{100, 98, 95}, []uint64{2, 2, 5})
+
+	// 3% from the best bid of 100 gives a bound of 97, with only 4 available above it
+	order := newSlippageTestOrder(types.SideSell, 5, types.OrderTimeInForceFOK, 300)
+	confirm, err := book.SubmitOrder(order)
+	require.NoError(t, err)
+
+	assert.Empty(t, confirm.Trades)
+	assert.Equal(t, types.OrderStatusStopped, confirm.Order.Status)
+	assert.Nil(t, confirm.Order.AchievedSlippage)
+
+	// the same order with a 5% bound fills
+	order = newSlippageTestOrder(types.SideSell, 5, types.OrderTimeInForceFOK, 500)
+	confirm, err = book.SubmitOrder(order)
+	require.NoError(t, err)
+
+	require.Len(t, confirm.Trades, 3)
+	assert.Equal(t, types.OrderStatusFilled, confirm.Order.Status)
+	// average price of 98.2 is 1.8% away from the best bid
+	require.NotNil(t, confirm.Order.AchievedSlippage)
+	assert.Equal(t, uint64(180), *confirm.Order.AchievedSlippage)
+}
+
+func testSlippageBoundMovesWithBook(t *testing.T) {
+	book := getTestOrderBook(t, "testMarket")
+	defer book.Finish()
+	submitSlippageTestOrders(t, book, types.SideSell, []uint64{100, 110}, []uint64{1, 5})
+
+	// 10% from 100 reaches the level at 110
+	order := newSlippageTestOrder(types.SideBuy, 3, types.OrderTimeInForceIOC, 1000)
+	confirm, err := book.SubmitOrder(order)
+	require.NoError(t, err)
+	assert.Len(t, confirm.Trades, 2)
+	assert.Equal(t, types.OrderStatusFilled, confirm.Order.Status)
+
+	// the best ask is now 110, so the same slippage allows trading up to 121
+	submitSlippageTestOrders(t, book, types.SideSell, []uint64{120}, []uint64{5})
+	order = newSlippageTestOrder(types.SideBuy, 9, types.OrderTimeInForceIOC, 1000)
+	confirm, err = book.SubmitOrder(order)
+	require.NoError(t, err)
+	assert.Len(t, confirm.Trades, 2)
+	assert.Equal(t, uint64(1), confirm.Order.Remaining)
+}
+
+func testGetTradesCappedBySlippageBound(t *testing.T) {
+	book := getTestOrderBook(t, "testMarket")
+	defer book.Finish()
+	submitSlippageTestOrders(t, book, types.SideSell, []uint64{100, 101, 120}, []uint64{1, 1, 5})
+
+	order := newSlippageTestOrder(types.SideBuy, 5, types.OrderTimeInForceIOC, 100)
+	trades, err := book.GetTrades(order)
+	require.NoError(t, err)
+	assert.Len(t, trades, 2)
+
+	// the order passed in is left untouched
+	assert.Equal(t, types.OrderTypeMarket, order.Type)
+	assert.True(t, order.Price.IsZero())
+	assert.Nil(t, order.AchievedSlippage)
+}
+
+func testNoSlippageReferenceStopsOrder(t *testing.T) {
+	book := getTestOrderBook(t, "testMarket")
+	defer book.Finish()
+	// the book is empty and there is no mark price, only AMM volume could be traded. Any call to the
+	// AMM would fail the test as the mock has no expectations.
+	book.SetOffbookSource(mocks.NewMockOffbookSource(gomock.NewController(t)))
+	order := newSlippageTestOrder(types.SideBuy, 5, types.OrderTimeInForceIOC, 100)
+
+	trades, err := book.GetTrades(order)
+	require.NoError(t, err)
+	assert.Empty(t, trades)
+
+	confirm, err := book.SubmitOrder(order)
+	require.NoError(t, err)
+	assert.Empty(t, confirm.Trades)
+	assert.Equal(t, types.OrderStatusStopped, confirm.Order.Status)
+	assert.Equal(t, types.OrderTypeMarket, confirm.Order.Type)
+	assert.Nil(t, confirm.Order.AchievedSlippage)
+}
+
+func testMarkPriceIsSlippageReference(t *testing.T) {
+	book := getTestOrderBook(t, "testMarket")
+	defer book.Finish()
+
+	order := newSlippageTestOrder(types.SideBuy, 5, types.OrderTimeInForceIOC, 100)
+	// no price to measure the slippage from
+	assert.Nil(t, book.slippageReferencePrice(order))
+
+	book.SetMarkPriceSource(func() *num.Uint { return num.NewUint(200) })
+	assert.Equal(t, uint64(200), book.slippageReferencePrice(order).Uint64())
+
+	// the best price on the book takes precedence
+	submitSlippageTestOrders(t, book, types.SideSell, []uint64{210}, []uint64{1})
+	assert.Equal(t, uint64(210), book.slippageReferencePrice(order).Uint64())
+}
+
+func testSlippageBoundRounding(t *testing.T) {
+	cases := []struct {
+		side        types.Side
+		maxSlippage uint64
+		reference   uint64
+		bound       uint64
+	}{
+		{side: types.SideBuy, maxSlippage: 150, reference: 99, bound: 100},
+		{side: types.SideBuy, maxSlippage: 0, reference: 99, bound: 99},
+		{side: types.SideSell, maxSlippage: 150, reference: 99, bound: 98},
+		{side: types.SideSell, maxSlippage: 10000, reference: 99, bound: 0},
+		{side: types.SideSell, maxSlippage: 20000, reference: 99, bound: 0},
+	}
+
+	for _, c := range cases {
+		order := newSlippageTestOrder(c.side, 1, types.OrderTimeInForceIOC, c.maxSlippage)
+		assert.Equal(t, c.bound, slippageBound(order, num.NewUint(c.reference)).Uint64())
+	}
+}
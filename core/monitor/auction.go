@@ -45,11 +45,16 @@ func NewAuctionState(mkt *types.Market, now time.Time) *AuctionState {
 		start:   true,
 		m:       mkt,
 	}
+	if mkt.FrequentBatchAuction != nil {
+		s.defMode = types.MarketTradingModeBatchAuction
+	}
 	// no opening auction
 	if mkt.OpeningAuction == nil {
 		s.mode = s.defMode
 		if s.mode == types.MarketTradingModeBatchAuction {
-			// @TODO set end params here (FBA is not yet implemented)
+			// no opening auction, go straight into the first batch
+			s.trigger = types.AuctionTriggerBatch
+			s.end = &types.AuctionDuration{Duration: mkt.FrequentBatchAuction.BatchDuration}
 			return &s
 		}
 		// no opening auction
@@ -105,6 +110,16 @@ func (a *AuctionState) StartPriceAuction(t time.Time, d *types.AuctionDuration)
 	a.end = d
 }
 
+// StartIndexDivergenceAuction - set the state to start an auction triggered by the traded price diverging from the index price.
+func (a *AuctionState) StartIndexDivergenceAuction(t time.Time, d *types.AuctionDuration) {
+	a.mode = types.MarketTradingModeMonitoringAuction
+	a.trigger = types.AuctionTriggerIndexDivergence
+	a.start = true
+	a.stop = false
+	a.begin = &t
+	a.end = d
+}
+
 func (a *AuctionState) StartLongBlockAuction(t time.Time, d int64) {
 	a.mode = types.MarketTradingModelLongBlockAuction
 	a.trigger = types.AuctionTriggerLongBlock
@@ -146,6 +161,22 @@ func (a *AuctionState) EndGovernanceSuspensionAuction() {
 	}
 }
 
+// StartBatchAuction - set the state to start the next batch of a market trading in frequent batch auctions.
+func (a *AuctionState) StartBatchAuction(t time.Time, d int64) {
+	a.mode = types.MarketTradingModeBatchAuction
+	a.trigger = types.AuctionTriggerBatch
+	a.start = true
+	a.stop = false
+	a.begin = &t
+	a.end = &types.AuctionDuration{Duration: d}
+}
+
+// UpdateDefaultMode sets the trading mode the market returns to when leaving an auction,
+// this is batch auction for markets trading in frequent batch auctions.
+func (a *AuctionState) UpdateDefaultMode(mode types.MarketTradingMode) {
+	a.defMode = mode
+}
+
 // StartOpeningAuction - set the state to start an opening auction (used for testing)
 // @TODO these functions will be removed once the types are in proto.
 func (a *AuctionState) StartOpeningAuction(t time.Time, d *types.AuctionDuration) {
@@ -165,6 +196,14 @@ func (a *AuctionState) ExtendAuctionPrice(delta types.AuctionDuration) {
 	a.ExtendAuction(delta)
 }
 
+// ExtendAuctionIndexDivergence - call from price monitoring to extend the auction
+// when the traded price diverges from the index price.
+func (a *AuctionState) ExtendAuctionIndexDivergence(delta types.AuctionDuration) {
+	t := types.AuctionTriggerIndexDivergence
+	a.extension = &t
+	a.ExtendAuction(delta)
+}
+
 func (a *AuctionState) ExtendAuctionLongBlock(delta types.AuctionDuration) {
 	t := types.AuctionTriggerLongBlock
 	if a.trigger != t {
@@ -257,11 +296,11 @@ func (a AuctionState) IsOpeningAuction() bool {
 }
 
 func (a AuctionState) IsPriceAuction() bool {
-	return a.trigger == types.AuctionTriggerPrice
+	return a.trigger == types.AuctionTriggerPrice || a.trigger == types.AuctionTriggerIndexDivergence
 }
 
 func (a AuctionState) IsPriceExtension() bool {
-	return a.extension != nil && *a.extension == types.AuctionTriggerPrice
+	return a.extension != nil && (*a.extension == types.AuctionTriggerPrice || *a.extension == types.AuctionTriggerIndexDivergence)
 }
 
 func (a AuctionState) IsFBA() bool {
@@ -273,7 +312,7 @@ func (a AuctionState) IsMonitorAuction() bool {
 	// FIXME(jeremy): the second part of the condition is to support
 	// the compatibility on 72 > 73 snapshots.
 
-	return a.trigger == types.AuctionTriggerPrice || a.trigger == types.AuctionTriggerLiquidityTargetNotMet || a.trigger == types.AuctionTriggerUnableToDeployLPOrders
+	return a.trigger == types.AuctionTriggerPrice || a.trigger == types.AuctionTriggerIndexDivergence || a.trigger == types.AuctionTriggerLiquidityTargetNotMet || a.trigger == types.AuctionTriggerUnableToDeployLPOrders
 }
 
 // CanLeave bool indicating whether auction should be closed or not, if true, we can still extend the auction
@@ -335,10 +374,6 @@ func (a *AuctionState) Left(ctx context.Context, now time.Time) *events.Auction
 	a.trigger = types.AuctionTriggerUnspecified
 	a.extension = nil
 	a.mode = a.defMode
-	// default mode is auction, this is an FBA market
-	if a.mode == types.MarketTradingModeBatchAuction {
-		a.trigger = types.AuctionTriggerBatch
-	}
 	return evt
 }
 
@@ -0,0 +1,58 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package price
+
+import (
+	"sort"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+)
+
+// EstimateBounds returns the price ranges the given risk model and price monitoring settings
+// would produce around the reference price, one per trigger. It doesn't need a running engine,
+// so it can be used to assess settings before they are applied to a market.
+func EstimateBounds(riskModel RangeProvider, settings *types.PriceMonitoringSettings, referencePrice num.Decimal) ([]*types.PriceMonitoringBounds, error) {
+	if riskModel == nil {
+		return nil, ErrNilRangeProvider
+	}
+	if settings == nil || settings.Parameters == nil {
+		return nil, ErrNilPriceMonitoringSettings
+	}
+
+	horizons, bounds := computeBoundsAndHorizons(settings, true)
+	ret := make([]*types.PriceMonitoringBounds, 0, len(bounds))
+	for _, b := range bounds {
+		min, max := riskModel.PriceRange(referencePrice, horizons[b.Trigger.Horizon], b.Trigger.Probability)
+		ret = append(ret, &types.PriceMonitoringBounds{
+			MinValidPrice:  wrapPriceRange(min, true).Representation(),
+			MaxValidPrice:  wrapPriceRange(max, false).Representation(),
+			Trigger:        b.Trigger,
+			ReferencePrice: referencePrice,
+			Active:         b.Active,
+		})
+	}
+
+	sort.SliceStable(ret,
+		func(i, j int) bool {
+			if ret[i].Trigger.Horizon == ret[j].Trigger.Horizon {
+				return ret[i].Trigger.Probability.LessThan(ret[j].Trigger.Probability)
+			}
+			return ret[i].Trigger.Horizon < ret[j].Trigger.Horizon
+		})
+
+	return ret, nil
+}
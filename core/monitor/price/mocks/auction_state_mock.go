@@ -92,6 +92,18 @@ func (mr *MockAuctionStateMockRecorder) ExpiresAt() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpiresAt", reflect.TypeOf((*MockAuctionState)(nil).ExpiresAt))
 }
 
+// ExtendAuctionIndexDivergence mocks base method.
+func (m *MockAuctionState) ExtendAuctionIndexDivergence(arg0 types.AuctionDuration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ExtendAuctionIndexDivergence", arg0)
+}
+
+// ExtendAuctionIndexDivergence indicates an expected call of ExtendAuctionIndexDivergence.
+func (mr *MockAuctionStateMockRecorder) ExtendAuctionIndexDivergence(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendAuctionIndexDivergence", reflect.TypeOf((*MockAuctionState)(nil).ExtendAuctionIndexDivergence), arg0)
+}
+
 // ExtendAuctionPrice mocks base method.
 func (m *MockAuctionState) ExtendAuctionPrice(arg0 types.AuctionDuration) {
 	m.ctrl.T.Helper()
@@ -214,6 +226,18 @@ func (mr *MockAuctionStateMockRecorder) Start() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockAuctionState)(nil).Start))
 }
 
+// StartIndexDivergenceAuction mocks base method.
+func (m *MockAuctionState) StartIndexDivergenceAuction(arg0 time.Time, arg1 *types.AuctionDuration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StartIndexDivergenceAuction", arg0, arg1)
+}
+
+// StartIndexDivergenceAuction indicates an expected call of StartIndexDivergenceAuction.
+func (mr *MockAuctionStateMockRecorder) StartIndexDivergenceAuction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartIndexDivergenceAuction", reflect.TypeOf((*MockAuctionState)(nil).StartIndexDivergenceAuction), arg0, arg1)
+}
+
 // StartPriceAuction mocks base method.
 func (m *MockAuctionState) StartPriceAuction(arg0 time.Time, arg1 *types.AuctionDuration) {
 	m.ctrl.T.Helper()
@@ -63,7 +63,9 @@ type AuctionState interface {
 	AuctionStart() bool
 	// start a price-related auction, extend a current auction, or end it
 	StartPriceAuction(t time.Time, d *types.AuctionDuration)
+	StartIndexDivergenceAuction(t time.Time, d *types.AuctionDuration)
 	ExtendAuctionPrice(delta types.AuctionDuration)
+	ExtendAuctionIndexDivergence(delta types.AuctionDuration)
 	SetReadyToLeave()
 	// get parameters for current auction
 	Start() time.Time
@@ -136,6 +138,11 @@ type Engine struct {
 
 	boundFactorsInitialised bool
 
+	// indexDivergence is the optional trigger on the divergence of the traded price from the index price.
+	indexDivergence       *types.PriceMonitoringIndexDivergence
+	indexDivergenceActive bool
+	indexPrice            func() *num.Uint
+
 	stateChanged   bool
 	stateVarEngine StateVarEngine
 	market         string
@@ -144,7 +151,8 @@ type Engine struct {
 
 func (e *Engine) UpdateSettings(riskModel risk.Model, settings *types.PriceMonitoringSettings, as AuctionState) {
 	e.riskModel = riskModel
-	e.fpHorizons, e.bounds = computeBoundsAndHorizons(settings, as)
+	e.fpHorizons, e.bounds = computeBoundsAndHorizons(settings, !as.IsPriceAuction())
+	e.indexDivergence = indexDivergenceFromSettings(settings)
 	e.initialised = false
 	e.boundFactorsInitialised = false
 	e.priceRangesCache = make(map[int]priceRange, len(e.bounds)) // clear the cache
@@ -169,7 +177,7 @@ func NewMonitor(asset, mktID string, riskModel RangeProvider, auctionState Aucti
 	}
 
 	// Other functions depend on this sorting
-	horizons, bounds := computeBoundsAndHorizons(settings, auctionState)
+	horizons, bounds := computeBoundsAndHorizons(settings, !auctionState.IsPriceAuction())
 
 	e := &Engine{
 		riskModel:               riskModel,
@@ -179,6 +187,8 @@ func NewMonitor(asset, mktID string, riskModel RangeProvider, auctionState Aucti
 		stateChanged:            true,
 		stateVarEngine:          stateVarEngine,
 		boundFactorsInitialised: false,
+		indexDivergence:         indexDivergenceFromSettings(settings),
+		indexDivergenceActive:   true,
 		log:                     log,
 		market:                  mktID,
 		asset:                   asset,
@@ -193,6 +203,11 @@ func (e *Engine) SetMinDuration(d time.Duration) {
 	e.stateChanged = true
 }
 
+// SetIndexPriceProvider sets the function returning the current index price the traded price is checked against.
+func (e *Engine) SetIndexPriceProvider(f func() *num.Uint) {
+	e.indexPrice = f
+}
+
 // GetHorizonYearFractions returns horizons of all the triggers specified, expressed as year fraction, sorted in ascending order.
 func (e *Engine) GetHorizonYearFractions() []num.Decimal {
 	h := make([]num.Decimal, 0, len(e.bounds))
@@ -286,9 +301,18 @@ func (e *Engine) OnTimeUpdate(now time.Time) {
 func (e *Engine) CheckPrice(ctx context.Context, as AuctionState, price *num.Uint, persistent bool, recordPriceHistory bool) bool {
 	// market is not in auction, or in batch auction
 	if fba := as.IsFBA(); !as.InAuction() || fba {
-		bounds := e.checkBounds(price)
+		bounds, divergence := e.checkTriggers(price)
 		// no bounds violations - update price, and we're done (unless we initialised as part of this call, then price has alrady been updated)
-		if len(bounds) == 0 {
+		if len(bounds) == 0 && divergence == nil {
+			// a batch auction extended by price monitoring can now end, start afresh as we would after a price auction
+			if fba && as.IsPriceExtension() && as.CanLeave() {
+				if recordPriceHistory {
+					e.ResetPriceHistory(price)
+				} else {
+					e.ResetPriceHistory(nil)
+				}
+				return false
+			}
 			if recordPriceHistory {
 				e.recordPriceChange(price)
 			}
@@ -303,22 +327,33 @@ func (e *Engine) CheckPrice(ctx context.Context, as AuctionState, price *num.Uin
 		for _, b := range bounds {
 			duration.Duration += b.AuctionExtension
 		}
+		if divergence != nil {
+			duration.Duration += divergence.AuctionExtension
+		}
 		// we're dealing with a batch auction that's about to end -> extend it?
 		if fba && as.CanLeave() {
 			// bounds were violated, based on the values in the bounds slice, we can calculate how long the auction should last
-			as.ExtendAuctionPrice(duration)
+			if divergence != nil {
+				as.ExtendAuctionIndexDivergence(duration)
+			} else {
+				as.ExtendAuctionPrice(duration)
+			}
 			return false
 		}
 		if min := int64(e.minDuration / time.Second); duration.Duration < min {
 			duration.Duration = min
 		}
 
-		as.StartPriceAuction(e.now, &duration)
+		if divergence != nil {
+			as.StartIndexDivergenceAuction(e.now, &duration)
+		} else {
+			as.StartPriceAuction(e.now, &duration)
+		}
 		return false
 	}
 
-	bounds := e.checkBounds(price)
-	if len(bounds) == 0 {
+	bounds, divergence := e.checkTriggers(price)
+	if len(bounds) == 0 && divergence == nil {
 		end := as.ExpiresAt()
 		if !e.now.After(*end) {
 			return false
@@ -333,12 +368,19 @@ func (e *Engine) CheckPrice(ctx context.Context, as AuctionState, price *num.Uin
 		return false
 	}
 
+	// extend the current auction
+	if divergence != nil {
+		as.ExtendAuctionIndexDivergence(types.AuctionDuration{
+			Duration: divergence.AuctionExtension,
+		})
+		return false
+	}
+
 	var duration int64
 	for _, b := range bounds {
 		duration += b.AuctionExtension
 	}
 
-	// extend the current auction
 	as.ExtendAuctionPrice(types.AuctionDuration{
 		Duration: duration,
 	})
@@ -346,6 +388,14 @@ func (e *Engine) CheckPrice(ctx context.Context, as AuctionState, price *num.Uin
 	return false
 }
 
+// checkTriggers checks the price against the bounds first, and only if none of them is violated, against the index divergence.
+func (e *Engine) checkTriggers(price *num.Uint) ([]*types.PriceMonitoringTrigger, *types.PriceMonitoringIndexDivergence) {
+	if bounds := e.checkBounds(price); len(bounds) > 0 {
+		return bounds, nil
+	}
+	return nil, e.checkIndexDivergence(price)
+}
+
 // ResetPriceHistory deletes existing price history and starts it afresh with the supplied value.
 func (e *Engine) ResetPriceHistory(price *num.Uint) {
 	e.update = e.now
@@ -378,6 +428,10 @@ func (e *Engine) reactivateBounds() {
 		}
 		b.Active = true
 	}
+	if e.indexDivergence != nil && !e.indexDivergenceActive {
+		e.stateChanged = true
+	}
+	e.indexDivergenceActive = true
 	e.priceRangeCacheTime = time.Time{}
 }
 
@@ -443,6 +497,26 @@ func (e *Engine) checkBounds(price *num.Uint) []*types.PriceMonitoringTrigger {
 	return ret
 }
 
+// checkIndexDivergence checks if the price diverges from the index price by more than the maximum divergence and returns the trigger if it does.
+func (e *Engine) checkIndexDivergence(price *num.Uint) *types.PriceMonitoringIndexDivergence {
+	if e.indexDivergence == nil || !e.indexDivergenceActive || e.indexPrice == nil || price == nil || price.IsZero() {
+		return nil
+	}
+	index := e.indexPrice()
+	if index == nil || index.IsZero() {
+		return nil
+	}
+	indexDec := index.ToDecimal()
+	divergence := price.ToDecimal().Sub(indexDec).Abs().Div(indexDec)
+	if divergence.LessThanOrEqual(e.indexDivergence.MaxDivergence) {
+		return nil
+	}
+	// deactivate the trigger so it doesn't prevent auction from terminating
+	e.indexDivergenceActive = false
+	e.stateChanged = true
+	return e.indexDivergence
+}
+
 // getCurrentPriceRanges calculates price ranges from current reference prices and bound down/up factors.
 func (e *Engine) getCurrentPriceRanges(force bool) map[int]priceRange {
 	if !force && e.priceRangeCacheTime == e.now && len(e.priceRangesCache) > 0 {
@@ -551,9 +625,8 @@ func (e *Engine) noHistory() bool {
 	return len(e.pricesPast) == 0 && len(e.pricesNow) == 0
 }
 
-func computeBoundsAndHorizons(settings *types.PriceMonitoringSettings, as AuctionState) (map[int64]num.Decimal, []*bound) {
-	// set bounds to inactive if we're in price monitoring auction
-	active := !as.IsPriceAuction()
+// computeBoundsAndHorizons sets up the bounds for the triggers, they should be inactive if we're in price monitoring auction.
+func computeBoundsAndHorizons(settings *types.PriceMonitoringSettings, active bool) (map[int64]num.Decimal, []*bound) {
 	parameters := make([]*types.PriceMonitoringTrigger, 0, len(settings.Parameters.Triggers))
 	for _, p := range settings.Parameters.Triggers {
 		p := *p
@@ -579,6 +652,13 @@ func computeBoundsAndHorizons(settings *types.PriceMonitoringSettings, as Auctio
 	return horizons, bounds
 }
 
+func indexDivergenceFromSettings(settings *types.PriceMonitoringSettings) *types.PriceMonitoringIndexDivergence {
+	if settings.Parameters == nil || settings.Parameters.IndexDivergence == nil {
+		return nil
+	}
+	return settings.Parameters.IndexDivergence.DeepClone()
+}
+
 func wrapPriceRange(b num.Decimal, isMin bool) num.WrappedDecimal {
 	var r *num.Uint
 	if isMin {
@@ -398,6 +398,101 @@ func TestAuctionStartedAndEndendBy1Trigger(t *testing.T) {
 	require.False(t, b)
 }
 
+func TestAuctionStartedAndEndedByIndexDivergence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	riskModel := mocks.NewMockRangeProvider(ctrl)
+	auctionStateMock := mocks.NewMockAuctionState(ctrl)
+	ctx := context.Background()
+	now := time.Date(1993, 2, 2, 6, 0, 0, 1, time.UTC)
+	pSet := &proto.PriceMonitoringSettings{
+		Parameters: &proto.PriceMonitoringParameters{
+			Triggers: []*proto.PriceMonitoringTrigger{},
+			IndexDivergence: &proto.PriceMonitoringIndexDivergence{
+				MaxDivergence:    "0.05",
+				AuctionExtension: 30,
+			},
+		},
+	}
+	settings := types.PriceMonitoringSettingsFromProto(pSet)
+
+	auctionStateMock.EXPECT().IsFBA().Return(false).AnyTimes()
+	auctionStateMock.EXPECT().IsPriceAuction().Return(false).Times(1)
+	statevar := mocks.NewMockStateVarEngine(ctrl)
+	statevar.EXPECT().RegisterStateVariable(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
+
+	pm, err := price.NewMonitor("asset", "market", riskModel, auctionStateMock, settings, statevar, logging.NewTestLogger())
+	require.NoError(t, err)
+	require.NotNil(t, pm)
+	pm.OnTimeUpdate(now)
+
+	// no index price, nothing to diverge from
+	auctionStateMock.EXPECT().InAuction().Return(false).Times(1)
+	require.False(t, pm.CheckPrice(ctx, auctionStateMock, num.NewUint(200), true, true))
+
+	index := num.NewUint(100)
+	pm.SetIndexPriceProvider(func() *num.Uint { return index.Clone() })
+
+	// 5% away from the index is fine
+	auctionStateMock.EXPECT().InAuction().Return(false).Times(2)
+	require.False(t, pm.CheckPrice(ctx, auctionStateMock, num.NewUint(105), true, true))
+	require.False(t, pm.CheckPrice(ctx, auctionStateMock, num.NewUint(95), true, true))
+
+	// non-persistent orders diverging from the index get rejected
+	auctionStateMock.EXPECT().InAuction().Return(false).Times(1)
+	require.True(t, pm.CheckPrice(ctx, auctionStateMock, num.NewUint(106), false, true))
+
+	// persistent ones start the auction
+	end := types.AuctionDuration{Duration: 30}
+	auctionStateMock.EXPECT().InAuction().Return(false).Times(1)
+	auctionStateMock.EXPECT().StartIndexDivergenceAuction(now, &end).Times(1)
+	require.False(t, pm.CheckPrice(ctx, auctionStateMock, num.NewUint(94), true, true))
+
+	// the trigger is inactive for the rest of the auction, so the auction can end
+	auctionEnd := now.Add(30 * time.Second)
+	auctionStateMock.EXPECT().InAuction().Return(true).Times(1)
+	auctionStateMock.EXPECT().ExpiresAt().Return(&auctionEnd).Times(1)
+	auctionStateMock.EXPECT().SetReadyToLeave().Times(1)
+	pm.OnTimeUpdate(auctionEnd.Add(time.Nanosecond))
+	require.False(t, pm.CheckPrice(ctx, auctionStateMock, num.NewUint(94), true, true))
+	require.True(t, pm.GetState().IndexDivergenceActive)
+}
+
+func TestAuctionExtendedByIndexDivergence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	riskModel := mocks.NewMockRangeProvider(ctrl)
+	auctionStateMock := mocks.NewMockAuctionState(ctrl)
+	ctx := context.Background()
+	now := time.Date(1993, 2, 2, 6, 0, 0, 1, time.UTC)
+	pSet := &proto.PriceMonitoringSettings{
+		Parameters: &proto.PriceMonitoringParameters{
+			Triggers: []*proto.PriceMonitoringTrigger{},
+			IndexDivergence: &proto.PriceMonitoringIndexDivergence{
+				MaxDivergence:    "0.05",
+				AuctionExtension: 30,
+			},
+		},
+	}
+	settings := types.PriceMonitoringSettingsFromProto(pSet)
+
+	auctionStateMock.EXPECT().IsFBA().Return(false).AnyTimes()
+	auctionStateMock.EXPECT().IsPriceAuction().Return(false).Times(1)
+	statevar := mocks.NewMockStateVarEngine(ctrl)
+	statevar.EXPECT().RegisterStateVariable(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any())
+
+	pm, err := price.NewMonitor("asset", "market", riskModel, auctionStateMock, settings, statevar, logging.NewTestLogger())
+	require.NoError(t, err)
+	pm.SetIndexPriceProvider(func() *num.Uint { return num.NewUint(100) })
+	pm.OnTimeUpdate(now)
+
+	// the market is in an auction triggered by something else, the indicative price diverges from the index
+	auctionStateMock.EXPECT().InAuction().Return(true).Times(1)
+	auctionStateMock.EXPECT().ExtendAuctionIndexDivergence(types.AuctionDuration{Duration: 30}).Times(1)
+	require.False(t, pm.CheckPrice(ctx, auctionStateMock, num.NewUint(110), true, true))
+	require.False(t, pm.GetState().IndexDivergenceActive)
+}
+
 func TestAuctionStartedAndEndendBy2Triggers(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	riskModel := mocks.NewMockRangeProvider(ctrl)
@@ -920,3 +1015,40 @@ func horizonToYearFraction(horizon int64) num.Decimal {
 	hdec := num.DecimalFromFloat(float64(horizon))
 	return hdec.Div(secondsPerYear)
 }
+
+func TestEstimateBounds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	riskModel := mocks.NewMockRangeProvider(ctrl)
+	t1 := proto.PriceMonitoringTrigger{Horizon: 7200, Probability: "0.95", AuctionExtension: 300}
+	t2 := proto.PriceMonitoringTrigger{Horizon: 3600, Probability: "0.99", AuctionExtension: 60}
+	settings := types.PriceMonitoringSettingsFromProto(&proto.PriceMonitoringSettings{
+		Parameters: &proto.PriceMonitoringParameters{
+			Triggers: []*proto.PriceMonitoringTrigger{&t1, &t2},
+		},
+	})
+
+	ref := num.DecimalFromInt64(1000)
+	riskModel.EXPECT().PriceRange(ref, horizonToYearFraction(t1.Horizon), num.MustDecimalFromString("0.95")).Return(num.DecimalFromFloat(900.5), num.DecimalFromFloat(1100.5)).Times(1)
+	riskModel.EXPECT().PriceRange(ref, horizonToYearFraction(t2.Horizon), num.MustDecimalFromString("0.99")).Return(num.DecimalFromFloat(950.5), num.DecimalFromFloat(1050.5)).Times(1)
+
+	bounds, err := price.EstimateBounds(riskModel, settings, ref)
+	require.NoError(t, err)
+	require.Len(t, bounds, 2)
+
+	// sorted by horizon, min price is rounded up and max price rounded down
+	require.Equal(t, t2.Horizon, bounds[0].Trigger.Horizon)
+	require.Equal(t, "951", bounds[0].MinValidPrice.String())
+	require.Equal(t, "1050", bounds[0].MaxValidPrice.String())
+	require.Equal(t, t1.Horizon, bounds[1].Trigger.Horizon)
+	require.Equal(t, "901", bounds[1].MinValidPrice.String())
+	require.Equal(t, "1100", bounds[1].MaxValidPrice.String())
+	for _, b := range bounds {
+		require.True(t, b.Active)
+		require.True(t, ref.Equal(b.ReferencePrice))
+	}
+
+	_, err = price.EstimateBounds(nil, settings, ref)
+	require.ErrorIs(t, err, price.ErrNilRangeProvider)
+	_, err = price.EstimateBounds(riskModel, nil, ref)
+	require.ErrorIs(t, err, price.ErrNilPriceMonitoringSettings)
+}
@@ -44,23 +44,25 @@ func NewMonitorFromSnapshot(
 	priceRangesCache, needRecalc := newPriceRangeCacheFromSlice(pm.PriceRangeCache)
 
 	e := &Engine{
-		market:              marketID,
-		log:                 log,
-		riskModel:           riskModel,
-		auctionState:        auctionState,
-		initialised:         pm.Initialised,
-		fpHorizons:          keyDecimalPairToMap(pm.FPHorizons),
-		now:                 pm.Now,
-		update:              pm.Update,
-		priceRangeCacheTime: pm.PriceRangeCacheTime,
-		refPriceCache:       keyDecimalPairToMap(pm.RefPriceCache),
-		refPriceCacheTime:   pm.RefPriceCacheTime,
-		bounds:              priceBoundsToBounds(pm.Bounds),
-		priceRangesCache:    priceRangesCache,
-		pricesNow:           pricesNowToInternal(pm.PricesNow),
-		pricesPast:          pricesPastToInternal(pm.PricesPast),
-		stateChanged:        true,
-		asset:               asset,
+		market:                marketID,
+		log:                   log,
+		riskModel:             riskModel,
+		auctionState:          auctionState,
+		initialised:           pm.Initialised,
+		fpHorizons:            keyDecimalPairToMap(pm.FPHorizons),
+		now:                   pm.Now,
+		update:                pm.Update,
+		priceRangeCacheTime:   pm.PriceRangeCacheTime,
+		refPriceCache:         keyDecimalPairToMap(pm.RefPriceCache),
+		refPriceCacheTime:     pm.RefPriceCacheTime,
+		bounds:                priceBoundsToBounds(pm.Bounds),
+		priceRangesCache:      priceRangesCache,
+		pricesNow:             pricesNowToInternal(pm.PricesNow),
+		pricesPast:            pricesPastToInternal(pm.PricesPast),
+		indexDivergence:       indexDivergenceFromSettings(settings),
+		indexDivergenceActive: pm.IndexDivergenceActive,
+		stateChanged:          true,
+		asset:                 asset,
 	}
 	e.boundFactorsInitialised = pm.PriceBoundsConsensusReached
 	stateVarEngine.RegisterStateVariable(asset, marketID, "bound-factors", boundFactorsConverter{}, e.startCalcPriceRanges, []statevar.EventType{statevar.EventTypeTimeTrigger, statevar.EventTypeAuctionEnded, statevar.EventTypeOpeningAuctionFirstUncrossingPrice}, e.updatePriceBounds)
@@ -245,6 +247,7 @@ func (e *Engine) GetState() *types.PriceMonitor {
 		RefPriceCache:               mapToKeyDecimalPair(e.refPriceCache),
 		RefPriceCacheTime:           e.refPriceCacheTime,
 		PriceBoundsConsensusReached: e.boundFactorsInitialised,
+		IndexDivergenceActive:       e.indexDivergenceActive,
 	}
 
 	e.stateChanged = false
@@ -24,6 +24,7 @@ const (
 	NetworkWideAuctionDuration = "auction.LongBlock"
 
 	MarketMarginScalingFactors        = "market.margin.scalingFactors"
+	MarketMarginPortfolioGroups       = "market.margin.portfolio.groups"
 	MarketFeeFactorsMakerFee          = "market.fee.factors.makerFee"
 	MarketFeeFactorsInfrastructureFee = "market.fee.factors.infrastructureFee"
 	MarketFeeFactorsTreasuryFee       = "market.fee.factors.treasuryFee"
@@ -333,6 +334,7 @@ var AllKeys = map[string]struct{}{
 	RewardMarketCreationQuantumMultiple:                          {},
 	MarketAggressiveOrderBlockDelay:                              {},
 	MarketMarginScalingFactors:                                   {},
+	MarketMarginPortfolioGroups:                                  {},
 	MarketFeeFactorsMakerFee:                                     {},
 	MarketFeeFactorsInfrastructureFee:                            {},
 	MarketFeeFactorsTreasuryFee:                                  {},
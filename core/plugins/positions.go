@@ -148,10 +148,10 @@ func (p *Positions) Push(evts ...events.Event) {
 	p.mu.Unlock()
 }
 
-// handle trade event closing distressed parties.
+// handle trade event closing distressed parties, or auto-deleveraging parties to reduce the network position.
 func (p *Positions) handleTradeEvent(e TE) {
 	trade := e.Trade()
-	if trade.Type != types.TradeTypeNetworkCloseOutBad {
+	if trade.Type != types.TradeTypeNetworkCloseOutBad && trade.Type != types.TradeTypeAutoDeleveraging {
 		return
 	}
 	marketID := e.MarketID()
@@ -409,6 +409,7 @@ func NewApp(log *logging.Logger,
 		HandleCheckTx(txn.CreateReferralSetCommand, app.CheckCreateOrUpdateReferralSet).
 		HandleCheckTx(txn.UpdateReferralSetCommand, app.CheckCreateOrUpdateReferralSet).
 		HandleCheckTx(txn.SubmitOrderCommand, app.CheckOrderSubmissionForSpam).
+		HandleCheckTx(txn.SubmitSpreadOrderCommand, app.CheckSubmitSpreadOrder).
 		HandleCheckTx(txn.LiquidityProvisionCommand, app.CheckLPSubmissionForSpam).
 		HandleCheckTx(txn.AmendLiquidityProvisionCommand, app.CheckLPAmendForSpam).
 		HandleCheckTx(txn.SubmitAMMCommand, app.CheckSubmitAmmForSpam).
@@ -516,6 +517,9 @@ func NewApp(log *logging.Logger,
 		HandleDeliverTx(txn.VoteCommand,
 			app.SendTransactionResult(app.DeliverVote),
 		).
+		HandleDeliverTx(txn.UpdateGovernanceDelegationCommand,
+			app.SendTransactionResult(app.DeliverUpdateGovernanceDelegation),
+		).
 		HandleDeliverTx(txn.LiquidityProvisionCommand,
 			app.SendTransactionResult(
 				addDeterministicID(app.DeliverLiquidityProvision),
@@ -563,6 +567,42 @@ func NewApp(log *logging.Logger,
 		HandleDeliverTx(txn.UpdatePartyProfileCommand,
 			app.SendTransactionResult(app.UpdatePartyProfile),
 		).
+		HandleDeliverTx(txn.UpdateMarketMakerProtectionCommand,
+			app.SendTransactionResult(app.UpdateMarketMakerProtection),
+		).
+		HandleDeliverTx(txn.CancelOnTimeoutCommand,
+			app.SendTransactionResult(app.CancelOnTimeout),
+		).
+		HandleDeliverTx(txn.SubmitSpreadOrderCommand,
+			app.SendTransactionResult(
+				app.CheckSubmitSpreadOrderW(
+					addDeterministicID(app.DeliverSubmitSpreadOrder),
+				),
+			),
+		).
+		HandleDeliverTx(txn.SubmitQuoteRequestCommand,
+			app.SendTransactionResult(
+				addDeterministicID(app.DeliverSubmitQuoteRequest),
+			),
+		).
+		HandleDeliverTx(txn.SubmitQuoteCommand,
+			app.SendTransactionResult(
+				addDeterministicID(app.DeliverSubmitQuote),
+			),
+		).
+		HandleDeliverTx(txn.AcceptQuoteCommand,
+			app.SendTransactionResult(
+				addDeterministicID(app.DeliverAcceptQuote),
+			),
+		).
+		HandleDeliverTx(txn.CancelQuoteRequestCommand,
+			app.SendTransactionResult(app.DeliverCancelQuoteRequest),
+		).
+		HandleDeliverTx(txn.SubmitCompleteSetCommand,
+			app.SendTransactionResult(
+				addDeterministicID(app.DeliverSubmitCompleteSet),
+			),
+		).
 		HandleDeliverTx(txn.DelayedTransactionsWrapper,
 			app.SendTransactionResult(app.handleDelayedTransactionWrapper))
 
@@ -1113,6 +1153,23 @@ func (app *App) prepareProposal(height uint64, txs []abci.Tx, rawTxs [][]byte) [
 					anythingElseFromThisBlock = append(anythingElseFromThisBlock, tx.raw)
 				}
 			}
+		case txn.SubmitSpreadOrderCommand:
+			s := &commandspb.SubmitSpreadOrder{}
+			if err := tx.tx.Unmarshal(s); err != nil {
+				continue
+			}
+			someMarketRequiresDelay := false
+			for _, l := range s.Legs {
+				if app.txCache.IsDelayRequired(l.MarketId) {
+					someMarketRequiresDelay = true
+					break
+				}
+			}
+			if someMarketRequiresDelay {
+				nextBlockRtx = append(nextBlockRtx, tx.raw)
+			} else {
+				anythingElseFromThisBlock = append(anythingElseFromThisBlock, tx.raw)
+			}
 		case txn.BatchMarketInstructions:
 			batch := &commandspb.BatchMarketInstructions{}
 			if err := tx.tx.Unmarshal(batch); err != nil {
@@ -1946,6 +2003,10 @@ func (app *App) CheckTransferCommand(_ context.Context, tx abci.Tx) error {
 		return app.banking.CheckTransfer(transfer.OneOff.TransferBase)
 	case types.TransferCommandKindRecurring:
 		return app.banking.CheckTransfer(transfer.Recurring.TransferBase)
+	case types.TransferCommandKindStreaming:
+		return app.banking.CheckTransfer(transfer.Streaming.TransferBase)
+	case types.TransferCommandKindConditional:
+		return app.banking.CheckTransfer(transfer.Conditional.TransferBase)
 	default:
 		return errors.New("unsupported transfer kind")
 	}
@@ -2498,6 +2559,15 @@ func (app *App) DeliverVote(ctx context.Context, tx abci.Tx) error {
 	return app.gov.AddVote(ctx, *v, party)
 }
 
+func (app *App) DeliverUpdateGovernanceDelegation(ctx context.Context, tx abci.Tx) error {
+	params := &commandspb.UpdateGovernanceDelegation{}
+	if err := tx.Unmarshal(params); err != nil {
+		return fmt.Errorf("could not deserialize UpdateGovernanceDelegation command: %w", err)
+	}
+
+	return app.gov.UpdateGovernanceDelegation(ctx, *types.NewGovernanceDelegationSubmissionFromProto(params), tx.Party())
+}
+
 func (app *App) DeliverNodeSignature(ctx context.Context, tx abci.Tx) error {
 	ns := &commandspb.NodeSignature{}
 	if err := tx.Unmarshal(ns); err != nil {
@@ -3054,7 +3124,128 @@ func (app *App) UpdateMarginMode(ctx context.Context, tx abci.Tx) error {
 			return err
 		}
 	}
-	return app.exec.UpdateMarginMode(ctx, tx.Party(), params.MarketId, types.MarginMode(params.Mode), marginFactor)
+	return app.exec.UpdateMarginMode(ctx, tx.Party(), params.MarketId, types.MarginMode(params.Mode), marginFactor, params.GetPortfolioMargin())
+}
+
+func (app *App) UpdateMarketMakerProtection(ctx context.Context, tx abci.Tx) error {
+	params := &commandspb.UpdateMarketMakerProtection{}
+	if err := tx.Unmarshal(params); err != nil {
+		return fmt.Errorf("could not deserialize UpdateMarketMakerProtection command: %w", err)
+	}
+	protection, err := types.NewMarketMakerProtectionFromProto(params, tx.Party())
+	if err != nil {
+		return err
+	}
+	return app.exec.UpdateMarketMakerProtection(ctx, protection)
+}
+
+func (app *App) CancelOnTimeout(ctx context.Context, tx abci.Tx) error {
+	params := &commandspb.CancelOnTimeout{}
+	if err := tx.Unmarshal(params); err != nil {
+		return fmt.Errorf("could not deserialize CancelOnTimeout command: %w", err)
+	}
+	return app.exec.CancelOnTimeout(ctx, types.NewCancelOnTimeoutFromProto(params, tx.Party()))
+}
+
+func (app *App) CheckSubmitSpreadOrderW(
+	f func(context.Context, abci.Tx) error,
+) func(context.Context, abci.Tx) error {
+	return func(ctx context.Context, tx abci.Tx) error {
+		if err := app.CheckSubmitSpreadOrder(ctx, tx); err != nil {
+			return err
+		}
+		return f(ctx, tx)
+	}
+}
+
+func (app *App) CheckSubmitSpreadOrder(_ context.Context, tx abci.Tx) error {
+	params := &commandspb.SubmitSpreadOrder{}
+	if err := tx.Unmarshal(params); err != nil {
+		return err
+	}
+
+	for _, l := range params.Legs {
+		if err := app.exec.CheckCanSubmitOrderOrLiquidityCommitment(tx.Party(), l.MarketId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (app *App) DeliverSubmitSpreadOrder(ctx context.Context, tx abci.Tx, deterministicID string) error {
+	params := &commandspb.SubmitSpreadOrder{}
+	if err := tx.Unmarshal(params); err != nil {
+		return fmt.Errorf("could not deserialize SubmitSpreadOrder command: %w", err)
+	}
+
+	spread, err := types.NewSpreadOrderSubmissionFromProto(params)
+	if err != nil {
+		return err
+	}
+
+	idgen := idgeneration.New(deterministicID)
+	confs, err := app.exec.SubmitSpreadOrder(ctx, spread, tx.Party(), idgen)
+	for _, conf := range confs {
+		app.stats.AddCurrentTradesInBatch(uint64(len(conf.Trades)))
+		app.stats.AddTotalTrades(uint64(len(conf.Trades)))
+		app.stats.IncCurrentOrdersInBatch()
+		app.stats.IncTotalOrders()
+	}
+	return err
+}
+
+func (app *App) DeliverSubmitQuoteRequest(ctx context.Context, tx abci.Tx, deterministicID string) error {
+	params := &commandspb.SubmitQuoteRequest{}
+	if err := tx.Unmarshal(params); err != nil {
+		return fmt.Errorf("could not deserialize SubmitQuoteRequest command: %w", err)
+	}
+	return app.exec.SubmitQuoteRequest(ctx, types.NewQuoteRequestSubmissionFromProto(params), tx.Party(), deterministicID)
+}
+
+func (app *App) DeliverSubmitQuote(ctx context.Context, tx abci.Tx, deterministicID string) error {
+	params := &commandspb.SubmitQuote{}
+	if err := tx.Unmarshal(params); err != nil {
+		return fmt.Errorf("could not deserialize SubmitQuote command: %w", err)
+	}
+
+	quote, err := types.NewQuoteSubmissionFromProto(params)
+	if err != nil {
+		return err
+	}
+	return app.exec.SubmitQuote(ctx, quote, tx.Party(), deterministicID)
+}
+
+func (app *App) DeliverAcceptQuote(ctx context.Context, tx abci.Tx, deterministicID string) error {
+	params := &commandspb.AcceptQuote{}
+	if err := tx.Unmarshal(params); err != nil {
+		return fmt.Errorf("could not deserialize AcceptQuote command: %w", err)
+	}
+
+	idgen := idgeneration.New(deterministicID)
+	if _, err := app.exec.AcceptQuote(ctx, params.QuoteId, tx.Party(), idgen); err != nil {
+		return err
+	}
+	app.stats.AddCurrentTradesInBatch(1)
+	app.stats.AddTotalTrades(1)
+	return nil
+}
+
+func (app *App) DeliverCancelQuoteRequest(ctx context.Context, tx abci.Tx) error {
+	params := &commandspb.CancelQuoteRequest{}
+	if err := tx.Unmarshal(params); err != nil {
+		return fmt.Errorf("could not deserialize CancelQuoteRequest command: %w", err)
+	}
+	return app.exec.CancelQuoteRequest(ctx, params.QuoteRequestId, tx.Party())
+}
+
+func (app *App) DeliverSubmitCompleteSet(ctx context.Context, tx abci.Tx, deterministicID string) error {
+	params := &commandspb.SubmitCompleteSet{}
+	if err := tx.Unmarshal(params); err != nil {
+		return fmt.Errorf("could not deserialize SubmitCompleteSet command: %w", err)
+	}
+
+	idgen := idgeneration.New(deterministicID)
+	return app.exec.SubmitCompleteSet(ctx, types.NewCompleteSetSubmissionFromProto(params), tx.Party(), idgen)
 }
 
 func (app *App) DeliverSubmitAMM(ctx context.Context, tx abci.Tx, deterministicID string) error {
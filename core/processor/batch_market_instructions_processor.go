@@ -145,7 +145,7 @@ func (p *BMIProcessor) ProcessBatch(
 			} else {
 				marginFactor = num.MustDecimalFromString(*umm.MarginFactor)
 			}
-			err = p.exec.UpdateMarginMode(ctx, party, umm.MarketId, vega.MarginMode(umm.Mode), marginFactor)
+			err = p.exec.UpdateMarginMode(ctx, party, umm.MarketId, vega.MarginMode(umm.Mode), marginFactor, umm.GetPortfolioMargin())
 		}
 		if err != nil {
 			errs.AddForProperty("updateMarginMode", err)
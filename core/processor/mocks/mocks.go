@@ -275,6 +275,21 @@ func (m *MockExecutionEngine) EXPECT() *MockExecutionEngineMockRecorder {
 	return m.recorder
 }
 
+// AcceptQuote mocks base method.
+func (m *MockExecutionEngine) AcceptQuote(arg0 context.Context, arg1, arg2 string, arg3 common0.IDGenerator) (*types.Trade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptQuote", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*types.Trade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptQuote indicates an expected call of AcceptQuote.
+func (mr *MockExecutionEngineMockRecorder) AcceptQuote(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptQuote", reflect.TypeOf((*MockExecutionEngine)(nil).AcceptQuote), arg0, arg1, arg2, arg3)
+}
+
 // AmendAMM mocks base method.
 func (m *MockExecutionEngine) AmendAMM(arg0 context.Context, arg1 *types.AmendAMM, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -370,6 +385,20 @@ func (mr *MockExecutionEngineMockRecorder) CancelLiquidityProvision(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelLiquidityProvision", reflect.TypeOf((*MockExecutionEngine)(nil).CancelLiquidityProvision), arg0, arg1, arg2)
 }
 
+// CancelOnTimeout mocks base method.
+func (m *MockExecutionEngine) CancelOnTimeout(arg0 context.Context, arg1 *types.CancelOnTimeout) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOnTimeout", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelOnTimeout indicates an expected call of CancelOnTimeout.
+func (mr *MockExecutionEngineMockRecorder) CancelOnTimeout(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOnTimeout", reflect.TypeOf((*MockExecutionEngine)(nil).CancelOnTimeout), arg0, arg1)
+}
+
 // CancelOrder mocks base method.
 func (m *MockExecutionEngine) CancelOrder(arg0 context.Context, arg1 *types.OrderCancellation, arg2 string, arg3 common0.IDGenerator) ([]*types.OrderCancellationConfirmation, error) {
 	m.ctrl.T.Helper()
@@ -385,6 +414,20 @@ func (mr *MockExecutionEngineMockRecorder) CancelOrder(arg0, arg1, arg2, arg3 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrder", reflect.TypeOf((*MockExecutionEngine)(nil).CancelOrder), arg0, arg1, arg2, arg3)
 }
 
+// CancelQuoteRequest mocks base method.
+func (m *MockExecutionEngine) CancelQuoteRequest(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelQuoteRequest", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelQuoteRequest indicates an expected call of CancelQuoteRequest.
+func (mr *MockExecutionEngineMockRecorder) CancelQuoteRequest(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelQuoteRequest", reflect.TypeOf((*MockExecutionEngine)(nil).CancelQuoteRequest), arg0, arg1, arg2)
+}
+
 // CancelStopOrders mocks base method.
 func (m *MockExecutionEngine) CancelStopOrders(arg0 context.Context, arg1 *types.StopOrdersCancellation, arg2 string, arg3 common0.IDGenerator) error {
 	m.ctrl.T.Helper()
@@ -511,6 +554,20 @@ func (mr *MockExecutionEngineMockRecorder) SubmitAMM(arg0, arg1, arg2 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitAMM", reflect.TypeOf((*MockExecutionEngine)(nil).SubmitAMM), arg0, arg1, arg2)
 }
 
+// SubmitCompleteSet mocks base method.
+func (m *MockExecutionEngine) SubmitCompleteSet(arg0 context.Context, arg1 *types.CompleteSetSubmission, arg2 string, arg3 common0.IDGenerator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitCompleteSet", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubmitCompleteSet indicates an expected call of SubmitCompleteSet.
+func (mr *MockExecutionEngineMockRecorder) SubmitCompleteSet(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitCompleteSet", reflect.TypeOf((*MockExecutionEngine)(nil).SubmitCompleteSet), arg0, arg1, arg2, arg3)
+}
+
 // SubmitLiquidityProvision mocks base method.
 func (m *MockExecutionEngine) SubmitLiquidityProvision(arg0 context.Context, arg1 *types.LiquidityProvisionSubmission, arg2, arg3 string) error {
 	m.ctrl.T.Helper()
@@ -554,6 +611,34 @@ func (mr *MockExecutionEngineMockRecorder) SubmitOrder(arg0, arg1, arg2, arg3, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitOrder", reflect.TypeOf((*MockExecutionEngine)(nil).SubmitOrder), arg0, arg1, arg2, arg3, arg4)
 }
 
+// SubmitQuote mocks base method.
+func (m *MockExecutionEngine) SubmitQuote(arg0 context.Context, arg1 *types.QuoteSubmission, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitQuote", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubmitQuote indicates an expected call of SubmitQuote.
+func (mr *MockExecutionEngineMockRecorder) SubmitQuote(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitQuote", reflect.TypeOf((*MockExecutionEngine)(nil).SubmitQuote), arg0, arg1, arg2, arg3)
+}
+
+// SubmitQuoteRequest mocks base method.
+func (m *MockExecutionEngine) SubmitQuoteRequest(arg0 context.Context, arg1 *types.QuoteRequestSubmission, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitQuoteRequest", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubmitQuoteRequest indicates an expected call of SubmitQuoteRequest.
+func (mr *MockExecutionEngineMockRecorder) SubmitQuoteRequest(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitQuoteRequest", reflect.TypeOf((*MockExecutionEngine)(nil).SubmitQuoteRequest), arg0, arg1, arg2, arg3)
+}
+
 // SubmitSpotMarket mocks base method.
 func (m *MockExecutionEngine) SubmitSpotMarket(arg0 context.Context, arg1 *types.Market, arg2 string, arg3 time.Time) error {
 	m.ctrl.T.Helper()
@@ -568,6 +653,21 @@ func (mr *MockExecutionEngineMockRecorder) SubmitSpotMarket(arg0, arg1, arg2, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitSpotMarket", reflect.TypeOf((*MockExecutionEngine)(nil).SubmitSpotMarket), arg0, arg1, arg2, arg3)
 }
 
+// SubmitSpreadOrder mocks base method.
+func (m *MockExecutionEngine) SubmitSpreadOrder(arg0 context.Context, arg1 *types.SpreadOrderSubmission, arg2 string, arg3 common0.IDGenerator) ([]*types.OrderConfirmation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitSpreadOrder", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*types.OrderConfirmation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitSpreadOrder indicates an expected call of SubmitSpreadOrder.
+func (mr *MockExecutionEngineMockRecorder) SubmitSpreadOrder(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitSpreadOrder", reflect.TypeOf((*MockExecutionEngine)(nil).SubmitSpreadOrder), arg0, arg1, arg2, arg3)
+}
+
 // SubmitStopOrders mocks base method.
 func (m *MockExecutionEngine) SubmitStopOrders(arg0 context.Context, arg1 *types.StopOrdersSubmission, arg2 string, arg3 common0.IDGenerator, arg4, arg5 *string) (*types.OrderConfirmation, error) {
 	m.ctrl.T.Helper()
@@ -598,17 +698,17 @@ func (mr *MockExecutionEngineMockRecorder) SucceedMarket(arg0, arg1, arg2 interf
 }
 
 // UpdateMarginMode mocks base method.
-func (m *MockExecutionEngine) UpdateMarginMode(arg0 context.Context, arg1, arg2 string, arg3 vega.MarginMode, arg4 decimal.Decimal) error {
+func (m *MockExecutionEngine) UpdateMarginMode(arg0 context.Context, arg1, arg2 string, arg3 vega.MarginMode, arg4 decimal.Decimal, arg5 bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateMarginMode", arg0, arg1, arg2, arg3, arg4)
+	ret := m.ctrl.Call(m, "UpdateMarginMode", arg0, arg1, arg2, arg3, arg4, arg5)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateMarginMode indicates an expected call of UpdateMarginMode.
-func (mr *MockExecutionEngineMockRecorder) UpdateMarginMode(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+func (mr *MockExecutionEngineMockRecorder) UpdateMarginMode(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMarginMode", reflect.TypeOf((*MockExecutionEngine)(nil).UpdateMarginMode), arg0, arg1, arg2, arg3, arg4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMarginMode", reflect.TypeOf((*MockExecutionEngine)(nil).UpdateMarginMode), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
 // UpdateMarket mocks base method.
@@ -625,6 +725,20 @@ func (mr *MockExecutionEngineMockRecorder) UpdateMarket(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMarket", reflect.TypeOf((*MockExecutionEngine)(nil).UpdateMarket), arg0, arg1)
 }
 
+// UpdateMarketMakerProtection mocks base method.
+func (m *MockExecutionEngine) UpdateMarketMakerProtection(arg0 context.Context, arg1 *types.MarketMakerProtection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMarketMakerProtection", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMarketMakerProtection indicates an expected call of UpdateMarketMakerProtection.
+func (mr *MockExecutionEngineMockRecorder) UpdateMarketMakerProtection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMarketMakerProtection", reflect.TypeOf((*MockExecutionEngine)(nil).UpdateMarketMakerProtection), arg0, arg1)
+}
+
 // UpdateMarketState mocks base method.
 func (m *MockExecutionEngine) UpdateMarketState(arg0 context.Context, arg1 *types.MarketStateUpdateConfiguration) error {
 	m.ctrl.T.Helper()
@@ -817,6 +931,20 @@ func (mr *MockGovernanceEngineMockRecorder) SubmitProposal(arg0, arg1, arg2, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitProposal", reflect.TypeOf((*MockGovernanceEngine)(nil).SubmitProposal), arg0, arg1, arg2, arg3)
 }
 
+// UpdateGovernanceDelegation mocks base method.
+func (m *MockGovernanceEngine) UpdateGovernanceDelegation(arg0 context.Context, arg1 types.GovernanceDelegationSubmission, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGovernanceDelegation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGovernanceDelegation indicates an expected call of UpdateGovernanceDelegation.
+func (mr *MockGovernanceEngineMockRecorder) UpdateGovernanceDelegation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGovernanceDelegation", reflect.TypeOf((*MockGovernanceEngine)(nil).UpdateGovernanceDelegation), arg0, arg1, arg2)
+}
+
 // MockStats is a mock of Stats interface.
 type MockStats struct {
 	ctrl     *gomock.Controller
@@ -80,6 +80,18 @@ type ExecutionEngine interface {
 	CancelOrder(ctx context.Context, order *types.OrderCancellation, party string, idgen common.IDGenerator) ([]*types.OrderCancellationConfirmation, error)
 	AmendOrder(ctx context.Context, order *types.OrderAmendment, party string, idgen common.IDGenerator) (*types.OrderConfirmation, error)
 
+	// spread orders stuff
+	SubmitSpreadOrder(ctx context.Context, spread *types.SpreadOrderSubmission, party string, idgen common.IDGenerator) ([]*types.OrderConfirmation, error)
+
+	// request for quote stuff
+	SubmitQuoteRequest(ctx context.Context, sub *types.QuoteRequestSubmission, party, requestID string) error
+	SubmitQuote(ctx context.Context, sub *types.QuoteSubmission, party, quoteID string) error
+	AcceptQuote(ctx context.Context, quoteID, party string, idgen common.IDGenerator) (*types.Trade, error)
+	CancelQuoteRequest(ctx context.Context, requestID, party string) error
+
+	// prediction markets stuff
+	SubmitCompleteSet(ctx context.Context, sub *types.CompleteSetSubmission, party string, idgen common.IDGenerator) error
+
 	// stop orders stuff
 	SubmitStopOrders(ctx context.Context, stopOrdersSubmission *types.StopOrdersSubmission, party string, idgen common.IDGenerator, stopOrderID1, stopOrderID2 *string) (*types.OrderConfirmation, error)
 	CancelStopOrders(ctx context.Context, stopOrdersCancellation *types.StopOrdersCancellation, party string, idgen common.IDGenerator) error
@@ -108,7 +120,11 @@ type ExecutionEngine interface {
 	BeginBlock(ctx context.Context, duration time.Duration)
 
 	// Margin mode
-	UpdateMarginMode(ctx context.Context, party, marketID string, marginMode types.MarginMode, marginFactor num.Decimal) error
+	UpdateMarginMode(ctx context.Context, party, marketID string, marginMode types.MarginMode, marginFactor num.Decimal, portfolioMargin bool) error
+	// Market maker protection
+	UpdateMarketMakerProtection(ctx context.Context, protection *types.MarketMakerProtection) error
+	// Cancel on timeout
+	CancelOnTimeout(ctx context.Context, cot *types.CancelOnTimeout) error
 	// default chain ID, can be removed once we've upgraded to v0.74
 	OnChainIDUpdate(uint64) error
 
@@ -125,6 +141,7 @@ type GovernanceEngine interface {
 	SubmitBatchProposal(context.Context, types.BatchProposalSubmission, string, string) ([]*governance.ToSubmit, error)
 	FinaliseEnactment(ctx context.Context, prop *types.Proposal)
 	AddVote(context.Context, types.VoteSubmission, string) error
+	UpdateGovernanceDelegation(context.Context, types.GovernanceDelegationSubmission, string) error
 	OnTick(context.Context, time.Time) ([]*governance.ToEnact, []*governance.VoteClosed)
 	RejectProposal(context.Context, *types.Proposal, types.ProposalError, error) error
 	RejectBatchProposal(context.Context, string, types.ProposalError, error) error
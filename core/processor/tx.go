@@ -154,6 +154,24 @@ func (t Tx) Command() txn.Command {
 		return txn.AmendAMMCommand
 	case *commandspb.InputData_CancelAmm:
 		return txn.CancelAMMCommand
+	case *commandspb.InputData_UpdateMarketMakerProtection:
+		return txn.UpdateMarketMakerProtectionCommand
+	case *commandspb.InputData_CancelOnTimeout:
+		return txn.CancelOnTimeoutCommand
+	case *commandspb.InputData_SubmitSpreadOrder:
+		return txn.SubmitSpreadOrderCommand
+	case *commandspb.InputData_SubmitQuoteRequest:
+		return txn.SubmitQuoteRequestCommand
+	case *commandspb.InputData_SubmitQuote:
+		return txn.SubmitQuoteCommand
+	case *commandspb.InputData_AcceptQuote:
+		return txn.AcceptQuoteCommand
+	case *commandspb.InputData_CancelQuoteRequest:
+		return txn.CancelQuoteRequestCommand
+	case *commandspb.InputData_SubmitCompleteSet:
+		return txn.SubmitCompleteSetCommand
+	case *commandspb.InputData_UpdateGovernanceDelegation:
+		return txn.UpdateGovernanceDelegationCommand
 	default:
 		panic(fmt.Sprintf("command %T is not supported", cmd))
 	}
@@ -263,6 +281,24 @@ func (t Tx) GetCmd() interface{} {
 		return cmd.AmendAmm
 	case *commandspb.InputData_CancelAmm:
 		return cmd.CancelAmm
+	case *commandspb.InputData_UpdateMarketMakerProtection:
+		return cmd.UpdateMarketMakerProtection
+	case *commandspb.InputData_CancelOnTimeout:
+		return cmd.CancelOnTimeout
+	case *commandspb.InputData_SubmitSpreadOrder:
+		return cmd.SubmitSpreadOrder
+	case *commandspb.InputData_SubmitQuoteRequest:
+		return cmd.SubmitQuoteRequest
+	case *commandspb.InputData_SubmitQuote:
+		return cmd.SubmitQuote
+	case *commandspb.InputData_AcceptQuote:
+		return cmd.AcceptQuote
+	case *commandspb.InputData_CancelQuoteRequest:
+		return cmd.CancelQuoteRequest
+	case *commandspb.InputData_SubmitCompleteSet:
+		return cmd.SubmitCompleteSet
+	case *commandspb.InputData_UpdateGovernanceDelegation:
+		return cmd.UpdateGovernanceDelegation
 	case *commandspb.InputData_DelayedTransactionsWrapper:
 		return cmd.DelayedTransactionsWrapper
 	default:
@@ -494,6 +530,60 @@ func (t Tx) Unmarshal(i interface{}) error {
 			return errors.New("failed to unmarshall to CancelAMM")
 		}
 		*underlyingCmd = *cmd.CancelAmm
+	case *commandspb.InputData_UpdateMarketMakerProtection:
+		underlyingCmd, ok := i.(*commandspb.UpdateMarketMakerProtection)
+		if !ok {
+			return errors.New("failed to unmarshall to UpdateMarketMakerProtection")
+		}
+		*underlyingCmd = *cmd.UpdateMarketMakerProtection
+	case *commandspb.InputData_CancelOnTimeout:
+		underlyingCmd, ok := i.(*commandspb.CancelOnTimeout)
+		if !ok {
+			return errors.New("failed to unmarshall to CancelOnTimeout")
+		}
+		*underlyingCmd = *cmd.CancelOnTimeout
+	case *commandspb.InputData_SubmitSpreadOrder:
+		underlyingCmd, ok := i.(*commandspb.SubmitSpreadOrder)
+		if !ok {
+			return errors.New("failed to unmarshall to SubmitSpreadOrder")
+		}
+		*underlyingCmd = *cmd.SubmitSpreadOrder
+	case *commandspb.InputData_SubmitQuoteRequest:
+		underlyingCmd, ok := i.(*commandspb.SubmitQuoteRequest)
+		if !ok {
+			return errors.New("failed to unmarshall to SubmitQuoteRequest")
+		}
+		*underlyingCmd = *cmd.SubmitQuoteRequest
+	case *commandspb.InputData_SubmitQuote:
+		underlyingCmd, ok := i.(*commandspb.SubmitQuote)
+		if !ok {
+			return errors.New("failed to unmarshall to SubmitQuote")
+		}
+		*underlyingCmd = *cmd.SubmitQuote
+	case *commandspb.InputData_AcceptQuote:
+		underlyingCmd, ok := i.(*commandspb.AcceptQuote)
+		if !ok {
+			return errors.New("failed to unmarshall to AcceptQuote")
+		}
+		*underlyingCmd = *cmd.AcceptQuote
+	case *commandspb.InputData_CancelQuoteRequest:
+		underlyingCmd, ok := i.(*commandspb.CancelQuoteRequest)
+		if !ok {
+			return errors.New("failed to unmarshall to CancelQuoteRequest")
+		}
+		*underlyingCmd = *cmd.CancelQuoteRequest
+	case *commandspb.InputData_SubmitCompleteSet:
+		underlyingCmd, ok := i.(*commandspb.SubmitCompleteSet)
+		if !ok {
+			return errors.New("failed to unmarshall to SubmitCompleteSet")
+		}
+		*underlyingCmd = *cmd.SubmitCompleteSet
+	case *commandspb.InputData_UpdateGovernanceDelegation:
+		underlyingCmd, ok := i.(*commandspb.UpdateGovernanceDelegation)
+		if !ok {
+			return errors.New("failed to unmarshall to UpdateGovernanceDelegation")
+		}
+		*underlyingCmd = *cmd.UpdateGovernanceDelegation
 	case *commandspb.InputData_DelayedTransactionsWrapper:
 		underlyingCmd, ok := i.(*commandspb.DelayedTransactionsWrapper)
 		if !ok {
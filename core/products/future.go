@@ -41,6 +41,9 @@ var (
 	// ErrSettlementDataDecimalsNotSupportedByAsset is returned when the decimal data decimal places
 	// are more than the asset decimals.
 	ErrSettlementDataDecimalsNotSupportedByAsset = errors.New("settlement data decimals not suported by market asset")
+
+	// ErrInvalidWinningOutcome is returned when the settlement data of a prediction market is not the index of one of its outcomes.
+	ErrInvalidWinningOutcome = errors.New("settlement data is not the index of an outcome of the prediction")
 )
 
 // Future represent a Future as describe by the market framework.
@@ -52,6 +55,44 @@ type Future struct {
 	tradingTerminationListener func(context.Context, bool)
 	settlementDataListener     func(context.Context, *num.Numeric)
 	assetDP                    uint32
+
+	// outcome is set if the future is one of the outcome contracts of a prediction market,
+	// the settlement data is then the index of the winning outcome.
+	outcome *types.PredictionOutcome
+	// payout is the settlement price of the outcome contract when its outcome wins, in asset decimals.
+	payout *num.Uint
+}
+
+// SetPredictionPayout sets the price, in asset decimals, the outcome contract of a prediction market settles at when its outcome wins.
+func (f *Future) SetPredictionPayout(payout *num.Uint) {
+	f.payout = payout.Clone()
+}
+
+// IsPredictionOutcome returns whether the future is one of the outcome contracts of a prediction market.
+func (f *Future) IsPredictionOutcome() bool {
+	return f.outcome != nil
+}
+
+// outcomeSettlementData maps the index of the winning outcome published by the settlement data source of a prediction
+// market to the settlement data of the outcome contract, in asset decimals: the payout if its outcome won and zero otherwise.
+func (f *Future) outcomeSettlementData(winner *num.Numeric) (*num.Numeric, error) {
+	var index num.Decimal
+	if winner.IsDecimal() {
+		index = *winner.Decimal()
+	} else {
+		index = winner.Uint().ToDecimal()
+	}
+	if !index.IsInteger() || index.IsNegative() || index.GreaterThanOrEqual(num.DecimalFromInt64(int64(len(f.outcome.Outcomes)))) {
+		return nil, ErrInvalidWinningOutcome
+	}
+
+	data := &num.Numeric{}
+	if index.IntPart() == int64(f.outcome.Index) && f.payout != nil {
+		data.SetUint(f.payout.Clone())
+	} else {
+		data.SetUint(num.UintZero())
+	}
+	return data, nil
 }
 
 func (_ Future) GetCurrentPeriod() uint64 { return 0 }
@@ -100,6 +141,10 @@ func (f *Future) ScaleSettlementDataToDecimalPlaces(price *num.Numeric, dp uint3
 	}
 
 	settlDataDecimals := int64(f.oracle.binding.settlementDecimals)
+	// the settlement data of an outcome contract is already mapped to its payout in asset decimals
+	if f.outcome != nil {
+		settlDataDecimals = int64(f.assetDP)
+	}
 	return price.ScaleTo(settlDataDecimals, int64(dp))
 }
 
@@ -220,6 +265,19 @@ func (f *Future) updateSettlementData(ctx context.Context, data dscommon.Data) e
 		odata.settlData.SetUint(settlDataAsUint)
 	}
 
+	if f.outcome != nil {
+		settlData, err := f.outcomeSettlementData(odata.settlData)
+		if err != nil {
+			f.log.Error(
+				"could not map the winning outcome to the settlement data",
+				logging.String("settlementData", odata.settlData.String()),
+				logging.Error(err),
+			)
+			return err
+		}
+		odata.settlData = settlData
+	}
+
 	f.oracle.data.settlData = odata.settlData
 	if f.settlementDataListener != nil {
 		f.settlementDataListener(ctx, odata.settlData)
@@ -298,6 +356,9 @@ func NewFuture(ctx context.Context, log *logging.Logger, f *types.Future, oe Ora
 		QuoteName:       f.QuoteName,
 		assetDP:         assetDP,
 	}
+	if f.PredictionOutcome != nil {
+		future.outcome = f.PredictionOutcome.DeepClone()
+	}
 
 	// Oracle spec for settlement data.
 	osForSettle, err := spec.New(*datasource.SpecFromDefinition(*f.DataSourceSpecForSettlementData.Data))
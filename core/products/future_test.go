@@ -274,3 +274,87 @@ func testFuture(t *testing.T, propertyTpe datapb.PropertyKey_Type) *tstFuture {
 func subscriptionID(i uint64) spec.SubscriptionID {
 	return spec.SubscriptionID(i)
 }
+
+func TestPredictionOutcomeSettlement(t *testing.T) {
+	t.Run("the winning outcome settles at the payout", testWinningOutcomeSettlesAtPayout)
+	t.Run("the losing outcomes settle at zero", testLosingOutcomeSettlesAtZero)
+	t.Run("settlement data which is not an outcome index is ignored", testInvalidWinningOutcomeIgnored)
+}
+
+type tstOutcome struct {
+	future  *products.Future
+	settle  spec.OnMatchedData
+	settled []*num.Numeric
+}
+
+func testPredictionOutcome(t *testing.T, index uint32) *tstOutcome {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	oe := mocks.NewMockOracleEngine(ctrl)
+
+	var dp uint64
+	f := getTestFutureProd(t, datapb.PropertyKey_TYPE_INTEGER, dp)
+	f.PredictionOutcome = &types.PredictionOutcome{
+		PredictionID: "prediction",
+		Index:        index,
+		Outcomes:     []string{"A", "B", "C"},
+	}
+
+	outcome := &tstOutcome{}
+	oe.EXPECT().
+		Subscribe(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, _ spec.Spec, cb spec.OnMatchedData) (spec.SubscriptionID, spec.Unsubscriber, error) {
+			outcome.settle = cb
+			return subscriptionID(1), func(context.Context, spec.SubscriptionID) {}, nil
+		})
+	oe.EXPECT().
+		Subscribe(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(subscriptionID(2), func(context.Context, spec.SubscriptionID) {}, nil)
+
+	future, err := products.NewFuture(context.Background(), logging.NewTestLogger(), f, oe, 3)
+	require.NoError(t, err)
+	require.True(t, future.IsPredictionOutcome())
+	future.SetPredictionPayout(num.NewUint(1000))
+	future.NotifyOnSettlementData(func(_ context.Context, data *num.Numeric) {
+		outcome.settled = append(outcome.settled, data)
+	})
+	outcome.future = future
+	return outcome
+}
+
+func (o *tstOutcome) publishWinner(winner string) error {
+	return o.settle(context.Background(), dstypes.Data{
+		Data: map[string]string{"price.ETH.value": winner},
+	})
+}
+
+func testWinningOutcomeSettlesAtPayout(t *testing.T) {
+	outcome := testPredictionOutcome(t, 1)
+	require.NoError(t, outcome.publishWinner("1"))
+	require.Len(t, outcome.settled, 1)
+
+	// the payout is already in asset decimals
+	settlement, err := outcome.future.ScaleSettlementDataToDecimalPlaces(outcome.settled[0], 3)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1000), settlement.Uint64())
+}
+
+func testLosingOutcomeSettlesAtZero(t *testing.T) {
+	outcome := testPredictionOutcome(t, 1)
+	require.NoError(t, outcome.publishWinner("2"))
+	require.Len(t, outcome.settled, 1)
+
+	settlement, err := outcome.future.ScaleSettlementDataToDecimalPlaces(outcome.settled[0], 3)
+	require.NoError(t, err)
+	assert.True(t, settlement.IsZero())
+}
+
+func testInvalidWinningOutcomeIgnored(t *testing.T) {
+	outcome := testPredictionOutcome(t, 0)
+	// there are only 3 outcomes
+	require.ErrorIs(t, outcome.publishWinner("3"), products.ErrInvalidWinningOutcome)
+	assert.Empty(t, outcome.settled)
+}
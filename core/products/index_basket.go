@@ -0,0 +1,166 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package products
+
+import (
+	"context"
+	"time"
+
+	dscommon "code.vegaprotocol.io/vega/core/datasource/common"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+)
+
+// indexBasket computes the value of an index from a weighted basket of price sources.
+// The basket holds a quantity of each constituent, chosen the first time all prices are known so
+// that the share of each constituent in the index value matches its weight. In between rebalancings
+// the index value is the sum of the quantities multiplied by the latest prices, so the shares drift
+// with the prices until the next rebalancing resets the quantities.
+type indexBasket struct {
+	constituents      []*basketConstituent
+	rebalanceInterval time.Duration
+	// the time of the last rebalancing, zero until the index is first computed
+	lastRebalance int64
+}
+
+type basketConstituent struct {
+	oracle             *CompositePriceOracle
+	weight             num.Decimal
+	stalenessTolerance time.Duration
+
+	// latest price received, in asset decimals, and when it was received
+	price     *num.Uint
+	updatedAt int64
+	quantity  num.Decimal
+}
+
+func newIndexBasket(ctx context.Context, oe OracleEngine, config *types.IndexBasketConfiguration, cb func(context.Context, int, dscommon.Data) error) (*indexBasket, error) {
+	total := num.DecimalZero()
+	for _, c := range config.Constituents {
+		total = total.Add(c.Weight)
+	}
+
+	b := &indexBasket{
+		constituents:      make([]*basketConstituent, 0, len(config.Constituents)),
+		rebalanceInterval: config.RebalanceInterval,
+	}
+	for i, c := range config.Constituents {
+		idx := i
+		oracle, err := NewCompositePriceOracle(ctx, oe, c.DataSource, c.Binding, func(ctx context.Context, data dscommon.Data) error {
+			return cb(ctx, idx, data)
+		})
+		if err != nil {
+			b.unsubAll(ctx)
+			return nil, err
+		}
+		b.constituents = append(b.constituents, &basketConstituent{
+			oracle:             oracle,
+			weight:             c.Weight.Div(total),
+			stalenessTolerance: c.StalenessTolerance,
+			quantity:           num.DecimalZero(),
+		})
+	}
+	return b, nil
+}
+
+func (b *indexBasket) unsubAll(ctx context.Context) {
+	for _, c := range b.constituents {
+		c.oracle.UnsubAll(ctx)
+	}
+}
+
+// setPrice records the latest price of the i-th constituent and returns the value of the index,
+// or false if it cannot be computed because a constituent has no price yet or its price is stale.
+func (b *indexBasket) setPrice(i int, price *num.Uint, now int64) (*num.Uint, bool) {
+	c := b.constituents[i]
+	c.price = price.Clone()
+	c.updatedAt = now
+	return b.value(now)
+}
+
+func (b *indexBasket) value(now int64) (*num.Uint, bool) {
+	for _, c := range b.constituents {
+		if c.price == nil || c.price.IsZero() {
+			return nil, false
+		}
+		if c.stalenessTolerance > 0 && now-c.updatedAt > c.stalenessTolerance.Nanoseconds() {
+			return nil, false
+		}
+	}
+
+	index := num.DecimalZero()
+	if b.lastRebalance == 0 {
+		// first time we have all the prices, the index starts as the weighted average of the prices
+		for _, c := range b.constituents {
+			index = index.Add(c.weight.Mul(c.price.ToDecimal()))
+		}
+		b.rebalance(index, now)
+	} else {
+		for _, c := range b.constituents {
+			index = index.Add(c.quantity.Mul(c.price.ToDecimal()))
+		}
+		if b.rebalanceInterval > 0 && now-b.lastRebalance >= b.rebalanceInterval.Nanoseconds() {
+			b.rebalance(index, now)
+		}
+	}
+
+	value, _ := num.UintFromDecimal(index.Round(0))
+	return value, true
+}
+
+// rebalance resets the quantity of each constituent so that its share of the index value matches its weight,
+// leaving the index value unchanged.
+func (b *indexBasket) rebalance(index num.Decimal, now int64) {
+	for _, c := range b.constituents {
+		c.quantity = c.weight.Mul(index).Div(c.price.ToDecimal())
+	}
+	b.lastRebalance = now
+}
+
+func (b *indexBasket) serialise() *snapshotpb.IndexBasketState {
+	state := &snapshotpb.IndexBasketState{
+		Constituents:  make([]*snapshotpb.IndexBasketConstituentState, 0, len(b.constituents)),
+		LastRebalance: b.lastRebalance,
+	}
+	for _, c := range b.constituents {
+		var price string
+		if c.price != nil {
+			price = c.price.String()
+		}
+		state.Constituents = append(state.Constituents, &snapshotpb.IndexBasketConstituentState{
+			Price:     price,
+			UpdatedAt: c.updatedAt,
+			Quantity:  c.quantity.String(),
+		})
+	}
+	return state
+}
+
+func (b *indexBasket) restore(state *snapshotpb.IndexBasketState) {
+	b.lastRebalance = state.LastRebalance
+	for i, s := range state.Constituents {
+		if i >= len(b.constituents) {
+			break
+		}
+		c := b.constituents[i]
+		if len(s.Price) > 0 {
+			c.price, _ = num.UintFromString(s.Price, 10)
+		}
+		c.updatedAt = s.UpdatedAt
+		c.quantity = num.MustDecimalFromString(s.Quantity)
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package products
+
+import (
+	"context"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+	datapb "code.vegaprotocol.io/vega/protos/vega/data/v1"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidOptionType is returned when the option is neither a call nor a put.
+	ErrInvalidOptionType = errors.New("option type must be call or put")
+	// ErrInvalidStrikePrice is returned when the strike price cannot be expressed in the asset decimals.
+	ErrInvalidStrikePrice = errors.New("invalid strike price")
+)
+
+// Option represents a cash-settled European option. The contract is traded at its
+// premium and settles against the oracle value of the underlying at expiry.
+// The oracle handling is shared with the future.
+type Option struct {
+	*Future
+	optionType types.OptionType
+	// strike expressed in the asset decimals.
+	strike *num.Uint
+	expiry time.Time
+}
+
+// Settle a position against the option. The payoff of the option replaces the
+// settlement data of the future so the position receives the payoff minus the premium paid.
+func (o *Option) Settle(entryPriceInAsset, settlementData *num.Uint, netFractionalPosition num.Decimal) (amt *types.FinancialAmount, neg bool, rounding num.Decimal, err error) {
+	return o.Future.Settle(entryPriceInAsset, o.Payoff(settlementData), netFractionalPosition)
+}
+
+// Payoff returns the value of one contract given the price of the underlying.
+func (o *Option) Payoff(underlying *num.Uint) *num.Uint {
+	if o.optionType == types.OptionTypeCall {
+		if underlying.LTE(o.strike) {
+			return num.UintZero()
+		}
+		return num.UintZero().Sub(underlying, o.strike)
+	}
+	if underlying.GTE(o.strike) {
+		return num.UintZero()
+	}
+	return num.UintZero().Sub(o.strike, underlying)
+}
+
+// IsCall returns true for a call option, false for a put.
+func (o *Option) IsCall() bool {
+	return o.optionType == types.OptionTypeCall
+}
+
+// Strike returns the strike price expressed in the asset decimals.
+func (o *Option) Strike() *num.Uint {
+	return o.strike.Clone()
+}
+
+// Expiry returns the expiry of the option.
+func (o *Option) Expiry() time.Time {
+	return o.expiry
+}
+
+// Underlying returns the latest value of the underlying received from the settlement
+// data source expressed in the asset decimals, or nil if none was received yet.
+func (o *Option) Underlying() *num.Uint {
+	data := o.oracle.data.settlData
+	if data == nil || (!data.IsDecimal() && !data.IsUint()) {
+		return nil
+	}
+	price, err := o.ScaleSettlementDataToDecimalPlaces(data, o.assetDP)
+	if err != nil {
+		return nil
+	}
+	return price
+}
+
+func (o *Option) Update(ctx context.Context, pp interface{}, oe OracleEngine) error {
+	io, ok := pp.(*types.InstrumentOption)
+	if !ok {
+		o.log.Panic("attempting to update an option into something else")
+	}
+
+	if err := o.Future.Update(ctx, &types.InstrumentFuture{Future: optionAsFuture(io.Option)}, oe); err != nil {
+		return err
+	}
+
+	strike, err := o.scaleStrike(io.Option.StrikePrice)
+	if err != nil {
+		return err
+	}
+	o.optionType = io.Option.OptionType
+	o.strike = strike
+	o.expiry = time.Unix(io.Option.ExpiryTimestamp, 0)
+	return nil
+}
+
+// scaleStrike converts a strike expressed like the settlement data into the asset decimals.
+func (o *Option) scaleStrike(strike num.Decimal) (*num.Uint, error) {
+	n := &num.Numeric{}
+	if o.oracle.binding.settlementType == datapb.PropertyKey_TYPE_DECIMAL {
+		n.SetDecimal(&strike)
+	} else {
+		u, overflow := num.UintFromDecimal(strike)
+		if overflow || !strike.IsInteger() {
+			return nil, ErrInvalidStrikePrice
+		}
+		n.SetUint(u)
+	}
+	scaled, err := o.ScaleSettlementDataToDecimalPlaces(n, o.assetDP)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidStrikePrice.Error())
+	}
+	return scaled, nil
+}
+
+func optionAsFuture(o *types.Option) *types.Future {
+	return &types.Future{
+		SettlementAsset:                     o.SettlementAsset,
+		QuoteName:                           o.QuoteName,
+		DataSourceSpecForSettlementData:     o.DataSourceSpecForSettlementData,
+		DataSourceSpecForTradingTermination: o.DataSourceSpecForTradingTermination,
+		DataSourceSpecBinding:               o.DataSourceSpecBinding,
+	}
+}
+
+func NewOption(ctx context.Context, log *logging.Logger, o *types.Option, oe OracleEngine, assetDP uint32) (*Option, error) {
+	if o.OptionType != types.OptionTypeCall && o.OptionType != types.OptionTypePut {
+		return nil, ErrInvalidOptionType
+	}
+
+	future, err := NewFuture(ctx, log, optionAsFuture(o), oe, assetDP)
+	if err != nil {
+		return nil, err
+	}
+
+	option := &Option{
+		Future:     future,
+		optionType: o.OptionType,
+		expiry:     time.Unix(o.ExpiryTimestamp, 0),
+	}
+	strike, err := option.scaleStrike(o.StrikePrice)
+	if err != nil {
+		future.Unsubscribe(ctx)
+		return nil, err
+	}
+	option.strike = strike
+
+	return option, nil
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package products_test
+
+import (
+	"context"
+	"testing"
+
+	"code.vegaprotocol.io/vega/core/products"
+	"code.vegaprotocol.io/vega/core/products/mocks"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+	datapb "code.vegaprotocol.io/vega/protos/vega/data/v1"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOption(t *testing.T) {
+	t.Run("strike price is scaled to the asset decimals", testOptionStrikeScaling)
+	t.Run("a call option settles its payoff minus the entry price", testOptionSettleCall)
+	t.Run("a put option settles its payoff minus the entry price", testOptionSettlePut)
+	t.Run("an option expiring out of the money settles to zero", testOptionSettleOutOfTheMoney)
+	t.Run("the underlying is taken from the settlement data", testOptionUnderlying)
+	t.Run("an option requires a valid option type", testOptionInvalidType)
+}
+
+func testOptionStrikeScaling(t *testing.T) {
+	// strike is 20 with 5 decimals, asset has 3 decimals
+	opt := testOption(t, types.OptionTypeCall, "2000000", datapb.PropertyKey_TYPE_INTEGER, 3)
+	assert.Equal(t, num.NewUint(20000), opt.Strike())
+
+	// strike is given as a decimal value
+	opt = testOption(t, types.OptionTypePut, "20.5", datapb.PropertyKey_TYPE_DECIMAL, 3)
+	assert.Equal(t, num.NewUint(20500), opt.Strike())
+	assert.False(t, opt.IsCall())
+}
+
+func testOptionSettleCall(t *testing.T) {
+	opt := testOption(t, types.OptionTypeCall, "100", datapb.PropertyKey_TYPE_INTEGER, 5)
+
+	// payoff is 30, premium paid was 10: long position receives 2 * 20
+	amt, neg, _, err := opt.Settle(num.NewUint(10), num.NewUint(130), num.DecimalFromInt64(2))
+	require.NoError(t, err)
+	assert.False(t, neg)
+	assert.Equal(t, num.NewUint(40), amt.Amount)
+
+	// and the short position pays it
+	amt, neg, _, err = opt.Settle(num.NewUint(10), num.NewUint(130), num.DecimalFromInt64(-2))
+	require.NoError(t, err)
+	assert.True(t, neg)
+	assert.Equal(t, num.NewUint(40), amt.Amount)
+}
+
+func testOptionSettlePut(t *testing.T) {
+	opt := testOption(t, types.OptionTypePut, "100", datapb.PropertyKey_TYPE_INTEGER, 5)
+
+	// payoff is 25, premium paid was 30: long position loses 5 per contract
+	amt, neg, _, err := opt.Settle(num.NewUint(30), num.NewUint(75), num.DecimalFromInt64(3))
+	require.NoError(t, err)
+	assert.True(t, neg)
+	assert.Equal(t, num.NewUint(15), amt.Amount)
+}
+
+func testOptionSettleOutOfTheMoney(t *testing.T) {
+	call := testOption(t, types.OptionTypeCall, "100", datapb.PropertyKey_TYPE_INTEGER, 5)
+	assert.True(t, call.Payoff(num.NewUint(90)).IsZero())
+	amt, neg, _, err := call.Settle(num.NewUint(10), num.NewUint(90), num.DecimalFromInt64(-1))
+	require.NoError(t, err)
+	assert.False(t, neg)
+	assert.Equal(t, num.NewUint(10), amt.Amount)
+
+	put := testOption(t, types.OptionTypePut, "100", datapb.PropertyKey_TYPE_INTEGER, 5)
+	assert.True(t, put.Payoff(num.NewUint(100)).IsZero())
+}
+
+func testOptionUnderlying(t *testing.T) {
+	opt := testOption(t, types.OptionTypeCall, "100", datapb.PropertyKey_TYPE_INTEGER, 3)
+	assert.Nil(t, opt.Underlying())
+
+	n := &num.Numeric{}
+	n.SetUint(num.NewUint(12345600))
+	opt.SetSettlementData(context.Background(), "price.ETH.value", n)
+	assert.Equal(t, num.NewUint(123456), opt.Underlying())
+}
+
+func testOptionInvalidType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	oe := mocks.NewMockOracleEngine(ctrl)
+
+	o := optionFromFuture(getTestFutureProd(t, datapb.PropertyKey_TYPE_INTEGER, 5), types.OptionTypeUnspecified, num.DecimalFromInt64(100))
+	_, err := products.NewOption(context.Background(), logging.NewTestLogger(), o, oe, 5)
+	assert.ErrorIs(t, err, products.ErrInvalidOptionType)
+}
+
+func optionFromFuture(f *types.Future, typ types.OptionType, strike num.Decimal) *types.Option {
+	return &types.Option{
+		SettlementAsset:                     f.SettlementAsset,
+		QuoteName:                           f.QuoteName,
+		OptionType:                          typ,
+		StrikePrice:                         strike,
+		ExpiryTimestamp:                     1700000000,
+		DataSourceSpecForSettlementData:     f.DataSourceSpecForSettlementData,
+		DataSourceSpecForTradingTermination: f.DataSourceSpecForTradingTermination,
+		DataSourceSpecBinding:               f.DataSourceSpecBinding,
+	}
+}
+
+func testOption(t *testing.T, typ types.OptionType, strike string, propertyTpe datapb.PropertyKey_Type, assetDP uint32) *products.Option {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	oe := mocks.NewMockOracleEngine(ctrl)
+	tf := &tstFuture{oe: oe}
+	oe.EXPECT().
+		Subscribe(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(2).
+		Return(subscriptionID(1), tf.unsubscribe, nil)
+
+	o := optionFromFuture(getTestFutureProd(t, propertyTpe, 5), typ, num.MustDecimalFromString(strike))
+	opt, err := products.NewOption(context.Background(), logging.NewTestLogger(), o, oe, assetDP)
+	require.NoError(t, err)
+	return opt
+}
@@ -405,6 +405,20 @@ func (c *cachedTWAP) addPoint(point *dataPoint) (*num.Uint, error) {
 	return c.insertPoint(point)
 }
 
+// replaceLastPoint changes the price of the latest data-point and returns the TWAP at its time. Any extension of the
+// calculation past the latest data-point is undone first, so that the running sum-product never includes the old price.
+func (c *cachedTWAP) replaceLastPoint(price *num.Uint) *num.Uint {
+	if len(c.points) == 1 {
+		c.points[0].price = price.Clone()
+		return price.Clone()
+	}
+
+	point := c.points[len(c.points)-1]
+	twap := c.calculate(num.MaxV(c.start, point.t))
+	point.price = price.Clone()
+	return twap
+}
+
 // A data-point that will be used to calculate periodic settlement in a perps market.
 type dataPoint struct {
 	// the asset price
@@ -437,6 +451,9 @@ type Perpetual struct {
 	internalTWAP *cachedTWAP
 	externalTWAP *cachedTWAP
 	auctions     *auctionIntervals
+
+	// basket of price sources used as the underlying index, if any
+	basket *indexBasket
 }
 
 func (p Perpetual) GetCurrentPeriod() uint64 {
@@ -450,7 +467,7 @@ func (p *Perpetual) Update(ctx context.Context, pp interface{}, oe OracleEngine)
 	}
 
 	// unsubsribe all old oracles
-	p.oracle.unsubAll(ctx)
+	p.unsubAll(ctx)
 
 	// grab all the new margin-factor and whatnot.
 	p.p = iPerp.Perps
@@ -478,6 +495,14 @@ func (p *Perpetual) Update(ctx context.Context, pp interface{}, oe OracleEngine)
 	}
 	p.oracle = oracle // ensure oracle on perp is not an old copy
 
+	// the basket is rebuilt from the new configuration, the index restarts once all its new prices are known
+	p.basket = nil
+	if p.p.IndexBasketConfig != nil {
+		if p.basket, err = newIndexBasket(ctx, oe, p.p.IndexBasketConfig, p.receiveBasketPrice); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -517,6 +542,12 @@ func NewPerpetual(ctx context.Context, log *logging.Logger, p *types.Perps, mark
 	}
 	perp.oracle = oracle // ensure oracle on perp is not an old copy
 
+	if p.IndexBasketConfig != nil {
+		if perp.basket, err = newIndexBasket(ctx, oe, p.IndexBasketConfig, perp.receiveBasketPrice); err != nil {
+			return nil, err
+		}
+	}
+
 	return perp, nil
 }
 
@@ -585,13 +616,20 @@ func (p *Perpetual) UnsubscribeTradingTerminated(ctx context.Context) {
 	// we could just use this call to indicate the underlying perp was terminted
 	p.log.Info("unsubscribed trading data and cue oracle on perpetual termination", logging.String("quote-name", p.p.QuoteName))
 	p.terminated = true
-	p.oracle.unsubAll(ctx)
+	p.unsubAll(ctx)
 	p.handleSettlementCue(ctx, p.timeService.GetTimeNow().Truncate(time.Second).UnixNano())
 }
 
 func (p *Perpetual) UnsubscribeSettlementData(ctx context.Context) {
 	p.log.Info("unsubscribed trading settlement data for", logging.String("quote-name", p.p.QuoteName))
+	p.unsubAll(ctx)
+}
+
+func (p *Perpetual) unsubAll(ctx context.Context) {
 	p.oracle.unsubAll(ctx)
+	if p.basket != nil {
+		p.basket.unsubAll(ctx)
+	}
 }
 
 func (p *Perpetual) UpdateAuctionState(ctx context.Context, enter bool) {
@@ -646,6 +684,11 @@ func (p *Perpetual) receiveDataPoint(ctx context.Context, data dscommon.Data) er
 		p.log.Debug("new oracle data received", data.Debug()...)
 	}
 
+	// the underlying index of a basket perpetual comes from its constituents only
+	if p.basket != nil {
+		return nil
+	}
+
 	settlDataDecimals := int64(p.oracle.binding.settlementDecimals)
 	odata := &oracleData{
 		settlData: &num.Numeric{},
@@ -705,6 +748,56 @@ func (p *Perpetual) receiveDataPoint(ctx context.Context, data dscommon.Data) er
 	return nil
 }
 
+// receiveBasketPrice will be hooked up as a subscriber to the oracle data for the price of the i-th constituent of the index basket.
+func (p *Perpetual) receiveBasketPrice(ctx context.Context, i int, data dscommon.Data) error {
+	if p.log.GetLevel() == logging.DebugLevel {
+		p.log.Debug("new index basket data received", append(data.Debug(), logging.Int("constituent", i))...)
+	}
+
+	oracle := p.basket.constituents[i].oracle
+	price, err := oracle.GetData(data)
+	if err != nil {
+		p.log.Error("could not parse the price of an index basket constituent", logging.Int("constituent", i), logging.Error(err))
+		return err
+	}
+
+	assetPrice, err := price.ScaleTo(oracle.GetDecimals(), int64(p.assetDP))
+	if err != nil {
+		p.log.Error("could not scale the index basket price received to asset decimals",
+			logging.String("price", price.String()),
+			logging.Error(err),
+		)
+		return err
+	}
+	pTime, err := data.GetDataTimestampNano()
+	if err != nil {
+		p.log.Error("No timestamp associated with data point",
+			logging.Error(err),
+		)
+		return err
+	}
+
+	index, ok := p.basket.setPrice(i, assetPrice, p.timeService.GetTimeNow().UnixNano())
+	if !ok {
+		// not all constituents have a fresh price yet
+		return nil
+	}
+	p.addIndexDataPoint(ctx, index, pTime)
+	return nil
+}
+
+// addIndexDataPoint adds the value of the index basket as an external data point. When several constituents
+// are updated with the same timestamp the latest value of the index replaces the previous one.
+func (p *Perpetual) addIndexDataPoint(ctx context.Context, price *num.Uint, t int64) {
+	points := p.externalTWAP.points
+	if !p.readyForData() || len(points) == 0 || points[len(points)-1].t != t {
+		p.addExternalDataPoint(ctx, price, t)
+		return
+	}
+	twap := p.externalTWAP.replaceLastPoint(price)
+	p.broker.Send(events.NewFundingPeriodDataPointEvent(ctx, p.id, price.String(), t, p.seq, dataPointSourceExternal, twap))
+}
+
 // receiveDataPoint will be hooked up as a subscriber to the oracle data for incoming settlement data from a data-source.
 func (p *Perpetual) addExternalDataPoint(ctx context.Context, price *num.Uint, t int64) {
 	if !p.readyForData() {
@@ -784,11 +877,6 @@ func (p *Perpetual) GetData(t int64) *types.ProductData {
 	t = time.Unix(0, t).Truncate(time.Second).UnixNano()
 	r := p.calculateFundingPayment(t)
 
-	var underlyingIndexPrice *num.Uint
-	if len(p.externalTWAP.points) > 0 {
-		underlyingIndexPrice = p.externalTWAP.points[len(p.externalTWAP.points)-1].price.Clone()
-	}
-
 	return &types.ProductData{
 		Data: &types.PerpetualData{
 			FundingRate:          r.fundingRate.String(),
@@ -797,11 +885,19 @@ func (p *Perpetual) GetData(t int64) *types.ProductData {
 			ExternalTWAP:         r.externalTWAP.String(),
 			SeqNum:               p.seq,
 			StartTime:            p.startedAt,
-			UnderlyingIndexPrice: underlyingIndexPrice,
+			UnderlyingIndexPrice: p.UnderlyingIndexPrice(),
 		},
 	}
 }
 
+// UnderlyingIndexPrice returns the latest price received from the external oracle, or nil if none was received yet.
+func (p *Perpetual) UnderlyingIndexPrice() *num.Uint {
+	if len(p.externalTWAP.points) == 0 {
+		return nil
+	}
+	return p.externalTWAP.points[len(p.externalTWAP.points)-1].price.Clone()
+}
+
 // restarts the funcing period at time st.
 func (p *Perpetual) startNewFundingPeriod(ctx context.Context, endAt int64) {
 	if p.terminated {
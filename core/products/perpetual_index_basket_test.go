@@ -0,0 +1,232 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package products_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/datasource"
+	dscommon "code.vegaprotocol.io/vega/core/datasource/common"
+	"code.vegaprotocol.io/vega/core/datasource/external/signedoracle"
+	"code.vegaprotocol.io/vega/core/datasource/spec"
+	"code.vegaprotocol.io/vega/core/products"
+	"code.vegaprotocol.io/vega/core/products/mocks"
+	"code.vegaprotocol.io/vega/core/types"
+	tmocks "code.vegaprotocol.io/vega/core/vegatime/mocks"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/ptr"
+	"code.vegaprotocol.io/vega/logging"
+	datapb "code.vegaprotocol.io/vega/protos/vega/data/v1"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tstBasketPerp struct {
+	perpetual *products.Perpetual
+	callbacks map[string]spec.OnMatchedData
+	now       time.Time
+}
+
+func TestIndexBasket(t *testing.T) {
+	t.Run("index is the weighted average of the prices once all are known", testIndexBasketInitialValue)
+	t.Run("index follows the prices with constant quantities", testIndexBasketConstantQuantities)
+	t.Run("settlement data is ignored for a basket perpetual", testIndexBasketIgnoresSettlementData)
+	t.Run("index is not updated while a price is stale", testIndexBasketStalePrice)
+	t.Run("basket is rebalanced at the rebalance interval", testIndexBasketRebalance)
+	t.Run("basket state is serialised", testIndexBasketSerialise)
+}
+
+func testIndexBasketInitialValue(t *testing.T) {
+	tp := testBasketPerpetual(t, 0)
+	t0 := tp.now
+
+	tp.sendPrice(t, "btc", "1000", t0.Add(time.Second))
+	assert.Nil(t, tp.perpetual.UnderlyingIndexPrice())
+
+	// both prices arrive with the same timestamp, the index replaces the previous data point
+	tp.sendPrice(t, "eth", "3000", t0.Add(time.Second))
+	assert.Equal(t, "2000", tp.perpetual.UnderlyingIndexPrice().String())
+}
+
+func testIndexBasketConstantQuantities(t *testing.T) {
+	tp := testBasketPerpetual(t, 0)
+	t0 := tp.now
+
+	tp.sendPrice(t, "btc", "1000", t0.Add(time.Second))
+	tp.sendPrice(t, "eth", "3000", t0.Add(time.Second))
+
+	// the basket holds 1 btc and 1/3 eth
+	tp.sendPrice(t, "btc", "2000", t0.Add(2*time.Second))
+	assert.Equal(t, "3000", tp.perpetual.UnderlyingIndexPrice().String())
+
+	tp.sendPrice(t, "eth", "6000", t0.Add(3*time.Second))
+	assert.Equal(t, "4000", tp.perpetual.UnderlyingIndexPrice().String())
+}
+
+func testIndexBasketIgnoresSettlementData(t *testing.T) {
+	tp := testBasketPerpetual(t, 0)
+	t0 := tp.now
+
+	tp.sendPrice(t, "btc", "1000", t0.Add(time.Second))
+	tp.sendPrice(t, "eth", "3000", t0.Add(time.Second))
+	tp.sendPrice(t, "foo", "50", t0.Add(2*time.Second))
+	assert.Equal(t, "2000", tp.perpetual.UnderlyingIndexPrice().String())
+}
+
+func testIndexBasketStalePrice(t *testing.T) {
+	tp := testBasketPerpetual(t, 0)
+	t0 := tp.now
+
+	tp.sendPrice(t, "btc", "1000", t0.Add(time.Second))
+	tp.sendPrice(t, "eth", "3000", t0.Add(time.Second))
+
+	// the eth price is only valid for a minute
+	tp.now = t0.Add(2 * time.Minute)
+	tp.sendPrice(t, "btc", "2000", tp.now)
+	assert.Equal(t, "2000", tp.perpetual.UnderlyingIndexPrice().String())
+
+	// a fresh eth price brings the index back
+	tp.sendPrice(t, "eth", "3000", tp.now.Add(time.Second))
+	assert.Equal(t, "3000", tp.perpetual.UnderlyingIndexPrice().String())
+}
+
+func testIndexBasketRebalance(t *testing.T) {
+	tp := testBasketPerpetual(t, time.Hour)
+	t0 := tp.now
+
+	tp.sendPrice(t, "btc", "1000", t0.Add(time.Second))
+	tp.sendPrice(t, "eth", "3000", t0.Add(time.Second))
+
+	tp.now = t0.Add(30 * time.Second)
+	tp.sendPrice(t, "btc", "2000", tp.now)
+	assert.Equal(t, "3000", tp.perpetual.UnderlyingIndexPrice().String())
+
+	// one hour later the basket is rebalanced after computing the index with the quantities 1 btc and 1/3 eth
+	tp.now = t0.Add(time.Hour + time.Second)
+	tp.sendPrice(t, "eth", "3000", tp.now)
+	assert.Equal(t, "3000", tp.perpetual.UnderlyingIndexPrice().String())
+
+	// the basket now holds 0.75 btc and 0.5 eth
+	tp.sendPrice(t, "eth", "4000", tp.now.Add(2*time.Second))
+	assert.Equal(t, "3500", tp.perpetual.UnderlyingIndexPrice().String())
+}
+
+func testIndexBasketSerialise(t *testing.T) {
+	tp := testBasketPerpetual(t, time.Hour)
+	t0 := tp.now
+
+	tp.sendPrice(t, "btc", "1000", t0.Add(time.Second))
+	tp.sendPrice(t, "eth", "3000", t0.Add(time.Second))
+
+	state := tp.perpetual.Serialize().GetPerps().IndexBasket
+	require.NotNil(t, state)
+	assert.Equal(t, t0.UnixNano(), state.LastRebalance)
+	require.Len(t, state.Constituents, 2)
+	assert.Equal(t, "1000", state.Constituents[0].Price)
+	assert.Equal(t, "1", state.Constituents[0].Quantity)
+	assert.Equal(t, "3000", state.Constituents[1].Price)
+}
+
+func (tp *tstBasketPerp) sendPrice(t *testing.T, property, price string, at time.Time) {
+	t.Helper()
+	cb, ok := tp.callbacks[property]
+	require.True(t, ok)
+	require.NoError(t, cb(context.Background(), dscommon.Data{
+		Data: map[string]string{
+			property: price,
+		},
+		MetaData: map[string]string{
+			"eth-block-time": fmt.Sprintf("%d", at.Unix()),
+		},
+	}))
+}
+
+func testBasketPerpetual(t *testing.T, rebalanceInterval time.Duration) *tstBasketPerp {
+	t.Helper()
+
+	log := logging.NewTestLogger()
+	ctrl := gomock.NewController(t)
+	oe := mocks.NewMockOracleEngine(ctrl)
+	broker := mocks.NewMockBroker(ctrl)
+	ts := tmocks.NewMockTimeService(ctrl)
+
+	tp := &tstBasketPerp{
+		callbacks: map[string]spec.OnMatchedData{},
+		now:       time.Unix(1700000000, 0),
+	}
+	oe.EXPECT().Subscribe(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(_ context.Context, s spec.Spec, cb spec.OnMatchedData) (spec.SubscriptionID, spec.Unsubscriber, error) {
+		for _, f := range s.OriginalSpec.GetDefinition().DataSourceType.GetFilters() {
+			tp.callbacks[f.Key.Name] = cb
+		}
+		return spec.SubscriptionID(1), func(_ context.Context, _ spec.SubscriptionID) {}, nil
+	})
+	broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+	ts.EXPECT().GetTimeNow().AnyTimes().DoAndReturn(func() time.Time { return tp.now })
+
+	perp := getTestPerpProd(t)
+	perp.IndexBasketConfig = &types.IndexBasketConfiguration{
+		Constituents: []*types.IndexBasketConstituent{
+			{
+				DataSource: basketPriceSource("btc"),
+				Binding:    &datasource.SpecBindingForCompositePrice{PriceSourceProperty: "btc"},
+				Weight:     num.DecimalFromInt64(1),
+			},
+			{
+				DataSource:         basketPriceSource("eth"),
+				Binding:            &datasource.SpecBindingForCompositePrice{PriceSourceProperty: "eth"},
+				Weight:             num.DecimalFromInt64(1),
+				StalenessTolerance: time.Minute,
+			},
+		},
+		RebalanceInterval: rebalanceInterval,
+	}
+
+	perpetual, err := products.NewPerpetual(context.Background(), log, perp, "", ts, oe, broker, 1)
+	require.NoError(t, err)
+	perpetual.UpdateAuctionState(context.Background(), false)
+
+	tp.perpetual = perpetual
+	return tp
+}
+
+func basketPriceSource(property string) *datasource.Spec {
+	return &datasource.Spec{
+		Data: datasource.NewDefinition(
+			datasource.ContentTypeOracle,
+		).SetOracleConfig(
+			&signedoracle.SpecConfiguration{
+				Signers: []*dscommon.Signer{
+					dscommon.CreateSignerFromString("0xDEADBEEF", dscommon.SignerTypePubKey),
+				},
+				Filters: []*dscommon.SpecFilter{
+					{
+						Key: &dscommon.SpecPropertyKey{
+							Name:                property,
+							Type:                datapb.PropertyKey_TYPE_INTEGER,
+							NumberDecimalPlaces: ptr.From(uint64(1)),
+						},
+					},
+				},
+			},
+		),
+	}
+}
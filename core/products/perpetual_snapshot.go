@@ -91,6 +91,9 @@ func NewPerpetualFromSnapshot(
 
 	perps.externalTWAP = NewCachedTWAPFromSnapshot(log, state.StartedAt, perps.auctions, state.ExternalTwapData, state.ExternalDataPoint)
 	perps.internalTWAP = NewCachedTWAPFromSnapshot(log, state.StartedAt, perps.auctions, state.InternalTwapData, state.InternalDataPoint)
+	if perps.basket != nil && state.IndexBasket != nil {
+		perps.basket.restore(state.IndexBasket)
+	}
 	return perps, nil
 }
 
@@ -109,6 +112,9 @@ func (p *Perpetual) Serialize() *snapshotpb.Product {
 		ExternalTwapData: p.externalTWAP.serialise(),
 		InternalTwapData: p.internalTWAP.serialise(),
 	}
+	if p.basket != nil {
+		perps.IndexBasket = p.basket.serialise()
+	}
 
 	for _, v := range p.externalTWAP.points {
 		perps.ExternalDataPoint = append(perps.ExternalDataPoint, &snapshotpb.DataPoint{
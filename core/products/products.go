@@ -92,6 +92,8 @@ func New(ctx context.Context, log *logging.Logger, pp interface{}, marketID stri
 		return NewFuture(ctx, log, p.Future, oe, assetDP)
 	case *types.InstrumentPerps:
 		return NewPerpetual(ctx, log, p.Perps, marketID, ts, oe, broker, assetDP)
+	case *types.InstrumentOption:
+		return NewOption(ctx, log, p.Option, oe, assetDP)
 	default:
 		return nil, ErrUnimplementedProduct
 	}
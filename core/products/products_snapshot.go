@@ -45,6 +45,8 @@ func NewFromSnapshot(
 	switch p := pp.(type) {
 	case *types.InstrumentFuture: // no state in the future, so all OK
 		return NewFuture(ctx, log, p.Future, oe, assetDP)
+	case *types.InstrumentOption: // no state in the option either
+		return NewOption(ctx, log, p.Option, oe, assetDP)
 	case *types.InstrumentPerps:
 		perpsState := state.GetPerps()
 		if perpsState == nil {
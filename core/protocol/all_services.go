@@ -336,7 +336,7 @@ func newServices(
 	svcs.volumeRebate = volumerebate.NewSnapshottedEngine(svcs.broker, svcs.marketActivityTracker)
 	svcs.banking = banking.New(svcs.log, svcs.conf.Banking, svcs.collateral, svcs.witness, svcs.timeService,
 		svcs.assets, svcs.notary, svcs.broker, svcs.topology, svcs.marketActivityTracker, svcs.primaryBridgeView,
-		svcs.secondaryBridgeView, svcs.forwarderHeartbeat, svcs.partiesEngine)
+		svcs.secondaryBridgeView, svcs.forwarderHeartbeat, svcs.partiesEngine, svcs.oracle)
 
 	// instantiate the execution engine
 	svcs.executionEngine = execution.NewEngine(
@@ -745,6 +745,10 @@ func (svcs *allServices) setupNetParameters(powWatchers []netparams.WatchParam)
 			Param:   netparams.MarketMarginScalingFactors,
 			Watcher: svcs.executionEngine.OnMarketMarginScalingFactorsUpdate,
 		},
+		{
+			Param:   netparams.MarketMarginPortfolioGroups,
+			Watcher: svcs.executionEngine.OnMarketMarginPortfolioGroupsUpdate,
+		},
 		{
 			Param:   netparams.MarketFeeFactorsMakerFee,
 			Watcher: svcs.executionEngine.OnMarketFeeFactorsMakerFeeUpdate,
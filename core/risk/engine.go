@@ -98,6 +98,13 @@ type Engine struct {
 	linearSlippageFactor    num.Decimal
 	quadraticSlippageFactor num.Decimal
 
+	// set for option markets, margins are then based on the option delta
+	option           OptionProduct
+	optionVolatility num.Decimal
+
+	// set when portfolio margining is available, nets positions across markets
+	portfolio *Portfolio
+
 	// a map of margin levels events to be send
 	// should be flushed after the processing of every transaction
 	// partyId -> MarginLevelsEvent
@@ -148,7 +155,12 @@ func NewEngine(log *logging.Logger,
 		quadraticSlippageFactor: quadraticSlippageFactor,
 		marginLevelsUpdates:     map[string]*events.MarginLevels{},
 	}
-	stateVarEngine.RegisterStateVariable(asset, mktID, RiskFactorStateVarName, FactorConverter{}, e.startRiskFactorsCalculation, []statevar.EventType{statevar.EventTypeMarketEnactment, statevar.EventTypeMarketUpdated}, e.updateRiskFactor)
+	triggers := []statevar.EventType{statevar.EventTypeMarketEnactment, statevar.EventTypeMarketUpdated}
+	if _, ok := model.(PriceHistoryModel); ok {
+		// the risk factors move with the price history so they need to be agreed periodically
+		triggers = append(triggers, statevar.EventTypeTimeTrigger)
+	}
+	stateVarEngine.RegisterStateVariable(asset, mktID, RiskFactorStateVarName, FactorConverter{}, e.startRiskFactorsCalculation, triggers, e.updateRiskFactor)
 
 	if initialisedRiskFactors != nil {
 		e.cfgMu.Lock()
@@ -387,6 +399,17 @@ func (e *Engine) UpdateMarginOnNewOrder(ctx context.Context, evt events.Margin,
 	return change, nil, nil
 }
 
+// InitialMarginOnNewOrder returns the initial margin UpdateMarginOnNewOrder requires for the position, including its potential orders.
+// NB: pure calculation, no events emitted, no state changed.
+func (e *Engine) InitialMarginOnNewOrder(evt events.Margin, markPrice *num.Uint, increment num.Decimal, auctionPrice *num.Uint) *num.Uint {
+	auction := e.as.InAuction() && !e.as.CanLeave()
+	margins := e.calculateMargins(evt, markPrice, *e.factors, true, auction, increment, auctionPrice)
+	if margins == nil {
+		return num.UintZero()
+	}
+	return margins.InitialMargin.Clone()
+}
+
 // UpdateMarginsOnSettlement ensure the margin requirement over all positions.
 // margins updates are based on the following requirement
 //
@@ -557,6 +580,16 @@ func (e *Engine) ExpectMargins(evts []events.Margin, markPrice *num.Uint, increm
 	return okMargins, distressedPositions
 }
 
+// MaintenanceMargin returns the maintenance margin required for the given position, ignoring potential orders.
+func (e *Engine) MaintenanceMargin(evt events.Margin, markPrice *num.Uint, increment num.Decimal, auctionPrice *num.Uint) *num.Uint {
+	auction := e.as.InAuction() && !e.as.CanLeave()
+	margins := e.calculateMargins(evt, markPrice, *e.factors, false, auction, increment, auctionPrice)
+	if margins == nil {
+		return num.UintZero()
+	}
+	return margins.MaintenanceMargin.Clone()
+}
+
 func (m marginChange) Amount() *num.Uint {
 	if m.transfer == nil {
 		return nil
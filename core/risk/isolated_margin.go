@@ -78,6 +78,43 @@ func (e *Engine) UpdateIsolatedMarginOnAggressor(ctx context.Context, evt events
 	if evt == nil {
 		return nil, nil
 	}
+	margins, transfers, err := e.isolatedMarginOnAggressor(evt, marketObservable, increment, orders, trades, marginFactor, traderSide, isAmend, fees)
+	if err != nil {
+		return nil, err
+	}
+	e.updateMarginLevels(events.NewMarginLevelsEvent(ctx, *margins))
+	if transfers == nil {
+		return nil, nil
+	}
+	ret := []events.Risk{}
+	for _, t := range transfers {
+		ret = append(ret, &marginChange{
+			Margin:   evt,
+			transfer: t,
+			margins:  margins,
+		})
+	}
+	return ret, nil
+}
+
+// IsolatedMarginOnAggressor runs the checks of UpdateIsolatedMarginOnAggressor for a new order, and returns the amount that would be moved
+// from the general account of the party to its margin account.
+// NB: pure calculation, no events emitted, no state changed.
+func (e *Engine) IsolatedMarginOnAggressor(evt events.Margin, marketObservable *num.Uint, increment num.Decimal, orders []*types.Order, trades []*types.Trade, marginFactor num.Decimal, traderSide types.Side, fees *num.Uint) (*num.Uint, error) {
+	_, transfers, err := e.isolatedMarginOnAggressor(evt, marketObservable, increment, orders, trades, marginFactor, traderSide, false, fees)
+	if err != nil {
+		return nil, err
+	}
+	amount := num.UintZero()
+	for _, t := range transfers {
+		if t.Type == types.TransferTypeMarginLow {
+			amount.AddSum(t.Amount.Amount)
+		}
+	}
+	return amount, nil
+}
+
+func (e *Engine) isolatedMarginOnAggressor(evt events.Margin, marketObservable *num.Uint, increment num.Decimal, orders []*types.Order, trades []*types.Trade, marginFactor num.Decimal, traderSide types.Side, isAmend bool, fees *num.Uint) (*types.MarginLevels, []*types.Transfer, error) {
 	margins := e.calculateIsolatedMargins(evt, marketObservable, increment, marginFactor, nil, orders)
 	tradedSize := int64(0)
 	side := trades[0].Aggressor
@@ -94,21 +131,21 @@ func (e *Engine) UpdateIsolatedMarginOnAggressor(ctx context.Context, evt events
 		if int64Abs(oldPosition) < int64Abs(evt.Size()) { // position increased
 			requiredMargin, _ = num.UintFromDecimal(requiredMargin.ToDecimal().Div(e.positionFactor).Mul(marginFactor))
 			if num.Sum(requiredMargin, evt.MarginBalance()).LT(margins.MaintenanceMargin) {
-				return nil, ErrInsufficientFundsForMaintenanceMargin
+				return nil, nil, ErrInsufficientFundsForMaintenanceMargin
 			}
 			if !isAmend && requiredMargin.GT(evt.GeneralAccountBalance()) {
-				return nil, ErrInsufficientFundsForMarginInGeneralAccount
+				return nil, nil, ErrInsufficientFundsForMarginInGeneralAccount
 			}
 			if isAmend && requiredMargin.GT(num.Sum(evt.GeneralAccountBalance(), evt.OrderMarginBalance())) {
-				return nil, ErrInsufficientFundsForMarginInGeneralAccount
+				return nil, nil, ErrInsufficientFundsForMarginInGeneralAccount
 			}
 			// new order, given that they can cover for the trade, do they have enough left to cover the fees?
 			if !isAmend && num.Sum(requiredMargin, fees).GT(num.Sum(evt.GeneralAccountBalance(), evt.MarginBalance())) {
-				return nil, ErrInsufficientFundsToCoverTradeFees
+				return nil, nil, ErrInsufficientFundsToCoverTradeFees
 			}
 			// amended order, given that they can cover for the trade, do they have enough left to cover the fees for the amended order's trade?
 			if isAmend && num.Sum(requiredMargin, fees).GT(num.Sum(evt.GeneralAccountBalance(), evt.MarginBalance(), evt.OrderMarginBalance())) {
-				return nil, ErrInsufficientFundsToCoverTradeFees
+				return nil, nil, ErrInsufficientFundsToCoverTradeFees
 			}
 		}
 	} else {
@@ -133,30 +170,17 @@ func (e *Engine) UpdateIsolatedMarginOnAggressor(ctx context.Context, evt events
 		// 2) there are sufficient funds in what's currently in the margin account + general account to cover for the new required margin
 		requiredMargin, _ = num.UintFromDecimal(requiredMargin.ToDecimal().Div(e.positionFactor).Mul(marginFactor))
 		if num.Sum(requiredMargin, evt.MarginBalance()).LT(margins.MaintenanceMargin) {
-			return nil, ErrInsufficientFundsForMaintenanceMargin
+			return nil, nil, ErrInsufficientFundsForMaintenanceMargin
 		}
 		if requiredMargin.GT(num.Sum(evt.GeneralAccountBalance(), evt.MarginBalance())) {
-			return nil, ErrInsufficientFundsForMarginInGeneralAccount
+			return nil, nil, ErrInsufficientFundsForMarginInGeneralAccount
 		}
 		if num.Sum(requiredMargin, fees).GT(num.Sum(evt.GeneralAccountBalance(), evt.MarginBalance())) {
-			return nil, ErrInsufficientFundsToCoverTradeFees
+			return nil, nil, ErrInsufficientFundsToCoverTradeFees
 		}
 	}
 
-	e.updateMarginLevels(events.NewMarginLevelsEvent(ctx, *margins))
-	transfers := getIsolatedMarginTransfersOnPositionChange(evt.Party(), evt.Asset(), trades, traderSide, evt.Size(), e.positionFactor, marginFactor, evt.MarginBalance(), evt.OrderMarginBalance(), marketObservable, true, isAmend)
-	if transfers == nil {
-		return nil, nil
-	}
-	ret := []events.Risk{}
-	for _, t := range transfers {
-		ret = append(ret, &marginChange{
-			Margin:   evt,
-			transfer: t,
-			margins:  margins,
-		})
-	}
-	return ret, nil
+	return margins, getIsolatedMarginTransfersOnPositionChange(evt.Party(), evt.Asset(), trades, traderSide, evt.Size(), e.positionFactor, marginFactor, evt.MarginBalance(), evt.OrderMarginBalance(), marketObservable, true, isAmend), nil
 }
 
 // UpdateIsolatedMarginOnOrder checks that the party has sufficient cover for the given orders including the new one. It returns an error if the party doesn't have sufficient cover and the necessary transfers otherwise.
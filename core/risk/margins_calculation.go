@@ -180,13 +180,16 @@ func (e *Engine) calculateMargins(m events.Margin, markPrice *num.Uint, rf types
 	}
 
 	mPriceDec := markPrice.ToDecimal()
+	// the value the risk factors apply to, the mark price unless the product is an option
+	lngRiskPrice := e.riskPerUnit(mPriceDec, true)
+	shtRiskPrice := e.riskPerUnit(mPriceDec, false)
 	// calculate margin maintenance long only if riskiest is > 0
 	// marginMaintenanceLng will be 0 by default
 	if riskiestLng.IsPositive() {
 		slippageVolume := num.MaxD(openVolume, num.DecimalZero())
 		minV := mPriceDec.Mul(e.linearSlippageFactor.Mul(slippageVolume).Add(e.quadraticSlippageFactor.Mul(slippageVolume.Mul(slippageVolume))))
 		if auction {
-			marginMaintenanceLng = minV.Add(slippageVolume.Mul(mPriceDec.Mul(rf.Long)))
+			marginMaintenanceLng = minV.Add(slippageVolume.Mul(lngRiskPrice.Mul(rf.Long)))
 			if withPotentialBuyAndSell {
 				p := m.BuySumProduct()
 				if auctionPrice != nil {
@@ -206,10 +209,10 @@ func (e *Engine) calculateMargins(m events.Margin, markPrice *num.Uint, rf types
 			marginMaintenanceLng = num.MaxD(
 				num.DecimalZero(),
 				minV,
-			).Add(slippageVolume.Mul(rf.Long).Mul(mPriceDec))
+			).Add(slippageVolume.Mul(rf.Long).Mul(lngRiskPrice))
 			if withPotentialBuyAndSell {
 				bDec := num.DecimalFromInt64(m.Buy()).Div(e.positionFactor)
-				maintenanceMarginLongOpenOrders := bDec.Mul(rf.Long).Mul(mPriceDec)
+				maintenanceMarginLongOpenOrders := bDec.Mul(rf.Long).Mul(lngRiskPrice)
 				marginMaintenanceLng = marginMaintenanceLng.Add(maintenanceMarginLongOpenOrders)
 			}
 		}
@@ -222,7 +225,7 @@ func (e *Engine) calculateMargins(m events.Margin, markPrice *num.Uint, rf types
 		quadraticSlipage := absSlippageVolume.Mul(absSlippageVolume).Mul(e.quadraticSlippageFactor)
 		minV := mPriceDec.Mul(linearSlippage.Add(quadraticSlipage))
 		if auction {
-			marginMaintenanceSht = minV.Add(absSlippageVolume.Mul(mPriceDec.Mul(rf.Short)))
+			marginMaintenanceSht = minV.Add(absSlippageVolume.Mul(shtRiskPrice.Mul(rf.Short)))
 			if withPotentialBuyAndSell {
 				p := m.SellSumProduct()
 				if auctionPrice != nil {
@@ -242,10 +245,10 @@ func (e *Engine) calculateMargins(m events.Margin, markPrice *num.Uint, rf types
 			marginMaintenanceSht = num.MaxD(
 				num.DecimalZero(),
 				minV,
-			).Add(absSlippageVolume.Mul(mPriceDec).Mul(rf.Short))
+			).Add(absSlippageVolume.Mul(shtRiskPrice).Mul(rf.Short))
 			if withPotentialBuyAndSell {
 				sDec := num.DecimalFromInt64(m.Sell()).Div(e.positionFactor)
-				maintenanceMarginShortOpenOrders := sDec.Abs().Mul(mPriceDec).Mul(rf.Short)
+				maintenanceMarginShortOpenOrders := sDec.Abs().Mul(shtRiskPrice).Mul(rf.Short)
 				marginMaintenanceSht = marginMaintenanceSht.Add(maintenanceMarginShortOpenOrders)
 			}
 		}
@@ -258,6 +261,17 @@ func (e *Engine) calculateMargins(m events.Margin, markPrice *num.Uint, rf types
 		marginMaintenanceSht = marginMaintenanceSht.Add(incD)
 	}
 
+	// offsetting positions in the other markets of the portfolio reduce the margin of the open position
+	if !auction {
+		if discount := e.portfolioDiscount(m.Party(), openVolume, mPriceDec, rf); discount.IsPositive() {
+			if openVolume.IsPositive() {
+				marginMaintenanceLng = num.MaxD(num.DecimalZero(), marginMaintenanceLng.Sub(discount))
+			} else {
+				marginMaintenanceSht = num.MaxD(num.DecimalZero(), marginMaintenanceSht.Sub(discount))
+			}
+		}
+	}
+
 	// the greatest liability is the most positive number
 	if marginMaintenanceLng.GreaterThan(marginMaintenanceSht) && marginMaintenanceLng.IsPositive() {
 		return newMarginLevels(marginMaintenanceLng, e.scalingFactorsUint)
@@ -17,10 +17,12 @@ package risk
 
 import (
 	"errors"
+	"time"
 
 	"code.vegaprotocol.io/vega/core/risk/models"
 	"code.vegaprotocol.io/vega/core/types"
 	"code.vegaprotocol.io/vega/libs/num"
+	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
 )
 
 var (
@@ -39,6 +41,13 @@ type Model interface {
 	GetProjectionHorizon() num.Decimal
 }
 
+// PriceHistoryModel is implemented by the risk models built from the history of the mark price.
+type PriceHistoryModel interface {
+	AddPrice(t time.Time, price *num.Uint)
+	Serialise() []*snapshotpb.DataPoint
+	Restore(points []*snapshotpb.DataPoint) error
+}
+
 // NewModel instantiate a new risk model from a market framework configuration.
 func NewModel(prm interface{}, asset string) (Model, error) {
 	if prm == nil {
@@ -50,6 +59,8 @@ func NewModel(prm interface{}, asset string) (Model, error) {
 		return models.NewBuiltinFutures(rm.LogNormalRiskModel, asset)
 	case *types.TradableInstrumentSimpleRiskModel:
 		return models.NewSimple(rm.SimpleRiskModel, asset)
+	case *types.TradableInstrumentHistoricalSimulationRiskModel:
+		return models.NewHistoricalSimulation(rm.HistoricalSimulationRiskModel, asset)
 	default:
 		return nil, ErrUnimplementedRiskModel
 	}
@@ -0,0 +1,288 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+)
+
+var (
+	ErrMissingHistoricalSimulationParameter = errors.New("missing historical simulation parameters")
+	ErrInvalidPriceHistory                  = errors.New("invalid price history")
+)
+
+const nanosPerYear = 365.25 * 24 * float64(time.Hour)
+
+type pricePoint struct {
+	price *num.Uint
+	t     int64
+}
+
+// HistoricalSimulation is a risk model using the empirical distribution of the returns
+// of the mark price over a rolling window. When a decay factor is set, the returns are
+// rescaled by an exponentially weighted estimate of the volatility (filtered historical simulation).
+// Until enough observations are collected a log-normal distribution with the fallback volatility is used.
+type HistoricalSimulation struct {
+	riskAversionParameter, tau num.Decimal
+	windowSize                 int
+	minObservations            int
+	decayFactor                float64
+	fallbackSigma              float64
+	asset                      string
+
+	prices []pricePoint
+	// sorted annualised returns, nil if they need to be rebuilt
+	scenarios []float64
+}
+
+// NewHistoricalSimulation instantiates a new historical simulation risk model.
+func NewHistoricalSimulation(hs *types.HistoricalSimulationRiskModel, asset string) (*HistoricalSimulation, error) {
+	if hs == nil || hs.WindowSize == 0 {
+		return nil, ErrMissingHistoricalSimulationParameter
+	}
+	decay, _ := hs.DecayFactor.Float64()
+	sigma, _ := hs.FallbackSigma.Float64()
+	return &HistoricalSimulation{
+		riskAversionParameter: hs.RiskAversionParameter,
+		tau:                   hs.Tau,
+		windowSize:            int(hs.WindowSize),
+		minObservations:       int(hs.MinObservations),
+		decayFactor:           decay,
+		fallbackSigma:         sigma,
+		asset:                 asset,
+		prices:                make([]pricePoint, 0, hs.WindowSize+1),
+	}, nil
+}
+
+// AddPrice records a new observation of the mark price. Observations at the same time
+// replace each other, the window keeps the latest window size returns.
+func (h *HistoricalSimulation) AddPrice(t time.Time, price *num.Uint) {
+	if price == nil || price.IsZero() {
+		return
+	}
+	ts := t.UnixNano()
+	if n := len(h.prices); n > 0 && h.prices[n-1].t >= ts {
+		if h.prices[n-1].t > ts {
+			return
+		}
+		h.prices = h.prices[:n-1]
+	}
+	h.prices = append(h.prices, pricePoint{price: price.Clone(), t: ts})
+	if len(h.prices) > h.windowSize+1 {
+		h.prices = append(h.prices[:0], h.prices[len(h.prices)-h.windowSize-1:]...)
+	}
+	h.scenarios = nil
+}
+
+// Serialise returns the observations currently in the window.
+func (h *HistoricalSimulation) Serialise() []*snapshotpb.DataPoint {
+	points := make([]*snapshotpb.DataPoint, 0, len(h.prices))
+	for _, p := range h.prices {
+		points = append(points, &snapshotpb.DataPoint{
+			Price:     p.price.String(),
+			Timestamp: p.t,
+		})
+	}
+	return points
+}
+
+// Restore replaces the observations with the ones from a snapshot, or from the previous model when the
+// parameters of the market change. Only the latest observations that fit in the window are kept.
+func (h *HistoricalSimulation) Restore(points []*snapshotpb.DataPoint) error {
+	if len(points) > h.windowSize+1 {
+		points = points[len(points)-h.windowSize-1:]
+	}
+	prices := make([]pricePoint, 0, len(points))
+	for _, p := range points {
+		price, overflow := num.UintFromString(p.Price, 10)
+		if overflow {
+			return ErrInvalidPriceHistory
+		}
+		prices = append(prices, pricePoint{price: price, t: p.Timestamp})
+	}
+	h.prices = prices
+	h.scenarios = nil
+	return nil
+}
+
+// CalculateRiskFactors returns the loss at the risk aversion quantile of the distribution
+// of the returns over the projection horizon, for a long and a short position respectively.
+func (h *HistoricalSimulation) CalculateRiskFactors() *types.RiskFactor {
+	lambda, _ := h.riskAversionParameter.Float64()
+	tau, _ := h.tau.Float64()
+	long := math.Max(0, 1-math.Exp(h.quantile(lambda, tau)))
+	short := math.Max(0, math.Exp(h.quantile(1-lambda, tau))-1)
+	return &types.RiskFactor{
+		Long:  num.DecimalFromFloat(long),
+		Short: num.DecimalFromFloat(short),
+	}
+}
+
+// PriceRange returns the minimum and maximum price implied by the distribution of the returns
+// with horizon given by yearFraction and probability level (e.g. 0.95 for 95%).
+func (h *HistoricalSimulation) PriceRange(currentP, yFrac, probabilityLevel num.Decimal) (num.Decimal, num.Decimal) {
+	yf, _ := yFrac.Float64()
+	pl, _ := probabilityLevel.Float64()
+	price := currentP.InexactFloat64()
+	min := price * math.Exp(h.quantile((1-pl)/2, yf))
+	max := price * math.Exp(h.quantile((1+pl)/2, yf))
+	return num.DecimalFromFloat(min), num.DecimalFromFloat(max)
+}
+
+// ProbabilityOfTrading returns the probability of the price reaching the order price
+// over the horizon, optionally truncating the distribution outside of [minP, maxP].
+func (h *HistoricalSimulation) ProbabilityOfTrading(currentP, orderP, minP, maxP, yFrac num.Decimal, isBid, applyMinMax bool) num.Decimal {
+	price := currentP.InexactFloat64()
+	order := orderP.InexactFloat64()
+	if price <= 0 || order <= 0 {
+		return num.DecimalZero()
+	}
+	yf, _ := yFrac.Float64()
+	cdf := func(p float64) float64 {
+		if p <= 0 {
+			return 0
+		}
+		return h.cdf(math.Log(p/price), yf)
+	}
+
+	var prob float64
+	if isBid {
+		prob = cdf(order)
+	} else {
+		prob = 1 - cdf(order)
+	}
+	if applyMinMax {
+		min, max := math.Max(minP.InexactFloat64(), 0), maxP.InexactFloat64()
+		if order < min || order > max {
+			return num.DecimalZero()
+		}
+		total := cdf(max) - cdf(min)
+		if total <= 0 {
+			return num.DecimalZero()
+		}
+		if isBid {
+			prob = (cdf(order) - cdf(min)) / total
+		} else {
+			prob = (cdf(max) - cdf(order)) / total
+		}
+	}
+	if math.IsNaN(prob) {
+		return num.DecimalZero()
+	}
+	return num.DecimalFromFloat(math.Min(math.Max(prob, 0), 1))
+}
+
+// GetProjectionHorizon returns the projection horizon used by the model for margin calculation purposes.
+func (h *HistoricalSimulation) GetProjectionHorizon() num.Decimal {
+	return h.tau
+}
+
+// DefaultRiskFactors returns the risk factors implied by the fallback volatility,
+// used until the risk factors are agreed by the validators.
+func (h *HistoricalSimulation) DefaultRiskFactors() *types.RiskFactor {
+	lambda, _ := h.riskAversionParameter.Float64()
+	tau, _ := h.tau.Float64()
+	sd := h.fallbackSigma * math.Sqrt(tau)
+	return &types.RiskFactor{
+		Long:  num.DecimalFromFloat(math.Max(0, 1-math.Exp(sd*normalQuantile(lambda)))),
+		Short: num.DecimalFromFloat(math.Max(0, math.Exp(sd*normalQuantile(1-lambda))-1)),
+	}
+}
+
+// quantile returns the p quantile of the log return over the horizon yFrac.
+func (h *HistoricalSimulation) quantile(p, yFrac float64) float64 {
+	scale := math.Sqrt(yFrac)
+	scenarios := h.getScenarios()
+	if scenarios == nil {
+		return h.fallbackSigma * scale * normalQuantile(p)
+	}
+	pos := math.Min(math.Max(p, 0), 1) * float64(len(scenarios)-1)
+	lo := int(math.Floor(pos))
+	if lo >= len(scenarios)-1 {
+		return scenarios[len(scenarios)-1] * scale
+	}
+	frac := pos - float64(lo)
+	return (scenarios[lo] + frac*(scenarios[lo+1]-scenarios[lo])) * scale
+}
+
+// cdf returns the probability of the log return over the horizon yFrac being at most r.
+func (h *HistoricalSimulation) cdf(r, yFrac float64) float64 {
+	if yFrac <= 0 {
+		if r < 0 {
+			return 0
+		}
+		return 1
+	}
+	x := r / math.Sqrt(yFrac)
+	scenarios := h.getScenarios()
+	if scenarios == nil {
+		return 0.5 * math.Erfc(-x/(h.fallbackSigma*math.Sqrt2))
+	}
+	return float64(sort.Search(len(scenarios), func(i int) bool { return scenarios[i] > x })) / float64(len(scenarios))
+}
+
+// getScenarios returns the sorted annualised log returns of the window,
+// or nil when there are not enough observations.
+func (h *HistoricalSimulation) getScenarios() []float64 {
+	if h.scenarios != nil {
+		return h.scenarios
+	}
+
+	returns := make([]float64, 0, len(h.prices))
+	for i := 1; i < len(h.prices); i++ {
+		dt := float64(h.prices[i].t-h.prices[i-1].t) / nanosPerYear
+		prev, cur := h.prices[i-1].price.Float64(), h.prices[i].price.Float64()
+		if dt <= 0 || prev <= 0 || cur <= 0 {
+			continue
+		}
+		returns = append(returns, math.Log(cur/prev)/math.Sqrt(dt))
+	}
+	if len(returns) == 0 || len(returns) < h.minObservations {
+		return nil
+	}
+
+	if h.decayFactor > 0 {
+		// scale each return by the ratio of the current volatility to the volatility at the time
+		vols := make([]float64, len(returns))
+		variance := h.fallbackSigma * h.fallbackSigma
+		for i, r := range returns {
+			vols[i] = math.Sqrt(variance)
+			variance = h.decayFactor*variance + (1-h.decayFactor)*r*r
+		}
+		current := math.Sqrt(variance)
+		for i := range returns {
+			if vols[i] > 0 {
+				returns[i] *= current / vols[i]
+			}
+		}
+	}
+
+	sort.Float64s(returns)
+	h.scenarios = returns
+	return h.scenarios
+}
+
+// normalQuantile returns the p quantile of the standard normal distribution.
+func normalQuantile(p float64) float64 {
+	return math.Sqrt2 * math.Erfinv(2*p-1)
+}
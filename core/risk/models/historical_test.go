@@ -0,0 +1,172 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/risk/models"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoricalSimulation(t *testing.T) {
+	t.Run("fallback volatility is used without enough observations", testHistoricalSimulationFallback)
+	t.Run("risk factors follow the tails of the price history", testHistoricalSimulationRiskFactors)
+	t.Run("window only keeps the latest returns", testHistoricalSimulationWindow)
+	t.Run("filtering scales the returns to the current volatility", testHistoricalSimulationFiltered)
+	t.Run("price range and probability of trading use the price history", testHistoricalSimulationPriceRange)
+	t.Run("price history survives serialisation", testHistoricalSimulationSerialise)
+}
+
+func newHistoricalSimulation(t *testing.T, window, minObs uint64, decay float64) *models.HistoricalSimulation {
+	t.Helper()
+	m, err := models.NewHistoricalSimulation(&types.HistoricalSimulationRiskModel{
+		RiskAversionParameter: num.DecimalFromFloat(0.01),
+		Tau:                   num.DecimalFromFloat(1.0 / 365.25 / 24),
+		WindowSize:            window,
+		MinObservations:       minObs,
+		DecayFactor:           num.DecimalFromFloat(decay),
+		FallbackSigma:         num.DecimalFromFloat(0.8),
+	}, "ETH")
+	require.NoError(t, err)
+	return m
+}
+
+// addPrices adds the prices one hour apart and returns the time of the next observation.
+func addPrices(m *models.HistoricalSimulation, start time.Time, prices ...uint64) time.Time {
+	for _, p := range prices {
+		m.AddPrice(start, num.NewUint(p))
+		start = start.Add(time.Hour)
+	}
+	return start
+}
+
+func testHistoricalSimulationFallback(t *testing.T) {
+	m := newHistoricalSimulation(t, 100, 10, 0)
+	addPrices(m, time.Unix(0, 0), 1000, 1001, 999)
+
+	rf := m.CalculateRiskFactors()
+	assert.Equal(t, m.DefaultRiskFactors(), rf)
+	assert.True(t, rf.Long.IsPositive())
+	assert.True(t, rf.Short.GreaterThan(rf.Long))
+}
+
+func testHistoricalSimulationRiskFactors(t *testing.T) {
+	calm := newHistoricalSimulation(t, 100, 10, 0)
+	wild := newHistoricalSimulation(t, 100, 10, 0)
+	prices := make([]uint64, 0, 50)
+	shocks := make([]uint64, 0, 50)
+	for i := 0; i < 50; i++ {
+		if i%2 == 0 {
+			prices = append(prices, 1000)
+			shocks = append(shocks, 1000)
+		} else {
+			prices = append(prices, 1001)
+			shocks = append(shocks, 1001)
+		}
+	}
+	// a single crash and recovery in the middle of the history
+	shocks[25] = 700
+	addPrices(calm, time.Unix(0, 0), prices...)
+	addPrices(wild, time.Unix(0, 0), shocks...)
+
+	calmRF := calm.CalculateRiskFactors()
+	wildRF := wild.CalculateRiskFactors()
+	assert.True(t, calmRF.Long.LessThan(num.DecimalFromFloat(0.002)))
+	assert.True(t, wildRF.Long.GreaterThan(calmRF.Long))
+	assert.True(t, wildRF.Short.GreaterThan(calmRF.Short))
+}
+
+func testHistoricalSimulationWindow(t *testing.T) {
+	m := newHistoricalSimulation(t, 10, 5, 0)
+	// the crash drops out of the window after 10 further returns
+	next := addPrices(m, time.Unix(0, 0), 1000, 500, 1000)
+	withCrash := m.CalculateRiskFactors()
+	next = addPrices(m, next, 1000, 1001, 1000, 1001, 1000, 1001, 1000, 1001, 1000, 1001)
+	addPrices(m, next, 1000)
+	withoutCrash := m.CalculateRiskFactors()
+	assert.True(t, withoutCrash.Long.LessThan(withCrash.Long))
+	assert.Len(t, m.Serialise(), 11)
+}
+
+func testHistoricalSimulationFiltered(t *testing.T) {
+	plain := newHistoricalSimulation(t, 100, 10, 0)
+	filtered := newHistoricalSimulation(t, 100, 10, 0.9)
+	prices := []uint64{}
+	// volatile history followed by a calm period
+	for i := 0; i < 20; i++ {
+		if i%2 == 0 {
+			prices = append(prices, 1000)
+		} else {
+			prices = append(prices, 1100)
+		}
+	}
+	for i := 0; i < 20; i++ {
+		if i%2 == 0 {
+			prices = append(prices, 1000)
+		} else {
+			prices = append(prices, 1001)
+		}
+	}
+	addPrices(plain, time.Unix(0, 0), prices...)
+	addPrices(filtered, time.Unix(0, 0), prices...)
+
+	// the filtered model scales the old volatile returns down to the current calm regime
+	assert.True(t, filtered.CalculateRiskFactors().Long.LessThan(plain.CalculateRiskFactors().Long))
+}
+
+func testHistoricalSimulationPriceRange(t *testing.T) {
+	m := newHistoricalSimulation(t, 100, 10, 0)
+	prices := []uint64{}
+	for i := 0; i < 30; i++ {
+		prices = append(prices, 1000+uint64(i%3)*10)
+	}
+	addPrices(m, time.Unix(0, 0), prices...)
+
+	price := num.DecimalFromInt64(1000)
+	yFrac := num.DecimalFromFloat(1.0 / 365.25 / 24)
+	min, max := m.PriceRange(price, yFrac, num.DecimalFromFloat(0.99))
+	assert.True(t, min.LessThan(price))
+	assert.True(t, max.GreaterThan(price))
+
+	// an order far outside of the history cannot trade, one at the current price is likely to
+	far := m.ProbabilityOfTrading(price, num.DecimalFromInt64(500), min, max, yFrac, true, false)
+	near := m.ProbabilityOfTrading(price, price, min, max, yFrac, true, false)
+	assert.True(t, far.IsZero())
+	assert.True(t, near.GreaterThan(num.DecimalFromFloat(0.3)))
+	// outside of the range when truncated
+	assert.True(t, m.ProbabilityOfTrading(price, num.DecimalFromInt64(2000), min, max, yFrac, false, true).IsZero())
+}
+
+func testHistoricalSimulationSerialise(t *testing.T) {
+	m := newHistoricalSimulation(t, 100, 5, 0.94)
+	addPrices(m, time.Unix(0, 0), 1000, 1010, 990, 1020, 980, 1000, 1005)
+
+	restored := newHistoricalSimulation(t, 100, 5, 0.94)
+	require.NoError(t, restored.Restore(m.Serialise()))
+	assert.Equal(t, m.Serialise(), restored.Serialise())
+	assert.Equal(t, m.CalculateRiskFactors(), restored.CalculateRiskFactors())
+
+	// a model with a smaller window only keeps the latest observations
+	smaller := newHistoricalSimulation(t, 3, 2, 0.94)
+	require.NoError(t, smaller.Restore(m.Serialise()))
+	assert.Equal(t, m.Serialise()[3:], smaller.Serialise())
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package risk
+
+import (
+	"time"
+
+	"code.vegaprotocol.io/vega/libs/num"
+)
+
+var (
+	secondsPerYear = num.DecimalFromInt64(365 * 24 * 60 * 60).Add(num.DecimalFromInt64(6 * 60 * 60))
+	// beyond this many standard deviations the normal distribution is taken as 0 or 1.
+	maxStdDevs = num.DecimalFromInt64(10)
+	// 1/sqrt(2*pi)
+	invSqrt2Pi = num.MustDecimalFromString("0.3989422804014327")
+)
+
+// OptionProduct exposes the terms of an option needed to margin it on its delta.
+type OptionProduct interface {
+	IsCall() bool
+	// Strike returns the strike price in asset decimals.
+	Strike() *num.Uint
+	Expiry() time.Time
+	// Underlying returns the latest price of the underlying in asset decimals, nil if unknown.
+	Underlying() *num.Uint
+}
+
+// SetOptionProduct makes the engine margin positions on the delta of the option
+// rather than on the premium. The volatility is annualised, a zero volatility
+// margins the option on its intrinsic delta.
+func (e *Engine) SetOptionProduct(option OptionProduct, volatility num.Decimal) {
+	e.option = option
+	e.optionVolatility = volatility
+}
+
+// riskPerUnit returns the exposure of one unit of position the risk factor is applied to.
+// For a future this is the mark price, for an option this is the delta equivalent
+// amount of underlying, capped at the premium for long positions which cannot lose more.
+func (e *Engine) riskPerUnit(markPrice num.Decimal, long bool) num.Decimal {
+	if e.option == nil {
+		return markPrice
+	}
+	underlying := e.option.Underlying()
+	if underlying == nil || underlying.IsZero() {
+		return markPrice
+	}
+	delta := optionDelta(
+		e.option.IsCall(),
+		underlying.ToDecimal(),
+		e.option.Strike().ToDecimal(),
+		e.option.Expiry().Sub(e.timeSvc.GetTimeNow()),
+		e.optionVolatility,
+	)
+	exposure := delta.Abs().Mul(underlying.ToDecimal())
+	if long {
+		return num.MinD(exposure, markPrice)
+	}
+	return exposure
+}
+
+// optionDelta returns the Black-Scholes delta of the option with zero interest rates.
+func optionDelta(call bool, underlying, strike num.Decimal, ttm time.Duration, volatility num.Decimal) num.Decimal {
+	if ttm <= 0 || !volatility.IsPositive() || !strike.IsPositive() {
+		switch {
+		case call && underlying.GreaterThan(strike):
+			return num.DecimalOne()
+		case !call && underlying.LessThan(strike):
+			return num.DecimalOne().Neg()
+		default:
+			return num.DecimalZero()
+		}
+	}
+
+	t := num.DecimalFromInt64(ttm.Nanoseconds()).Div(num.DecimalFromInt64(int64(time.Second))).Div(secondsPerYear)
+	sigmaSqrtT := volatility.Mul(num.DecimalSqrt(t))
+	d1 := num.DecimalLn(underlying.Div(strike)).Add(sigmaSqrtT.Mul(sigmaSqrtT).Div(num.DecimalTwo())).Div(sigmaSqrtT)
+	nd1 := normalCDF(d1)
+	if call {
+		return nd1
+	}
+	return nd1.Sub(num.DecimalOne())
+}
+
+// normalCDF returns the standard normal cumulative distribution at x using
+// the series 1/2 + phi(x) * (x + x^3/3 + x^5/(3*5) + ...), all terms of which are positive.
+func normalCDF(x num.Decimal) num.Decimal {
+	if x.GreaterThan(maxStdDevs) {
+		return num.DecimalOne()
+	}
+	if x.LessThan(maxStdDevs.Neg()) {
+		return num.DecimalZero()
+	}
+	x2 := x.Mul(x)
+	phi, err := x2.Div(num.DecimalTwo()).Neg().ExpTaylor(24)
+	if err != nil {
+		return num.DecimalZero()
+	}
+	phi = phi.Mul(invSqrt2Pi)
+	sum, term := num.DecimalZero(), x
+	for n := int64(1); n < 500 && !term.IsZero(); n++ {
+		sum = sum.Add(term)
+		term = term.Mul(x2).Div(num.DecimalFromInt64(2*n + 1))
+	}
+	return num.MustDecimalFromString("0.5").Add(phi.Mul(sum))
+}
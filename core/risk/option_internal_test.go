@@ -0,0 +1,54 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package risk
+
+import (
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/libs/num"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalCDF(t *testing.T) {
+	for x, expected := range map[string]string{
+		"0":     "0.5",
+		"1":     "0.8413447461",
+		"-1.96": "0.0249978951",
+		"3.5":   "0.9997673709",
+		"12":    "1",
+		"-12":   "0",
+	} {
+		assert.Equal(t, expected, normalCDF(num.MustDecimalFromString(x)).Round(10).String(), x)
+	}
+}
+
+func TestOptionDelta(t *testing.T) {
+	year := 365*24*time.Hour + 6*time.Hour
+	s, k, sigma := num.DecimalFromInt64(1100), num.DecimalFromInt64(1000), num.MustDecimalFromString("0.2")
+
+	// d1 = (ln(1.1) + 0.02) / 0.2
+	call := optionDelta(true, s, k, year, sigma)
+	assert.Equal(t, "0.7179", call.Round(4).String())
+	// put-call parity, the delta of the put is the delta of the call minus one
+	put := optionDelta(false, s, k, year, sigma)
+	assert.True(t, call.Sub(put).Equal(num.DecimalOne()))
+
+	// expired options have their intrinsic delta
+	assert.True(t, optionDelta(true, s, k, 0, sigma).Equal(num.DecimalOne()))
+	assert.True(t, optionDelta(false, s, k, 0, sigma).IsZero())
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package risk_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/libs/num"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testOption struct {
+	call       bool
+	strike     *num.Uint
+	expiry     time.Time
+	underlying *num.Uint
+}
+
+func (o testOption) IsCall() bool          { return o.call }
+func (o testOption) Strike() *num.Uint     { return o.strike.Clone() }
+func (o testOption) Expiry() time.Time     { return o.expiry }
+func (o testOption) Underlying() *num.Uint { return o.underlying }
+
+func TestOptionMargins(t *testing.T) {
+	t.Run("short in the money option is margined on the underlying", testOptionMarginShortInTheMoney)
+	t.Run("short out of the money option is only margined for slippage", testOptionMarginShortOutOfTheMoney)
+	t.Run("long option margin is capped by the premium", testOptionMarginLongCapped)
+	t.Run("option without underlying price is margined on the premium", testOptionMarginNoUnderlying)
+	t.Run("option delta accounts for volatility before expiry", testOptionMarginWithVolatility)
+}
+
+// optionMaintenance returns the maintenance margin of a position of the given size,
+// the premium of the option being the mark price of 100.
+func optionMaintenance(t *testing.T, size int64, option *testOption, volatility num.Decimal) *num.Uint {
+	t.Helper()
+	eng := getTestEngine(t, num.DecimalOne())
+	now := time.Unix(1700000000, 0)
+	eng.tsvc.EXPECT().GetTimeNow().Return(now).AnyTimes()
+	eng.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+	eng.as.EXPECT().InAuction().AnyTimes().Return(false)
+	if option != nil {
+		if option.expiry.IsZero() {
+			option.expiry = now
+		}
+		eng.SetOptionProduct(option, volatility)
+	}
+
+	evt := testMargin{
+		party:   "party1",
+		size:    size,
+		price:   100,
+		asset:   "ETH",
+		general: 100000,
+		market:  "ETH/DEC19",
+	}
+	resp := eng.UpdateMarginsOnSettlement(context.Background(), []events.Margin{evt}, markPrice, num.DecimalZero(), nil)
+	require.Len(t, resp, 1)
+	return resp[0].Transfer().MinAmount
+}
+
+func testOptionMarginShortInTheMoney(t *testing.T) {
+	option := &testOption{call: true, strike: num.NewUint(900), underlying: num.NewUint(1000)}
+	// slippage 100 * (0.1 + 0.1) = 20 + delta 1 * 1000 * 0.2
+	assert.Equal(t, num.NewUint(220), optionMaintenance(t, -1, option, num.DecimalZero()))
+
+	option = &testOption{call: false, strike: num.NewUint(1100), underlying: num.NewUint(1000)}
+	assert.Equal(t, num.NewUint(220), optionMaintenance(t, -1, option, num.DecimalZero()))
+}
+
+func testOptionMarginShortOutOfTheMoney(t *testing.T) {
+	option := &testOption{call: true, strike: num.NewUint(1100), underlying: num.NewUint(1000)}
+	assert.Equal(t, num.NewUint(20), optionMaintenance(t, -1, option, num.DecimalZero()))
+}
+
+func testOptionMarginLongCapped(t *testing.T) {
+	option := &testOption{call: true, strike: num.NewUint(900), underlying: num.NewUint(1000)}
+	// delta exposure is 1000 but a long position cannot lose more than the premium of 100
+	assert.Equal(t, num.NewUint(45), optionMaintenance(t, 1, option, num.DecimalZero()))
+}
+
+func testOptionMarginNoUnderlying(t *testing.T) {
+	option := &testOption{call: true, strike: num.NewUint(900)}
+	assert.Equal(t, optionMaintenance(t, -1, nil, num.DecimalZero()), optionMaintenance(t, -1, option, num.DecimalZero()))
+}
+
+func testOptionMarginWithVolatility(t *testing.T) {
+	// at the money option expiring in a year, the delta is just above 0.5
+	option := &testOption{
+		call:       true,
+		strike:     num.NewUint(1000),
+		underlying: num.NewUint(1000),
+		expiry:     time.Unix(1700000000, 0).Add(365*24*time.Hour + 6*time.Hour),
+	}
+	margin := optionMaintenance(t, -1, option, num.DecimalFromFloat(0.2))
+	// slippage 20 + 1000 * N(0.1) * 0.2 rounded up
+	assert.Equal(t, num.NewUint(128), margin)
+}
@@ -0,0 +1,256 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package risk
+
+import (
+	"sort"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+)
+
+// PortfolioMarket is a market which can be margined as part of a portfolio.
+type PortfolioMarket interface {
+	GetID() string
+	GetSettlementAsset() string
+	// IsPortfolioMargin returns true if the party is in cross margin mode in the market, and opted in
+	// to have its position margined as part of the portfolio.
+	IsPortfolioMargin(party string) bool
+	// PortfolioExposure returns the maintenance margin of the open position of the party on its own,
+	// signed with the direction of the position. It is zero if the party has not opted in to portfolio margin.
+	PortfolioExposure(party string) num.Decimal
+}
+
+// Portfolio nets the margin requirements of cross margin parties which opted in to portfolio
+// margin across groups of correlated markets sharing a settlement asset. Each market of a group sees its
+// position margin scaled down by the diversification of the positions of the party
+// across the group: sqrt(sum_ij corr_ij * e_i * e_j) / sum_i |e_i|, where e_i is
+// the signed standalone position margin in market i.
+type Portfolio struct {
+	groups  *types.PortfolioMarginGroups
+	markets map[string]PortfolioMarket
+	// market ID -> group the market is margined in, only for registered markets
+	byMarket map[string]*portfolioGroup
+	// market ID -> parties to margin again in the market, their portfolio changed since the last reassessment
+	changed map[string]map[string]struct{}
+}
+
+type portfolioGroup struct {
+	markets      []string
+	correlations map[[2]string]num.Decimal
+}
+
+// NewPortfolio returns a portfolio with no group, all markets are margined in isolation.
+func NewPortfolio() *Portfolio {
+	return &Portfolio{
+		groups:   &types.PortfolioMarginGroups{},
+		markets:  map[string]PortfolioMarket{},
+		byMarket: map[string]*portfolioGroup{},
+		changed:  map[string]map[string]struct{}{},
+	}
+}
+
+// UpdateGroups replaces the groups of markets margined together.
+func (p *Portfolio) UpdateGroups(groups *types.PortfolioMarginGroups) {
+	p.groups = groups.Clone()
+	p.rebuild()
+}
+
+func (p *Portfolio) RegisterMarket(mkt PortfolioMarket) {
+	p.markets[mkt.GetID()] = mkt
+	p.rebuild()
+}
+
+func (p *Portfolio) RemoveMarket(marketID string) {
+	delete(p.markets, marketID)
+	delete(p.changed, marketID)
+	p.rebuild()
+}
+
+// rebuild the lookup of the groups by market. Markets which do not exist or do not
+// settle in the asset of their group are ignored.
+func (p *Portfolio) rebuild() {
+	p.byMarket = map[string]*portfolioGroup{}
+	for _, g := range p.groups.Groups {
+		group := &portfolioGroup{
+			correlations: map[[2]string]num.Decimal{},
+		}
+		for _, id := range g.Markets {
+			if mkt, ok := p.markets[id]; ok && mkt.GetSettlementAsset() == g.Asset {
+				group.markets = append(group.markets, id)
+			}
+		}
+		if len(group.markets) < 2 {
+			continue
+		}
+		sort.Strings(group.markets)
+		for _, c := range g.Correlations {
+			group.correlations[correlationKey(c.MarketA, c.MarketB)] = c.Correlation
+		}
+		for _, id := range group.markets {
+			p.byMarket[id] = group
+		}
+	}
+}
+
+func correlationKey(a, b string) [2]string {
+	if a > b {
+		a, b = b, a
+	}
+	return [2]string{a, b}
+}
+
+// IsGrouped returns true if the market is margined as part of a portfolio.
+func (p *Portfolio) IsGrouped(marketID string) bool {
+	_, ok := p.byMarket[marketID]
+	return ok
+}
+
+// NetPositionMargin returns the share of the net portfolio margin allocated to the position of
+// the party in the market, given the signed standalone margin of that position. The result is never
+// more than the standalone margin.
+func (p *Portfolio) NetPositionMargin(marketID, party string, exposure num.Decimal) num.Decimal {
+	own := exposure.Abs()
+	group, ok := p.byMarket[marketID]
+	if !ok || own.IsZero() || !p.markets[marketID].IsPortfolioMargin(party) {
+		return own
+	}
+
+	markets := make([]string, 0, len(group.markets))
+	exposures := make([]num.Decimal, 0, len(group.markets))
+	gross := num.DecimalZero()
+	for _, id := range group.markets {
+		e := exposure
+		if id != marketID {
+			if e = p.markets[id].PortfolioExposure(party); e.IsZero() {
+				continue
+			}
+		}
+		markets = append(markets, id)
+		exposures = append(exposures, e)
+		gross = gross.Add(e.Abs())
+	}
+	if len(exposures) < 2 {
+		return own
+	}
+
+	variance := num.DecimalZero()
+	for i := range exposures {
+		variance = variance.Add(exposures[i].Mul(exposures[i]))
+		for j := i + 1; j < len(exposures); j++ {
+			corr, ok := group.correlations[correlationKey(markets[i], markets[j])]
+			if !ok || corr.IsZero() {
+				continue
+			}
+			variance = variance.Add(num.DecimalFromInt64(2).Mul(corr).Mul(exposures[i]).Mul(exposures[j]))
+		}
+	}
+	if !variance.IsPositive() {
+		return num.DecimalZero()
+	}
+
+	net := num.DecimalSqrt(variance)
+	return num.MinD(own, own.Mul(net).Div(gross))
+}
+
+// SetPortfolio makes the engine net the position margins of cross margin parties
+// with their positions in the other markets of the portfolio.
+func (e *Engine) SetPortfolio(portfolio *Portfolio) {
+	e.portfolio = portfolio
+}
+
+// PortfolioExposure returns the maintenance margin of a position of the given size on its own,
+// ignoring orders, signed with the direction of the position.
+func (e *Engine) PortfolioExposure(size int64, markPrice *num.Uint) num.Decimal {
+	if size == 0 || markPrice == nil || e.marginCalculator.FullyCollateralised {
+		return num.DecimalZero()
+	}
+	return e.positionMargin(num.DecimalFromInt64(size).Div(e.positionFactor), markPrice.ToDecimal(), *e.factors)
+}
+
+// positionMargin returns the signed maintenance margin of the open volume outside of auction.
+func (e *Engine) positionMargin(openVolume, markPrice num.Decimal, rf types.RiskFactor) num.Decimal {
+	volume := openVolume.Abs()
+	slippage := markPrice.Mul(e.linearSlippageFactor.Mul(volume).Add(e.quadraticSlippageFactor.Mul(volume).Mul(volume)))
+	if openVolume.IsPositive() {
+		return slippage.Add(volume.Mul(rf.Long).Mul(e.riskPerUnit(markPrice, true)))
+	}
+	return slippage.Add(volume.Mul(rf.Short).Mul(e.riskPerUnit(markPrice, false))).Neg()
+}
+
+// portfolioDiscount returns the reduction of the position margin of the party from the offsetting
+// positions held in the other markets of the portfolio.
+func (e *Engine) portfolioDiscount(party string, openVolume, markPrice num.Decimal, rf types.RiskFactor) num.Decimal {
+	if e.portfolio == nil || openVolume.IsZero() || !e.portfolio.IsGrouped(e.mktID) {
+		return num.DecimalZero()
+	}
+	exposure := e.positionMargin(openVolume, markPrice, rf)
+	return num.MaxD(num.DecimalZero(), exposure.Abs().Sub(e.portfolio.NetPositionMargin(e.mktID, party, exposure)))
+}
+
+// RecordPositionChange records a change of the position of a party in a market, the positions of the party
+// in the other markets of the group see a different offset and have to be margined again.
+func (p *Portfolio) RecordPositionChange(marketID, party string) {
+	group, ok := p.byMarket[marketID]
+	if !ok || !p.markets[marketID].IsPortfolioMargin(party) {
+		return
+	}
+	for _, id := range group.markets {
+		if id != marketID {
+			p.recordChange(id, party)
+		}
+	}
+}
+
+// RecordPortfolioMarginChange records a party opting in or out of portfolio margin in a market, the positions
+// of the party in all markets of the group, including that one, have to be margined again.
+func (p *Portfolio) RecordPortfolioMarginChange(marketID, party string) {
+	group, ok := p.byMarket[marketID]
+	if !ok {
+		return
+	}
+	for _, id := range group.markets {
+		p.recordChange(id, party)
+	}
+}
+
+func (p *Portfolio) recordChange(marketID, party string) {
+	parties, ok := p.changed[marketID]
+	if !ok {
+		parties = map[string]struct{}{}
+		p.changed[marketID] = parties
+	}
+	parties[party] = struct{}{}
+}
+
+// PopChanges returns the parties to margin again by market, sorted by party ID, for the
+// changes recorded since the last call.
+func (p *Portfolio) PopChanges() map[string][]string {
+	if len(p.changed) == 0 {
+		return nil
+	}
+	out := make(map[string][]string, len(p.changed))
+	for id, parties := range p.changed {
+		sorted := make([]string, 0, len(parties))
+		for party := range parties {
+			sorted = append(sorted, party)
+		}
+		sort.Strings(sorted)
+		out[id] = sorted
+	}
+	p.changed = map[string]map[string]struct{}{}
+	return out
+}
@@ -0,0 +1,222 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package risk_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/risk"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPortfolioMarket struct {
+	id        string
+	asset     string
+	optedIn   map[string]bool
+	exposures map[string]num.Decimal
+}
+
+func (m *testPortfolioMarket) GetID() string                       { return m.id }
+func (m *testPortfolioMarket) GetSettlementAsset() string          { return m.asset }
+func (m *testPortfolioMarket) IsPortfolioMargin(party string) bool { return m.optedIn[party] }
+
+func (m *testPortfolioMarket) PortfolioExposure(party string) num.Decimal {
+	if e, ok := m.exposures[party]; ok && m.optedIn[party] {
+		return e
+	}
+	return num.DecimalZero()
+}
+
+// newTestPortfolioMarket returns a market in which the given parties opted in to portfolio margin.
+func newTestPortfolioMarket(id, asset string, parties ...string) *testPortfolioMarket {
+	m := &testPortfolioMarket{
+		id:        id,
+		asset:     asset,
+		optedIn:   map[string]bool{},
+		exposures: map[string]num.Decimal{},
+	}
+	for _, party := range parties {
+		m.optedIn[party] = true
+	}
+	return m
+}
+
+func TestPortfolioMargin(t *testing.T) {
+	t.Run("offsetting positions in perfectly correlated markets net out", testPortfolioPerfectHedge)
+	t.Run("uncorrelated positions are diversified", testPortfolioUncorrelated)
+	t.Run("positions in the same direction are not discounted", testPortfolioSameDirection)
+	t.Run("markets outside of the group or asset are margined alone", testPortfolioUngrouped)
+	t.Run("parties which did not opt in are margined alone", testPortfolioOptIn)
+	t.Run("position changes are reported to the other markets of the group", testPortfolioPositionChanges)
+	t.Run("risk engine reduces the margin of hedged positions", testPortfolioRiskEngine)
+}
+
+func newTestPortfolio(t *testing.T, correlation string, markets ...*testPortfolioMarket) *risk.Portfolio {
+	t.Helper()
+	p := risk.NewPortfolio()
+	for _, m := range markets {
+		p.RegisterMarket(m)
+	}
+	groups, err := types.PortfolioMarginGroupsFromProto(&vegapb.PortfolioMarginGroups{
+		Groups: []*vegapb.PortfolioMarginGroup{
+			{
+				Name:    "eth",
+				Asset:   "ETH",
+				Markets: []string{"perp", "future"},
+				Correlations: []*vegapb.MarketCorrelation{
+					{MarketA: "perp", MarketB: "future", Correlation: correlation},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	p.UpdateGroups(groups)
+	return p
+}
+
+func testPortfolioPerfectHedge(t *testing.T) {
+	perp, future := newTestPortfolioMarket("perp", "ETH", "party1"), newTestPortfolioMarket("future", "ETH", "party1")
+	future.exposures["party1"] = num.DecimalFromInt64(-100)
+	p := newTestPortfolio(t, "1", perp, future)
+
+	assert.True(t, p.NetPositionMargin("perp", "party1", num.DecimalFromInt64(100)).IsZero())
+	// the net margin of 50 is shared pro rata between the two positions
+	assert.Equal(t, "30", p.NetPositionMargin("perp", "party1", num.DecimalFromInt64(150)).Round(6).String())
+}
+
+func testPortfolioUncorrelated(t *testing.T) {
+	perp, future := newTestPortfolioMarket("perp", "ETH", "party1"), newTestPortfolioMarket("future", "ETH", "party1")
+	future.exposures["party1"] = num.DecimalFromInt64(-100)
+	p := newTestPortfolio(t, "0", perp, future)
+
+	// 100 * sqrt(100^2 + 100^2) / 200
+	assert.Equal(t, "70.710678", p.NetPositionMargin("perp", "party1", num.DecimalFromInt64(100)).Round(6).String())
+}
+
+func testPortfolioSameDirection(t *testing.T) {
+	perp, future := newTestPortfolioMarket("perp", "ETH", "party1"), newTestPortfolioMarket("future", "ETH", "party1")
+	future.exposures["party1"] = num.DecimalFromInt64(100)
+	p := newTestPortfolio(t, "1", perp, future)
+
+	assert.Equal(t, "100", p.NetPositionMargin("perp", "party1", num.DecimalFromInt64(100)).Round(6).String())
+}
+
+func testPortfolioUngrouped(t *testing.T) {
+	perp, future := newTestPortfolioMarket("perp", "ETH", "party1"), newTestPortfolioMarket("future", "BTC", "party1")
+	future.exposures["party1"] = num.DecimalFromInt64(-100)
+	p := newTestPortfolio(t, "1", perp, future)
+
+	assert.False(t, p.IsGrouped("perp"))
+	assert.Equal(t, "100", p.NetPositionMargin("perp", "party1", num.DecimalFromInt64(100)).String())
+
+	// the group is effective once the market settling in the asset of the group exists
+	p.RemoveMarket("future")
+	p.RegisterMarket(newTestPortfolioMarket("future", "ETH", "party1"))
+	assert.True(t, p.IsGrouped("perp"))
+}
+
+func testPortfolioOptIn(t *testing.T) {
+	perp, future := newTestPortfolioMarket("perp", "ETH"), newTestPortfolioMarket("future", "ETH")
+	future.exposures["party1"] = num.DecimalFromInt64(-100)
+	p := newTestPortfolio(t, "1", perp, future)
+
+	assert.Equal(t, "100", p.NetPositionMargin("perp", "party1", num.DecimalFromInt64(100)).String())
+
+	// the position in the other market only counts once the party opted in there too
+	perp.optedIn["party1"] = true
+	assert.Equal(t, "100", p.NetPositionMargin("perp", "party1", num.DecimalFromInt64(100)).String())
+
+	future.optedIn["party1"] = true
+	assert.True(t, p.NetPositionMargin("perp", "party1", num.DecimalFromInt64(100)).IsZero())
+}
+
+func testPortfolioPositionChanges(t *testing.T) {
+	perp, future := newTestPortfolioMarket("perp", "ETH", "party1", "party2"), newTestPortfolioMarket("future", "ETH", "party1", "party2")
+	p := newTestPortfolio(t, "1", perp, future)
+
+	assert.Nil(t, p.PopChanges())
+	p.RecordPositionChange("perp", "party2")
+	p.RecordPositionChange("perp", "party1")
+	p.RecordPositionChange("other", "party3")
+	// the party did not opt in, its position doesn't affect the other markets
+	p.RecordPositionChange("perp", "party4")
+	assert.Equal(t, map[string][]string{"future": {"party1", "party2"}}, p.PopChanges())
+	assert.Nil(t, p.PopChanges())
+
+	// opting in or out changes the margin in all the markets of the group
+	p.RecordPortfolioMarginChange("perp", "party4")
+	assert.Equal(t, map[string][]string{"future": {"party4"}, "perp": {"party4"}}, p.PopChanges())
+}
+
+func testPortfolioRiskEngine(t *testing.T) {
+	maintenance := func(portfolio *risk.Portfolio) *num.Uint {
+		eng := getTestEngine(t, num.DecimalOne())
+		eng.tsvc.EXPECT().GetTimeNow().Return(time.Unix(1700000000, 0)).AnyTimes()
+		eng.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+		eng.as.EXPECT().InAuction().AnyTimes().Return(false)
+		if portfolio != nil {
+			eng.SetPortfolio(portfolio)
+		}
+		evt := testMargin{
+			party:   "party1",
+			size:    10,
+			price:   100,
+			asset:   "ETH",
+			general: 100000,
+			market:  "mktid",
+		}
+		resp := eng.UpdateMarginsOnSettlement(context.Background(), []events.Margin{evt}, markPrice, num.DecimalZero(), nil)
+		require.Len(t, resp, 1)
+		return resp[0].MarginLevels().MaintenanceMargin
+	}
+
+	mkt, other := newTestPortfolioMarket("mktid", "ETH", "party1"), newTestPortfolioMarket("other", "ETH", "party1")
+	p := risk.NewPortfolio()
+	p.RegisterMarket(mkt)
+	p.RegisterMarket(other)
+	groups, err := types.PortfolioMarginGroupsFromProto(&vegapb.PortfolioMarginGroups{
+		Groups: []*vegapb.PortfolioMarginGroup{
+			{
+				Name:    "eth",
+				Asset:   "ETH",
+				Markets: []string{"mktid", "other"},
+				Correlations: []*vegapb.MarketCorrelation{
+					{MarketA: "mktid", MarketB: "other", Correlation: "0.9"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	p.UpdateGroups(groups)
+
+	alone := maintenance(nil)
+	// no position in the other market
+	assert.Equal(t, alone, maintenance(p))
+
+	other.exposures["party1"] = alone.ToDecimal().Neg()
+	hedged := maintenance(p)
+	assert.True(t, hedged.LT(alone))
+	assert.False(t, hedged.IsZero())
+}
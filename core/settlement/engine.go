@@ -450,6 +450,39 @@ func (e *Engine) RemoveDistressed(ctx context.Context, evts []events.Margin) {
 	e.broker.SendBatch(devts)
 }
 
+// ReduceDistressed adds the partial close-out trades of distressed parties, and the auto-deleveraging trades of the
+// parties absorbing the network's position. The parties are settled on the trade like they would be for any trade,
+// but like it is for parties closed out in full, the network's settled position is updated by the volume traded.
+// The trades happen at the mark price the positions were last settled at.
+func (e *Engine) ReduceDistressed(trades []*types.Trade) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	netSize := e.settledPosition[types.NetworkParty]
+	netTrades := e.trades[types.NetworkParty]
+	for _, t := range trades {
+		party, size := t.Seller, -int64(t.Size)
+		if party == types.NetworkParty {
+			party, size = t.Buyer, -size
+		}
+		partySize := e.settledPosition[party]
+		if cd := e.trades[party]; len(cd) > 0 {
+			partySize = cd[len(cd)-1].newSize
+		}
+		e.trades[party] = append(e.trades[party], &settlementTrade{
+			price:       t.Price.Clone(),
+			marketPrice: t.MarketPrice,
+			size:        size,
+			newSize:     partySize + size,
+		})
+		// the network position changes by the opposite of the party's
+		netSize -= size
+		for _, nt := range netTrades {
+			nt.newSize -= size
+		}
+	}
+	e.settledPosition[types.NetworkParty] = netSize
+}
+
 // simplified settle call.
 func (e *Engine) settleAll(settlementData *num.Uint) ([]*types.Transfer, *num.Uint, error) {
 	e.mu.Lock()
@@ -532,6 +565,14 @@ func (e *Engine) getOrCreateCurrentPosition(party string, size int64) (int64, *n
 	return p, e.lastMarkPrice
 }
 
+// LastMarkPrice returns the mark price the positions were last marked to market at.
+func (e *Engine) LastMarkPrice() *num.Uint {
+	if e.lastMarkPrice == nil {
+		return nil
+	}
+	return e.lastMarkPrice.Clone()
+}
+
 func (e *Engine) HasPosition(party string) bool {
 	_, okPos := e.settledPosition[party]
 	_, okTrades := e.trades[party]
@@ -96,6 +96,24 @@ const (
 	CancelAMMCommand Command = 0x66
 	// DelayedTransactionsWrapper ...
 	DelayedTransactionsWrapper Command = 0x67
+	// UpdateMarketMakerProtectionCommand ...
+	UpdateMarketMakerProtectionCommand Command = 0x68
+	// CancelOnTimeoutCommand ...
+	CancelOnTimeoutCommand Command = 0x69
+	// SubmitSpreadOrderCommand ...
+	SubmitSpreadOrderCommand Command = 0x6a
+	// SubmitQuoteRequestCommand ...
+	SubmitQuoteRequestCommand Command = 0x6b
+	// SubmitQuoteCommand ...
+	SubmitQuoteCommand Command = 0x6c
+	// AcceptQuoteCommand ...
+	AcceptQuoteCommand Command = 0x6d
+	// CancelQuoteRequestCommand ...
+	CancelQuoteRequestCommand Command = 0x6e
+	// SubmitCompleteSetCommand ...
+	SubmitCompleteSetCommand Command = 0x6f
+	// UpdateGovernanceDelegationCommand ...
+	UpdateGovernanceDelegationCommand Command = 0x70
 )
 
 var commandName = map[Command]string{
@@ -137,6 +155,15 @@ var commandName = map[Command]string{
 	AmendAMMCommand:                    "Amend AMM",
 	CancelAMMCommand:                   "Cancel AMM",
 	DelayedTransactionsWrapper:         "Delayed Transactions Wrapper",
+	UpdateMarketMakerProtectionCommand: "Update Market Maker Protection",
+	CancelOnTimeoutCommand:             "Cancel On Timeout",
+	SubmitSpreadOrderCommand:           "Submit Spread Order",
+	SubmitQuoteRequestCommand:          "Submit Quote Request",
+	SubmitQuoteCommand:                 "Submit Quote",
+	AcceptQuoteCommand:                 "Accept Quote",
+	CancelQuoteRequestCommand:          "Cancel Quote Request",
+	SubmitCompleteSetCommand:           "Submit Complete Set",
+	UpdateGovernanceDelegationCommand:  "Update Governance Delegation",
 }
 
 func (cmd Command) IsValidatorCommand() bool {
@@ -97,6 +97,8 @@ type SubmitAMM struct {
 	AMMBaseCommand
 	CommitmentAmount *num.Uint
 	Parameters       *ConcentratedLiquidityParameters
+	// BaseCommitmentAmount is the amount of base asset committed to an AMM on a spot market.
+	BaseCommitmentAmount *num.Uint
 }
 
 func NewSubmitAMMFromProto(
@@ -111,6 +113,11 @@ func NewSubmitAMMFromProto(
 
 	commitment, _ := num.UintFromString(submitAMM.CommitmentAmount, 10)
 
+	var baseCommitment *num.Uint
+	if submitAMM.BaseCommitmentAmount != nil {
+		baseCommitment, _ = num.UintFromString(*submitAMM.BaseCommitmentAmount, 10)
+	}
+
 	params := submitAMM.ConcentratedLiquidityParameters
 	base, _ := num.UintFromString(params.Base, 10)
 	if params.LowerBound != nil {
@@ -140,7 +147,8 @@ func NewSubmitAMMFromProto(
 			SlippageTolerance: slippage,
 			ProposedFee:       proposedFee,
 		},
-		CommitmentAmount: commitment,
+		CommitmentAmount:     commitment,
+		BaseCommitmentAmount: baseCommitment,
 		Parameters: &ConcentratedLiquidityParameters{
 			Base:                 base,
 			LowerBound:           lowerBound,
@@ -182,11 +190,17 @@ func (s SubmitAMM) IntoProto() *commandspb.SubmitAMM {
 	if s.Parameters.Base != nil {
 		base = s.Parameters.Base.String()
 	}
+
+	var baseCommitment *string
+	if s.BaseCommitmentAmount != nil {
+		baseCommitment = ptr.From(s.BaseCommitmentAmount.String())
+	}
 	return &commandspb.SubmitAMM{
-		MarketId:          s.MarketID,
-		CommitmentAmount:  s.CommitmentAmount.String(),
-		SlippageTolerance: s.SlippageTolerance.String(),
-		ProposedFee:       s.ProposedFee.String(),
+		MarketId:             s.MarketID,
+		CommitmentAmount:     s.CommitmentAmount.String(),
+		SlippageTolerance:    s.SlippageTolerance.String(),
+		ProposedFee:          s.ProposedFee.String(),
+		BaseCommitmentAmount: baseCommitment,
 		ConcentratedLiquidityParameters: &commandspb.SubmitAMM_ConcentratedLiquidityParameters{
 			UpperBound:           upper,
 			LowerBound:           lower,
@@ -201,6 +215,8 @@ type AmendAMM struct {
 	AMMBaseCommand
 	CommitmentAmount *num.Uint
 	Parameters       *ConcentratedLiquidityParameters
+	// BaseCommitmentAmount is the updated amount of base asset committed to an AMM on a spot market.
+	BaseCommitmentAmount *num.Uint
 }
 
 func (a AmendAMM) IntoProto() *commandspb.AmendAMM {
@@ -213,6 +229,9 @@ func (a AmendAMM) IntoProto() *commandspb.AmendAMM {
 	if a.CommitmentAmount != nil {
 		ret.CommitmentAmount = ptr.From(a.CommitmentAmount.String())
 	}
+	if a.BaseCommitmentAmount != nil {
+		ret.BaseCommitmentAmount = ptr.From(a.BaseCommitmentAmount.String())
+	}
 	if !a.ProposedFee.IsZero() {
 		ret.ProposedFee = ptr.From(a.ProposedFee.String())
 	}
@@ -244,7 +263,7 @@ func NewAmendAMMFromProto(
 ) *AmendAMM {
 	// all parameters have been validated by the command package here.
 
-	var commitment, base, lowerBound, upperBound *num.Uint
+	var commitment, baseCommitment, base, lowerBound, upperBound *num.Uint
 	var leverageAtUpperBound, leverageAtLowerBound *num.Decimal
 
 	// this is optional
@@ -252,6 +271,11 @@ func NewAmendAMMFromProto(
 		commitment, _ = num.UintFromString(*amendAMM.CommitmentAmount, 10)
 	}
 
+	// as is this, only used by spot markets
+	if amendAMM.BaseCommitmentAmount != nil {
+		baseCommitment, _ = num.UintFromString(*amendAMM.BaseCommitmentAmount, 10)
+	}
+
 	//  this too, and the parameters it contains
 	if amendAMM.ConcentratedLiquidityParameters != nil {
 		base, _ = num.UintFromString(amendAMM.ConcentratedLiquidityParameters.Base, 10)
@@ -285,7 +309,8 @@ func NewAmendAMMFromProto(
 			SlippageTolerance: slippage,
 			ProposedFee:       proposedFee,
 		},
-		CommitmentAmount: commitment,
+		CommitmentAmount:     commitment,
+		BaseCommitmentAmount: baseCommitment,
 		Parameters: &ConcentratedLiquidityParameters{
 			Base:                 base,
 			LowerBound:           lowerBound,
@@ -339,6 +364,7 @@ const (
 	AMMStatusReasonMarketClosed                          = eventspb.AMM_STATUS_REASON_MARKET_CLOSED
 	AMMStatusReasonCommitmentTooLow                      = eventspb.AMM_STATUS_REASON_COMMITMENT_TOO_LOW
 	AMMStatusReasonCannotRebase                          = eventspb.AMM_STATUS_REASON_CANNOT_REBASE
+	AMMStatusReasonPositionLimitExceeded                 = eventspb.AMM_STATUS_REASON_POSITION_LIMIT_EXCEEDED
 )
 
 type AMMPoolStatus = eventspb.AMM_Status
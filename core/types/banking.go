@@ -19,6 +19,7 @@ import (
 	"errors"
 	"time"
 
+	"code.vegaprotocol.io/vega/core/datasource"
 	vgcrypto "code.vegaprotocol.io/vega/libs/crypto"
 	"code.vegaprotocol.io/vega/libs/num"
 	vegapb "code.vegaprotocol.io/vega/protos/vega"
@@ -57,6 +58,10 @@ var (
 	ErrInvalidFactor                 = errors.New("invalid factor")
 	ErrStartEpochAfterEndEpoch       = errors.New("start epoch after end epoch")
 	ErrInvalidToForRewardAccountType = errors.New("to party is invalid for reward account type")
+	ErrStartTimeAfterEndTime         = errors.New("start time after end time")
+	ErrInvalidReleaseInterval        = errors.New("invalid release interval")
+	ErrInvalidReleaseCurve           = errors.New("invalid release curve")
+	ErrMissingReleaseCondition       = errors.New("missing release condition")
 )
 
 type TransferCommandKind int
@@ -64,6 +69,21 @@ type TransferCommandKind int
 const (
 	TransferCommandKindOneOff TransferCommandKind = iota
 	TransferCommandKindRecurring
+	TransferCommandKindStreaming
+	TransferCommandKindConditional
+)
+
+type StreamingReleaseCurve = vegapb.StreamingReleaseCurve
+
+const (
+	// Default value, considered as linear.
+	StreamingReleaseCurveUnspecified StreamingReleaseCurve = vegapb.StreamingReleaseCurve_STREAMING_RELEASE_CURVE_UNSPECIFIED
+	// Constant release rate.
+	StreamingReleaseCurveLinear StreamingReleaseCurve = vegapb.StreamingReleaseCurve_STREAMING_RELEASE_CURVE_LINEAR
+	// Release rate increasing over time.
+	StreamingReleaseCurveEaseIn StreamingReleaseCurve = vegapb.StreamingReleaseCurve_STREAMING_RELEASE_CURVE_EASE_IN
+	// Release rate decreasing over time.
+	StreamingReleaseCurveEaseOut StreamingReleaseCurve = vegapb.StreamingReleaseCurve_STREAMING_RELEASE_CURVE_EASE_OUT
 )
 
 type TransferBase struct {
@@ -342,12 +362,262 @@ func (r *RecurringTransfer) IntoEvent(reason *string, gameID *string) *eventspb.
 	}
 }
 
+type StreamingTransfer struct {
+	*TransferBase
+	StartTime time.Time
+	EndTime   time.Time
+	// ReleaseInterval is the minimum time between two releases,
+	// if zero the vested amount is released at every block.
+	ReleaseInterval time.Duration
+	ReleaseCurve    StreamingReleaseCurve
+	// Released is the amount already paid to the receiver.
+	Released    *num.Uint
+	LastRelease time.Time
+}
+
+func (s *StreamingTransfer) IsValid() error {
+	if err := s.TransferBase.IsValid(); err != nil {
+		return err
+	}
+
+	// unreleased funds are refunded to the general account on cancellation
+	if s.FromAccountType != AccountTypeGeneral {
+		return ErrUnsupportedFromAccountType
+	}
+
+	if !s.EndTime.After(s.StartTime) {
+		return ErrStartTimeAfterEndTime
+	}
+
+	if s.ReleaseInterval < 0 || s.ReleaseInterval > s.EndTime.Sub(s.StartTime) {
+		return ErrInvalidReleaseInterval
+	}
+
+	switch s.ReleaseCurve {
+	case StreamingReleaseCurveUnspecified, StreamingReleaseCurveLinear, StreamingReleaseCurveEaseIn, StreamingReleaseCurveEaseOut:
+	default:
+		return ErrInvalidReleaseCurve
+	}
+
+	return nil
+}
+
+// VestedAt returns the total amount which should have been
+// released to the receiver at the given time.
+func (s *StreamingTransfer) VestedAt(t time.Time) *num.Uint {
+	if !t.After(s.StartTime) {
+		return num.UintZero()
+	}
+	if !t.Before(s.EndTime) {
+		return s.Amount.Clone()
+	}
+
+	elapsed := num.DecimalFromInt64(t.Sub(s.StartTime).Nanoseconds()).Div(
+		num.DecimalFromInt64(s.EndTime.Sub(s.StartTime).Nanoseconds()),
+	)
+
+	var fraction num.Decimal
+	switch s.ReleaseCurve {
+	case StreamingReleaseCurveEaseIn:
+		fraction = elapsed.Mul(elapsed)
+	case StreamingReleaseCurveEaseOut:
+		// 1 - (1 - elapsed)^2
+		fraction = elapsed.Mul(num.DecimalFromInt64(2).Sub(elapsed))
+	default:
+		fraction = elapsed
+	}
+
+	vested, _ := num.UintFromDecimal(s.Amount.ToDecimal().Mul(fraction))
+	return num.Min(vested, s.Amount)
+}
+
+// ClaimableAt returns the amount vested at the given time
+// which has not been released yet.
+func (s *StreamingTransfer) ClaimableAt(t time.Time) *num.Uint {
+	vested := s.VestedAt(t)
+	if vested.LTE(s.Released) {
+		return num.UintZero()
+	}
+	return vested.Sub(vested, s.Released)
+}
+
+// Remaining returns the amount not released yet.
+func (s *StreamingTransfer) Remaining() *num.Uint {
+	return num.UintZero().Sub(s.Amount, s.Released)
+}
+
+// ReleaseDue returns true if a release should happen at the given time.
+func (s *StreamingTransfer) ReleaseDue(t time.Time) bool {
+	if !t.After(s.StartTime) {
+		return false
+	}
+	return s.ReleaseInterval == 0 || !t.Before(s.EndTime) || t.Sub(s.LastRelease) >= s.ReleaseInterval
+}
+
+func (s *StreamingTransfer) IntoEvent(reason *string) *eventspb.Transfer {
+	var releaseInterval *int64
+	if s.ReleaseInterval > 0 {
+		releaseInterval = toPtr(int64(s.ReleaseInterval / time.Second))
+	}
+
+	return &eventspb.Transfer{
+		Id:              s.ID,
+		From:            s.From,
+		FromAccountType: s.FromAccountType,
+		To:              s.To,
+		ToAccountType:   s.ToAccountType,
+		Asset:           s.Asset,
+		Amount:          s.Amount.String(),
+		Reference:       s.Reference,
+		Status:          s.Status,
+		Timestamp:       s.Timestamp.UnixNano(),
+		Reason:          reason,
+		Kind: &eventspb.Transfer_Streaming{
+			Streaming: &eventspb.StreamingTransfer{
+				StartTime:       s.StartTime.UnixNano(),
+				EndTime:         s.EndTime.UnixNano(),
+				ReleaseInterval: releaseInterval,
+				ReleaseCurve:    s.ReleaseCurve,
+				Released:        s.Released.String(),
+				LastRelease:     s.LastRelease.UnixNano(),
+			},
+		},
+	}
+}
+
+func StreamingTransferFromEvent(p *eventspb.Transfer) *StreamingTransfer {
+	amount, overflow := num.UintFromString(p.Amount, 10)
+	if overflow {
+		// panic is alright here, this should come only from
+		// a checkpoint, and it would mean the checkpoint is fucked
+		// so executions is not possible.
+		panic("invalid transfer amount")
+	}
+
+	released := num.UintZero()
+	if len(p.GetStreaming().GetReleased()) > 0 {
+		released, overflow = num.UintFromString(p.GetStreaming().GetReleased(), 10)
+		if overflow {
+			panic("invalid streaming transfer released amount")
+		}
+	}
+
+	return &StreamingTransfer{
+		TransferBase: &TransferBase{
+			ID:              p.Id,
+			From:            p.From,
+			FromAccountType: p.FromAccountType,
+			To:              p.To,
+			ToAccountType:   p.ToAccountType,
+			Asset:           p.Asset,
+			Amount:          amount,
+			Reference:       p.Reference,
+			Status:          p.Status,
+			Timestamp:       time.Unix(0, p.Timestamp),
+		},
+		StartTime:       time.Unix(0, p.GetStreaming().GetStartTime()),
+		EndTime:         time.Unix(0, p.GetStreaming().GetEndTime()),
+		ReleaseInterval: time.Duration(p.GetStreaming().GetReleaseInterval()) * time.Second,
+		ReleaseCurve:    p.GetStreaming().GetReleaseCurve(),
+		Released:        released,
+		LastRelease:     time.Unix(0, p.GetStreaming().GetLastRelease()),
+	}
+}
+
+type ConditionalTransfer struct {
+	*TransferBase
+	// ReleaseCondition is the data source releasing
+	// the funds to the receiver when it's triggered.
+	ReleaseCondition *datasource.Spec
+	// Timeout is the time after which the funds are
+	// returned to the sender if the condition hasn't been met.
+	Timeout time.Time
+}
+
+func (c *ConditionalTransfer) IsValid() error {
+	if err := c.TransferBase.IsValid(); err != nil {
+		return err
+	}
+
+	// funds are refunded to the general account on timeout
+	if c.FromAccountType != AccountTypeGeneral {
+		return ErrUnsupportedFromAccountType
+	}
+
+	if c.ReleaseCondition == nil || c.ReleaseCondition.Data == nil {
+		return ErrMissingReleaseCondition
+	}
+
+	return nil
+}
+
+func (c *ConditionalTransfer) IntoEvent(reason *string) *eventspb.Transfer {
+	var releaseCondition *vegapb.DataSourceSpec
+	if c.ReleaseCondition != nil {
+		releaseCondition = c.ReleaseCondition.IntoProto()
+	}
+
+	return &eventspb.Transfer{
+		Id:              c.ID,
+		From:            c.From,
+		FromAccountType: c.FromAccountType,
+		To:              c.To,
+		ToAccountType:   c.ToAccountType,
+		Asset:           c.Asset,
+		Amount:          c.Amount.String(),
+		Reference:       c.Reference,
+		Status:          c.Status,
+		Timestamp:       c.Timestamp.UnixNano(),
+		Reason:          reason,
+		Kind: &eventspb.Transfer_Conditional{
+			Conditional: &eventspb.ConditionalTransfer{
+				ReleaseCondition: releaseCondition,
+				Timeout:          c.Timeout.UnixNano(),
+			},
+		},
+	}
+}
+
+func ConditionalTransferFromEvent(p *eventspb.Transfer) *ConditionalTransfer {
+	amount, overflow := num.UintFromString(p.Amount, 10)
+	if overflow {
+		// panic is alright here, this should come only from
+		// a checkpoint, and it would mean the checkpoint is fucked
+		// so executions is not possible.
+		panic("invalid transfer amount")
+	}
+
+	var releaseCondition *datasource.Spec
+	if p.GetConditional().GetReleaseCondition() != nil {
+		releaseCondition = datasource.SpecFromProto(p.GetConditional().GetReleaseCondition())
+	}
+
+	return &ConditionalTransfer{
+		TransferBase: &TransferBase{
+			ID:              p.Id,
+			From:            p.From,
+			FromAccountType: p.FromAccountType,
+			To:              p.To,
+			ToAccountType:   p.ToAccountType,
+			Asset:           p.Asset,
+			Amount:          amount,
+			Reference:       p.Reference,
+			Status:          p.Status,
+			Timestamp:       time.Unix(0, p.Timestamp),
+		},
+		ReleaseCondition: releaseCondition,
+		Timeout:          time.Unix(0, p.GetConditional().GetTimeout()),
+	}
+}
+
 // Just a wrapper, use the Kind on a
 // switch to access the proper value.
 type TransferFunds struct {
-	Kind      TransferCommandKind
-	OneOff    *OneOffTransfer
-	Recurring *RecurringTransfer
+	Kind        TransferCommandKind
+	OneOff      *OneOffTransfer
+	Recurring   *RecurringTransfer
+	Streaming   *StreamingTransfer
+	Conditional *ConditionalTransfer
 }
 
 func NewTransferFromProto(id, from string, tf *commandspb.Transfer) (*TransferFunds, error) {
@@ -360,6 +630,10 @@ func NewTransferFromProto(id, from string, tf *commandspb.Transfer) (*TransferFu
 		return newOneOffTransfer(base, tf)
 	case *commandspb.Transfer_Recurring:
 		return newRecurringTransfer(base, tf)
+	case *commandspb.Transfer_Streaming:
+		return newStreamingTransfer(base, tf)
+	case *commandspb.Transfer_Conditional:
+		return newConditionalTransfer(base, tf)
 	default:
 		return nil, ErrMissingTransferKind
 	}
@@ -371,6 +645,10 @@ func (t *TransferFunds) IntoEvent(reason, gameID *string) *eventspb.Transfer {
 		return t.OneOff.IntoEvent(reason)
 	case TransferCommandKindRecurring:
 		return t.Recurring.IntoEvent(reason, gameID)
+	case TransferCommandKindStreaming:
+		return t.Streaming.IntoEvent(reason)
+	case TransferCommandKindConditional:
+		return t.Conditional.IntoEvent(reason)
 	default:
 		panic("invalid transfer kind")
 	}
@@ -440,6 +718,46 @@ func newRecurringTransfer(base *TransferBase, tf *commandspb.Transfer) (*Transfe
 	}, nil
 }
 
+func newStreamingTransfer(base *TransferBase, tf *commandspb.Transfer) (*TransferFunds, error) {
+	st := tf.GetStreaming()
+	startTime := time.Unix(0, st.GetStartTime())
+
+	return &TransferFunds{
+		Kind: TransferCommandKindStreaming,
+		Streaming: &StreamingTransfer{
+			TransferBase:    base,
+			StartTime:       startTime,
+			EndTime:         time.Unix(0, st.GetEndTime()),
+			ReleaseInterval: time.Duration(st.GetReleaseInterval()) * time.Second,
+			ReleaseCurve:    st.GetReleaseCurve(),
+			Released:        num.UintZero(),
+			LastRelease:     startTime,
+		},
+	}, nil
+}
+
+func newConditionalTransfer(base *TransferBase, tf *commandspb.Transfer) (*TransferFunds, error) {
+	ct := tf.GetConditional()
+
+	var releaseCondition *datasource.Spec
+	if ct.GetReleaseCondition() != nil {
+		def, err := datasource.DefinitionFromProto(ct.GetReleaseCondition())
+		if err != nil {
+			return nil, err
+		}
+		releaseCondition = datasource.SpecFromDefinition(*datasource.NewDefinitionWith(def))
+	}
+
+	return &TransferFunds{
+		Kind: TransferCommandKindConditional,
+		Conditional: &ConditionalTransfer{
+			TransferBase:     base,
+			ReleaseCondition: releaseCondition,
+			Timeout:          time.Unix(0, ct.GetTimeout()),
+		},
+	}, nil
+}
+
 func RecurringTransferFromEvent(p *eventspb.Transfer) *RecurringTransfer {
 	var endEpoch *uint64
 	if p.GetRecurring().EndEpoch != nil {
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	checkpointpb "code.vegaprotocol.io/vega/protos/vega/checkpoint/v1"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
 	snapshot "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
 )
 
@@ -601,3 +602,67 @@ func PayloadBankingRecurringTransfersFromProto(pbd *snapshot.Payload_BankingRecu
 		NextMetricUpdate:          time.Unix(0, pbd.BankingRecurringTransfers.NextMetricUpdate),
 	}
 }
+
+type PayloadBankingStreamingTransfers struct {
+	BankingStreamingTransfers []*eventspb.Transfer
+}
+
+func (p PayloadBankingStreamingTransfers) IntoProto() *snapshot.Payload_BankingStreamingTransfers {
+	return &snapshot.Payload_BankingStreamingTransfers{
+		BankingStreamingTransfers: &snapshot.BankingStreamingTransfers{
+			StreamingTransfers: p.BankingStreamingTransfers,
+		},
+	}
+}
+
+func (*PayloadBankingStreamingTransfers) isPayload() {}
+
+func (p *PayloadBankingStreamingTransfers) plToProto() interface{} {
+	return p.IntoProto()
+}
+
+func (*PayloadBankingStreamingTransfers) Key() string {
+	return "streamingTransfers"
+}
+
+func (*PayloadBankingStreamingTransfers) Namespace() SnapshotNamespace {
+	return BankingSnapshot
+}
+
+func PayloadBankingStreamingTransfersFromProto(pbd *snapshot.Payload_BankingStreamingTransfers) *PayloadBankingStreamingTransfers {
+	return &PayloadBankingStreamingTransfers{
+		BankingStreamingTransfers: pbd.BankingStreamingTransfers.StreamingTransfers,
+	}
+}
+
+type PayloadBankingConditionalTransfers struct {
+	BankingConditionalTransfers []*eventspb.Transfer
+}
+
+func (p PayloadBankingConditionalTransfers) IntoProto() *snapshot.Payload_BankingConditionalTransfers {
+	return &snapshot.Payload_BankingConditionalTransfers{
+		BankingConditionalTransfers: &snapshot.BankingConditionalTransfers{
+			ConditionalTransfers: p.BankingConditionalTransfers,
+		},
+	}
+}
+
+func (*PayloadBankingConditionalTransfers) isPayload() {}
+
+func (p *PayloadBankingConditionalTransfers) plToProto() interface{} {
+	return p.IntoProto()
+}
+
+func (*PayloadBankingConditionalTransfers) Key() string {
+	return "conditionalTransfers"
+}
+
+func (*PayloadBankingConditionalTransfers) Namespace() SnapshotNamespace {
+	return BankingSnapshot
+}
+
+func PayloadBankingConditionalTransfersFromProto(pbd *snapshot.Payload_BankingConditionalTransfers) *PayloadBankingConditionalTransfers {
+	return &PayloadBankingConditionalTransfers{
+		BankingConditionalTransfers: pbd.BankingConditionalTransfers.ConditionalTransfers,
+	}
+}
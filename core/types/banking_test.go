@@ -0,0 +1,223 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+	datapb "code.vegaprotocol.io/vega/protos/vega/data/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testStreamingTransfer(curve types.StreamingReleaseCurve, interval time.Duration) *types.StreamingTransfer {
+	start := time.Unix(1000, 0)
+	return &types.StreamingTransfer{
+		TransferBase: &types.TransferBase{
+			ID:              "transfer",
+			From:            "03ae90688632c649c4beab6040ff5bd04dbde8efbf737d8673bbda792a110301",
+			FromAccountType: types.AccountTypeGeneral,
+			To:              "2e05fd230f3c9f4eaf0bdc5bfb7ca0c9d00278afc44637aab60da76653d7ccf0",
+			ToAccountType:   types.AccountTypeGeneral,
+			Asset:           "eth",
+			Amount:          num.NewUint(1000),
+			Reference:       "payroll",
+			Status:          types.TransferStatusPending,
+			Timestamp:       start,
+		},
+		StartTime:       start,
+		EndTime:         start.Add(100 * time.Second),
+		ReleaseInterval: interval,
+		ReleaseCurve:    curve,
+		Released:        num.UintZero(),
+		LastRelease:     start,
+	}
+}
+
+func TestStreamingTransferVestedAt(t *testing.T) {
+	cases := []struct {
+		curve    types.StreamingReleaseCurve
+		elapsed  time.Duration
+		expected uint64
+	}{
+		{curve: types.StreamingReleaseCurveLinear, elapsed: -time.Second, expected: 0},
+		{curve: types.StreamingReleaseCurveLinear, elapsed: 0, expected: 0},
+		{curve: types.StreamingReleaseCurveLinear, elapsed: 25 * time.Second, expected: 250},
+		{curve: types.StreamingReleaseCurveUnspecified, elapsed: 25 * time.Second, expected: 250},
+		{curve: types.StreamingReleaseCurveEaseIn, elapsed: 25 * time.Second, expected: 62},
+		{curve: types.StreamingReleaseCurveEaseOut, elapsed: 25 * time.Second, expected: 437},
+		{curve: types.StreamingReleaseCurveEaseIn, elapsed: 100 * time.Second, expected: 1000},
+		{curve: types.StreamingReleaseCurveEaseOut, elapsed: 200 * time.Second, expected: 1000},
+	}
+
+	for _, c := range cases {
+		st := testStreamingTransfer(c.curve, 0)
+		assert.Equal(t, c.expected, st.VestedAt(st.StartTime.Add(c.elapsed)).Uint64(), "%s after %s", c.curve, c.elapsed)
+	}
+}
+
+func TestStreamingTransferClaimable(t *testing.T) {
+	st := testStreamingTransfer(types.StreamingReleaseCurveLinear, 0)
+	now := st.StartTime.Add(40 * time.Second)
+
+	assert.Equal(t, uint64(400), st.ClaimableAt(now).Uint64())
+
+	st.Released = num.NewUint(300)
+	assert.Equal(t, uint64(100), st.ClaimableAt(now).Uint64())
+	assert.Equal(t, uint64(700), st.Remaining().Uint64())
+	// the released amount is never affected
+	assert.Equal(t, uint64(300), st.Released.Uint64())
+
+	st.Released = num.NewUint(500)
+	assert.True(t, st.ClaimableAt(now).IsZero())
+}
+
+func TestStreamingTransferReleaseDue(t *testing.T) {
+	st := testStreamingTransfer(types.StreamingReleaseCurveLinear, 0)
+	assert.False(t, st.ReleaseDue(st.StartTime))
+	assert.True(t, st.ReleaseDue(st.StartTime.Add(time.Second)))
+
+	st = testStreamingTransfer(types.StreamingReleaseCurveLinear, 30*time.Second)
+	assert.False(t, st.ReleaseDue(st.StartTime.Add(29*time.Second)))
+	assert.True(t, st.ReleaseDue(st.StartTime.Add(30*time.Second)))
+
+	st.LastRelease = st.StartTime.Add(90 * time.Second)
+	assert.False(t, st.ReleaseDue(st.StartTime.Add(99*time.Second)))
+	// the end of the stream is always due
+	assert.True(t, st.ReleaseDue(st.EndTime))
+}
+
+func TestStreamingTransferIsValid(t *testing.T) {
+	st := testStreamingTransfer(types.StreamingReleaseCurveEaseIn, 10*time.Second)
+	require.NoError(t, st.IsValid())
+
+	st.EndTime = st.StartTime
+	assert.ErrorIs(t, st.IsValid(), types.ErrStartTimeAfterEndTime)
+
+	st = testStreamingTransfer(types.StreamingReleaseCurveLinear, 101*time.Second)
+	assert.ErrorIs(t, st.IsValid(), types.ErrInvalidReleaseInterval)
+
+	st = testStreamingTransfer(types.StreamingReleaseCurve(42), 0)
+	assert.ErrorIs(t, st.IsValid(), types.ErrInvalidReleaseCurve)
+}
+
+func TestStreamingTransferEventRoundTrip(t *testing.T) {
+	st := testStreamingTransfer(types.StreamingReleaseCurveEaseOut, 10*time.Second)
+	st.Released = num.NewUint(123)
+	st.LastRelease = st.StartTime.Add(10 * time.Second)
+
+	evt := st.IntoEvent(nil)
+	require.NotNil(t, evt.GetStreaming())
+	assert.Equal(t, int64(10), evt.GetStreaming().GetReleaseInterval())
+
+	got := types.StreamingTransferFromEvent(evt)
+	assert.Equal(t, st.ID, got.ID)
+	assert.Equal(t, st.Amount, got.Amount)
+	assert.Equal(t, st.Released, got.Released)
+	assert.Equal(t, st.ReleaseInterval, got.ReleaseInterval)
+	assert.Equal(t, st.ReleaseCurve, got.ReleaseCurve)
+	assert.True(t, st.StartTime.Equal(got.StartTime))
+	assert.True(t, st.EndTime.Equal(got.EndTime))
+	assert.True(t, st.LastRelease.Equal(got.LastRelease))
+}
+
+func testConditionalTransferCommand() *commandspb.Transfer {
+	return &commandspb.Transfer{
+		FromAccountType: vegapb.AccountType_ACCOUNT_TYPE_GENERAL,
+		To:              "2e05fd230f3c9f4eaf0bdc5bfb7ca0c9d00278afc44637aab60da76653d7ccf0",
+		ToAccountType:   vegapb.AccountType_ACCOUNT_TYPE_GENERAL,
+		Asset:           "eth",
+		Amount:          "1000",
+		Reference:       "bounty",
+		Kind: &commandspb.Transfer_Conditional{
+			Conditional: &commandspb.ConditionalTransfer{
+				ReleaseCondition: vegapb.NewDataSourceDefinition(
+					vegapb.DataSourceContentTypeOracle,
+				).SetOracleConfig(
+					&vegapb.DataSourceDefinitionExternal_Oracle{
+						Oracle: &vegapb.DataSourceSpecConfiguration{
+							Signers: []*datapb.Signer{
+								{
+									Signer: &datapb.Signer_PubKey{
+										PubKey: &datapb.PubKey{Key: "0xCAFEDOOD"},
+									},
+								},
+							},
+							Filters: []*datapb.Filter{
+								{
+									Key: &datapb.PropertyKey{
+										Name: "audit.report.published",
+										Type: datapb.PropertyKey_TYPE_BOOLEAN,
+									},
+									Conditions: []*datapb.Condition{
+										{Operator: datapb.Condition_OPERATOR_EQUALS, Value: "true"},
+									},
+								},
+							},
+						},
+					},
+				),
+				Timeout: time.Unix(2000, 0).UnixNano(),
+			},
+		},
+	}
+}
+
+func TestConditionalTransferFromProto(t *testing.T) {
+	tf, err := types.NewTransferFromProto("transfer", "03ae90688632c649c4beab6040ff5bd04dbde8efbf737d8673bbda792a110301", testConditionalTransferCommand())
+	require.NoError(t, err)
+	require.Equal(t, types.TransferCommandKindConditional, tf.Kind)
+
+	ct := tf.Conditional
+	require.NotNil(t, ct.ReleaseCondition)
+	assert.NotEmpty(t, ct.ReleaseCondition.ID)
+	assert.True(t, time.Unix(2000, 0).Equal(ct.Timeout))
+	assert.Equal(t, types.TransferStatusPending, ct.Status)
+	require.NoError(t, ct.IsValid())
+
+	ct.FromAccountType = types.AccountTypeVestedRewards
+	assert.ErrorIs(t, ct.IsValid(), types.ErrUnsupportedFromAccountType)
+
+	ct.FromAccountType = types.AccountTypeGeneral
+	ct.ReleaseCondition = nil
+	assert.ErrorIs(t, ct.IsValid(), types.ErrMissingReleaseCondition)
+}
+
+func TestConditionalTransferEventRoundTrip(t *testing.T) {
+	tf, err := types.NewTransferFromProto("transfer", "03ae90688632c649c4beab6040ff5bd04dbde8efbf737d8673bbda792a110301", testConditionalTransferCommand())
+	require.NoError(t, err)
+	ct := tf.Conditional
+	ct.Timestamp = time.Unix(1000, 0)
+
+	evt := tf.IntoEvent(nil, nil)
+	require.NotNil(t, evt.GetConditional())
+	assert.Equal(t, ct.ReleaseCondition.ID, evt.GetConditional().GetReleaseCondition().GetId())
+
+	got := types.ConditionalTransferFromEvent(evt)
+	assert.Equal(t, ct.ID, got.ID)
+	assert.Equal(t, ct.Amount, got.Amount)
+	assert.Equal(t, ct.Reference, got.Reference)
+	assert.Equal(t, ct.ReleaseCondition.ID, got.ReleaseCondition.ID)
+	assert.Equal(t, ct.ReleaseCondition.IntoProto().String(), got.ReleaseCondition.IntoProto().String())
+	assert.True(t, ct.Timeout.Equal(got.Timeout))
+	assert.True(t, ct.Timestamp.Equal(got.Timestamp))
+}
@@ -0,0 +1,41 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"time"
+
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+)
+
+// CancelOnTimeout is a party's request to have its orders, stop orders and
+// optionally AMMs cancelled if it doesn't submit the command again within the
+// timeout. A zero timeout removes it.
+type CancelOnTimeout struct {
+	Party      string
+	Timeout    time.Duration
+	MarketIDs  []string
+	CancelAMMs bool
+}
+
+func NewCancelOnTimeoutFromProto(cmd *commandspb.CancelOnTimeout, party string) *CancelOnTimeout {
+	return &CancelOnTimeout{
+		Party:      party,
+		Timeout:    time.Duration(cmd.Timeout) * time.Second,
+		MarketIDs:  append([]string{}, cmd.MarketIds...),
+		CancelAMMs: cmd.CancelAmms,
+	}
+}
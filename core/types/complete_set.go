@@ -0,0 +1,55 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+)
+
+type CompleteSetAction = commandspb.SubmitCompleteSet_Action
+
+const (
+	// CompleteSetActionUnspecified is never valid.
+	CompleteSetActionUnspecified CompleteSetAction = commandspb.SubmitCompleteSet_ACTION_UNSPECIFIED
+	// CompleteSetActionMint mints complete sets against collateral.
+	CompleteSetActionMint CompleteSetAction = commandspb.SubmitCompleteSet_ACTION_MINT
+	// CompleteSetActionRedeem redeems complete sets for collateral.
+	CompleteSetActionRedeem CompleteSetAction = commandspb.SubmitCompleteSet_ACTION_REDEEM
+)
+
+// CompleteSetSubmission mints or redeems complete sets of the outcome contracts
+// of a prediction market, a complete set being one long unit of every outcome.
+type CompleteSetSubmission struct {
+	PredictionID string
+	Size         uint64
+	Action       CompleteSetAction
+}
+
+func NewCompleteSetSubmissionFromProto(cmd *commandspb.SubmitCompleteSet) *CompleteSetSubmission {
+	return &CompleteSetSubmission{
+		PredictionID: cmd.PredictionId,
+		Size:         cmd.Size,
+		Action:       cmd.Action,
+	}
+}
+
+func (c CompleteSetSubmission) IntoProto() *commandspb.SubmitCompleteSet {
+	return &commandspb.SubmitCompleteSet{
+		PredictionId: c.PredictionID,
+		Size:         c.Size,
+		Action:       c.Action,
+	}
+}
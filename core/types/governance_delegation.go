@@ -0,0 +1,171 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"fmt"
+
+	"code.vegaprotocol.io/vega/libs/ptr"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+)
+
+type ProposalType = vegapb.ProposalType
+
+const (
+	// ProposalTypeUnspecified is never a valid proposal type, a delegation without
+	// proposal type applies to all proposal types.
+	ProposalTypeUnspecified                 ProposalType = vegapb.ProposalType_PROPOSAL_TYPE_UNSPECIFIED
+	ProposalTypeNewMarket                   ProposalType = vegapb.ProposalType_PROPOSAL_TYPE_NEW_MARKET
+	ProposalTypeUpdateMarket                ProposalType = vegapb.ProposalType_PROPOSAL_TYPE_UPDATE_MARKET
+	ProposalTypeUpdateNetworkParameter      ProposalType = vegapb.ProposalType_PROPOSAL_TYPE_UPDATE_NETWORK_PARAMETER
+	ProposalTypeNewAsset                    ProposalType = vegapb.ProposalType_PROPOSAL_TYPE_NEW_ASSET
+	ProposalTypeNewFreeform                 ProposalType = vegapb.ProposalType_PROPOSAL_TYPE_NEW_FREEFORM
+	ProposalTypeUpdateAsset                 ProposalType = vegapb.ProposalType_PROPOSAL_TYPE_UPDATE_ASSET
+	ProposalTypeNewSpotMarket               ProposalType = vegapb.ProposalType_PROPOSAL_TYPE_NEW_SPOT_MARKET
+	ProposalTypeUpdateSpotMarket            ProposalType = vegapb.ProposalType_PROPOSAL_TYPE_UPDATE_SPOT_MARKET
+	ProposalTypeNewTransfer                 ProposalType = vegapb.ProposalType_PROPOSAL_TYPE_NEW_TRANSFER
+	ProposalTypeCancelTransfer              ProposalType = vegapb.ProposalType_PROPOSAL_TYPE_CANCEL_TRANSFER
+	ProposalTypeUpdateMarketState           ProposalType = vegapb.ProposalType_PROPOSAL_TYPE_UPDATE_MARKET_STATE
+	ProposalTypeUpdateReferralProgram       ProposalType = vegapb.ProposalType_PROPOSAL_TYPE_UPDATE_REFERRAL_PROGRAM
+	ProposalTypeUpdateVolumeDiscountProgram ProposalType = vegapb.ProposalType_PROPOSAL_TYPE_UPDATE_VOLUME_DISCOUNT_PROGRAM
+	ProposalTypeUpdateVolumeRebateProgram   ProposalType = vegapb.ProposalType_PROPOSAL_TYPE_UPDATE_VOLUME_REBATE_PROGRAM
+)
+
+// ProposalType returns the proposal type matching the terms type.
+func (t ProposalTermsType) ProposalType() ProposalType {
+	switch t {
+	case ProposalTermsTypeNewMarket:
+		return ProposalTypeNewMarket
+	case ProposalTermsTypeUpdateMarket:
+		return ProposalTypeUpdateMarket
+	case ProposalTermsTypeUpdateNetworkParameter:
+		return ProposalTypeUpdateNetworkParameter
+	case ProposalTermsTypeNewAsset:
+		return ProposalTypeNewAsset
+	case ProposalTermsTypeNewFreeform:
+		return ProposalTypeNewFreeform
+	case ProposalTermsTypeUpdateAsset:
+		return ProposalTypeUpdateAsset
+	case ProposalTermsTypeNewSpotMarket:
+		return ProposalTypeNewSpotMarket
+	case ProposalTermsTypeUpdateSpotMarket:
+		return ProposalTypeUpdateSpotMarket
+	case ProposalTermsTypeNewTransfer:
+		return ProposalTypeNewTransfer
+	case ProposalTermsTypeCancelTransfer:
+		return ProposalTypeCancelTransfer
+	case ProposalTermsTypeUpdateMarketState:
+		return ProposalTypeUpdateMarketState
+	case ProposalTermsTypeUpdateReferralProgram:
+		return ProposalTypeUpdateReferralProgram
+	case ProposalTermsTypeUpdateVolumeDiscountProgram:
+		return ProposalTypeUpdateVolumeDiscountProgram
+	case ProposalTermsTypeUpdateVolumeRebateProgram:
+		return ProposalTypeUpdateVolumeRebateProgram
+	default:
+		return ProposalTypeUnspecified
+	}
+}
+
+// GovernanceDelegationSubmission registers, updates or revokes the governance
+// delegate of a party, for all proposal types or for a single one.
+type GovernanceDelegationSubmission struct {
+	// Delegate is the party receiving the voting weight, empty to revoke
+	// the delegation.
+	Delegate string
+	// ProposalType the delegation applies to, nil if it applies to all
+	// proposal types.
+	ProposalType *ProposalType
+}
+
+func NewGovernanceDelegationSubmissionFromProto(cmd *commandspb.UpdateGovernanceDelegation) *GovernanceDelegationSubmission {
+	var proposalType *ProposalType
+	if cmd.ProposalType != nil {
+		proposalType = ptr.From(*cmd.ProposalType)
+	}
+	return &GovernanceDelegationSubmission{
+		Delegate:     cmd.Delegate,
+		ProposalType: proposalType,
+	}
+}
+
+func (g GovernanceDelegationSubmission) IntoProto() *commandspb.UpdateGovernanceDelegation {
+	var proposalType *vegapb.ProposalType
+	if g.ProposalType != nil {
+		proposalType = ptr.From(*g.ProposalType)
+	}
+	return &commandspb.UpdateGovernanceDelegation{
+		Delegate:     g.Delegate,
+		ProposalType: proposalType,
+	}
+}
+
+func (g GovernanceDelegationSubmission) String() string {
+	proposalType := "all"
+	if g.ProposalType != nil {
+		proposalType = g.ProposalType.String()
+	}
+	return fmt.Sprintf("delegate(%s) proposalType(%s)", g.Delegate, proposalType)
+}
+
+// GovernanceDelegation is the governance delegate registered by a party. If the
+// party doesn't vote on a proposal, its voting weight counts toward the vote of
+// its delegate.
+type GovernanceDelegation struct {
+	Delegator string
+	// Delegate is empty when the delegation has been revoked.
+	Delegate string
+	// ProposalType the delegation applies to, nil if it applies to all
+	// proposal types.
+	ProposalType *ProposalType
+	UpdatedAt    int64
+}
+
+func GovernanceDelegationFromProto(d *vegapb.GovernanceDelegation) *GovernanceDelegation {
+	var proposalType *ProposalType
+	if d.ProposalType != nil {
+		proposalType = ptr.From(*d.ProposalType)
+	}
+	return &GovernanceDelegation{
+		Delegator:    d.Delegator,
+		Delegate:     d.Delegate,
+		ProposalType: proposalType,
+		UpdatedAt:    d.UpdatedAt,
+	}
+}
+
+func (g GovernanceDelegation) IntoProto() *vegapb.GovernanceDelegation {
+	var proposalType *vegapb.ProposalType
+	if g.ProposalType != nil {
+		proposalType = ptr.From(*g.ProposalType)
+	}
+	return &vegapb.GovernanceDelegation{
+		Delegator:    g.Delegator,
+		Delegate:     g.Delegate,
+		ProposalType: proposalType,
+		UpdatedAt:    g.UpdatedAt,
+	}
+}
+
+// Scope returns the proposal type the delegation applies to, or
+// ProposalTypeUnspecified if it applies to all proposal types.
+func (g GovernanceDelegation) Scope() ProposalType {
+	if g.ProposalType == nil {
+		return ProposalTypeUnspecified
+	}
+	return *g.ProposalType
+}
@@ -17,6 +17,7 @@ package types
 
 import (
 	"fmt"
+	"slices"
 
 	"code.vegaprotocol.io/vega/libs/stringer"
 	vegapb "code.vegaprotocol.io/vega/protos/vega"
@@ -68,22 +69,50 @@ func (f ProposalTermsNewFreeform) DeepClone() ProposalTerm {
 	}
 }
 
-func NewNewFreeformFromProto(_ *vegapb.NewFreeform) *ProposalTermsNewFreeform {
+func NewNewFreeformFromProto(p *vegapb.NewFreeform) *ProposalTermsNewFreeform {
+	nf := &NewFreeform{}
+	if p != nil {
+		nf.Options = slices.Clone(p.Options)
+		nf.TallyMethod = p.TallyMethod
+	}
 	return &ProposalTermsNewFreeform{
-		NewFreeform: &NewFreeform{},
+		NewFreeform: nf,
 	}
 }
 
-type NewFreeform struct{}
+// NewFreeform is voted yes or no, unless it lists options, in which case it is
+// a poll on which parties vote for options.
+type NewFreeform struct {
+	Options     []string
+	TallyMethod PollTallyMethod
+}
+
+// IsPoll tells if the freeform proposal is a poll.
+func (n NewFreeform) IsPoll() bool {
+	return len(n.Options) > 0
+}
 
 func (n NewFreeform) IntoProto() *vegapb.NewFreeform {
-	return &vegapb.NewFreeform{}
+	return &vegapb.NewFreeform{
+		Options:     slices.Clone(n.Options),
+		TallyMethod: n.TallyMethod,
+	}
 }
 
 func (n NewFreeform) String() string {
-	return ""
+	if !n.IsPoll() {
+		return ""
+	}
+	return fmt.Sprintf(
+		"options(%v) tallyMethod(%s)",
+		n.Options,
+		n.TallyMethod.String(),
+	)
 }
 
 func (n NewFreeform) DeepClone() *NewFreeform {
-	return &NewFreeform{}
+	return &NewFreeform{
+		Options:     slices.Clone(n.Options),
+		TallyMethod: n.TallyMethod,
+	}
 }
@@ -18,6 +18,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -42,6 +43,7 @@ const (
 	ProductTypeFuture ProductType = iota
 	ProductTypeSpot
 	ProductTypePerps
+	ProductTypeOption
 	ProductTypeUnspecified // used on updates, if the product is not set
 )
 
@@ -402,6 +404,7 @@ type NewMarketConfiguration struct {
 	// Types that are valid to be assigned to RiskParameters:
 	//	*NewMarketConfigurationSimple
 	//	*NewMarketConfigurationLogNormal
+	//	*NewMarketConfigurationHistoricalSimulation
 	// RiskParameters isNewMarketConfiguration_RiskParameters
 	// Trading mode for the new market
 	//
@@ -413,6 +416,9 @@ type NewMarketConfiguration struct {
 	MarkPriceConfiguration *CompositePriceConfiguration
 	TickSize               *num.Uint
 	EnableTxReordering     bool
+	FrequentBatchAuction   *FrequentBatchAuctionParameters
+	PositionLimits         *PositionLimits
+	MatchingAlgorithm      MatchingAlgorithm
 }
 
 func (n NewMarketConfiguration) IntoProto() *vegapb.NewMarketConfiguration {
@@ -461,6 +467,9 @@ func (n NewMarketConfiguration) IntoProto() *vegapb.NewMarketConfiguration {
 		MarkPriceConfiguration:        n.MarkPriceConfiguration.IntoProto(),
 		TickSize:                      n.TickSize.String(),
 		EnableTransactionReordering:   n.EnableTxReordering,
+		FrequentBatchAuction:          n.FrequentBatchAuction.IntoProto(),
+		PositionLimits:                n.PositionLimits.IntoProto(),
+		MatchingAlgorithm:             n.MatchingAlgorithm,
 	}
 	if n.Successor != nil {
 		r.Successor = n.Successor.IntoProto()
@@ -470,6 +479,8 @@ func (n NewMarketConfiguration) IntoProto() *vegapb.NewMarketConfiguration {
 		r.RiskParameters = rp
 	case *vegapb.NewMarketConfiguration_LogNormal:
 		r.RiskParameters = rp
+	case *vegapb.NewMarketConfiguration_HistoricalSimulation:
+		r.RiskParameters = rp
 	}
 	return r
 }
@@ -483,6 +494,9 @@ func (n NewMarketConfiguration) DeepClone() *NewMarketConfiguration {
 		QuadraticSlippageFactor: n.QuadraticSlippageFactor.Copy(),
 		TickSize:                n.TickSize.Clone(),
 		EnableTxReordering:      n.EnableTxReordering,
+		FrequentBatchAuction:    n.FrequentBatchAuction.DeepClone(),
+		PositionLimits:          n.PositionLimits.DeepClone(),
+		MatchingAlgorithm:       n.MatchingAlgorithm,
 	}
 	cpy.Metadata = append(cpy.Metadata, n.Metadata...)
 	if n.Instrument != nil {
@@ -515,7 +529,7 @@ func (n NewMarketConfiguration) DeepClone() *NewMarketConfiguration {
 
 func (n NewMarketConfiguration) String() string {
 	return fmt.Sprintf(
-		"decimalPlaces(%v) positionDecimalPlaces(%v) metadata(%v) instrument(%s) priceMonitoring(%s) liquidityMonitoring(%s) risk(%s) linearSlippageFactor(%s) quadraticSlippageFactor(%s), CompositePriceConfiguration(%s), TickSize(%s), EnableTxReordering(%v)",
+		"decimalPlaces(%v) positionDecimalPlaces(%v) metadata(%v) instrument(%s) priceMonitoring(%s) liquidityMonitoring(%s) risk(%s) linearSlippageFactor(%s) quadraticSlippageFactor(%s), CompositePriceConfiguration(%s), TickSize(%s), EnableTxReordering(%v), FrequentBatchAuction(%s), PositionLimits(%s), matchingAlgorithm(%s)",
 		n.Metadata,
 		n.DecimalPlaces,
 		n.PositionDecimalPlaces,
@@ -528,6 +542,9 @@ func (n NewMarketConfiguration) String() string {
 		stringer.PtrToString(n.MarkPriceConfiguration),
 		num.UintToString(n.TickSize),
 		n.EnableTxReordering,
+		stringer.PtrToString(n.FrequentBatchAuction),
+		stringer.PtrToString(n.PositionLimits),
+		n.MatchingAlgorithm.String(),
 	)
 }
 
@@ -551,6 +568,14 @@ func (n NewMarketConfiguration) GetPerps() *InstrumentConfigurationPerps {
 	return nil
 }
 
+func (n NewMarketConfiguration) GetOption() *InstrumentConfigurationOption {
+	if n.ProductType() == ProductTypeOption {
+		o, _ := n.Instrument.Product.(*InstrumentConfigurationOption)
+		return o
+	}
+	return nil
+}
+
 func (n NewMarketConfiguration) GetSpot() *InstrumentConfigurationSpot {
 	if n.ProductType() == ProductTypeSpot {
 		f, _ := n.Instrument.Product.(*InstrumentConfigurationSpot)
@@ -632,6 +657,9 @@ func NewMarketConfigurationFromProto(p *vegapb.NewMarketConfiguration) (*NewMark
 		MarkPriceConfiguration:        markPriceConfig,
 		TickSize:                      tickSize,
 		EnableTxReordering:            p.EnableTransactionReordering,
+		FrequentBatchAuction:          FrequentBatchAuctionParametersFromProto(p.FrequentBatchAuction),
+		PositionLimits:                PositionLimitsFromProto(p.PositionLimits),
+		MatchingAlgorithm:             p.MatchingAlgorithm,
 	}
 	if p.RiskParameters != nil {
 		switch rp := p.RiskParameters.(type) {
@@ -639,6 +667,8 @@ func NewMarketConfigurationFromProto(p *vegapb.NewMarketConfiguration) (*NewMark
 			r.RiskParameters = NewMarketConfigurationSimpleFromProto(rp)
 		case *vegapb.NewMarketConfiguration_LogNormal:
 			r.RiskParameters = NewMarketConfigurationLogNormalFromProto(rp)
+		case *vegapb.NewMarketConfiguration_HistoricalSimulation:
+			r.RiskParameters = NewMarketConfigurationHistoricalSimulationFromProto(rp)
 		}
 	}
 	if p.Successor != nil {
@@ -763,6 +793,42 @@ func NewMarketConfigurationLogNormalFromProto(p *vegapb.NewMarketConfiguration_L
 	}
 }
 
+type NewMarketConfigurationHistoricalSimulation struct {
+	HistoricalSimulation *HistoricalSimulationRiskModel
+}
+
+func (n NewMarketConfigurationHistoricalSimulation) String() string {
+	return fmt.Sprintf(
+		"historicalSimulation(%s)",
+		stringer.PtrToString(n.HistoricalSimulation),
+	)
+}
+
+func (n NewMarketConfigurationHistoricalSimulation) newRiskParamsIntoProto() interface{} {
+	return n.IntoProto()
+}
+
+func (n NewMarketConfigurationHistoricalSimulation) DeepClone() newRiskParams {
+	if n.HistoricalSimulation == nil {
+		return &NewMarketConfigurationHistoricalSimulation{}
+	}
+	return &NewMarketConfigurationHistoricalSimulation{
+		HistoricalSimulation: n.HistoricalSimulation.DeepClone(),
+	}
+}
+
+func (n NewMarketConfigurationHistoricalSimulation) IntoProto() *vegapb.NewMarketConfiguration_HistoricalSimulation {
+	return &vegapb.NewMarketConfiguration_HistoricalSimulation{
+		HistoricalSimulation: n.HistoricalSimulation.IntoProto(),
+	}
+}
+
+func NewMarketConfigurationHistoricalSimulationFromProto(p *vegapb.NewMarketConfiguration_HistoricalSimulation) *NewMarketConfigurationHistoricalSimulation {
+	return &NewMarketConfigurationHistoricalSimulation{
+		HistoricalSimulation: HistoricalSimulationRiskModelFromProto(p.HistoricalSimulation),
+	}
+}
+
 type instrumentConfigurationProduct interface {
 	isInstrumentConfigurationProduct()
 	icpIntoProto() interface{}
@@ -852,12 +918,53 @@ func (i InstrumentConfigurationPerps) icpIntoProto() interface{} {
 
 func (InstrumentConfigurationPerps) isInstrumentConfigurationProduct() {}
 
+type InstrumentConfigurationOption struct {
+	Option *OptionProduct
+}
+
+func (i InstrumentConfigurationOption) String() string {
+	return fmt.Sprintf(
+		"option(%s)",
+		stringer.PtrToString(i.Option),
+	)
+}
+
+func (i InstrumentConfigurationOption) DeepClone() instrumentConfigurationProduct {
+	if i.Option == nil {
+		return &InstrumentConfigurationOption{}
+	}
+	return &InstrumentConfigurationOption{
+		Option: i.Option.DeepClone(),
+	}
+}
+
+func (i InstrumentConfigurationOption) Assets() []string {
+	return i.Option.Assets()
+}
+
+func (InstrumentConfigurationOption) Type() ProductType {
+	return ProductTypeOption
+}
+
+func (i InstrumentConfigurationOption) IntoProto() *vegapb.InstrumentConfiguration_Option {
+	return &vegapb.InstrumentConfiguration_Option{
+		Option: i.Option.IntoProto(),
+	}
+}
+
+func (i InstrumentConfigurationOption) icpIntoProto() interface{} {
+	return i.IntoProto()
+}
+
+func (InstrumentConfigurationOption) isInstrumentConfigurationProduct() {}
+
 type InstrumentConfiguration struct {
 	Name string
 	Code string
 	// *InstrumentConfigurationFuture
 	// *InstrumentConfigurationSpot
 	// *InstrumentConfigurationPerps
+	// *InstrumentConfigurationOption
 	Product instrumentConfigurationProduct
 }
 
@@ -885,6 +992,8 @@ func (i InstrumentConfiguration) IntoProto() *vegapb.InstrumentConfiguration {
 		r.Product = pr
 	case *vegapb.InstrumentConfiguration_Spot:
 		r.Product = pr
+	case *vegapb.InstrumentConfiguration_Option:
+		r.Product = pr
 	}
 	return r
 }
@@ -929,6 +1038,7 @@ func InstrumentConfigurationFromProto(
 				DataSourceSpecForTradingTermination: *datasource.NewDefinitionWith(term),
 				DataSourceSpecBinding:               datasource.SpecBindingForFutureFromProto(pr.Future.DataSourceSpecBinding),
 				Cap:                                 fCap,
+				PredictionOutcomes:                  slices.Clone(pr.Future.PredictionOutcomes),
 			},
 		}
 	case *vegapb.InstrumentConfiguration_Perpetual:
@@ -986,6 +1096,11 @@ func InstrumentConfigurationFromProto(
 			ipc = CompositePriceConfigurationFromProto(pr.Perpetual.InternalCompositePriceConfiguration)
 		}
 
+		var ibc *IndexBasketConfiguration
+		if pr.Perpetual.IndexBasketConfiguration != nil {
+			ibc = IndexBasketConfigurationFromProto(pr.Perpetual.IndexBasketConfiguration)
+		}
+
 		r.Product = &InstrumentConfigurationPerps{
 			Perps: &PerpsProduct{
 				SettlementAsset:                     pr.Perpetual.SettlementAsset,
@@ -1001,6 +1116,35 @@ func InstrumentConfigurationFromProto(
 				DataSourceSpecForSettlementSchedule: *datasource.NewDefinitionWith(settlementSchedule),
 				DataSourceSpecBinding:               datasource.SpecBindingForPerpsFromProto(pr.Perpetual.DataSourceSpecBinding),
 				InternalCompositePriceConfig:        ipc,
+				IndexBasketConfig:                   ibc,
+			},
+		}
+	case *vegapb.InstrumentConfiguration_Option:
+		settl, err := datasource.DefinitionFromProto(pr.Option.DataSourceSpecForSettlementData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse settlement data source spec: %w", err)
+		}
+
+		term, err := datasource.DefinitionFromProto(pr.Option.DataSourceSpecForTradingTermination)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse trading termination data source spec: %w", err)
+		}
+
+		strike, err := num.DecimalFromString(pr.Option.StrikePrice)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse strike price: %w", err)
+		}
+
+		r.Product = &InstrumentConfigurationOption{
+			Option: &OptionProduct{
+				SettlementAsset:                     pr.Option.SettlementAsset,
+				QuoteName:                           pr.Option.QuoteName,
+				OptionType:                          pr.Option.OptionType,
+				StrikePrice:                         strike,
+				ExpiryTimestamp:                     pr.Option.ExpiryTimestamp,
+				DataSourceSpecForSettlementData:     *datasource.NewDefinitionWith(settl),
+				DataSourceSpecForTradingTermination: *datasource.NewDefinitionWith(term),
+				DataSourceSpecBinding:               datasource.SpecBindingForFutureFromProto(pr.Option.DataSourceSpecBinding),
 			},
 		}
 	case *vegapb.InstrumentConfiguration_Spot:
@@ -1062,6 +1206,8 @@ type FutureProduct struct {
 	DataSourceSpecForTradingTermination dsdefinition.Definition
 	DataSourceSpecBinding               *datasource.SpecBindingForFuture
 	Cap                                 *FutureCap
+	// PredictionOutcomes are the names of the outcomes if the product creates a prediction market.
+	PredictionOutcomes []string
 }
 
 func (f FutureProduct) IntoProto() *vegapb.FutureProduct {
@@ -1076,6 +1222,7 @@ func (f FutureProduct) IntoProto() *vegapb.FutureProduct {
 		DataSourceSpecForTradingTermination: f.DataSourceSpecForTradingTermination.IntoProto(),
 		DataSourceSpecBinding:               f.DataSourceSpecBinding.IntoProto(),
 		Cap:                                 fCap,
+		PredictionOutcomes:                  slices.Clone(f.PredictionOutcomes),
 	}
 }
 
@@ -1091,6 +1238,7 @@ func (f FutureProduct) DeepClone() *FutureProduct {
 		DataSourceSpecForTradingTermination: *f.DataSourceSpecForTradingTermination.DeepClone().(*dsdefinition.Definition),
 		DataSourceSpecBinding:               f.DataSourceSpecBinding.DeepClone(),
 		Cap:                                 fCap,
+		PredictionOutcomes:                  slices.Clone(f.PredictionOutcomes),
 	}
 }
 
@@ -1100,13 +1248,14 @@ func (f FutureProduct) String() string {
 		fCap = f.Cap.String()
 	}
 	return fmt.Sprintf(
-		"quote(%s) settlementAsset(%s) settlementData(%s) tradingTermination(%s) binding(%s) capped(%s)",
+		"quote(%s) settlementAsset(%s) settlementData(%s) tradingTermination(%s) binding(%s) capped(%s) predictionOutcomes(%v)",
 		f.QuoteName,
 		f.SettlementAsset,
 		stringer.ObjToString(f.DataSourceSpecForSettlementData),
 		stringer.ObjToString(f.DataSourceSpecForTradingTermination),
 		stringer.PtrToString(f.DataSourceSpecBinding),
 		fCap,
+		f.PredictionOutcomes,
 	)
 }
 
@@ -1114,6 +1263,62 @@ func (f FutureProduct) Assets() []string {
 	return []string{f.SettlementAsset}
 }
 
+type OptionProduct struct {
+	SettlementAsset string
+	QuoteName       string
+	OptionType      OptionType
+	// StrikePrice is expressed in the same decimal places as the settlement data.
+	StrikePrice                         num.Decimal
+	ExpiryTimestamp                     int64
+	DataSourceSpecForSettlementData     dsdefinition.Definition
+	DataSourceSpecForTradingTermination dsdefinition.Definition
+	DataSourceSpecBinding               *datasource.SpecBindingForFuture
+}
+
+func (o OptionProduct) IntoProto() *vegapb.OptionProduct {
+	return &vegapb.OptionProduct{
+		SettlementAsset:                     o.SettlementAsset,
+		QuoteName:                           o.QuoteName,
+		OptionType:                          o.OptionType,
+		StrikePrice:                         o.StrikePrice.String(),
+		ExpiryTimestamp:                     o.ExpiryTimestamp,
+		DataSourceSpecForSettlementData:     o.DataSourceSpecForSettlementData.IntoProto(),
+		DataSourceSpecForTradingTermination: o.DataSourceSpecForTradingTermination.IntoProto(),
+		DataSourceSpecBinding:               o.DataSourceSpecBinding.IntoProto(),
+	}
+}
+
+func (o OptionProduct) DeepClone() *OptionProduct {
+	return &OptionProduct{
+		SettlementAsset:                     o.SettlementAsset,
+		QuoteName:                           o.QuoteName,
+		OptionType:                          o.OptionType,
+		StrikePrice:                         o.StrikePrice,
+		ExpiryTimestamp:                     o.ExpiryTimestamp,
+		DataSourceSpecForSettlementData:     *o.DataSourceSpecForSettlementData.DeepClone().(*dsdefinition.Definition),
+		DataSourceSpecForTradingTermination: *o.DataSourceSpecForTradingTermination.DeepClone().(*dsdefinition.Definition),
+		DataSourceSpecBinding:               o.DataSourceSpecBinding.DeepClone(),
+	}
+}
+
+func (o OptionProduct) String() string {
+	return fmt.Sprintf(
+		"quote(%s) settlementAsset(%s) optionType(%s) strikePrice(%s) expiry(%d) settlementData(%s) tradingTermination(%s) binding(%s)",
+		o.QuoteName,
+		o.SettlementAsset,
+		o.OptionType.String(),
+		o.StrikePrice.String(),
+		o.ExpiryTimestamp,
+		stringer.ObjToString(o.DataSourceSpecForSettlementData),
+		stringer.ObjToString(o.DataSourceSpecForTradingTermination),
+		stringer.PtrToString(o.DataSourceSpecBinding),
+	)
+}
+
+func (o OptionProduct) Assets() []string {
+	return []string{o.SettlementAsset}
+}
+
 type PerpsProduct struct {
 	SettlementAsset string
 	QuoteName       string
@@ -1132,6 +1337,7 @@ type PerpsProduct struct {
 	DataSourceSpecBinding               *datasource.SpecBindingForPerps
 
 	InternalCompositePriceConfig *CompositePriceConfiguration
+	IndexBasketConfig            *IndexBasketConfiguration
 }
 
 func (p PerpsProduct) IntoProto() *vegapb.PerpetualProduct {
@@ -1155,6 +1361,11 @@ func (p PerpsProduct) IntoProto() *vegapb.PerpetualProduct {
 		ipc = p.InternalCompositePriceConfig.IntoProto()
 	}
 
+	var ibc *vegapb.IndexBasketConfiguration
+	if p.IndexBasketConfig != nil {
+		ibc = p.IndexBasketConfig.IntoProto()
+	}
+
 	return &vegapb.PerpetualProduct{
 		SettlementAsset:                     p.SettlementAsset,
 		QuoteName:                           p.QuoteName,
@@ -1169,6 +1380,7 @@ func (p PerpsProduct) IntoProto() *vegapb.PerpetualProduct {
 		DataSourceSpecForSettlementSchedule: p.DataSourceSpecForSettlementSchedule.IntoProto(),
 		DataSourceSpecBinding:               p.DataSourceSpecBinding.IntoProto(),
 		InternalCompositePriceConfiguration: ipc,
+		IndexBasketConfiguration:            ibc,
 	}
 }
 
@@ -1177,6 +1389,10 @@ func (p PerpsProduct) DeepClone() *PerpsProduct {
 	if p.InternalCompositePriceConfig != nil {
 		ipc = p.InternalCompositePriceConfig.DeepClone()
 	}
+	var ibc *IndexBasketConfiguration
+	if p.IndexBasketConfig != nil {
+		ibc = p.IndexBasketConfig.DeepClone()
+	}
 	return &PerpsProduct{
 		SettlementAsset:                     p.SettlementAsset,
 		QuoteName:                           p.QuoteName,
@@ -1191,12 +1407,13 @@ func (p PerpsProduct) DeepClone() *PerpsProduct {
 		DataSourceSpecForSettlementSchedule: *p.DataSourceSpecForSettlementSchedule.DeepClone().(*dsdefinition.Definition),
 		DataSourceSpecBinding:               p.DataSourceSpecBinding.DeepClone(),
 		InternalCompositePriceConfig:        ipc,
+		IndexBasketConfig:                   ibc,
 	}
 }
 
 func (p PerpsProduct) String() string {
 	return fmt.Sprintf(
-		"quote(%s) settlementAsset(%s) marginFundingFactor(%s) interestRate(%s) clampLowerBound(%s) clampUpperBound(%s) settlementData(%s) settlementSchedule(%s) binding(%s) internalCompositePriceConfig(%s)",
+		"quote(%s) settlementAsset(%s) marginFundingFactor(%s) interestRate(%s) clampLowerBound(%s) clampUpperBound(%s) settlementData(%s) settlementSchedule(%s) binding(%s) internalCompositePriceConfig(%s) indexBasketConfig(%s)",
 		p.QuoteName,
 		p.SettlementAsset,
 		p.MarginFundingFactor.String(),
@@ -1207,6 +1424,7 @@ func (p PerpsProduct) String() string {
 		stringer.ObjToString(p.DataSourceSpecForSettlementSchedule),
 		stringer.PtrToString(p.DataSourceSpecBinding),
 		stringer.PtrToString(p.InternalCompositePriceConfig),
+		stringer.PtrToString(p.IndexBasketConfig),
 	)
 }
 
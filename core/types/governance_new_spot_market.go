@@ -121,6 +121,8 @@ type NewSpotMarketConfiguration struct {
 	LiquidityFeeSettings      *LiquidityFeeSettings
 	TickSize                  *num.Uint
 	EnableTxReordering        bool
+	FrequentBatchAuction      *FrequentBatchAuctionParameters
+	MatchingAlgorithm         MatchingAlgorithm
 
 	// New market risk model parameters
 	//
@@ -165,6 +167,8 @@ func (n NewSpotMarketConfiguration) IntoProto() *vegapb.NewSpotMarketConfigurati
 		LiquidityFeeSettings:        n.LiquidityFeeSettings.IntoProto(),
 		TickSize:                    n.TickSize.String(),
 		EnableTransactionReordering: n.EnableTxReordering,
+		FrequentBatchAuction:        n.FrequentBatchAuction.IntoProto(),
+		MatchingAlgorithm:           n.MatchingAlgorithm,
 	}
 	switch rp := riskParams.(type) {
 	case *vegapb.NewSpotMarketConfiguration_Simple:
@@ -177,12 +181,14 @@ func (n NewSpotMarketConfiguration) IntoProto() *vegapb.NewSpotMarketConfigurati
 
 func (n NewSpotMarketConfiguration) DeepClone() *NewSpotMarketConfiguration {
 	cpy := &NewSpotMarketConfiguration{
-		PriceDecimalPlaces: n.PriceDecimalPlaces,
-		SizeDecimalPlaces:  n.SizeDecimalPlaces,
-		Metadata:           make([]string, len(n.Metadata)),
-		SLAParams:          n.SLAParams.DeepClone(),
-		TickSize:           n.TickSize.Clone(),
-		EnableTxReordering: n.EnableTxReordering,
+		PriceDecimalPlaces:   n.PriceDecimalPlaces,
+		SizeDecimalPlaces:    n.SizeDecimalPlaces,
+		Metadata:             make([]string, len(n.Metadata)),
+		SLAParams:            n.SLAParams.DeepClone(),
+		TickSize:             n.TickSize.Clone(),
+		EnableTxReordering:   n.EnableTxReordering,
+		FrequentBatchAuction: n.FrequentBatchAuction.DeepClone(),
+		MatchingAlgorithm:    n.MatchingAlgorithm,
 	}
 	cpy.Metadata = append(cpy.Metadata, n.Metadata...)
 	if n.Instrument != nil {
@@ -205,7 +211,7 @@ func (n NewSpotMarketConfiguration) DeepClone() *NewSpotMarketConfiguration {
 
 func (n NewSpotMarketConfiguration) String() string {
 	return fmt.Sprintf(
-		"decimalPlaces(%v) positionDecimalPlaces(%v) metadata(%v) instrument(%s) priceMonitoring(%s) targetStakeParameters(%s) risk(%s) slaParams(%s) tickSize (%s) enableTxReordering(%v)",
+		"decimalPlaces(%v) positionDecimalPlaces(%v) metadata(%v) instrument(%s) priceMonitoring(%s) targetStakeParameters(%s) risk(%s) slaParams(%s) tickSize (%s) enableTxReordering(%v) frequentBatchAuction(%s) matchingAlgorithm(%s)",
 		n.Metadata,
 		n.PriceDecimalPlaces,
 		n.SizeDecimalPlaces,
@@ -216,6 +222,8 @@ func (n NewSpotMarketConfiguration) String() string {
 		stringer.PtrToString(n.SLAParams),
 		num.UintToString(n.TickSize),
 		n.EnableTxReordering,
+		stringer.PtrToString(n.FrequentBatchAuction),
+		n.MatchingAlgorithm.String(),
 	)
 }
 
@@ -261,6 +269,8 @@ func NewSpotMarketConfigurationFromProto(p *vegapb.NewSpotMarketConfiguration) (
 		LiquidityFeeSettings:      liquidityFeeSettings,
 		TickSize:                  tickSize,
 		EnableTxReordering:        p.EnableTransactionReordering,
+		FrequentBatchAuction:      FrequentBatchAuctionParametersFromProto(p.FrequentBatchAuction),
+		MatchingAlgorithm:         p.MatchingAlgorithm,
 	}
 	if p.RiskParameters != nil {
 		switch rp := p.RiskParameters.(type) {
@@ -0,0 +1,117 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"fmt"
+
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/ptr"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+)
+
+type PollTallyMethod = vegapb.PollTallyMethod
+
+const (
+	// PollTallyMethodUnspecified is the default value, always invalid for a poll.
+	PollTallyMethodUnspecified PollTallyMethod = vegapb.PollTallyMethod_POLL_TALLY_METHOD_UNSPECIFIED
+	// PollTallyMethodPlurality lets parties vote for a single option.
+	PollTallyMethodPlurality PollTallyMethod = vegapb.PollTallyMethod_POLL_TALLY_METHOD_PLURALITY
+	// PollTallyMethodApproval lets parties vote for any number of options.
+	PollTallyMethodApproval PollTallyMethod = vegapb.PollTallyMethod_POLL_TALLY_METHOD_APPROVAL
+	// PollTallyMethodRankedChoice lets parties rank options, the votes being
+	// tallied by instant runoff.
+	PollTallyMethodRankedChoice PollTallyMethod = vegapb.PollTallyMethod_POLL_TALLY_METHOD_RANKED_CHOICE
+)
+
+// PollResult is the tally of the votes of a poll.
+type PollResult struct {
+	// Tallies of the options, in the order of the options of the poll.
+	Tallies []*PollOptionTally
+	// WinningOption is the index of the winning option, nil if the poll ended
+	// in a tie or if no option received any vote.
+	WinningOption *uint32
+	// Rounds is the number of rounds needed to find the outcome of the poll.
+	Rounds uint32
+}
+
+// PollOptionTally is the tally of an option of a poll.
+type PollOptionTally struct {
+	// Weight is the number of governance tokens of the votes for the option.
+	Weight *num.Uint
+	// EliminatedInRound is the round in which the option was eliminated from a
+	// ranked-choice poll, 0 if it was not eliminated.
+	EliminatedInRound uint32
+}
+
+func (r PollResult) IntoProto() *vegapb.PollResult {
+	tallies := make([]*vegapb.PollOptionTally, 0, len(r.Tallies))
+	for _, t := range r.Tallies {
+		tallies = append(tallies, &vegapb.PollOptionTally{
+			Weight:            num.UintToString(t.Weight),
+			EliminatedInRound: t.EliminatedInRound,
+		})
+	}
+	var winningOption *uint32
+	if r.WinningOption != nil {
+		winningOption = ptr.From(*r.WinningOption)
+	}
+	return &vegapb.PollResult{
+		Tallies:       tallies,
+		WinningOption: winningOption,
+		Rounds:        r.Rounds,
+	}
+}
+
+func PollResultFromProto(p *vegapb.PollResult) (*PollResult, error) {
+	tallies := make([]*PollOptionTally, 0, len(p.Tallies))
+	for _, t := range p.Tallies {
+		weight, overflow := num.UintFromString(t.Weight, 10)
+		if overflow {
+			return nil, fmt.Errorf("invalid poll option weight: %s", t.Weight)
+		}
+		tallies = append(tallies, &PollOptionTally{
+			Weight:            weight,
+			EliminatedInRound: t.EliminatedInRound,
+		})
+	}
+	var winningOption *uint32
+	if p.WinningOption != nil {
+		winningOption = ptr.From(*p.WinningOption)
+	}
+	return &PollResult{
+		Tallies:       tallies,
+		WinningOption: winningOption,
+		Rounds:        p.Rounds,
+	}, nil
+}
+
+func (r PollResult) DeepClone() *PollResult {
+	cpy := &PollResult{
+		Tallies: make([]*PollOptionTally, 0, len(r.Tallies)),
+		Rounds:  r.Rounds,
+	}
+	for _, t := range r.Tallies {
+		cpy.Tallies = append(cpy.Tallies, &PollOptionTally{
+			Weight:            t.Weight.Clone(),
+			EliminatedInRound: t.EliminatedInRound,
+		})
+	}
+	if r.WinningOption != nil {
+		cpy.WinningOption = ptr.From(*r.WinningOption)
+	}
+	return cpy
+}
@@ -133,6 +133,10 @@ const (
 	ProposalErrorInvalidSizeDecimalPlaces = vegapb.ProposalError_PROPOSAL_ERROR_INVALID_SIZE_DECIMAL_PLACES
 	// ProposalErrorInvalidVolumeRebateProgram is returned when the volume rebate program proposal is not valid.
 	ProposalErrorInvalidVolumeRebateProgram ProposalError = vegapb.ProposalError_PROPOSAL_ERROR_INVALID_VOLUME_REBATE_PROGRAM
+	// ProposalErrorInvalidOptionProduct is returned when the option market proposal contains an invalid product definition.
+	ProposalErrorInvalidOptionProduct ProposalError = vegapb.ProposalError_PROPOSAL_ERROR_INVALID_OPTION_PRODUCT
+	// ProposalErrorPollTied is returned when a poll ends in a tie between its leading options.
+	ProposalErrorPollTied ProposalError = vegapb.ProposalError_PROPOSAL_ERROR_POLL_TIED
 )
 
 type ProposalState = vegapb.Proposal_State
@@ -442,6 +446,8 @@ type Proposal struct {
 	RequiredParticipation   num.Decimal
 	RequiredLPMajority      num.Decimal
 	RequiredLPParticipation num.Decimal
+	// PollResult is the tally of the votes of a poll, set once the poll is closed.
+	PollResult *PollResult
 }
 
 func (p Proposal) IsOpen() bool {
@@ -492,6 +498,12 @@ func (p Proposal) IsVolumeRebateProgramUpdate() bool {
 	return p.Terms.IsVolumeRebateProgramUpdate()
 }
 
+// IsPoll tells if the proposal is a freeform proposal with options.
+func (p Proposal) IsPoll() bool {
+	nf := p.Terms.GetNewFreeform()
+	return nf != nil && nf.IsPoll()
+}
+
 func (p Proposal) MarketUpdate() *UpdateMarket {
 	return p.Terms.MarketUpdate()
 }
@@ -562,6 +574,9 @@ func (p Proposal) DeepClone() *Proposal {
 	if p.Terms != nil {
 		cpy.Terms = p.Terms.DeepClone()
 	}
+	if p.PollResult != nil {
+		cpy.PollResult = p.PollResult.DeepClone()
+	}
 	return &cpy
 }
 
@@ -628,6 +643,9 @@ func (p Proposal) IntoProto() *vegapb.Proposal {
 			Title:       p.Rationale.Title,
 		}
 	}
+	if p.PollResult != nil {
+		proposal.PollResult = p.PollResult.IntoProto()
+	}
 
 	return proposal
 }
@@ -676,6 +694,12 @@ func ProposalFromProto(pp *vegapb.Proposal) (*Proposal, error) {
 	if pp.ErrorDetails != nil {
 		errDetails = *pp.ErrorDetails
 	}
+	var pollResult *PollResult
+	if pp.PollResult != nil {
+		if pollResult, err = PollResultFromProto(pp.PollResult); err != nil {
+			return nil, err
+		}
+	}
 
 	return &Proposal{
 		ID:                      pp.Id,
@@ -692,6 +716,7 @@ func ProposalFromProto(pp *vegapb.Proposal) (*Proposal, error) {
 		RequiredParticipation:   participation,
 		RequiredLPMajority:      lpMajority,
 		RequiredLPParticipation: lpParticipation,
+		PollResult:              pollResult,
 	}, nil
 }
 
@@ -139,11 +139,14 @@ type UpdateMarketConfiguration struct {
 	MarkPriceConfiguration        *CompositePriceConfiguration
 	TickSize                      *num.Uint
 	EnableTxReordering            bool
+	FrequentBatchAuction          *FrequentBatchAuctionParameters
+	PositionLimits                *PositionLimits
+	MatchingAlgorithm             MatchingAlgorithm
 }
 
 func (n UpdateMarketConfiguration) String() string {
 	return fmt.Sprintf(
-		"instrument(%s) metadata(%v) priceMonitoring(%s) liquidityMonitoring(%s) risk(%s) linearSlippageFactor(%s) quadraticSlippageFactor(%s), markPriceConfiguration(%s), tickSize(%s), enableTxReordering(%v)",
+		"instrument(%s) metadata(%v) priceMonitoring(%s) liquidityMonitoring(%s) risk(%s) linearSlippageFactor(%s) quadraticSlippageFactor(%s), markPriceConfiguration(%s), tickSize(%s), enableTxReordering(%v), frequentBatchAuction(%s), positionLimits(%s), matchingAlgorithm(%s)",
 		stringer.PtrToString(n.Instrument),
 		MetadataList(n.Metadata).String(),
 		stringer.PtrToString(n.PriceMonitoringParameters),
@@ -154,6 +157,9 @@ func (n UpdateMarketConfiguration) String() string {
 		stringer.PtrToString(n.MarkPriceConfiguration),
 		num.UintToString(n.TickSize),
 		n.EnableTxReordering,
+		stringer.PtrToString(n.FrequentBatchAuction),
+		stringer.PtrToString(n.PositionLimits),
+		n.MatchingAlgorithm.String(),
 	)
 }
 
@@ -164,6 +170,9 @@ func (n UpdateMarketConfiguration) DeepClone() *UpdateMarketConfiguration {
 		QuadraticSlippageFactor: n.QuadraticSlippageFactor.Copy(),
 		TickSize:                n.TickSize.Clone(),
 		EnableTxReordering:      n.EnableTxReordering,
+		FrequentBatchAuction:    n.FrequentBatchAuction.DeepClone(),
+		PositionLimits:          n.PositionLimits.DeepClone(),
+		MatchingAlgorithm:       n.MatchingAlgorithm,
 	}
 	cpy.Metadata = append(cpy.Metadata, n.Metadata...)
 	if n.Instrument != nil {
@@ -236,12 +245,17 @@ func (n UpdateMarketConfiguration) IntoProto() *vegapb.UpdateMarketConfiguration
 		MarkPriceConfiguration:        n.MarkPriceConfiguration.IntoProto(),
 		TickSize:                      n.TickSize.String(),
 		EnableTransactionReordering:   n.EnableTxReordering,
+		FrequentBatchAuction:          n.FrequentBatchAuction.IntoProto(),
+		PositionLimits:                n.PositionLimits.IntoProto(),
+		MatchingAlgorithm:             n.MatchingAlgorithm,
 	}
 	switch rp := riskParams.(type) {
 	case *vegapb.UpdateMarketConfiguration_Simple:
 		r.RiskParameters = rp
 	case *vegapb.UpdateMarketConfiguration_LogNormal:
 		r.RiskParameters = rp
+	case *vegapb.UpdateMarketConfiguration_HistoricalSimulation:
+		r.RiskParameters = rp
 	}
 	return r
 }
@@ -337,6 +351,9 @@ func UpdateMarketConfigurationFromProto(p *vegapb.UpdateMarketConfiguration) (*U
 		MarkPriceConfiguration:        CompositePriceConfigurationFromProto(p.MarkPriceConfiguration),
 		TickSize:                      tickSize,
 		EnableTxReordering:            p.EnableTransactionReordering,
+		FrequentBatchAuction:          FrequentBatchAuctionParametersFromProto(p.FrequentBatchAuction),
+		PositionLimits:                PositionLimitsFromProto(p.PositionLimits),
+		MatchingAlgorithm:             p.MatchingAlgorithm,
 	}
 	if p.RiskParameters != nil {
 		switch rp := p.RiskParameters.(type) {
@@ -344,6 +361,8 @@ func UpdateMarketConfigurationFromProto(p *vegapb.UpdateMarketConfiguration) (*U
 			r.RiskParameters = UpdateMarketConfigurationSimpleFromProto(rp)
 		case *vegapb.UpdateMarketConfiguration_LogNormal:
 			r.RiskParameters = UpdateMarketConfigurationLogNormalFromProto(rp)
+		case *vegapb.UpdateMarketConfiguration_HistoricalSimulation:
+			r.RiskParameters = UpdateMarketConfigurationHistoricalSimulationFromProto(rp)
 		}
 	}
 	return r, nil
@@ -542,6 +561,11 @@ func UpdateInstrumentConfigurationFromProto(p *vegapb.UpdateInstrumentConfigurat
 			ipc = CompositePriceConfigurationFromProto(pr.Perpetual.InternalCompositePriceConfiguration)
 		}
 
+		var ibc *IndexBasketConfiguration
+		if pr.Perpetual.IndexBasketConfiguration != nil {
+			ibc = IndexBasketConfigurationFromProto(pr.Perpetual.IndexBasketConfiguration)
+		}
+
 		r.Product = &UpdateInstrumentConfigurationPerps{
 			Perps: &UpdatePerpsProduct{
 				QuoteName:                           pr.Perpetual.QuoteName,
@@ -556,6 +580,7 @@ func UpdateInstrumentConfigurationFromProto(p *vegapb.UpdateInstrumentConfigurat
 				DataSourceSpecForSettlementSchedule: *datasource.NewDefinitionWith(settlementSchedule),
 				DataSourceSpecBinding:               datasource.SpecBindingForPerpsFromProto(pr.Perpetual.DataSourceSpecBinding),
 				InternalCompositePrice:              ipc,
+				IndexBasket:                         ibc,
 			},
 		}
 	}
@@ -613,6 +638,7 @@ type UpdatePerpsProduct struct {
 	DataSourceSpecForSettlementSchedule dsdefinition.Definition
 	DataSourceSpecBinding               *datasource.SpecBindingForPerps
 	InternalCompositePrice              *CompositePriceConfiguration
+	IndexBasket                         *IndexBasketConfiguration
 }
 
 func (p UpdatePerpsProduct) IntoProto() *vegapb.UpdatePerpetualProduct {
@@ -632,6 +658,11 @@ func (p UpdatePerpsProduct) IntoProto() *vegapb.UpdatePerpetualProduct {
 		ipc = p.InternalCompositePrice.IntoProto()
 	}
 
+	var ibc *vegapb.IndexBasketConfiguration
+	if p.IndexBasket != nil {
+		ibc = p.IndexBasket.IntoProto()
+	}
+
 	return &vegapb.UpdatePerpetualProduct{
 		QuoteName:                           p.QuoteName,
 		MarginFundingFactor:                 p.MarginFundingFactor.String(),
@@ -645,10 +676,15 @@ func (p UpdatePerpsProduct) IntoProto() *vegapb.UpdatePerpetualProduct {
 		DataSourceSpecForSettlementSchedule: p.DataSourceSpecForSettlementSchedule.IntoProto(),
 		DataSourceSpecBinding:               p.DataSourceSpecBinding.IntoProto(),
 		InternalCompositePriceConfiguration: ipc,
+		IndexBasketConfiguration:            ibc,
 	}
 }
 
 func (p UpdatePerpsProduct) DeepClone() *UpdatePerpsProduct {
+	var ibc *IndexBasketConfiguration
+	if p.IndexBasket != nil {
+		ibc = p.IndexBasket.DeepClone()
+	}
 	return &UpdatePerpsProduct{
 		QuoteName:                           p.QuoteName,
 		MarginFundingFactor:                 p.MarginFundingFactor,
@@ -659,6 +695,7 @@ func (p UpdatePerpsProduct) DeepClone() *UpdatePerpsProduct {
 		DataSourceSpecForSettlementSchedule: *p.DataSourceSpecForSettlementSchedule.DeepClone().(*dsdefinition.Definition),
 		DataSourceSpecBinding:               p.DataSourceSpecBinding.DeepClone(),
 		InternalCompositePrice:              p.InternalCompositePrice.DeepClone(),
+		IndexBasket:                         ibc,
 	}
 }
 
@@ -760,3 +797,39 @@ func UpdateMarketConfigurationLogNormalFromProto(p *vegapb.UpdateMarketConfigura
 		},
 	}
 }
+
+type UpdateMarketConfigurationHistoricalSimulation struct {
+	HistoricalSimulation *HistoricalSimulationRiskModel
+}
+
+func (n UpdateMarketConfigurationHistoricalSimulation) String() string {
+	return fmt.Sprintf(
+		"historicalSimulation(%s)",
+		stringer.PtrToString(n.HistoricalSimulation),
+	)
+}
+
+func (n UpdateMarketConfigurationHistoricalSimulation) updateRiskParamsIntoProto() interface{} {
+	return n.IntoProto()
+}
+
+func (n UpdateMarketConfigurationHistoricalSimulation) DeepClone() updateRiskParams {
+	if n.HistoricalSimulation == nil {
+		return &UpdateMarketConfigurationHistoricalSimulation{}
+	}
+	return &UpdateMarketConfigurationHistoricalSimulation{
+		HistoricalSimulation: n.HistoricalSimulation.DeepClone(),
+	}
+}
+
+func (n UpdateMarketConfigurationHistoricalSimulation) IntoProto() *vegapb.UpdateMarketConfiguration_HistoricalSimulation {
+	return &vegapb.UpdateMarketConfiguration_HistoricalSimulation{
+		HistoricalSimulation: n.HistoricalSimulation.IntoProto(),
+	}
+}
+
+func UpdateMarketConfigurationHistoricalSimulationFromProto(p *vegapb.UpdateMarketConfiguration_HistoricalSimulation) *UpdateMarketConfigurationHistoricalSimulation {
+	return &UpdateMarketConfigurationHistoricalSimulation{
+		HistoricalSimulation: HistoricalSimulationRiskModelFromProto(p.HistoricalSimulation),
+	}
+}
@@ -131,11 +131,13 @@ type UpdateSpotMarketConfiguration struct {
 	Instrument                *InstrumentConfiguration
 	LiquidityFeeSettings      *LiquidityFeeSettings
 	EnableTxReordering        bool
+	FrequentBatchAuction      *FrequentBatchAuctionParameters
+	MatchingAlgorithm         MatchingAlgorithm
 }
 
 func (n UpdateSpotMarketConfiguration) String() string {
 	return fmt.Sprintf(
-		"metadata(%v) priceMonitoring(%s) targetStakeParameters(%s) risk(%s) slaParams(%s) tickSize(%s) enableTxReordering(%v)",
+		"metadata(%v) priceMonitoring(%s) targetStakeParameters(%s) risk(%s) slaParams(%s) tickSize(%s) enableTxReordering(%v) frequentBatchAuction(%s) matchingAlgorithm(%s)",
 		MetadataList(n.Metadata).String(),
 		stringer.PtrToString(n.PriceMonitoringParameters),
 		stringer.PtrToString(n.TargetStakeParameters),
@@ -143,6 +145,8 @@ func (n UpdateSpotMarketConfiguration) String() string {
 		stringer.PtrToString(n.SLAParams),
 		num.UintToString(n.TickSize),
 		n.EnableTxReordering,
+		stringer.PtrToString(n.FrequentBatchAuction),
+		n.MatchingAlgorithm.String(),
 	)
 }
 
@@ -155,7 +159,9 @@ func (n UpdateSpotMarketConfiguration) DeepClone() *UpdateSpotMarketConfiguratio
 			Code: n.Instrument.Code,
 			Name: n.Instrument.Name,
 		},
-		EnableTxReordering: n.EnableTxReordering,
+		EnableTxReordering:   n.EnableTxReordering,
+		FrequentBatchAuction: n.FrequentBatchAuction.DeepClone(),
+		MatchingAlgorithm:    n.MatchingAlgorithm,
 	}
 	cpy.Metadata = append(cpy.Metadata, n.Metadata...)
 	if n.PriceMonitoringParameters != nil {
@@ -202,6 +208,8 @@ func (n UpdateSpotMarketConfiguration) IntoProto() *vegapb.UpdateSpotMarketConfi
 		},
 		LiquidityFeeSettings:        liquidityFeeSettings,
 		EnableTransactionReordering: n.EnableTxReordering,
+		FrequentBatchAuction:        n.FrequentBatchAuction.IntoProto(),
+		MatchingAlgorithm:           n.MatchingAlgorithm,
 	}
 	switch rp := riskParams.(type) {
 	case *vegapb.UpdateSpotMarketConfiguration_Simple:
@@ -239,7 +247,9 @@ func UpdateSpotMarketConfigurationFromProto(p *vegapb.UpdateSpotMarketConfigurat
 			Name: p.Instrument.Name,
 			Code: p.Instrument.Code,
 		},
-		EnableTxReordering: p.EnableTransactionReordering,
+		EnableTxReordering:   p.EnableTransactionReordering,
+		FrequentBatchAuction: FrequentBatchAuctionParametersFromProto(p.FrequentBatchAuction),
+		MatchingAlgorithm:    p.MatchingAlgorithm,
 	}
 	if p.RiskParameters != nil {
 		switch rp := p.RiskParameters.(type) {
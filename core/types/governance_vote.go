@@ -17,6 +17,7 @@ package types
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 
 	"code.vegaprotocol.io/vega/libs/num"
@@ -40,12 +41,15 @@ type VoteSubmission struct {
 	ProposalID string
 	// The actual value of the vote
 	Value VoteValue
+	// The indices of the options voted for, if the proposal is a poll.
+	Options []uint32
 }
 
 func NewVoteSubmissionFromProto(p *commandspb.VoteSubmission) *VoteSubmission {
 	return &VoteSubmission{
 		ProposalID: p.ProposalId,
 		Value:      p.Value,
+		Options:    slices.Clone(p.Options),
 	}
 }
 
@@ -53,14 +57,16 @@ func (v VoteSubmission) IntoProto() *commandspb.VoteSubmission {
 	return &commandspb.VoteSubmission{
 		ProposalId: v.ProposalID,
 		Value:      v.Value,
+		Options:    slices.Clone(v.Options),
 	}
 }
 
 func (v VoteSubmission) String() string {
 	return fmt.Sprintf(
-		"proposalID(%s) value(%s)",
+		"proposalID(%s) value(%s) options(%v)",
 		v.ProposalID,
 		v.Value.String(),
+		v.Options,
 	)
 }
 
@@ -85,6 +91,16 @@ type Vote struct {
 	// total number of equity-like share on the market.
 	TotalEquityLikeShareWeight     num.Decimal
 	PerMarketEquityLikeShareWeight map[string]num.Decimal
+	// DelegatedGovernanceTokenBalance is the total number of tokens delegated
+	// to the voter by parties that didn't vote themselves. It is nil if no
+	// voting weight has been delegated to the voter.
+	DelegatedGovernanceTokenBalance *num.Uint
+	// DelegatedEquityLikeShareWeight is the equity-like share delegated to the
+	// voter by parties that didn't vote themselves.
+	DelegatedEquityLikeShareWeight num.Decimal
+	// Options are the indices of the options voted for, if the proposal is a
+	// poll. For ranked-choice polls, they are in order of preference.
+	Options []uint32
 }
 
 func (v Vote) IntoProto() *vegapb.Vote {
@@ -101,7 +117,7 @@ func (v Vote) IntoProto() *vegapb.Vote {
 			return ELSMap[i].MarketId > ELSMap[j].MarketId
 		})
 	}
-	return &vegapb.Vote{
+	vote := &vegapb.Vote{
 		PartyId:                     v.PartyID,
 		Value:                       v.Value,
 		ProposalId:                  v.ProposalID,
@@ -110,7 +126,13 @@ func (v Vote) IntoProto() *vegapb.Vote {
 		TotalGovernanceTokenWeight:  v.TotalGovernanceTokenWeight.String(),
 		TotalEquityLikeShareWeight:  v.TotalEquityLikeShareWeight.String(),
 		ElsPerMarket:                ELSMap,
+		Options:                     slices.Clone(v.Options),
 	}
+	if v.DelegatedGovernanceTokenBalance != nil || !v.DelegatedEquityLikeShareWeight.IsZero() {
+		vote.DelegatedGovernanceTokenBalance = num.UintToString(v.DelegatedGovernanceTokenBalance)
+		vote.DelegatedEquityLikeShareWeight = v.DelegatedEquityLikeShareWeight.String()
+	}
+	return vote
 }
 
 func VoteFromProto(v *vegapb.Vote) (*Vote, error) {
@@ -119,6 +141,7 @@ func VoteFromProto(v *vegapb.Vote) (*Vote, error) {
 		Value:      v.Value,
 		ProposalID: v.ProposalId,
 		Timestamp:  v.Timestamp,
+		Options:    slices.Clone(v.Options),
 	}
 	if len(v.TotalGovernanceTokenBalance) > 0 {
 		ret.TotalGovernanceTokenBalance, _ = num.UintFromString(v.TotalGovernanceTokenBalance, 10)
@@ -133,6 +156,12 @@ func VoteFromProto(v *vegapb.Vote) (*Vote, error) {
 	if len(v.TotalEquityLikeShareWeight) > 0 {
 		ret.TotalEquityLikeShareWeight, _ = num.DecimalFromString(v.TotalEquityLikeShareWeight)
 	}
+	if len(v.DelegatedGovernanceTokenBalance) > 0 {
+		ret.DelegatedGovernanceTokenBalance, _ = num.UintFromString(v.DelegatedGovernanceTokenBalance, 10)
+	}
+	if len(v.DelegatedEquityLikeShareWeight) > 0 {
+		ret.DelegatedEquityLikeShareWeight, _ = num.DecimalFromString(v.DelegatedEquityLikeShareWeight)
+	}
 	if len(v.ElsPerMarket) > 0 {
 		els := make(map[string]num.Decimal, len(v.ElsPerMarket))
 		for _, pair := range v.ElsPerMarket {
@@ -0,0 +1,146 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/datasource"
+	dsdefinition "code.vegaprotocol.io/vega/core/datasource/definition"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/stringer"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+)
+
+// IndexBasketConfiguration describes a basket of price sources whose weighted value is
+// used as the underlying index of a product.
+type IndexBasketConfiguration struct {
+	Constituents      []*IndexBasketConstituent
+	RebalanceInterval time.Duration
+}
+
+type IndexBasketConstituent struct {
+	DataSource         *datasource.Spec
+	Binding            *datasource.SpecBindingForCompositePrice
+	Weight             num.Decimal
+	StalenessTolerance time.Duration
+}
+
+func IndexBasketConfigurationFromProto(ibc *vegapb.IndexBasketConfiguration) *IndexBasketConfiguration {
+	if ibc == nil {
+		return nil
+	}
+	constituents := make([]*IndexBasketConstituent, 0, len(ibc.Constituents))
+	for _, c := range ibc.Constituents {
+		specDef, err := dsdefinition.FromProto(c.DataSourceSpec, nil)
+		if err != nil {
+			return nil
+		}
+		weight, _ := num.DecimalFromString(c.Weight)
+		var tolerance time.Duration
+		if len(c.StalenessTolerance) > 0 {
+			tolerance, _ = time.ParseDuration(c.StalenessTolerance)
+		}
+		var binding *datasource.SpecBindingForCompositePrice
+		if c.DataSourceSpecBinding != nil {
+			binding = datasource.SpecBindingForCompositePriceFromProto(c.DataSourceSpecBinding)
+		}
+		constituents = append(constituents, &IndexBasketConstituent{
+			DataSource:         datasource.SpecFromDefinition(*dsdefinition.NewWith(specDef)),
+			Binding:            binding,
+			Weight:             weight,
+			StalenessTolerance: tolerance,
+		})
+	}
+	return &IndexBasketConfiguration{
+		Constituents:      constituents,
+		RebalanceInterval: time.Duration(ibc.RebalanceInterval) * time.Second,
+	}
+}
+
+func (ibc *IndexBasketConfiguration) IntoProto() *vegapb.IndexBasketConfiguration {
+	if ibc == nil {
+		return nil
+	}
+	constituents := make([]*vegapb.IndexBasketConstituent, 0, len(ibc.Constituents))
+	for _, c := range ibc.Constituents {
+		var tolerance string
+		if c.StalenessTolerance > 0 {
+			tolerance = c.StalenessTolerance.String()
+		}
+		var binding *vegapb.SpecBindingForCompositePrice
+		if c.Binding != nil {
+			binding = c.Binding.IntoProto()
+		}
+		constituents = append(constituents, &vegapb.IndexBasketConstituent{
+			DataSourceSpec:        c.DataSource.Data.IntoProto(),
+			DataSourceSpecBinding: binding,
+			Weight:                c.Weight.String(),
+			StalenessTolerance:    tolerance,
+		})
+	}
+	return &vegapb.IndexBasketConfiguration{
+		Constituents:      constituents,
+		RebalanceInterval: int64(ibc.RebalanceInterval / time.Second),
+	}
+}
+
+func (ibc *IndexBasketConfiguration) DeepClone() *IndexBasketConfiguration {
+	constituents := make([]*IndexBasketConstituent, 0, len(ibc.Constituents))
+	for _, c := range ibc.Constituents {
+		definition := c.DataSource.GetDefinition()
+		definition = *definition.DeepClone().(*dsdefinition.Definition)
+		spec := &datasource.Spec{}
+		var binding *datasource.SpecBindingForCompositePrice
+		if c.Binding != nil {
+			binding = c.Binding.DeepClone()
+		}
+		constituents = append(constituents, &IndexBasketConstituent{
+			DataSource:         spec.FromDefinition(&definition),
+			Binding:            binding,
+			Weight:             c.Weight,
+			StalenessTolerance: c.StalenessTolerance,
+		})
+	}
+	return &IndexBasketConfiguration{
+		Constituents:      constituents,
+		RebalanceInterval: ibc.RebalanceInterval,
+	}
+}
+
+func (ibc *IndexBasketConfiguration) String() string {
+	constituents := "["
+	for _, c := range ibc.Constituents {
+		constituents += c.String() + ","
+	}
+	constituents += "]"
+	return fmt.Sprintf(
+		"constituents(%s) rebalanceInterval(%s)",
+		constituents,
+		ibc.RebalanceInterval.String(),
+	)
+}
+
+func (c *IndexBasketConstituent) String() string {
+	return fmt.Sprintf(
+		"dataSource(%s) binding(%s) weight(%s) stalenessTolerance(%s)",
+		stringer.PtrToString(c.DataSource),
+		stringer.PtrToString(c.Binding),
+		c.Weight.String(),
+		c.StalenessTolerance.String(),
+	)
+}
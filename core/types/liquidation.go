@@ -29,6 +29,12 @@ type LiquidationStrategy struct {
 	FullDisposalSize    uint64
 	MaxFractionConsumed num.Decimal
 	DisposalSlippage    num.Decimal // this has to be a pointer for the time being, with the need to default to 0.1
+	// PartialCloseOutStep is the fraction of a distressed position closed out per step, zero means full close-outs.
+	PartialCloseOutStep num.Decimal
+	// PartialCloseOutBuffer is the margin a partially closed out party must cover on top of its maintenance margin, as a fraction of it.
+	PartialCloseOutBuffer num.Decimal
+	// AutoDeleveraging reduces the profitable positions opposite the network's position once the insurance pool is depleted.
+	AutoDeleveraging bool
 }
 
 type LiquidationNode struct {
@@ -121,29 +127,58 @@ func LiquidationStrategyFromProto(p *vegapb.LiquidationStrategy) (*LiquidationSt
 	if err != nil {
 		return nil, err
 	}
+	step, buffer := num.DecimalZero(), num.DecimalZero()
+	if p.PartialCloseOutStep != "" {
+		if step, err = num.DecimalFromString(p.PartialCloseOutStep); err != nil {
+			return nil, err
+		}
+	}
+	if p.PartialCloseOutBuffer != "" {
+		if buffer, err = num.DecimalFromString(p.PartialCloseOutBuffer); err != nil {
+			return nil, err
+		}
+	}
 	return &LiquidationStrategy{
-		DisposalTimeStep:    time.Second * time.Duration(p.DisposalTimeStep),
-		DisposalFraction:    df,
-		FullDisposalSize:    p.FullDisposalSize,
-		MaxFractionConsumed: mfc,
-		DisposalSlippage:    slippage,
+		DisposalTimeStep:      time.Second * time.Duration(p.DisposalTimeStep),
+		DisposalFraction:      df,
+		FullDisposalSize:      p.FullDisposalSize,
+		MaxFractionConsumed:   mfc,
+		DisposalSlippage:      slippage,
+		PartialCloseOutStep:   step,
+		PartialCloseOutBuffer: buffer,
+		AutoDeleveraging:      p.AutoDeleveraging,
 	}, nil
 }
 
 func (l *LiquidationStrategy) IntoProto() *vegapb.LiquidationStrategy {
-	slip := ""
+	slip, step, buffer := "", "", ""
 	if !l.DisposalSlippage.IsZero() {
 		slip = l.DisposalSlippage.String()
 	}
+	if !l.PartialCloseOutStep.IsZero() {
+		step = l.PartialCloseOutStep.String()
+	}
+	if !l.PartialCloseOutBuffer.IsZero() {
+		buffer = l.PartialCloseOutBuffer.String()
+	}
 	return &vegapb.LiquidationStrategy{
 		DisposalTimeStep:      int64(l.DisposalTimeStep / time.Second),
 		DisposalFraction:      l.DisposalFraction.String(),
 		FullDisposalSize:      l.FullDisposalSize,
 		MaxFractionConsumed:   l.MaxFractionConsumed.String(),
 		DisposalSlippageRange: slip,
+		PartialCloseOutStep:   step,
+		PartialCloseOutBuffer: buffer,
+		AutoDeleveraging:      l.AutoDeleveraging,
 	}
 }
 
+// PartialCloseOut returns true if distressed parties are only closed out as much as needed to cover the maintenance
+// margin of the rest of their position.
+func (l *LiquidationStrategy) PartialCloseOut() bool {
+	return l.PartialCloseOutStep.IsPositive()
+}
+
 func (l *LiquidationStrategy) DeepClone() *LiquidationStrategy {
 	cpy := *l
 	return &cpy
@@ -161,5 +196,7 @@ func (l *LiquidationStrategy) EQ(l2 *LiquidationStrategy) bool {
 	// but just in case we end up switching the decimal types out
 	// return *l == *l2
 	return l.DisposalTimeStep == l2.DisposalTimeStep && l.FullDisposalSize == l2.FullDisposalSize &&
-		l.DisposalFraction.Equals(l2.DisposalFraction) && l.MaxFractionConsumed.Equals(l2.MaxFractionConsumed)
+		l.DisposalFraction.Equals(l2.DisposalFraction) && l.MaxFractionConsumed.Equals(l2.MaxFractionConsumed) &&
+		l.PartialCloseOutStep.Equals(l2.PartialCloseOutStep) && l.PartialCloseOutBuffer.Equals(l2.PartialCloseOutBuffer) &&
+		l.AutoDeleveraging == l2.AutoDeleveraging
 }
@@ -20,6 +20,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -193,6 +194,8 @@ const (
 	AuctionTriggerUnableToDeployLPOrders AuctionTrigger = vegapb.AuctionTrigger_AUCTION_TRIGGER_UNABLE_TO_DEPLOY_LP_ORDERS
 	// AuctionTriggerLongBlock for market suspension due to a long block.
 	AuctionTriggerLongBlock AuctionTrigger = vegapb.AuctionTrigger_AUCTION_TRIGGER_LONG_BLOCK
+	// AuctionTriggerIndexDivergence for price monitoring due to the traded price diverging from the index price.
+	AuctionTriggerIndexDivergence AuctionTrigger = vegapb.AuctionTrigger_AUCTION_TRIGGER_INDEX_DIVERGENCE
 )
 
 type InstrumentMetadata struct {
@@ -264,11 +267,115 @@ func (a AuctionDuration) DeepClone() *AuctionDuration {
 	}
 }
 
+// FrequentBatchAuctionParameters holds the configuration of markets trading in frequent batch auctions.
+type FrequentBatchAuctionParameters struct {
+	// BatchDuration is the duration of each batch auction in seconds.
+	BatchDuration int64
+}
+
+func FrequentBatchAuctionParametersFromProto(p *vegapb.FrequentBatchAuctionParameters) *FrequentBatchAuctionParameters {
+	if p == nil {
+		return nil
+	}
+	return &FrequentBatchAuctionParameters{
+		BatchDuration: p.BatchDuration,
+	}
+}
+
+func (f *FrequentBatchAuctionParameters) IntoProto() *vegapb.FrequentBatchAuctionParameters {
+	if f == nil {
+		return nil
+	}
+	return &vegapb.FrequentBatchAuctionParameters{
+		BatchDuration: f.BatchDuration,
+	}
+}
+
+func (f FrequentBatchAuctionParameters) String() string {
+	return fmt.Sprintf(
+		"batchDuration(%v)",
+		f.BatchDuration,
+	)
+}
+
+func (f *FrequentBatchAuctionParameters) DeepClone() *FrequentBatchAuctionParameters {
+	if f == nil {
+		return nil
+	}
+	return &FrequentBatchAuctionParameters{
+		BatchDuration: f.BatchDuration,
+	}
+}
+
+// PositionLimits holds the limits on the positions held in a market, a limit of zero is not enforced.
+type PositionLimits struct {
+	// MaxPositionSize is the maximum absolute size of the position of a single party.
+	MaxPositionSize uint64
+	// MaxPositionNotional is the maximum notional value of the position of a single party.
+	MaxPositionNotional *num.Uint
+	// MaxOpenInterest is the maximum total open interest of the market.
+	MaxOpenInterest uint64
+}
+
+func PositionLimitsFromProto(p *vegapb.PositionLimits) *PositionLimits {
+	if p == nil {
+		return nil
+	}
+	var maxNotional *num.Uint
+	if len(p.MaxPositionNotional) > 0 {
+		maxNotional, _ = num.UintFromString(p.MaxPositionNotional, 10)
+	}
+	return &PositionLimits{
+		MaxPositionSize:     p.MaxPositionSize,
+		MaxPositionNotional: maxNotional,
+		MaxOpenInterest:     p.MaxOpenInterest,
+	}
+}
+
+func (p *PositionLimits) IntoProto() *vegapb.PositionLimits {
+	if p == nil {
+		return nil
+	}
+	var maxNotional string
+	if p.MaxPositionNotional != nil {
+		maxNotional = p.MaxPositionNotional.String()
+	}
+	return &vegapb.PositionLimits{
+		MaxPositionSize:     p.MaxPositionSize,
+		MaxPositionNotional: maxNotional,
+		MaxOpenInterest:     p.MaxOpenInterest,
+	}
+}
+
+func (p PositionLimits) String() string {
+	return fmt.Sprintf(
+		"maxPositionSize(%v) maxPositionNotional(%s) maxOpenInterest(%v)",
+		p.MaxPositionSize,
+		num.UintToString(p.MaxPositionNotional),
+		p.MaxOpenInterest,
+	)
+}
+
+func (p *PositionLimits) DeepClone() *PositionLimits {
+	if p == nil {
+		return nil
+	}
+	cpy := &PositionLimits{
+		MaxPositionSize: p.MaxPositionSize,
+		MaxOpenInterest: p.MaxOpenInterest,
+	}
+	if p.MaxPositionNotional != nil {
+		cpy.MaxPositionNotional = p.MaxPositionNotional.Clone()
+	}
+	return cpy
+}
+
 type rmType int
 
 const (
 	SimpleRiskModelType rmType = iota
 	LogNormalRiskModelType
+	HistoricalSimulationRiskModelType
 )
 
 type TradableInstrument struct {
@@ -323,6 +430,8 @@ func (t TradableInstrument) IntoProto() *vegapb.TradableInstrument {
 		r.RiskModel = rm
 	case *vegapb.TradableInstrument_LogNormalRiskModel:
 		r.RiskModel = rm
+	case *vegapb.TradableInstrument_HistoricalSimulationRiskModel:
+		r.RiskModel = rm
 	}
 	return r
 }
@@ -349,6 +458,17 @@ func (t TradableInstrument) GetLogNormalRiskModel() *LogNormalRiskModel {
 	return nil
 }
 
+func (t TradableInstrument) GetHistoricalSimulationRiskModel() *HistoricalSimulationRiskModel {
+	if t.rmt == HistoricalSimulationRiskModelType {
+		hrm, ok := t.RiskModel.(*TradableInstrumentHistoricalSimulationRiskModel)
+		if !ok || hrm == nil {
+			return nil
+		}
+		return hrm.HistoricalSimulationRiskModel
+	}
+	return nil
+}
+
 func (t TradableInstrument) String() string {
 	return fmt.Sprintf(
 		"instrument(%s) marginCalculator(%s) riskModel(%s)",
@@ -435,6 +555,7 @@ type Future struct {
 	DataSourceSpecForTradingTermination *datasource.Spec
 	DataSourceSpecBinding               *datasource.SpecBindingForFuture
 	Cap                                 *FutureCap
+	PredictionOutcome                   *PredictionOutcome
 }
 
 func FutureFromProto(f *vegapb.Future) *Future {
@@ -446,6 +567,7 @@ func FutureFromProto(f *vegapb.Future) *Future {
 		DataSourceSpecForTradingTermination: datasource.SpecFromProto(f.DataSourceSpecForTradingTermination),
 		DataSourceSpecBinding:               datasource.SpecBindingForFutureFromProto(f.DataSourceSpecBinding),
 		Cap:                                 fCap,
+		PredictionOutcome:                   PredictionOutcomeFromProto(f.PredictionOutcome),
 	}
 }
 
@@ -454,6 +576,10 @@ func (f Future) IntoProto() *vegapb.Future {
 	if f.Cap != nil {
 		fCap = f.Cap.IntoProto()
 	}
+	var outcome *vegapb.PredictionOutcome
+	if f.PredictionOutcome != nil {
+		outcome = f.PredictionOutcome.IntoProto()
+	}
 	return &vegapb.Future{
 		SettlementAsset:                     f.SettlementAsset,
 		QuoteName:                           f.QuoteName,
@@ -461,6 +587,7 @@ func (f Future) IntoProto() *vegapb.Future {
 		DataSourceSpecForTradingTermination: f.DataSourceSpecForTradingTermination.IntoProto(),
 		DataSourceSpecBinding:               f.DataSourceSpecBinding.IntoProto(),
 		Cap:                                 fCap,
+		PredictionOutcome:                   outcome,
 	}
 }
 
@@ -470,16 +597,64 @@ func (f Future) String() string {
 		fCap = f.Cap.String()
 	}
 	return fmt.Sprintf(
-		"quoteName(%s) settlementAsset(%s) dataSourceSpec(settlementData(%s) tradingTermination(%s) binding(%s)) capped(%s)",
+		"quoteName(%s) settlementAsset(%s) dataSourceSpec(settlementData(%s) tradingTermination(%s) binding(%s)) capped(%s) predictionOutcome(%s)",
 		f.QuoteName,
 		f.SettlementAsset,
 		stringer.PtrToString(f.DataSourceSpecForSettlementData),
 		stringer.PtrToString(f.DataSourceSpecForTradingTermination),
 		stringer.PtrToString(f.DataSourceSpecBinding),
 		fCap,
+		stringer.PtrToString(f.PredictionOutcome),
 	)
 }
 
+// PredictionOutcome identifies the outcome a future settles on when it is one of the outcome contracts of a prediction market.
+// The prediction ID is left empty on the market a prediction market proposal creates, until it is split into one market per outcome.
+type PredictionOutcome struct {
+	PredictionID string
+	Index        uint32
+	Outcomes     []string
+}
+
+func PredictionOutcomeFromProto(p *vegapb.PredictionOutcome) *PredictionOutcome {
+	if p == nil {
+		return nil
+	}
+	return &PredictionOutcome{
+		PredictionID: p.PredictionId,
+		Index:        p.Index,
+		Outcomes:     slices.Clone(p.Outcomes),
+	}
+}
+
+func (p PredictionOutcome) IntoProto() *vegapb.PredictionOutcome {
+	return &vegapb.PredictionOutcome{
+		PredictionId: p.PredictionID,
+		Index:        p.Index,
+		Outcomes:     slices.Clone(p.Outcomes),
+	}
+}
+
+func (p PredictionOutcome) DeepClone() *PredictionOutcome {
+	return &PredictionOutcome{
+		PredictionID: p.PredictionID,
+		Index:        p.Index,
+		Outcomes:     slices.Clone(p.Outcomes),
+	}
+}
+
+// Name returns the name of the outcome the future settles on.
+func (p PredictionOutcome) Name() string {
+	if int(p.Index) >= len(p.Outcomes) {
+		return ""
+	}
+	return p.Outcomes[p.Index]
+}
+
+func (p PredictionOutcome) String() string {
+	return fmt.Sprintf("predictionID(%s) index(%d) outcomes(%v)", p.PredictionID, p.Index, p.Outcomes)
+}
+
 type InstrumentPerps struct {
 	Perps *Perps
 }
@@ -514,6 +689,7 @@ type Perps struct {
 	DataSourceSpecBinding               *datasource.SpecBindingForPerps
 
 	InternalCompositePriceConfig *CompositePriceConfiguration
+	IndexBasketConfig            *IndexBasketConfiguration
 }
 
 func PerpsFromProto(p *vegapb.Perpetual) *Perps {
@@ -537,6 +713,11 @@ func PerpsFromProto(p *vegapb.Perpetual) *Perps {
 		internalCompositePriceConfig = CompositePriceConfigurationFromProto(p.InternalCompositePriceConfig)
 	}
 
+	var indexBasketConfig *IndexBasketConfiguration
+	if p.IndexBasketConfig != nil {
+		indexBasketConfig = IndexBasketConfigurationFromProto(p.IndexBasketConfig)
+	}
+
 	return &Perps{
 		SettlementAsset:                     p.SettlementAsset,
 		QuoteName:                           p.QuoteName,
@@ -551,6 +732,7 @@ func PerpsFromProto(p *vegapb.Perpetual) *Perps {
 		DataSourceSpecForSettlementSchedule: datasource.SpecFromProto(p.DataSourceSpecForSettlementSchedule),
 		DataSourceSpecBinding:               datasource.SpecBindingForPerpsFromProto(p.DataSourceSpecBinding),
 		InternalCompositePriceConfig:        internalCompositePriceConfig,
+		IndexBasketConfig:                   indexBasketConfig,
 	}
 }
 
@@ -575,6 +757,11 @@ func (p Perps) IntoProto() *vegapb.Perpetual {
 		internalCompositePriceConfig = p.InternalCompositePriceConfig.IntoProto()
 	}
 
+	var indexBasketConfig *vega.IndexBasketConfiguration
+	if p.IndexBasketConfig != nil {
+		indexBasketConfig = p.IndexBasketConfig.IntoProto()
+	}
+
 	return &vegapb.Perpetual{
 		SettlementAsset:                     p.SettlementAsset,
 		QuoteName:                           p.QuoteName,
@@ -589,12 +776,13 @@ func (p Perps) IntoProto() *vegapb.Perpetual {
 		DataSourceSpecForSettlementSchedule: p.DataSourceSpecForSettlementSchedule.IntoProto(),
 		DataSourceSpecBinding:               p.DataSourceSpecBinding.IntoProto(),
 		InternalCompositePriceConfig:        internalCompositePriceConfig,
+		IndexBasketConfig:                   indexBasketConfig,
 	}
 }
 
 func (p Perps) String() string {
 	return fmt.Sprintf(
-		"quoteName(%s) settlementAsset(%s) marginFundingFactore(%s) interestRate(%s) clampLowerBound(%s) clampUpperBound(%s) settlementData(%s) tradingTermination(%s) binding(%s), internalCompositePriceConfig(%s)",
+		"quoteName(%s) settlementAsset(%s) marginFundingFactore(%s) interestRate(%s) clampLowerBound(%s) clampUpperBound(%s) settlementData(%s) tradingTermination(%s) binding(%s), internalCompositePriceConfig(%s), indexBasketConfig(%s)",
 		p.QuoteName,
 		p.SettlementAsset,
 		p.MarginFundingFactor.String(),
@@ -605,6 +793,85 @@ func (p Perps) String() string {
 		stringer.PtrToString(p.DataSourceSpecForSettlementSchedule),
 		stringer.PtrToString(p.DataSourceSpecBinding),
 		stringer.PtrToString(p.InternalCompositePriceConfig),
+		stringer.PtrToString(p.IndexBasketConfig),
+	)
+}
+
+type InstrumentOption struct {
+	Option *Option
+}
+
+func (InstrumentOption) Type() ProductType {
+	return ProductTypeOption
+}
+
+func (i InstrumentOption) String() string {
+	return fmt.Sprintf(
+		"option(%s)",
+		stringer.PtrToString(i.Option),
+	)
+}
+
+type OptionType = vegapb.OptionType
+
+const (
+	// OptionTypeUnspecified Default value, always invalid.
+	OptionTypeUnspecified OptionType = vegapb.OptionType_OPTION_TYPE_UNSPECIFIED
+	// OptionTypeCall pays out the amount by which the settlement price is above the strike price.
+	OptionTypeCall OptionType = vegapb.OptionType_OPTION_TYPE_CALL
+	// OptionTypePut pays out the amount by which the settlement price is below the strike price.
+	OptionTypePut OptionType = vegapb.OptionType_OPTION_TYPE_PUT
+)
+
+type Option struct {
+	SettlementAsset string
+	QuoteName       string
+	OptionType      OptionType
+	// StrikePrice is expressed in the same decimal places as the settlement data.
+	StrikePrice                         num.Decimal
+	ExpiryTimestamp                     int64
+	DataSourceSpecForSettlementData     *datasource.Spec
+	DataSourceSpecForTradingTermination *datasource.Spec
+	DataSourceSpecBinding               *datasource.SpecBindingForFuture
+}
+
+func OptionFromProto(o *vegapb.Option) *Option {
+	return &Option{
+		SettlementAsset:                     o.SettlementAsset,
+		QuoteName:                           o.QuoteName,
+		OptionType:                          o.OptionType,
+		StrikePrice:                         num.MustDecimalFromString(o.StrikePrice),
+		ExpiryTimestamp:                     o.ExpiryTimestamp,
+		DataSourceSpecForSettlementData:     datasource.SpecFromProto(o.DataSourceSpecForSettlementData),
+		DataSourceSpecForTradingTermination: datasource.SpecFromProto(o.DataSourceSpecForTradingTermination),
+		DataSourceSpecBinding:               datasource.SpecBindingForFutureFromProto(o.DataSourceSpecBinding),
+	}
+}
+
+func (o Option) IntoProto() *vegapb.Option {
+	return &vegapb.Option{
+		SettlementAsset:                     o.SettlementAsset,
+		QuoteName:                           o.QuoteName,
+		OptionType:                          o.OptionType,
+		StrikePrice:                         o.StrikePrice.String(),
+		ExpiryTimestamp:                     o.ExpiryTimestamp,
+		DataSourceSpecForSettlementData:     o.DataSourceSpecForSettlementData.IntoProto(),
+		DataSourceSpecForTradingTermination: o.DataSourceSpecForTradingTermination.IntoProto(),
+		DataSourceSpecBinding:               o.DataSourceSpecBinding.IntoProto(),
+	}
+}
+
+func (o Option) String() string {
+	return fmt.Sprintf(
+		"quoteName(%s) settlementAsset(%s) optionType(%s) strikePrice(%s) expiry(%d) dataSourceSpec(settlementData(%s) tradingTermination(%s) binding(%s))",
+		o.QuoteName,
+		o.SettlementAsset,
+		o.OptionType.String(),
+		o.StrikePrice.String(),
+		o.ExpiryTimestamp,
+		stringer.PtrToString(o.DataSourceSpecForSettlementData),
+		stringer.PtrToString(o.DataSourceSpecForTradingTermination),
+		stringer.PtrToString(o.DataSourceSpecBinding),
 	)
 }
 
@@ -622,6 +889,10 @@ func iInstrumentFromProto(pi interface{}) iProto {
 		return InstrumentSpotFromProto(&i)
 	case *vegapb.Instrument_Spot:
 		return InstrumentSpotFromProto(i)
+	case vegapb.Instrument_Option:
+		return InstrumentOptionFromProto(&i)
+	case *vegapb.Instrument_Option:
+		return InstrumentOptionFromProto(i)
 	}
 	return nil
 }
@@ -689,6 +960,25 @@ func (i InstrumentPerps) getAssets() ([]string, error) {
 	return []string{i.Perps.SettlementAsset}, nil
 }
 
+func InstrumentOptionFromProto(o *vegapb.Instrument_Option) *InstrumentOption {
+	return &InstrumentOption{
+		Option: OptionFromProto(o.Option),
+	}
+}
+
+func (i InstrumentOption) IntoProto() *vegapb.Instrument_Option {
+	return &vegapb.Instrument_Option{
+		Option: i.Option.IntoProto(),
+	}
+}
+
+func (i InstrumentOption) getAssets() ([]string, error) {
+	if i.Option == nil {
+		return []string{}, ErrUnknownAsset
+	}
+	return []string{i.Option.SettlementAsset}, nil
+}
+
 func (m *Market) GetAssets() ([]string, error) {
 	if m.TradableInstrument == nil {
 		return []string{}, ErrNilTradableInstrument
@@ -715,6 +1005,14 @@ func (m *Market) GetFuture() *InstrumentFuture {
 	return nil
 }
 
+// GetPredictionOutcome returns the outcome the market settles on if it is one of the outcome contracts of a prediction market.
+func (m *Market) GetPredictionOutcome() *PredictionOutcome {
+	if f := m.GetFuture(); f != nil && f.Future != nil {
+		return f.Future.PredictionOutcome
+	}
+	return nil
+}
+
 func (m *Market) GetPerps() *InstrumentPerps {
 	if m.ProductType() == ProductTypePerps {
 		p, _ := m.TradableInstrument.Instrument.Product.(*InstrumentPerps)
@@ -723,6 +1021,14 @@ func (m *Market) GetPerps() *InstrumentPerps {
 	return nil
 }
 
+func (m *Market) GetOption() *InstrumentOption {
+	if m.ProductType() == ProductTypeOption {
+		o, _ := m.TradableInstrument.Instrument.Product.(*InstrumentOption)
+		return o
+	}
+	return nil
+}
+
 func (m *Market) GetSpot() *InstrumentSpot {
 	if m.ProductType() == ProductTypeSpot {
 		s, _ := m.TradableInstrument.Instrument.Product.(*InstrumentSpot)
@@ -748,6 +1054,12 @@ func (i InstrumentPerps) iIntoProto() interface{} {
 
 func (_ InstrumentPerps) Cap() *FutureCap { return nil }
 
+func (i InstrumentOption) iIntoProto() interface{} {
+	return i.IntoProto()
+}
+
+func (_ InstrumentOption) Cap() *FutureCap { return nil }
+
 type iProto interface {
 	iIntoProto() interface{}
 	getAssets() ([]string, error)
@@ -765,6 +1077,7 @@ type Instrument struct {
 	//	*InstrumentFuture
 	//	*InstrumentSpot
 	//  *InstrumentPerps
+	//  *InstrumentOption
 	Product iProto
 }
 
@@ -808,6 +1121,15 @@ func (i Instrument) GetPerps() *Perps {
 	}
 }
 
+func (i Instrument) GetOption() *Option {
+	switch p := i.Product.(type) {
+	case *InstrumentOption:
+		return p.Option
+	default:
+		return nil
+	}
+}
+
 func (i Instrument) IntoProto() *vegapb.Instrument {
 	p := i.Product.iIntoProto()
 	r := &vegapb.Instrument{
@@ -823,6 +1145,8 @@ func (i Instrument) IntoProto() *vegapb.Instrument {
 		r.Product = pt
 	case *vegapb.Instrument_Spot:
 		r.Product = pt
+	case *vegapb.Instrument_Option:
+		r.Product = pt
 	}
 	return r
 }
@@ -1074,6 +1398,7 @@ const (
 	MarketTypeFuture
 	MarketTypeSpot
 	MarketTypePerp
+	MarketTypeOption
 )
 
 type Market struct {
@@ -1101,6 +1426,9 @@ type Market struct {
 	MarkPriceConfiguration *CompositePriceConfiguration
 	TickSize               *num.Uint
 	EnableTxReordering     bool
+	FrequentBatchAuction   *FrequentBatchAuctionParameters
+	PositionLimits         *PositionLimits
+	MatchingAlgorithm      MatchingAlgorithm
 }
 
 func MarketFromProto(mkt *vegapb.Market) (*Market, error) {
@@ -1168,6 +1496,9 @@ func MarketFromProto(mkt *vegapb.Market) (*Market, error) {
 		MarkPriceConfiguration:        markPriceConfiguration,
 		TickSize:                      tickSize,
 		EnableTxReordering:            mkt.EnableTransactionReordering,
+		FrequentBatchAuction:          FrequentBatchAuctionParametersFromProto(mkt.FrequentBatchAuction),
+		PositionLimits:                PositionLimitsFromProto(mkt.PositionLimits),
+		MatchingAlgorithm:             mkt.MatchingAlgorithm,
 	}
 
 	if mkt.LiquiditySlaParams != nil {
@@ -1241,6 +1572,9 @@ func (m Market) IntoProto() *vegapb.Market {
 		MarkPriceConfiguration:        m.MarkPriceConfiguration.IntoProto(),
 		TickSize:                      m.TickSize.String(),
 		EnableTransactionReordering:   m.EnableTxReordering,
+		FrequentBatchAuction:          m.FrequentBatchAuction.IntoProto(),
+		PositionLimits:                m.PositionLimits.IntoProto(),
+		MatchingAlgorithm:             m.MatchingAlgorithm,
 	}
 	return r
 }
@@ -1251,7 +1585,7 @@ func (m Market) GetID() string {
 
 func (m Market) String() string {
 	return fmt.Sprintf(
-		"ID(%s) tradableInstrument(%s) decimalPlaces(%v) positionDecimalPlaces(%v) fees(%s) openingAuction(%s) priceMonitoringSettings(%s) liquidityMonitoringParameters(%s) tradingMode(%s) state(%s) marketTimestamps(%s) tickSize(%s) enableTxReordering(%v)",
+		"ID(%s) tradableInstrument(%s) decimalPlaces(%v) positionDecimalPlaces(%v) fees(%s) openingAuction(%s) priceMonitoringSettings(%s) liquidityMonitoringParameters(%s) tradingMode(%s) state(%s) marketTimestamps(%s) tickSize(%s) enableTxReordering(%v) frequentBatchAuction(%s) positionLimits(%s) matchingAlgorithm(%s)",
 		m.ID,
 		stringer.PtrToString(m.TradableInstrument),
 		m.DecimalPlaces,
@@ -1265,6 +1599,9 @@ func (m Market) String() string {
 		stringer.PtrToString(m.MarketTimestamps),
 		num.UintToString(m.TickSize),
 		m.EnableTxReordering,
+		stringer.PtrToString(m.FrequentBatchAuction),
+		stringer.PtrToString(m.PositionLimits),
+		m.MatchingAlgorithm.String(),
 	)
 }
 
@@ -1278,6 +1615,9 @@ func (m Market) MarketType() MarketType {
 	if p := m.GetPerps(); p != nil {
 		return MarketTypePerp
 	}
+	if o := m.GetOption(); o != nil {
+		return MarketTypeOption
+	}
 
 	return MarketTypeUnspecified
 }
@@ -1295,6 +1635,9 @@ func (m Market) DeepClone() *Market {
 		InsurancePoolFraction:   m.InsurancePoolFraction,
 		TickSize:                m.TickSize.Clone(),
 		EnableTxReordering:      m.EnableTxReordering,
+		FrequentBatchAuction:    m.FrequentBatchAuction.DeepClone(),
+		PositionLimits:          m.PositionLimits.DeepClone(),
+		MatchingAlgorithm:       m.MatchingAlgorithm,
 	}
 
 	if m.LiquiditySLAParams != nil {
@@ -1352,6 +1695,19 @@ type MarketCounters struct {
 	OrderbookLevelCount uint64
 }
 
+type MatchingAlgorithm = vegapb.MatchingAlgorithm
+
+const (
+	// Default value, orders are matched in price-time priority.
+	MatchingAlgorithmUnspecified MatchingAlgorithm = vegapb.MatchingAlgorithm_MATCHING_ALGORITHM_UNSPECIFIED
+	// Orders at a price level are matched in the order they were placed.
+	MatchingAlgorithmPriceTime MatchingAlgorithm = vegapb.MatchingAlgorithm_MATCHING_ALGORITHM_PRICE_TIME
+	// Volume is shared between the orders at a price level in proportion to their size.
+	MatchingAlgorithmProRata MatchingAlgorithm = vegapb.MatchingAlgorithm_MATCHING_ALGORITHM_PRO_RATA
+	// The oldest order at a price level is filled first, the rest is shared in proportion to size.
+	MatchingAlgorithmProRataTopOfBook MatchingAlgorithm = vegapb.MatchingAlgorithm_MATCHING_ALGORITHM_PRO_RATA_TOP_OF_BOOK
+)
+
 type CompositePriceType = vegapb.CompositePriceType
 
 const (
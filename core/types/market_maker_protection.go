@@ -0,0 +1,78 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"errors"
+	"time"
+
+	"code.vegaprotocol.io/vega/libs/num"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+)
+
+var ErrInvalidMaxFilledNotional = errors.New("invalid max filled notional")
+
+type MarketMakerProtectionLimit = eventspb.MarketMakerProtectionTriggered_Limit
+
+const (
+	MarketMakerProtectionLimitUnspecified MarketMakerProtectionLimit = eventspb.MarketMakerProtectionTriggered_LIMIT_UNSPECIFIED
+	MarketMakerProtectionLimitVolume      MarketMakerProtectionLimit = eventspb.MarketMakerProtectionTriggered_LIMIT_VOLUME
+	MarketMakerProtectionLimitDelta       MarketMakerProtectionLimit = eventspb.MarketMakerProtectionTriggered_LIMIT_DELTA
+	MarketMakerProtectionLimitNotional    MarketMakerProtectionLimit = eventspb.MarketMakerProtectionTriggered_LIMIT_NOTIONAL
+)
+
+// MarketMakerProtection is a party's request to have all its orders in a market
+// cancelled when the amount it gets filled for within a rolling window exceeds
+// any of the configured limits. A protection with no limit set removes any
+// existing one.
+type MarketMakerProtection struct {
+	MarketID          string
+	Party             string
+	WindowDuration    time.Duration
+	FreezeDuration    time.Duration
+	MaxFilledVolume   *uint64
+	MaxFilledDelta    *uint64
+	MaxFilledNotional *num.Uint
+}
+
+func NewMarketMakerProtectionFromProto(
+	cmd *commandspb.UpdateMarketMakerProtection,
+	party string,
+) (*MarketMakerProtection, error) {
+	p := &MarketMakerProtection{
+		MarketID:        cmd.MarketId,
+		Party:           party,
+		WindowDuration:  time.Duration(cmd.WindowDuration) * time.Second,
+		FreezeDuration:  time.Duration(cmd.FreezeDuration) * time.Second,
+		MaxFilledVolume: cmd.MaxFilledVolume,
+		MaxFilledDelta:  cmd.MaxFilledDelta,
+	}
+	if cmd.MaxFilledNotional != nil {
+		notional, overflow := num.UintFromString(*cmd.MaxFilledNotional, 10)
+		if overflow {
+			return nil, ErrInvalidMaxFilledNotional
+		}
+		p.MaxFilledNotional = notional
+	}
+	return p, nil
+}
+
+// IsRemoval returns true if no limit is set, in which case the party's
+// protection in the market is removed.
+func (p *MarketMakerProtection) IsRemoval() bool {
+	return p.MaxFilledVolume == nil && p.MaxFilledDelta == nil && p.MaxFilledNotional == nil
+}
@@ -51,6 +51,14 @@ type Order struct {
 	extraRemaining   uint64
 	IcebergOrder     *IcebergOrder
 	GeneratedOffbook bool
+	// SelfTradePrevention is the mode applied when the order would trade with an order from the same party.
+	SelfTradePrevention OrderSelfTradePrevention
+	// StrategyID is set on the legs of a spread order and copied onto the trades they produce.
+	StrategyID string
+	// MaxSlippage is the maximum slippage, in basis points, a market order accepts from the reference price.
+	MaxSlippage *uint64
+	// AchievedSlippage is the slippage, in basis points, of the average price the market order traded at.
+	AchievedSlippage *uint64
 }
 
 func (o *Order) ReduceOnlyAdjustRemaining(extraSize uint64) {
@@ -138,6 +146,10 @@ func (o Order) IntoSubmission() *OrderSubmission {
 		Reference:   o.Reference,
 		PostOnly:    o.PostOnly,
 		ReduceOnly:  o.ReduceOnly,
+		StrategyID:  o.StrategyID,
+		MaxSlippage: o.MaxSlippage,
+
+		SelfTradePrevention: o.SelfTradePrevention,
 	}
 	if o.IcebergOrder != nil {
 		sub.IcebergOrder = &IcebergOrder{
@@ -173,12 +185,18 @@ func (o Order) Clone() *Order {
 	if o.IcebergOrder != nil {
 		cpy.IcebergOrder = o.IcebergOrder.Clone()
 	}
+	if o.MaxSlippage != nil {
+		cpy.MaxSlippage = ptr.From(*o.MaxSlippage)
+	}
+	if o.AchievedSlippage != nil {
+		cpy.AchievedSlippage = ptr.From(*o.AchievedSlippage)
+	}
 	return &cpy
 }
 
 func (o Order) String() string {
 	return fmt.Sprintf(
-		"ID(%s) marketID(%s) party(%s) side(%s) price(%s) size(%v) remaining(%v) timeInForce(%s) type(%s) status(%s) reference(%s) reason(%s) version(%v) batchID(%v) createdAt(%v) updatedAt(%v) expiresAt(%v) originalPrice(%s) peggedOrder(%s) postOnly(%v) reduceOnly(%v) iceberg(%s)",
+		"ID(%s) marketID(%s) party(%s) side(%s) price(%s) size(%v) remaining(%v) timeInForce(%s) type(%s) status(%s) reference(%s) reason(%s) version(%v) batchID(%v) createdAt(%v) updatedAt(%v) expiresAt(%v) originalPrice(%s) peggedOrder(%s) postOnly(%v) reduceOnly(%v) iceberg(%s) selfTradePrevention(%s) maxSlippage(%s) achievedSlippage(%s)",
 		o.ID,
 		o.MarketID,
 		o.Party,
@@ -201,6 +219,9 @@ func (o Order) String() string {
 		o.PostOnly,
 		o.ReduceOnly,
 		stringer.PtrToString(o.IcebergOrder),
+		o.SelfTradePrevention.String(),
+		stringer.PtrToString(o.MaxSlippage),
+		stringer.PtrToString(o.AchievedSlippage),
 	)
 }
 
@@ -251,6 +272,10 @@ func (o *Order) IntoProto() *proto.Order {
 		PostOnly:     o.PostOnly,
 		ReduceOnly:   o.ReduceOnly,
 		IcebergOrder: iceberg,
+
+		SelfTradePrevention: o.SelfTradePrevention,
+		MaxSlippage:         o.MaxSlippage,
+		AchievedSlippage:    o.AchievedSlippage,
 	}
 }
 
@@ -305,6 +330,10 @@ func OrderFromProto(o *proto.Order) (*Order, error) {
 		PostOnly:     o.PostOnly,
 		ReduceOnly:   o.ReduceOnly,
 		IcebergOrder: iceberg,
+
+		SelfTradePrevention: o.SelfTradePrevention,
+		MaxSlippage:         o.MaxSlippage,
+		AchievedSlippage:    o.AchievedSlippage,
 	}, nil
 }
 
@@ -589,6 +618,16 @@ type OrderConfirmation struct {
 	Order                 *Order
 	Trades                []*Trade
 	PassiveOrdersAffected []*Order
+	// SelfTradeReductions lists the orders which were cancelled or decremented
+	// without trading in order to prevent a self-trade.
+	SelfTradeReductions []*SelfTradeReduction
+}
+
+// SelfTradeReduction records the volume removed from an order by self-trade prevention.
+type SelfTradeReduction struct {
+	Order *Order
+	// Volume is the size removed from the order without trading.
+	Volume uint64
 }
 
 func (o *OrderConfirmation) IntoProto() *proto.OrderConfirmation {
@@ -635,6 +674,7 @@ type Trade struct {
 	SellerFee          *Fee
 	BuyerAuctionBatch  uint64
 	SellerAuctionBatch uint64
+	StrategyID         string
 }
 
 func (t *Trade) SetIDs(tradeID string, aggressive, passive *Order) {
@@ -672,6 +712,7 @@ func (t *Trade) IntoProto() *proto.Trade {
 		SellerFee:          sellerFee,
 		BuyerAuctionBatch:  t.BuyerAuctionBatch,
 		SellerAuctionBatch: t.SellerAuctionBatch,
+		StrategyId:         t.StrategyID,
 	}
 }
 
@@ -696,6 +737,7 @@ func TradeFromProto(t *proto.Trade) *Trade {
 		SellerFee:          FeeFromProto(t.SellerFee),
 		BuyerAuctionBatch:  t.BuyerAuctionBatch,
 		SellerAuctionBatch: t.SellerAuctionBatch,
+		StrategyID:         t.StrategyId,
 	}
 }
 
@@ -744,6 +786,10 @@ const (
 	// Trading initiated by the network with another party off the book,
 	// with a distressed party in order to zero-out the position of the party.
 	TradeTypeNetworkCloseOutBad TradeType = proto.Trade_TYPE_NETWORK_CLOSE_OUT_BAD
+	// Block trade executed off the book at the price of an accepted quote.
+	TradeTypeBlock TradeType = proto.Trade_TYPE_BLOCK
+	// Trading initiated by the network with a profitable party off the book, to absorb the network's position.
+	TradeTypeAutoDeleveraging TradeType = proto.Trade_TYPE_AUTO_DELEVERAGING
 )
 
 type PeggedReference = proto.PeggedReference
@@ -757,6 +803,10 @@ const (
 	PeggedReferenceBestBid PeggedReference = proto.PeggedReference_PEGGED_REFERENCE_BEST_BID
 	// Best ask price reference.
 	PeggedReferenceBestAsk PeggedReference = proto.PeggedReference_PEGGED_REFERENCE_BEST_ASK
+	// Mark price reference.
+	PeggedReferenceMarkPrice PeggedReference = proto.PeggedReference_PEGGED_REFERENCE_MARK_PRICE
+	// Index price reference.
+	PeggedReferenceIndexPrice PeggedReference = proto.PeggedReference_PEGGED_REFERENCE_INDEX_PRICE
 )
 
 type OrderStatus = proto.Order_Status
@@ -825,6 +875,21 @@ const (
 	OrderTimeInForceGFN OrderTimeInForce = proto.Order_TIME_IN_FORCE_GFN
 )
 
+type OrderSelfTradePrevention = proto.Order_SelfTradePrevention
+
+const (
+	// Default value, the aggressive order is stopped before trading with the party's own order.
+	OrderSelfTradePreventionUnspecified OrderSelfTradePrevention = proto.Order_SELF_TRADE_PREVENTION_UNSPECIFIED
+	// The aggressive order is stopped, the resting order stays on the book.
+	OrderSelfTradePreventionCancelNewest OrderSelfTradePrevention = proto.Order_SELF_TRADE_PREVENTION_CANCEL_NEWEST
+	// The resting order is cancelled and the aggressive order carries on matching.
+	OrderSelfTradePreventionCancelOldest OrderSelfTradePrevention = proto.Order_SELF_TRADE_PREVENTION_CANCEL_OLDEST
+	// Both the aggressive and the resting order are cancelled.
+	OrderSelfTradePreventionCancelBoth OrderSelfTradePrevention = proto.Order_SELF_TRADE_PREVENTION_CANCEL_BOTH
+	// The smaller order is cancelled and the larger one decremented by the same size.
+	OrderSelfTradePreventionDecrementAndCancel OrderSelfTradePrevention = proto.Order_SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL
+)
+
 type OrderError = proto.OrderError
 
 const (
@@ -931,6 +996,14 @@ const (
 	OrderErrorPeggedOrdersNotAllowedInIsolatedMargin OrderError = proto.OrderError_ORDER_ERROR_PEGGED_ORDERS_NOT_ALLOWED_IN_ISOLATED_MARGIN_MODE
 	OrderErrorPriceNotInTickSize                     OrderError = proto.OrderError_ORDER_ERROR_PRICE_NOT_IN_TICK_SIZE
 	OrderErrorPriceLTEMaxPrice                       OrderError = proto.OrderError_ORDER_ERROR_PRICE_MUST_BE_LESS_THAN_OR_EQUAL_TO_MAX_PRICE
+	// The party's market maker protection was triggered and it cannot place resting orders until the freeze ends.
+	OrderErrorMarketMakerProtectionFrozen OrderError = proto.OrderError_ORDER_ERROR_MARKET_MAKER_PROTECTION_FROZEN
+	// The pegged order references a price the market does not provide, e.g. an index price on a non-perpetual market.
+	OrderErrorPeggedReferenceNotSupported OrderError = proto.OrderError_ORDER_ERROR_PEGGED_REFERENCE_NOT_SUPPORTED
+	// The order could take the position of the party, or the open interest of the market, above the limits of the market.
+	OrderErrorPositionSizeLimitExceeded     OrderError = proto.OrderError_ORDER_ERROR_POSITION_SIZE_LIMIT_EXCEEDED
+	OrderErrorPositionNotionalLimitExceeded OrderError = proto.OrderError_ORDER_ERROR_POSITION_NOTIONAL_LIMIT_EXCEEDED
+	OrderErrorOpenInterestLimitExceeded     OrderError = proto.OrderError_ORDER_ERROR_OPEN_INTEREST_LIMIT_EXCEEDED
 )
 
 var (
@@ -960,6 +1033,11 @@ var (
 	ErrReduceOnlyOrderWouldNotReducePosition       = OrderErrorReduceOnlyOrderWouldNotReducePosition
 	ErrPeggedOrdersNotAllowedInIsolatedMargin      = OrderErrorPeggedOrdersNotAllowedInIsolatedMargin
 	ErrOrderNotInTickSize                          = OrderErrorPriceNotInTickSize
+	ErrMarketMakerProtectionFrozen                 = OrderErrorMarketMakerProtectionFrozen
+	ErrPeggedReferenceNotSupported                 = OrderErrorPeggedReferenceNotSupported
+	ErrPositionSizeLimitExceeded                   = OrderErrorPositionSizeLimitExceeded
+	ErrPositionNotionalLimitExceeded               = OrderErrorPositionNotionalLimitExceeded
+	ErrOpenInterestLimitExceeded                   = OrderErrorOpenInterestLimitExceeded
 )
 
 func OtherSide(s Side) Side {
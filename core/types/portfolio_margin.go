@@ -0,0 +1,193 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"fmt"
+
+	"code.vegaprotocol.io/vega/libs/num"
+	vgreflect "code.vegaprotocol.io/vega/libs/reflect"
+	proto "code.vegaprotocol.io/vega/protos/vega"
+)
+
+// PortfolioMarginGroups lists the groups of markets margined on their net risk.
+// No group means portfolio margining is disabled.
+type PortfolioMarginGroups struct {
+	Groups []*PortfolioMarginGroup
+}
+
+type PortfolioMarginGroup struct {
+	Name    string
+	Asset   string
+	Markets []string
+	// Correlations between pairs of markets, the pairs not listed are uncorrelated.
+	Correlations []*MarketCorrelation
+}
+
+type MarketCorrelation struct {
+	MarketA     string
+	MarketB     string
+	Correlation num.Decimal
+}
+
+func (p *PortfolioMarginGroups) Clone() *PortfolioMarginGroups {
+	out := &PortfolioMarginGroups{
+		Groups: make([]*PortfolioMarginGroup, 0, len(p.Groups)),
+	}
+	for _, g := range p.Groups {
+		cpy := &PortfolioMarginGroup{
+			Name:         g.Name,
+			Asset:        g.Asset,
+			Markets:      append([]string{}, g.Markets...),
+			Correlations: make([]*MarketCorrelation, 0, len(g.Correlations)),
+		}
+		for _, c := range g.Correlations {
+			cpy.Correlations = append(cpy.Correlations, &MarketCorrelation{
+				MarketA:     c.MarketA,
+				MarketB:     c.MarketB,
+				Correlation: c.Correlation,
+			})
+		}
+		out.Groups = append(out.Groups, cpy)
+	}
+	return out
+}
+
+func PortfolioMarginGroupsFromUntypedProto(v interface{}) (*PortfolioMarginGroups, error) {
+	pgroups, err := toPortfolioMarginGroups(v)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't convert untyped proto to PortfolioMarginGroups proto: %w", err)
+	}
+
+	groups, err := PortfolioMarginGroupsFromProto(pgroups)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't build PortfolioMarginGroups: %w", err)
+	}
+
+	return groups, nil
+}
+
+func PortfolioMarginGroupsFromProto(pgroups *proto.PortfolioMarginGroups) (*PortfolioMarginGroups, error) {
+	if err := CheckPortfolioMarginGroups(pgroups); err != nil {
+		return nil, err
+	}
+
+	groups := &PortfolioMarginGroups{
+		Groups: make([]*PortfolioMarginGroup, 0, len(pgroups.Groups)),
+	}
+	for _, g := range pgroups.Groups {
+		group := &PortfolioMarginGroup{
+			Name:         g.Name,
+			Asset:        g.Asset,
+			Markets:      append([]string{}, g.Markets...),
+			Correlations: make([]*MarketCorrelation, 0, len(g.Correlations)),
+		}
+		for _, c := range g.Correlations {
+			group.Correlations = append(group.Correlations, &MarketCorrelation{
+				MarketA:     c.MarketA,
+				MarketB:     c.MarketB,
+				Correlation: num.MustDecimalFromString(c.Correlation),
+			})
+		}
+		groups.Groups = append(groups.Groups, group)
+	}
+
+	return groups, nil
+}
+
+func CheckUntypedPortfolioMarginGroups(v interface{}, _ interface{}) error {
+	groups, err := toPortfolioMarginGroups(v)
+	if err != nil {
+		return err
+	}
+
+	return CheckPortfolioMarginGroups(groups)
+}
+
+// CheckPortfolioMarginGroups verifies the proto.PortfolioMarginGroups is valid.
+func CheckPortfolioMarginGroups(pgroups *proto.PortfolioMarginGroups) error {
+	names := map[string]struct{}{}
+	allMarkets := map[string]struct{}{}
+	minusOne, one := num.DecimalOne().Neg(), num.DecimalOne()
+
+	for i, g := range pgroups.Groups {
+		if len(g.Name) == 0 {
+			return fmt.Errorf("%d.name is required", i)
+		}
+		if _, ok := names[g.Name]; ok {
+			return fmt.Errorf("duplicate group name: %s", g.Name)
+		}
+		names[g.Name] = struct{}{}
+		if len(g.Asset) == 0 {
+			return fmt.Errorf("%d.asset is required", i)
+		}
+		if len(g.Markets) < 2 {
+			return fmt.Errorf("%d.markets must contain at least 2 markets", i)
+		}
+
+		markets := map[string]struct{}{}
+		for _, m := range g.Markets {
+			if len(m) == 0 {
+				return fmt.Errorf("%d.markets cannot contain an empty market ID", i)
+			}
+			if _, ok := allMarkets[m]; ok {
+				return fmt.Errorf("market %s is in more than one group", m)
+			}
+			allMarkets[m] = struct{}{}
+			markets[m] = struct{}{}
+		}
+
+		pairs := map[[2]string]struct{}{}
+		for j, c := range g.Correlations {
+			if _, ok := markets[c.MarketA]; !ok {
+				return fmt.Errorf("%d.correlations.%d.market_a is not in the group", i, j)
+			}
+			if _, ok := markets[c.MarketB]; !ok {
+				return fmt.Errorf("%d.correlations.%d.market_b is not in the group", i, j)
+			}
+			if c.MarketA == c.MarketB {
+				return fmt.Errorf("%d.correlations.%d must reference two different markets", i, j)
+			}
+			pair := [2]string{c.MarketA, c.MarketB}
+			if pair[0] > pair[1] {
+				pair[0], pair[1] = pair[1], pair[0]
+			}
+			if _, ok := pairs[pair]; ok {
+				return fmt.Errorf("duplicate correlation for markets %s and %s", c.MarketA, c.MarketB)
+			}
+			pairs[pair] = struct{}{}
+
+			corr, err := num.DecimalFromString(c.Correlation)
+			if err != nil {
+				return fmt.Errorf("%d.correlations.%d.correlation, invalid decimal: %w", i, j, err)
+			}
+			if corr.LessThan(minusOne) || corr.GreaterThan(one) {
+				return fmt.Errorf("%d.correlations.%d.correlation must be between [-1, 1]", i, j)
+			}
+		}
+	}
+
+	return nil
+}
+
+func toPortfolioMarginGroups(v interface{}) (*proto.PortfolioMarginGroups, error) {
+	cfg, ok := v.(*proto.PortfolioMarginGroups)
+	if !ok {
+		return nil, fmt.Errorf("type \"%s\" is not a *PortfolioMarginGroups proto", vgreflect.TypeName(v))
+	}
+
+	return cfg, nil
+}
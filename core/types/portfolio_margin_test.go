@@ -0,0 +1,73 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package types_test
+
+import (
+	"testing"
+
+	"code.vegaprotocol.io/vega/core/types"
+	proto "code.vegaprotocol.io/vega/protos/vega"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortfolioMarginGroupsNetworkParameter(t *testing.T) {
+	err := types.CheckPortfolioMarginGroups(&proto.PortfolioMarginGroups{})
+	require.NoError(t, err)
+
+	// a valid group
+	correlation := &proto.MarketCorrelation{
+		MarketA:     "perp",
+		MarketB:     "future",
+		Correlation: "0.95",
+	}
+	group := &proto.PortfolioMarginGroup{
+		Name:         "eth",
+		Asset:        "USDT",
+		Markets:      []string{"perp", "future"},
+		Correlations: []*proto.MarketCorrelation{correlation},
+	}
+	groups := &proto.PortfolioMarginGroups{
+		Groups: []*proto.PortfolioMarginGroup{group},
+	}
+	err = types.CheckPortfolioMarginGroups(groups)
+	require.NoError(t, err)
+
+	// correlation out of range
+	correlation.Correlation = "-1.01"
+	err = types.CheckPortfolioMarginGroups(groups)
+	require.Error(t, err)
+
+	// correlation to a market outside of the group
+	correlation.Correlation = "-1"
+	correlation.MarketB = "spot"
+	err = types.CheckPortfolioMarginGroups(groups)
+	require.Error(t, err)
+
+	// a market cannot be in two groups
+	correlation.MarketB = "future"
+	groups.Groups = append(groups.Groups, &proto.PortfolioMarginGroup{
+		Name:    "btc",
+		Asset:   "USDT",
+		Markets: []string{"perp", "btc-perp"},
+	})
+	err = types.CheckPortfolioMarginGroups(groups)
+	require.Error(t, err)
+
+	groups.Groups[1].Markets[0] = "btc-future"
+	err = types.CheckPortfolioMarginGroups(groups)
+	require.NoError(t, err)
+}
@@ -30,7 +30,8 @@ type PriceMonitoringSettings struct {
 }
 
 type PriceMonitoringParameters struct {
-	Triggers []*PriceMonitoringTrigger
+	Triggers        []*PriceMonitoringTrigger
+	IndexDivergence *PriceMonitoringIndexDivergence
 }
 
 type PriceMonitoringBoundsList []*PriceMonitoringBounds
@@ -100,8 +101,13 @@ func PriceMonitoringParametersFromProto(p *proto.PriceMonitoringParameters) *Pri
 	for _, t := range p.Triggers {
 		triggers = append(triggers, PriceMonitoringTriggerFromProto(t))
 	}
+	var indexDivergence *PriceMonitoringIndexDivergence
+	if p.IndexDivergence != nil {
+		indexDivergence = PriceMonitoringIndexDivergenceFromProto(p.IndexDivergence)
+	}
 	return &PriceMonitoringParameters{
-		Triggers: triggers,
+		Triggers:        triggers,
+		IndexDivergence: indexDivergence,
 	}
 }
 
@@ -110,8 +116,13 @@ func (p PriceMonitoringParameters) IntoProto() *proto.PriceMonitoringParameters
 	for _, t := range p.Triggers {
 		triggers = append(triggers, t.IntoProto())
 	}
+	var indexDivergence *proto.PriceMonitoringIndexDivergence
+	if p.IndexDivergence != nil {
+		indexDivergence = p.IndexDivergence.IntoProto()
+	}
 	return &proto.PriceMonitoringParameters{
-		Triggers: triggers,
+		Triggers:        triggers,
+		IndexDivergence: indexDivergence,
 	}
 }
 
@@ -122,6 +133,9 @@ func (p PriceMonitoringParameters) DeepClone() *PriceMonitoringParameters {
 	for _, t := range p.Triggers {
 		cpy.Triggers = append(cpy.Triggers, t.DeepClone())
 	}
+	if p.IndexDivergence != nil {
+		cpy.IndexDivergence = p.IndexDivergence.DeepClone()
+	}
 	return &cpy
 }
 
@@ -131,8 +145,9 @@ func (p *PriceMonitoringParameters) Reset() {
 
 func (p PriceMonitoringParameters) String() string {
 	return fmt.Sprintf(
-		"triggers(%v)",
+		"triggers(%v) indexDivergence(%s)",
 		PriceMonitoringTriggers(p.Triggers).String(),
+		stringer.PtrToString(p.IndexDivergence),
 	)
 }
 
@@ -246,3 +261,43 @@ func (p PriceMonitoringTrigger) DeepClone() *PriceMonitoringTrigger {
 		AuctionExtension: p.AuctionExtension,
 	}
 }
+
+// PriceMonitoringIndexDivergence starts a price monitoring auction when the traded price
+// diverges from the market's index price by more than MaxDivergence.
+type PriceMonitoringIndexDivergence struct {
+	MaxDivergence    num.Decimal
+	AuctionExtension int64
+}
+
+func PriceMonitoringIndexDivergenceFromProto(p *proto.PriceMonitoringIndexDivergence) *PriceMonitoringIndexDivergence {
+	maxDivergence, err := num.DecimalFromString(p.MaxDivergence)
+	if err != nil {
+		maxDivergence = num.DecimalZero()
+	}
+	return &PriceMonitoringIndexDivergence{
+		MaxDivergence:    maxDivergence,
+		AuctionExtension: p.AuctionExtension,
+	}
+}
+
+func (p PriceMonitoringIndexDivergence) IntoProto() *proto.PriceMonitoringIndexDivergence {
+	return &proto.PriceMonitoringIndexDivergence{
+		MaxDivergence:    p.MaxDivergence.String(),
+		AuctionExtension: p.AuctionExtension,
+	}
+}
+
+func (p PriceMonitoringIndexDivergence) DeepClone() *PriceMonitoringIndexDivergence {
+	return &PriceMonitoringIndexDivergence{
+		MaxDivergence:    p.MaxDivergence,
+		AuctionExtension: p.AuctionExtension,
+	}
+}
+
+func (p PriceMonitoringIndexDivergence) String() string {
+	return fmt.Sprintf(
+		"maxDivergence(%s) auctionExtension(%v)",
+		p.MaxDivergence.String(),
+		p.AuctionExtension,
+	)
+}
@@ -86,6 +86,12 @@ type OrderSubmission struct {
 	PostOnly     bool
 	ReduceOnly   bool
 	IcebergOrder *IcebergOrder
+	// Used to specify what happens when the order would trade with an order from the same party
+	SelfTradePrevention OrderSelfTradePrevention
+	// Set internally on the legs of a spread order, not part of the order submission command
+	StrategyID string
+	// Maximum slippage in basis points from the reference price at execution time, only for market orders
+	MaxSlippage *uint64
 }
 
 func (o OrderSubmission) IntoProto() *commandspb.OrderSubmission {
@@ -116,6 +122,9 @@ func (o OrderSubmission) IntoProto() *commandspb.OrderSubmission {
 		PostOnly:    o.PostOnly,
 		ReduceOnly:  o.ReduceOnly,
 		IcebergOpts: iceberg,
+		MaxSlippage: o.MaxSlippage,
+
+		SelfTradePrevention: o.SelfTradePrevention,
 	}
 }
 
@@ -155,12 +164,15 @@ func NewOrderSubmissionFromProto(p *commandspb.OrderSubmission) (*OrderSubmissio
 		PostOnly:     p.PostOnly,
 		ReduceOnly:   p.ReduceOnly,
 		IcebergOrder: iceberg,
+		MaxSlippage:  p.MaxSlippage,
+
+		SelfTradePrevention: p.SelfTradePrevention,
 	}, nil
 }
 
 func (o OrderSubmission) String() string {
 	return fmt.Sprintf(
-		"marketID(%s) price(%s) size(%v) side(%s) timeInForce(%s) expiresAt(%v) type(%s) reference(%s) peggedOrder(%s) postOnly(%v) reduceOnly(%v)",
+		"marketID(%s) price(%s) size(%v) side(%s) timeInForce(%s) expiresAt(%v) type(%s) reference(%s) peggedOrder(%s) postOnly(%v) reduceOnly(%v) selfTradePrevention(%s) maxSlippage(%s)",
 		o.MarketID,
 		stringer.PtrToString(o.Price),
 		o.Size,
@@ -172,6 +184,8 @@ func (o OrderSubmission) String() string {
 		stringer.PtrToString(o.PeggedOrder),
 		o.PostOnly,
 		o.ReduceOnly,
+		o.SelfTradePrevention.String(),
+		stringer.PtrToString(o.MaxSlippage),
 	)
 }
 
@@ -200,6 +214,10 @@ func (o OrderSubmission) IntoOrder(party string) *Order {
 		PostOnly:     o.PostOnly,
 		ReduceOnly:   o.ReduceOnly,
 		IcebergOrder: iceberg,
+		MaxSlippage:  o.MaxSlippage,
+
+		SelfTradePrevention: o.SelfTradePrevention,
+		StrategyID:          o.StrategyID,
 	}
 }
 
@@ -0,0 +1,198 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"errors"
+	"time"
+
+	"code.vegaprotocol.io/vega/libs/num"
+	proto "code.vegaprotocol.io/vega/protos/vega"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+)
+
+var ErrInvalidQuotePrice = errors.New("invalid quote price")
+
+type QuoteRequestStatus = proto.QuoteRequest_Status
+
+const (
+	// Default value, always invalid.
+	QuoteRequestStatusUnspecified QuoteRequestStatus = proto.QuoteRequest_STATUS_UNSPECIFIED
+	// Request is open and can be quoted on.
+	QuoteRequestStatusOpen QuoteRequestStatus = proto.QuoteRequest_STATUS_OPEN
+	// A quote was accepted and the block trade executed.
+	QuoteRequestStatusFilled QuoteRequestStatus = proto.QuoteRequest_STATUS_FILLED
+	// Request was cancelled by the party, or because the market closed.
+	QuoteRequestStatusCancelled QuoteRequestStatus = proto.QuoteRequest_STATUS_CANCELLED
+	// Request expired without any quote being accepted.
+	QuoteRequestStatusExpired QuoteRequestStatus = proto.QuoteRequest_STATUS_EXPIRED
+)
+
+type QuoteStatus = proto.Quote_Status
+
+const (
+	// Default value, always invalid.
+	QuoteStatusUnspecified QuoteStatus = proto.Quote_STATUS_UNSPECIFIED
+	// Quote can be accepted by the requesting party.
+	QuoteStatusActive QuoteStatus = proto.Quote_STATUS_ACTIVE
+	// Quote was accepted and the block trade executed.
+	QuoteStatusAccepted QuoteStatus = proto.Quote_STATUS_ACCEPTED
+	// Quote was replaced by a newer quote from the same party.
+	QuoteStatusReplaced QuoteStatus = proto.Quote_STATUS_REPLACED
+	// Quote was cancelled because its request was cancelled or filled.
+	QuoteStatusCancelled QuoteStatus = proto.Quote_STATUS_CANCELLED
+	// Quote expired before being accepted.
+	QuoteStatusExpired QuoteStatus = proto.Quote_STATUS_EXPIRED
+	// Quote was accepted but the quoting party could not cover the margin.
+	QuoteStatusRejected QuoteStatus = proto.Quote_STATUS_REJECTED
+)
+
+type QuoteRequestSubmission struct {
+	MarketID  string
+	Side      Side
+	Size      uint64
+	ExpiresIn time.Duration
+	Reference string
+}
+
+func NewQuoteRequestSubmissionFromProto(cmd *commandspb.SubmitQuoteRequest) *QuoteRequestSubmission {
+	return &QuoteRequestSubmission{
+		MarketID:  cmd.MarketId,
+		Side:      cmd.Side,
+		Size:      cmd.Size,
+		ExpiresIn: time.Duration(cmd.ExpiresIn) * time.Second,
+		Reference: cmd.Reference,
+	}
+}
+
+type QuoteSubmission struct {
+	QuoteRequestID string
+	// Price is in market precision.
+	Price     *num.Uint
+	ExpiresIn time.Duration
+}
+
+func NewQuoteSubmissionFromProto(cmd *commandspb.SubmitQuote) (*QuoteSubmission, error) {
+	price, overflow := num.UintFromString(cmd.Price, 10)
+	if overflow {
+		return nil, ErrInvalidQuotePrice
+	}
+
+	return &QuoteSubmission{
+		QuoteRequestID: cmd.QuoteRequestId,
+		Price:          price,
+		ExpiresIn:      time.Duration(cmd.ExpiresIn) * time.Second,
+	}, nil
+}
+
+// QuoteRequest is a request for quote broadcast by a party wanting to
+// trade Size on a market without going through the order book.
+type QuoteRequest struct {
+	ID              string
+	MarketID        string
+	Party           string
+	Side            Side
+	Size            uint64
+	Status          QuoteRequestStatus
+	CreatedAt       time.Time
+	ExpiresAt       time.Time
+	UpdatedAt       time.Time
+	Reference       string
+	AcceptedQuoteID string
+	TradeID         string
+}
+
+func (q *QuoteRequest) IntoProto() *proto.QuoteRequest {
+	return &proto.QuoteRequest{
+		Id:              q.ID,
+		MarketId:        q.MarketID,
+		PartyId:         q.Party,
+		Side:            q.Side,
+		Size:            q.Size,
+		Status:          q.Status,
+		CreatedAt:       q.CreatedAt.UnixNano(),
+		ExpiresAt:       q.ExpiresAt.UnixNano(),
+		UpdatedAt:       q.UpdatedAt.UnixNano(),
+		Reference:       q.Reference,
+		AcceptedQuoteId: q.AcceptedQuoteID,
+		TradeId:         q.TradeID,
+	}
+}
+
+func QuoteRequestFromProto(q *proto.QuoteRequest) *QuoteRequest {
+	return &QuoteRequest{
+		ID:              q.Id,
+		MarketID:        q.MarketId,
+		Party:           q.PartyId,
+		Side:            q.Side,
+		Size:            q.Size,
+		Status:          q.Status,
+		CreatedAt:       time.Unix(0, q.CreatedAt),
+		ExpiresAt:       time.Unix(0, q.ExpiresAt),
+		UpdatedAt:       time.Unix(0, q.UpdatedAt),
+		Reference:       q.Reference,
+		AcceptedQuoteID: q.AcceptedQuoteId,
+		TradeID:         q.TradeId,
+	}
+}
+
+// Quote is the price at which a party is willing to take the other
+// side of a request for quote, for its full size.
+type Quote struct {
+	ID             string
+	QuoteRequestID string
+	MarketID       string
+	Party          string
+	// Price is in market precision.
+	Price     *num.Uint
+	Status    QuoteStatus
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	UpdatedAt time.Time
+}
+
+func (q *Quote) IntoProto() *proto.Quote {
+	return &proto.Quote{
+		Id:             q.ID,
+		QuoteRequestId: q.QuoteRequestID,
+		MarketId:       q.MarketID,
+		PartyId:        q.Party,
+		Price:          num.UintToString(q.Price),
+		Status:         q.Status,
+		CreatedAt:      q.CreatedAt.UnixNano(),
+		ExpiresAt:      q.ExpiresAt.UnixNano(),
+		UpdatedAt:      q.UpdatedAt.UnixNano(),
+	}
+}
+
+func QuoteFromProto(q *proto.Quote) (*Quote, error) {
+	price, overflow := num.UintFromString(q.Price, 10)
+	if overflow {
+		return nil, ErrInvalidQuotePrice
+	}
+
+	return &Quote{
+		ID:             q.Id,
+		QuoteRequestID: q.QuoteRequestId,
+		MarketID:       q.MarketId,
+		Party:          q.PartyId,
+		Price:          price,
+		Status:         q.Status,
+		CreatedAt:      time.Unix(0, q.CreatedAt),
+		ExpiresAt:      time.Unix(0, q.ExpiresAt),
+		UpdatedAt:      time.Unix(0, q.UpdatedAt),
+	}, nil
+}
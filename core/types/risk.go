@@ -180,6 +180,124 @@ func (t TradableInstrumentLogNormalRiskModel) Equal(trm isTRM) bool {
 	return p.Mu.Equal(cp.Mu) && p.R.Equal(cp.R) && p.Sigma.Equal(cp.Sigma)
 }
 
+// HistoricalSimulationRiskModel derives the risk factors from the empirical distribution
+// of the returns of the mark price observed in the market.
+type HistoricalSimulationRiskModel struct {
+	RiskAversionParameter num.Decimal
+	Tau                   num.Decimal
+	WindowSize            uint64
+	MinObservations       uint64
+	// 0 disables the volatility filtering
+	DecayFactor   num.Decimal
+	FallbackSigma num.Decimal
+}
+
+func (h HistoricalSimulationRiskModel) IntoProto() *proto.HistoricalSimulationRiskModel {
+	ra, _ := h.RiskAversionParameter.Float64()
+	t, _ := h.Tau.Float64()
+	decay, _ := h.DecayFactor.Float64()
+	sigma, _ := h.FallbackSigma.Float64()
+	return &proto.HistoricalSimulationRiskModel{
+		RiskAversionParameter: ra,
+		Tau:                   t,
+		WindowSize:            h.WindowSize,
+		MinObservations:       h.MinObservations,
+		DecayFactor:           decay,
+		FallbackSigma:         sigma,
+	}
+}
+
+func (h HistoricalSimulationRiskModel) DeepClone() *HistoricalSimulationRiskModel {
+	cpy := h
+	return &cpy
+}
+
+func (h HistoricalSimulationRiskModel) String() string {
+	return fmt.Sprintf(
+		"tau(%s) riskAversionParameter(%s) windowSize(%d) minObservations(%d) decayFactor(%s) fallbackSigma(%s)",
+		h.Tau.String(),
+		h.RiskAversionParameter.String(),
+		h.WindowSize,
+		h.MinObservations,
+		h.DecayFactor.String(),
+		h.FallbackSigma.String(),
+	)
+}
+
+func (h HistoricalSimulationRiskModel) Equal(o *HistoricalSimulationRiskModel) bool {
+	return o != nil &&
+		h.RiskAversionParameter.Equal(o.RiskAversionParameter) &&
+		h.Tau.Equal(o.Tau) &&
+		h.WindowSize == o.WindowSize &&
+		h.MinObservations == o.MinObservations &&
+		h.DecayFactor.Equal(o.DecayFactor) &&
+		h.FallbackSigma.Equal(o.FallbackSigma)
+}
+
+func HistoricalSimulationRiskModelFromProto(p *proto.HistoricalSimulationRiskModel) *HistoricalSimulationRiskModel {
+	if p == nil {
+		return nil
+	}
+	return &HistoricalSimulationRiskModel{
+		RiskAversionParameter: num.DecimalFromFloat(p.RiskAversionParameter),
+		Tau:                   num.DecimalFromFloat(p.Tau),
+		WindowSize:            p.WindowSize,
+		MinObservations:       p.MinObservations,
+		DecayFactor:           num.DecimalFromFloat(p.DecayFactor),
+		FallbackSigma:         num.DecimalFromFloat(p.FallbackSigma),
+	}
+}
+
+type TradableInstrumentHistoricalSimulationRiskModel struct {
+	HistoricalSimulationRiskModel *HistoricalSimulationRiskModel
+}
+
+func (t TradableInstrumentHistoricalSimulationRiskModel) String() string {
+	return fmt.Sprintf(
+		"historicalSimulationRiskModel(%s)",
+		stringer.PtrToString(t.HistoricalSimulationRiskModel),
+	)
+}
+
+func (t TradableInstrumentHistoricalSimulationRiskModel) IntoProto() *proto.TradableInstrument_HistoricalSimulationRiskModel {
+	return &proto.TradableInstrument_HistoricalSimulationRiskModel{
+		HistoricalSimulationRiskModel: t.HistoricalSimulationRiskModel.IntoProto(),
+	}
+}
+
+func (TradableInstrumentHistoricalSimulationRiskModel) isTRM() {}
+
+func (t TradableInstrumentHistoricalSimulationRiskModel) trmIntoProto() interface{} {
+	return t.IntoProto()
+}
+
+func (TradableInstrumentHistoricalSimulationRiskModel) rmType() rmType {
+	return HistoricalSimulationRiskModelType
+}
+
+func (t TradableInstrumentHistoricalSimulationRiskModel) Equal(trm isTRM) bool {
+	var ct *TradableInstrumentHistoricalSimulationRiskModel
+	switch et := trm.(type) {
+	case *TradableInstrumentHistoricalSimulationRiskModel:
+		ct = et
+	case TradableInstrumentHistoricalSimulationRiskModel:
+		ct = &et
+	}
+	if ct == nil {
+		return false
+	}
+	return t.HistoricalSimulationRiskModel.Equal(ct.HistoricalSimulationRiskModel)
+}
+
+func TradableInstrumentHistoricalSimulationFromProto(p *proto.TradableInstrument_HistoricalSimulationRiskModel) *TradableInstrumentHistoricalSimulationRiskModel {
+	if p == nil {
+		return nil
+	}
+	return &TradableInstrumentHistoricalSimulationRiskModel{
+		HistoricalSimulationRiskModel: HistoricalSimulationRiskModelFromProto(p.HistoricalSimulationRiskModel),
+	}
+}
+
 func MarginCalculatorFromProto(p *proto.MarginCalculator) *MarginCalculator {
 	if p == nil {
 		return nil
@@ -347,6 +465,8 @@ func isTRMFromProto(p interface{}) isTRM {
 		return TradableInstrumentSimpleFromProto(tirm)
 	case *proto.TradableInstrument_LogNormalRiskModel:
 		return TradableInstrumentLogNormalFromProto(tirm)
+	case *proto.TradableInstrument_HistoricalSimulationRiskModel:
+		return TradableInstrumentHistoricalSimulationFromProto(tirm)
 	}
 	// default to nil simple params
 	return TradableInstrumentSimpleFromProto(nil)
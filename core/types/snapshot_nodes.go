@@ -136,6 +136,10 @@ type PayloadGovernanceActive struct {
 	GovernanceActive *GovernanceActive
 }
 
+type PayloadGovernanceDelegations struct {
+	GovernanceDelegations *snapshot.GovernanceDelegations
+}
+
 type PayloadGovernanceEnacted struct {
 	GovernanceEnacted *GovernanceEnacted
 }
@@ -373,11 +377,13 @@ type SLANetworkParams struct {
 }
 
 type ExecutionMarkets struct {
-	Markets        []*ExecMarket
-	SpotMarkets    []*ExecSpotMarket
-	SettledMarkets []*CPMarketState
-	Successors     []*Successors
-	AllMarketIDs   []string
+	Markets          []*ExecMarket
+	SpotMarkets      []*ExecSpotMarket
+	SettledMarkets   []*CPMarketState
+	Successors       []*Successors
+	AllMarketIDs     []string
+	CancelOnTimeouts []*snapshot.CancelOnTimeout
+	RequestsForQuote *snapshot.RequestsForQuote
 }
 
 type ExecMarket struct {
@@ -414,6 +420,9 @@ type ExecMarket struct {
 	InternalCompositePriceCalculator *snapshot.CompositePriceCalculator
 	Amm                              *snapshot.AmmState
 	MarketLiquidity                  *snapshot.MarketLiquidity
+	RiskModelPriceHistory            []*snapshot.DataPoint
+	MarketMakerProtection            []*snapshot.MarketMakerProtection
+	PortfolioMarginParties           []string
 }
 
 type ExecSpotMarket struct {
@@ -440,6 +449,8 @@ type ExecSpotMarket struct {
 	ExpiringStopOrders         []*Order
 	FeesStats                  *eventspb.FeesStats
 	MarketLiquidity            *snapshot.MarketLiquidity
+	Amm                        *snapshot.AmmState
+	MarketMakerProtection      []*snapshot.MarketMakerProtection
 }
 
 type PriceMonitor struct {
@@ -455,6 +466,7 @@ type PriceMonitor struct {
 	RefPriceCache               []*KeyDecimalPair
 	RefPriceCacheTime           time.Time
 	PriceBoundsConsensusReached bool
+	IndexDivergenceActive       bool
 }
 
 type CurrentPrice struct {
@@ -492,7 +504,9 @@ type KeyDecimalPair struct {
 }
 
 type PeggedOrdersState struct {
-	Parked []*Order
+	Parked         []*Order
+	LastMarkPrice  *num.Uint
+	LastIndexPrice *num.Uint
 }
 
 type AuctionState struct {
@@ -846,6 +860,10 @@ func PayloadFromProto(p *snapshot.Payload) *Payload {
 		ret.Data = PayloadMarketActivityTrackerFromProto(dt)
 	case *snapshot.Payload_BankingRecurringTransfers:
 		ret.Data = PayloadBankingRecurringTransfersFromProto(dt)
+	case *snapshot.Payload_BankingStreamingTransfers:
+		ret.Data = PayloadBankingStreamingTransfersFromProto(dt)
+	case *snapshot.Payload_BankingConditionalTransfers:
+		ret.Data = PayloadBankingConditionalTransfersFromProto(dt)
 	case *snapshot.Payload_BankingScheduledTransfers:
 		ret.Data = PayloadBankingScheduledTransfersFromProto(dt)
 	case *snapshot.Payload_BankingRecurringGovernanceTransfers:
@@ -902,6 +920,8 @@ func PayloadFromProto(p *snapshot.Payload) *Payload {
 		ret.Data = PayloadEVMFwdHeartbeatsFromProto(dt)
 	case *snapshot.Payload_VolumeRebateProgram:
 		ret.Data = PayloadVolumeRebateProgramFromProto(dt)
+	case *snapshot.Payload_GovernanceDelegations:
+		ret.Data = PayloadGovernanceDelegationsFromProto(dt)
 	default:
 		panic(fmt.Errorf("missing support for payload %T", dt))
 	}
@@ -1041,6 +1061,10 @@ func (p Payload) IntoProto() *snapshot.Payload {
 		ret.Data = dt
 	case *snapshot.Payload_BankingRecurringTransfers:
 		ret.Data = dt
+	case *snapshot.Payload_BankingStreamingTransfers:
+		ret.Data = dt
+	case *snapshot.Payload_BankingConditionalTransfers:
+		ret.Data = dt
 	case *snapshot.Payload_BankingScheduledTransfers:
 		ret.Data = dt
 	case *snapshot.Payload_BankingRecurringGovernanceTransfers:
@@ -1101,6 +1125,8 @@ func (p Payload) IntoProto() *snapshot.Payload {
 		ret.Data = dt
 	case *snapshot.Payload_VolumeRebateProgram:
 		ret.Data = dt
+	case *snapshot.Payload_GovernanceDelegations:
+		ret.Data = dt
 	default:
 		panic(fmt.Errorf("missing support for payload %T", dt))
 	}
@@ -1691,6 +1717,32 @@ func (p *PayloadGovernanceBatchActive) plToProto() interface{} {
 	return p.IntoProto()
 }
 
+func PayloadGovernanceDelegationsFromProto(gd *snapshot.Payload_GovernanceDelegations) *PayloadGovernanceDelegations {
+	return &PayloadGovernanceDelegations{
+		GovernanceDelegations: gd.GovernanceDelegations,
+	}
+}
+
+func (p PayloadGovernanceDelegations) IntoProto() *snapshot.Payload_GovernanceDelegations {
+	return &snapshot.Payload_GovernanceDelegations{
+		GovernanceDelegations: p.GovernanceDelegations,
+	}
+}
+
+func (*PayloadGovernanceDelegations) Key() string {
+	return "delegations"
+}
+
+func (*PayloadGovernanceDelegations) Namespace() SnapshotNamespace {
+	return GovernanceSnapshot
+}
+
+func (*PayloadGovernanceDelegations) isPayload() {}
+
+func (p *PayloadGovernanceDelegations) plToProto() interface{} {
+	return p.IntoProto()
+}
+
 func PayloadGovernanceActiveFromProto(ga *snapshot.Payload_GovernanceActive) *PayloadGovernanceActive {
 	return &PayloadGovernanceActive{
 		GovernanceActive: GovernanceActiveFromProto(ga.GovernanceActive),
@@ -2595,6 +2647,12 @@ func PeggedOrdersStateFromProto(s *snapshot.PeggedOrders) *PeggedOrdersState {
 		o, _ := OrderFromProto(v)
 		po.Parked = append(po.Parked, o)
 	}
+	if len(s.LastMarkPrice) > 0 {
+		po.LastMarkPrice, _ = num.UintFromString(s.LastMarkPrice, 10)
+	}
+	if len(s.LastIndexPrice) > 0 {
+		po.LastIndexPrice, _ = num.UintFromString(s.LastIndexPrice, 10)
+	}
 	return po
 }
 
@@ -2606,6 +2664,12 @@ func (s PeggedOrdersState) IntoProto() *snapshot.PeggedOrders {
 	for _, v := range s.Parked {
 		po.ParkedOrders = append(po.ParkedOrders, v.IntoProto())
 	}
+	if s.LastMarkPrice != nil {
+		po.LastMarkPrice = s.LastMarkPrice.String()
+	}
+	if s.LastIndexPrice != nil {
+		po.LastIndexPrice = s.LastIndexPrice.String()
+	}
 	// NB: we should not sort the parked orders as this will mess up their order in the orderbook if they get unparked
 	return po
 }
@@ -2813,6 +2877,7 @@ func PriceMonitorFromProto(pm *snapshot.PriceMonitor) *PriceMonitor {
 		RefPriceCacheTime:           time.Unix(0, pm.RefPriceCacheTime).UTC(),
 		RefPriceCache:               make([]*KeyDecimalPair, 0, len(pm.RefPriceCache)),
 		PriceBoundsConsensusReached: pm.ConsensusReached,
+		IndexDivergenceActive:       pm.IndexDivergenceActive,
 	}
 	for _, d := range pm.FpHorizons {
 		ret.FPHorizons = append(ret.FPHorizons, KeyDecimalPairFromProto(d))
@@ -2837,18 +2902,19 @@ func PriceMonitorFromProto(pm *snapshot.PriceMonitor) *PriceMonitor {
 
 func (p PriceMonitor) IntoProto() *snapshot.PriceMonitor {
 	ret := snapshot.PriceMonitor{
-		Initialised:         p.Initialised,
-		FpHorizons:          make([]*snapshot.DecimalMap, 0, len(p.FPHorizons)),
-		Now:                 p.Now.UnixNano(),
-		Update:              p.Update.UnixNano(),
-		Bounds:              make([]*snapshot.PriceBound, 0, len(p.Bounds)),
-		PriceRangeCacheTime: p.PriceRangeCacheTime.UnixNano(),
-		PriceRangeCache:     make([]*snapshot.PriceRangeCache, 0, len(p.PriceRangeCache)),
-		PricesNow:           make([]*snapshot.CurrentPrice, 0, len(p.PricesNow)),
-		PricesPast:          make([]*snapshot.PastPrice, 0, len(p.PricesPast)),
-		RefPriceCacheTime:   p.RefPriceCacheTime.UnixNano(),
-		RefPriceCache:       make([]*snapshot.DecimalMap, 0, len(p.RefPriceCache)),
-		ConsensusReached:    p.PriceBoundsConsensusReached,
+		Initialised:           p.Initialised,
+		FpHorizons:            make([]*snapshot.DecimalMap, 0, len(p.FPHorizons)),
+		Now:                   p.Now.UnixNano(),
+		Update:                p.Update.UnixNano(),
+		Bounds:                make([]*snapshot.PriceBound, 0, len(p.Bounds)),
+		PriceRangeCacheTime:   p.PriceRangeCacheTime.UnixNano(),
+		PriceRangeCache:       make([]*snapshot.PriceRangeCache, 0, len(p.PriceRangeCache)),
+		PricesNow:             make([]*snapshot.CurrentPrice, 0, len(p.PricesNow)),
+		PricesPast:            make([]*snapshot.PastPrice, 0, len(p.PricesPast)),
+		RefPriceCacheTime:     p.RefPriceCacheTime.UnixNano(),
+		RefPriceCache:         make([]*snapshot.DecimalMap, 0, len(p.RefPriceCache)),
+		ConsensusReached:      p.PriceBoundsConsensusReached,
+		IndexDivergenceActive: p.IndexDivergenceActive,
 	}
 	for _, d := range p.FPHorizons {
 		ret.FpHorizons = append(ret.FpHorizons, d.IntoProto())
@@ -2942,6 +3008,8 @@ func ExecSpotMarketFromProto(em *snapshot.SpotMarket) *ExecSpotMarket {
 		FeesStats:                  em.FeesStats,
 		HasTraded:                  em.HasTraded,
 		MarketLiquidity:            em.MarketLiquidity,
+		Amm:                        em.Amm,
+		MarketMakerProtection:      em.MarketMakerProtection,
 	}
 	for _, o := range em.ExpiringOrders {
 		or, _ := OrderFromProto(o)
@@ -2976,6 +3044,8 @@ func (e ExecSpotMarket) IntoProto() *snapshot.SpotMarket {
 		FeesStats:                  e.FeesStats,
 		HasTraded:                  e.HasTraded,
 		MarketLiquidity:            e.MarketLiquidity,
+		Amm:                        e.Amm,
+		MarketMakerProtection:      e.MarketMakerProtection,
 	}
 	if e.CurrentMarkPrice != nil {
 		ret.CurrentMarkPrice = e.CurrentMarkPrice.String()
@@ -3059,6 +3129,9 @@ func ExecMarketFromProto(em *snapshot.Market) *ExecMarket {
 		InternalCompositePriceCalculator: em.InternalCompositePriceCalculator,
 		Amm:                              em.Amm,
 		MarketLiquidity:                  em.MarketLiquidity,
+		RiskModelPriceHistory:            em.RiskModelPriceHistory,
+		MarketMakerProtection:            em.MarketMakerProtection,
+		PortfolioMarginParties:           em.PortfolioMarginParties,
 	}
 
 	for _, o := range em.ExpiringOrders {
@@ -3104,6 +3177,9 @@ func (e ExecMarket) IntoProto() *snapshot.Market {
 		InternalCompositePriceCalculator: e.InternalCompositePriceCalculator,
 		MarketLiquidity:                  e.MarketLiquidity,
 		Amm:                              e.Amm,
+		RiskModelPriceHistory:            e.RiskModelPriceHistory,
+		MarketMakerProtection:            e.MarketMakerProtection,
+		PortfolioMarginParties:           e.PortfolioMarginParties,
 	}
 
 	if e.CurrentMarkPrice != nil {
@@ -3153,11 +3229,13 @@ func ExecutionMarketsFromProto(em *snapshot.ExecutionMarkets) *ExecutionMarkets
 	}
 
 	return &ExecutionMarkets{
-		Markets:        mkts,
-		SpotMarkets:    spots,
-		SettledMarkets: settled,
-		Successors:     successors,
-		AllMarketIDs:   allMarkets,
+		Markets:          mkts,
+		SpotMarkets:      spots,
+		SettledMarkets:   settled,
+		Successors:       successors,
+		AllMarketIDs:     allMarkets,
+		CancelOnTimeouts: em.CancelOnTimeouts,
+		RequestsForQuote: em.RequestsForQuote,
 	}
 }
 
@@ -3180,11 +3258,13 @@ func (e ExecutionMarkets) IntoProto() *snapshot.ExecutionMarkets {
 	}
 
 	return &snapshot.ExecutionMarkets{
-		Markets:        mkts,
-		SpotMarkets:    spots,
-		SettledMarkets: settled,
-		Successors:     successors,
-		MarketIds:      e.AllMarketIDs,
+		Markets:          mkts,
+		SpotMarkets:      spots,
+		SettledMarkets:   settled,
+		Successors:       successors,
+		MarketIds:        e.AllMarketIDs,
+		CancelOnTimeouts: e.CancelOnTimeouts,
+		RequestsForQuote: e.RequestsForQuote,
 	}
 }
 
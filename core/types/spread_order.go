@@ -0,0 +1,68 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"code.vegaprotocol.io/vega/libs/num"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+)
+
+// SpreadOrderLeg is one leg of a spread order, trading ratio units on
+// its market for each unit of the spread.
+type SpreadOrderLeg struct {
+	MarketID string
+	Side     Side
+	Ratio    uint64
+}
+
+// Size returns the size of the leg's order for the given spread size.
+func (l *SpreadOrderLeg) Size(spreadSize uint64) uint64 {
+	return l.Ratio * spreadSize
+}
+
+// SpreadOrderSubmission is a set of fill-or-kill market orders on different
+// markets which are either all executed, or none of them are.
+type SpreadOrderSubmission struct {
+	Legs []*SpreadOrderLeg
+	Size uint64
+	// MaxNetPrice is the maximum net price per unit of the spread, in market
+	// precision. Buy legs count positively and sell legs negatively.
+	MaxNetPrice num.Decimal
+	Reference   string
+}
+
+func NewSpreadOrderSubmissionFromProto(cmd *commandspb.SubmitSpreadOrder) (*SpreadOrderSubmission, error) {
+	maxNetPrice, err := num.DecimalFromString(cmd.MaxNetPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	legs := make([]*SpreadOrderLeg, 0, len(cmd.Legs))
+	for _, l := range cmd.Legs {
+		legs = append(legs, &SpreadOrderLeg{
+			MarketID: l.MarketId,
+			Side:     l.Side,
+			Ratio:    l.Ratio,
+		})
+	}
+
+	return &SpreadOrderSubmission{
+		Legs:        legs,
+		Size:        cmd.Size,
+		MaxNetPrice: maxNetPrice,
+		Reference:   cmd.Reference,
+	}, nil
+}
@@ -49,6 +49,21 @@ const (
 	StopOrderTriggerDirectionRisesAbove = vega.StopOrder_TRIGGER_DIRECTION_RISES_ABOVE
 )
 
+type StopOrderTriggerReference = vega.StopOrder_TriggerReference
+
+const (
+	// Default, the stop order is triggered by the last traded price.
+	StopOrderTriggerReferenceUnspecified StopOrderTriggerReference = vega.StopOrder_TRIGGER_REFERENCE_UNSPECIFIED
+	// The stop order is triggered by the last traded price.
+	StopOrderTriggerReferenceLastTradedPrice = vega.StopOrder_TRIGGER_REFERENCE_LAST_TRADED_PRICE
+	// The stop order is triggered by the mark price.
+	StopOrderTriggerReferenceMarkPrice = vega.StopOrder_TRIGGER_REFERENCE_MARK_PRICE
+	// The stop order is triggered by the index price.
+	StopOrderTriggerReferenceIndexPrice = vega.StopOrder_TRIGGER_REFERENCE_INDEX_PRICE
+	// The stop order is triggered by the mid price.
+	StopOrderTriggerReferenceMidPrice = vega.StopOrder_TRIGGER_REFERENCE_MID_PRICE
+)
+
 type StopOrderStatus = vega.StopOrder_Status
 
 const (
@@ -94,6 +109,7 @@ const (
 	StopOrderRejectionNotAllowedDuringOpeningAuction StopOrderRejectionReason = vega.StopOrder_REJECTION_REASON_STOP_ORDER_NOT_ALLOWED_DURING_OPENING_AUCTION
 	StopOrderRejectionOCONotAllowedSameExpiryTime    StopOrderRejectionReason = vega.StopOrder_REJECTION_REASON_STOP_ORDER_CANNOT_MATCH_OCO_EXPIRY_TIMES
 	StopOrderRejectionSizeOverrideUnsupportedForSpot StopOrderRejectionReason = vega.StopOrder_REJECTION_REASON_STOP_ORDER_SIZE_OVERRIDE_UNSUPPORTED_FOR_SPOT
+	StopOrderRejectionTriggerReferenceUnsupported    StopOrderRejectionReason = vega.StopOrder_REJECTION_REASON_STOP_ORDER_TRIGGER_REFERENCE_UNSUPPORTED
 )
 
 type StopOrderExpiry struct {
@@ -119,6 +135,7 @@ type StopOrderSizeOverrideValue struct {
 
 type StopOrderTrigger struct {
 	Direction             StopOrderTriggerDirection
+	Reference             StopOrderTriggerReference
 	price                 *num.Uint
 	trailingPercentOffset num.Decimal
 }
@@ -145,12 +162,22 @@ func NewTrailingStopOrderTrigger(
 
 func (s StopOrderTrigger) String() string {
 	return fmt.Sprintf(
-		"price(%v) trailingPercentOffset(%v)",
+		"price(%v) trailingPercentOffset(%v) reference(%v)",
 		s.price,
 		s.trailingPercentOffset,
+		s.Reference,
 	)
 }
 
+// PriceReference returns the price the trigger is evaluated against,
+// orders not specifying one are triggered by the last traded price.
+func (s *StopOrderTrigger) PriceReference() StopOrderTriggerReference {
+	if s.Reference == StopOrderTriggerReferenceUnspecified {
+		return StopOrderTriggerReferenceLastTradedPrice
+	}
+	return s.Reference
+}
+
 func (s *StopOrderTrigger) IsPrice() bool {
 	return s.price != nil
 }
@@ -201,6 +228,7 @@ func StopOrderSetupFromProto(
 
 	trigger := &StopOrderTrigger{
 		Direction: direction,
+		Reference: ptr.UnBox(psetup.TriggerReference),
 	}
 	switch t := psetup.Trigger.(type) {
 	case *commandspb.StopOrderSetup_Price:
@@ -384,6 +412,7 @@ func NewStopOrderFromProto(p *eventspb.StopOrderEvent) *StopOrder {
 
 	trigger := &StopOrderTrigger{
 		Direction: p.StopOrder.TriggerDirection,
+		Reference: p.StopOrder.TriggerReference,
 	}
 	switch t := p.StopOrder.Trigger.(type) {
 	case *vega.StopOrder_Price:
@@ -462,6 +491,7 @@ func (s *StopOrder) ToProtoEvent() *eventspb.StopOrderEvent {
 			CreatedAt:           s.CreatedAt.UnixNano(),
 			UpdatedAt:           updatedAt,
 			TriggerDirection:    s.Trigger.Direction,
+			TriggerReference:    s.Trigger.Reference,
 			RejectionReason:     s.RejectionReason,
 			SizeOverrideSetting: s.SizeOverrideSetting,
 			SizeOverrideValue:   sizeOverrideValue,
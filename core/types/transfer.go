@@ -229,4 +229,8 @@ const (
 	TransferTypeHighMakerRebatePay TransferType = proto.TransferType_TRANSFER_TYPE_HIGH_MAKER_FEE_REBATE_PAY
 	// Receive high maker rebate.
 	TransferTypeHighMakerRebateReceive TransferType = proto.TransferType_TRANSFER_TYPE_HIGH_MAKER_FEE_REBATE_RECEIVE
+	// Mint complete sets of the outcomes of a prediction market.
+	TransferTypeCompleteSetMint TransferType = proto.TransferType_TRANSFER_TYPE_COMPLETE_SET_MINT
+	// Redeem complete sets of the outcomes of a prediction market.
+	TransferTypeCompleteSetRedeem TransferType = proto.TransferType_TRANSFER_TYPE_COMPLETE_SET_REDEEM
 )
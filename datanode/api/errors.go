@@ -110,6 +110,12 @@ var (
 	ErrMissingMarginFactor = newInvalidArgumentError("missing margin factor")
 	// ErrInvalidGameID is returned when the game ID is not a valid ID.
 	ErrInvalidGameID = newInvalidArgumentError("invalid game ID")
+	// ErrMissingProposalChange is returned when the change to estimate the impact of is missing from the request.
+	ErrMissingProposalChange = newInvalidArgumentError("missing proposal change")
+	// ErrInvalidProposalChange is returned when the change to estimate the impact of is not valid.
+	ErrInvalidProposalChange = newInvalidArgumentError("invalid proposal change")
+	// ErrNetworkParameterImpactNotSupported is returned when estimating the impact of a network parameter update.
+	ErrNetworkParameterImpactNotSupported = newInvalidArgumentError("impact estimation is not supported for network parameter updates")
 
 	// ErrOracleServiceSpecID is returned when there was no data found for the given ID.
 	ErrOracleServiceGetSpec = errors.New("failed to retrieve data for oracle spec")
@@ -161,6 +167,7 @@ var (
 	ErrWithdrawalServiceGet = errors.New("failed to get withdrawal")
 	// PositionService...
 	ErrPositionServiceGetByParty   = errors.New("failed to get positions for party")
+	ErrPositionServiceGetByMarket  = errors.New("failed to get positions for market")
 	ErrPositionServiceSendSnapshot = errors.New("failed to send positions snapshot")
 	// RiskService...
 	ErrRiskServiceGetMarginLevelsByID = errors.New("failed to get margin levels")
@@ -353,6 +360,20 @@ var (
 	ErrInvalidLeverageAtUpperPrice = newInvalidArgumentError("invalid leverage at upper price")
 	ErrInvalidCommitmentAmount     = newInvalidArgumentError("invalid commitment amount")
 	ErrEstimateAMMBounds           = errors.New("failed to estimate AMM bounds")
+
+	// Requests for quote.
+	ErrListQuoteRequests = errors.New("failed to list requests for quote")
+	ErrListQuotes        = errors.New("failed to list quotes")
+	ErrListBlockTrades   = errors.New("failed to list block trades")
+
+	// Auto-deleveraging.
+	ErrListAutoDeleveragingRankings = errors.New("failed to list auto-deleveraging rankings")
+
+	// Governance delegations.
+	ErrListGovernanceDelegations = errors.New("failed to list governance delegations")
+
+	// Proposal impact estimates.
+	ErrEstimateProposalImpact = errors.New("failed to estimate proposal impact")
 )
 
 // errorMap contains a mapping between errors and Vega numeric error codes.
@@ -112,6 +112,7 @@ type MarketsService interface {
 	GetByTxHash(ctx context.Context, txHash entities.TxHash) ([]entities.Market, error)
 	GetAllPaged(ctx context.Context, marketID string, pagination entities.CursorPagination, includeSettled bool) ([]entities.Market, entities.PageInfo, error)
 	ListSuccessorMarkets(ctx context.Context, marketID string, childrenOnly bool, pagination entities.CursorPagination) ([]entities.SuccessorMarket, entities.PageInfo, error)
+	ListPredictionMarkets(ctx context.Context, predictionID string, pagination entities.CursorPagination, includeSettled bool) ([]entities.Market, entities.PageInfo, error)
 }
 
 // MarketDataService ...
@@ -224,6 +225,9 @@ type GRPCServer struct {
 	ammPoolService                      *service.AMMPools
 	volumeRebateStatsService            *service.VolumeRebateStats
 	volumeRebateProgramService          *service.VolumeRebatePrograms
+	requestsForQuoteService             *service.RequestsForQuote
+	autoDeleveragingRankingsService     *service.AutoDeleveragingRankings
+	governanceDelegationsService        *service.GovernanceDelegations
 
 	eventObserver *eventObserver
 
@@ -298,6 +302,9 @@ func NewGRPCServer(
 	ammPoolService *service.AMMPools,
 	volumeRebateStatsService *service.VolumeRebateStats,
 	volumeRebateProgramsService *service.VolumeRebatePrograms,
+	requestsForQuoteService *service.RequestsForQuote,
+	autoDeleveragingRankingsService *service.AutoDeleveragingRankings,
+	governanceDelegationsService *service.GovernanceDelegations,
 ) *GRPCServer {
 	// setup logger
 	log = log.Named(namedLogger)
@@ -371,6 +378,9 @@ func NewGRPCServer(
 		ammPoolService:                      ammPoolService,
 		volumeRebateStatsService:            volumeRebateStatsService,
 		volumeRebateProgramService:          volumeRebateProgramsService,
+		requestsForQuoteService:             requestsForQuoteService,
+		autoDeleveragingRankingsService:     autoDeleveragingRankingsService,
+		governanceDelegationsService:        governanceDelegationsService,
 		eventObserver: &eventObserver{
 			log:          log,
 			eventService: eventService,
@@ -572,57 +582,60 @@ func (g *GRPCServer) Start(ctx context.Context, lis net.Listener) error {
 		candleService:        g.candleService,
 		MarketsService:       g.marketsService,
 
-		partyService:                  g.partyService,
-		riskService:                   g.riskService,
-		positionService:               g.positionService,
-		AccountService:                g.accountService,
-		RewardService:                 g.rewardService,
-		depositService:                g.depositService,
-		withdrawalService:             g.withdrawalService,
-		oracleSpecService:             g.oracleSpecService,
-		oracleDataService:             g.oracleDataService,
-		liquidityProvisionService:     g.liquidityProvisionService,
-		governanceService:             g.governanceService,
-		transfersService:              g.transferService,
-		delegationService:             g.delegationService,
-		marketDepthService:            g.marketDepthService,
-		nodeService:                   g.nodeService,
-		EpochService:                  g.epochService,
-		RiskFactorService:             g.riskFactorService,
-		networkParameterService:       g.networkParameterService,
-		checkpointService:             g.checkpointService,
-		stakeLinkingService:           g.stakeLinkingService,
-		eventService:                  g.eventService,
-		ledgerService:                 g.ledgerService,
-		keyRotationService:            g.keyRotationService,
-		ethereumKeyRotationService:    g.ethereumKeyRotationService,
-		blockService:                  g.blockService,
-		protocolUpgradeService:        g.protocolUpgradeService,
-		NetworkHistoryService:         g.networkHistoryService,
-		coreSnapshotService:           g.coreSnapshotService,
-		stopOrderService:              g.stopOrderService,
-		fundingPeriodService:          g.fundingPeriodService,
-		partyActivityStreak:           g.partyActivityStreak,
-		referralProgramService:        g.referralProgramService,
-		ReferralSetsService:           g.referralSetsService,
-		teamsService:                  g.teamsService,
-		feesStatsService:              g.FeesStatsService,
-		fundingPaymentService:         g.fundingPaymentService,
-		VolumeDiscountStatsService:    g.volumeDiscountStatsService,
-		volumeDiscountProgramService:  g.volumeDiscountProgramService,
-		paidLiquidityFeesStatsService: g.paidLiquidityFeesStatsService,
-		partyLockedBalances:           g.partyLockedBalances,
-		partyVestingBalances:          g.partyVestingBalances,
-		vestingStats:                  g.vestingStatsService,
-		transactionResults:            g.transactionResults,
-		gamesService:                  g.gamesService,
-		marginModesService:            g.marginModesService,
-		twNotionalPositionService:     g.timeWeightedNotionalPositionService,
-		gameScoreService:              g.gameScoreService,
-		AMMPoolService:                g.ammPoolService,
-		volumeRebateStatsService:      g.volumeRebateStatsService,
-		volumeRebateProgramService:    g.volumeRebateProgramService,
-		partyDiscountStats:            partyDiscountStats,
+		partyService:                    g.partyService,
+		riskService:                     g.riskService,
+		positionService:                 g.positionService,
+		AccountService:                  g.accountService,
+		RewardService:                   g.rewardService,
+		depositService:                  g.depositService,
+		withdrawalService:               g.withdrawalService,
+		oracleSpecService:               g.oracleSpecService,
+		oracleDataService:               g.oracleDataService,
+		liquidityProvisionService:       g.liquidityProvisionService,
+		governanceService:               g.governanceService,
+		transfersService:                g.transferService,
+		delegationService:               g.delegationService,
+		marketDepthService:              g.marketDepthService,
+		nodeService:                     g.nodeService,
+		EpochService:                    g.epochService,
+		RiskFactorService:               g.riskFactorService,
+		networkParameterService:         g.networkParameterService,
+		checkpointService:               g.checkpointService,
+		stakeLinkingService:             g.stakeLinkingService,
+		eventService:                    g.eventService,
+		ledgerService:                   g.ledgerService,
+		keyRotationService:              g.keyRotationService,
+		ethereumKeyRotationService:      g.ethereumKeyRotationService,
+		blockService:                    g.blockService,
+		protocolUpgradeService:          g.protocolUpgradeService,
+		NetworkHistoryService:           g.networkHistoryService,
+		coreSnapshotService:             g.coreSnapshotService,
+		stopOrderService:                g.stopOrderService,
+		fundingPeriodService:            g.fundingPeriodService,
+		partyActivityStreak:             g.partyActivityStreak,
+		referralProgramService:          g.referralProgramService,
+		ReferralSetsService:             g.referralSetsService,
+		teamsService:                    g.teamsService,
+		feesStatsService:                g.FeesStatsService,
+		fundingPaymentService:           g.fundingPaymentService,
+		VolumeDiscountStatsService:      g.volumeDiscountStatsService,
+		volumeDiscountProgramService:    g.volumeDiscountProgramService,
+		paidLiquidityFeesStatsService:   g.paidLiquidityFeesStatsService,
+		partyLockedBalances:             g.partyLockedBalances,
+		partyVestingBalances:            g.partyVestingBalances,
+		vestingStats:                    g.vestingStatsService,
+		transactionResults:              g.transactionResults,
+		gamesService:                    g.gamesService,
+		marginModesService:              g.marginModesService,
+		twNotionalPositionService:       g.timeWeightedNotionalPositionService,
+		gameScoreService:                g.gameScoreService,
+		AMMPoolService:                  g.ammPoolService,
+		volumeRebateStatsService:        g.volumeRebateStatsService,
+		volumeRebateProgramService:      g.volumeRebateProgramService,
+		requestsForQuoteService:         g.requestsForQuoteService,
+		autoDeleveragingRankingsService: g.autoDeleveragingRankingsService,
+		governanceDelegationsService:    g.governanceDelegationsService,
+		partyDiscountStats:              partyDiscountStats,
 	}
 
 	protoapi.RegisterTradingDataServiceServer(g.srv, tradingDataSvcV2)
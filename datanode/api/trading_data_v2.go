@@ -33,6 +33,7 @@ import (
 	"code.vegaprotocol.io/vega/core/banking"
 	"code.vegaprotocol.io/vega/core/events"
 	"code.vegaprotocol.io/vega/core/execution/amm"
+	"code.vegaprotocol.io/vega/core/governance/impact"
 	"code.vegaprotocol.io/vega/core/netparams"
 	"code.vegaprotocol.io/vega/core/risk"
 	"code.vegaprotocol.io/vega/core/types"
@@ -77,68 +78,71 @@ var httpBodyChunkSize = 1024 * 1024
 
 type TradingDataServiceV2 struct {
 	v2.UnimplementedTradingDataServiceServer
-	config                        Config
-	log                           *logging.Logger
-	eventService                  EventService
-	orderService                  *service.Order
-	networkLimitsService          *service.NetworkLimits
-	MarketDataService             MarketDataService
-	tradeService                  *service.Trade
-	multiSigService               *service.MultiSig
-	notaryService                 *service.Notary
-	AssetService                  AssetService
-	candleService                 *candlesv2.Svc
-	MarketsService                MarketsService
-	partyService                  *service.Party
-	riskService                   *service.Risk
-	positionService               *service.Position
-	AccountService                *service.Account
-	RewardService                 *service.Reward
-	depositService                *service.Deposit
-	withdrawalService             *service.Withdrawal
-	oracleSpecService             *service.OracleSpec
-	oracleDataService             *service.OracleData
-	liquidityProvisionService     *service.LiquidityProvision
-	governanceService             *service.Governance
-	transfersService              *service.Transfer
-	delegationService             *service.Delegation
-	marketDepthService            *service.MarketDepth
-	nodeService                   *service.Node
-	EpochService                  EpochService
-	RiskFactorService             RiskFactorService
-	networkParameterService       *service.NetworkParameter
-	checkpointService             *service.Checkpoint
-	stakeLinkingService           *service.StakeLinking
-	ledgerService                 *service.Ledger
-	keyRotationService            *service.KeyRotations
-	ethereumKeyRotationService    *service.EthereumKeyRotation
-	blockService                  BlockService
-	protocolUpgradeService        *service.ProtocolUpgrade
-	NetworkHistoryService         NetworkHistoryService
-	coreSnapshotService           *service.SnapshotData
-	stopOrderService              *service.StopOrders
-	fundingPeriodService          *service.FundingPeriods
-	partyActivityStreak           *service.PartyActivityStreak
-	fundingPaymentService         *service.FundingPayment
-	referralProgramService        *service.ReferralPrograms
-	ReferralSetsService           ReferralSetService
-	teamsService                  *service.Teams
-	feesStatsService              *service.FeesStats
-	VolumeDiscountStatsService    VolumeDiscountService
-	volumeDiscountProgramService  *service.VolumeDiscountPrograms
-	volumeRebateStatsService      *service.VolumeRebateStats
-	volumeRebateProgramService    *service.VolumeRebatePrograms
-	paidLiquidityFeesStatsService *service.PaidLiquidityFeesStats
-	partyLockedBalances           *service.PartyLockedBalances
-	partyVestingBalances          *service.PartyVestingBalances
-	vestingStats                  *service.VestingStats
-	transactionResults            *service.TransactionResults
-	gamesService                  *service.Games
-	marginModesService            *service.MarginModes
-	twNotionalPositionService     *service.TimeWeightedNotionalPosition
-	gameScoreService              *service.GameScore
-	AMMPoolService                AMMService
-	partyDiscountStats            PartyStatsSvc
+	config                          Config
+	log                             *logging.Logger
+	eventService                    EventService
+	orderService                    *service.Order
+	networkLimitsService            *service.NetworkLimits
+	MarketDataService               MarketDataService
+	tradeService                    *service.Trade
+	multiSigService                 *service.MultiSig
+	notaryService                   *service.Notary
+	AssetService                    AssetService
+	candleService                   *candlesv2.Svc
+	MarketsService                  MarketsService
+	partyService                    *service.Party
+	riskService                     *service.Risk
+	positionService                 *service.Position
+	AccountService                  *service.Account
+	RewardService                   *service.Reward
+	depositService                  *service.Deposit
+	withdrawalService               *service.Withdrawal
+	oracleSpecService               *service.OracleSpec
+	oracleDataService               *service.OracleData
+	liquidityProvisionService       *service.LiquidityProvision
+	governanceService               *service.Governance
+	transfersService                *service.Transfer
+	delegationService               *service.Delegation
+	marketDepthService              *service.MarketDepth
+	nodeService                     *service.Node
+	EpochService                    EpochService
+	RiskFactorService               RiskFactorService
+	networkParameterService         *service.NetworkParameter
+	checkpointService               *service.Checkpoint
+	stakeLinkingService             *service.StakeLinking
+	ledgerService                   *service.Ledger
+	keyRotationService              *service.KeyRotations
+	ethereumKeyRotationService      *service.EthereumKeyRotation
+	blockService                    BlockService
+	protocolUpgradeService          *service.ProtocolUpgrade
+	NetworkHistoryService           NetworkHistoryService
+	coreSnapshotService             *service.SnapshotData
+	stopOrderService                *service.StopOrders
+	fundingPeriodService            *service.FundingPeriods
+	partyActivityStreak             *service.PartyActivityStreak
+	fundingPaymentService           *service.FundingPayment
+	referralProgramService          *service.ReferralPrograms
+	ReferralSetsService             ReferralSetService
+	teamsService                    *service.Teams
+	feesStatsService                *service.FeesStats
+	VolumeDiscountStatsService      VolumeDiscountService
+	volumeDiscountProgramService    *service.VolumeDiscountPrograms
+	volumeRebateStatsService        *service.VolumeRebateStats
+	volumeRebateProgramService      *service.VolumeRebatePrograms
+	paidLiquidityFeesStatsService   *service.PaidLiquidityFeesStats
+	partyLockedBalances             *service.PartyLockedBalances
+	partyVestingBalances            *service.PartyVestingBalances
+	vestingStats                    *service.VestingStats
+	transactionResults              *service.TransactionResults
+	gamesService                    *service.Games
+	marginModesService              *service.MarginModes
+	twNotionalPositionService       *service.TimeWeightedNotionalPosition
+	gameScoreService                *service.GameScore
+	AMMPoolService                  AMMService
+	requestsForQuoteService         *service.RequestsForQuote
+	autoDeleveragingRankingsService *service.AutoDeleveragingRankings
+	governanceDelegationsService    *service.GovernanceDelegations
+	partyDiscountStats              PartyStatsSvc
 }
 
 func (t *TradingDataServiceV2) SetLogger(l *logging.Logger) {
@@ -1461,7 +1465,16 @@ func (t *TradingDataServiceV2) ListMarkets(ctx context.Context, req *v2.ListMark
 		includeSettled = *req.IncludeSettled
 	}
 
-	markets, pageInfo, err := t.MarketsService.GetAllPaged(ctx, "", pagination, includeSettled)
+	var (
+		markets  []entities.Market
+		pageInfo entities.PageInfo
+	)
+	if req.PredictionId != nil && *req.PredictionId != "" {
+		// only the outcome markets of the prediction market
+		markets, pageInfo, err = t.MarketsService.ListPredictionMarkets(ctx, *req.PredictionId, pagination, includeSettled)
+	} else {
+		markets, pageInfo, err = t.MarketsService.GetAllPaged(ctx, "", pagination, includeSettled)
+	}
 	if err != nil {
 		return nil, formatE(ErrMarketServiceGetAllPaged, err)
 	}
@@ -2705,6 +2718,9 @@ func (t *TradingDataServiceV2) GetTransfer(ctx context.Context, req *v2.GetTrans
 	if err != nil {
 		return nil, formatE(err)
 	}
+	if err := t.setStreamingTransfersClaimable(ctx, tp); err != nil {
+		return nil, formatE(ErrBlockServiceGetLast, err)
+	}
 	fees := make([]*eventspb.TransferFees, 0, len(transfer.Fees))
 	for _, f := range transfer.Fees {
 		fees = append(fees, f.ToProto())
@@ -2793,12 +2809,42 @@ func (t *TradingDataServiceV2) ListTransfers(ctx context.Context, req *v2.ListTr
 		return nil, formatE(err)
 	}
 
+	tps := make([]*eventspb.Transfer, 0, len(edges))
+	for _, edge := range edges {
+		tps = append(tps, edge.Node.Transfer)
+	}
+	if err := t.setStreamingTransfersClaimable(ctx, tps...); err != nil {
+		return nil, formatE(ErrBlockServiceGetLast, err)
+	}
+
 	return &v2.ListTransfersResponse{Transfers: &v2.TransferConnection{
 		Edges:    edges,
 		PageInfo: pageInfo.ToProto(),
 	}}, nil
 }
 
+// setStreamingTransfersClaimable sets the amount vested but not yet released
+// of the active streaming transfers, as of the last block.
+func (t *TradingDataServiceV2) setStreamingTransfersClaimable(ctx context.Context, transfers ...*eventspb.Transfer) error {
+	var now *time.Time
+	for _, transfer := range transfers {
+		streaming := transfer.GetStreaming()
+		if streaming == nil || transfer.Status != eventspb.Transfer_STATUS_PENDING {
+			continue
+		}
+		if now == nil {
+			b, err := t.blockService.GetLastBlock(ctx)
+			if err != nil {
+				return err
+			}
+			now = &b.VegaTime
+		}
+		claimable := types.StreamingTransferFromEvent(transfer).ClaimableAt(*now).String()
+		streaming.Claimable = &claimable
+	}
+	return nil
+}
+
 // GetOrder gets an order by ID.
 func (t *TradingDataServiceV2) GetOrder(ctx context.Context, req *v2.GetOrderRequest) (*v2.GetOrderResponse, error) {
 	defer metrics.StartAPIRequestAndTimeGRPC("GetOrderV2")()
@@ -4279,6 +4325,249 @@ func (t *TradingDataServiceV2) ObserveVotes(req *v2.ObserveVotesRequest, stream
 	return formatE(ErrMissingProposalIDOrPartyID)
 }
 
+// ListGovernanceDelegations lists the current governance delegations.
+func (t *TradingDataServiceV2) ListGovernanceDelegations(ctx context.Context, req *v2.ListGovernanceDelegationsRequest) (*v2.ListGovernanceDelegationsResponse, error) {
+	defer metrics.StartAPIRequestAndTimeGRPC("ListGovernanceDelegations")()
+
+	pagination, err := entities.CursorPaginationFromProto(req.Pagination)
+	if err != nil {
+		return nil, formatE(ErrInvalidPagination, err)
+	}
+
+	if req.ProposalType != nil && ptr.UnBox(req.AllProposalTypes) {
+		return nil, formatE(ErrInvalidFilter, errors.New("proposal type and all proposal types cannot be set together"))
+	}
+
+	filters := sqlstore.ListGovernanceDelegationsFilters{}
+	if req.Delegator != nil {
+		filters.Delegator = ptr.From(entities.PartyID(*req.Delegator))
+	}
+	if req.Delegate != nil {
+		filters.Delegate = ptr.From(entities.PartyID(*req.Delegate))
+	}
+	if req.ProposalType != nil {
+		filters.ProposalType = ptr.From(entities.DelegationProposalType(*req.ProposalType))
+	} else if ptr.UnBox(req.AllProposalTypes) {
+		filters.ProposalType = ptr.From(entities.DelegationProposalTypeAll)
+	}
+
+	delegations, pageInfo, err := t.governanceDelegationsService.ListGovernanceDelegations(ctx, pagination, filters)
+	if err != nil {
+		return nil, formatE(ErrListGovernanceDelegations, err)
+	}
+
+	edges, err := makeEdges[*v2.GovernanceDelegationEdge](delegations)
+	if err != nil {
+		return nil, formatE(err)
+	}
+
+	return &v2.ListGovernanceDelegationsResponse{
+		Delegations: &v2.GovernanceDelegationConnection{
+			Edges:    edges,
+			PageInfo: pageInfo.ToProto(),
+		},
+	}, nil
+}
+
+// EstimateProposalImpact estimates the impact a market update would have if it was enacted.
+// Network parameter updates are rejected, none of them changes the risk factors, margins or
+// price monitoring bounds of the existing markets.
+func (t *TradingDataServiceV2) EstimateProposalImpact(ctx context.Context, req *v2.EstimateProposalImpactRequest) (*v2.EstimateProposalImpactResponse, error) {
+	defer metrics.StartAPIRequestAndTimeGRPC("EstimateProposalImpact")()
+
+	switch change := req.Change.(type) {
+	case *v2.EstimateProposalImpactRequest_UpdateMarket:
+		marketImpact, err := t.estimateUpdateMarketImpact(ctx, change.UpdateMarket)
+		if err != nil {
+			return nil, err
+		}
+		return &v2.EstimateProposalImpactResponse{
+			MarketImpact: marketImpact,
+		}, nil
+	case *v2.EstimateProposalImpactRequest_UpdateNetworkParameter:
+		return nil, ErrNetworkParameterImpactNotSupported
+	default:
+		return nil, ErrMissingProposalChange
+	}
+}
+
+func (t *TradingDataServiceV2) estimateUpdateMarketImpact(ctx context.Context, update *vega.UpdateMarket) (*v2.MarketUpdateImpact, error) {
+	if update == nil || update.MarketId == "" {
+		return nil, ErrEmptyMissingMarketID
+	}
+
+	terms, err := types.UpdateMarketFromProto(update)
+	if err != nil {
+		return nil, formatE(ErrInvalidProposalChange, err)
+	}
+
+	mkt, err := t.MarketsService.GetByID(ctx, update.MarketId)
+	if err != nil {
+		return nil, formatE(ErrMarketServiceGetByID, err)
+	}
+
+	market, err := types.MarketFromProto(mkt.ToProto())
+	if err != nil {
+		return nil, formatE(ErrEstimateProposalImpact, err)
+	}
+
+	assetID, err := mkt.ToProto().GetAsset()
+	if err != nil {
+		return nil, formatE(ErrEstimateProposalImpact, err)
+	}
+
+	priceFactor, err := t.getMarketPriceFactor(ctx, mkt)
+	if err != nil {
+		return nil, err
+	}
+
+	mktData, err := t.MarketDataService.GetMarketDataByID(ctx, update.MarketId)
+	if err != nil {
+		return nil, formatE(ErrMarketServiceGetMarketData, err)
+	}
+
+	rf, err := t.RiskFactorService.GetMarketRiskFactors(ctx, update.MarketId)
+	if err != nil {
+		return nil, formatE(ErrRiskFactorServiceGet, err)
+	}
+
+	positions, err := t.positionService.GetByMarket(ctx, update.MarketId)
+	if err != nil {
+		return nil, formatE(ErrPositionServiceGetByMarket, err)
+	}
+
+	collateral, err := t.collateralForMarginInMarket(ctx, mkt.ID, entities.AssetID(assetID), positions)
+	if err != nil {
+		return nil, err
+	}
+
+	state := impact.MarketState{
+		Market:      market,
+		MarkPrice:   mktData.MarkPrice,
+		PriceFactor: priceFactor,
+		RiskFactors: &types.RiskFactor{
+			Market: update.MarketId,
+			Long:   rf.Long,
+			Short:  rf.Short,
+		},
+		Positions: make([]impact.Position, 0, len(positions)),
+	}
+	for _, pos := range positions {
+		state.Positions = append(state.Positions, impact.Position{
+			PartyID:    pos.PartyID.String(),
+			OpenVolume: pos.OpenVolume,
+			Collateral: collateral[pos.PartyID],
+		})
+	}
+
+	report, err := impact.EstimateUpdateMarket(state, terms.UpdateMarket.Changes)
+	if err != nil {
+		return nil, formatE(ErrEstimateProposalImpact, err)
+	}
+
+	return marketUpdateImpactToProto(report), nil
+}
+
+// collateralForMarginInMarket returns the collateral each party can use to cover its margin requirement in the market:
+// the margin and general accounts in cross margin mode, and the margin account only in isolated margin mode.
+func (t *TradingDataServiceV2) collateralForMarginInMarket(ctx context.Context, marketID entities.MarketID, assetID entities.AssetID, positions []entities.Position) (map[entities.PartyID]num.Decimal, error) {
+	collateral := make(map[entities.PartyID]num.Decimal, len(positions))
+	if len(positions) == 0 {
+		return collateral, nil
+	}
+
+	parties := make([]entities.PartyID, 0, len(positions))
+	for _, pos := range positions {
+		parties = append(parties, pos.PartyID)
+		collateral[pos.PartyID] = num.DecimalZero()
+	}
+
+	marginAccounts, _, err := t.AccountService.QueryBalances(ctx, entities.AccountFilter{
+		AssetID:      assetID,
+		PartyIDs:     parties,
+		AccountTypes: []vega.AccountType{vega.AccountType_ACCOUNT_TYPE_MARGIN},
+		MarketIDs:    []entities.MarketID{marketID},
+	}, entities.CursorPagination{})
+	if err != nil {
+		return nil, formatE(ErrAccountServiceGetBalances, err)
+	}
+	for _, acc := range marginAccounts {
+		collateral[acc.PartyID] = collateral[acc.PartyID].Add(acc.Balance)
+	}
+
+	modes, _, err := t.marginModesService.ListPartyMarginModes(ctx, entities.CursorPagination{}, sqlstore.ListPartyMarginModesFilters{
+		MarketID: ptr.From(marketID),
+	})
+	if err != nil {
+		return nil, formatE(ErrListPartyMarginModes, err)
+	}
+	isolated := map[entities.PartyID]struct{}{}
+	for _, mode := range modes {
+		if mode.MarginMode == vega.MarginMode_MARGIN_MODE_ISOLATED_MARGIN {
+			isolated[mode.PartyID] = struct{}{}
+		}
+	}
+
+	generalAccounts, _, err := t.AccountService.QueryBalances(ctx, entities.AccountFilter{
+		AssetID:      assetID,
+		PartyIDs:     parties,
+		AccountTypes: []vega.AccountType{vega.AccountType_ACCOUNT_TYPE_GENERAL},
+	}, entities.CursorPagination{})
+	if err != nil {
+		return nil, formatE(ErrAccountServiceGetBalances, err)
+	}
+	for _, acc := range generalAccounts {
+		if _, ok := isolated[acc.PartyID]; ok {
+			continue
+		}
+		collateral[acc.PartyID] = collateral[acc.PartyID].Add(acc.Balance)
+	}
+
+	return collateral, nil
+}
+
+func marketUpdateImpactToProto(report *impact.MarketImpact) *v2.MarketUpdateImpact {
+	parties := make([]*v2.PartyMarginImpact, 0, len(report.Parties))
+	for _, p := range report.Parties {
+		parties = append(parties, &v2.PartyMarginImpact{
+			PartyId:                   p.PartyID,
+			OpenVolume:                p.OpenVolume,
+			Collateral:                p.Collateral.String(),
+			CurrentMaintenanceMargin:  p.CurrentMaintenanceMargin.Round(0).String(),
+			ProposedMaintenanceMargin: p.ProposedMaintenanceMargin.Round(0).String(),
+			CurrentlyDistressed:       p.CurrentlyDistressed,
+			Distressed:                p.Distressed,
+		})
+	}
+
+	return &v2.MarketUpdateImpact{
+		MarketId:                      report.MarketID,
+		MarkPrice:                     report.MarkPrice.String(),
+		CurrentRiskFactors:            riskFactorIntoProto(report.MarketID, report.CurrentRiskFactors),
+		ProposedRiskFactors:           riskFactorIntoProto(report.MarketID, report.ProposedRiskFactors),
+		CurrentPriceMonitoringBounds:  priceMonitoringBoundsIntoProto(report.CurrentBounds),
+		ProposedPriceMonitoringBounds: priceMonitoringBoundsIntoProto(report.ProposedBounds),
+		Parties:                       parties,
+		NewlyDistressedParties:        report.NewlyDistressedParties,
+	}
+}
+
+func riskFactorIntoProto(marketID string, rf *types.RiskFactor) *vega.RiskFactor {
+	return types.RiskFactor{
+		Market: marketID,
+		Long:   rf.Long,
+		Short:  rf.Short,
+	}.IntoProto()
+}
+
+func priceMonitoringBoundsIntoProto(bounds []*types.PriceMonitoringBounds) []*vega.PriceMonitoringBounds {
+	ret := make([]*vega.PriceMonitoringBounds, 0, len(bounds))
+	for _, b := range bounds {
+		ret = append(ret, b.IntoProto())
+	}
+	return ret
+}
+
 func (t *TradingDataServiceV2) observePartyVotes(partyID string, stream v2.TradingDataService_ObserveVotesServer) error {
 	ctx, cfunc := context.WithCancel(stream.Context())
 	defer cfunc()
@@ -6019,3 +6308,146 @@ func (t *TradingDataServiceV2) EstimateAMMBounds(ctx context.Context, req *v2.Es
 		AmmError:                status,
 	}, nil
 }
+
+func (t *TradingDataServiceV2) ListQuoteRequests(ctx context.Context, req *v2.ListQuoteRequestsRequest) (*v2.ListQuoteRequestsResponse, error) {
+	defer metrics.StartAPIRequestAndTimeGRPC("ListQuoteRequests")()
+
+	pagination, err := entities.CursorPaginationFromProto(req.Pagination)
+	if err != nil {
+		return nil, formatE(ErrInvalidPagination, err)
+	}
+
+	filters := sqlstore.ListQuoteRequestsFilters{}
+	if req.Id != nil {
+		filters.ID = ptr.From(entities.QuoteRequestID(*req.Id))
+	}
+	if req.MarketId != nil {
+		filters.MarketID = ptr.From(entities.MarketID(*req.MarketId))
+	}
+	if req.PartyId != nil {
+		filters.PartyID = ptr.From(entities.PartyID(*req.PartyId))
+	}
+	if req.Status != nil {
+		filters.Status = ptr.From(entities.QuoteRequestStatus(*req.Status))
+	}
+
+	requests, pageInfo, err := t.requestsForQuoteService.ListQuoteRequests(ctx, pagination, filters)
+	if err != nil {
+		return nil, formatE(ErrListQuoteRequests, err)
+	}
+
+	edges, err := makeEdges[*v2.QuoteRequestEdge](requests)
+	if err != nil {
+		return nil, formatE(err)
+	}
+
+	return &v2.ListQuoteRequestsResponse{
+		QuoteRequests: &v2.QuoteRequestConnection{
+			Edges:    edges,
+			PageInfo: pageInfo.ToProto(),
+		},
+	}, nil
+}
+
+func (t *TradingDataServiceV2) ListQuotes(ctx context.Context, req *v2.ListQuotesRequest) (*v2.ListQuotesResponse, error) {
+	defer metrics.StartAPIRequestAndTimeGRPC("ListQuotes")()
+
+	pagination, err := entities.CursorPaginationFromProto(req.Pagination)
+	if err != nil {
+		return nil, formatE(ErrInvalidPagination, err)
+	}
+
+	filters := sqlstore.ListQuotesFilters{}
+	if req.QuoteRequestId != nil {
+		filters.QuoteRequestID = ptr.From(entities.QuoteRequestID(*req.QuoteRequestId))
+	}
+	if req.PartyId != nil {
+		filters.PartyID = ptr.From(entities.PartyID(*req.PartyId))
+	}
+	if req.Status != nil {
+		filters.Status = ptr.From(entities.QuoteStatus(*req.Status))
+	}
+
+	quotes, pageInfo, err := t.requestsForQuoteService.ListQuotes(ctx, pagination, filters)
+	if err != nil {
+		return nil, formatE(ErrListQuotes, err)
+	}
+
+	edges, err := makeEdges[*v2.QuoteEdge](quotes)
+	if err != nil {
+		return nil, formatE(err)
+	}
+
+	return &v2.ListQuotesResponse{
+		Quotes: &v2.QuoteConnection{
+			Edges:    edges,
+			PageInfo: pageInfo.ToProto(),
+		},
+	}, nil
+}
+
+func (t *TradingDataServiceV2) ListBlockTrades(ctx context.Context, req *v2.ListBlockTradesRequest) (*v2.ListBlockTradesResponse, error) {
+	defer metrics.StartAPIRequestAndTimeGRPC("ListBlockTrades")()
+
+	pagination, err := entities.CursorPaginationFromProto(req.Pagination)
+	if err != nil {
+		return nil, formatE(ErrInvalidPagination, err)
+	}
+
+	dateRange := entities.DateRangeFromProto(req.DateRange)
+	trades, pageInfo, err := t.tradeService.ListBlockTrades(ctx,
+		toEntityIDs[entities.MarketID](req.GetMarketIds()),
+		toEntityIDs[entities.PartyID](req.GetPartyIds()),
+		pagination,
+		dateRange)
+	if err != nil {
+		return nil, formatE(ErrListBlockTrades, err)
+	}
+
+	edges, err := makeEdges[*v2.TradeEdge](trades)
+	if err != nil {
+		return nil, formatE(err)
+	}
+
+	return &v2.ListBlockTradesResponse{
+		Trades: &v2.TradeConnection{
+			Edges:    edges,
+			PageInfo: pageInfo.ToProto(),
+		},
+	}, nil
+}
+
+// ListAutoDeleveragingRankings lists the latest auto-deleveraging ranking of the positions in the markets with auto-deleveraging enabled.
+func (t *TradingDataServiceV2) ListAutoDeleveragingRankings(ctx context.Context, req *v2.ListAutoDeleveragingRankingsRequest) (*v2.ListAutoDeleveragingRankingsResponse, error) {
+	defer metrics.StartAPIRequestAndTimeGRPC("ListAutoDeleveragingRankings")()
+
+	pagination, err := entities.CursorPaginationFromProto(req.Pagination)
+	if err != nil {
+		return nil, formatE(ErrInvalidPagination, err)
+	}
+
+	filters := sqlstore.ListAutoDeleveragingRankingsFilters{}
+	if req.MarketId != nil {
+		filters.MarketID = ptr.From(entities.MarketID(*req.MarketId))
+	}
+	if req.PartyId != nil {
+		filters.PartyID = ptr.From(entities.PartyID(*req.PartyId))
+	}
+
+	rankings, pageInfo, err := t.autoDeleveragingRankingsService.ListAutoDeleveragingRankings(ctx, pagination, filters)
+	if err != nil {
+		return nil, formatE(ErrListAutoDeleveragingRankings, err)
+	}
+
+	edges, err := makeEdges[*v2.AutoDeleveragingRankingEdge](rankings)
+	if err != nil {
+		return nil, formatE(err)
+	}
+
+	return &v2.ListAutoDeleveragingRankingsResponse{
+		Rankings: &v2.AutoDeleveragingRankingConnection{
+			Edges:    edges,
+			PageInfo: pageInfo.ToProto(),
+		},
+	}, nil
+}
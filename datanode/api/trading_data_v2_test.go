@@ -49,7 +49,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/maps"
 	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 //go:embed testdata/dummysegment.zip
@@ -1713,3 +1715,50 @@ func (m mockServerStream) SetTrailer(md metadata.MD) {
 		m.setTrailer(md)
 	}
 }
+
+func TestEstimateProposalImpact(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	marketService := mocks.NewMockMarketsService(ctrl)
+
+	apiService := api.TradingDataServiceV2{
+		MarketsService: marketService,
+	}
+
+	t.Run("a change is required", func(t *testing.T) {
+		_, err := apiService.EstimateProposalImpact(ctx, &v2.EstimateProposalImpactRequest{})
+		require.ErrorIs(t, err, api.ErrMissingProposalChange)
+	})
+
+	t.Run("a market update requires a market ID", func(t *testing.T) {
+		_, err := apiService.EstimateProposalImpact(ctx, &v2.EstimateProposalImpactRequest{
+			Change: &v2.EstimateProposalImpactRequest_UpdateMarket{
+				UpdateMarket: &vega.UpdateMarket{},
+			},
+		})
+		require.ErrorIs(t, err, api.ErrEmptyMissingMarketID)
+	})
+
+	t.Run("a network parameter update is not supported", func(t *testing.T) {
+		_, err := apiService.EstimateProposalImpact(ctx, &v2.EstimateProposalImpactRequest{
+			Change: &v2.EstimateProposalImpactRequest_UpdateNetworkParameter{
+				UpdateNetworkParameter: &vega.UpdateNetworkParameter{
+					Changes: &vega.NetworkParameter{Key: "market.margin.scalingFactors", Value: "{}"},
+				},
+			},
+		})
+		require.ErrorIs(t, err, api.ErrNetworkParameterImpactNotSupported)
+	})
+
+	t.Run("the market must exist", func(t *testing.T) {
+		marketService.EXPECT().GetByID(ctx, "market-1").Return(entities.Market{}, entities.ErrNotFound).Times(1)
+
+		_, err := apiService.EstimateProposalImpact(ctx, &v2.EstimateProposalImpactRequest{
+			Change: &v2.EstimateProposalImpactRequest_UpdateMarket{
+				UpdateMarket: &vega.UpdateMarket{MarketId: "market-1"},
+			},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
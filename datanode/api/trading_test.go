@@ -165,6 +165,9 @@ func getTestGRPCServer(t *testing.T, ctx context.Context) (tidy func(), conn *gr
 	sqlMarketDepthService := service.NewMarketDepth(service.NewDefaultConfig().MarketDepth, sqlOrderService, ammPoolsService, nil, nil, nil, nil, logger)
 	volumeRebateStatsService := service.NewVolumeRebateStats(sqlstore.NewVolumeRebateStats(sqlConn))
 	volumeRebateProgramssService := service.NewVolumeRebatePrograms(sqlstore.NewVolumeRebatePrograms(sqlConn))
+	requestsForQuoteService := service.NewRequestsForQuote(sqlstore.NewRequestsForQuote(sqlConn))
+	autoDeleveragingRankingsService := service.NewAutoDeleveragingRankings(sqlstore.NewAutoDeleveragingRankings(sqlConn))
+	governanceDelegationsService := service.NewGovernanceDelegations(sqlstore.NewGovernanceDelegations(sqlConn))
 
 	g := api.NewGRPCServer(
 		logger,
@@ -229,6 +232,9 @@ func getTestGRPCServer(t *testing.T, ctx context.Context) (tidy func(), conn *gr
 		ammPoolsService,
 		volumeRebateStatsService,
 		volumeRebateProgramssService,
+		requestsForQuoteService,
+		autoDeleveragingRankingsService,
+		governanceDelegationsService,
 	)
 	if g == nil {
 		err = fmt.Errorf("failed to create gRPC server")
@@ -196,6 +196,22 @@ func toEvent(ctx context.Context, be *eventspb.BusEvent) events.Event {
 		return events.TimeWeightedNotionalPositionUpdatedEventFromStream(ctx, be)
 	case eventspb.BusEventType_BUS_EVENT_TYPE_CANCELLED_ORDERS:
 		return events.CancelledOrdersEventFromStream(ctx, be)
+	case eventspb.BusEventType_BUS_EVENT_TYPE_MARKET_MAKER_PROTECTION_TRIGGERED:
+		return events.MarketMakerProtectionTriggeredEventFromStream(ctx, be)
+	case eventspb.BusEventType_BUS_EVENT_TYPE_CANCEL_ON_TIMEOUT_TRIGGERED:
+		return events.CancelOnTimeoutTriggeredEventFromStream(ctx, be)
+	case eventspb.BusEventType_BUS_EVENT_TYPE_QUOTE_REQUEST:
+		return events.QuoteRequestEventFromStream(ctx, be)
+	case eventspb.BusEventType_BUS_EVENT_TYPE_QUOTE:
+		return events.QuoteEventFromStream(ctx, be)
+	case eventspb.BusEventType_BUS_EVENT_TYPE_PARTIAL_CLOSE_OUTS:
+		return events.PartialCloseOutsEventFromStream(ctx, be)
+	case eventspb.BusEventType_BUS_EVENT_TYPE_AUTO_DELEVERAGING:
+		return events.AutoDeleveragingEventFromStream(ctx, be)
+	case eventspb.BusEventType_BUS_EVENT_TYPE_AUTO_DELEVERAGING_RANKINGS:
+		return events.AutoDeleveragingRankingsEventFromStream(ctx, be)
+	case eventspb.BusEventType_BUS_EVENT_TYPE_GOVERNANCE_DELEGATION:
+		return events.GovernanceDelegationEventFromStream(ctx, be)
 	case eventspb.BusEventType_BUS_EVENT_TYPE_GAME_SCORES:
 		return events.GameScoresEventFromStream(ctx, be)
 	case eventspb.BusEventType_BUS_EVENT_TYPE_AMM:
@@ -50,6 +50,7 @@ type AMMPool struct {
 	LowerTheoreticalPosition       num.Decimal
 	UpperVirtualLiquidity          num.Decimal
 	UpperTheoreticalPosition       num.Decimal
+	BaseCommitment                 *num.Decimal
 }
 
 type AMMFilterType interface {
@@ -134,6 +135,15 @@ func AMMPoolFromProto(pool *eventspb.AMM, vegaTime time.Time) (AMMPool, error) {
 		fee = &fd
 	}
 
+	var baseCommitment *num.Decimal
+	if pool.BaseCommitment != nil {
+		v, err := num.DecimalFromString(*pool.BaseCommitment)
+		if err != nil {
+			return AMMPool{}, err
+		}
+		baseCommitment = &v
+	}
+
 	var lowerL, upperL, lowerPv, upperPv num.Decimal
 	if pool.LowerCurve != nil {
 		lowerL, err = num.DecimalFromString(pool.LowerCurve.VirtualLiquidity)
@@ -179,11 +189,12 @@ func AMMPoolFromProto(pool *eventspb.AMM, vegaTime time.Time) (AMMPool, error) {
 		LowerTheoreticalPosition:       lowerPv,
 		UpperVirtualLiquidity:          upperL,
 		UpperTheoreticalPosition:       upperPv,
+		BaseCommitment:                 baseCommitment,
 	}, nil
 }
 
 func (p AMMPool) ToProto() *eventspb.AMM {
-	var lowerBound, upperBound, lowerLeverage, upperLeverage *string
+	var lowerBound, upperBound, lowerLeverage, upperLeverage, baseCommitment *string
 	var fee string
 
 	if p.ParametersLowerBound != nil {
@@ -205,6 +216,10 @@ func (p AMMPool) ToProto() *eventspb.AMM {
 		fee = p.ProposedFee.String()
 	}
 
+	if p.BaseCommitment != nil {
+		baseCommitment = ptr.From(p.BaseCommitment.String())
+	}
+
 	return &eventspb.AMM{
 		PartyId:      p.PartyID.String(),
 		MarketId:     p.MarketID.String(),
@@ -221,6 +236,7 @@ func (p AMMPool) ToProto() *eventspb.AMM {
 			LeverageAtLowerBound: lowerLeverage,
 			LeverageAtUpperBound: upperLeverage,
 		},
+		BaseCommitment: baseCommitment,
 	}
 }
 
@@ -0,0 +1,102 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package entities
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"code.vegaprotocol.io/vega/libs/num"
+	v2 "code.vegaprotocol.io/vega/protos/data-node/api/v2"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+)
+
+type AutoDeleveragingRanking struct {
+	MarketID   MarketID
+	PartyID    PartyID
+	OpenVolume int64
+	Rank       uint64
+	Score      num.Decimal
+	TxHash     TxHash
+	VegaTime   time.Time
+}
+
+func AutoDeleveragingRankingsFromProto(marketID MarketID, rankings []*eventspb.AutoDeleveragingRank, txHash TxHash, vegaTime time.Time) ([]AutoDeleveragingRanking, error) {
+	out := make([]AutoDeleveragingRanking, 0, len(rankings))
+	for _, r := range rankings {
+		score, err := num.DecimalFromString(r.Score)
+		if err != nil {
+			return nil, fmt.Errorf("invalid auto-deleveraging score: %w", err)
+		}
+		out = append(out, AutoDeleveragingRanking{
+			MarketID:   marketID,
+			PartyID:    PartyID(r.PartyId),
+			OpenVolume: r.OpenVolume,
+			Rank:       r.Rank,
+			Score:      score,
+			TxHash:     txHash,
+			VegaTime:   vegaTime,
+		})
+	}
+	return out, nil
+}
+
+func (r AutoDeleveragingRanking) Cursor() *Cursor {
+	rc := AutoDeleveragingRankingCursor{
+		MarketID: r.MarketID,
+		PartyID:  r.PartyID,
+	}
+	return NewCursor(rc.String())
+}
+
+func (r AutoDeleveragingRanking) ToProto() *v2.AutoDeleveragingRanking {
+	return &v2.AutoDeleveragingRanking{
+		MarketId:   r.MarketID.String(),
+		PartyId:    r.PartyID.String(),
+		OpenVolume: r.OpenVolume,
+		Rank:       r.Rank,
+		Score:      r.Score.String(),
+		UpdatedAt:  r.VegaTime.UnixNano(),
+	}
+}
+
+func (r AutoDeleveragingRanking) ToProtoEdge(_ ...any) (*v2.AutoDeleveragingRankingEdge, error) {
+	return &v2.AutoDeleveragingRankingEdge{
+		Node:   r.ToProto(),
+		Cursor: r.Cursor().Encode(),
+	}, nil
+}
+
+type AutoDeleveragingRankingCursor struct {
+	MarketID MarketID
+	PartyID  PartyID
+}
+
+func (rc AutoDeleveragingRankingCursor) String() string {
+	bs, err := json.Marshal(rc)
+	if err != nil {
+		panic(fmt.Errorf("could not marshal auto-deleveraging ranking cursor: %v", err))
+	}
+	return string(bs)
+}
+
+func (rc *AutoDeleveragingRankingCursor) Parse(cursorString string) error {
+	if cursorString == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(cursorString), rc)
+}
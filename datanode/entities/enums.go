@@ -84,6 +84,10 @@ const (
 	// Trading initiated by the network with another party off the book,
 	// with a distressed party in order to zero-out the position of the party.
 	TradeTypeNetworkCloseOutBad TradeType = vega.Trade_TYPE_NETWORK_CLOSE_OUT_BAD
+	// Trading negotiated off the book through a request for quote.
+	TradeTypeBlock TradeType = vega.Trade_TYPE_BLOCK
+	// Trading initiated by the network with a profitable party off the book, to absorb the network's position.
+	TradeTypeAutoDeleveraging TradeType = vega.Trade_TYPE_AUTO_DELEVERAGING
 )
 
 type PeggedReference = vega.PeggedReference
@@ -97,6 +101,10 @@ const (
 	PeggedReferenceBestBid PeggedReference = vega.PeggedReference_PEGGED_REFERENCE_BEST_BID
 	// Best ask price reference.
 	PeggedReferenceBestAsk PeggedReference = vega.PeggedReference_PEGGED_REFERENCE_BEST_ASK
+	// Mark price reference.
+	PeggedReferenceMarkPrice PeggedReference = vega.PeggedReference_PEGGED_REFERENCE_MARK_PRICE
+	// Index price reference.
+	PeggedReferenceIndexPrice PeggedReference = vega.PeggedReference_PEGGED_REFERENCE_INDEX_PRICE
 )
 
 type OrderStatus = vega.Order_Status
@@ -154,6 +162,21 @@ const (
 	OrderTimeInForceGFN OrderTimeInForce = vega.Order_TIME_IN_FORCE_GFN
 )
 
+type OrderSelfTradePrevention = vega.Order_SelfTradePrevention
+
+const (
+	// Default value, the aggressive order is stopped before trading with the party's own order.
+	OrderSelfTradePreventionUnspecified OrderSelfTradePrevention = vega.Order_SELF_TRADE_PREVENTION_UNSPECIFIED
+	// The aggressive order is stopped.
+	OrderSelfTradePreventionCancelNewest OrderSelfTradePrevention = vega.Order_SELF_TRADE_PREVENTION_CANCEL_NEWEST
+	// The resting order is cancelled.
+	OrderSelfTradePreventionCancelOldest OrderSelfTradePrevention = vega.Order_SELF_TRADE_PREVENTION_CANCEL_OLDEST
+	// Both orders are cancelled.
+	OrderSelfTradePreventionCancelBoth OrderSelfTradePrevention = vega.Order_SELF_TRADE_PREVENTION_CANCEL_BOTH
+	// The smaller order is cancelled and the larger one decremented.
+	OrderSelfTradePreventionDecrementAndCancel OrderSelfTradePrevention = vega.Order_SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL
+)
+
 type OrderError = vega.OrderError
 
 const (
@@ -271,6 +294,8 @@ const (
 	Recurring
 	GovernanceOneOff
 	GovernanceRecurring
+	Streaming
+	Conditional
 )
 
 const (
@@ -278,6 +303,8 @@ const (
 	RecurringStr           = "Recurring"
 	GovernanceOneOffStr    = "GovernanceOneOff"
 	GovernanceRecurringStr = "GovernanceRecurring"
+	StreamingStr           = "Streaming"
+	ConditionalStr         = "Conditional"
 	UnknownStr             = "Unknown"
 )
 
@@ -292,6 +319,10 @@ func (m TransferType) EncodeText(_ *pgtype.ConnInfo, buf []byte) ([]byte, error)
 		mode = GovernanceOneOffStr
 	case GovernanceRecurring:
 		mode = GovernanceRecurringStr
+	case Streaming:
+		mode = StreamingStr
+	case Conditional:
+		mode = ConditionalStr
 	}
 
 	return append(buf, []byte(mode)...), nil
@@ -308,6 +339,10 @@ func (m *TransferType) DecodeText(_ *pgtype.ConnInfo, src []byte) error {
 		val = GovernanceOneOff
 	case GovernanceRecurringStr:
 		val = GovernanceRecurring
+	case StreamingStr:
+		val = Streaming
+	case ConditionalStr:
+		val = Conditional
 	}
 
 	*m = val
@@ -445,6 +480,34 @@ func (s *MarketState) DecodeText(_ *pgtype.ConnInfo, src []byte) error {
 	return nil
 }
 
+type MatchingAlgorithm vega.MatchingAlgorithm
+
+const (
+	MatchingAlgorithmUnspecified      = MatchingAlgorithm(vega.MatchingAlgorithm_MATCHING_ALGORITHM_UNSPECIFIED)
+	MatchingAlgorithmPriceTime        = MatchingAlgorithm(vega.MatchingAlgorithm_MATCHING_ALGORITHM_PRICE_TIME)
+	MatchingAlgorithmProRata          = MatchingAlgorithm(vega.MatchingAlgorithm_MATCHING_ALGORITHM_PRO_RATA)
+	MatchingAlgorithmProRataTopOfBook = MatchingAlgorithm(vega.MatchingAlgorithm_MATCHING_ALGORITHM_PRO_RATA_TOP_OF_BOOK)
+)
+
+func (m MatchingAlgorithm) EncodeText(_ *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	algorithm, ok := vega.MatchingAlgorithm_name[int32(m)]
+	if !ok {
+		return buf, fmt.Errorf("unknown matching algorithm: %v", m)
+	}
+	return append(buf, []byte(algorithm)...), nil
+}
+
+func (m *MatchingAlgorithm) DecodeText(_ *pgtype.ConnInfo, src []byte) error {
+	val, ok := vega.MatchingAlgorithm_value[string(src)]
+	if !ok {
+		return fmt.Errorf("unknown matching algorithm: %s", src)
+	}
+
+	*m = MatchingAlgorithm(val)
+
+	return nil
+}
+
 type DepositStatus vega.Deposit_Status
 
 const (
@@ -972,6 +1035,7 @@ const (
 	StopOrderRejectionReasonOCONotAllowedSameExpiryTime  = StopOrderRejectionReason(vega.StopOrder_REJECTION_REASON_STOP_ORDER_CANNOT_MATCH_OCO_EXPIRY_TIMES)
 	StopOrderRejectionSizeOverrideUnSupportedForSpot     = StopOrderRejectionReason(vega.StopOrder_REJECTION_REASON_STOP_ORDER_SIZE_OVERRIDE_UNSUPPORTED_FOR_SPOT)
 	StopOrderRejectionLinkedPercentageInvalid            = StopOrderRejectionReason(vega.StopOrder_REJECTION_REASON_STOP_ORDER_LINKED_PERCENTAGE_INVALID)
+	StopOrderRejectionTriggerReferenceUnsupported        = StopOrderRejectionReason(vega.StopOrder_REJECTION_REASON_STOP_ORDER_TRIGGER_REFERENCE_UNSUPPORTED)
 )
 
 func (s StopOrderRejectionReason) EncodeText(_ *pgtype.ConnInfo, buf []byte) ([]byte, error) {
@@ -1114,6 +1178,7 @@ const (
 	AMMStatusReasonMarketClosed          = AMMStatusReason(eventspb.AMM_STATUS_REASON_MARKET_CLOSED)
 	AMMStatusReasonCommitmentTooLow      = AMMStatusReason(eventspb.AMM_STATUS_REASON_COMMITMENT_TOO_LOW)
 	AMMStatusReasonCannotRebase          = AMMStatusReason(eventspb.AMM_STATUS_REASON_CANNOT_REBASE)
+	AMMStatusReasonPositionLimitExceeded = AMMStatusReason(eventspb.AMM_STATUS_REASON_POSITION_LIMIT_EXCEEDED)
 )
 
 func (s AMMStatusReason) EncodeText(_ *pgtype.ConnInfo, buf []byte) ([]byte, error) {
@@ -1133,6 +1198,85 @@ func (s *AMMStatusReason) DecodeText(_ *pgtype.ConnInfo, src []byte) error {
 	return nil
 }
 
+type QuoteRequestStatus vega.QuoteRequest_Status
+
+const (
+	QuoteRequestStatusUnspecified = QuoteRequestStatus(vega.QuoteRequest_STATUS_UNSPECIFIED)
+	QuoteRequestStatusOpen        = QuoteRequestStatus(vega.QuoteRequest_STATUS_OPEN)
+	QuoteRequestStatusFilled      = QuoteRequestStatus(vega.QuoteRequest_STATUS_FILLED)
+	QuoteRequestStatusCancelled   = QuoteRequestStatus(vega.QuoteRequest_STATUS_CANCELLED)
+	QuoteRequestStatusExpired     = QuoteRequestStatus(vega.QuoteRequest_STATUS_EXPIRED)
+)
+
+func (s QuoteRequestStatus) EncodeText(_ *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	status, ok := vega.QuoteRequest_Status_name[int32(s)]
+	if !ok {
+		return buf, fmt.Errorf("unknown request for quote status: %v", s)
+	}
+	return append(buf, []byte(status)...), nil
+}
+
+func (s *QuoteRequestStatus) DecodeText(_ *pgtype.ConnInfo, src []byte) error {
+	val, ok := vega.QuoteRequest_Status_value[string(src)]
+	if !ok {
+		return fmt.Errorf("unknown request for quote status: %s", src)
+	}
+	*s = QuoteRequestStatus(val)
+	return nil
+}
+
+type QuoteStatus vega.Quote_Status
+
+const (
+	QuoteStatusUnspecified = QuoteStatus(vega.Quote_STATUS_UNSPECIFIED)
+	QuoteStatusActive      = QuoteStatus(vega.Quote_STATUS_ACTIVE)
+	QuoteStatusAccepted    = QuoteStatus(vega.Quote_STATUS_ACCEPTED)
+	QuoteStatusReplaced    = QuoteStatus(vega.Quote_STATUS_REPLACED)
+	QuoteStatusCancelled   = QuoteStatus(vega.Quote_STATUS_CANCELLED)
+	QuoteStatusExpired     = QuoteStatus(vega.Quote_STATUS_EXPIRED)
+	QuoteStatusRejected    = QuoteStatus(vega.Quote_STATUS_REJECTED)
+)
+
+func (s QuoteStatus) EncodeText(_ *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	status, ok := vega.Quote_Status_name[int32(s)]
+	if !ok {
+		return buf, fmt.Errorf("unknown quote status: %v", s)
+	}
+	return append(buf, []byte(status)...), nil
+}
+
+func (s *QuoteStatus) DecodeText(_ *pgtype.ConnInfo, src []byte) error {
+	val, ok := vega.Quote_Status_value[string(src)]
+	if !ok {
+		return fmt.Errorf("unknown quote status: %s", src)
+	}
+	*s = QuoteStatus(val)
+	return nil
+}
+
+// DelegationProposalType is the proposal type a governance delegation is scoped to.
+type DelegationProposalType vega.ProposalType
+
+// DelegationProposalTypeAll scopes a governance delegation to all proposal types.
+const DelegationProposalTypeAll = DelegationProposalType(vega.ProposalType_PROPOSAL_TYPE_UNSPECIFIED)
+
+func (t DelegationProposalType) EncodeText(_ *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	proposalType, ok := vega.ProposalType_name[int32(t)]
+	if !ok {
+		return buf, fmt.Errorf("unknown proposal type: %v", t)
+	}
+	return append(buf, []byte(proposalType)...), nil
+}
+
+func (t *DelegationProposalType) DecodeText(_ *pgtype.ConnInfo, src []byte) error {
+	val, ok := vega.ProposalType_value[string(src)]
+	if !ok {
+		return fmt.Errorf("unknown proposal type: %s", src)
+	}
+	*t = DelegationProposalType(val)
+	return nil
+}
+
 type ProtoEnum interface {
 	GetEnums() map[int32]string
 }
@@ -0,0 +1,100 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package entities
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"code.vegaprotocol.io/vega/libs/ptr"
+	v2 "code.vegaprotocol.io/vega/protos/data-node/api/v2"
+	"code.vegaprotocol.io/vega/protos/vega"
+)
+
+// GovernanceDelegation is an edge of the governance delegation graph. A delegation
+// with an unspecified proposal type applies to all proposal types.
+type GovernanceDelegation struct {
+	Delegator    PartyID
+	ProposalType DelegationProposalType
+	Delegate     PartyID
+	TxHash       TxHash
+	VegaTime     time.Time
+}
+
+func GovernanceDelegationFromProto(d *vega.GovernanceDelegation, txHash TxHash, vegaTime time.Time) GovernanceDelegation {
+	return GovernanceDelegation{
+		Delegator:    PartyID(d.Delegator),
+		ProposalType: DelegationProposalType(d.GetProposalType()),
+		Delegate:     PartyID(d.Delegate),
+		TxHash:       txHash,
+		VegaTime:     vegaTime,
+	}
+}
+
+// IsRevoked returns whether the delegation has been revoked, in which case it is
+// no longer part of the delegation graph.
+func (d GovernanceDelegation) IsRevoked() bool {
+	return d.Delegate == ""
+}
+
+func (d GovernanceDelegation) Cursor() *Cursor {
+	dc := GovernanceDelegationCursor{
+		Delegator:    d.Delegator,
+		ProposalType: d.ProposalType,
+	}
+	return NewCursor(dc.String())
+}
+
+func (d GovernanceDelegation) ToProto() *vega.GovernanceDelegation {
+	var proposalType *vega.ProposalType
+	if d.ProposalType != DelegationProposalTypeAll {
+		proposalType = ptr.From(vega.ProposalType(d.ProposalType))
+	}
+	return &vega.GovernanceDelegation{
+		Delegator:    d.Delegator.String(),
+		Delegate:     d.Delegate.String(),
+		ProposalType: proposalType,
+		UpdatedAt:    d.VegaTime.UnixNano(),
+	}
+}
+
+func (d GovernanceDelegation) ToProtoEdge(_ ...any) (*v2.GovernanceDelegationEdge, error) {
+	return &v2.GovernanceDelegationEdge{
+		Node:   d.ToProto(),
+		Cursor: d.Cursor().Encode(),
+	}, nil
+}
+
+type GovernanceDelegationCursor struct {
+	Delegator    PartyID
+	ProposalType DelegationProposalType
+}
+
+func (dc GovernanceDelegationCursor) String() string {
+	bs, err := json.Marshal(dc)
+	if err != nil {
+		panic(fmt.Errorf("could not marshal governance delegation cursor: %v", err))
+	}
+	return string(bs)
+}
+
+func (dc *GovernanceDelegationCursor) Parse(cursorString string) error {
+	if cursorString == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(cursorString), dc)
+}
@@ -71,6 +71,11 @@ type Market struct {
 	MarkPriceConfiguration *CompositePriceConfiguration
 	TickSize               *decimal.Decimal
 	EnableTXReordering     bool
+	FrequentBatchAuction   *FrequentBatchAuctionParameters
+	PositionLimits         *PositionLimits
+	MatchingAlgorithm      MatchingAlgorithm
+	// PredictionID is set on the outcome markets of a prediction market, to group them.
+	PredictionID MarketID
 }
 
 func (m *Market) HasCap() (cap *vega.FutureCap, hasCap bool) {
@@ -180,6 +185,13 @@ func NewMarketFromProto(market *vega.Market, txHash TxHash, vegaTime time.Time)
 		parentMarketID = parent
 	}
 
+	predictionID := MarketID("")
+	if inst := market.TradableInstrument.GetInstrument(); inst != nil {
+		if outcome := inst.GetFuture().GetPredictionOutcome(); outcome != nil {
+			predictionID = MarketID(outcome.PredictionId)
+		}
+	}
+
 	var insurancePoolFraction *num.Decimal
 	if market.InsurancePoolFraction != nil && *market.InsurancePoolFraction != "" {
 		insurance, err := num.DecimalFromString(*market.InsurancePoolFraction)
@@ -227,6 +239,10 @@ func NewMarketFromProto(market *vega.Market, txHash TxHash, vegaTime time.Time)
 		MarkPriceConfiguration:        mpc,
 		TickSize:                      &tickSize,
 		EnableTXReordering:            market.EnableTransactionReordering,
+		FrequentBatchAuction:          FrequentBatchAuctionParametersFromProto(market.FrequentBatchAuction),
+		PositionLimits:                PositionLimitsFromProto(market.PositionLimits),
+		MatchingAlgorithm:             MatchingAlgorithm(market.MatchingAlgorithm),
+		PredictionID:                  predictionID,
 	}, nil
 }
 
@@ -294,6 +310,9 @@ func (m Market) ToProto() *vega.Market {
 		MarkPriceConfiguration:        m.MarkPriceConfiguration.CompositePriceConfiguration,
 		TickSize:                      m.TickSize.String(),
 		EnableTransactionReordering:   m.EnableTXReordering,
+		FrequentBatchAuction:          m.FrequentBatchAuction.IntoProto(),
+		PositionLimits:                m.PositionLimits.IntoProto(),
+		MatchingAlgorithm:             vega.MatchingAlgorithm(m.MatchingAlgorithm),
 	}
 }
 
@@ -381,12 +400,64 @@ func (cpc CompositePriceConfiguration) ToProto() *vega.CompositePriceConfigurati
 	return cpc.CompositePriceConfiguration
 }
 
+type FrequentBatchAuctionParameters struct {
+	BatchDuration int64 `json:"batchDuration"`
+}
+
+func FrequentBatchAuctionParametersFromProto(fba *vega.FrequentBatchAuctionParameters) *FrequentBatchAuctionParameters {
+	if fba == nil {
+		return nil
+	}
+	return &FrequentBatchAuctionParameters{
+		BatchDuration: fba.BatchDuration,
+	}
+}
+
+func (f *FrequentBatchAuctionParameters) IntoProto() *vega.FrequentBatchAuctionParameters {
+	if f == nil {
+		return nil
+	}
+	return &vega.FrequentBatchAuctionParameters{
+		BatchDuration: f.BatchDuration,
+	}
+}
+
+type PositionLimits struct {
+	MaxPositionSize     uint64 `json:"maxPositionSize"`
+	MaxPositionNotional string `json:"maxPositionNotional"`
+	MaxOpenInterest     uint64 `json:"maxOpenInterest"`
+}
+
+func PositionLimitsFromProto(pl *vega.PositionLimits) *PositionLimits {
+	if pl == nil {
+		return nil
+	}
+	return &PositionLimits{
+		MaxPositionSize:     pl.MaxPositionSize,
+		MaxPositionNotional: pl.MaxPositionNotional,
+		MaxOpenInterest:     pl.MaxOpenInterest,
+	}
+}
+
+func (p *PositionLimits) IntoProto() *vega.PositionLimits {
+	if p == nil {
+		return nil
+	}
+	return &vega.PositionLimits{
+		MaxPositionSize:     p.MaxPositionSize,
+		MaxPositionNotional: p.MaxPositionNotional,
+		MaxOpenInterest:     p.MaxOpenInterest,
+	}
+}
+
 type LiquidationStrategy struct {
-	DisposalTimeStep    time.Duration `json:"disposalTimeStep"`
-	DisposalFraction    num.Decimal   `json:"disposalFraction"`
-	FullDisposalSize    uint64        `json:"fullDisposalSize"`
-	MaxFractionConsumed num.Decimal   `json:"maxFractionConsumed"`
-	DisposalSlippage    num.Decimal   `json:"disposalSlippageRange"`
+	DisposalTimeStep      time.Duration `json:"disposalTimeStep"`
+	DisposalFraction      num.Decimal   `json:"disposalFraction"`
+	FullDisposalSize      uint64        `json:"fullDisposalSize"`
+	MaxFractionConsumed   num.Decimal   `json:"maxFractionConsumed"`
+	DisposalSlippage      num.Decimal   `json:"disposalSlippageRange"`
+	PartialCloseOutStep   num.Decimal   `json:"partialCloseOutStep"`
+	PartialCloseOutBuffer num.Decimal   `json:"partialCloseOutBuffer"`
 }
 
 func LiquidationStrategyFromProto(ls *vega.LiquidationStrategy) LiquidationStrategy {
@@ -399,22 +470,41 @@ func LiquidationStrategyFromProto(ls *vega.LiquidationStrategy) LiquidationStrat
 	if len(ls.DisposalSlippageRange) > 0 {
 		slip, _ = num.DecimalFromString(ls.DisposalSlippageRange)
 	}
+	step, buffer := num.DecimalZero(), num.DecimalZero()
+	if len(ls.PartialCloseOutStep) > 0 {
+		step, _ = num.DecimalFromString(ls.PartialCloseOutStep)
+	}
+	if len(ls.PartialCloseOutBuffer) > 0 {
+		buffer, _ = num.DecimalFromString(ls.PartialCloseOutBuffer)
+	}
 	return LiquidationStrategy{
-		DisposalTimeStep:    time.Duration(ls.DisposalTimeStep) * time.Second,
-		FullDisposalSize:    ls.FullDisposalSize,
-		DisposalFraction:    df,
-		MaxFractionConsumed: mfc,
-		DisposalSlippage:    slip,
+		DisposalTimeStep:      time.Duration(ls.DisposalTimeStep) * time.Second,
+		FullDisposalSize:      ls.FullDisposalSize,
+		DisposalFraction:      df,
+		MaxFractionConsumed:   mfc,
+		DisposalSlippage:      slip,
+		PartialCloseOutStep:   step,
+		PartialCloseOutBuffer: buffer,
 	}
 }
 
 func (l LiquidationStrategy) IntoProto() *vega.LiquidationStrategy {
+	// partial close-outs are optional, leave them unset when not configured
+	var step, buffer string
+	if !l.PartialCloseOutStep.IsZero() {
+		step = l.PartialCloseOutStep.String()
+	}
+	if !l.PartialCloseOutBuffer.IsZero() {
+		buffer = l.PartialCloseOutBuffer.String()
+	}
 	return &vega.LiquidationStrategy{
 		DisposalTimeStep:      int64(l.DisposalTimeStep / time.Second),
 		DisposalFraction:      l.DisposalFraction.String(),
 		FullDisposalSize:      l.FullDisposalSize,
 		MaxFractionConsumed:   l.MaxFractionConsumed.String(),
 		DisposalSlippageRange: l.DisposalSlippage.String(),
+		PartialCloseOutStep:   step,
+		PartialCloseOutBuffer: buffer,
 	}
 }
 
@@ -481,12 +571,34 @@ func liquidityMonitoringParametersFromProto(lmp *vega.LiquidityMonitoringParamet
 }
 
 type PriceMonitoringParameters struct {
-	Triggers []*PriceMonitoringTrigger `json:"triggers,omitempty"`
+	Triggers        []*PriceMonitoringTrigger       `json:"triggers,omitempty"`
+	IndexDivergence *PriceMonitoringIndexDivergence `json:"indexDivergence,omitempty"`
+}
+
+type PriceMonitoringIndexDivergence struct {
+	MaxDivergence    decimal.Decimal `json:"maxDivergence"`
+	AuctionExtension uint64          `json:"auctionExtension"`
+}
+
+func (d PriceMonitoringIndexDivergence) ToProto() *vega.PriceMonitoringIndexDivergence {
+	return &vega.PriceMonitoringIndexDivergence{
+		MaxDivergence:    d.MaxDivergence.String(),
+		AuctionExtension: int64(d.AuctionExtension),
+	}
 }
 
 func priceMonitoringParametersFromProto(pmp *vega.PriceMonitoringParameters) PriceMonitoringParameters {
+	var indexDivergence *PriceMonitoringIndexDivergence
+	if pmp.IndexDivergence != nil {
+		maxDivergence, _ := decimal.NewFromString(pmp.IndexDivergence.MaxDivergence)
+		indexDivergence = &PriceMonitoringIndexDivergence{
+			MaxDivergence:    maxDivergence,
+			AuctionExtension: uint64(pmp.IndexDivergence.AuctionExtension),
+		}
+	}
+
 	if len(pmp.Triggers) == 0 {
-		return PriceMonitoringParameters{}
+		return PriceMonitoringParameters{IndexDivergence: indexDivergence}
 	}
 
 	triggers := make([]*PriceMonitoringTrigger, 0, len(pmp.Triggers))
@@ -501,7 +613,8 @@ func priceMonitoringParametersFromProto(pmp *vega.PriceMonitoringParameters) Pri
 	}
 
 	return PriceMonitoringParameters{
-		Triggers: triggers,
+		Triggers:        triggers,
+		IndexDivergence: indexDivergence,
 	}
 }
 
@@ -521,9 +634,15 @@ func (s PriceMonitoringSettings) ToProto() *vega.PriceMonitoringSettings {
 		}
 	}
 
+	var indexDivergence *vega.PriceMonitoringIndexDivergence
+	if s.Parameters.IndexDivergence != nil {
+		indexDivergence = s.Parameters.IndexDivergence.ToProto()
+	}
+
 	return &vega.PriceMonitoringSettings{
 		Parameters: &vega.PriceMonitoringParameters{
-			Triggers: triggers,
+			Triggers:        triggers,
+			IndexDivergence: indexDivergence,
 		},
 	}
 }
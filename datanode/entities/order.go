@@ -61,6 +61,12 @@ type Order struct {
 	PostOnly        bool
 	ReduceOnly      bool
 
+	SelfTradePrevention OrderSelfTradePrevention
+
+	// Slippage fields, in basis points, only set for market orders submitted with a maximum slippage
+	MaxSlippage      *int64
+	AchievedSlippage *int64
+
 	// Iceberg fields
 	ReservedRemaining  *int64
 	PeakSize           *int64
@@ -113,6 +119,13 @@ func (o Order) ToProto() *vega.Order {
 		PostOnly:             o.PostOnly,
 		ReduceOnly:           o.ReduceOnly,
 		IcebergOrder:         icebergOrder,
+		SelfTradePrevention:  o.SelfTradePrevention,
+	}
+	if o.MaxSlippage != nil {
+		vo.MaxSlippage = ptr.From(uint64(*o.MaxSlippage))
+	}
+	if o.AchievedSlippage != nil {
+		vo.AchievedSlippage = ptr.From(uint64(*o.AchievedSlippage))
 	}
 	return &vo
 }
@@ -188,34 +201,51 @@ func OrderFromProto(po *vega.Order, seqNum uint64, txHash TxHash) (Order, error)
 		MinimumVisibleSize = ptr.From(int64(po.IcebergOrder.MinimumVisibleSize))
 	}
 
+	var maxSlippage, achievedSlippage *int64
+	if po.MaxSlippage != nil {
+		if *po.MaxSlippage > math.MaxInt64 {
+			return Order{}, fmt.Errorf("max slippage is larger than a 64-bit integer: %v", *po.MaxSlippage)
+		}
+		maxSlippage = ptr.From(int64(*po.MaxSlippage))
+	}
+	if po.AchievedSlippage != nil {
+		if *po.AchievedSlippage > math.MaxInt64 {
+			return Order{}, fmt.Errorf("achieved slippage is larger than a 64-bit integer: %v", *po.AchievedSlippage)
+		}
+		achievedSlippage = ptr.From(int64(*po.AchievedSlippage))
+	}
+
 	o := Order{
-		ID:                 OrderID(po.Id),
-		MarketID:           MarketID(po.MarketId),
-		PartyID:            PartyID(po.PartyId),
-		Side:               po.Side,
-		Price:              price,
-		Size:               size,
-		Remaining:          remaining,
-		TimeInForce:        po.TimeInForce,
-		Type:               po.Type,
-		Status:             po.Status,
-		Reference:          po.Reference,
-		Reason:             reason,
-		Version:            version,
-		PeggedOffset:       peggedOffset,
-		BatchID:            batchID,
-		PeggedReference:    peggedReference,
-		LpID:               lpID,
-		CreatedAt:          NanosToPostgresTimestamp(po.CreatedAt),
-		UpdatedAt:          NanosToPostgresTimestamp(po.UpdatedAt),
-		ExpiresAt:          NanosToPostgresTimestamp(po.ExpiresAt),
-		SeqNum:             seqNum,
-		TxHash:             txHash,
-		PostOnly:           po.PostOnly,
-		ReduceOnly:         po.ReduceOnly,
-		ReservedRemaining:  reservedRemaining,
-		PeakSize:           PeakSize,
-		MinimumVisibleSize: MinimumVisibleSize,
+		ID:                  OrderID(po.Id),
+		MarketID:            MarketID(po.MarketId),
+		PartyID:             PartyID(po.PartyId),
+		Side:                po.Side,
+		Price:               price,
+		Size:                size,
+		Remaining:           remaining,
+		TimeInForce:         po.TimeInForce,
+		Type:                po.Type,
+		Status:              po.Status,
+		Reference:           po.Reference,
+		Reason:              reason,
+		Version:             version,
+		PeggedOffset:        peggedOffset,
+		BatchID:             batchID,
+		PeggedReference:     peggedReference,
+		LpID:                lpID,
+		CreatedAt:           NanosToPostgresTimestamp(po.CreatedAt),
+		UpdatedAt:           NanosToPostgresTimestamp(po.UpdatedAt),
+		ExpiresAt:           NanosToPostgresTimestamp(po.ExpiresAt),
+		SeqNum:              seqNum,
+		TxHash:              txHash,
+		PostOnly:            po.PostOnly,
+		ReduceOnly:          po.ReduceOnly,
+		ReservedRemaining:   reservedRemaining,
+		PeakSize:            PeakSize,
+		MinimumVisibleSize:  MinimumVisibleSize,
+		SelfTradePrevention: po.SelfTradePrevention,
+		MaxSlippage:         maxSlippage,
+		AchievedSlippage:    achievedSlippage,
 	}
 
 	return o, nil
@@ -238,7 +268,7 @@ func (o Order) ToRow() []interface{} {
 		o.Reference, o.Reason, o.Version, o.PeggedOffset, o.BatchID,
 		o.PeggedReference, o.LpID, o.CreatedAt, o.UpdatedAt, o.ExpiresAt,
 		o.TxHash, o.VegaTime, o.SeqNum, o.PostOnly, o.ReduceOnly, o.ReservedRemaining,
-		o.PeakSize, o.MinimumVisibleSize,
+		o.PeakSize, o.MinimumVisibleSize, o.SelfTradePrevention, o.MaxSlippage, o.AchievedSlippage,
 	}
 }
 
@@ -248,7 +278,7 @@ var OrderColumns = []string{
 	"reference", "reason", "version", "pegged_offset", "batch_id",
 	"pegged_reference", "lp_id", "created_at", "updated_at", "expires_at",
 	"tx_hash", "vega_time", "seq_num", "post_only", "reduce_only", "reserved_remaining",
-	"peak_size", "minimum_visible_size",
+	"peak_size", "minimum_visible_size", "self_trade_prevention", "max_slippage", "achieved_slippage",
 }
 
 type OrderCursor struct {
@@ -142,7 +142,7 @@ func (p *Position) UpdateWithTrade(trade vega.Trade, seller bool, pf num.Decimal
 	p.PendingAverageEntryMarketPrice = updateVWAP(p.PendingAverageEntryMarketPrice, p.PendingOpenVolume, opened, marketPriceUint)
 	p.PendingOpenVolume += opened
 	p.pendingMTM(assetPrice, pf)
-	if trade.Type == types.TradeTypeNetworkCloseOutBad {
+	if trade.Type == types.TradeTypeNetworkCloseOutBad || trade.Type == types.TradeTypeAutoDeleveraging {
 		p.updateWithBadTrade(trade, seller, pf)
 	}
 }
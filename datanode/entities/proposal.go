@@ -98,6 +98,7 @@ type Proposal struct {
 	Rationale               ProposalRationale
 	Terms                   ProposalTerms
 	BatchTerms              BatchProposalTerms
+	PollResult              ProposalPollResult
 	Reason                  ProposalError
 	ErrorDetails            string
 	ProposalTime            time.Time
@@ -153,6 +154,7 @@ func (p Proposal) ToProto() *vega.Proposal {
 		Timestamp:                              p.ProposalTime.UnixNano(),
 		Terms:                                  p.Terms.ProposalTerms,
 		BatchTerms:                             p.BatchTerms.BatchProposalTerms,
+		PollResult:                             p.PollResult.PollResult,
 		Reason:                                 reason,
 		ErrorDetails:                           errDetails,
 		RequiredMajority:                       p.RequiredMajority.String(),
@@ -241,6 +243,7 @@ func ProposalFromProto(pp *vega.Proposal, txHash TxHash) (Proposal, error) {
 		Rationale:               ProposalRationale{pp.Rationale},
 		Terms:                   ProposalTerms{pp.GetTerms()},
 		BatchTerms:              BatchProposalTerms{pp.GetBatchTerms()},
+		PollResult:              ProposalPollResult{pp.GetPollResult()},
 		Reason:                  reason,
 		ErrorDetails:            errDetails,
 		ProposalTime:            time.Unix(0, pp.Timestamp),
@@ -308,6 +311,27 @@ func (pt *BatchProposalTerms) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+type ProposalPollResult struct {
+	*vega.PollResult
+}
+
+func (pr ProposalPollResult) MarshalJSON() ([]byte, error) {
+	return protojson.Marshal(pr)
+}
+
+func (pr *ProposalPollResult) UnmarshalJSON(b []byte) error {
+	pr.PollResult = &vega.PollResult{}
+	if err := protojson.Unmarshal(b, pr); err != nil {
+		return err
+	}
+
+	if len(pr.PollResult.Tallies) == 0 {
+		pr.PollResult = nil
+	}
+
+	return nil
+}
+
 type ProposalCursor struct {
 	State    ProposalState `json:"state"`
 	VegaTime time.Time     `json:"vega_time"`
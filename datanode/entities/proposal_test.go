@@ -18,9 +18,12 @@ package entities
 import (
 	"testing"
 
+	"code.vegaprotocol.io/vega/libs/ptr"
 	v2 "code.vegaprotocol.io/vega/protos/data-node/api/v2"
+	"code.vegaprotocol.io/vega/protos/vega"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestProposalType_String(t *testing.T) {
@@ -69,3 +72,35 @@ func TestProposalType_String(t *testing.T) {
 		})
 	}
 }
+
+func TestProposalPollResultJSON(t *testing.T) {
+	t.Run("empty poll result unmarshals to nil", func(t *testing.T) {
+		empty := ProposalPollResult{}
+		b, err := empty.MarshalJSON()
+		require.NoError(t, err)
+
+		var got ProposalPollResult
+		require.NoError(t, got.UnmarshalJSON(b))
+		assert.Nil(t, got.PollResult)
+	})
+
+	t.Run("poll result round trips", func(t *testing.T) {
+		pr := ProposalPollResult{
+			PollResult: &vega.PollResult{
+				Tallies: []*vega.PollOptionTally{
+					{Weight: "40"},
+					{Weight: "60"},
+					{Weight: "25", EliminatedInRound: 2},
+				},
+				WinningOption: ptr.From(uint32(1)),
+				Rounds:        3,
+			},
+		}
+		b, err := pr.MarshalJSON()
+		require.NoError(t, err)
+
+		var got ProposalPollResult
+		require.NoError(t, got.UnmarshalJSON(b))
+		assert.Equal(t, pr.PollResult.String(), got.PollResult.String())
+	})
+}
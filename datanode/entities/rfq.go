@@ -0,0 +1,228 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package entities
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"code.vegaprotocol.io/vega/libs/num"
+	v2 "code.vegaprotocol.io/vega/protos/data-node/api/v2"
+	"code.vegaprotocol.io/vega/protos/vega"
+)
+
+type (
+	_QuoteRequest struct{}
+	_Quote        struct{}
+)
+
+type (
+	QuoteRequestID = ID[_QuoteRequest]
+	QuoteID        = ID[_Quote]
+)
+
+type QuoteRequest struct {
+	ID              QuoteRequestID
+	MarketID        MarketID
+	PartyID         PartyID
+	Side            Side
+	Size            uint64
+	Status          QuoteRequestStatus
+	Reference       string
+	AcceptedQuoteID *QuoteID
+	TradeID         *TradeID
+	CreatedAt       time.Time
+	ExpiresAt       time.Time
+	UpdatedAt       time.Time
+	TxHash          TxHash
+	VegaTime        time.Time
+}
+
+func QuoteRequestFromProto(qr *vega.QuoteRequest, txHash TxHash, vegaTime time.Time) QuoteRequest {
+	var acceptedQuoteID *QuoteID
+	if len(qr.AcceptedQuoteId) > 0 {
+		id := QuoteID(qr.AcceptedQuoteId)
+		acceptedQuoteID = &id
+	}
+
+	var tradeID *TradeID
+	if len(qr.TradeId) > 0 {
+		id := TradeID(qr.TradeId)
+		tradeID = &id
+	}
+
+	return QuoteRequest{
+		ID:              QuoteRequestID(qr.Id),
+		MarketID:        MarketID(qr.MarketId),
+		PartyID:         PartyID(qr.PartyId),
+		Side:            qr.Side,
+		Size:            qr.Size,
+		Status:          QuoteRequestStatus(qr.Status),
+		Reference:       qr.Reference,
+		AcceptedQuoteID: acceptedQuoteID,
+		TradeID:         tradeID,
+		CreatedAt:       NanosToPostgresTimestamp(qr.CreatedAt),
+		ExpiresAt:       NanosToPostgresTimestamp(qr.ExpiresAt),
+		UpdatedAt:       NanosToPostgresTimestamp(qr.UpdatedAt),
+		TxHash:          txHash,
+		VegaTime:        vegaTime,
+	}
+}
+
+func (qr QuoteRequest) ToProto() *vega.QuoteRequest {
+	var acceptedQuoteID, tradeID string
+	if qr.AcceptedQuoteID != nil {
+		acceptedQuoteID = qr.AcceptedQuoteID.String()
+	}
+	if qr.TradeID != nil {
+		tradeID = qr.TradeID.String()
+	}
+
+	return &vega.QuoteRequest{
+		Id:              qr.ID.String(),
+		MarketId:        qr.MarketID.String(),
+		PartyId:         qr.PartyID.String(),
+		Side:            qr.Side,
+		Size:            qr.Size,
+		Status:          vega.QuoteRequest_Status(qr.Status),
+		CreatedAt:       qr.CreatedAt.UnixNano(),
+		ExpiresAt:       qr.ExpiresAt.UnixNano(),
+		UpdatedAt:       qr.UpdatedAt.UnixNano(),
+		Reference:       qr.Reference,
+		AcceptedQuoteId: acceptedQuoteID,
+		TradeId:         tradeID,
+	}
+}
+
+func (qr QuoteRequest) Cursor() *Cursor {
+	c := QuoteRequestCursor{
+		CreatedAt: qr.CreatedAt,
+		ID:        qr.ID,
+	}
+	return NewCursor(c.String())
+}
+
+func (qr QuoteRequest) ToProtoEdge(_ ...any) (*v2.QuoteRequestEdge, error) {
+	return &v2.QuoteRequestEdge{
+		Node:   qr.ToProto(),
+		Cursor: qr.Cursor().Encode(),
+	}, nil
+}
+
+type QuoteRequestCursor struct {
+	CreatedAt time.Time      `json:"createdAt"`
+	ID        QuoteRequestID `json:"id"`
+}
+
+func (c QuoteRequestCursor) String() string {
+	bs, err := json.Marshal(c)
+	if err != nil {
+		panic(fmt.Errorf("could not marshal request for quote cursor: %v", err))
+	}
+	return string(bs)
+}
+
+func (c *QuoteRequestCursor) Parse(cursorString string) error {
+	if cursorString == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(cursorString), c)
+}
+
+type Quote struct {
+	ID             QuoteID
+	QuoteRequestID QuoteRequestID
+	MarketID       MarketID
+	PartyID        PartyID
+	Price          num.Decimal
+	Status         QuoteStatus
+	CreatedAt      time.Time
+	ExpiresAt      time.Time
+	UpdatedAt      time.Time
+	TxHash         TxHash
+	VegaTime       time.Time
+}
+
+func QuoteFromProto(q *vega.Quote, txHash TxHash, vegaTime time.Time) (Quote, error) {
+	price, err := num.DecimalFromString(q.Price)
+	if err != nil {
+		return Quote{}, fmt.Errorf("invalid quote price: %w", err)
+	}
+
+	return Quote{
+		ID:             QuoteID(q.Id),
+		QuoteRequestID: QuoteRequestID(q.QuoteRequestId),
+		MarketID:       MarketID(q.MarketId),
+		PartyID:        PartyID(q.PartyId),
+		Price:          price,
+		Status:         QuoteStatus(q.Status),
+		CreatedAt:      NanosToPostgresTimestamp(q.CreatedAt),
+		ExpiresAt:      NanosToPostgresTimestamp(q.ExpiresAt),
+		UpdatedAt:      NanosToPostgresTimestamp(q.UpdatedAt),
+		TxHash:         txHash,
+		VegaTime:       vegaTime,
+	}, nil
+}
+
+func (q Quote) ToProto() *vega.Quote {
+	return &vega.Quote{
+		Id:             q.ID.String(),
+		QuoteRequestId: q.QuoteRequestID.String(),
+		MarketId:       q.MarketID.String(),
+		PartyId:        q.PartyID.String(),
+		Price:          q.Price.String(),
+		Status:         vega.Quote_Status(q.Status),
+		CreatedAt:      q.CreatedAt.UnixNano(),
+		ExpiresAt:      q.ExpiresAt.UnixNano(),
+		UpdatedAt:      q.UpdatedAt.UnixNano(),
+	}
+}
+
+func (q Quote) Cursor() *Cursor {
+	c := QuoteCursor{
+		CreatedAt: q.CreatedAt,
+		ID:        q.ID,
+	}
+	return NewCursor(c.String())
+}
+
+func (q Quote) ToProtoEdge(_ ...any) (*v2.QuoteEdge, error) {
+	return &v2.QuoteEdge{
+		Node:   q.ToProto(),
+		Cursor: q.Cursor().Encode(),
+	}, nil
+}
+
+type QuoteCursor struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        QuoteID   `json:"id"`
+}
+
+func (c QuoteCursor) String() string {
+	bs, err := json.Marshal(c)
+	if err != nil {
+		panic(fmt.Errorf("could not marshal quote cursor: %v", err))
+	}
+	return string(bs)
+}
+
+func (c *QuoteCursor) Parse(cursorString string) error {
+	if cursorString == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(cursorString), c)
+}
@@ -53,6 +53,7 @@ type (
 		RejectionReason      StopOrderRejectionReason
 		SizeOverrideSetting  int32
 		SizeOverrideValue    *string
+		TriggerReference     int32
 	}
 )
 
@@ -83,6 +84,7 @@ var StopOrderColumns = []string{
 	"rejection_reason",
 	"size_override_setting",
 	"size_override_value",
+	"trigger_reference",
 }
 
 func (o StopOrder) ToProto() *pbevents.StopOrderEvent {
@@ -147,6 +149,7 @@ func (o StopOrder) ToProto() *pbevents.StopOrderEvent {
 		RejectionReason:     rejectionReason,
 		SizeOverrideSetting: vega.StopOrder_SizeOverrideSetting(o.SizeOverrideSetting),
 		SizeOverrideValue:   sizeOVerrideValue,
+		TriggerReference:    vega.StopOrder_TriggerReference(o.TriggerReference),
 	}
 
 	if triggerPrice != nil {
@@ -279,6 +282,7 @@ func StopOrderFromProto(so *pbevents.StopOrderEvent, vegaTime time.Time, seqNum
 		RejectionReason:      rejectionReason,
 		SizeOverrideSetting:  int32(so.StopOrder.SizeOverrideSetting),
 		SizeOverrideValue:    sizeOverrideValue,
+		TriggerReference:     int32(so.StopOrder.TriggerReference),
 	}
 
 	return stopOrder, nil
@@ -306,6 +310,7 @@ func (so StopOrder) ToRow() []interface{} {
 		so.RejectionReason,
 		so.SizeOverrideSetting,
 		so.SizeOverrideValue,
+		so.TriggerReference,
 	}
 }
 
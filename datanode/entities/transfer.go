@@ -61,6 +61,8 @@ type Transfer struct {
 	DispatchStrategy *vega.DispatchStrategy
 	Reason           *string
 	GameID           GameID
+	Streaming        *eventspb.StreamingTransfer
+	Conditional      *eventspb.ConditionalTransfer
 }
 
 type TransferFees struct {
@@ -159,6 +161,10 @@ func (t *Transfer) ToProto(ctx context.Context, accountSource AccountSource) (*e
 			recurringTransfer.EndEpoch = &endEpoch
 		}
 		proto.Kind = &eventspb.Transfer_RecurringGovernance{RecurringGovernance: recurringTransfer}
+	case Streaming:
+		proto.Kind = &eventspb.Transfer_Streaming{Streaming: t.Streaming}
+	case Conditional:
+		proto.Kind = &eventspb.Transfer_Conditional{Conditional: t.Conditional}
 	case Unknown:
 		// leave Kind as nil
 	}
@@ -280,6 +286,12 @@ func TransferFromProto(ctx context.Context, t *eventspb.Transfer, txHash TxHash,
 			return nil, fmt.Errorf("invalid factor for recurring transfer:%w", err)
 		}
 		transfer.Factor = &factor
+	case *eventspb.Transfer_Streaming:
+		transfer.TransferType = Streaming
+		transfer.Streaming = v.Streaming
+	case *eventspb.Transfer_Conditional:
+		transfer.TransferType = Conditional
+		transfer.Conditional = v.Conditional
 	default:
 		transfer.TransferType = Unknown
 	}
@@ -39,6 +39,7 @@ type Vote struct {
 	TotalGovernanceTokenWeight     decimal.Decimal
 	TotalEquityLikeShareWeight     decimal.Decimal
 	PerMarketEquityLikeShareWeight []PerMarketELSWeight
+	Options                        []uint32
 	InitialTime                    time.Time // First vote for this party/proposal
 	TxHash                         TxHash
 	VegaTime                       time.Time // Time of last vote update
@@ -66,6 +67,7 @@ func (v Vote) ToProto() *vega.Vote {
 		TotalEquityLikeShareWeight:  v.TotalEquityLikeShareWeight.String(),
 		Timestamp:                   v.InitialTime.UnixNano(),
 		ElsPerMarket:                perMarketELSWeight,
+		Options:                     v.Options,
 	}
 }
 
@@ -104,6 +106,7 @@ func VoteFromProto(pv *vega.Vote, txHash TxHash) (Vote, error) {
 		TotalEquityLikeShareWeight:     totalEquityLikeShareWeight,
 		InitialTime:                    NanosToPostgresTimestamp(pv.Timestamp),
 		PerMarketEquityLikeShareWeight: perMarketELSWeight,
+		Options:                        pv.Options,
 		TxHash:                         txHash,
 	}
 
@@ -493,6 +493,26 @@ func UnmarshalInterval(v interface{}) (vega.Interval, error) {
 	return vega.Interval(t), nil
 }
 
+func MarshalMatchingAlgorithm(s vega.MatchingAlgorithm) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		w.Write([]byte(strconv.Quote(s.String())))
+	})
+}
+
+func UnmarshalMatchingAlgorithm(v interface{}) (vega.MatchingAlgorithm, error) {
+	s, ok := v.(string)
+	if !ok {
+		return vega.MatchingAlgorithm_MATCHING_ALGORITHM_UNSPECIFIED, fmt.Errorf("expected matching algorithm to be a string")
+	}
+
+	t, ok := vega.MatchingAlgorithm_value[s]
+	if !ok {
+		return vega.MatchingAlgorithm_MATCHING_ALGORITHM_UNSPECIFIED, fmt.Errorf("failed to convert MatchingAlgorithm from GraphQL to Proto: %v", s)
+	}
+
+	return vega.MatchingAlgorithm(t), nil
+}
+
 func MarshalProposalType(s v2.ListGovernanceDataRequest_Type) graphql.Marshaler {
 	return graphql.WriterFunc(func(w io.Writer) {
 		w.Write([]byte(strconv.Quote(s.String())))
@@ -653,6 +673,24 @@ func UnmarshalStopOrderSizeOverrideSetting(v interface{}) (vega.StopOrder_SizeOv
 	return vega.StopOrder_SizeOverrideSetting(t), nil
 }
 
+func MarshalStopOrderTriggerReference(s vega.StopOrder_TriggerReference) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		w.Write([]byte(strconv.Quote(s.String())))
+	})
+}
+
+func UnmarshalStopOrderTriggerReference(v interface{}) (vega.StopOrder_TriggerReference, error) {
+	s, ok := v.(string)
+	if !ok {
+		return vega.StopOrder_TRIGGER_REFERENCE_UNSPECIFIED, fmt.Errorf("expected stop order trigger reference to be a string")
+	}
+	t, ok := vega.StopOrder_TriggerReference_value[s]
+	if !ok {
+		return vega.StopOrder_TRIGGER_REFERENCE_UNSPECIFIED, fmt.Errorf("failed to convert stop order trigger reference to Proto: %v", s)
+	}
+	return vega.StopOrder_TriggerReference(t), nil
+}
+
 func MarshalFundingPeriodDataPointSource(s eventspb.FundingPeriodDataPoint_Source) graphql.Marshaler {
 	return graphql.WriterFunc(func(w io.Writer) {
 		w.Write([]byte(strconv.Quote(s.String())))
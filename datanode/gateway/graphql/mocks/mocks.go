@@ -340,6 +340,26 @@ func (mr *MockTradingDataServiceClientV2MockRecorder) EstimatePosition(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimatePosition", reflect.TypeOf((*MockTradingDataServiceClientV2)(nil).EstimatePosition), varargs...)
 }
 
+// EstimateProposalImpact mocks base method.
+func (m *MockTradingDataServiceClientV2) EstimateProposalImpact(arg0 context.Context, arg1 *v2.EstimateProposalImpactRequest, arg2 ...grpc.CallOption) (*v2.EstimateProposalImpactResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EstimateProposalImpact", varargs...)
+	ret0, _ := ret[0].(*v2.EstimateProposalImpactResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateProposalImpact indicates an expected call of EstimateProposalImpact.
+func (mr *MockTradingDataServiceClientV2MockRecorder) EstimateProposalImpact(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateProposalImpact", reflect.TypeOf((*MockTradingDataServiceClientV2)(nil).EstimateProposalImpact), varargs...)
+}
+
 // EstimateTransferFee mocks base method.
 func (m *MockTradingDataServiceClientV2) EstimateTransferFee(arg0 context.Context, arg1 *v2.EstimateTransferFeeRequest, arg2 ...grpc.CallOption) (*v2.EstimateTransferFeeResponse, error) {
 	m.ctrl.T.Helper()
@@ -1420,6 +1440,26 @@ func (mr *MockTradingDataServiceClientV2MockRecorder) ListAssets(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssets", reflect.TypeOf((*MockTradingDataServiceClientV2)(nil).ListAssets), varargs...)
 }
 
+// ListAutoDeleveragingRankings mocks base method.
+func (m *MockTradingDataServiceClientV2) ListAutoDeleveragingRankings(arg0 context.Context, arg1 *v2.ListAutoDeleveragingRankingsRequest, arg2 ...grpc.CallOption) (*v2.ListAutoDeleveragingRankingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAutoDeleveragingRankings", varargs...)
+	ret0, _ := ret[0].(*v2.ListAutoDeleveragingRankingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAutoDeleveragingRankings indicates an expected call of ListAutoDeleveragingRankings.
+func (mr *MockTradingDataServiceClientV2MockRecorder) ListAutoDeleveragingRankings(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoDeleveragingRankings", reflect.TypeOf((*MockTradingDataServiceClientV2)(nil).ListAutoDeleveragingRankings), varargs...)
+}
+
 // ListBalanceChanges mocks base method.
 func (m *MockTradingDataServiceClientV2) ListBalanceChanges(arg0 context.Context, arg1 *v2.ListBalanceChangesRequest, arg2 ...grpc.CallOption) (*v2.ListBalanceChangesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1440,6 +1480,26 @@ func (mr *MockTradingDataServiceClientV2MockRecorder) ListBalanceChanges(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBalanceChanges", reflect.TypeOf((*MockTradingDataServiceClientV2)(nil).ListBalanceChanges), varargs...)
 }
 
+// ListBlockTrades mocks base method.
+func (m *MockTradingDataServiceClientV2) ListBlockTrades(arg0 context.Context, arg1 *v2.ListBlockTradesRequest, arg2 ...grpc.CallOption) (*v2.ListBlockTradesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBlockTrades", varargs...)
+	ret0, _ := ret[0].(*v2.ListBlockTradesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlockTrades indicates an expected call of ListBlockTrades.
+func (mr *MockTradingDataServiceClientV2MockRecorder) ListBlockTrades(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlockTrades", reflect.TypeOf((*MockTradingDataServiceClientV2)(nil).ListBlockTrades), varargs...)
+}
+
 // ListCandleData mocks base method.
 func (m *MockTradingDataServiceClientV2) ListCandleData(arg0 context.Context, arg1 *v2.ListCandleDataRequest, arg2 ...grpc.CallOption) (*v2.ListCandleDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -1800,6 +1860,26 @@ func (mr *MockTradingDataServiceClientV2MockRecorder) ListGovernanceData(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGovernanceData", reflect.TypeOf((*MockTradingDataServiceClientV2)(nil).ListGovernanceData), varargs...)
 }
 
+// ListGovernanceDelegations mocks base method.
+func (m *MockTradingDataServiceClientV2) ListGovernanceDelegations(arg0 context.Context, arg1 *v2.ListGovernanceDelegationsRequest, arg2 ...grpc.CallOption) (*v2.ListGovernanceDelegationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGovernanceDelegations", varargs...)
+	ret0, _ := ret[0].(*v2.ListGovernanceDelegationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGovernanceDelegations indicates an expected call of ListGovernanceDelegations.
+func (mr *MockTradingDataServiceClientV2MockRecorder) ListGovernanceDelegations(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGovernanceDelegations", reflect.TypeOf((*MockTradingDataServiceClientV2)(nil).ListGovernanceDelegations), varargs...)
+}
+
 // ListKeyRotations mocks base method.
 func (m *MockTradingDataServiceClientV2) ListKeyRotations(arg0 context.Context, arg1 *v2.ListKeyRotationsRequest, arg2 ...grpc.CallOption) (*v2.ListKeyRotationsResponse, error) {
 	m.ctrl.T.Helper()
@@ -2200,6 +2280,46 @@ func (mr *MockTradingDataServiceClientV2MockRecorder) ListProtocolUpgradeProposa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProtocolUpgradeProposals", reflect.TypeOf((*MockTradingDataServiceClientV2)(nil).ListProtocolUpgradeProposals), varargs...)
 }
 
+// ListQuoteRequests mocks base method.
+func (m *MockTradingDataServiceClientV2) ListQuoteRequests(arg0 context.Context, arg1 *v2.ListQuoteRequestsRequest, arg2 ...grpc.CallOption) (*v2.ListQuoteRequestsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListQuoteRequests", varargs...)
+	ret0, _ := ret[0].(*v2.ListQuoteRequestsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQuoteRequests indicates an expected call of ListQuoteRequests.
+func (mr *MockTradingDataServiceClientV2MockRecorder) ListQuoteRequests(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQuoteRequests", reflect.TypeOf((*MockTradingDataServiceClientV2)(nil).ListQuoteRequests), varargs...)
+}
+
+// ListQuotes mocks base method.
+func (m *MockTradingDataServiceClientV2) ListQuotes(arg0 context.Context, arg1 *v2.ListQuotesRequest, arg2 ...grpc.CallOption) (*v2.ListQuotesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListQuotes", varargs...)
+	ret0, _ := ret[0].(*v2.ListQuotesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQuotes indicates an expected call of ListQuotes.
+func (mr *MockTradingDataServiceClientV2MockRecorder) ListQuotes(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQuotes", reflect.TypeOf((*MockTradingDataServiceClientV2)(nil).ListQuotes), varargs...)
+}
+
 // ListReferralSetReferees mocks base method.
 func (m *MockTradingDataServiceClientV2) ListReferralSetReferees(arg0 context.Context, arg1 *v2.ListReferralSetRefereesRequest, arg2 ...grpc.CallOption) (*v2.ListReferralSetRefereesResponse, error) {
 	m.ctrl.T.Helper()
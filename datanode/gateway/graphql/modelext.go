@@ -82,10 +82,22 @@ func PriceMonitoringParametersFromProto(ppmp *types.PriceMonitoringParameters) (
 	}
 
 	return &PriceMonitoringParameters{
-		Triggers: triggers,
+		Triggers:        triggers,
+		IndexDivergence: PriceMonitoringIndexDivergenceFromProto(ppmp.IndexDivergence),
 	}, nil
 }
 
+func PriceMonitoringIndexDivergenceFromProto(ppmid *types.PriceMonitoringIndexDivergence) *PriceMonitoringIndexDivergence {
+	if ppmid == nil {
+		return nil
+	}
+
+	return &PriceMonitoringIndexDivergence{
+		MaxDivergence:        ppmid.MaxDivergence,
+		AuctionExtensionSecs: int(ppmid.AuctionExtension),
+	}
+}
+
 func PriceMonitoringSettingsFromProto(ppmst *types.PriceMonitoringSettings) (*PriceMonitoringSettings, error) {
 	if ppmst == nil {
 		// these are not mandatoryu anyway for now, so if nil we return an empty one
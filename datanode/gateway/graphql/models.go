@@ -676,6 +676,16 @@ type PriceMonitoringBounds struct {
 type PriceMonitoringParameters struct {
 	// The list of triggers for this price monitoring
 	Triggers []*PriceMonitoringTrigger `json:"triggers,omitempty"`
+	// Trigger on the divergence of the traded price from the market's index price
+	IndexDivergence *PriceMonitoringIndexDivergence `json:"indexDivergence,omitempty"`
+}
+
+// PriceMonitoringIndexDivergence holds the maximum divergence of the traded price from the index price, and the auction extension
+type PriceMonitoringIndexDivergence struct {
+	// Maximum relative divergence of the traded price from the index price, e.g. 0.05 for 5%
+	MaxDivergence string `json:"maxDivergence"`
+	// Price monitoring auction extension duration in seconds should the traded price diverge from the index price by more than the maximum divergence
+	AuctionExtensionSecs int `json:"auctionExtensionSecs"`
 }
 
 // Configuration of a market price monitoring auctions triggers
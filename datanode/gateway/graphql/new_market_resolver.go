@@ -29,6 +29,18 @@ func (r *newMarketResolver) EnableTxReordering(ctx context.Context, obj *types.N
 	return obj.Changes.EnableTransactionReordering, nil
 }
 
+func (r *newMarketResolver) FrequentBatchAuction(ctx context.Context, obj *types.NewMarket) (*types.FrequentBatchAuctionParameters, error) {
+	return obj.Changes.FrequentBatchAuction, nil
+}
+
+func (r *newMarketResolver) PositionLimits(ctx context.Context, obj *types.NewMarket) (*types.PositionLimits, error) {
+	return obj.Changes.PositionLimits, nil
+}
+
+func (r *newMarketResolver) MatchingAlgorithm(ctx context.Context, obj *types.NewMarket) (types.MatchingAlgorithm, error) {
+	return obj.Changes.MatchingAlgorithm, nil
+}
+
 func (r *newMarketResolver) TickSize(_ context.Context, obj *types.NewMarket) (string, error) {
 	return obj.Changes.TickSize, nil
 }
@@ -54,7 +66,10 @@ func (r *newMarketResolver) PriceMonitoringParameters(_ context.Context, obj *ty
 			AuctionExtensionSecs: int(t.AuctionExtension),
 		}
 	}
-	return &PriceMonitoringParameters{Triggers: triggers}, nil
+	return &PriceMonitoringParameters{
+		Triggers:        triggers,
+		IndexDivergence: PriceMonitoringIndexDivergenceFromProto(obj.Changes.PriceMonitoringParameters.IndexDivergence),
+	}, nil
 }
 
 func (r *newMarketResolver) LiquidityMonitoringParameters(_ context.Context, obj *types.NewMarket) (*LiquidityMonitoringParameters, error) {
@@ -529,10 +529,26 @@ func (r *VegaResolverRoot) LiquidationStrategy() LiquidationStrategyResolver {
 	return (*liquidationStrategyResolver)(r)
 }
 
+func (r *VegaResolverRoot) PositionLimits() PositionLimitsResolver {
+	return (*positionLimitsResolver)(r)
+}
+
+func (r *VegaResolverRoot) PredictionOutcome() PredictionOutcomeResolver {
+	return (*predictionOutcomeResolver)(r)
+}
+
 func (r *VegaResolverRoot) CompositePriceConfiguration() CompositePriceConfigurationResolver {
 	return (*compositePriceConfigurationResolver)(r)
 }
 
+func (r *VegaResolverRoot) IndexBasketConfiguration() IndexBasketConfigurationResolver {
+	return (*indexBasketConfigurationResolver)(r)
+}
+
+func (r *VegaResolverRoot) IndexBasketConstituent() IndexBasketConstituentResolver {
+	return (*indexBasketConstituentResolver)(r)
+}
+
 func (r *VegaResolverRoot) NewSpotMarket() NewSpotMarketResolver {
 	return (*newSpotMarketResolver)(r)
 }
@@ -4164,7 +4180,7 @@ func (r *myQueryResolver) GetMarketDataHistoryConnectionByID(ctx context.Context
 	return resp.GetMarketData(), nil
 }
 
-func (r *myQueryResolver) MarketsConnection(ctx context.Context, id *string, pagination *v2.Pagination, includeSettled *bool) (*v2.MarketConnection, error) {
+func (r *myQueryResolver) MarketsConnection(ctx context.Context, id *string, pagination *v2.Pagination, includeSettled *bool, predictionID *string) (*v2.MarketConnection, error) {
 	var marketID string
 
 	if id != nil {
@@ -4196,6 +4212,7 @@ func (r *myQueryResolver) MarketsConnection(ctx context.Context, id *string, pag
 	resp, err := r.tradingDataClientV2.ListMarkets(ctx, &v2.ListMarketsRequest{
 		Pagination:     pagination,
 		IncludeSettled: includeSettled,
+		PredictionId:   predictionID,
 	})
 	if err != nil {
 		return nil, err
@@ -1203,24 +1203,24 @@ func TestNewResolverRoot_Resolver(t *testing.T) {
 	})
 
 	name := "BTC/DEC19"
-	vMarkets, err := root.Query().MarketsConnection(ctx, &name, nil, nil)
+	vMarkets, err := root.Query().MarketsConnection(ctx, &name, nil, nil, nil)
 	assert.Nil(t, err)
 	assert.NotNil(t, vMarkets)
 	assert.Len(t, vMarkets.Edges, 1)
 
 	name = "ETH/USD18"
-	vMarkets, err = root.Query().MarketsConnection(ctx, &name, nil, nil)
+	vMarkets, err = root.Query().MarketsConnection(ctx, &name, nil, nil, nil)
 	assert.Error(t, err)
 	assert.Nil(t, vMarkets)
 
 	name = "ETH/USD"
-	vMarkets, err = root.Query().MarketsConnection(ctx, &name, nil, nil)
+	vMarkets, err = root.Query().MarketsConnection(ctx, &name, nil, nil, nil)
 	assert.Nil(t, err)
 	assert.NotNil(t, vMarkets)
 	assert.Len(t, vMarkets.Edges, 1)
 
 	name = "ETH-230929"
-	vMarkets, err = root.Query().MarketsConnection(ctx, &name, nil, nil)
+	vMarkets, err = root.Query().MarketsConnection(ctx, &name, nil, nil, nil)
 	assert.Nil(t, err)
 	assert.NotNil(t, vMarkets)
 	assert.Len(t, vMarkets.Edges, 1)
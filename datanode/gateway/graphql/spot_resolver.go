@@ -95,6 +95,14 @@ func (r *newSpotMarketResolver) EnableTxReordering(ctx context.Context, obj *typ
 	return obj.Changes.EnableTransactionReordering, nil
 }
 
+func (r *newSpotMarketResolver) FrequentBatchAuction(ctx context.Context, obj *types.NewSpotMarket) (*types.FrequentBatchAuctionParameters, error) {
+	return obj.Changes.FrequentBatchAuction, nil
+}
+
+func (r *newSpotMarketResolver) MatchingAlgorithm(ctx context.Context, obj *types.NewSpotMarket) (types.MatchingAlgorithm, error) {
+	return obj.Changes.MatchingAlgorithm, nil
+}
+
 func (r *newSpotMarketResolver) TickSize(_ context.Context, obj *types.NewSpotMarket) (string, error) {
 	return obj.Changes.TickSize, nil
 }
@@ -139,6 +139,10 @@ func (s stopOrderResolver) SizeOverrideValue(_ context.Context, obj *eventspb.St
 	return ptr.From(obj.StopOrder.SizeOverrideValue.Percentage), nil
 }
 
+func (s stopOrderResolver) TriggerReference(_ context.Context, obj *eventspb.StopOrderEvent) (vega.StopOrder_TriggerReference, error) {
+	return obj.StopOrder.TriggerReference, nil
+}
+
 type stopOrderFilterResolver VegaResolverRoot
 
 func (s stopOrderFilterResolver) Parties(ctx context.Context, obj *v2.StopOrderFilter, data []string) error {
@@ -100,7 +100,8 @@ func (r *updateMarketConfigurationResolver) PriceMonitoringParameters(ctx contex
 	}
 
 	params := &PriceMonitoringParameters{
-		Triggers: triggers,
+		Triggers:        triggers,
+		IndexDivergence: PriceMonitoringIndexDivergenceFromProto(obj.PriceMonitoringParameters.IndexDivergence),
 	}
 
 	return params, nil
@@ -30,6 +30,7 @@ type MarketStore interface {
 	GetByTxHash(ctx context.Context, txHash entities.TxHash) ([]entities.Market, error)
 	GetAllPaged(ctx context.Context, marketID string, pagination entities.CursorPagination, includeSettled bool) ([]entities.Market, entities.PageInfo, error)
 	ListSuccessorMarkets(ctx context.Context, marketID string, fullHistory bool, pagination entities.CursorPagination) ([]entities.SuccessorMarket, entities.PageInfo, error)
+	ListPredictionMarkets(ctx context.Context, predictionID string, pagination entities.CursorPagination, includeSettled bool) ([]entities.Market, entities.PageInfo, error)
 }
 
 type Markets struct {
@@ -118,3 +119,7 @@ func (m *Markets) GetAllPaged(ctx context.Context, marketID string, pagination e
 func (m *Markets) ListSuccessorMarkets(ctx context.Context, marketID string, childrenOnly bool, pagination entities.CursorPagination) ([]entities.SuccessorMarket, entities.PageInfo, error) {
 	return m.store.ListSuccessorMarkets(ctx, marketID, childrenOnly, pagination)
 }
+
+func (m *Markets) ListPredictionMarkets(ctx context.Context, predictionID string, pagination entities.CursorPagination, includeSettled bool) ([]entities.Market, entities.PageInfo, error) {
+	return m.store.ListPredictionMarkets(ctx, predictionID, pagination, includeSettled)
+}
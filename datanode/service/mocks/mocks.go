@@ -187,6 +187,22 @@ func (mr *MockMarketStoreMockRecorder) GetByTxHash(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTxHash", reflect.TypeOf((*MockMarketStore)(nil).GetByTxHash), arg0, arg1)
 }
 
+// ListPredictionMarkets mocks base method.
+func (m *MockMarketStore) ListPredictionMarkets(arg0 context.Context, arg1 string, arg2 entities.CursorPagination, arg3 bool) ([]entities.Market, entities.PageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPredictionMarkets", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]entities.Market)
+	ret1, _ := ret[1].(entities.PageInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListPredictionMarkets indicates an expected call of ListPredictionMarkets.
+func (mr *MockMarketStoreMockRecorder) ListPredictionMarkets(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPredictionMarkets", reflect.TypeOf((*MockMarketStore)(nil).ListPredictionMarkets), arg0, arg1, arg2, arg3)
+}
+
 // ListSuccessorMarkets mocks base method.
 func (m *MockMarketStore) ListSuccessorMarkets(arg0 context.Context, arg1 string, arg2 bool, arg3 entities.CursorPagination) ([]entities.SuccessorMarket, entities.PageInfo, error) {
 	m.ctrl.T.Helper()
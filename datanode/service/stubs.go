@@ -68,8 +68,15 @@ type (
 	TransactionResults struct {
 		*sqlsubscribers.TransactionResults
 	}
-	Games                        struct{ *sqlstore.Games }
-	MarginModes                  struct{ *sqlstore.MarginModes }
+	Games                    struct{ *sqlstore.Games }
+	MarginModes              struct{ *sqlstore.MarginModes }
+	RequestsForQuote         struct{ *sqlstore.RequestsForQuote }
+	AutoDeleveragingRankings struct {
+		*sqlstore.AutoDeleveragingRankings
+	}
+	GovernanceDelegations struct {
+		*sqlstore.GovernanceDelegations
+	}
 	TimeWeightedNotionalPosition struct {
 		*sqlstore.TimeWeightedNotionalPosition
 	}
@@ -228,6 +235,18 @@ func NewMarginModes(store *sqlstore.MarginModes) *MarginModes {
 	return &MarginModes{MarginModes: store}
 }
 
+func NewRequestsForQuote(store *sqlstore.RequestsForQuote) *RequestsForQuote {
+	return &RequestsForQuote{RequestsForQuote: store}
+}
+
+func NewAutoDeleveragingRankings(store *sqlstore.AutoDeleveragingRankings) *AutoDeleveragingRankings {
+	return &AutoDeleveragingRankings{AutoDeleveragingRankings: store}
+}
+
+func NewGovernanceDelegations(store *sqlstore.GovernanceDelegations) *GovernanceDelegations {
+	return &GovernanceDelegations{GovernanceDelegations: store}
+}
+
 func NewTimeWeightedNotionalPosition(store *sqlstore.TimeWeightedNotionalPosition) *TimeWeightedNotionalPosition {
 	return &TimeWeightedNotionalPosition{TimeWeightedNotionalPosition: store}
 }
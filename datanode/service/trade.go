@@ -28,6 +28,7 @@ type tradeStore interface {
 	Flush(ctx context.Context) ([]*entities.Trade, error)
 	Add(t *entities.Trade) error
 	List(context.Context, []entities.MarketID, []entities.PartyID, []entities.OrderID, entities.CursorPagination, entities.DateRange) ([]entities.Trade, entities.PageInfo, error)
+	ListBlockTrades(context.Context, []entities.MarketID, []entities.PartyID, entities.CursorPagination, entities.DateRange) ([]entities.Trade, entities.PageInfo, error)
 	GetLastTradeByMarket(ctx context.Context, market string) ([]entities.Trade, error)
 	GetByTxHash(ctx context.Context, txHash entities.TxHash) ([]entities.Trade, error)
 }
@@ -67,6 +68,15 @@ func (t *Trade) List(ctx context.Context,
 	return t.store.List(ctx, marketIDs, partyIDs, orderIDs, pagination, dateRange)
 }
 
+func (t *Trade) ListBlockTrades(ctx context.Context,
+	marketIDs []entities.MarketID,
+	partyIDs []entities.PartyID,
+	pagination entities.CursorPagination,
+	dateRange entities.DateRange,
+) ([]entities.Trade, entities.PageInfo, error) {
+	return t.store.ListBlockTrades(ctx, marketIDs, partyIDs, pagination, dateRange)
+}
+
 func (t *Trade) GetLastTradeByMarket(ctx context.Context, market string) ([]entities.Trade, error) {
 	return t.store.GetLastTradeByMarket(ctx, market)
 }
@@ -0,0 +1,111 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package sqlstore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"code.vegaprotocol.io/vega/datanode/entities"
+	"code.vegaprotocol.io/vega/datanode/metrics"
+	v2 "code.vegaprotocol.io/vega/protos/data-node/api/v2"
+
+	"github.com/georgysavva/scany/pgxscan"
+)
+
+var autoDeleveragingRankingsOrdering = TableOrdering{
+	ColumnOrdering{Name: "market_id", Sorting: ASC},
+	ColumnOrdering{Name: "party_id", Sorting: ASC},
+}
+
+type ListAutoDeleveragingRankingsFilters struct {
+	MarketID *entities.MarketID
+	PartyID  *entities.PartyID
+}
+
+type AutoDeleveragingRankings struct {
+	*ConnectionSource
+}
+
+func NewAutoDeleveragingRankings(connectionSource *ConnectionSource) *AutoDeleveragingRankings {
+	return &AutoDeleveragingRankings{
+		ConnectionSource: connectionSource,
+	}
+}
+
+// ReplaceAutoDeleveragingRankings replaces the ranking of a market with the latest one, the parties no longer ranked are removed.
+func (a *AutoDeleveragingRankings) ReplaceAutoDeleveragingRankings(ctx context.Context, marketID entities.MarketID, rankings []entities.AutoDeleveragingRanking) error {
+	defer metrics.StartSQLQuery("AutoDeleveragingRankings", "ReplaceAutoDeleveragingRankings")()
+	if _, err := a.Exec(ctx, `DELETE FROM auto_deleveraging_rankings WHERE market_id = $1`, marketID); err != nil {
+		return fmt.Errorf("could not delete auto-deleveraging rankings: %w", err)
+	}
+
+	for _, r := range rankings {
+		if _, err := a.Exec(ctx, `
+INSERT INTO auto_deleveraging_rankings(market_id, party_id, open_volume, rank, score, tx_hash, vega_time)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			r.MarketID,
+			r.PartyID,
+			r.OpenVolume,
+			r.Rank,
+			r.Score,
+			r.TxHash,
+			r.VegaTime,
+		); err != nil {
+			return fmt.Errorf("could not insert auto-deleveraging ranking: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (a *AutoDeleveragingRankings) ListAutoDeleveragingRankings(ctx context.Context, pagination entities.CursorPagination, filters ListAutoDeleveragingRankingsFilters) ([]entities.AutoDeleveragingRanking, entities.PageInfo, error) {
+	defer metrics.StartSQLQuery("AutoDeleveragingRankings", "ListAutoDeleveragingRankings")()
+
+	var (
+		rankings []entities.AutoDeleveragingRanking
+		args     []interface{}
+		pageInfo entities.PageInfo
+	)
+
+	query := `SELECT * FROM auto_deleveraging_rankings`
+
+	whereClauses := []string{}
+	if filters.MarketID != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("market_id = %s", nextBindVar(&args, *filters.MarketID)))
+	}
+	if filters.PartyID != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("party_id = %s", nextBindVar(&args, *filters.PartyID)))
+	}
+
+	if len(whereClauses) > 0 {
+		query += " WHERE " + strings.Join(whereClauses, " AND ")
+	}
+
+	query, args, err := PaginateQuery[entities.AutoDeleveragingRankingCursor](query, args, autoDeleveragingRankingsOrdering, pagination)
+	if err != nil {
+		return nil, pageInfo, err
+	}
+
+	if err := pgxscan.Select(ctx, a.ConnectionSource, &rankings, query, args...); err != nil {
+		return nil, pageInfo, err
+	}
+
+	rankings, pageInfo = entities.PageEntities[*v2.AutoDeleveragingRankingEdge](rankings, pagination)
+
+	return rankings, pageInfo, nil
+}
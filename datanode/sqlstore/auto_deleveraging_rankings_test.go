@@ -0,0 +1,106 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package sqlstore_test
+
+import (
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/datanode/entities"
+	"code.vegaprotocol.io/vega/datanode/sqlstore"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/ptr"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoDeleveragingRankingsStore(t *testing.T) {
+	ctx := tempTransaction(t)
+
+	store := sqlstore.NewAutoDeleveragingRankings(connectionSource)
+
+	now := time.Now().Truncate(time.Microsecond)
+	market1 := entities.MarketID(GenerateID())
+	market2 := entities.MarketID(GenerateID())
+	party1 := entities.PartyID(GenerateID())
+	party2 := entities.PartyID(GenerateID())
+
+	ranking := func(market entities.MarketID, party entities.PartyID, openVolume int64, rank uint64, score int64, vegaTime time.Time) entities.AutoDeleveragingRanking {
+		return entities.AutoDeleveragingRanking{
+			MarketID:   market,
+			PartyID:    party,
+			OpenVolume: openVolume,
+			Rank:       rank,
+			Score:      num.DecimalFromInt64(score),
+			TxHash:     generateTxHash(),
+			VegaTime:   vegaTime,
+		}
+	}
+
+	t.Run("Inserting the rankings of markets", func(t *testing.T) {
+		require.NoError(t, store.ReplaceAutoDeleveragingRankings(ctx, market1, []entities.AutoDeleveragingRanking{
+			ranking(market1, party1, 10, 1, 5, now),
+			ranking(market1, party2, -5, 1, 2, now),
+		}))
+		require.NoError(t, store.ReplaceAutoDeleveragingRankings(ctx, market2, []entities.AutoDeleveragingRanking{
+			ranking(market2, party1, -3, 1, 1, now),
+		}))
+
+		rankings, _, err := store.ListAutoDeleveragingRankings(ctx, entities.DefaultCursorPagination(false), sqlstore.ListAutoDeleveragingRankingsFilters{
+			MarketID: ptr.From(market1),
+		})
+		require.NoError(t, err)
+		require.Len(t, rankings, 2)
+		for _, r := range rankings {
+			assert.Equal(t, market1, r.MarketID)
+		}
+
+		rankings, _, err = store.ListAutoDeleveragingRankings(ctx, entities.DefaultCursorPagination(false), sqlstore.ListAutoDeleveragingRankingsFilters{
+			PartyID: ptr.From(party1),
+		})
+		require.NoError(t, err)
+		require.Len(t, rankings, 2)
+		for _, r := range rankings {
+			assert.Equal(t, party1, r.PartyID)
+		}
+	})
+
+	t.Run("Replacing the ranking of a market", func(t *testing.T) {
+		later := now.Add(time.Second)
+		require.NoError(t, store.ReplaceAutoDeleveragingRankings(ctx, market1, []entities.AutoDeleveragingRanking{
+			ranking(market1, party2, -8, 1, 7, later),
+		}))
+
+		rankings, _, err := store.ListAutoDeleveragingRankings(ctx, entities.DefaultCursorPagination(false), sqlstore.ListAutoDeleveragingRankingsFilters{
+			MarketID: ptr.From(market1),
+		})
+		require.NoError(t, err)
+		require.Len(t, rankings, 1)
+		assert.Equal(t, party2, rankings[0].PartyID)
+		assert.Equal(t, int64(-8), rankings[0].OpenVolume)
+		assert.Equal(t, uint64(1), rankings[0].Rank)
+		assert.True(t, num.DecimalFromInt64(7).Equal(rankings[0].Score))
+		assert.Equal(t, later, rankings[0].VegaTime)
+
+		// the ranking of the other market is untouched
+		rankings, _, err = store.ListAutoDeleveragingRankings(ctx, entities.DefaultCursorPagination(false), sqlstore.ListAutoDeleveragingRankingsFilters{
+			MarketID: ptr.From(market2),
+		})
+		require.NoError(t, err)
+		require.Len(t, rankings, 1)
+	})
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package sqlstore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"code.vegaprotocol.io/vega/datanode/entities"
+	"code.vegaprotocol.io/vega/datanode/metrics"
+	v2 "code.vegaprotocol.io/vega/protos/data-node/api/v2"
+
+	"github.com/georgysavva/scany/pgxscan"
+)
+
+var governanceDelegationsOrdering = TableOrdering{
+	ColumnOrdering{Name: "delegator", Sorting: ASC},
+	ColumnOrdering{Name: "proposal_type", Sorting: ASC},
+}
+
+type ListGovernanceDelegationsFilters struct {
+	Delegator    *entities.PartyID
+	Delegate     *entities.PartyID
+	ProposalType *entities.DelegationProposalType
+}
+
+type GovernanceDelegations struct {
+	*ConnectionSource
+}
+
+func NewGovernanceDelegations(connectionSource *ConnectionSource) *GovernanceDelegations {
+	return &GovernanceDelegations{
+		ConnectionSource: connectionSource,
+	}
+}
+
+// Upsert records the latest delegation of a party for a proposal type. A revoked
+// delegation is removed, so the table only holds the current delegation graph.
+func (g *GovernanceDelegations) Upsert(ctx context.Context, d entities.GovernanceDelegation) error {
+	defer metrics.StartSQLQuery("GovernanceDelegations", "Upsert")()
+
+	if d.IsRevoked() {
+		if _, err := g.Exec(ctx, `DELETE FROM governance_delegations WHERE delegator = $1 AND proposal_type = $2`,
+			d.Delegator, d.ProposalType); err != nil {
+			return fmt.Errorf("could not delete governance delegation: %w", err)
+		}
+		return nil
+	}
+
+	if _, err := g.Exec(ctx, `
+INSERT INTO governance_delegations(delegator, proposal_type, delegate, tx_hash, vega_time)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (delegator, proposal_type) DO UPDATE SET
+	delegate = excluded.delegate,
+	tx_hash = excluded.tx_hash,
+	vega_time = excluded.vega_time`,
+		d.Delegator,
+		d.ProposalType,
+		d.Delegate,
+		d.TxHash,
+		d.VegaTime,
+	); err != nil {
+		return fmt.Errorf("could not upsert governance delegation: %w", err)
+	}
+
+	return nil
+}
+
+func (g *GovernanceDelegations) ListGovernanceDelegations(ctx context.Context, pagination entities.CursorPagination, filters ListGovernanceDelegationsFilters) ([]entities.GovernanceDelegation, entities.PageInfo, error) {
+	defer metrics.StartSQLQuery("GovernanceDelegations", "ListGovernanceDelegations")()
+
+	var (
+		delegations []entities.GovernanceDelegation
+		args        []interface{}
+		pageInfo    entities.PageInfo
+	)
+
+	query := `SELECT * FROM governance_delegations`
+
+	whereClauses := []string{}
+	if filters.Delegator != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("delegator = %s", nextBindVar(&args, *filters.Delegator)))
+	}
+	if filters.Delegate != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("delegate = %s", nextBindVar(&args, *filters.Delegate)))
+	}
+	if filters.ProposalType != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("proposal_type = %s", nextBindVar(&args, *filters.ProposalType)))
+	}
+
+	if len(whereClauses) > 0 {
+		query += " WHERE " + strings.Join(whereClauses, " AND ")
+	}
+
+	query, args, err := PaginateQuery[entities.GovernanceDelegationCursor](query, args, governanceDelegationsOrdering, pagination)
+	if err != nil {
+		return nil, pageInfo, err
+	}
+
+	if err := pgxscan.Select(ctx, g.ConnectionSource, &delegations, query, args...); err != nil {
+		return nil, pageInfo, fmt.Errorf("could not list governance delegations: %w", err)
+	}
+
+	delegations, pageInfo = entities.PageEntities[*v2.GovernanceDelegationEdge](delegations, pagination)
+	return delegations, pageInfo, nil
+}
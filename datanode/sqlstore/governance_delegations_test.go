@@ -0,0 +1,98 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package sqlstore_test
+
+import (
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/datanode/entities"
+	"code.vegaprotocol.io/vega/datanode/sqlstore"
+	"code.vegaprotocol.io/vega/libs/ptr"
+	"code.vegaprotocol.io/vega/protos/vega"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGovernanceDelegationsStore(t *testing.T) {
+	ctx := tempTransaction(t)
+
+	store := sqlstore.NewGovernanceDelegations(connectionSource)
+
+	now := time.Now().Truncate(time.Microsecond)
+	delegator1 := entities.PartyID(GenerateID())
+	delegator2 := entities.PartyID(GenerateID())
+	delegate1 := entities.PartyID(GenerateID())
+	delegate2 := entities.PartyID(GenerateID())
+	newMarket := entities.DelegationProposalType(vega.ProposalType_PROPOSAL_TYPE_NEW_MARKET)
+
+	delegation := func(delegator entities.PartyID, proposalType entities.DelegationProposalType, delegate entities.PartyID, vegaTime time.Time) entities.GovernanceDelegation {
+		return entities.GovernanceDelegation{
+			Delegator:    delegator,
+			ProposalType: proposalType,
+			Delegate:     delegate,
+			TxHash:       generateTxHash(),
+			VegaTime:     vegaTime,
+		}
+	}
+
+	list := func(t *testing.T, filters sqlstore.ListGovernanceDelegationsFilters) []entities.GovernanceDelegation {
+		t.Helper()
+		delegations, _, err := store.ListGovernanceDelegations(ctx, entities.DefaultCursorPagination(false), filters)
+		require.NoError(t, err)
+		return delegations
+	}
+
+	t.Run("Inserting delegations", func(t *testing.T) {
+		require.NoError(t, store.Upsert(ctx, delegation(delegator1, entities.DelegationProposalTypeAll, delegate1, now)))
+		require.NoError(t, store.Upsert(ctx, delegation(delegator1, newMarket, delegate2, now)))
+		require.NoError(t, store.Upsert(ctx, delegation(delegator2, entities.DelegationProposalTypeAll, delegate1, now)))
+
+		assert.Len(t, list(t, sqlstore.ListGovernanceDelegationsFilters{Delegator: ptr.From(delegator1)}), 2)
+
+		delegations := list(t, sqlstore.ListGovernanceDelegationsFilters{Delegate: ptr.From(delegate1)})
+		require.Len(t, delegations, 2)
+		for _, d := range delegations {
+			assert.Equal(t, delegate1, d.Delegate)
+			assert.Equal(t, entities.DelegationProposalTypeAll, d.ProposalType)
+		}
+
+		delegations = list(t, sqlstore.ListGovernanceDelegationsFilters{ProposalType: ptr.From(newMarket)})
+		require.Len(t, delegations, 1)
+		assert.Equal(t, delegator1, delegations[0].Delegator)
+		assert.Equal(t, delegate2, delegations[0].Delegate)
+	})
+
+	t.Run("Overriding a delegation", func(t *testing.T) {
+		later := now.Add(time.Second)
+		require.NoError(t, store.Upsert(ctx, delegation(delegator2, entities.DelegationProposalTypeAll, delegate2, later)))
+
+		delegations := list(t, sqlstore.ListGovernanceDelegationsFilters{Delegator: ptr.From(delegator2)})
+		require.Len(t, delegations, 1)
+		assert.Equal(t, delegate2, delegations[0].Delegate)
+		assert.Equal(t, later, delegations[0].VegaTime)
+	})
+
+	t.Run("Revoking a delegation", func(t *testing.T) {
+		require.NoError(t, store.Upsert(ctx, delegation(delegator1, newMarket, "", now.Add(2*time.Second))))
+
+		delegations := list(t, sqlstore.ListGovernanceDelegationsFilters{Delegator: ptr.From(delegator1)})
+		require.Len(t, delegations, 1)
+		assert.Equal(t, entities.DelegationProposalTypeAll, delegations[0].ProposalType)
+		assert.Equal(t, delegate1, delegations[0].Delegate)
+	})
+}
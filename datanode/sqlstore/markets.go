@@ -95,7 +95,7 @@ const (
 	sqlMarketsColumns = `id, tx_hash, vega_time, instrument_id, tradable_instrument, decimal_places,
 		fees, opening_auction, price_monitoring_settings, liquidity_monitoring_parameters,
 		trading_mode, state, market_timestamps, position_decimal_places, lp_price_range, linear_slippage_factor, quadratic_slippage_factor,
-		parent_market_id, insurance_pool_fraction, liquidity_sla_parameters, liquidation_strategy, mark_price_configuration, tick_size, enable_tx_reordering`
+		parent_market_id, insurance_pool_fraction, liquidity_sla_parameters, liquidation_strategy, mark_price_configuration, tick_size, enable_tx_reordering, frequent_batch_auction, position_limits, matching_algorithm, prediction_id`
 )
 
 func NewMarkets(connectionSource *ConnectionSource) *Markets {
@@ -108,7 +108,7 @@ func NewMarkets(connectionSource *ConnectionSource) *Markets {
 
 func (m *Markets) Upsert(ctx context.Context, market *entities.Market) error {
 	query := fmt.Sprintf(`insert into markets(%s)
-values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28)
 on conflict (id, vega_time) do update
 set
 	instrument_id=EXCLUDED.instrument_id,
@@ -132,7 +132,11 @@ set
 	liquidation_strategy=EXCLUDED.liquidation_strategy,
 	mark_price_configuration=EXCLUDED.mark_price_configuration,
 	tick_size=EXCLUDED.tick_size,
-	enable_tx_reordering=EXCLUDED.enable_tx_reordering;`, sqlMarketsColumns)
+	enable_tx_reordering=EXCLUDED.enable_tx_reordering,
+	frequent_batch_auction=EXCLUDED.frequent_batch_auction,
+	position_limits=EXCLUDED.position_limits,
+	matching_algorithm=EXCLUDED.matching_algorithm,
+	prediction_id=EXCLUDED.prediction_id;`, sqlMarketsColumns)
 
 	defer metrics.StartSQLQuery("Markets", "Upsert")()
 	if _, err := m.Exec(ctx, query, market.ID, market.TxHash, market.VegaTime, market.InstrumentID, market.TradableInstrument, market.DecimalPlaces,
@@ -140,7 +144,7 @@ set
 		market.TradingMode, market.State, market.MarketTimestamps, market.PositionDecimalPlaces, market.LpPriceRange,
 		market.LinearSlippageFactor, market.QuadraticSlippageFactor, market.ParentMarketID, market.InsurancePoolFraction,
 		market.LiquiditySLAParameters, market.LiquidationStrategy,
-		market.MarkPriceConfiguration, market.TickSize, market.EnableTXReordering); err != nil {
+		market.MarkPriceConfiguration, market.TickSize, market.EnableTXReordering, market.FrequentBatchAuction, market.PositionLimits, market.MatchingAlgorithm, market.PredictionID); err != nil {
 		err = fmt.Errorf("could not insert market into database: %w", err)
 		return err
 	}
@@ -167,7 +171,7 @@ func getSelect() string {
 select mc.id,  mc.tx_hash,  mc.vega_time,  mc.instrument_id,  mc.tradable_instrument,  mc.decimal_places,
 		mc.fees, mc.opening_auction, mc.price_monitoring_settings, mc.liquidity_monitoring_parameters,
 		mc.trading_mode, mc.state, mc.market_timestamps, mc.position_decimal_places, mc.lp_price_range, mc.linear_slippage_factor, mc.quadratic_slippage_factor,
-		mc.parent_market_id, mc.insurance_pool_fraction, ml.market_id as successor_market_id, mc.liquidity_sla_parameters, mc.liquidation_strategy, mc.mark_price_configuration, mc.tick_size, mc.enable_tx_reordering
+		mc.parent_market_id, mc.insurance_pool_fraction, ml.market_id as successor_market_id, mc.liquidity_sla_parameters, mc.liquidation_strategy, mc.mark_price_configuration, mc.tick_size, mc.enable_tx_reordering, mc.frequent_batch_auction, mc.position_limits, mc.matching_algorithm, mc.prediction_id
 from markets_current mc
 left join lineage ml on mc.id = ml.parent_market_id
 `
@@ -303,6 +307,43 @@ func (m *Markets) GetAllPaged(ctx context.Context, marketID string, pagination e
 	return markets, pageInfo, nil
 }
 
+// ListPredictionMarkets returns the outcome markets of the prediction market.
+func (m *Markets) ListPredictionMarkets(ctx context.Context, predictionID string, pagination entities.CursorPagination, includeSettled bool) ([]entities.Market, entities.PageInfo, error) {
+	if predictionID == "" {
+		return nil, entities.PageInfo{}, errors.New("invalid prediction ID. Prediction ID cannot be empty")
+	}
+
+	defer metrics.StartSQLQuery("Markets", "ListPredictionMarkets")()
+
+	markets := make([]entities.Market, 0)
+	args := make([]interface{}, 0)
+
+	settledClause := ""
+	if !includeSettled {
+		settledClause = " AND state != 'STATE_SETTLED' AND state != 'STATE_CLOSED'"
+	}
+
+	query := fmt.Sprintf(`%s
+		where state != 'STATE_REJECTED' AND prediction_id = %s %s`, getSelect(), nextBindVar(&args, entities.MarketID(predictionID)), settledClause)
+
+	var (
+		pageInfo entities.PageInfo
+		err      error
+	)
+
+	query, args, err = PaginateQuery[entities.MarketCursor](query, args, marketOrdering, pagination)
+	if err != nil {
+		return markets, pageInfo, err
+	}
+
+	if err = pgxscan.Select(ctx, m.ConnectionSource, &markets, query, args...); err != nil {
+		return markets, pageInfo, m.wrapE(err)
+	}
+
+	markets, pageInfo = entities.PageEntities[*v2.MarketEdge](markets, pagination)
+	return markets, pageInfo, nil
+}
+
 func (m *Markets) ListSuccessorMarkets(ctx context.Context, marketID string, fullHistory bool, pagination entities.CursorPagination) ([]entities.SuccessorMarket, entities.PageInfo, error) {
 	if marketID == "" {
 		return nil, entities.PageInfo{}, errors.New("invalid market ID. Market ID cannot be empty")
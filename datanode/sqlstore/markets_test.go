@@ -47,6 +47,7 @@ func TestMarkets_Get(t *testing.T) {
 	t.Run("GetByTxHash", getByTxHashReturnsMatchingMarkets)
 	t.Run("GetByID should return a spot market if it exists", getByIDShouldReturnASpotMarketIfItExists)
 	t.Run("GetByID should return a perpetual market if it exists", getByIDShouldReturnAPerpetualMarketIfItExists)
+	t.Run("ListPredictionMarkets should only return the outcome markets of the prediction", listPredictionMarketsShouldOnlyReturnOutcomeMarkets)
 }
 
 func getByIDShouldReturnTheRequestedMarketIfItExists(t *testing.T) {
@@ -198,6 +199,52 @@ func getAllPagedShouldNotIncludeRejectedMarkets(t *testing.T) {
 	}, pageInfo)
 }
 
+func listPredictionMarketsShouldOnlyReturnOutcomeMarkets(t *testing.T) {
+	bs, md := setupMarketsTest(t)
+
+	ctx := tempTransaction(t)
+
+	block := addTestBlock(t, ctx, bs)
+
+	outcomes := []entities.Market{
+		{
+			ID:           "deadbeef",
+			TxHash:       generateTxHash(),
+			VegaTime:     block.VegaTime,
+			State:        entities.MarketStateActive,
+			PredictionID: "deadbeef",
+		},
+		{
+			ID:           "deadbaad",
+			TxHash:       generateTxHash(),
+			VegaTime:     block.VegaTime,
+			State:        entities.MarketStateActive,
+			PredictionID: "deadbeef",
+		},
+	}
+	for i := range outcomes {
+		require.NoError(t, md.Upsert(ctx, &outcomes[i]), "Saving market entity to database")
+	}
+
+	other := entities.Market{
+		ID:       "cafed00d",
+		TxHash:   generateTxHash(),
+		VegaTime: block.VegaTime,
+		State:    entities.MarketStateActive,
+	}
+	require.NoError(t, md.Upsert(ctx, &other), "Saving market entity to database")
+
+	markets, _, err := md.ListPredictionMarkets(ctx, "deadbeef", entities.CursorPagination{}, true)
+	require.NoError(t, err)
+	require.Len(t, markets, 2)
+	for _, market := range markets {
+		assert.Equal(t, entities.MarketID("deadbeef"), market.PredictionID)
+	}
+
+	_, _, err = md.ListPredictionMarkets(ctx, "", entities.CursorPagination{}, true)
+	require.Error(t, err)
+}
+
 func shouldInsertAValidMarketRecord(t *testing.T) {
 	bs, md := setupMarketsTest(t)
 
@@ -0,0 +1,7 @@
+-- +goose Up
+
+ALTER TABLE amms ADD COLUMN IF NOT EXISTS base_commitment numeric;
+
+-- +goose Down
+
+ALTER TABLE amms DROP COLUMN IF EXISTS base_commitment;
@@ -0,0 +1,69 @@
+-- +goose Up
+
+-- +goose StatementBegin
+do $$
+begin
+    if not exists (select 1 from pg_type where typname = 'quote_request_status') then
+        create type quote_request_status as enum(
+            'STATUS_UNSPECIFIED', 'STATUS_OPEN', 'STATUS_FILLED', 'STATUS_CANCELLED', 'STATUS_EXPIRED'
+        );
+    end if;
+end $$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+do $$
+begin
+    if not exists (select 1 from pg_type where typname = 'quote_status') then
+        create type quote_status as enum(
+            'STATUS_UNSPECIFIED', 'STATUS_ACTIVE', 'STATUS_ACCEPTED', 'STATUS_REPLACED', 'STATUS_CANCELLED',
+            'STATUS_EXPIRED', 'STATUS_REJECTED'
+        );
+    end if;
+end $$;
+-- +goose StatementEnd
+
+create table if not exists quote_requests (
+    id bytea not null,
+    market_id bytea not null,
+    party_id bytea not null,
+    side smallint not null,
+    size bigint not null,
+    status quote_request_status not null,
+    reference text,
+    accepted_quote_id bytea,
+    trade_id bytea,
+    created_at timestamp with time zone not null,
+    expires_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    tx_hash bytea not null,
+    vega_time timestamp with time zone not null,
+    primary key (id)
+);
+
+create index if not exists quote_requests_market_id_idx on quote_requests(market_id);
+create index if not exists quote_requests_party_id_idx on quote_requests(party_id);
+
+create table if not exists quotes (
+    id bytea not null,
+    quote_request_id bytea not null,
+    market_id bytea not null,
+    party_id bytea not null,
+    price numeric not null,
+    status quote_status not null,
+    created_at timestamp with time zone not null,
+    expires_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    tx_hash bytea not null,
+    vega_time timestamp with time zone not null,
+    primary key (id)
+);
+
+create index if not exists quotes_quote_request_id_idx on quotes(quote_request_id);
+create index if not exists quotes_party_id_idx on quotes(party_id);
+
+-- +goose Down
+drop table if exists quotes;
+drop table if exists quote_requests;
+drop type if exists quote_status;
+drop type if exists quote_request_status;
@@ -0,0 +1,97 @@
+-- +goose Up
+
+ALTER TYPE stop_order_rejection_reason ADD VALUE IF NOT EXISTS 'REJECTION_REASON_STOP_ORDER_TRIGGER_REFERENCE_UNSUPPORTED';
+
+alter table stop_orders
+    add column if not exists trigger_reference int not null default 0;
+
+alter table stop_orders_live
+    add column if not exists trigger_reference int not null default 0;
+
+create or replace view stop_orders_current_desc
+as
+    select distinct on (so.created_at, so.id) *
+    from stop_orders so
+    order by so.created_at desc, so.id, so.vega_time desc, so.seq_num desc;
+
+create or replace view stop_orders_current_desc_by_market
+as
+    select distinct on (so.created_at, so.market_id, so.id) *
+    from stop_orders so
+    order by so.created_at desc, so.market_id, so.id, so.vega_time desc, so.seq_num desc;
+
+create or replace view stop_orders_current_desc_by_party
+as
+select distinct on (so.created_at, so.party_id, so.id) *
+from stop_orders so
+order by so.created_at desc, so.party_id, so.id, so.vega_time desc, so.seq_num desc;
+
+-- +goose StatementBegin
+create or replace function stop_orders_live_insert_trigger()
+returns trigger
+    language plpgsql
+    as $$
+begin
+    delete from stop_orders_live
+    where id = new.id;
+
+    if new.status in ('STATUS_UNSPECIFIED', 'STATUS_PENDING') then
+        insert into stop_orders_live
+        values (new.id, new.oco_link_id, new.expires_at, new.expiry_strategy, new.trigger_direction, new.status,
+                new.created_at, new.updated_at, new.order_id, new.trigger_price, new.trigger_percent_offset, new.party_id,
+                new.market_id, new.vega_time, new.seq_num, new.tx_hash, new.submission, new.size_override_setting, new.size_override_value,
+                new.trigger_reference);
+    end if;
+
+    return new;
+end;
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+
+-- +goose StatementBegin
+create or replace function stop_orders_live_insert_trigger()
+returns trigger
+    language plpgsql
+    as $$
+begin
+    delete from stop_orders_live
+    where id = new.id;
+
+    if new.status in ('STATUS_UNSPECIFIED', 'STATUS_PENDING') then
+        insert into stop_orders_live
+        values (new.id, new.oco_link_id, new.expires_at, new.expiry_strategy, new.trigger_direction, new.status,
+                new.created_at, new.updated_at, new.order_id, new.trigger_price, new.trigger_percent_offset, new.party_id,
+                new.market_id, new.vega_time, new.seq_num, new.tx_hash, new.submission, new.size_override_setting, new.size_override_value);
+    end if;
+
+    return new;
+end;
+$$;
+-- +goose StatementEnd
+
+drop view if exists stop_orders_current_desc;
+drop view if exists stop_orders_current_desc_by_market;
+drop view if exists stop_orders_current_desc_by_party;
+
+alter table stop_orders_live drop column if exists trigger_reference;
+alter table stop_orders drop column if exists trigger_reference;
+
+create view stop_orders_current_desc
+as
+    select distinct on (so.created_at, so.id) *
+    from stop_orders so
+    order by so.created_at desc, so.id, so.vega_time desc, so.seq_num desc;
+
+create view stop_orders_current_desc_by_market
+as
+    select distinct on (so.created_at, so.market_id, so.id) *
+    from stop_orders so
+    order by so.created_at desc, so.market_id, so.id, so.vega_time desc, so.seq_num desc;
+
+create view stop_orders_current_desc_by_party
+as
+select distinct on (so.created_at, so.party_id, so.id) *
+from stop_orders so
+order by so.created_at desc, so.party_id, so.id, so.vega_time desc, so.seq_num desc;
@@ -0,0 +1,3 @@
+-- +goose Up
+
+ALTER TYPE auction_trigger_type ADD VALUE IF NOT EXISTS 'AUCTION_TRIGGER_INDEX_DIVERGENCE';
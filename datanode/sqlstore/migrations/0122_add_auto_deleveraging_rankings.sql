@@ -0,0 +1,18 @@
+-- +goose Up
+
+create table if not exists auto_deleveraging_rankings (
+    market_id   bytea                    not null,
+    party_id    bytea                    not null,
+    open_volume bigint                   not null,
+    rank        bigint                   not null,
+    score       numeric                  not null,
+    tx_hash     bytea                    not null,
+    vega_time   timestamp with time zone not null,
+    primary key (market_id, party_id)
+);
+
+create index if not exists auto_deleveraging_rankings_party_id_idx on auto_deleveraging_rankings(party_id);
+
+-- +goose Down
+
+drop table if exists auto_deleveraging_rankings;
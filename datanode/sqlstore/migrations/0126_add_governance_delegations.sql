@@ -0,0 +1,34 @@
+-- +goose Up
+
+-- +goose StatementBegin
+do $$
+begin
+    if not exists (select 1 from pg_type where typname = 'proposal_type') then
+        create type proposal_type as enum(
+            'PROPOSAL_TYPE_UNSPECIFIED', 'PROPOSAL_TYPE_NEW_MARKET', 'PROPOSAL_TYPE_UPDATE_MARKET',
+            'PROPOSAL_TYPE_UPDATE_NETWORK_PARAMETER', 'PROPOSAL_TYPE_NEW_ASSET', 'PROPOSAL_TYPE_NEW_FREEFORM',
+            'PROPOSAL_TYPE_UPDATE_ASSET', 'PROPOSAL_TYPE_NEW_SPOT_MARKET', 'PROPOSAL_TYPE_UPDATE_SPOT_MARKET',
+            'PROPOSAL_TYPE_NEW_TRANSFER', 'PROPOSAL_TYPE_CANCEL_TRANSFER', 'PROPOSAL_TYPE_UPDATE_MARKET_STATE',
+            'PROPOSAL_TYPE_UPDATE_REFERRAL_PROGRAM', 'PROPOSAL_TYPE_UPDATE_VOLUME_DISCOUNT_PROGRAM',
+            'PROPOSAL_TYPE_UPDATE_VOLUME_REBATE_PROGRAM'
+        );
+    end if;
+end $$;
+-- +goose StatementEnd
+
+-- A delegation with the unspecified proposal type applies to all proposal types.
+create table if not exists governance_delegations (
+    delegator     bytea                    not null,
+    proposal_type proposal_type            not null,
+    delegate      bytea                    not null,
+    tx_hash       bytea                    not null,
+    vega_time     timestamp with time zone not null,
+    primary key (delegator, proposal_type)
+);
+
+create index if not exists governance_delegations_delegate_idx on governance_delegations(delegate);
+
+-- +goose Down
+
+drop table if exists governance_delegations;
+drop type if exists proposal_type;
@@ -0,0 +1,34 @@
+-- +goose Up
+
+ALTER TYPE proposal_error ADD VALUE IF NOT EXISTS 'PROPOSAL_ERROR_INVALID_OPTION_PRODUCT';
+ALTER TYPE proposal_error ADD VALUE IF NOT EXISTS 'PROPOSAL_ERROR_POLL_TIED';
+
+DROP VIEW IF EXISTS proposals_current;
+DROP VIEW IF EXISTS votes_current;
+
+ALTER TABLE proposals ADD COLUMN IF NOT EXISTS poll_result JSONB DEFAULT '{}' NOT NULL;
+ALTER TABLE votes ADD COLUMN IF NOT EXISTS options JSONB;
+
+CREATE VIEW proposals_current AS (
+    SELECT DISTINCT ON (id) * FROM proposals ORDER BY id, vega_time DESC
+);
+
+CREATE VIEW votes_current AS (
+    SELECT DISTINCT ON (proposal_id, party_id) * FROM votes ORDER BY proposal_id, party_id, vega_time DESC
+);
+
+-- +goose Down
+
+DROP VIEW IF EXISTS proposals_current;
+DROP VIEW IF EXISTS votes_current;
+
+ALTER TABLE proposals DROP COLUMN IF EXISTS poll_result;
+ALTER TABLE votes DROP COLUMN IF EXISTS options;
+
+CREATE VIEW proposals_current AS (
+    SELECT DISTINCT ON (id) * FROM proposals ORDER BY id, vega_time DESC
+);
+
+CREATE VIEW votes_current AS (
+    SELECT DISTINCT ON (proposal_id, party_id) * FROM votes ORDER BY proposal_id, party_id, vega_time DESC
+);
@@ -0,0 +1,22 @@
+-- +goose Up
+
+ALTER TYPE transfer_type ADD VALUE IF NOT EXISTS 'Conditional';
+
+ALTER TABLE transfers ADD COLUMN IF NOT EXISTS conditional JSONB NULL;
+
+-- Make sure we refresh the view to account for the new column
+CREATE OR REPLACE VIEW transfers_current AS
+(
+    SELECT DISTINCT ON (id, from_account_id, to_account_id) * FROM transfers ORDER BY id, from_account_id, to_account_id, vega_time DESC
+);
+
+-- +goose Down
+
+DROP VIEW IF EXISTS transfers_current;
+
+ALTER TABLE transfers DROP COLUMN IF EXISTS conditional;
+
+CREATE VIEW transfers_current AS
+(
+    SELECT DISTINCT ON (id, from_account_id, to_account_id) * FROM transfers ORDER BY id, from_account_id, to_account_id, vega_time DESC
+);
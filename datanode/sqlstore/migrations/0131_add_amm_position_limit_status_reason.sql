@@ -0,0 +1,3 @@
+-- +goose Up
+
+ALTER TYPE amm_status_reason ADD VALUE IF NOT EXISTS 'STATUS_REASON_POSITION_LIMIT_EXCEEDED';
@@ -0,0 +1,143 @@
+-- +goose Up
+
+ALTER TABLE orders
+      ADD COLUMN IF NOT EXISTS max_slippage BIGINT,
+      ADD COLUMN IF NOT EXISTS achieved_slippage BIGINT;
+
+ALTER TABLE orders_live
+      ADD COLUMN IF NOT EXISTS max_slippage BIGINT,
+      ADD COLUMN IF NOT EXISTS achieved_slippage BIGINT;
+
+-- +goose StatementBegin
+
+CREATE OR REPLACE FUNCTION archive_orders()
+    RETURNS TRIGGER
+    LANGUAGE PLPGSQL AS
+$$
+BEGIN
+
+    DELETE from orders_live
+    WHERE id = NEW.id;
+
+    -- As per https://github.com/vegaprotocol/specs-internal/blob/master/protocol/0024-OSTA-order_status.md
+-- we consider an order 'live' if it either ACTIVE (status=1) or PARKED (status=8). Orders
+-- with statuses other than this are discarded by core, so we consider them candidates for
+-- eventual deletion according to the data retention policy by placing them in orders_history.
+-- As per https://github.com/vegaprotocol/vega/issues/8149, only LIMIT type (1) orders with status active (1) and parked (8)
+-- and time_in_force != IOC (3) and time_in_force != FOK (4) are considered live.
+    IF NEW.status IN (1, 8) AND NEW.type = 1 AND NEW.time_in_force NOT IN (3, 4)
+    THEN
+        INSERT INTO orders_live
+        VALUES(new.id, new.market_id, new.party_id, new.side, new.price,
+               new.size, new.remaining, new.time_in_force, new.type, new.status,
+               new.reference, new.reason, new.version, new.batch_id, new.pegged_offset,
+               new.pegged_reference, new.lp_id, new.created_at, new.updated_at, new.expires_at,
+               new.tx_hash, new.vega_time, new.seq_num, new.post_only, new.reduce_only, new.reserved_remaining, new.peak_size, new.minimum_visible_size, new.self_trade_prevention, new.max_slippage, new.achieved_slippage);
+    END IF;
+
+    RETURN NEW;
+
+END;
+$$;
+-- +goose StatementEnd
+
+-- Make sure we refresh the views to account for the new column
+CREATE OR REPLACE VIEW orders_current_versions AS (
+   SELECT DISTINCT ON (id, version) * FROM orders ORDER BY id, version DESC, vega_time DESC
+);
+
+CREATE OR REPLACE VIEW orders_current_desc
+ AS
+SELECT DISTINCT ON (orders.created_at, orders.id) *
+FROM orders
+ORDER BY orders.created_at DESC, orders.id, orders.vega_time DESC, orders.seq_num DESC;
+
+CREATE OR REPLACE VIEW orders_current_desc_by_market
+ AS
+SELECT DISTINCT ON (orders.created_at, orders.market_id, orders.id) *
+FROM orders
+ORDER BY orders.created_at DESC, orders.market_id, orders.id, orders.vega_time DESC, orders.seq_num DESC;
+
+CREATE OR REPLACE VIEW orders_current_desc_by_party
+AS
+SELECT DISTINCT ON (orders.created_at, orders.party_id, orders.id) *
+        FROM orders
+        ORDER BY orders.created_at DESC, orders.party_id, orders.id, orders.vega_time DESC, orders.seq_num DESC;
+
+CREATE OR REPLACE VIEW orders_current_desc_by_reference
+AS
+SELECT DISTINCT ON (orders.created_at, orders.reference, orders.id) *
+        FROM orders
+        ORDER BY orders.created_at DESC, orders.reference, orders.id, orders.vega_time DESC, orders.seq_num DESC;
+
+-- +goose Down
+
+DROP VIEW IF EXISTS orders_current_versions;
+DROP VIEW IF EXISTS orders_current_desc;
+DROP VIEW IF EXISTS orders_current_desc_by_reference;
+DROP VIEW IF EXISTS orders_current_desc_by_party;
+DROP VIEW IF EXISTS orders_current_desc_by_market;
+
+-- +goose StatementBegin
+
+CREATE OR REPLACE FUNCTION archive_orders()
+    RETURNS TRIGGER
+    LANGUAGE PLPGSQL AS
+$$
+BEGIN
+
+    DELETE from orders_live
+    WHERE id = NEW.id;
+
+    -- As per https://github.com/vegaprotocol/specs-internal/blob/master/protocol/0024-OSTA-order_status.md
+-- we consider an order 'live' if it either ACTIVE (status=1) or PARKED (status=8). Orders
+-- with statuses other than this are discarded by core, so we consider them candidates for
+-- eventual deletion according to the data retention policy by placing them in orders_history.
+-- As per https://github.com/vegaprotocol/vega/issues/8149, only LIMIT type (1) orders with status active (1) and parked (8)
+-- and time_in_force != IOC (3) and time_in_force != FOK (4) are considered live.
+    IF NEW.status IN (1, 8) AND NEW.type = 1 AND NEW.time_in_force NOT IN (3, 4)
+    THEN
+        INSERT INTO orders_live
+        VALUES(new.id, new.market_id, new.party_id, new.side, new.price,
+               new.size, new.remaining, new.time_in_force, new.type, new.status,
+               new.reference, new.reason, new.version, new.batch_id, new.pegged_offset,
+               new.pegged_reference, new.lp_id, new.created_at, new.updated_at, new.expires_at,
+               new.tx_hash, new.vega_time, new.seq_num, new.post_only, new.reduce_only, new.reserved_remaining, new.peak_size, new.minimum_visible_size, new.self_trade_prevention);
+    END IF;
+
+    RETURN NEW;
+
+END;
+$$;
+-- +goose StatementEnd
+
+ALTER TABLE orders_live DROP COLUMN IF EXISTS max_slippage, DROP COLUMN IF EXISTS achieved_slippage;
+ALTER TABLE orders DROP COLUMN IF EXISTS max_slippage, DROP COLUMN IF EXISTS achieved_slippage;
+
+CREATE OR REPLACE VIEW orders_current_versions AS (
+   SELECT DISTINCT ON (id, version) * FROM orders ORDER BY id, version DESC, vega_time DESC
+);
+
+CREATE OR REPLACE VIEW orders_current_desc
+ AS
+SELECT DISTINCT ON (orders.created_at, orders.id) *
+FROM orders
+ORDER BY orders.created_at DESC, orders.id, orders.vega_time DESC, orders.seq_num DESC;
+
+CREATE OR REPLACE VIEW orders_current_desc_by_market
+ AS
+SELECT DISTINCT ON (orders.created_at, orders.market_id, orders.id) *
+FROM orders
+ORDER BY orders.created_at DESC, orders.market_id, orders.id, orders.vega_time DESC, orders.seq_num DESC;
+
+CREATE OR REPLACE VIEW orders_current_desc_by_party
+AS
+SELECT DISTINCT ON (orders.created_at, orders.party_id, orders.id) *
+        FROM orders
+        ORDER BY orders.created_at DESC, orders.party_id, orders.id, orders.vega_time DESC, orders.seq_num DESC;
+
+CREATE OR REPLACE VIEW orders_current_desc_by_reference
+AS
+SELECT DISTINCT ON (orders.created_at, orders.reference, orders.id) *
+        FROM orders
+        ORDER BY orders.created_at DESC, orders.reference, orders.id, orders.vega_time DESC, orders.seq_num DESC;
@@ -470,6 +470,98 @@ func TestOrders_GetLiveOrders(t *testing.T) {
 	assert.ElementsMatch(t, want, got)
 }
 
+func TestOrders_SelfTradePrevention(t *testing.T) {
+	ctx := tempTransaction(t)
+
+	bs := sqlstore.NewBlocks(connectionSource)
+	ps := sqlstore.NewParties(connectionSource)
+	os := sqlstore.NewOrders(connectionSource)
+
+	block := addTestBlock(t, ctx, bs)
+	party := addTestParty(t, ctx, ps, block)
+	market := entities.Market{ID: entities.MarketID(GenerateID())}
+
+	order := entities.Order{
+		ID:                  entities.OrderID(GenerateID()),
+		MarketID:            market.ID,
+		PartyID:             party.ID,
+		Side:                types.SideBuy,
+		Price:               decimal.NewFromInt(100),
+		Size:                10,
+		Remaining:           10,
+		TimeInForce:         types.OrderTimeInForceGTC,
+		Type:                types.OrderTypeLimit,
+		Status:              types.OrderStatusActive,
+		Version:             1,
+		PeggedOffset:        decimal.NewFromInt(0),
+		CreatedAt:           block.VegaTime,
+		UpdatedAt:           block.VegaTime,
+		ExpiresAt:           block.VegaTime,
+		VegaTime:            block.VegaTime,
+		TxHash:              defaultTxHash,
+		SelfTradePrevention: entities.OrderSelfTradePreventionDecrementAndCancel,
+	}
+	require.NoError(t, os.Add(order))
+	_, err := os.Flush(ctx)
+	require.NoError(t, err)
+
+	// the mode is stored alongside the order and kept by the live orders
+	fetched, err := os.GetOrder(ctx, order.ID.String(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, entities.OrderSelfTradePreventionDecrementAndCancel, fetched.SelfTradePrevention)
+
+	live, err := os.GetLiveOrders(ctx)
+	require.NoError(t, err)
+	require.Len(t, live, 1)
+	assert.Equal(t, entities.OrderSelfTradePreventionDecrementAndCancel, live[0].SelfTradePrevention)
+	assert.Equal(t, vega.Order_SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL, live[0].ToProto().SelfTradePrevention)
+}
+
+func TestOrders_Slippage(t *testing.T) {
+	ctx := tempTransaction(t)
+
+	bs := sqlstore.NewBlocks(connectionSource)
+	ps := sqlstore.NewParties(connectionSource)
+	os := sqlstore.NewOrders(connectionSource)
+
+	block := addTestBlock(t, ctx, bs)
+	party := addTestParty(t, ctx, ps, block)
+	market := entities.Market{ID: entities.MarketID(GenerateID())}
+
+	order := entities.Order{
+		ID:               entities.OrderID(GenerateID()),
+		MarketID:         market.ID,
+		PartyID:          party.ID,
+		Side:             types.SideBuy,
+		Price:            decimal.NewFromInt(0),
+		Size:             10,
+		Remaining:        0,
+		TimeInForce:      types.OrderTimeInForceIOC,
+		Type:             types.OrderTypeMarket,
+		Status:           types.OrderStatusFilled,
+		Version:          1,
+		PeggedOffset:     decimal.NewFromInt(0),
+		CreatedAt:        block.VegaTime,
+		UpdatedAt:        block.VegaTime,
+		ExpiresAt:        block.VegaTime,
+		VegaTime:         block.VegaTime,
+		TxHash:           defaultTxHash,
+		MaxSlippage:      ptr.From(int64(100)),
+		AchievedSlippage: ptr.From(int64(25)),
+	}
+	require.NoError(t, os.Add(order))
+	_, err := os.Flush(ctx)
+	require.NoError(t, err)
+
+	fetched, err := os.GetOrder(ctx, order.ID.String(), nil)
+	require.NoError(t, err)
+	require.NotNil(t, fetched.MaxSlippage)
+	require.NotNil(t, fetched.AchievedSlippage)
+	assert.Equal(t, int64(100), *fetched.MaxSlippage)
+	assert.Equal(t, int64(25), *fetched.AchievedSlippage)
+	assert.Equal(t, uint64(25), fetched.ToProto().GetAchievedSlippage())
+}
+
 func TestOrders_CursorPagination(t *testing.T) {
 	t.Run("Should return all current orders for a given market when no cursor is given - Newest First", testOrdersCursorPaginationByMarketNoCursorNewestFirst)
 	t.Run("Should return all current orders for a given party when no cursor is given - Newest First", testOrdersCursorPaginationByPartyNoCursorNewestFirst)
@@ -65,8 +65,9 @@ func (ps *Proposals) Add(ctx context.Context, p entities.Proposal) error {
 			required_participation,
 			required_lp_majority,
 			required_lp_participation,
-			tx_hash)
-		 VALUES ($1,  $2,  $3,  $4,  $5,  $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+			tx_hash,
+			poll_result)
+		 VALUES ($1,  $2,  $3,  $4,  $5,  $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
 		 ON CONFLICT (id, vega_time) DO UPDATE SET
 			reference = EXCLUDED.reference,
 			party_id = EXCLUDED.party_id,
@@ -76,12 +77,13 @@ func (ps *Proposals) Add(ctx context.Context, p entities.Proposal) error {
 			reason = EXCLUDED.reason,
 			error_details = EXCLUDED.error_details,
 			proposal_time = EXCLUDED.proposal_time,
-			tx_hash = EXCLUDED.tx_hash
+			tx_hash = EXCLUDED.tx_hash,
+			poll_result = EXCLUDED.poll_result
 			;
 		 `,
 		p.ID, p.BatchID, p.Reference, p.PartyID, p.State, p.Terms, p.BatchTerms, p.Rationale, p.Reason,
 		p.ErrorDetails, p.ProposalTime, p.VegaTime, p.RequiredMajority, p.RequiredParticipation,
-		p.RequiredLPMajority, p.RequiredLPParticipation, p.TxHash)
+		p.RequiredLPMajority, p.RequiredLPParticipation, p.TxHash, p.PollResult)
 	return err
 }
 
@@ -0,0 +1,209 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package sqlstore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"code.vegaprotocol.io/vega/datanode/entities"
+	"code.vegaprotocol.io/vega/datanode/metrics"
+	v2 "code.vegaprotocol.io/vega/protos/data-node/api/v2"
+
+	"github.com/georgysavva/scany/pgxscan"
+)
+
+var (
+	quoteRequestsOrdering = TableOrdering{
+		ColumnOrdering{Name: "created_at", Sorting: DESC},
+		ColumnOrdering{Name: "id", Sorting: ASC},
+	}
+
+	quotesOrdering = TableOrdering{
+		ColumnOrdering{Name: "created_at", Sorting: DESC},
+		ColumnOrdering{Name: "id", Sorting: ASC},
+	}
+)
+
+type ListQuoteRequestsFilters struct {
+	ID       *entities.QuoteRequestID
+	MarketID *entities.MarketID
+	PartyID  *entities.PartyID
+	Status   *entities.QuoteRequestStatus
+}
+
+type ListQuotesFilters struct {
+	QuoteRequestID *entities.QuoteRequestID
+	PartyID        *entities.PartyID
+	Status         *entities.QuoteStatus
+}
+
+type RequestsForQuote struct {
+	*ConnectionSource
+}
+
+func NewRequestsForQuote(connectionSource *ConnectionSource) *RequestsForQuote {
+	return &RequestsForQuote{
+		ConnectionSource: connectionSource,
+	}
+}
+
+func (r *RequestsForQuote) UpsertQuoteRequest(ctx context.Context, qr entities.QuoteRequest) error {
+	defer metrics.StartSQLQuery("RequestsForQuote", "UpsertQuoteRequest")()
+	if _, err := r.Exec(ctx, `
+INSERT INTO quote_requests(id, market_id, party_id, side, size, status, reference,
+	accepted_quote_id, trade_id, created_at, expires_at, updated_at, tx_hash, vega_time)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	ON CONFLICT (id) DO UPDATE SET
+	status = excluded.status,
+	accepted_quote_id = excluded.accepted_quote_id,
+	trade_id = excluded.trade_id,
+	updated_at = excluded.updated_at,
+	tx_hash = excluded.tx_hash,
+	vega_time = excluded.vega_time`,
+		qr.ID,
+		qr.MarketID,
+		qr.PartyID,
+		qr.Side,
+		qr.Size,
+		qr.Status,
+		qr.Reference,
+		qr.AcceptedQuoteID,
+		qr.TradeID,
+		qr.CreatedAt,
+		qr.ExpiresAt,
+		qr.UpdatedAt,
+		qr.TxHash,
+		qr.VegaTime,
+	); err != nil {
+		return fmt.Errorf("could not upsert request for quote: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RequestsForQuote) UpsertQuote(ctx context.Context, q entities.Quote) error {
+	defer metrics.StartSQLQuery("RequestsForQuote", "UpsertQuote")()
+	if _, err := r.Exec(ctx, `
+INSERT INTO quotes(id, quote_request_id, market_id, party_id, price, status,
+	created_at, expires_at, updated_at, tx_hash, vega_time)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	ON CONFLICT (id) DO UPDATE SET
+	status = excluded.status,
+	updated_at = excluded.updated_at,
+	tx_hash = excluded.tx_hash,
+	vega_time = excluded.vega_time`,
+		q.ID,
+		q.QuoteRequestID,
+		q.MarketID,
+		q.PartyID,
+		q.Price,
+		q.Status,
+		q.CreatedAt,
+		q.ExpiresAt,
+		q.UpdatedAt,
+		q.TxHash,
+		q.VegaTime,
+	); err != nil {
+		return fmt.Errorf("could not upsert quote: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RequestsForQuote) ListQuoteRequests(ctx context.Context, pagination entities.CursorPagination, filters ListQuoteRequestsFilters) ([]entities.QuoteRequest, entities.PageInfo, error) {
+	defer metrics.StartSQLQuery("RequestsForQuote", "ListQuoteRequests")()
+
+	var (
+		requests []entities.QuoteRequest
+		args     []interface{}
+		pageInfo entities.PageInfo
+	)
+
+	query := `SELECT * FROM quote_requests`
+
+	whereClauses := []string{}
+	if filters.ID != nil {
+		// when listing a specific request, the other filters are ignored.
+		whereClauses = append(whereClauses, fmt.Sprintf("id = %s", nextBindVar(&args, *filters.ID)))
+	} else {
+		if filters.MarketID != nil {
+			whereClauses = append(whereClauses, fmt.Sprintf("market_id = %s", nextBindVar(&args, *filters.MarketID)))
+		}
+		if filters.PartyID != nil {
+			whereClauses = append(whereClauses, fmt.Sprintf("party_id = %s", nextBindVar(&args, *filters.PartyID)))
+		}
+		if filters.Status != nil {
+			whereClauses = append(whereClauses, fmt.Sprintf("status = %s", nextBindVar(&args, *filters.Status)))
+		}
+	}
+
+	if len(whereClauses) > 0 {
+		query += " WHERE " + strings.Join(whereClauses, " AND ")
+	}
+
+	query, args, err := PaginateQuery[entities.QuoteRequestCursor](query, args, quoteRequestsOrdering, pagination)
+	if err != nil {
+		return nil, pageInfo, err
+	}
+
+	if err := pgxscan.Select(ctx, r.ConnectionSource, &requests, query, args...); err != nil {
+		return nil, pageInfo, fmt.Errorf("could not list requests for quote: %w", err)
+	}
+
+	requests, pageInfo = entities.PageEntities[*v2.QuoteRequestEdge](requests, pagination)
+	return requests, pageInfo, nil
+}
+
+func (r *RequestsForQuote) ListQuotes(ctx context.Context, pagination entities.CursorPagination, filters ListQuotesFilters) ([]entities.Quote, entities.PageInfo, error) {
+	defer metrics.StartSQLQuery("RequestsForQuote", "ListQuotes")()
+
+	var (
+		quotes   []entities.Quote
+		args     []interface{}
+		pageInfo entities.PageInfo
+	)
+
+	query := `SELECT * FROM quotes`
+
+	whereClauses := []string{}
+	if filters.QuoteRequestID != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("quote_request_id = %s", nextBindVar(&args, *filters.QuoteRequestID)))
+	}
+	if filters.PartyID != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("party_id = %s", nextBindVar(&args, *filters.PartyID)))
+	}
+	if filters.Status != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("status = %s", nextBindVar(&args, *filters.Status)))
+	}
+
+	if len(whereClauses) > 0 {
+		query += " WHERE " + strings.Join(whereClauses, " AND ")
+	}
+
+	query, args, err := PaginateQuery[entities.QuoteCursor](query, args, quotesOrdering, pagination)
+	if err != nil {
+		return nil, pageInfo, err
+	}
+
+	if err := pgxscan.Select(ctx, r.ConnectionSource, &quotes, query, args...); err != nil {
+		return nil, pageInfo, fmt.Errorf("could not list quotes: %w", err)
+	}
+
+	quotes, pageInfo = entities.PageEntities[*v2.QuoteEdge](quotes, pagination)
+	return quotes, pageInfo, nil
+}
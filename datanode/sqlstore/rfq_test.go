@@ -0,0 +1,154 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package sqlstore_test
+
+import (
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/datanode/entities"
+	"code.vegaprotocol.io/vega/datanode/sqlstore"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/ptr"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestsForQuoteStore(t *testing.T) {
+	ctx := tempTransaction(t)
+
+	store := sqlstore.NewRequestsForQuote(connectionSource)
+
+	now := time.Now().Truncate(time.Microsecond)
+	market := entities.MarketID(GenerateID())
+	taker := entities.PartyID(GenerateID())
+	maker1 := entities.PartyID(GenerateID())
+	maker2 := entities.PartyID(GenerateID())
+
+	request := entities.QuoteRequest{
+		ID:        entities.QuoteRequestID(GenerateID()),
+		MarketID:  market,
+		PartyID:   taker,
+		Side:      entities.SideBuy,
+		Size:      10,
+		Status:    entities.QuoteRequestStatusOpen,
+		Reference: "block",
+		CreatedAt: now,
+		ExpiresAt: now.Add(time.Minute),
+		UpdatedAt: now,
+		TxHash:    generateTxHash(),
+		VegaTime:  now,
+	}
+	quote1 := entities.Quote{
+		ID:             entities.QuoteID(GenerateID()),
+		QuoteRequestID: request.ID,
+		MarketID:       market,
+		PartyID:        maker1,
+		Price:          num.DecimalFromInt64(100),
+		Status:         entities.QuoteStatusActive,
+		CreatedAt:      now,
+		ExpiresAt:      now.Add(time.Minute),
+		UpdatedAt:      now,
+		TxHash:         generateTxHash(),
+		VegaTime:       now,
+	}
+	quote2 := entities.Quote{
+		ID:             entities.QuoteID(GenerateID()),
+		QuoteRequestID: request.ID,
+		MarketID:       market,
+		PartyID:        maker2,
+		Price:          num.DecimalFromInt64(99),
+		Status:         entities.QuoteStatusActive,
+		CreatedAt:      now.Add(time.Second),
+		ExpiresAt:      now.Add(time.Minute),
+		UpdatedAt:      now.Add(time.Second),
+		TxHash:         generateTxHash(),
+		VegaTime:       now.Add(time.Second),
+	}
+
+	t.Run("Inserting a request for quote and its quotes", func(t *testing.T) {
+		require.NoError(t, store.UpsertQuoteRequest(ctx, request))
+		require.NoError(t, store.UpsertQuote(ctx, quote1))
+		require.NoError(t, store.UpsertQuote(ctx, quote2))
+
+		requests, _, err := store.ListQuoteRequests(ctx, entities.DefaultCursorPagination(false), sqlstore.ListQuoteRequestsFilters{
+			MarketID: ptr.From(market),
+		})
+		require.NoError(t, err)
+		require.Len(t, requests, 1)
+		assert.Equal(t, request.ToProto(), requests[0].ToProto())
+
+		quotes, _, err := store.ListQuotes(ctx, entities.DefaultCursorPagination(false), sqlstore.ListQuotesFilters{
+			QuoteRequestID: ptr.From(request.ID),
+		})
+		require.NoError(t, err)
+		require.Len(t, quotes, 2)
+		// most recent quotes come first
+		assert.Equal(t, quote2.ToProto(), quotes[0].ToProto())
+		assert.Equal(t, quote1.ToProto(), quotes[1].ToProto())
+	})
+
+	t.Run("Accepting a quote fills the request", func(t *testing.T) {
+		filledAt := now.Add(2 * time.Second)
+
+		quote2.Status = entities.QuoteStatusAccepted
+		quote2.UpdatedAt = filledAt
+		quote2.VegaTime = filledAt
+		quote1.Status = entities.QuoteStatusCancelled
+		quote1.UpdatedAt = filledAt
+		quote1.VegaTime = filledAt
+		request.Status = entities.QuoteRequestStatusFilled
+		request.AcceptedQuoteID = ptr.From(quote2.ID)
+		request.TradeID = ptr.From(entities.TradeID(GenerateID()))
+		request.UpdatedAt = filledAt
+		request.VegaTime = filledAt
+
+		require.NoError(t, store.UpsertQuote(ctx, quote2))
+		require.NoError(t, store.UpsertQuote(ctx, quote1))
+		require.NoError(t, store.UpsertQuoteRequest(ctx, request))
+
+		requests, _, err := store.ListQuoteRequests(ctx, entities.DefaultCursorPagination(false), sqlstore.ListQuoteRequestsFilters{
+			ID: ptr.From(request.ID),
+		})
+		require.NoError(t, err)
+		require.Len(t, requests, 1)
+		assert.Equal(t, request.ToProto(), requests[0].ToProto())
+
+		quotes, _, err := store.ListQuotes(ctx, entities.DefaultCursorPagination(false), sqlstore.ListQuotesFilters{
+			Status: ptr.From(entities.QuoteStatusAccepted),
+		})
+		require.NoError(t, err)
+		require.Len(t, quotes, 1)
+		assert.Equal(t, quote2.ToProto(), quotes[0].ToProto())
+	})
+
+	t.Run("Filtering by party and status", func(t *testing.T) {
+		requests, _, err := store.ListQuoteRequests(ctx, entities.DefaultCursorPagination(false), sqlstore.ListQuoteRequestsFilters{
+			PartyID: ptr.From(taker),
+			Status:  ptr.From(entities.QuoteRequestStatusOpen),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, requests)
+
+		quotes, _, err := store.ListQuotes(ctx, entities.DefaultCursorPagination(false), sqlstore.ListQuotesFilters{
+			PartyID: ptr.From(maker1),
+		})
+		require.NoError(t, err)
+		require.Len(t, quotes, 1)
+		assert.Equal(t, quote1.ToProto(), quotes[0].ToProto())
+	})
+}
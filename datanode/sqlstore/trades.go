@@ -137,6 +137,28 @@ func (ts *Trades) List(ctx context.Context,
 	orderIDs []entities.OrderID,
 	pagination entities.CursorPagination,
 	dateRange entities.DateRange,
+) ([]entities.Trade, entities.PageInfo, error) {
+	return ts.list(ctx, marketIDs, partyIDs, orderIDs, nil, pagination, dateRange)
+}
+
+// ListBlockTrades lists the trades negotiated off-book through requests for quote.
+func (ts *Trades) ListBlockTrades(ctx context.Context,
+	marketIDs []entities.MarketID,
+	partyIDs []entities.PartyID,
+	pagination entities.CursorPagination,
+	dateRange entities.DateRange,
+) ([]entities.Trade, entities.PageInfo, error) {
+	tradeType := entities.TradeTypeBlock
+	return ts.list(ctx, marketIDs, partyIDs, nil, &tradeType, pagination, dateRange)
+}
+
+func (ts *Trades) list(ctx context.Context,
+	marketIDs []entities.MarketID,
+	partyIDs []entities.PartyID,
+	orderIDs []entities.OrderID,
+	tradeType *entities.TradeType,
+	pagination entities.CursorPagination,
+	dateRange entities.DateRange,
 ) ([]entities.Trade, entities.PageInfo, error) {
 	args := []interface{}{}
 
@@ -180,6 +202,10 @@ func (ts *Trades) List(ctx context.Context,
 		conditions = append(conditions, fmt.Sprintf("(buy_order = ANY(%s::bytea[]) or sell_order = ANY(%s::bytea[]))", bindVar, bindVar))
 	}
 
+	if tradeType != nil {
+		conditions = append(conditions, fmt.Sprintf("type = %s", nextBindVar(&args, *tradeType)))
+	}
+
 	query := `SELECT * from trades`
 	first := true
 	if len(conditions) > 0 {
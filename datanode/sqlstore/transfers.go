@@ -74,9 +74,11 @@ func (t *Transfers) Upsert(ctx context.Context, transfer *entities.Transfer) err
 				factor,
 				dispatch_strategy,
 				reason,
-				game_id
+				game_id,
+				streaming,
+				conditional
 			)
-					VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+					VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
 					ON CONFLICT (id, vega_time) DO UPDATE
 					SET
 				from_account_id=excluded.from_account_id,
@@ -93,12 +95,14 @@ func (t *Transfers) Upsert(ctx context.Context, transfer *entities.Transfer) err
 				dispatch_strategy=excluded.dispatch_strategy,
 				reason=excluded.reason,
 				tx_hash=excluded.tx_hash,
-				game_id=excluded.game_id
+				game_id=excluded.game_id,
+				streaming=excluded.streaming,
+				conditional=excluded.conditional
 				;`
 
 	if _, err := t.Exec(ctx, query, transfer.ID, transfer.TxHash, transfer.VegaTime, transfer.FromAccountID, transfer.ToAccountID,
 		transfer.AssetID, transfer.Amount, transfer.Reference, transfer.Status, transfer.TransferType,
-		transfer.DeliverOn, transfer.StartEpoch, transfer.EndEpoch, transfer.Factor, transfer.DispatchStrategy, transfer.Reason, transfer.GameID); err != nil {
+		transfer.DeliverOn, transfer.StartEpoch, transfer.EndEpoch, transfer.Factor, transfer.DispatchStrategy, transfer.Reason, transfer.GameID, transfer.Streaming, transfer.Conditional); err != nil {
 		return fmt.Errorf("could not insert transfer into database: %w", err)
 	}
 
@@ -305,7 +309,7 @@ func (t *Transfers) getCurrentTransfers(ctx context.Context, pagination entities
 	JOIN accounts at on tc.to_account_id = at.id
 )
 	SELECT id, tx_hash, vega_time, from_account_id, to_account_id, asset_id, amount, reference, status, transfer_type, deliver_on,
-		start_epoch, end_epoch, factor, dispatch_strategy, reason, game_id
+		start_epoch, end_epoch, factor, dispatch_strategy, reason, game_id, streaming, conditional
 	FROM current_transfers
 ` + whereStr
 
@@ -323,7 +327,7 @@ func (t *Transfers) getRecurringTransfers(ctx context.Context, pagination entiti
 		AND at.type = 12 OR (jsonb_typeof(tc.dispatch_strategy) != 'null' AND dispatch_strategy->>'metric' <> '0')
 )
 SELECT id, tx_hash, vega_time, from_account_id, to_account_id, asset_id, amount, reference, status, transfer_type, deliver_on,
-	start_epoch, end_epoch, factor, dispatch_strategy, reason, game_id
+	start_epoch, end_epoch, factor, dispatch_strategy, reason, game_id, streaming, conditional
 FROM recurring_transfers
 ` + whereStr
 
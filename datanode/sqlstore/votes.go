@@ -55,19 +55,21 @@ func (vs *Votes) Add(ctx context.Context, v entities.Vote) error {
 			total_governance_token_balance,
 			total_governance_token_weight,
 			total_equity_like_share_weight,
-			per_market_equity_like_share_weight
+			per_market_equity_like_share_weight,
+			options
 		)
-		 VALUES ($1,  $2,  $3,  $4,  $5, $6, $7, $8, $9, $10)
+		 VALUES ($1,  $2,  $3,  $4,  $5, $6, $7, $8, $9, $10, $11)
 		 ON CONFLICT (proposal_id, party_id, vega_time) DO UPDATE SET
 			value = EXCLUDED.value,
 			total_governance_token_balance =EXCLUDED.total_governance_token_balance,
 			total_governance_token_weight = EXCLUDED.total_governance_token_weight,
 			total_equity_like_share_weight = EXCLUDED.total_equity_like_share_weight,
 			per_market_equity_like_share_weight = EXCLUDED.per_market_equity_like_share_weight,
+			options = EXCLUDED.options,
 			tx_hash = EXCLUDED.tx_hash;
 		`,
 		v.ProposalID, v.PartyID, v.Value, v.TxHash, v.VegaTime, v.InitialTime,
-		v.TotalGovernanceTokenBalance, v.TotalGovernanceTokenWeight, v.TotalEquityLikeShareWeight, v.PerMarketEquityLikeShareWeight)
+		v.TotalGovernanceTokenBalance, v.TotalGovernanceTokenWeight, v.TotalEquityLikeShareWeight, v.PerMarketEquityLikeShareWeight, v.Options)
 	return err
 }
 
@@ -0,0 +1,73 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package sqlsubscribers
+
+import (
+	"context"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/datanode/entities"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+
+	"github.com/pkg/errors"
+)
+
+type AutoDeleveragingRankingsEvent interface {
+	events.Event
+	MarketID() string
+	Rankings() []*eventspb.AutoDeleveragingRank
+}
+
+type AutoDeleveragingRankingsStore interface {
+	ReplaceAutoDeleveragingRankings(ctx context.Context, marketID entities.MarketID, rankings []entities.AutoDeleveragingRanking) error
+}
+
+type AutoDeleveragingRankings struct {
+	subscriber
+	store AutoDeleveragingRankingsStore
+}
+
+func NewAutoDeleveragingRankings(store AutoDeleveragingRankingsStore) *AutoDeleveragingRankings {
+	return &AutoDeleveragingRankings{
+		store: store,
+	}
+}
+
+func (a *AutoDeleveragingRankings) Types() []events.Type {
+	return []events.Type{events.AutoDeleveragingRankingsEvent}
+}
+
+func (a *AutoDeleveragingRankings) Push(ctx context.Context, evt events.Event) error {
+	switch e := evt.(type) {
+	case AutoDeleveragingRankingsEvent:
+		return a.consumeAutoDeleveragingRankings(ctx, e)
+	default:
+		return nil
+	}
+}
+
+func (a *AutoDeleveragingRankings) consumeAutoDeleveragingRankings(ctx context.Context, e AutoDeleveragingRankingsEvent) error {
+	marketID := entities.MarketID(e.MarketID())
+	rankings, err := entities.AutoDeleveragingRankingsFromProto(marketID, e.Rankings(), entities.TxHash(e.TxHash()), a.vegaTime)
+	if err != nil {
+		return errors.Wrap(err, "deserializing auto-deleveraging rankings")
+	}
+	return errors.Wrap(a.store.ReplaceAutoDeleveragingRankings(ctx, marketID, rankings), "replacing auto-deleveraging rankings")
+}
+
+func (a *AutoDeleveragingRankings) Name() string {
+	return "AutoDeleveragingRankings"
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package sqlsubscribers
+
+import (
+	"context"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/datanode/entities"
+	"code.vegaprotocol.io/vega/protos/vega"
+
+	"github.com/pkg/errors"
+)
+
+type GovernanceDelegationEvent interface {
+	events.Event
+	GovernanceDelegation() vega.GovernanceDelegation
+}
+
+type GovernanceDelegationsStore interface {
+	Upsert(ctx context.Context, d entities.GovernanceDelegation) error
+}
+
+type GovernanceDelegations struct {
+	subscriber
+	store GovernanceDelegationsStore
+}
+
+func NewGovernanceDelegations(store GovernanceDelegationsStore) *GovernanceDelegations {
+	return &GovernanceDelegations{
+		store: store,
+	}
+}
+
+func (g *GovernanceDelegations) Types() []events.Type {
+	return []events.Type{events.GovernanceDelegationEvent}
+}
+
+func (g *GovernanceDelegations) Push(ctx context.Context, evt events.Event) error {
+	return g.consume(ctx, evt.(GovernanceDelegationEvent))
+}
+
+func (g *GovernanceDelegations) consume(ctx context.Context, e GovernanceDelegationEvent) error {
+	gd := e.GovernanceDelegation()
+	d := entities.GovernanceDelegationFromProto(&gd, entities.TxHash(e.TxHash()), g.vegaTime)
+	return errors.Wrap(g.store.Upsert(ctx, d), "upserting governance delegation")
+}
+
+func (g *GovernanceDelegations) Name() string {
+	return "GovernanceDelegations"
+}
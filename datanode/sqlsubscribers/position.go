@@ -232,7 +232,8 @@ func (p *Position) handleTradeEvent(ctx context.Context, event tradeEvent) error
 		return fmt.Errorf("failed to get market scaling factor for market %s", trade.MarketId)
 	}
 
-	if trade.Type == types.TradeTypeNetworkCloseOutBad {
+	// the parties auto-deleveraged are settled like the distressed parties, only the network position is updated by the trade
+	if trade.Type == types.TradeTypeNetworkCloseOutBad || trade.Type == types.TradeTypeAutoDeleveraging {
 		pos := p.getNetworkPosition(ctx, trade.MarketId)
 		seller := trade.Seller == types.NetworkParty
 		pos.UpdateWithTrade(trade, seller, sf)
@@ -0,0 +1,84 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package sqlsubscribers
+
+import (
+	"context"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/datanode/entities"
+	"code.vegaprotocol.io/vega/protos/vega"
+
+	"github.com/pkg/errors"
+)
+
+type QuoteRequestEvent interface {
+	events.Event
+	QuoteRequest() *vega.QuoteRequest
+}
+
+type QuoteEvent interface {
+	events.Event
+	Quote() *vega.Quote
+}
+
+type RequestsForQuoteStore interface {
+	UpsertQuoteRequest(ctx context.Context, qr entities.QuoteRequest) error
+	UpsertQuote(ctx context.Context, q entities.Quote) error
+}
+
+type RequestsForQuote struct {
+	subscriber
+	store RequestsForQuoteStore
+}
+
+func NewRequestsForQuote(store RequestsForQuoteStore) *RequestsForQuote {
+	return &RequestsForQuote{
+		store: store,
+	}
+}
+
+func (r *RequestsForQuote) Types() []events.Type {
+	return []events.Type{events.QuoteRequestEvent, events.QuoteEvent}
+}
+
+func (r *RequestsForQuote) Push(ctx context.Context, evt events.Event) error {
+	switch e := evt.(type) {
+	case QuoteRequestEvent:
+		return r.consumeQuoteRequest(ctx, e)
+	case QuoteEvent:
+		return r.consumeQuote(ctx, e)
+	default:
+		return nil
+	}
+}
+
+func (r *RequestsForQuote) consumeQuoteRequest(ctx context.Context, e QuoteRequestEvent) error {
+	qr := entities.QuoteRequestFromProto(e.QuoteRequest(), entities.TxHash(e.TxHash()), r.vegaTime)
+	return errors.Wrap(r.store.UpsertQuoteRequest(ctx, qr), "upserting request for quote")
+}
+
+func (r *RequestsForQuote) consumeQuote(ctx context.Context, e QuoteEvent) error {
+	q, err := entities.QuoteFromProto(e.Quote(), entities.TxHash(e.TxHash()), r.vegaTime)
+	if err != nil {
+		return errors.Wrap(err, "deserializing quote")
+	}
+	return errors.Wrap(r.store.UpsertQuote(ctx, q), "upserting quote")
+}
+
+func (r *RequestsForQuote) Name() string {
+	return "RequestsForQuote"
+}
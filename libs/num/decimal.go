@@ -31,6 +31,9 @@ var (
 	d2         = decimal.NewFromFloat(2)
 	maxDecimal = decimal.NewFromBigInt(maxU256, 0)
 	e          = MustDecimalFromString("2.7182818285")
+	sqrtScale  = decimal.New(1, 8)
+	dhalf      = decimal.New(5, -1)
+	ln2        = lnSeries(d2)
 )
 
 func MustDecimalFromString(f string) Decimal {
@@ -140,6 +143,57 @@ func Variance(numbers []Decimal) (Decimal, error) {
 	return total.Div(DecimalFromInt64(int64(len(numbers)))), nil
 }
 
+// DecimalSqrt returns the square root of d, or zero if d is not positive.
+func DecimalSqrt(d Decimal) Decimal {
+	if !d.IsPositive() {
+		return dzero
+	}
+	// start from the integer square root, scaled up for values below 1, and refine it with Heron's method
+	scale := d1
+	if d.LessThan(d1) {
+		scale = sqrtScale
+	}
+	u, _ := UintFromDecimal(d.Mul(scale).Mul(scale))
+	r := DecimalFromUint(UintZero().SqrtInt(u)).Div(scale)
+	if r.IsZero() {
+		r = d
+	}
+	for i := 0; i < 8; i++ {
+		r = r.Add(d.Div(r)).Div(d2)
+	}
+	return r
+}
+
+// DecimalLn returns the natural logarithm of d, or zero if d is not positive.
+func DecimalLn(d Decimal) Decimal {
+	if !d.IsPositive() {
+		return dzero
+	}
+	// bring the value within [0.5, 2] so the series converges quickly, ln(d) = ln(m) + k*ln(2)
+	k := int64(0)
+	for d.GreaterThan(d2) {
+		d = d.Div(d2)
+		k++
+	}
+	for d.LessThan(dhalf) {
+		d = d.Mul(d2)
+		k--
+	}
+	return lnSeries(d).Add(ln2.Mul(DecimalFromInt64(k)))
+}
+
+// lnSeries computes ln(d) = 2 * sum(z^(2n+1) / (2n+1)) with z = (d-1)/(d+1).
+func lnSeries(d Decimal) Decimal {
+	z := d.Sub(d1).Div(d.Add(d1))
+	z2 := z.Mul(z)
+	sum, term := dzero, z
+	for n := int64(0); n < 64 && !term.IsZero(); n++ {
+		sum = sum.Add(term.Div(decimal.NewFromInt(2*n + 1)))
+		term = term.Mul(z2).Truncate(int32(decimal.DivisionPrecision))
+	}
+	return sum.Mul(d2)
+}
+
 func UnmarshalBinaryDecimal(data []byte) (Decimal, error) {
 	d := decimal.New(0, 1)
 	err := d.UnmarshalBinary(data)
@@ -0,0 +1,47 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package num_test
+
+import (
+	"testing"
+
+	"code.vegaprotocol.io/vega/libs/num"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimalSqrt(t *testing.T) {
+	assert.True(t, num.DecimalSqrt(num.DecimalZero()).IsZero())
+	assert.True(t, num.DecimalSqrt(num.DecimalFromInt64(-4)).IsZero())
+	assert.Equal(t, "3", num.DecimalSqrt(num.DecimalFromInt64(9)).String())
+	assert.Equal(t, "0.5", num.DecimalSqrt(num.MustDecimalFromString("0.25")).String())
+
+	for _, v := range []string{"2", "0.0003", "123456789.123", "1000000000000000000000000000000000000000"} {
+		d := num.MustDecimalFromString(v)
+		r := num.DecimalSqrt(d)
+		// the square of the root is within a relative 1e-12 of the value
+		assert.True(t, r.Mul(r).Sub(d).Abs().Div(d).LessThan(num.MustDecimalFromString("0.000000000001")), v)
+	}
+}
+
+func TestDecimalLn(t *testing.T) {
+	assert.True(t, num.DecimalLn(num.DecimalZero()).IsZero())
+	assert.True(t, num.DecimalLn(num.DecimalOne()).IsZero())
+	assert.Equal(t, "0.69314718", num.DecimalLn(num.DecimalTwo()).Round(8).String())
+	assert.Equal(t, "1", num.DecimalLn(num.DecimalE()).Round(8).String())
+	assert.Equal(t, "-6.90775528", num.DecimalLn(num.MustDecimalFromString("0.001")).Round(8).String())
+	assert.Equal(t, "20.72326584", num.DecimalLn(num.DecimalFromInt64(1000000000)).Round(8).String())
+}
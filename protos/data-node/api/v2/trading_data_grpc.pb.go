@@ -99,13 +99,11 @@ type TradingDataServiceClient interface {
 	//   - receiving account (market ID, asset ID, account type)
 	//   - sending AND receiving account
 	//   - transfer type either in addition to the above filters or as a standalone option
-	//
 	// Note: The date range is restricted to any 5 days.
-	//
-	//	If no start or end date is provided, only ledger entries from the last 5 days will be returned.
-	//	If a start and end date are provided, but the end date is more than 5 days after the start date, only data up to 5 days after the start date will be returned.
-	//	If a start date is provided but no end date, the end date will be set to 5 days after the start date.
-	//	If no start date is provided, but the end date is, the start date will be set to 5 days before the end date.
+	//   If no start or end date is provided, only ledger entries from the last 5 days will be returned.
+	//   If a start and end date are provided, but the end date is more than 5 days after the start date, only data up to 5 days after the start date will be returned.
+	//   If a start date is provided but no end date, the end date will be set to 5 days after the start date.
+	//   If no start date is provided, but the end date is, the start date will be set to 5 days before the end date.
 	ListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest, opts ...grpc.CallOption) (*ListLedgerEntriesResponse, error)
 	// Export ledger entries
 	//
@@ -115,7 +113,6 @@ type TradingDataServiceClient interface {
 	// Ledger entries can be exported by:
 	//   - export ledger entries for a single party for a given asset within a given time range
 	//   - export ledger entries for a single party for a given asset for all times
-	//
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	ExportLedgerEntries(ctx context.Context, in *ExportLedgerEntriesRequest, opts ...grpc.CallOption) (TradingDataService_ExportLedgerEntriesClient, error)
@@ -188,6 +185,19 @@ type TradingDataServiceClient interface {
 	//
 	// Subscribe to a stream of votes cast on a given proposal, or by all votes made by a given party
 	ObserveVotes(ctx context.Context, in *ObserveVotesRequest, opts ...grpc.CallOption) (TradingDataService_ObserveVotesClient, error)
+	// List governance delegations
+	//
+	// Get the current governance delegations, optionally filtered by delegator, delegate or proposal type.
+	// Filtering by delegator or delegate returns the outgoing or incoming edges of the delegation graph of a party.
+	ListGovernanceDelegations(ctx context.Context, in *ListGovernanceDelegationsRequest, opts ...grpc.CallOption) (*ListGovernanceDelegationsResponse, error)
+	// Estimate proposal impact
+	//
+	// Estimate the impact a market update proposal would have if it was enacted, without submitting it.
+	// For a market update, the risk factors, the price monitoring bounds around the current mark price, and the maintenance margin
+	// of every open position are compared under the current and proposed parameters.
+	// Network parameter updates are rejected as unsupported, none of the network parameters changes the risk factors, margins
+	// or price monitoring bounds of the existing markets.
+	EstimateProposalImpact(ctx context.Context, in *EstimateProposalImpactRequest, opts ...grpc.CallOption) (*EstimateProposalImpactResponse, error)
 	// List ERC20 multisig signer added bundles
 	//
 	// Get a list of the signature bundles to add a particular validator to the signer list of the multisig contract
@@ -593,6 +603,23 @@ type TradingDataServiceClient interface {
 	//
 	// Get the discount and reward tiers, and the fees and rebates for a party per market.
 	GetPartyDiscountStats(ctx context.Context, in *GetPartyDiscountStatsRequest, opts ...grpc.CallOption) (*GetPartyDiscountStatsResponse, error)
+	// List requests for quote
+	//
+	// Get a list of requests for quote, optionally filtered by ID, market, requesting party or status
+	ListQuoteRequests(ctx context.Context, in *ListQuoteRequestsRequest, opts ...grpc.CallOption) (*ListQuoteRequestsResponse, error)
+	// List quotes
+	//
+	// Get a list of quotes, optionally filtered by request for quote, quoting party or status
+	ListQuotes(ctx context.Context, in *ListQuotesRequest, opts ...grpc.CallOption) (*ListQuotesResponse, error)
+	// List block trades
+	//
+	// Get a list of the block trades executed from accepted quotes, optionally filtered by markets and parties
+	ListBlockTrades(ctx context.Context, in *ListBlockTradesRequest, opts ...grpc.CallOption) (*ListBlockTradesResponse, error)
+	// List auto-deleveraging rankings
+	//
+	// Get the latest auto-deleveraging ranking of the positions in markets with auto-deleveraging enabled,
+	// optionally filtered by market or party
+	ListAutoDeleveragingRankings(ctx context.Context, in *ListAutoDeleveragingRankingsRequest, opts ...grpc.CallOption) (*ListAutoDeleveragingRankingsResponse, error)
 	// Export network history as CSV
 	//
 	// Export CSV table data from network history between two block heights.
@@ -636,9 +663,9 @@ type TradingDataServiceClient interface {
 	// file will contain multiple CSV files, with a potentially different set of headers. The
 	// 'version' number of the database schema is part of the in the CSV filename:
 	//
-	//	`[chain id]-[table name]-[schema version]-[start block]-[end block].zip`
+	//  `[chain id]-[table name]-[schema version]-[start block]-[end block].zip`
 	//
-	// # For example, a zip file might be called mainnet-sometable-000001-003000.zip
+	// For example, a zip file might be called mainnet-sometable-000001-003000.zip
 	//
 	// And contain two CSV files: `mainnet-sometable-1-000001-002000.csv`:
 	//
@@ -1165,6 +1192,24 @@ func (x *tradingDataServiceObserveVotesClient) Recv() (*ObserveVotesResponse, er
 	return m, nil
 }
 
+func (c *tradingDataServiceClient) ListGovernanceDelegations(ctx context.Context, in *ListGovernanceDelegationsRequest, opts ...grpc.CallOption) (*ListGovernanceDelegationsResponse, error) {
+	out := new(ListGovernanceDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/datanode.api.v2.TradingDataService/ListGovernanceDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingDataServiceClient) EstimateProposalImpact(ctx context.Context, in *EstimateProposalImpactRequest, opts ...grpc.CallOption) (*EstimateProposalImpactResponse, error) {
+	out := new(EstimateProposalImpactResponse)
+	err := c.cc.Invoke(ctx, "/datanode.api.v2.TradingDataService/EstimateProposalImpact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tradingDataServiceClient) ListERC20MultiSigSignerAddedBundles(ctx context.Context, in *ListERC20MultiSigSignerAddedBundlesRequest, opts ...grpc.CallOption) (*ListERC20MultiSigSignerAddedBundlesResponse, error) {
 	out := new(ListERC20MultiSigSignerAddedBundlesResponse)
 	err := c.cc.Invoke(ctx, "/datanode.api.v2.TradingDataService/ListERC20MultiSigSignerAddedBundles", in, out, opts...)
@@ -2173,6 +2218,42 @@ func (c *tradingDataServiceClient) GetPartyDiscountStats(ctx context.Context, in
 	return out, nil
 }
 
+func (c *tradingDataServiceClient) ListQuoteRequests(ctx context.Context, in *ListQuoteRequestsRequest, opts ...grpc.CallOption) (*ListQuoteRequestsResponse, error) {
+	out := new(ListQuoteRequestsResponse)
+	err := c.cc.Invoke(ctx, "/datanode.api.v2.TradingDataService/ListQuoteRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingDataServiceClient) ListQuotes(ctx context.Context, in *ListQuotesRequest, opts ...grpc.CallOption) (*ListQuotesResponse, error) {
+	out := new(ListQuotesResponse)
+	err := c.cc.Invoke(ctx, "/datanode.api.v2.TradingDataService/ListQuotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingDataServiceClient) ListBlockTrades(ctx context.Context, in *ListBlockTradesRequest, opts ...grpc.CallOption) (*ListBlockTradesResponse, error) {
+	out := new(ListBlockTradesResponse)
+	err := c.cc.Invoke(ctx, "/datanode.api.v2.TradingDataService/ListBlockTrades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingDataServiceClient) ListAutoDeleveragingRankings(ctx context.Context, in *ListAutoDeleveragingRankingsRequest, opts ...grpc.CallOption) (*ListAutoDeleveragingRankingsResponse, error) {
+	out := new(ListAutoDeleveragingRankingsResponse)
+	err := c.cc.Invoke(ctx, "/datanode.api.v2.TradingDataService/ListAutoDeleveragingRankings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tradingDataServiceClient) ExportNetworkHistory(ctx context.Context, in *ExportNetworkHistoryRequest, opts ...grpc.CallOption) (TradingDataService_ExportNetworkHistoryClient, error) {
 	stream, err := c.cc.NewStream(ctx, &TradingDataService_ServiceDesc.Streams[16], "/datanode.api.v2.TradingDataService/ExportNetworkHistory", opts...)
 	if err != nil {
@@ -2294,13 +2375,11 @@ type TradingDataServiceServer interface {
 	//   - receiving account (market ID, asset ID, account type)
 	//   - sending AND receiving account
 	//   - transfer type either in addition to the above filters or as a standalone option
-	//
 	// Note: The date range is restricted to any 5 days.
-	//
-	//	If no start or end date is provided, only ledger entries from the last 5 days will be returned.
-	//	If a start and end date are provided, but the end date is more than 5 days after the start date, only data up to 5 days after the start date will be returned.
-	//	If a start date is provided but no end date, the end date will be set to 5 days after the start date.
-	//	If no start date is provided, but the end date is, the start date will be set to 5 days before the end date.
+	//   If no start or end date is provided, only ledger entries from the last 5 days will be returned.
+	//   If a start and end date are provided, but the end date is more than 5 days after the start date, only data up to 5 days after the start date will be returned.
+	//   If a start date is provided but no end date, the end date will be set to 5 days after the start date.
+	//   If no start date is provided, but the end date is, the start date will be set to 5 days before the end date.
 	ListLedgerEntries(context.Context, *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error)
 	// Export ledger entries
 	//
@@ -2310,7 +2389,6 @@ type TradingDataServiceServer interface {
 	// Ledger entries can be exported by:
 	//   - export ledger entries for a single party for a given asset within a given time range
 	//   - export ledger entries for a single party for a given asset for all times
-	//
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	ExportLedgerEntries(*ExportLedgerEntriesRequest, TradingDataService_ExportLedgerEntriesServer) error
@@ -2383,6 +2461,19 @@ type TradingDataServiceServer interface {
 	//
 	// Subscribe to a stream of votes cast on a given proposal, or by all votes made by a given party
 	ObserveVotes(*ObserveVotesRequest, TradingDataService_ObserveVotesServer) error
+	// List governance delegations
+	//
+	// Get the current governance delegations, optionally filtered by delegator, delegate or proposal type.
+	// Filtering by delegator or delegate returns the outgoing or incoming edges of the delegation graph of a party.
+	ListGovernanceDelegations(context.Context, *ListGovernanceDelegationsRequest) (*ListGovernanceDelegationsResponse, error)
+	// Estimate proposal impact
+	//
+	// Estimate the impact a market update proposal would have if it was enacted, without submitting it.
+	// For a market update, the risk factors, the price monitoring bounds around the current mark price, and the maintenance margin
+	// of every open position are compared under the current and proposed parameters.
+	// Network parameter updates are rejected as unsupported, none of the network parameters changes the risk factors, margins
+	// or price monitoring bounds of the existing markets.
+	EstimateProposalImpact(context.Context, *EstimateProposalImpactRequest) (*EstimateProposalImpactResponse, error)
 	// List ERC20 multisig signer added bundles
 	//
 	// Get a list of the signature bundles to add a particular validator to the signer list of the multisig contract
@@ -2788,6 +2879,23 @@ type TradingDataServiceServer interface {
 	//
 	// Get the discount and reward tiers, and the fees and rebates for a party per market.
 	GetPartyDiscountStats(context.Context, *GetPartyDiscountStatsRequest) (*GetPartyDiscountStatsResponse, error)
+	// List requests for quote
+	//
+	// Get a list of requests for quote, optionally filtered by ID, market, requesting party or status
+	ListQuoteRequests(context.Context, *ListQuoteRequestsRequest) (*ListQuoteRequestsResponse, error)
+	// List quotes
+	//
+	// Get a list of quotes, optionally filtered by request for quote, quoting party or status
+	ListQuotes(context.Context, *ListQuotesRequest) (*ListQuotesResponse, error)
+	// List block trades
+	//
+	// Get a list of the block trades executed from accepted quotes, optionally filtered by markets and parties
+	ListBlockTrades(context.Context, *ListBlockTradesRequest) (*ListBlockTradesResponse, error)
+	// List auto-deleveraging rankings
+	//
+	// Get the latest auto-deleveraging ranking of the positions in markets with auto-deleveraging enabled,
+	// optionally filtered by market or party
+	ListAutoDeleveragingRankings(context.Context, *ListAutoDeleveragingRankingsRequest) (*ListAutoDeleveragingRankingsResponse, error)
 	// Export network history as CSV
 	//
 	// Export CSV table data from network history between two block heights.
@@ -2831,9 +2939,9 @@ type TradingDataServiceServer interface {
 	// file will contain multiple CSV files, with a potentially different set of headers. The
 	// 'version' number of the database schema is part of the in the CSV filename:
 	//
-	//	`[chain id]-[table name]-[schema version]-[start block]-[end block].zip`
+	//  `[chain id]-[table name]-[schema version]-[start block]-[end block].zip`
 	//
-	// # For example, a zip file might be called mainnet-sometable-000001-003000.zip
+	// For example, a zip file might be called mainnet-sometable-000001-003000.zip
 	//
 	// And contain two CSV files: `mainnet-sometable-1-000001-002000.csv`:
 	//
@@ -2957,6 +3065,12 @@ func (UnimplementedTradingDataServiceServer) ListVotes(context.Context, *ListVot
 func (UnimplementedTradingDataServiceServer) ObserveVotes(*ObserveVotesRequest, TradingDataService_ObserveVotesServer) error {
 	return status.Errorf(codes.Unimplemented, "method ObserveVotes not implemented")
 }
+func (UnimplementedTradingDataServiceServer) ListGovernanceDelegations(context.Context, *ListGovernanceDelegationsRequest) (*ListGovernanceDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGovernanceDelegations not implemented")
+}
+func (UnimplementedTradingDataServiceServer) EstimateProposalImpact(context.Context, *EstimateProposalImpactRequest) (*EstimateProposalImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateProposalImpact not implemented")
+}
 func (UnimplementedTradingDataServiceServer) ListERC20MultiSigSignerAddedBundles(context.Context, *ListERC20MultiSigSignerAddedBundlesRequest) (*ListERC20MultiSigSignerAddedBundlesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListERC20MultiSigSignerAddedBundles not implemented")
 }
@@ -3239,6 +3353,18 @@ func (UnimplementedTradingDataServiceServer) EstimateAMMBounds(context.Context,
 func (UnimplementedTradingDataServiceServer) GetPartyDiscountStats(context.Context, *GetPartyDiscountStatsRequest) (*GetPartyDiscountStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPartyDiscountStats not implemented")
 }
+func (UnimplementedTradingDataServiceServer) ListQuoteRequests(context.Context, *ListQuoteRequestsRequest) (*ListQuoteRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuoteRequests not implemented")
+}
+func (UnimplementedTradingDataServiceServer) ListQuotes(context.Context, *ListQuotesRequest) (*ListQuotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuotes not implemented")
+}
+func (UnimplementedTradingDataServiceServer) ListBlockTrades(context.Context, *ListBlockTradesRequest) (*ListBlockTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockTrades not implemented")
+}
+func (UnimplementedTradingDataServiceServer) ListAutoDeleveragingRankings(context.Context, *ListAutoDeleveragingRankingsRequest) (*ListAutoDeleveragingRankingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAutoDeleveragingRankings not implemented")
+}
 func (UnimplementedTradingDataServiceServer) ExportNetworkHistory(*ExportNetworkHistoryRequest, TradingDataService_ExportNetworkHistoryServer) error {
 	return status.Errorf(codes.Unimplemented, "method ExportNetworkHistory not implemented")
 }
@@ -3861,6 +3987,42 @@ func (x *tradingDataServiceObserveVotesServer) Send(m *ObserveVotesResponse) err
 	return x.ServerStream.SendMsg(m)
 }
 
+func _TradingDataService_ListGovernanceDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGovernanceDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingDataServiceServer).ListGovernanceDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datanode.api.v2.TradingDataService/ListGovernanceDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingDataServiceServer).ListGovernanceDelegations(ctx, req.(*ListGovernanceDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradingDataService_EstimateProposalImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateProposalImpactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingDataServiceServer).EstimateProposalImpact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datanode.api.v2.TradingDataService/EstimateProposalImpact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingDataServiceServer).EstimateProposalImpact(ctx, req.(*EstimateProposalImpactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TradingDataService_ListERC20MultiSigSignerAddedBundles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListERC20MultiSigSignerAddedBundlesRequest)
 	if err := dec(in); err != nil {
@@ -5579,6 +5741,78 @@ func _TradingDataService_GetPartyDiscountStats_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TradingDataService_ListQuoteRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuoteRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingDataServiceServer).ListQuoteRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datanode.api.v2.TradingDataService/ListQuoteRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingDataServiceServer).ListQuoteRequests(ctx, req.(*ListQuoteRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradingDataService_ListQuotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingDataServiceServer).ListQuotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datanode.api.v2.TradingDataService/ListQuotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingDataServiceServer).ListQuotes(ctx, req.(*ListQuotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradingDataService_ListBlockTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockTradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingDataServiceServer).ListBlockTrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datanode.api.v2.TradingDataService/ListBlockTrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingDataServiceServer).ListBlockTrades(ctx, req.(*ListBlockTradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TradingDataService_ListAutoDeleveragingRankings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAutoDeleveragingRankingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingDataServiceServer).ListAutoDeleveragingRankings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datanode.api.v2.TradingDataService/ListAutoDeleveragingRankings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingDataServiceServer).ListAutoDeleveragingRankings(ctx, req.(*ListAutoDeleveragingRankingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TradingDataService_ExportNetworkHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ExportNetworkHistoryRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -5717,6 +5951,14 @@ var TradingDataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListVotes",
 			Handler:    _TradingDataService_ListVotes_Handler,
 		},
+		{
+			MethodName: "ListGovernanceDelegations",
+			Handler:    _TradingDataService_ListGovernanceDelegations_Handler,
+		},
+		{
+			MethodName: "EstimateProposalImpact",
+			Handler:    _TradingDataService_EstimateProposalImpact_Handler,
+		},
 		{
 			MethodName: "ListERC20MultiSigSignerAddedBundles",
 			Handler:    _TradingDataService_ListERC20MultiSigSignerAddedBundles_Handler,
@@ -6065,6 +6307,22 @@ var TradingDataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPartyDiscountStats",
 			Handler:    _TradingDataService_GetPartyDiscountStats_Handler,
 		},
+		{
+			MethodName: "ListQuoteRequests",
+			Handler:    _TradingDataService_ListQuoteRequests_Handler,
+		},
+		{
+			MethodName: "ListQuotes",
+			Handler:    _TradingDataService_ListQuotes_Handler,
+		},
+		{
+			MethodName: "ListBlockTrades",
+			Handler:    _TradingDataService_ListBlockTrades_Handler,
+		},
+		{
+			MethodName: "ListAutoDeleveragingRankings",
+			Handler:    _TradingDataService_ListAutoDeleveragingRankings_Handler,
+		},
 		{
 			MethodName: "Ping",
 			Handler:    _TradingDataService_Ping_Handler,
@@ -128,6 +128,16 @@ func (err OrderError) Error() string {
 		return "OrderError: price not in tick size"
 	case OrderError_ORDER_ERROR_PRICE_MUST_BE_LESS_THAN_OR_EQUAL_TO_MAX_PRICE:
 		return "OrderError: price exceeds max price"
+	case OrderError_ORDER_ERROR_MARKET_MAKER_PROTECTION_FROZEN:
+		return "OrderError: party is frozen by market maker protection"
+	case OrderError_ORDER_ERROR_PEGGED_REFERENCE_NOT_SUPPORTED:
+		return "OrderError: pegged reference not supported by the market"
+	case OrderError_ORDER_ERROR_POSITION_SIZE_LIMIT_EXCEEDED:
+		return "OrderError: position size limit exceeded"
+	case OrderError_ORDER_ERROR_POSITION_NOTIONAL_LIMIT_EXCEEDED:
+		return "OrderError: position notional limit exceeded"
+	case OrderError_ORDER_ERROR_OPEN_INTEREST_LIMIT_EXCEEDED:
+		return "OrderError: open interest limit exceeded"
 	default:
 		return "invalid OrderError"
 	}
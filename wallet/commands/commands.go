@@ -107,6 +107,24 @@ func CheckSubmitTransactionRequest(req *walletpb.SubmitTransactionRequest) comma
 		cmdErr = commands.CheckAmendAMM(cmd.AmendAmm)
 	case *walletpb.SubmitTransactionRequest_CancelAmm:
 		cmdErr = commands.CheckCancelAMM(cmd.CancelAmm)
+	case *walletpb.SubmitTransactionRequest_UpdateMarketMakerProtection:
+		cmdErr = commands.CheckUpdateMarketMakerProtection(cmd.UpdateMarketMakerProtection)
+	case *walletpb.SubmitTransactionRequest_CancelOnTimeout:
+		cmdErr = commands.CheckCancelOnTimeout(cmd.CancelOnTimeout)
+	case *walletpb.SubmitTransactionRequest_SubmitSpreadOrder:
+		cmdErr = commands.CheckSubmitSpreadOrder(cmd.SubmitSpreadOrder)
+	case *walletpb.SubmitTransactionRequest_SubmitQuoteRequest:
+		cmdErr = commands.CheckSubmitQuoteRequest(cmd.SubmitQuoteRequest)
+	case *walletpb.SubmitTransactionRequest_SubmitQuote:
+		cmdErr = commands.CheckSubmitQuote(cmd.SubmitQuote)
+	case *walletpb.SubmitTransactionRequest_AcceptQuote:
+		cmdErr = commands.CheckAcceptQuote(cmd.AcceptQuote)
+	case *walletpb.SubmitTransactionRequest_CancelQuoteRequest:
+		cmdErr = commands.CheckCancelQuoteRequest(cmd.CancelQuoteRequest)
+	case *walletpb.SubmitTransactionRequest_SubmitCompleteSet:
+		cmdErr = commands.CheckSubmitCompleteSet(cmd.SubmitCompleteSet)
+	case *walletpb.SubmitTransactionRequest_UpdateGovernanceDelegation:
+		cmdErr = commands.CheckUpdateGovernanceDelegation(cmd.UpdateGovernanceDelegation)
 	default:
 		errs.AddForProperty("input_data.command", commands.ErrIsNotSupported)
 	}
@@ -270,6 +288,42 @@ func WrapRequestCommandIntoInputData(data *commandspb.InputData, req *walletpb.S
 		data.Command = &commandspb.InputData_CancelAmm{
 			CancelAmm: req.GetCancelAmm(),
 		}
+	case *walletpb.SubmitTransactionRequest_UpdateMarketMakerProtection:
+		data.Command = &commandspb.InputData_UpdateMarketMakerProtection{
+			UpdateMarketMakerProtection: req.GetUpdateMarketMakerProtection(),
+		}
+	case *walletpb.SubmitTransactionRequest_CancelOnTimeout:
+		data.Command = &commandspb.InputData_CancelOnTimeout{
+			CancelOnTimeout: req.GetCancelOnTimeout(),
+		}
+	case *walletpb.SubmitTransactionRequest_SubmitSpreadOrder:
+		data.Command = &commandspb.InputData_SubmitSpreadOrder{
+			SubmitSpreadOrder: req.GetSubmitSpreadOrder(),
+		}
+	case *walletpb.SubmitTransactionRequest_SubmitQuoteRequest:
+		data.Command = &commandspb.InputData_SubmitQuoteRequest{
+			SubmitQuoteRequest: req.GetSubmitQuoteRequest(),
+		}
+	case *walletpb.SubmitTransactionRequest_SubmitQuote:
+		data.Command = &commandspb.InputData_SubmitQuote{
+			SubmitQuote: req.GetSubmitQuote(),
+		}
+	case *walletpb.SubmitTransactionRequest_AcceptQuote:
+		data.Command = &commandspb.InputData_AcceptQuote{
+			AcceptQuote: req.GetAcceptQuote(),
+		}
+	case *walletpb.SubmitTransactionRequest_CancelQuoteRequest:
+		data.Command = &commandspb.InputData_CancelQuoteRequest{
+			CancelQuoteRequest: req.GetCancelQuoteRequest(),
+		}
+	case *walletpb.SubmitTransactionRequest_SubmitCompleteSet:
+		data.Command = &commandspb.InputData_SubmitCompleteSet{
+			SubmitCompleteSet: req.GetSubmitCompleteSet(),
+		}
+	case *walletpb.SubmitTransactionRequest_UpdateGovernanceDelegation:
+		data.Command = &commandspb.InputData_UpdateGovernanceDelegation{
+			UpdateGovernanceDelegation: req.GetUpdateGovernanceDelegation(),
+		}
 	default:
 		panic(fmt.Sprintf("command %T is not supported", cmd))
 	}